@@ -0,0 +1,596 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var andTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check (truth table)
+	// andx001 and             0    0 ->    0
+	{"andx001", "0", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx002 and             0    1 ->    0
+	{"andx002", "0", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx003 and             1    0 ->    0
+	{"andx003", "1", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx004 and             1    1 ->    1
+	{"andx004", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx005 and          1100 1010 -> 1000
+	{"andx005", "1100", "1010", "1000", 0, 9, ToNearestAway, 999, -999, false},
+	// andx006 and          1111   10 ->   10
+	{"andx006", "1111", "10", "10", 0, 9, ToNearestAway, 999, -999, false},
+	// andx007 and          1111 1010 -> 1010
+	{"andx007", "1111", "1010", "1010", 0, 9, ToNearestAway, 999, -999, false},
+	// and at msd and msd-1
+	// andx010 and 000000000 000000000 ->           0
+	{"andx010", "000000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx011 and 000000000 100000000 ->           0
+	{"andx011", "000000000", "100000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx012 and 100000000 000000000 ->           0
+	{"andx012", "100000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx013 and 100000000 100000000 ->   100000000
+	{"andx013", "100000000", "100000000", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// andx014 and 000000000 000000000 ->           0
+	{"andx014", "000000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx015 and 000000000 010000000 ->           0
+	{"andx015", "000000000", "010000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx016 and 010000000 000000000 ->           0
+	{"andx016", "010000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx017 and 010000000 010000000 ->    10000000
+	{"andx017", "010000000", "010000000", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// Various lengths
+	//          123456789     123456789      123456789
+	// andx021 and 111111111     111111111  ->  111111111
+	{"andx021", "111111111", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx022 and 111111111111  111111111  ->  111111111
+	{"andx022", "111111111111", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx023 and 111111111111   11111111  ->   11111111
+	{"andx023", "111111111111", "11111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx024 and 111111111      11111111  ->   11111111
+	{"andx024", "111111111", "11111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx025 and 111111111       1111111  ->    1111111
+	{"andx025", "111111111", "1111111", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx026 and 111111111111     111111  ->     111111
+	{"andx026", "111111111111", "111111", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx027 and 111111111111      11111  ->      11111
+	{"andx027", "111111111111", "11111", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx028 and 111111111111       1111  ->       1111
+	{"andx028", "111111111111", "1111", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx029 and 111111111111        111  ->        111
+	{"andx029", "111111111111", "111", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx031 and 111111111111         11  ->         11
+	{"andx031", "111111111111", "11", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// andx032 and 111111111111          1  ->          1
+	{"andx032", "111111111111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx033 and 111111111111 1111111111  ->  111111111
+	{"andx033", "111111111111", "1111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx034 and 11111111111 11111111111  ->  111111111
+	{"andx034", "11111111111", "11111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx035 and 1111111111 111111111111  ->  111111111
+	{"andx035", "1111111111", "111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx036 and 111111111 1111111111111  ->  111111111
+	{"andx036", "111111111", "1111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx040 and 111111111  111111111111  ->  111111111
+	{"andx040", "111111111", "111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx041 and  11111111  111111111111  ->   11111111
+	{"andx041", "11111111", "111111111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx042 and  11111111     111111111  ->   11111111
+	{"andx042", "11111111", "111111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx043 and   1111111     111111111  ->    1111111
+	{"andx043", "1111111", "111111111", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx044 and    111111     111111111  ->     111111
+	{"andx044", "111111", "111111111", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx045 and     11111     111111111  ->      11111
+	{"andx045", "11111", "111111111", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx046 and      1111     111111111  ->       1111
+	{"andx046", "1111", "111111111", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx047 and       111     111111111  ->        111
+	{"andx047", "111", "111111111", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx048 and        11     111111111  ->         11
+	{"andx048", "11", "111111111", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// andx049 and         1     111111111  ->          1
+	{"andx049", "1", "111111111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx050 and 1111111111  1  ->  1
+	{"andx050", "1111111111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx051 and  111111111  1  ->  1
+	{"andx051", "111111111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx052 and   11111111  1  ->  1
+	{"andx052", "11111111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx053 and    1111111  1  ->  1
+	{"andx053", "1111111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx054 and     111111  1  ->  1
+	{"andx054", "111111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx055 and      11111  1  ->  1
+	{"andx055", "11111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx056 and       1111  1  ->  1
+	{"andx056", "1111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx057 and        111  1  ->  1
+	{"andx057", "111", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx058 and         11  1  ->  1
+	{"andx058", "11", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx059 and          1  1  ->  1
+	{"andx059", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx060 and 1111111111  0  ->  0
+	{"andx060", "1111111111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx061 and  111111111  0  ->  0
+	{"andx061", "111111111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx062 and   11111111  0  ->  0
+	{"andx062", "11111111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx063 and    1111111  0  ->  0
+	{"andx063", "1111111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx064 and     111111  0  ->  0
+	{"andx064", "111111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx065 and      11111  0  ->  0
+	{"andx065", "11111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx066 and       1111  0  ->  0
+	{"andx066", "1111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx067 and        111  0  ->  0
+	{"andx067", "111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx068 and         11  0  ->  0
+	{"andx068", "11", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx069 and          1  0  ->  0
+	{"andx069", "1", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx070 and 1  1111111111  ->  1
+	{"andx070", "1", "1111111111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx071 and 1   111111111  ->  1
+	{"andx071", "1", "111111111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx072 and 1    11111111  ->  1
+	{"andx072", "1", "11111111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx073 and 1     1111111  ->  1
+	{"andx073", "1", "1111111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx074 and 1      111111  ->  1
+	{"andx074", "1", "111111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx075 and 1       11111  ->  1
+	{"andx075", "1", "11111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx076 and 1        1111  ->  1
+	{"andx076", "1", "1111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx077 and 1         111  ->  1
+	{"andx077", "1", "111", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx078 and 1          11  ->  1
+	{"andx078", "1", "11", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx079 and 1           1  ->  1
+	{"andx079", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// andx080 and 0  1111111111  ->  0
+	{"andx080", "0", "1111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx081 and 0   111111111  ->  0
+	{"andx081", "0", "111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx082 and 0    11111111  ->  0
+	{"andx082", "0", "11111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx083 and 0     1111111  ->  0
+	{"andx083", "0", "1111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx084 and 0      111111  ->  0
+	{"andx084", "0", "111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx085 and 0       11111  ->  0
+	{"andx085", "0", "11111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx086 and 0        1111  ->  0
+	{"andx086", "0", "1111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx087 and 0         111  ->  0
+	{"andx087", "0", "111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx088 and 0          11  ->  0
+	{"andx088", "0", "11", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx089 and 0           1  ->  0
+	{"andx089", "0", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// andx090 and 011111111  111111111  ->   11111111
+	{"andx090", "011111111", "111111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx091 and 101111111  111111111  ->  101111111
+	{"andx091", "101111111", "111111111", "101111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx092 and 110111111  111111111  ->  110111111
+	{"andx092", "110111111", "111111111", "110111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx093 and 111011111  111111111  ->  111011111
+	{"andx093", "111011111", "111111111", "111011111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx094 and 111101111  111111111  ->  111101111
+	{"andx094", "111101111", "111111111", "111101111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx095 and 111110111  111111111  ->  111110111
+	{"andx095", "111110111", "111111111", "111110111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx096 and 111111011  111111111  ->  111111011
+	{"andx096", "111111011", "111111111", "111111011", 0, 9, ToNearestAway, 999, -999, false},
+	// andx097 and 111111101  111111111  ->  111111101
+	{"andx097", "111111101", "111111111", "111111101", 0, 9, ToNearestAway, 999, -999, false},
+	// andx098 and 111111110  111111111  ->  111111110
+	{"andx098", "111111110", "111111111", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// andx100 and 111111111  011111111  ->   11111111
+	{"andx100", "111111111", "011111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx101 and 111111111  101111111  ->  101111111
+	{"andx101", "111111111", "101111111", "101111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx102 and 111111111  110111111  ->  110111111
+	{"andx102", "111111111", "110111111", "110111111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx103 and 111111111  111011111  ->  111011111
+	{"andx103", "111111111", "111011111", "111011111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx104 and 111111111  111101111  ->  111101111
+	{"andx104", "111111111", "111101111", "111101111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx105 and 111111111  111110111  ->  111110111
+	{"andx105", "111111111", "111110111", "111110111", 0, 9, ToNearestAway, 999, -999, false},
+	// andx106 and 111111111  111111011  ->  111111011
+	{"andx106", "111111111", "111111011", "111111011", 0, 9, ToNearestAway, 999, -999, false},
+	// andx107 and 111111111  111111101  ->  111111101
+	{"andx107", "111111111", "111111101", "111111101", 0, 9, ToNearestAway, 999, -999, false},
+	// andx108 and 111111111  111111110  ->  111111110
+	{"andx108", "111111111", "111111110", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// non-0/1 should not be accepted, nor should signs
+	// andx220 and 111111112  111111111  ->  NaN Invalid_operation
+	{"andx220", "111111112", "111111111", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx221 and 333333333  333333333  ->  NaN Invalid_operation
+	{"andx221", "333333333", "333333333", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx222 and 555555555  555555555  ->  NaN Invalid_operation
+	{"andx222", "555555555", "555555555", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx223 and 777777777  777777777  ->  NaN Invalid_operation
+	{"andx223", "777777777", "777777777", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx224 and 999999999  999999999  ->  NaN Invalid_operation
+	{"andx224", "999999999", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx225 and 222222222  999999999  ->  NaN Invalid_operation
+	{"andx225", "222222222", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx226 and 444444444  999999999  ->  NaN Invalid_operation
+	{"andx226", "444444444", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx227 and 666666666  999999999  ->  NaN Invalid_operation
+	{"andx227", "666666666", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx228 and 888888888  999999999  ->  NaN Invalid_operation
+	{"andx228", "888888888", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx229 and 999999999  222222222  ->  NaN Invalid_operation
+	{"andx229", "999999999", "222222222", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx230 and 999999999  444444444  ->  NaN Invalid_operation
+	{"andx230", "999999999", "444444444", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx231 and 999999999  666666666  ->  NaN Invalid_operation
+	{"andx231", "999999999", "666666666", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx232 and 999999999  888888888  ->  NaN Invalid_operation
+	{"andx232", "999999999", "888888888", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// a few randoms
+	// andx240 and  567468689 -934981942 ->  NaN Invalid_operation
+	{"andx240", "567468689", "-934981942", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx241 and  567367689  934981942 ->  NaN Invalid_operation
+	{"andx241", "567367689", "934981942", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx242 and -631917772 -706014634 ->  NaN Invalid_operation
+	{"andx242", "-631917772", "-706014634", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx243 and -756253257  138579234 ->  NaN Invalid_operation
+	{"andx243", "-756253257", "138579234", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx244 and  835590149  567435400 ->  NaN Invalid_operation
+	{"andx244", "835590149", "567435400", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD
+	// andx250 and  200000000 100000000 ->  NaN Invalid_operation
+	{"andx250", "200000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx251 and  700000000 100000000 ->  NaN Invalid_operation
+	{"andx251", "700000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx252 and  800000000 100000000 ->  NaN Invalid_operation
+	{"andx252", "800000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx253 and  900000000 100000000 ->  NaN Invalid_operation
+	{"andx253", "900000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx254 and  200000000 000000000 ->  NaN Invalid_operation
+	{"andx254", "200000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx255 and  700000000 000000000 ->  NaN Invalid_operation
+	{"andx255", "700000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx256 and  800000000 000000000 ->  NaN Invalid_operation
+	{"andx256", "800000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx257 and  900000000 000000000 ->  NaN Invalid_operation
+	{"andx257", "900000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx258 and  100000000 200000000 ->  NaN Invalid_operation
+	{"andx258", "100000000", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx259 and  100000000 700000000 ->  NaN Invalid_operation
+	{"andx259", "100000000", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx260 and  100000000 800000000 ->  NaN Invalid_operation
+	{"andx260", "100000000", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx261 and  100000000 900000000 ->  NaN Invalid_operation
+	{"andx261", "100000000", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx262 and  000000000 200000000 ->  NaN Invalid_operation
+	{"andx262", "000000000", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx263 and  000000000 700000000 ->  NaN Invalid_operation
+	{"andx263", "000000000", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx264 and  000000000 800000000 ->  NaN Invalid_operation
+	{"andx264", "000000000", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx265 and  000000000 900000000 ->  NaN Invalid_operation
+	{"andx265", "000000000", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD-1
+	// andx270 and  020000000 100000000 ->  NaN Invalid_operation
+	{"andx270", "020000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx271 and  070100000 100000000 ->  NaN Invalid_operation
+	{"andx271", "070100000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx272 and  080010000 100000001 ->  NaN Invalid_operation
+	{"andx272", "080010000", "100000001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx273 and  090001000 100000010 ->  NaN Invalid_operation
+	{"andx273", "090001000", "100000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx274 and  100000100 020010100 ->  NaN Invalid_operation
+	{"andx274", "100000100", "020010100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx275 and  100000000 070001000 ->  NaN Invalid_operation
+	{"andx275", "100000000", "070001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx276 and  100000010 080010100 ->  NaN Invalid_operation
+	{"andx276", "100000010", "080010100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx277 and  100000000 090000010 ->  NaN Invalid_operation
+	{"andx277", "100000000", "090000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test LSD
+	// andx280 and  001000002 100000000 ->  NaN Invalid_operation
+	{"andx280", "001000002", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx281 and  000000007 100000000 ->  NaN Invalid_operation
+	{"andx281", "000000007", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx282 and  000000008 100000000 ->  NaN Invalid_operation
+	{"andx282", "000000008", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx283 and  000000009 100000000 ->  NaN Invalid_operation
+	{"andx283", "000000009", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx284 and  100000000 000100002 ->  NaN Invalid_operation
+	{"andx284", "100000000", "000100002", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx285 and  100100000 001000007 ->  NaN Invalid_operation
+	{"andx285", "100100000", "001000007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx286 and  100010000 010000008 ->  NaN Invalid_operation
+	{"andx286", "100010000", "010000008", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx287 and  100001000 100000009 ->  NaN Invalid_operation
+	{"andx287", "100001000", "100000009", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test Middie
+	// andx288 and  001020000 100000000 ->  NaN Invalid_operation
+	{"andx288", "001020000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx289 and  000070001 100000000 ->  NaN Invalid_operation
+	{"andx289", "000070001", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx290 and  000080000 100010000 ->  NaN Invalid_operation
+	{"andx290", "000080000", "100010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx291 and  000090000 100001000 ->  NaN Invalid_operation
+	{"andx291", "000090000", "100001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx292 and  100000010 000020100 ->  NaN Invalid_operation
+	{"andx292", "100000010", "000020100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx293 and  100100000 000070010 ->  NaN Invalid_operation
+	{"andx293", "100100000", "000070010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx294 and  100010100 000080001 ->  NaN Invalid_operation
+	{"andx294", "100010100", "000080001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx295 and  100001000 000090000 ->  NaN Invalid_operation
+	{"andx295", "100001000", "000090000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// signs
+	// andx296 and -100001000 -000000000 ->  NaN Invalid_operation
+	{"andx296", "-100001000", "-000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx297 and -100001000  000010000 ->  NaN Invalid_operation
+	{"andx297", "-100001000", "000010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx298 and  100001000 -000000000 ->  NaN Invalid_operation
+	{"andx298", "100001000", "-000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx299 and  100001000  000011000 ->  1000
+	{"andx299", "100001000", "000011000", "1000", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// andx331 and  2   9.99999999E+999     -> NaN Invalid_operation
+	{"andx331", "2", "9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx332 and  3   1E-999              -> NaN Invalid_operation
+	{"andx332", "3", "1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx333 and  4   1.00000000E-999     -> NaN Invalid_operation
+	{"andx333", "4", "1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx334 and  5   1E-1007             -> NaN Invalid_operation
+	{"andx334", "5", "1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx335 and  6   -1E-1007            -> NaN Invalid_operation
+	{"andx335", "6", "-1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx336 and  7   -1.00000000E-999    -> NaN Invalid_operation
+	{"andx336", "7", "-1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx337 and  8   -1E-999             -> NaN Invalid_operation
+	{"andx337", "8", "-1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx338 and  9   -9.99999999E+999    -> NaN Invalid_operation
+	{"andx338", "9", "-9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx341 and  9.99999999E+999     -18 -> NaN Invalid_operation
+	{"andx341", "9.99999999E+999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx342 and  1E-999               01 -> NaN Invalid_operation
+	{"andx342", "1E-999", "01", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx343 and  1.00000000E-999     -18 -> NaN Invalid_operation
+	{"andx343", "1.00000000E-999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx344 and  1E-1007              18 -> NaN Invalid_operation
+	{"andx344", "1E-1007", "18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx345 and  -1E-1007            -10 -> NaN Invalid_operation
+	{"andx345", "-1E-1007", "-10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx346 and  -1.00000000E-999     18 -> NaN Invalid_operation
+	{"andx346", "-1.00000000E-999", "18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx347 and  -1E-999              10 -> NaN Invalid_operation
+	{"andx347", "-1E-999", "10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx348 and  -9.99999999E+999    -18 -> NaN Invalid_operation
+	{"andx348", "-9.99999999E+999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// A few other non-integers
+	// andx361 and  1.0                  1  -> NaN Invalid_operation
+	{"andx361", "1.0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx362 and  1E+1                 1  -> NaN Invalid_operation
+	{"andx362", "1E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx363 and  0.0                  1  -> NaN Invalid_operation
+	{"andx363", "0.0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx364 and  0E+1                 1  -> NaN Invalid_operation
+	{"andx364", "0E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx365 and  9.9                  1  -> NaN Invalid_operation
+	{"andx365", "9.9", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx366 and  9E+1                 1  -> NaN Invalid_operation
+	{"andx366", "9E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx371 and  0 1.0                   -> NaN Invalid_operation
+	{"andx371", "0", "1.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx372 and  0 1E+1                  -> NaN Invalid_operation
+	{"andx372", "0", "1E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx373 and  0 0.0                   -> NaN Invalid_operation
+	{"andx373", "0", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx374 and  0 0E+1                  -> NaN Invalid_operation
+	{"andx374", "0", "0E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx375 and  0 9.9                   -> NaN Invalid_operation
+	{"andx375", "0", "9.9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx376 and  0 9E+1                  -> NaN Invalid_operation
+	{"andx376", "0", "9E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// All Specials are in error
+	// andx780 and -Inf  -Inf   -> NaN Invalid_operation
+	{"andx780", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx781 and -Inf  -1000  -> NaN Invalid_operation
+	{"andx781", "-Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx782 and -Inf  -1     -> NaN Invalid_operation
+	{"andx782", "-Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx783 and -Inf  -0     -> NaN Invalid_operation
+	{"andx783", "-Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx784 and -Inf   0     -> NaN Invalid_operation
+	{"andx784", "-Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx785 and -Inf   1     -> NaN Invalid_operation
+	{"andx785", "-Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx786 and -Inf   1000  -> NaN Invalid_operation
+	{"andx786", "-Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx787 and -1000 -Inf   -> NaN Invalid_operation
+	{"andx787", "-1000", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx788 and -Inf  -Inf   -> NaN Invalid_operation
+	{"andx788", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx789 and -1    -Inf   -> NaN Invalid_operation
+	{"andx789", "-1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx790 and -0    -Inf   -> NaN Invalid_operation
+	{"andx790", "-0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx791 and  0    -Inf   -> NaN Invalid_operation
+	{"andx791", "0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx792 and  1    -Inf   -> NaN Invalid_operation
+	{"andx792", "1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx793 and  1000 -Inf   -> NaN Invalid_operation
+	{"andx793", "1000", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx794 and  Inf  -Inf   -> NaN Invalid_operation
+	{"andx794", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx800 and  Inf  -Inf   -> NaN Invalid_operation
+	{"andx800", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx801 and  Inf  -1000  -> NaN Invalid_operation
+	{"andx801", "Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx802 and  Inf  -1     -> NaN Invalid_operation
+	{"andx802", "Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx803 and  Inf  -0     -> NaN Invalid_operation
+	{"andx803", "Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx804 and  Inf   0     -> NaN Invalid_operation
+	{"andx804", "Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx805 and  Inf   1     -> NaN Invalid_operation
+	{"andx805", "Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx806 and  Inf   1000  -> NaN Invalid_operation
+	{"andx806", "Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx807 and  Inf   Inf   -> NaN Invalid_operation
+	{"andx807", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx808 and -1000  Inf   -> NaN Invalid_operation
+	{"andx808", "-1000", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx809 and -Inf   Inf   -> NaN Invalid_operation
+	{"andx809", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx810 and -1     Inf   -> NaN Invalid_operation
+	{"andx810", "-1", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx811 and -0     Inf   -> NaN Invalid_operation
+	{"andx811", "-0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx812 and  0     Inf   -> NaN Invalid_operation
+	{"andx812", "0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx813 and  1     Inf   -> NaN Invalid_operation
+	{"andx813", "1", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx814 and  1000  Inf   -> NaN Invalid_operation
+	{"andx814", "1000", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx815 and  Inf   Inf   -> NaN Invalid_operation
+	{"andx815", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx821 and  NaN -Inf    -> NaN Invalid_operation
+	{"andx821", "NaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx822 and  NaN -1000   -> NaN Invalid_operation
+	{"andx822", "NaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx823 and  NaN -1      -> NaN Invalid_operation
+	{"andx823", "NaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx824 and  NaN -0      -> NaN Invalid_operation
+	{"andx824", "NaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx825 and  NaN  0      -> NaN Invalid_operation
+	{"andx825", "NaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx826 and  NaN  1      -> NaN Invalid_operation
+	{"andx826", "NaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx827 and  NaN  1000   -> NaN Invalid_operation
+	{"andx827", "NaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx828 and  NaN  Inf    -> NaN Invalid_operation
+	{"andx828", "NaN", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx829 and  NaN  NaN    -> NaN Invalid_operation
+	{"andx829", "NaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx830 and -Inf  NaN    -> NaN Invalid_operation
+	{"andx830", "-Inf", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx831 and -1000 NaN    -> NaN Invalid_operation
+	{"andx831", "-1000", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx832 and -1    NaN    -> NaN Invalid_operation
+	{"andx832", "-1", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx833 and -0    NaN    -> NaN Invalid_operation
+	{"andx833", "-0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx834 and  0    NaN    -> NaN Invalid_operation
+	{"andx834", "0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx835 and  1    NaN    -> NaN Invalid_operation
+	{"andx835", "1", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx836 and  1000 NaN    -> NaN Invalid_operation
+	{"andx836", "1000", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx837 and  Inf  NaN    -> NaN Invalid_operation
+	{"andx837", "Inf", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx841 and  sNaN -Inf   ->  NaN  Invalid_operation
+	{"andx841", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx842 and  sNaN -1000  ->  NaN  Invalid_operation
+	{"andx842", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx843 and  sNaN -1     ->  NaN  Invalid_operation
+	{"andx843", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx844 and  sNaN -0     ->  NaN  Invalid_operation
+	{"andx844", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx845 and  sNaN  0     ->  NaN  Invalid_operation
+	{"andx845", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx846 and  sNaN  1     ->  NaN  Invalid_operation
+	{"andx846", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx847 and  sNaN  1000  ->  NaN  Invalid_operation
+	{"andx847", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx848 and  sNaN  NaN   ->  NaN  Invalid_operation
+	{"andx848", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx849 and  sNaN sNaN   ->  NaN  Invalid_operation
+	{"andx849", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx850 and  NaN  sNaN   ->  NaN  Invalid_operation
+	{"andx850", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx851 and -Inf  sNaN   ->  NaN  Invalid_operation
+	{"andx851", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx852 and -1000 sNaN   ->  NaN  Invalid_operation
+	{"andx852", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx853 and -1    sNaN   ->  NaN  Invalid_operation
+	{"andx853", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx854 and -0    sNaN   ->  NaN  Invalid_operation
+	{"andx854", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx855 and  0    sNaN   ->  NaN  Invalid_operation
+	{"andx855", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx856 and  1    sNaN   ->  NaN  Invalid_operation
+	{"andx856", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx857 and  1000 sNaN   ->  NaN  Invalid_operation
+	{"andx857", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx858 and  Inf  sNaN   ->  NaN  Invalid_operation
+	{"andx858", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx859 and  NaN  sNaN   ->  NaN  Invalid_operation
+	{"andx859", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// andx861 and  NaN1   -Inf    -> NaN Invalid_operation
+	{"andx861", "NaN1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx862 and +NaN2   -1000   -> NaN Invalid_operation
+	{"andx862", "+NaN2", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx863 and  NaN3    1000   -> NaN Invalid_operation
+	{"andx863", "NaN3", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx864 and  NaN4    Inf    -> NaN Invalid_operation
+	{"andx864", "NaN4", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx865 and  NaN5   +NaN6   -> NaN Invalid_operation
+	{"andx865", "NaN5", "+NaN6", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx866 and -Inf     NaN7   -> NaN Invalid_operation
+	{"andx866", "-Inf", "NaN7", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx867 and -1000    NaN8   -> NaN Invalid_operation
+	{"andx867", "-1000", "NaN8", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx868 and  1000    NaN9   -> NaN Invalid_operation
+	{"andx868", "1000", "NaN9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx869 and  Inf    +NaN10  -> NaN Invalid_operation
+	{"andx869", "Inf", "+NaN10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx871 and  sNaN11  -Inf   -> NaN Invalid_operation
+	{"andx871", "sNaN11", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx872 and  sNaN12  -1000  -> NaN Invalid_operation
+	{"andx872", "sNaN12", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx873 and  sNaN13   1000  -> NaN Invalid_operation
+	{"andx873", "sNaN13", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx874 and  sNaN14   NaN17 -> NaN Invalid_operation
+	{"andx874", "sNaN14", "NaN17", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx875 and  sNaN15  sNaN18 -> NaN Invalid_operation
+	{"andx875", "sNaN15", "sNaN18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx876 and  NaN16   sNaN19 -> NaN Invalid_operation
+	{"andx876", "NaN16", "sNaN19", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx877 and -Inf    +sNaN20 -> NaN Invalid_operation
+	{"andx877", "-Inf", "+sNaN20", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx878 and -1000    sNaN21 -> NaN Invalid_operation
+	{"andx878", "-1000", "sNaN21", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx879 and  1000    sNaN22 -> NaN Invalid_operation
+	{"andx879", "1000", "sNaN22", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx880 and  Inf     sNaN23 -> NaN Invalid_operation
+	{"andx880", "Inf", "sNaN23", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx881 and +NaN25  +sNaN24 -> NaN Invalid_operation
+	{"andx881", "+NaN25", "+sNaN24", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx882 and -NaN26    NaN28 -> NaN Invalid_operation
+	{"andx882", "-NaN26", "NaN28", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx883 and -sNaN27  sNaN29 -> NaN Invalid_operation
+	{"andx883", "-sNaN27", "sNaN29", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx884 and  1000    -NaN30 -> NaN Invalid_operation
+	{"andx884", "1000", "-NaN30", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// andx885 and  1000   -sNaN31 -> NaN Invalid_operation
+	{"andx885", "1000", "-sNaN31", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+}
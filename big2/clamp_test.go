@@ -0,0 +1,297 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var applyTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// This set of tests uses the same limits as the 8-byte concrete
+	// representation, but applies clamping without using format-specific
+	// conversions.
+	// extended: 1
+	// precision: 16
+	// rounding: half_even
+	// maxexponent: 384
+	// minexponent: -383
+	// clamp: 1
+	// General testcases
+	// Normality
+	// clam010 apply   1234567890123456   ->  1234567890123456
+	{"clam010", "1234567890123456", "1234567890123456", 0, 16, ToNearestEven, 384, -383, true},
+	// clam011 apply   1234567890123456.0 ->  1234567890123456 Rounded
+	{"clam011", "1234567890123456.0", "1234567890123456", Rounded, 16, ToNearestEven, 384, -383, true},
+	// clam012 apply   1234567890123456.1 ->  1234567890123456 Rounded Inexact
+	{"clam012", "1234567890123456.1", "1234567890123456", Rounded | Inexact, 16, ToNearestEven, 384, -383, true},
+	// clam013 apply  -1234567890123456   -> -1234567890123456
+	{"clam013", "-1234567890123456", "-1234567890123456", 0, 16, ToNearestEven, 384, -383, true},
+	// clam014 apply  -1234567890123456.0 -> -1234567890123456 Rounded
+	{"clam014", "-1234567890123456.0", "-1234567890123456", Rounded, 16, ToNearestEven, 384, -383, true},
+	// clam015 apply  -1234567890123456.1 -> -1234567890123456 Rounded Inexact
+	{"clam015", "-1234567890123456.1", "-1234567890123456", Rounded | Inexact, 16, ToNearestEven, 384, -383, true},
+	// Nmax and similar
+	// clam022 apply   9.999999999999999E+384  -> 9.999999999999999E+384
+	{"clam022", "9.999999999999999E+384", "9.999999999999999E+384", 0, 16, ToNearestEven, 384, -383, true},
+	// clam024 apply   1.234567890123456E+384  -> 1.234567890123456E+384
+	{"clam024", "1.234567890123456E+384", "1.234567890123456E+384", 0, 16, ToNearestEven, 384, -383, true},
+	// fold-downs (more below)
+	// clam030 apply   1.23E+384               -> 1.230000000000000E+384 Clamped
+	{"clam030", "1.23E+384", "1.230000000000000E+384", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam032 apply   1E+384                  -> 1.000000000000000E+384 Clamped
+	{"clam032", "1E+384", "1.000000000000000E+384", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam051 apply   12345                   -> 12345
+	{"clam051", "12345", "12345", 0, 16, ToNearestEven, 384, -383, true},
+	// clam053 apply   1234                    -> 1234
+	{"clam053", "1234", "1234", 0, 16, ToNearestEven, 384, -383, true},
+	// clam055 apply   123                     -> 123
+	{"clam055", "123", "123", 0, 16, ToNearestEven, 384, -383, true},
+	// clam057 apply   12                      -> 12
+	{"clam057", "12", "12", 0, 16, ToNearestEven, 384, -383, true},
+	// clam059 apply   1                       -> 1
+	{"clam059", "1", "1", 0, 16, ToNearestEven, 384, -383, true},
+	// clam061 apply   1.23                    -> 1.23
+	{"clam061", "1.23", "1.23", 0, 16, ToNearestEven, 384, -383, true},
+	// clam063 apply   123.45                  -> 123.45
+	{"clam063", "123.45", "123.45", 0, 16, ToNearestEven, 384, -383, true},
+	// Nmin and below
+	// clam071 apply   1E-383                  -> 1E-383
+	{"clam071", "1E-383", "1E-383", 0, 16, ToNearestEven, 384, -383, true},
+	// clam073 apply   1.000000000000000E-383  -> 1.000000000000000E-383
+	{"clam073", "1.000000000000000E-383", "1.000000000000000E-383", 0, 16, ToNearestEven, 384, -383, true},
+	// clam075 apply   1.000000000000001E-383  -> 1.000000000000001E-383
+	{"clam075", "1.000000000000001E-383", "1.000000000000001E-383", 0, 16, ToNearestEven, 384, -383, true},
+	// clam077 apply   0.100000000000000E-383  -> 1.00000000000000E-384  Subnormal
+	{"clam077", "0.100000000000000E-383", "1.00000000000000E-384", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam079 apply   0.000000000000010E-383  -> 1.0E-397               Subnormal
+	{"clam079", "0.000000000000010E-383", "1.0E-397", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam081 apply   0.00000000000001E-383   -> 1E-397                 Subnormal
+	{"clam081", "0.00000000000001E-383", "1E-397", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam083 apply   0.000000000000001E-383  -> 1E-398                 Subnormal
+	{"clam083", "0.000000000000001E-383", "1E-398", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// underflows
+	// SKIP (encoding not supported): clam090 apply   1e-398                  -> #0000000000000001  Subnormal
+	// SKIP (encoding not supported): clam091 apply   1.9e-398                -> #0000000000000002  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam092 apply   1.1e-398                -> #0000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam093 apply   1.00000000001e-398      -> #0000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam094 apply   1.00000000000001e-398   -> #0000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam095 apply   1.000000000000001e-398  -> #0000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam096 apply   0.1e-398                -> #0000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// SKIP (encoding not supported): clam097 apply   0.00000000001e-398      -> #0000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// SKIP (encoding not supported): clam098 apply   0.00000000000001e-398   -> #0000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// SKIP (encoding not supported): clam099 apply   0.000000000000001e-398  -> #0000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// Same again, negatives
+	// Nmax and similar
+	// clam122 apply  -9.999999999999999E+384  -> -9.999999999999999E+384
+	{"clam122", "-9.999999999999999E+384", "-9.999999999999999E+384", 0, 16, ToNearestEven, 384, -383, true},
+	// clam124 apply  -1.234567890123456E+384  -> -1.234567890123456E+384
+	{"clam124", "-1.234567890123456E+384", "-1.234567890123456E+384", 0, 16, ToNearestEven, 384, -383, true},
+	// fold-downs (more below)
+	// clam130 apply  -1.23E+384               -> -1.230000000000000E+384 Clamped
+	{"clam130", "-1.23E+384", "-1.230000000000000E+384", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam132 apply  -1E+384                  -> -1.000000000000000E+384 Clamped
+	{"clam132", "-1E+384", "-1.000000000000000E+384", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam151 apply  -12345                   -> -12345
+	{"clam151", "-12345", "-12345", 0, 16, ToNearestEven, 384, -383, true},
+	// clam153 apply  -1234                    -> -1234
+	{"clam153", "-1234", "-1234", 0, 16, ToNearestEven, 384, -383, true},
+	// clam155 apply  -123                     -> -123
+	{"clam155", "-123", "-123", 0, 16, ToNearestEven, 384, -383, true},
+	// clam157 apply  -12                      -> -12
+	{"clam157", "-12", "-12", 0, 16, ToNearestEven, 384, -383, true},
+	// clam159 apply  -1                       -> -1
+	{"clam159", "-1", "-1", 0, 16, ToNearestEven, 384, -383, true},
+	// clam161 apply  -1.23                    -> -1.23
+	{"clam161", "-1.23", "-1.23", 0, 16, ToNearestEven, 384, -383, true},
+	// clam163 apply  -123.45                  -> -123.45
+	{"clam163", "-123.45", "-123.45", 0, 16, ToNearestEven, 384, -383, true},
+	// Nmin and below
+	// clam171 apply  -1E-383                  -> -1E-383
+	{"clam171", "-1E-383", "-1E-383", 0, 16, ToNearestEven, 384, -383, true},
+	// clam173 apply  -1.000000000000000E-383  -> -1.000000000000000E-383
+	{"clam173", "-1.000000000000000E-383", "-1.000000000000000E-383", 0, 16, ToNearestEven, 384, -383, true},
+	// clam175 apply  -1.000000000000001E-383  -> -1.000000000000001E-383
+	{"clam175", "-1.000000000000001E-383", "-1.000000000000001E-383", 0, 16, ToNearestEven, 384, -383, true},
+	// clam177 apply  -0.100000000000000E-383  -> -1.00000000000000E-384  Subnormal
+	{"clam177", "-0.100000000000000E-383", "-1.00000000000000E-384", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam179 apply  -0.000000000000010E-383  -> -1.0E-397               Subnormal
+	{"clam179", "-0.000000000000010E-383", "-1.0E-397", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam181 apply  -0.00000000000001E-383   -> -1E-397                 Subnormal
+	{"clam181", "-0.00000000000001E-383", "-1E-397", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam183 apply  -0.000000000000001E-383  -> -1E-398                 Subnormal
+	{"clam183", "-0.000000000000001E-383", "-1E-398", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// underflows
+	// SKIP (encoding not supported): clam189 apply   -1e-398                 -> #8000000000000001  Subnormal
+	// SKIP (encoding not supported): clam190 apply   -1.0e-398               -> #8000000000000001  Subnormal Rounded
+	// SKIP (encoding not supported): clam191 apply   -1.9e-398               -> #8000000000000002  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam192 apply   -1.1e-398               -> #8000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam193 apply   -1.00000000001e-398     -> #8000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam194 apply   -1.00000000000001e-398  -> #8000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam195 apply   -1.000000000000001e-398 -> #8000000000000001  Subnormal Underflow Inexact Rounded
+	// SKIP (encoding not supported): clam196 apply   -0.1e-398               -> #8000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// SKIP (encoding not supported): clam197 apply   -0.00000000001e-398     -> #8000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// SKIP (encoding not supported): clam198 apply   -0.00000000000001e-398  -> #8000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// SKIP (encoding not supported): clam199 apply   -0.000000000000001e-398 -> #8000000000000000  Subnormal Underflow Inexact Rounded Clamped
+	// zeros
+	// clam401 apply   0E-500                  -> 0E-398  Clamped
+	{"clam401", "0E-500", "0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam402 apply   0E-400                  -> 0E-398  Clamped
+	{"clam402", "0E-400", "0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam403 apply   0E-398                  -> 0E-398
+	{"clam403", "0E-398", "0E-398", 0, 16, ToNearestEven, 384, -383, true},
+	// clam404 apply   0.000000000000000E-383  -> 0E-398
+	{"clam404", "0.000000000000000E-383", "0E-398", 0, 16, ToNearestEven, 384, -383, true},
+	// clam405 apply   0E-2                    ->  0.00
+	{"clam405", "0E-2", "0.00", 0, 16, ToNearestEven, 384, -383, true},
+	// clam406 apply   0                       -> 0
+	{"clam406", "0", "0", 0, 16, ToNearestEven, 384, -383, true},
+	// clam407 apply   0E+3                    -> 0E+3
+	{"clam407", "0E+3", "0E+3", 0, 16, ToNearestEven, 384, -383, true},
+	// clam408 apply   0E+369                  -> 0E+369
+	{"clam408", "0E+369", "0E+369", 0, 16, ToNearestEven, 384, -383, true},
+	// clamped zeros...
+	// clam410 apply   0E+370                  -> 0E+369 Clamped
+	{"clam410", "0E+370", "0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam411 apply   0E+384                  -> 0E+369 Clamped
+	{"clam411", "0E+384", "0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam412 apply   0E+400                  -> 0E+369 Clamped
+	{"clam412", "0E+400", "0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam413 apply   0E+500                  -> 0E+369 Clamped
+	{"clam413", "0E+500", "0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// negative zeros
+	// clam420 apply   -0E-500                 -> -0E-398 Clamped
+	{"clam420", "-0E-500", "-0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam421 apply   -0E-400                 -> -0E-398 Clamped
+	{"clam421", "-0E-400", "-0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam422 apply   -0E-398                 -> -0E-398
+	{"clam422", "-0E-398", "-0E-398", 0, 16, ToNearestEven, 384, -383, true},
+	// clam423 apply   -0.000000000000000E-383 -> -0E-398
+	{"clam423", "-0.000000000000000E-383", "-0E-398", 0, 16, ToNearestEven, 384, -383, true},
+	// clam424 apply   -0E-2                   -> -0.00
+	{"clam424", "-0E-2", "-0.00", 0, 16, ToNearestEven, 384, -383, true},
+	// clam425 apply   -0                      -> -0
+	{"clam425", "-0", "-0", 0, 16, ToNearestEven, 384, -383, true},
+	// clam426 apply   -0E+3                   -> -0E+3
+	{"clam426", "-0E+3", "-0E+3", 0, 16, ToNearestEven, 384, -383, true},
+	// clam427 apply   -0E+369                 -> -0E+369
+	{"clam427", "-0E+369", "-0E+369", 0, 16, ToNearestEven, 384, -383, true},
+	// clamped zeros...
+	// clam431 apply   -0E+370                 -> -0E+369 Clamped
+	{"clam431", "-0E+370", "-0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam432 apply   -0E+384                 -> -0E+369 Clamped
+	{"clam432", "-0E+384", "-0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam433 apply   -0E+400                 -> -0E+369 Clamped
+	{"clam433", "-0E+400", "-0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam434 apply   -0E+500                 -> -0E+369 Clamped
+	{"clam434", "-0E+500", "-0E+369", Clamped, 16, ToNearestEven, 384, -383, true},
+	// fold-down full sequence
+	// clam601 apply   1E+384                  -> 1.000000000000000E+384 Clamped
+	{"clam601", "1E+384", "1.000000000000000E+384", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam603 apply   1E+383                  -> 1.00000000000000E+383 Clamped
+	{"clam603", "1E+383", "1.00000000000000E+383", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam605 apply   1E+382                  -> 1.0000000000000E+382 Clamped
+	{"clam605", "1E+382", "1.0000000000000E+382", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam607 apply   1E+381                  -> 1.000000000000E+381 Clamped
+	{"clam607", "1E+381", "1.000000000000E+381", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam609 apply   1E+380                  -> 1.00000000000E+380 Clamped
+	{"clam609", "1E+380", "1.00000000000E+380", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam611 apply   1E+379                  -> 1.0000000000E+379 Clamped
+	{"clam611", "1E+379", "1.0000000000E+379", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam613 apply   1E+378                  -> 1.000000000E+378 Clamped
+	{"clam613", "1E+378", "1.000000000E+378", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam615 apply   1E+377                  -> 1.00000000E+377 Clamped
+	{"clam615", "1E+377", "1.00000000E+377", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam617 apply   1E+376                  -> 1.0000000E+376 Clamped
+	{"clam617", "1E+376", "1.0000000E+376", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam619 apply   1E+375                  -> 1.000000E+375 Clamped
+	{"clam619", "1E+375", "1.000000E+375", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam621 apply   1E+374                  -> 1.00000E+374 Clamped
+	{"clam621", "1E+374", "1.00000E+374", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam623 apply   1E+373                  -> 1.0000E+373 Clamped
+	{"clam623", "1E+373", "1.0000E+373", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam625 apply   1E+372                  -> 1.000E+372 Clamped
+	{"clam625", "1E+372", "1.000E+372", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam627 apply   1E+371                  -> 1.00E+371 Clamped
+	{"clam627", "1E+371", "1.00E+371", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam629 apply   1E+370                  -> 1.0E+370 Clamped
+	{"clam629", "1E+370", "1.0E+370", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam631 apply   1E+369                  -> 1E+369
+	{"clam631", "1E+369", "1E+369", 0, 16, ToNearestEven, 384, -383, true},
+	// clam633 apply   1E+368                  -> 1E+368
+	{"clam633", "1E+368", "1E+368", 0, 16, ToNearestEven, 384, -383, true},
+	// same with 9s
+	// clam641 apply   9E+384                  -> 9.000000000000000E+384 Clamped
+	{"clam641", "9E+384", "9.000000000000000E+384", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam643 apply   9E+383                  -> 9.00000000000000E+383 Clamped
+	{"clam643", "9E+383", "9.00000000000000E+383", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam645 apply   9E+382                  -> 9.0000000000000E+382 Clamped
+	{"clam645", "9E+382", "9.0000000000000E+382", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam647 apply   9E+381                  -> 9.000000000000E+381 Clamped
+	{"clam647", "9E+381", "9.000000000000E+381", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam649 apply   9E+380                  -> 9.00000000000E+380 Clamped
+	{"clam649", "9E+380", "9.00000000000E+380", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam651 apply   9E+379                  -> 9.0000000000E+379 Clamped
+	{"clam651", "9E+379", "9.0000000000E+379", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam653 apply   9E+378                  -> 9.000000000E+378 Clamped
+	{"clam653", "9E+378", "9.000000000E+378", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam655 apply   9E+377                  -> 9.00000000E+377 Clamped
+	{"clam655", "9E+377", "9.00000000E+377", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam657 apply   9E+376                  -> 9.0000000E+376 Clamped
+	{"clam657", "9E+376", "9.0000000E+376", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam659 apply   9E+375                  -> 9.000000E+375 Clamped
+	{"clam659", "9E+375", "9.000000E+375", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam661 apply   9E+374                  -> 9.00000E+374 Clamped
+	{"clam661", "9E+374", "9.00000E+374", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam663 apply   9E+373                  -> 9.0000E+373 Clamped
+	{"clam663", "9E+373", "9.0000E+373", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam665 apply   9E+372                  -> 9.000E+372 Clamped
+	{"clam665", "9E+372", "9.000E+372", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam667 apply   9E+371                  -> 9.00E+371 Clamped
+	{"clam667", "9E+371", "9.00E+371", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam669 apply   9E+370                  -> 9.0E+370 Clamped
+	{"clam669", "9E+370", "9.0E+370", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam671 apply   9E+369                  -> 9E+369
+	{"clam671", "9E+369", "9E+369", 0, 16, ToNearestEven, 384, -383, true},
+	// clam673 apply   9E+368                  -> 9E+368
+	{"clam673", "9E+368", "9E+368", 0, 16, ToNearestEven, 384, -383, true},
+	// subnormals clamped to 0-Etiny
+	// precision: 16
+	// maxexponent: 384
+	// minexponent: -383
+	// clam681 apply 7E-398     -> 7E-398 Subnormal
+	{"clam681", "7E-398", "7E-398", Subnormal, 16, ToNearestEven, 384, -383, true},
+	// clam682 apply 0E-398     -> 0E-398
+	{"clam682", "0E-398", "0E-398", 0, 16, ToNearestEven, 384, -383, true},
+	// clam683 apply 7E-399     -> 1E-398 Subnormal Underflow Inexact Rounded
+	{"clam683", "7E-399", "1E-398", Subnormal | Underflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, true},
+	// clam684 apply 4E-399     -> 0E-398 Clamped Subnormal Underflow Inexact Rounded
+	{"clam684", "4E-399", "0E-398", Clamped | Subnormal | Underflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, true},
+	// clam685 apply 7E-400     -> 0E-398 Clamped Subnormal Underflow Inexact Rounded
+	{"clam685", "7E-400", "0E-398", Clamped | Subnormal | Underflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, true},
+	// clam686 apply 7E-401     -> 0E-398 Clamped Subnormal Underflow Inexact Rounded
+	{"clam686", "7E-401", "0E-398", Clamped | Subnormal | Underflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, true},
+	// clam687 apply 0E-399     -> 0E-398 Clamped
+	{"clam687", "0E-399", "0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam688 apply 0E-400     -> 0E-398 Clamped
+	{"clam688", "0E-400", "0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// clam689 apply 0E-401     -> 0E-398 Clamped
+	{"clam689", "0E-401", "0E-398", Clamped, 16, ToNearestEven, 384, -383, true},
+	// example from documentation
+	// precision: 7
+	// rounding: half_even
+	// maxexponent: +96
+	// minexponent: -95
+	// clamp: 0
+	// clam700 apply   1.23E+96                -> 1.23E+96
+	{"clam700", "1.23E+96", "1.23E+96", 0, 7, ToNearestEven, 96, -95, false},
+	// clamp: 1
+	// clam701 apply   1.23E+96                -> 1.230000E+96 Clamped
+	{"clam701", "1.23E+96", "1.230000E+96", Clamped, 7, ToNearestEven, 96, -95, true},
+}
@@ -0,0 +1,48 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package big2
+
+import "strconv"
+
+// A Class is one of the ten classes of Decimal values defined by the
+// General Decimal Arithmetic specification (see Decimal.Class).
+type Class byte
+
+// Classes, in the order of the total ordering of values (see CmpTotal)
+// except for the NaNs.
+const (
+	SignalingNaN      Class = iota // sNaN
+	QuietNaN                       // NaN
+	NegativeInfinity               // -Inf
+	NegativeNormal                 // finite negative number that is not subnormal
+	NegativeSubnormal              // negative number with an adjusted exponent less than Emin
+	NegativeZero                   // -0
+	PositiveZero                   // +0
+	PositiveSubnormal              // positive number with an adjusted exponent less than Emin
+	PositiveNormal                 // finite positive number that is not subnormal
+	PositiveInfinity               // +Inf
+)
+
+var classNames = [...]string{
+	"sNaN",
+	"NaN",
+	"-Infinity",
+	"-Normal",
+	"-Subnormal",
+	"-Zero",
+	"+Zero",
+	"+Subnormal",
+	"+Normal",
+	"+Infinity",
+}
+
+// String returns the name of c as used by the specification, such as
+// "+Normal" or "-Zero".
+func (c Class) String() string {
+	if int(c) < len(classNames) {
+		return classNames[c]
+	}
+	return "Class(" + strconv.Itoa(int(c)) + ")"
+}
@@ -0,0 +1,193 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var classTests = []struct {
+	id   string
+	in   string
+	out  string
+	emin int
+}{
+	// version: 2.59
+	// [New 2006.11.27]
+	// precision: 9
+	// maxexponent: 999
+	// minexponent: -999
+	// extended: 1
+	// clamp: 1
+	// rounding: half_even
+	// clasx001  class    0                        -> +Zero
+	{"clasx001", "0", "+Zero", -999},
+	// clasx002  class    0.00                     -> +Zero
+	{"clasx002", "0.00", "+Zero", -999},
+	// clasx003  class    0E+5                     -> +Zero
+	{"clasx003", "0E+5", "+Zero", -999},
+	// clasx004  class    1E-1007                  -> +Subnormal
+	{"clasx004", "1E-1007", "+Subnormal", -999},
+	// clasx005  class  0.1E-999                   -> +Subnormal
+	{"clasx005", "0.1E-999", "+Subnormal", -999},
+	// clasx006  class  0.99999999E-999            -> +Subnormal
+	{"clasx006", "0.99999999E-999", "+Subnormal", -999},
+	// clasx007  class  1.00000000E-999            -> +Normal
+	{"clasx007", "1.00000000E-999", "+Normal", -999},
+	// clasx008  class   1E-999                    -> +Normal
+	{"clasx008", "1E-999", "+Normal", -999},
+	// clasx009  class   1E-100                    -> +Normal
+	{"clasx009", "1E-100", "+Normal", -999},
+	// clasx010  class   1E-10                     -> +Normal
+	{"clasx010", "1E-10", "+Normal", -999},
+	// clasx012  class   1E-1                      -> +Normal
+	{"clasx012", "1E-1", "+Normal", -999},
+	// clasx013  class   1                         -> +Normal
+	{"clasx013", "1", "+Normal", -999},
+	// clasx014  class   2.50                      -> +Normal
+	{"clasx014", "2.50", "+Normal", -999},
+	// clasx015  class   100.100                   -> +Normal
+	{"clasx015", "100.100", "+Normal", -999},
+	// clasx016  class   1E+30                     -> +Normal
+	{"clasx016", "1E+30", "+Normal", -999},
+	// clasx017  class   1E+999                    -> +Normal
+	{"clasx017", "1E+999", "+Normal", -999},
+	// clasx018  class   9.99999999E+999           -> +Normal
+	{"clasx018", "9.99999999E+999", "+Normal", -999},
+	// clasx019  class   Inf                       -> +Infinity
+	{"clasx019", "Inf", "+Infinity", -999},
+	// clasx021  class   -0                        -> -Zero
+	{"clasx021", "-0", "-Zero", -999},
+	// clasx022  class   -0.00                     -> -Zero
+	{"clasx022", "-0.00", "-Zero", -999},
+	// clasx023  class   -0E+5                     -> -Zero
+	{"clasx023", "-0E+5", "-Zero", -999},
+	// clasx024  class   -1E-1007                  -> -Subnormal
+	{"clasx024", "-1E-1007", "-Subnormal", -999},
+	// clasx025  class  -0.1E-999                  -> -Subnormal
+	{"clasx025", "-0.1E-999", "-Subnormal", -999},
+	// clasx026  class  -0.99999999E-999           -> -Subnormal
+	{"clasx026", "-0.99999999E-999", "-Subnormal", -999},
+	// clasx027  class  -1.00000000E-999           -> -Normal
+	{"clasx027", "-1.00000000E-999", "-Normal", -999},
+	// clasx028  class  -1E-999                    -> -Normal
+	{"clasx028", "-1E-999", "-Normal", -999},
+	// clasx029  class  -1E-100                    -> -Normal
+	{"clasx029", "-1E-100", "-Normal", -999},
+	// clasx030  class  -1E-10                     -> -Normal
+	{"clasx030", "-1E-10", "-Normal", -999},
+	// clasx032  class  -1E-1                      -> -Normal
+	{"clasx032", "-1E-1", "-Normal", -999},
+	// clasx033  class  -1                         -> -Normal
+	{"clasx033", "-1", "-Normal", -999},
+	// clasx034  class  -2.50                      -> -Normal
+	{"clasx034", "-2.50", "-Normal", -999},
+	// clasx035  class  -100.100                   -> -Normal
+	{"clasx035", "-100.100", "-Normal", -999},
+	// clasx036  class  -1E+30                     -> -Normal
+	{"clasx036", "-1E+30", "-Normal", -999},
+	// clasx037  class  -1E+999                    -> -Normal
+	{"clasx037", "-1E+999", "-Normal", -999},
+	// clasx038  class  -9.99999999E+999           -> -Normal
+	{"clasx038", "-9.99999999E+999", "-Normal", -999},
+	// clasx039  class  -Inf                       -> -Infinity
+	{"clasx039", "-Inf", "-Infinity", -999},
+	// clasx041  class   NaN                       -> NaN
+	{"clasx041", "NaN", "NaN", -999},
+	// clasx042  class  -NaN                       -> NaN
+	{"clasx042", "-NaN", "NaN", -999},
+	// clasx043  class  +NaN12345                  -> NaN
+	{"clasx043", "+NaN12345", "NaN", -999},
+	// clasx044  class   sNaN                      -> sNaN
+	{"clasx044", "sNaN", "sNaN", -999},
+	// clasx045  class  -sNaN                      -> sNaN
+	{"clasx045", "-sNaN", "sNaN", -999},
+	// clasx046  class  +sNaN12345                 -> sNaN
+	{"clasx046", "+sNaN12345", "sNaN", -999},
+	// decimal64 bounds
+	// precision: 16
+	// maxexponent: 384
+	// minexponent: -383
+	// clamp: 1
+	// rounding: half_even
+	// clasx201  class    0                        -> +Zero
+	{"clasx201", "0", "+Zero", -383},
+	// clasx202  class    0.00                     -> +Zero
+	{"clasx202", "0.00", "+Zero", -383},
+	// clasx203  class    0E+5                     -> +Zero
+	{"clasx203", "0E+5", "+Zero", -383},
+	// clasx204  class    1E-396                   -> +Subnormal
+	{"clasx204", "1E-396", "+Subnormal", -383},
+	// clasx205  class  0.1E-383                   -> +Subnormal
+	{"clasx205", "0.1E-383", "+Subnormal", -383},
+	// clasx206  class  0.999999999999999E-383     -> +Subnormal
+	{"clasx206", "0.999999999999999E-383", "+Subnormal", -383},
+	// clasx207  class  1.000000000000000E-383     -> +Normal
+	{"clasx207", "1.000000000000000E-383", "+Normal", -383},
+	// clasx208  class   1E-383                    -> +Normal
+	{"clasx208", "1E-383", "+Normal", -383},
+	// clasx209  class   1E-100                    -> +Normal
+	{"clasx209", "1E-100", "+Normal", -383},
+	// clasx210  class   1E-10                     -> +Normal
+	{"clasx210", "1E-10", "+Normal", -383},
+	// clasx212  class   1E-1                      -> +Normal
+	{"clasx212", "1E-1", "+Normal", -383},
+	// clasx213  class   1                         -> +Normal
+	{"clasx213", "1", "+Normal", -383},
+	// clasx214  class   2.50                      -> +Normal
+	{"clasx214", "2.50", "+Normal", -383},
+	// clasx215  class   100.100                   -> +Normal
+	{"clasx215", "100.100", "+Normal", -383},
+	// clasx216  class   1E+30                     -> +Normal
+	{"clasx216", "1E+30", "+Normal", -383},
+	// clasx217  class   1E+384                    -> +Normal
+	{"clasx217", "1E+384", "+Normal", -383},
+	// clasx218  class   9.999999999999999E+384    -> +Normal
+	{"clasx218", "9.999999999999999E+384", "+Normal", -383},
+	// clasx219  class   Inf                       -> +Infinity
+	{"clasx219", "Inf", "+Infinity", -383},
+	// clasx221  class   -0                        -> -Zero
+	{"clasx221", "-0", "-Zero", -383},
+	// clasx222  class   -0.00                     -> -Zero
+	{"clasx222", "-0.00", "-Zero", -383},
+	// clasx223  class   -0E+5                     -> -Zero
+	{"clasx223", "-0E+5", "-Zero", -383},
+	// clasx224  class   -1E-396                   -> -Subnormal
+	{"clasx224", "-1E-396", "-Subnormal", -383},
+	// clasx225  class  -0.1E-383                  -> -Subnormal
+	{"clasx225", "-0.1E-383", "-Subnormal", -383},
+	// clasx226  class  -0.999999999999999E-383    -> -Subnormal
+	{"clasx226", "-0.999999999999999E-383", "-Subnormal", -383},
+	// clasx227  class  -1.000000000000000E-383    -> -Normal
+	{"clasx227", "-1.000000000000000E-383", "-Normal", -383},
+	// clasx228  class  -1E-383                    -> -Normal
+	{"clasx228", "-1E-383", "-Normal", -383},
+	// clasx229  class  -1E-100                    -> -Normal
+	{"clasx229", "-1E-100", "-Normal", -383},
+	// clasx230  class  -1E-10                     -> -Normal
+	{"clasx230", "-1E-10", "-Normal", -383},
+	// clasx232  class  -1E-1                      -> -Normal
+	{"clasx232", "-1E-1", "-Normal", -383},
+	// clasx233  class  -1                         -> -Normal
+	{"clasx233", "-1", "-Normal", -383},
+	// clasx234  class  -2.50                      -> -Normal
+	{"clasx234", "-2.50", "-Normal", -383},
+	// clasx235  class  -100.100                   -> -Normal
+	{"clasx235", "-100.100", "-Normal", -383},
+	// clasx236  class  -1E+30                     -> -Normal
+	{"clasx236", "-1E+30", "-Normal", -383},
+	// clasx237  class  -1E+384                    -> -Normal
+	{"clasx237", "-1E+384", "-Normal", -383},
+	// clasx238  class  -9.999999999999999E+384    -> -Normal
+	{"clasx238", "-9.999999999999999E+384", "-Normal", -383},
+	// clasx239  class  -Inf                       -> -Infinity
+	{"clasx239", "-Inf", "-Infinity", -383},
+	// clasx241  class   NaN                       -> NaN
+	{"clasx241", "NaN", "NaN", -383},
+	// clasx242  class  -NaN                       -> NaN
+	{"clasx242", "-NaN", "NaN", -383},
+	// clasx243  class  +NaN12345                  -> NaN
+	{"clasx243", "+NaN12345", "NaN", -383},
+	// clasx244  class   sNaN                      -> sNaN
+	{"clasx244", "sNaN", "sNaN", -383},
+	// clasx245  class  -sNaN                      -> sNaN
+	{"clasx245", "-sNaN", "sNaN", -383},
+	// clasx246  class  +sNaN12345                 -> sNaN
+	{"clasx246", "+sNaN12345", "sNaN", -383},
+}
@@ -8,7 +8,7 @@ var comparetotalTests = []struct {
 	in2 string
 	out int
 }{
-	// version: 2.62
+	// version: 2.59
 	// Note that we cannot assume add/subtract tests cover paths adequately,
 	// here, because the code might be quite different (comparison cannot
 	// overflow or underflow, so actual subtractions are not necessary).
@@ -192,7 +192,8 @@ var comparetotalTests = []struct {
 	{"cotx097", "12.30", "12.30", 0},
 	// cotx098 comparetotal  12.3   12.300 ->  1
 	{"cotx098", "12.3", "12.300", 1},
-	// SKIP (NaN): cotx099 comparetotal  12.3   NaN    -> -1
+	// cotx099 comparetotal  12.3   NaN    -> -1
+	{"cotx099", "12.3", "NaN", -1},
 	// some differing length/exponent cases
 	// in this first group, compare would compare all equal
 	// cotx100 comparetotal   7.0    7.0    -> 0
@@ -1099,105 +1100,203 @@ var comparetotalTests = []struct {
 	{"cotx814", "1000", "-Inf", 1},
 	// cotx815 comparetotal  Inf  -Inf   ->  1
 	{"cotx815", "Inf", "-Inf", 1},
-	// SKIP (NaN): cotx821 comparetotal  NaN -Inf    ->  1
-	// SKIP (NaN): cotx822 comparetotal  NaN -1000   ->  1
-	// SKIP (NaN): cotx823 comparetotal  NaN -1      ->  1
-	// SKIP (NaN): cotx824 comparetotal  NaN -0      ->  1
-	// SKIP (NaN): cotx825 comparetotal  NaN  0      ->  1
-	// SKIP (NaN): cotx826 comparetotal  NaN  1      ->  1
-	// SKIP (NaN): cotx827 comparetotal  NaN  1000   ->  1
-	// SKIP (NaN): cotx828 comparetotal  NaN  Inf    ->  1
-	// SKIP (NaN): cotx829 comparetotal  NaN  NaN    ->  0
-	// SKIP (NaN): cotx830 comparetotal -Inf  NaN    ->  -1
-	// SKIP (NaN): cotx831 comparetotal -1000 NaN    ->  -1
-	// SKIP (NaN): cotx832 comparetotal -1    NaN    ->  -1
-	// SKIP (NaN): cotx833 comparetotal -0    NaN    ->  -1
-	// SKIP (NaN): cotx834 comparetotal  0    NaN    ->  -1
-	// SKIP (NaN): cotx835 comparetotal  1    NaN    ->  -1
-	// SKIP (NaN): cotx836 comparetotal  1000 NaN    ->  -1
-	// SKIP (NaN): cotx837 comparetotal  Inf  NaN    ->  -1
-	// SKIP (NaN): cotx838 comparetotal -NaN -NaN    ->  0
-	// SKIP (NaN): cotx839 comparetotal +NaN -NaN    ->  1
-	// SKIP (NaN): cotx840 comparetotal -NaN +NaN    ->  -1
-	// SKIP (NaN): cotx841 comparetotal  sNaN -sNaN  ->  1
-	// SKIP (NaN): cotx842 comparetotal  sNaN -NaN   ->  1
-	// SKIP (NaN): cotx843 comparetotal  sNaN -Inf   ->  1
-	// SKIP (NaN): cotx844 comparetotal  sNaN -1000  ->  1
-	// SKIP (NaN): cotx845 comparetotal  sNaN -1     ->  1
-	// SKIP (NaN): cotx846 comparetotal  sNaN -0     ->  1
-	// SKIP (NaN): cotx847 comparetotal  sNaN  0     ->  1
-	// SKIP (NaN): cotx848 comparetotal  sNaN  1     ->  1
-	// SKIP (NaN): cotx849 comparetotal  sNaN  1000  ->  1
-	// SKIP (NaN): cotx850 comparetotal  sNaN  NaN   ->  -1
-	// SKIP (NaN): cotx851 comparetotal  sNaN sNaN   ->  0
-	// SKIP (NaN): cotx852 comparetotal -sNaN sNaN   ->  -1
-	// SKIP (NaN): cotx853 comparetotal -NaN  sNaN   ->  -1
-	// SKIP (NaN): cotx854 comparetotal -Inf  sNaN   ->  -1
-	// SKIP (NaN): cotx855 comparetotal -1000 sNaN   ->  -1
-	// SKIP (NaN): cotx856 comparetotal -1    sNaN   ->  -1
-	// SKIP (NaN): cotx857 comparetotal -0    sNaN   ->  -1
-	// SKIP (NaN): cotx858 comparetotal  0    sNaN   ->  -1
-	// SKIP (NaN): cotx859 comparetotal  1    sNaN   ->  -1
-	// SKIP (NaN): cotx860 comparetotal  1000 sNaN   ->  -1
-	// SKIP (NaN): cotx861 comparetotal  Inf  sNaN   ->  -1
-	// SKIP (NaN): cotx862 comparetotal  NaN  sNaN   ->  1
-	// SKIP (NaN): cotx863 comparetotal  sNaN sNaN   ->  0
-	// SKIP (NaN): cotx871 comparetotal  -sNaN -sNaN  ->  0
-	// SKIP (NaN): cotx872 comparetotal  -sNaN -NaN   ->  1
-	// SKIP (NaN): cotx873 comparetotal  -sNaN -Inf   ->  -1
-	// SKIP (NaN): cotx874 comparetotal  -sNaN -1000  ->  -1
-	// SKIP (NaN): cotx875 comparetotal  -sNaN -1     ->  -1
-	// SKIP (NaN): cotx876 comparetotal  -sNaN -0     ->  -1
-	// SKIP (NaN): cotx877 comparetotal  -sNaN  0     ->  -1
-	// SKIP (NaN): cotx878 comparetotal  -sNaN  1     ->  -1
-	// SKIP (NaN): cotx879 comparetotal  -sNaN  1000  ->  -1
-	// SKIP (NaN): cotx880 comparetotal  -sNaN  NaN   ->  -1
-	// SKIP (NaN): cotx881 comparetotal  -sNaN sNaN   ->  -1
-	// SKIP (NaN): cotx882 comparetotal -sNaN -sNaN   ->  0
-	// SKIP (NaN): cotx883 comparetotal -NaN  -sNaN   ->  -1
-	// SKIP (NaN): cotx884 comparetotal -Inf  -sNaN   ->  1
-	// SKIP (NaN): cotx885 comparetotal -1000 -sNaN   ->  1
-	// SKIP (NaN): cotx886 comparetotal -1    -sNaN   ->  1
-	// SKIP (NaN): cotx887 comparetotal -0    -sNaN   ->  1
-	// SKIP (NaN): cotx888 comparetotal  0    -sNaN   ->  1
-	// SKIP (NaN): cotx889 comparetotal  1    -sNaN   ->  1
-	// SKIP (NaN): cotx890 comparetotal  1000 -sNaN   ->  1
-	// SKIP (NaN): cotx891 comparetotal  Inf  -sNaN   ->  1
-	// SKIP (NaN): cotx892 comparetotal  NaN  -sNaN   ->  1
-	// SKIP (NaN): cotx893 comparetotal  sNaN -sNaN   ->  1
+	// cotx821 comparetotal  NaN -Inf    ->  1
+	{"cotx821", "NaN", "-Inf", 1},
+	// cotx822 comparetotal  NaN -1000   ->  1
+	{"cotx822", "NaN", "-1000", 1},
+	// cotx823 comparetotal  NaN -1      ->  1
+	{"cotx823", "NaN", "-1", 1},
+	// cotx824 comparetotal  NaN -0      ->  1
+	{"cotx824", "NaN", "-0", 1},
+	// cotx825 comparetotal  NaN  0      ->  1
+	{"cotx825", "NaN", "0", 1},
+	// cotx826 comparetotal  NaN  1      ->  1
+	{"cotx826", "NaN", "1", 1},
+	// cotx827 comparetotal  NaN  1000   ->  1
+	{"cotx827", "NaN", "1000", 1},
+	// cotx828 comparetotal  NaN  Inf    ->  1
+	{"cotx828", "NaN", "Inf", 1},
+	// cotx829 comparetotal  NaN  NaN    ->  0
+	{"cotx829", "NaN", "NaN", 0},
+	// cotx830 comparetotal -Inf  NaN    ->  -1
+	{"cotx830", "-Inf", "NaN", -1},
+	// cotx831 comparetotal -1000 NaN    ->  -1
+	{"cotx831", "-1000", "NaN", -1},
+	// cotx832 comparetotal -1    NaN    ->  -1
+	{"cotx832", "-1", "NaN", -1},
+	// cotx833 comparetotal -0    NaN    ->  -1
+	{"cotx833", "-0", "NaN", -1},
+	// cotx834 comparetotal  0    NaN    ->  -1
+	{"cotx834", "0", "NaN", -1},
+	// cotx835 comparetotal  1    NaN    ->  -1
+	{"cotx835", "1", "NaN", -1},
+	// cotx836 comparetotal  1000 NaN    ->  -1
+	{"cotx836", "1000", "NaN", -1},
+	// cotx837 comparetotal  Inf  NaN    ->  -1
+	{"cotx837", "Inf", "NaN", -1},
+	// cotx838 comparetotal -NaN -NaN    ->  0
+	{"cotx838", "-NaN", "-NaN", 0},
+	// cotx839 comparetotal +NaN -NaN    ->  1
+	{"cotx839", "+NaN", "-NaN", 1},
+	// cotx840 comparetotal -NaN +NaN    ->  -1
+	{"cotx840", "-NaN", "+NaN", -1},
+	// cotx841 comparetotal  sNaN -sNaN  ->  1
+	{"cotx841", "sNaN", "-sNaN", 1},
+	// cotx842 comparetotal  sNaN -NaN   ->  1
+	{"cotx842", "sNaN", "-NaN", 1},
+	// cotx843 comparetotal  sNaN -Inf   ->  1
+	{"cotx843", "sNaN", "-Inf", 1},
+	// cotx844 comparetotal  sNaN -1000  ->  1
+	{"cotx844", "sNaN", "-1000", 1},
+	// cotx845 comparetotal  sNaN -1     ->  1
+	{"cotx845", "sNaN", "-1", 1},
+	// cotx846 comparetotal  sNaN -0     ->  1
+	{"cotx846", "sNaN", "-0", 1},
+	// cotx847 comparetotal  sNaN  0     ->  1
+	{"cotx847", "sNaN", "0", 1},
+	// cotx848 comparetotal  sNaN  1     ->  1
+	{"cotx848", "sNaN", "1", 1},
+	// cotx849 comparetotal  sNaN  1000  ->  1
+	{"cotx849", "sNaN", "1000", 1},
+	// cotx850 comparetotal  sNaN  NaN   ->  -1
+	{"cotx850", "sNaN", "NaN", -1},
+	// cotx851 comparetotal  sNaN sNaN   ->  0
+	{"cotx851", "sNaN", "sNaN", 0},
+	// cotx852 comparetotal -sNaN sNaN   ->  -1
+	{"cotx852", "-sNaN", "sNaN", -1},
+	// cotx853 comparetotal -NaN  sNaN   ->  -1
+	{"cotx853", "-NaN", "sNaN", -1},
+	// cotx854 comparetotal -Inf  sNaN   ->  -1
+	{"cotx854", "-Inf", "sNaN", -1},
+	// cotx855 comparetotal -1000 sNaN   ->  -1
+	{"cotx855", "-1000", "sNaN", -1},
+	// cotx856 comparetotal -1    sNaN   ->  -1
+	{"cotx856", "-1", "sNaN", -1},
+	// cotx857 comparetotal -0    sNaN   ->  -1
+	{"cotx857", "-0", "sNaN", -1},
+	// cotx858 comparetotal  0    sNaN   ->  -1
+	{"cotx858", "0", "sNaN", -1},
+	// cotx859 comparetotal  1    sNaN   ->  -1
+	{"cotx859", "1", "sNaN", -1},
+	// cotx860 comparetotal  1000 sNaN   ->  -1
+	{"cotx860", "1000", "sNaN", -1},
+	// cotx861 comparetotal  Inf  sNaN   ->  -1
+	{"cotx861", "Inf", "sNaN", -1},
+	// cotx862 comparetotal  NaN  sNaN   ->  1
+	{"cotx862", "NaN", "sNaN", 1},
+	// cotx863 comparetotal  sNaN sNaN   ->  0
+	{"cotx863", "sNaN", "sNaN", 0},
+	// cotx871 comparetotal  -sNaN -sNaN  ->  0
+	{"cotx871", "-sNaN", "-sNaN", 0},
+	// cotx872 comparetotal  -sNaN -NaN   ->  1
+	{"cotx872", "-sNaN", "-NaN", 1},
+	// cotx873 comparetotal  -sNaN -Inf   ->  -1
+	{"cotx873", "-sNaN", "-Inf", -1},
+	// cotx874 comparetotal  -sNaN -1000  ->  -1
+	{"cotx874", "-sNaN", "-1000", -1},
+	// cotx875 comparetotal  -sNaN -1     ->  -1
+	{"cotx875", "-sNaN", "-1", -1},
+	// cotx876 comparetotal  -sNaN -0     ->  -1
+	{"cotx876", "-sNaN", "-0", -1},
+	// cotx877 comparetotal  -sNaN  0     ->  -1
+	{"cotx877", "-sNaN", "0", -1},
+	// cotx878 comparetotal  -sNaN  1     ->  -1
+	{"cotx878", "-sNaN", "1", -1},
+	// cotx879 comparetotal  -sNaN  1000  ->  -1
+	{"cotx879", "-sNaN", "1000", -1},
+	// cotx880 comparetotal  -sNaN  NaN   ->  -1
+	{"cotx880", "-sNaN", "NaN", -1},
+	// cotx881 comparetotal  -sNaN sNaN   ->  -1
+	{"cotx881", "-sNaN", "sNaN", -1},
+	// cotx882 comparetotal -sNaN -sNaN   ->  0
+	{"cotx882", "-sNaN", "-sNaN", 0},
+	// cotx883 comparetotal -NaN  -sNaN   ->  -1
+	{"cotx883", "-NaN", "-sNaN", -1},
+	// cotx884 comparetotal -Inf  -sNaN   ->  1
+	{"cotx884", "-Inf", "-sNaN", 1},
+	// cotx885 comparetotal -1000 -sNaN   ->  1
+	{"cotx885", "-1000", "-sNaN", 1},
+	// cotx886 comparetotal -1    -sNaN   ->  1
+	{"cotx886", "-1", "-sNaN", 1},
+	// cotx887 comparetotal -0    -sNaN   ->  1
+	{"cotx887", "-0", "-sNaN", 1},
+	// cotx888 comparetotal  0    -sNaN   ->  1
+	{"cotx888", "0", "-sNaN", 1},
+	// cotx889 comparetotal  1    -sNaN   ->  1
+	{"cotx889", "1", "-sNaN", 1},
+	// cotx890 comparetotal  1000 -sNaN   ->  1
+	{"cotx890", "1000", "-sNaN", 1},
+	// cotx891 comparetotal  Inf  -sNaN   ->  1
+	{"cotx891", "Inf", "-sNaN", 1},
+	// cotx892 comparetotal  NaN  -sNaN   ->  1
+	{"cotx892", "NaN", "-sNaN", 1},
+	// cotx893 comparetotal  sNaN -sNaN   ->  1
+	{"cotx893", "sNaN", "-sNaN", 1},
 	// NaNs with payload
-	// SKIP (NaN): cotx960 comparetotal  NaN9 -Inf   ->  1
-	// SKIP (NaN): cotx961 comparetotal  NaN8  999   ->  1
-	// SKIP (NaN): cotx962 comparetotal  NaN77 Inf   ->  1
-	// SKIP (NaN): cotx963 comparetotal -NaN67 NaN5  ->  -1
-	// SKIP (NaN): cotx964 comparetotal -Inf  -NaN4  ->  1
-	// SKIP (NaN): cotx965 comparetotal -999  -NaN33 ->  1
-	// SKIP (NaN): cotx966 comparetotal  Inf   NaN2  ->  -1
-	// SKIP (NaN): cotx970 comparetotal -NaN41 -NaN42 -> 1
-	// SKIP (NaN): cotx971 comparetotal +NaN41 -NaN42 -> 1
-	// SKIP (NaN): cotx972 comparetotal -NaN41 +NaN42 -> -1
-	// SKIP (NaN): cotx973 comparetotal +NaN41 +NaN42 -> -1
-	// SKIP (NaN): cotx974 comparetotal -NaN42 -NaN01 -> -1
-	// SKIP (NaN): cotx975 comparetotal +NaN42 -NaN01 ->  1
-	// SKIP (NaN): cotx976 comparetotal -NaN42 +NaN01 -> -1
-	// SKIP (NaN): cotx977 comparetotal +NaN42 +NaN01 ->  1
-	// SKIP (NaN): cotx980 comparetotal -sNaN771 -sNaN772 -> 1
-	// SKIP (NaN): cotx981 comparetotal +sNaN771 -sNaN772 -> 1
-	// SKIP (NaN): cotx982 comparetotal -sNaN771 +sNaN772 -> -1
-	// SKIP (NaN): cotx983 comparetotal +sNaN771 +sNaN772 -> -1
-	// SKIP (NaN): cotx984 comparetotal -sNaN772 -sNaN771 -> -1
-	// SKIP (NaN): cotx985 comparetotal +sNaN772 -sNaN771 ->  1
-	// SKIP (NaN): cotx986 comparetotal -sNaN772 +sNaN771 -> -1
-	// SKIP (NaN): cotx987 comparetotal +sNaN772 +sNaN771 ->  1
-	// SKIP (NaN): cotx991 comparetotal -sNaN99 -Inf    -> -1
-	// SKIP (NaN): cotx992 comparetotal  sNaN98 -11     ->  1
-	// SKIP (NaN): cotx993 comparetotal  sNaN97  NaN    -> -1
-	// SKIP (NaN): cotx994 comparetotal  sNaN16 sNaN94  -> -1
-	// SKIP (NaN): cotx995 comparetotal  NaN85  sNaN83  ->  1
-	// SKIP (NaN): cotx996 comparetotal -Inf    sNaN92  -> -1
-	// SKIP (NaN): cotx997 comparetotal  088    sNaN81  -> -1
-	// SKIP (NaN): cotx998 comparetotal  Inf    sNaN90  -> -1
-	// SKIP (NaN): cotx999 comparetotal  NaN   -sNaN89  ->  1
+	// cotx960 comparetotal  NaN9 -Inf   ->  1
+	{"cotx960", "NaN9", "-Inf", 1},
+	// cotx961 comparetotal  NaN8  999   ->  1
+	{"cotx961", "NaN8", "999", 1},
+	// cotx962 comparetotal  NaN77 Inf   ->  1
+	{"cotx962", "NaN77", "Inf", 1},
+	// cotx963 comparetotal -NaN67 NaN5  ->  -1
+	{"cotx963", "-NaN67", "NaN5", -1},
+	// cotx964 comparetotal -Inf  -NaN4  ->  1
+	{"cotx964", "-Inf", "-NaN4", 1},
+	// cotx965 comparetotal -999  -NaN33 ->  1
+	{"cotx965", "-999", "-NaN33", 1},
+	// cotx966 comparetotal  Inf   NaN2  ->  -1
+	{"cotx966", "Inf", "NaN2", -1},
+	// cotx970 comparetotal -NaN41 -NaN42 -> 1
+	{"cotx970", "-NaN41", "-NaN42", 1},
+	// cotx971 comparetotal +NaN41 -NaN42 -> 1
+	{"cotx971", "+NaN41", "-NaN42", 1},
+	// cotx972 comparetotal -NaN41 +NaN42 -> -1
+	{"cotx972", "-NaN41", "+NaN42", -1},
+	// cotx973 comparetotal +NaN41 +NaN42 -> -1
+	{"cotx973", "+NaN41", "+NaN42", -1},
+	// cotx974 comparetotal -NaN42 -NaN01 -> -1
+	{"cotx974", "-NaN42", "-NaN01", -1},
+	// cotx975 comparetotal +NaN42 -NaN01 ->  1
+	{"cotx975", "+NaN42", "-NaN01", 1},
+	// cotx976 comparetotal -NaN42 +NaN01 -> -1
+	{"cotx976", "-NaN42", "+NaN01", -1},
+	// cotx977 comparetotal +NaN42 +NaN01 ->  1
+	{"cotx977", "+NaN42", "+NaN01", 1},
+	// cotx980 comparetotal -sNaN771 -sNaN772 -> 1
+	{"cotx980", "-sNaN771", "-sNaN772", 1},
+	// cotx981 comparetotal +sNaN771 -sNaN772 -> 1
+	{"cotx981", "+sNaN771", "-sNaN772", 1},
+	// cotx982 comparetotal -sNaN771 +sNaN772 -> -1
+	{"cotx982", "-sNaN771", "+sNaN772", -1},
+	// cotx983 comparetotal +sNaN771 +sNaN772 -> -1
+	{"cotx983", "+sNaN771", "+sNaN772", -1},
+	// cotx984 comparetotal -sNaN772 -sNaN771 -> -1
+	{"cotx984", "-sNaN772", "-sNaN771", -1},
+	// cotx985 comparetotal +sNaN772 -sNaN771 ->  1
+	{"cotx985", "+sNaN772", "-sNaN771", 1},
+	// cotx986 comparetotal -sNaN772 +sNaN771 -> -1
+	{"cotx986", "-sNaN772", "+sNaN771", -1},
+	// cotx987 comparetotal +sNaN772 +sNaN771 ->  1
+	{"cotx987", "+sNaN772", "+sNaN771", 1},
+	// cotx991 comparetotal -sNaN99 -Inf    -> -1
+	{"cotx991", "-sNaN99", "-Inf", -1},
+	// cotx992 comparetotal  sNaN98 -11     ->  1
+	{"cotx992", "sNaN98", "-11", 1},
+	// cotx993 comparetotal  sNaN97  NaN    -> -1
+	{"cotx993", "sNaN97", "NaN", -1},
+	// cotx994 comparetotal  sNaN16 sNaN94  -> -1
+	{"cotx994", "sNaN16", "sNaN94", -1},
+	// cotx995 comparetotal  NaN85  sNaN83  ->  1
+	{"cotx995", "NaN85", "sNaN83", 1},
+	// cotx996 comparetotal -Inf    sNaN92  -> -1
+	{"cotx996", "-Inf", "sNaN92", -1},
+	// cotx997 comparetotal  088    sNaN81  -> -1
+	{"cotx997", "088", "sNaN81", -1},
+	// cotx998 comparetotal  Inf    sNaN90  -> -1
+	{"cotx998", "Inf", "sNaN90", -1},
+	// cotx999 comparetotal  NaN   -sNaN89  ->  1
+	{"cotx999", "NaN", "-sNaN89", 1},
 	// overflow and underflow tests .. subnormal results now allowed
 	// maxexponent: 999999999
 	// minexponent: -999999999
@@ -1308,6 +1407,6 @@ var comparetotalTests = []struct {
 	// cotx1141 comparetotal  -0      -0E-383  -> -1
 	{"cotx1141", "-0", "-0E-383", -1},
 	// Null tests
-	// SKIP (NaN): cotx9990 comparetotal 10  # -> NaN Invalid_operation
-	// SKIP (NaN): cotx9991 comparetotal  # 10 -> NaN Invalid_operation
+	// SKIP (encoding not supported): cotx9990 comparetotal 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): cotx9991 comparetotal  # 10 -> NaN Invalid_operation
 }
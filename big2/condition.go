@@ -0,0 +1,67 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package big2
+
+import "strings"
+
+// A Condition is a set of the exceptional conditions defined by the
+// General Decimal Arithmetic specification. Each operation records the
+// conditions it raised in its result (see Decimal.Conditions); a Context
+// accumulates them in its Flags and panics for those set in its Traps.
+type Condition uint32
+
+// Conditions.
+const (
+	Clamped            Condition = 1 << iota // exponent of the result was altered
+	ConversionSyntax                         // string is not a valid number
+	DivisionByZero                           // finite number divided by zero
+	DivisionImpossible                       // integer part of a quotient is too long
+	DivisionUndefined                        // zero divided by zero
+	Inexact                                  // result is not exact
+	InvalidOperation                         // result would be NaN
+	Overflow                                 // adjusted exponent of the result is greater than Emax
+	Rounded                                  // digits were discarded from the result
+	Subnormal                                // adjusted exponent of the result is less than Emin
+	Underflow                                // result is subnormal and inexact
+)
+
+var conditionNames = []string{
+	"Clamped",
+	"ConversionSyntax",
+	"DivisionByZero",
+	"DivisionImpossible",
+	"DivisionUndefined",
+	"Inexact",
+	"InvalidOperation",
+	"Overflow",
+	"Rounded",
+	"Subnormal",
+	"Underflow",
+}
+
+// String returns the names of the conditions in c separated by "|", or "0"
+// if c is empty.
+func (c Condition) String() string {
+	if c == 0 {
+		return "0"
+	}
+	var names []string
+	for i, name := range conditionNames {
+		if c&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// An ErrTrap panic is raised by a Context operation that raises a condition
+// set in the Context's Traps. An ErrTrap implements the error interface.
+type ErrTrap struct {
+	Cond Condition // trapped conditions
+}
+
+func (err ErrTrap) Error() string {
+	return "trapped condition: " + err.Cond.String()
+}
@@ -0,0 +1,328 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package big2
+
+// A Context specifies the precision, rounding mode, exponent limits and
+// clamping applied to the results of arithmetic operations. The methods
+// of a Context configure the result z accordingly and then perform the
+// corresponding Decimal operation, so that a single policy can be used for
+// a whole computation.
+//
+// The conditions raised by the operations are accumulated in Flags until
+// they are cleared explicitly. If an operation raises a condition that is
+// set in Traps, it panics with ErrTrap after z has been set.
+//
+// The zero value for a Context is ready to use: it behaves as the Context
+// returned by NewUnlimitedContext and no conditions are trapped.
+type Context struct {
+	Prec  uint         // precision in decimal digits; 0 as for Decimal
+	Mode  RoundingMode // rounding mode
+	Emax  int          // maximum exponent
+	Emin  int          // minimum exponent
+	Clamp bool         // limit exponents to Emax - (Prec - 1)
+	Flags Condition    // conditions raised so far
+	Traps Condition    // conditions that cause a panic
+}
+
+// NewDecimal32Context returns a Context for the IEEE 754 decimal32
+// interchange format: a precision of 7 digits, exponent limits of 96 and
+// -95, clamping and rounding mode ToNearestEven. Since the operations of a
+// Context update its Flags, each computation should use its own Context.
+func NewDecimal32Context() Context {
+	return Context{Prec: 7, Mode: ToNearestEven, Emax: 96, Emin: -95, Clamp: true}
+}
+
+// NewDecimal64Context returns a Context for the IEEE 754 decimal64
+// interchange format: a precision of 16 digits, exponent limits of 384 and
+// -383, clamping and rounding mode ToNearestEven. See NewDecimal32Context.
+func NewDecimal64Context() Context {
+	return Context{Prec: 16, Mode: ToNearestEven, Emax: 384, Emin: -383, Clamp: true}
+}
+
+// NewDecimal128Context returns a Context for the IEEE 754 decimal128
+// interchange format: a precision of 34 digits, exponent limits of 6144 and
+// -6143, clamping and rounding mode ToNearestEven. See NewDecimal32Context.
+func NewDecimal128Context() Context {
+	return Context{Prec: 34, Mode: ToNearestEven, Emax: 6144, Emin: -6143, Clamp: true}
+}
+
+// NewUnlimitedContext returns a Context with a precision of 0 and the MinExp
+// and MaxExp exponent limits; it is equivalent to the zero Context. Each
+// result gets the precision that the corresponding Decimal method chooses
+// for a precision of 0. Add, Sub and Mul are then exact, but operations such
+// as Quo, Sqrt, Exp, Ln, Log10 and Pow use the precision of their operands;
+// for example, Quo(z, 1, 3) yields 0.3 (Inexact).
+func NewUnlimitedContext() Context {
+	return Context{Prec: 0, Mode: ToNearestEven, Emax: MaxExp, Emin: MinExp}
+}
+
+// apply sets the precision, rounding mode, exponent limits and clamping of
+// z to those of c and returns z. If both c.Emax and c.Emin are 0, the
+// default limits are used.
+func (c *Context) apply(z *Decimal) *Decimal {
+	z.SetPrec(c.Prec)
+	z.SetMode(c.Mode)
+	if c.Emax == 0 && c.Emin == 0 {
+		z.SetEmax(MaxExp)
+		z.SetEmin(MinExp)
+	} else {
+		z.SetEmax(c.Emax)
+		z.SetEmin(c.Emin)
+	}
+	z.SetClamp(c.Clamp)
+	return z
+}
+
+// raise adds the conditions raised by the operation that produced z to
+// c.Flags and returns z. It panics if any of them is trapped.
+func (c *Context) raise(z *Decimal) *Decimal {
+	c.signal(z.cond)
+	return z
+}
+
+// signal adds cond to c.Flags. It panics if any of the conditions in cond
+// is trapped.
+func (c *Context) signal(cond Condition) {
+	c.Flags |= cond
+	if t := cond & c.Traps; t != 0 {
+		panic(ErrTrap{t})
+	}
+}
+
+// Round sets z to the value of x rounded according to c and returns z.
+// Unlike Decimal.Set, Round also rounds z if z and x are the same.
+func (c *Context) Round(z, x *Decimal) *Decimal {
+	if z == x {
+		// Set does not round a Decimal in place
+		x = new(Decimal).Copy(x)
+	}
+	return c.raise(c.apply(z).Set(x))
+}
+
+// SetString sets z to the value of s rounded according to c and returns z
+// and a boolean indicating success. See Decimal.SetString for the accepted
+// formats.
+func (c *Context) SetString(z *Decimal, s string) (*Decimal, bool) {
+	if _, ok := c.apply(z).SetString(s); !ok {
+		z.cond = ConversionSyntax
+		c.raise(z)
+		return nil, false
+	}
+	return c.raise(z), true
+}
+
+// Abs sets z to |x| rounded according to c and returns z.
+func (c *Context) Abs(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Abs(x))
+}
+
+// Neg sets z to -x rounded according to c and returns z.
+func (c *Context) Neg(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Neg(x))
+}
+
+// Add sets z to the sum x+y rounded according to c and returns z.
+func (c *Context) Add(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Add(x, y))
+}
+
+// Sub sets z to the difference x-y rounded according to c and returns z.
+func (c *Context) Sub(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Sub(x, y))
+}
+
+// Mul sets z to the product x*y rounded according to c and returns z.
+func (c *Context) Mul(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Mul(x, y))
+}
+
+// Quo sets z to the quotient x/y rounded according to c and returns z.
+// If c.Prec is 0, see Decimal.Quo for the precision of the result.
+func (c *Context) Quo(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Quo(x, y))
+}
+
+// QuoInt sets z to the integer part of the quotient x/y and returns z.
+// See Decimal.QuoInt.
+func (c *Context) QuoInt(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).QuoInt(x, y))
+}
+
+// Rem sets z to the remainder x - y*QuoInt(x, y) and returns z.
+// See Decimal.Rem.
+func (c *Context) Rem(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Rem(x, y))
+}
+
+// RemNear sets z to the remainder x - y*n, where n is the integer nearest
+// to x/y, and returns z. See Decimal.RemNear.
+func (c *Context) RemNear(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).RemNear(x, y))
+}
+
+// QuoRem sets z to the integer part of the quotient x/y and r to the
+// remainder x - y*z, both configured according to c, and returns the pair
+// (z, r). See Decimal.QuoRem.
+func (c *Context) QuoRem(z, x, y, r *Decimal) (*Decimal, *Decimal) {
+	c.apply(r)
+	c.apply(z).QuoRem(x, y, r)
+	c.signal(z.cond | r.cond)
+	return z, r
+}
+
+// Sqrt sets z to the square root of x rounded according to c and returns z.
+// See Decimal.Sqrt.
+func (c *Context) Sqrt(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Sqrt(x))
+}
+
+// Exp sets z to e**x rounded according to c and returns z. See Decimal.Exp.
+func (c *Context) Exp(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Exp(x))
+}
+
+// Ln sets z to the natural logarithm of x rounded according to c and
+// returns z. See Decimal.Ln.
+func (c *Context) Ln(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Ln(x))
+}
+
+// Log10 sets z to the base-10 logarithm of x rounded according to c and
+// returns z. See Decimal.Log10.
+func (c *Context) Log10(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Log10(x))
+}
+
+// Pow sets z to x**y rounded according to c and returns z.
+// See Decimal.Pow.
+func (c *Context) Pow(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Pow(x, y))
+}
+
+// Quantize sets z to the value of x rounded according to c to the exponent
+// of y and returns z. See Decimal.Quantize.
+func (c *Context) Quantize(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Quantize(x, y))
+}
+
+// Rescale sets z to the value of x rounded according to c to the given
+// scale and returns z. See Decimal.Rescale.
+func (c *Context) Rescale(z, x *Decimal, scale int32) *Decimal {
+	return c.raise(c.apply(z).Rescale(x, scale))
+}
+
+// ScaleB sets z to x × 10**n rounded according to c and returns z.
+// See Decimal.ScaleB.
+func (c *Context) ScaleB(z, x *Decimal, n int) *Decimal {
+	return c.raise(c.apply(z).ScaleB(x, n))
+}
+
+// LogB sets z to the adjusted exponent of x rounded according to c and
+// returns z. See Decimal.LogB.
+func (c *Context) LogB(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).LogB(x))
+}
+
+// Reduce sets z to the value of x rounded according to c with all trailing
+// zeros removed and returns z. See Decimal.Reduce.
+func (c *Context) Reduce(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Reduce(x))
+}
+
+// ToIntegral sets z to the value of x rounded to an integer using c.Mode
+// and returns z. See Decimal.ToIntegral.
+func (c *Context) ToIntegral(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).ToIntegral(x, c.Mode))
+}
+
+// ToIntegralExact sets z to the value of x rounded to an integer using
+// c.Mode and returns z. See Decimal.ToIntegralExact.
+func (c *Context) ToIntegralExact(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).ToIntegralExact(x, c.Mode))
+}
+
+// NextPlus sets z to the smallest number greater than x that can be
+// represented according to c and returns z. See Decimal.NextPlus.
+func (c *Context) NextPlus(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).NextPlus(x))
+}
+
+// NextMinus sets z to the largest number less than x that can be
+// represented according to c and returns z. See Decimal.NextMinus.
+func (c *Context) NextMinus(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).NextMinus(x))
+}
+
+// NextToward sets z to the number closest to x in the direction of y that
+// can be represented according to c and returns z. See Decimal.NextToward.
+func (c *Context) NextToward(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).NextToward(x, y))
+}
+
+// Max sets z to the larger of x and y rounded according to c and returns z.
+// See Decimal.Max.
+func (c *Context) Max(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Max(x, y))
+}
+
+// Min sets z to the smaller of x and y rounded according to c and returns z.
+// See Decimal.Min.
+func (c *Context) Min(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Min(x, y))
+}
+
+// MaxMag sets z to the operand with the larger absolute value rounded
+// according to c and returns z. See Decimal.MaxMag.
+func (c *Context) MaxMag(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).MaxMag(x, y))
+}
+
+// MinMag sets z to the operand with the smaller absolute value rounded
+// according to c and returns z. See Decimal.MinMag.
+func (c *Context) MinMag(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).MinMag(x, y))
+}
+
+// And sets z to the digit-wise logical conjunction of x and y with c.Prec
+// digits and returns z. See Decimal.And.
+func (c *Context) And(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).And(x, y))
+}
+
+// Or sets z to the digit-wise logical disjunction of x and y with c.Prec
+// digits and returns z. See Decimal.Or.
+func (c *Context) Or(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Or(x, y))
+}
+
+// Xor sets z to the digit-wise logical exclusive disjunction of x and y with
+// c.Prec digits and returns z. See Decimal.Xor.
+func (c *Context) Xor(z, x, y *Decimal) *Decimal {
+	return c.raise(c.apply(z).Xor(x, y))
+}
+
+// Invert sets z to the digit-wise logical negation of x with c.Prec digits
+// and returns z. See Decimal.Invert.
+func (c *Context) Invert(z, x *Decimal) *Decimal {
+	return c.raise(c.apply(z).Invert(x))
+}
+
+// Shift sets z to x with the digits of its coefficient shifted n places
+// within c.Prec digits and returns z. See Decimal.Shift.
+func (c *Context) Shift(z, x *Decimal, n int) *Decimal {
+	return c.raise(c.apply(z).Shift(x, n))
+}
+
+// Rotate sets z to x with the digits of its coefficient rotated n places
+// within c.Prec digits and returns z. See Decimal.Rotate.
+func (c *Context) Rotate(z, x *Decimal, n int) *Decimal {
+	return c.raise(c.apply(z).Rotate(x, n))
+}
+
+// FMA sets z to x*y+u rounded once according to c and returns z.
+// See Decimal.FMA.
+func (c *Context) FMA(z, x, y, u *Decimal) *Decimal {
+	return c.raise(c.apply(z).FMA(x, y, u))
+}
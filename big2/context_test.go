@@ -0,0 +1,323 @@
+package big2
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	for i, test := range []struct {
+		ctx  Context
+		op   string
+		x, y string
+		out  string
+		acc  big.Accuracy
+	}{
+		{NewDecimal64Context(), "add", "1", "1E-20", "1.000000000000000", big.Below},
+		{NewDecimal64Context(), "sub", "1", "1E-20", "1.000000000000000", big.Above},
+		{Context{Prec: 16, Mode: ToZero}, "sub", "1", "1E-20", "0.9999999999999999", big.Below},
+		{NewDecimal32Context(), "mul", "1E+60", "1E+60", "Inf", big.Above},
+		{NewDecimal32Context(), "mul", "-1E+60", "1E+60", "-Inf", big.Below},
+		{NewDecimal32Context(), "quo", "2", "3", "0.6666667", big.Above},
+		{NewDecimal128Context(), "quo", "1", "3", "0.3333333333333333333333333333333333", big.Below},
+		{NewDecimal32Context(), "round", "1E+96", "", "1.000000E+96", big.Exact},
+		{NewDecimal32Context(), "round", "1E-101", "", "1E-101", big.Exact},
+		{NewDecimal32Context(), "round", "1E-102", "", "0E-101", big.Below},
+		{NewUnlimitedContext(), "mul", "1.5", "1.5", "2.25", big.Exact},
+		{NewUnlimitedContext(), "add", "1E+100", "1E-100", "1" + strings.Repeat("0", 100) + "." + strings.Repeat("0", 99) + "1", big.Exact},
+		{NewUnlimitedContext(), "quo", "1", "3", "0.3", big.Below},
+		{NewUnlimitedContext(), "sqrt", "2", "", "1", big.Below},
+		{Context{Prec: 3, Mode: ToZero}, "quo", "2", "3", "0.666", big.Below},
+		{Context{Prec: 3, Mode: ToZero, Emax: 9, Emin: -9}, "mul", "1E+9", "10", "9.99E+9", big.Below},
+		{Context{}, "add", "0.1", "0.02", "0.12", big.Exact},
+		{NewDecimal32Context(), "sqrt", "2", "", "1.414214", big.Above},
+		{Context{Prec: 3, Mode: ToPositiveInf}, "sqrt", "2", "", "1.41", big.Below},
+		{NewDecimal64Context(), "sqrt", "0.0100", "", "0.10", big.Exact},
+		{NewDecimal64Context(), "exp", "1", "", "2.718281828459045", big.Below},
+		{NewDecimal32Context(), "exp", "-1000", "", "0E-101", big.Below},
+		{NewDecimal64Context(), "ln", "10", "", "2.302585092994046", big.Above},
+		{NewDecimal32Context(), "log10", "0.001", "", "-3", big.Exact},
+		{NewDecimal32Context(), "log10", "2", "", "0.3010300", big.Above},
+		{NewDecimal64Context(), "pow", "1.05", "10", "1.628894626777441", big.Below},
+		{Context{Prec: 7, Mode: ToZero}, "pow", "1.05", "10", "1.628894", big.Below},
+		{NewDecimal32Context(), "pow", "2", "-3", "0.125", big.Exact},
+		{NewDecimal32Context(), "pow", "2", "0.5", "1.414214", big.Above},
+		{NewDecimal64Context(), "quantize", "2.17", "0.001", "2.170", big.Exact},
+		{NewDecimal64Context(), "quantize", "2.175", "0.01", "2.18", big.Above},
+		{Context{Prec: 16, Mode: ToZero}, "quantize", "2.175", "0.01", "2.17", big.Below},
+		{NewDecimal64Context(), "reduce", "1.000", "", "1", big.Exact},
+		{NewDecimal32Context(), "reduce", "1.2345678", "", "1.234568", big.Above},
+		{NewDecimal32Context(), "tointegral", "2.5", "", "2", big.Exact},
+		{Context{Prec: 3, Mode: ToPositiveInf}, "tointegral", "12345.1", "", "12346", big.Exact},
+		{NewDecimal32Context(), "tointegralx", "2.5", "", "2", big.Below},
+		{NewDecimal32Context(), "tointegralx", "-2.5", "", "-2", big.Above},
+		{NewDecimal32Context(), "nextplus", "1", "", "1.000001", big.Exact},
+		{NewDecimal32Context(), "nextminus", "1", "", "0.9999999", big.Exact},
+		{NewDecimal32Context(), "nextplus", "9.999999E+96", "", "Inf", big.Exact},
+		{NewDecimal32Context(), "nexttoward", "1", "0", "0.9999999", big.Exact},
+		{NewDecimal32Context(), "nexttoward", "0", "1", "1E-101", big.Above},
+		{NewDecimal32Context(), "max", "1.0", "1.00", "1.0", big.Exact},
+		{NewDecimal32Context(), "min", "1.0", "1.00", "1.00", big.Exact},
+		{NewDecimal32Context(), "max", "1.23456789", "NaN", "1.234568", big.Above},
+		{NewDecimal32Context(), "maxmag", "-2", "1", "-2", big.Exact},
+		{NewDecimal32Context(), "minmag", "-2", "1", "1", big.Exact},
+		{NewDecimal32Context(), "and", "1100", "1010", "1000", big.Exact},
+		{NewDecimal32Context(), "or", "1100", "1010", "1110", big.Exact},
+		{NewDecimal32Context(), "xor", "1100", "1010", "110", big.Exact},
+		{NewDecimal32Context(), "invert", "101", "", "1111010", big.Exact},
+		{NewDecimal32Context(), "and", "12", "10", "NaN", big.Exact},
+		{NewDecimal32Context(), "shift", "1234567", "2", "3456700", big.Exact},
+		{NewDecimal32Context(), "shift", "1234567", "-2", "12345", big.Exact},
+		{NewDecimal32Context(), "rotate", "1234567", "2", "3456712", big.Exact},
+		{NewDecimal32Context(), "rotate", "1234567", "-2", "6712345", big.Exact},
+		{NewDecimal32Context(), "scaleb", "12.34", "2", "1234", big.Exact},
+		{NewDecimal32Context(), "scaleb", "1.5", "-2", "0.015", big.Exact},
+		{NewDecimal32Context(), "scaleb", "1E+90", "10", "Inf", big.Above},
+		{NewDecimal32Context(), "logb", "0.0123", "", "-2", big.Exact},
+	} {
+		x := new(Decimal)
+		if _, ok := x.SetString(test.x); !ok {
+			t.Errorf("#%d: failed to parse %s", i, test.x)
+			continue
+		}
+		y := new(Decimal)
+		if test.y != "" {
+			if _, ok := y.SetString(test.y); !ok {
+				t.Errorf("#%d: failed to parse %s", i, test.y)
+				continue
+			}
+		}
+
+		z := new(Decimal)
+		var r *Decimal
+		switch test.op {
+		case "add":
+			r = test.ctx.Add(z, x, y)
+		case "sub":
+			r = test.ctx.Sub(z, x, y)
+		case "mul":
+			r = test.ctx.Mul(z, x, y)
+		case "quo":
+			r = test.ctx.Quo(z, x, y)
+		case "round":
+			r = test.ctx.Round(z, x)
+		case "sqrt":
+			r = test.ctx.Sqrt(z, x)
+		case "exp":
+			r = test.ctx.Exp(z, x)
+		case "ln":
+			r = test.ctx.Ln(z, x)
+		case "log10":
+			r = test.ctx.Log10(z, x)
+		case "pow":
+			r = test.ctx.Pow(z, x, y)
+		case "quantize":
+			r = test.ctx.Quantize(z, x, y)
+		case "reduce":
+			r = test.ctx.Reduce(z, x)
+		case "tointegral":
+			r = test.ctx.ToIntegral(z, x)
+		case "tointegralx":
+			r = test.ctx.ToIntegralExact(z, x)
+		case "nextplus":
+			r = test.ctx.NextPlus(z, x)
+		case "nextminus":
+			r = test.ctx.NextMinus(z, x)
+		case "nexttoward":
+			r = test.ctx.NextToward(z, x, y)
+		case "max":
+			r = test.ctx.Max(z, x, y)
+		case "min":
+			r = test.ctx.Min(z, x, y)
+		case "maxmag":
+			r = test.ctx.MaxMag(z, x, y)
+		case "minmag":
+			r = test.ctx.MinMag(z, x, y)
+		case "and":
+			r = test.ctx.And(z, x, y)
+		case "or":
+			r = test.ctx.Or(z, x, y)
+		case "xor":
+			r = test.ctx.Xor(z, x, y)
+		case "invert":
+			r = test.ctx.Invert(z, x)
+		case "shift":
+			n, _ := strconv.Atoi(test.y)
+			r = test.ctx.Shift(z, x, n)
+		case "rotate":
+			n, _ := strconv.Atoi(test.y)
+			r = test.ctx.Rotate(z, x, n)
+		case "scaleb":
+			n, _ := strconv.Atoi(test.y)
+			r = test.ctx.ScaleB(z, x, n)
+		case "logb":
+			r = test.ctx.LogB(z, x)
+		}
+		if r != z {
+			t.Errorf("#%d: return value got: %p want: %p", i, r, z)
+		}
+		if s := z.String(); s != test.out {
+			t.Errorf("#%d: %s(%s, %s) got: %s want: %s", i, test.op, test.x, test.y, s, test.out)
+		}
+		if z.Acc() != test.acc {
+			t.Errorf("#%d: %s(%s, %s) accuracy got: %s want: %s", i, test.op, test.x, test.y, z.Acc(), test.acc)
+		}
+	}
+}
+
+func TestContextFMA(t *testing.T) {
+	for i, test := range []struct {
+		ctx     Context
+		x, y, u string
+		out     string
+		acc     big.Accuracy
+	}{
+		// balance*rate + fee rounded once: 1000.005 would round to 1000.00
+		// before the addition
+		{Context{Prec: 6}, "100.0005", "10", "0.004", "1000.01", big.Above},
+		{Context{Prec: 6, Mode: ToZero}, "100.0005", "10", "0.004", "1000.00", big.Below},
+		{NewDecimal32Context(), "1E+60", "1E+60", "-Inf", "-Inf", big.Exact},
+	} {
+		x, _ := new(Decimal).SetString(test.x)
+		y, _ := new(Decimal).SetString(test.y)
+		u, _ := new(Decimal).SetString(test.u)
+		z := new(Decimal)
+		if r := test.ctx.FMA(z, x, y, u); r != z {
+			t.Errorf("#%d: return value got: %p want: %p", i, r, z)
+		}
+		if s := z.String(); s != test.out {
+			t.Errorf("#%d: FMA(%s, %s, %s) got: %s want: %s", i, test.x, test.y, test.u, s, test.out)
+		}
+		if z.Acc() != test.acc {
+			t.Errorf("#%d: FMA(%s, %s, %s) accuracy got: %s want: %s", i, test.x, test.y, test.u, z.Acc(), test.acc)
+		}
+	}
+}
+
+func TestContextRoundAliased(t *testing.T) {
+	for i, test := range []struct {
+		ctx  Context
+		x    string
+		out  string
+		acc  big.Accuracy
+		cond Condition
+	}{
+		{Context{Prec: 3}, "1.23456", "1.23", big.Below, Inexact | Rounded},
+		{Context{Prec: 3, Mode: ToPositiveInf}, "1.23456", "1.24", big.Above, Inexact | Rounded},
+		{NewDecimal32Context(), "1E-102", "0E-101", big.Below, Subnormal | Underflow | Inexact | Rounded | Clamped},
+		{NewUnlimitedContext(), "1.23456", "1.23456", big.Exact, 0},
+		{Context{Prec: 3}, "NaN", "NaN", big.Exact, 0},
+	} {
+		z, _ := new(Decimal).SetString(test.x)
+		ctx := test.ctx
+		if r := ctx.Round(z, z); r != z {
+			t.Errorf("#%d: return value got: %p want: %p", i, r, z)
+		}
+		if s := z.String(); s != test.out {
+			t.Errorf("#%d: Round(%s) got: %s want: %s", i, test.x, s, test.out)
+		}
+		if z.Acc() != test.acc {
+			t.Errorf("#%d: Round(%s) accuracy got: %s want: %s", i, test.x, z.Acc(), test.acc)
+		}
+		if ctx.Flags != test.cond {
+			t.Errorf("#%d: Round(%s) flags got: %s want: %s", i, test.x, ctx.Flags, test.cond)
+		}
+	}
+}
+
+func TestContextFlags(t *testing.T) {
+	one, _ := new(Decimal).SetString("1")
+	zero, _ := new(Decimal).SetString("0")
+	tiny, _ := new(Decimal).SetString("1E-20")
+	huge, _ := new(Decimal).SetString("1E+300")
+
+	ctx := NewDecimal64Context()
+	if ctx.Flags != 0 {
+		t.Fatalf("initial flags got: %s want: 0", ctx.Flags)
+	}
+	ctx.Add(new(Decimal), one, one)
+	if ctx.Flags != 0 {
+		t.Errorf("flags after exact Add got: %s want: 0", ctx.Flags)
+	}
+	ctx.Add(new(Decimal), one, tiny)
+	if want := Inexact | Rounded; ctx.Flags != want {
+		t.Errorf("flags after inexact Add got: %s want: %s", ctx.Flags, want)
+	}
+	ctx.Quo(new(Decimal), one, zero)
+	if want := Inexact | Rounded | DivisionByZero; ctx.Flags != want {
+		t.Errorf("flags after Quo by zero got: %s want: %s", ctx.Flags, want)
+	}
+	if _, ok := ctx.SetString(new(Decimal), "1..2"); ok {
+		t.Errorf("SetString parsed illegal input")
+	}
+	if ctx.Flags&ConversionSyntax == 0 {
+		t.Errorf("flags after SetString got: %s want: ConversionSyntax", ctx.Flags)
+	}
+	if c := NewDecimal64Context(); c.Flags != 0 {
+		t.Errorf("flags of a new Decimal64 context got: %s want: 0", c.Flags)
+	}
+
+	ctx = NewDecimal64Context()
+	ctx.Traps = Overflow | DivisionByZero
+	z := new(Decimal)
+	func() {
+		defer func() {
+			err, ok := recover().(ErrTrap)
+			if !ok {
+				t.Errorf("Mul did not panic with ErrTrap")
+				return
+			}
+			if err.Cond != Overflow {
+				t.Errorf("trapped condition got: %s want: Overflow", err.Cond)
+			}
+		}()
+		ctx.Mul(z, huge, huge)
+	}()
+	if z.String() != "Inf" {
+		t.Errorf("trapped Mul got: %s want: Inf", z)
+	}
+	if want := Overflow | Inexact | Rounded; ctx.Flags != want {
+		t.Errorf("flags after trapped Mul got: %s want: %s", ctx.Flags, want)
+	}
+
+	ctx = NewDecimal64Context()
+	ctx.Traps = DivisionByZero | InvalidOperation
+	q, r := new(Decimal), new(Decimal)
+	func() {
+		defer func() {
+			err, ok := recover().(ErrTrap)
+			if !ok {
+				t.Errorf("QuoRem did not panic with ErrTrap")
+				return
+			}
+			if want := DivisionByZero | InvalidOperation; err.Cond != want {
+				t.Errorf("trapped condition got: %s want: %s", err.Cond, want)
+			}
+		}()
+		ctx.QuoRem(q, one, zero, r)
+	}()
+	if q.String() != "Inf" || r.String() != "NaN" {
+		t.Errorf("trapped QuoRem got: %s, %s want: Inf, NaN", q, r)
+	}
+	if want := DivisionByZero | InvalidOperation; ctx.Flags != want {
+		t.Errorf("flags after trapped QuoRem got: %s want: %s", ctx.Flags, want)
+	}
+}
+
+func TestConditionString(t *testing.T) {
+	for _, test := range []struct {
+		c   Condition
+		out string
+	}{
+		{0, "0"},
+		{Inexact, "Inexact"},
+		{Inexact | Rounded, "Inexact|Rounded"},
+		{Underflow | Subnormal | Clamped, "Clamped|Subnormal|Underflow"},
+	} {
+		if s := test.c.String(); s != test.out {
+			t.Errorf("%d.String() got: %s want: %s", test.c, s, test.out)
+		}
+	}
+}
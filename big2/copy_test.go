@@ -0,0 +1,116 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var copyTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check
+	// cpyx001 copy       +7.50  -> 7.50
+	{"cpyx001", "+7.50", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// Infinities
+	// cpyx011 copy  Infinity    -> Infinity
+	{"cpyx011", "Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx012 copy  -Infinity   -> -Infinity
+	{"cpyx012", "-Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, 0 payload
+	// cpyx021 copy         NaN  -> NaN
+	{"cpyx021", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx022 copy        -NaN  -> -NaN
+	{"cpyx022", "-NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx023 copy        sNaN  -> sNaN
+	{"cpyx023", "sNaN", "sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx024 copy       -sNaN  -> -sNaN
+	{"cpyx024", "-sNaN", "-sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, non-0 payload
+	// cpyx031 copy       NaN10  -> NaN10
+	{"cpyx031", "NaN10", "NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx032 copy      -NaN10  -> -NaN10
+	{"cpyx032", "-NaN10", "-NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx033 copy      sNaN10  -> sNaN10
+	{"cpyx033", "sNaN10", "sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx034 copy     -sNaN10  -> -sNaN10
+	{"cpyx034", "-sNaN10", "-sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx035 copy       NaN7   -> NaN7
+	{"cpyx035", "NaN7", "NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx036 copy      -NaN7   -> -NaN7
+	{"cpyx036", "-NaN7", "-NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx037 copy      sNaN101 -> sNaN101
+	{"cpyx037", "sNaN101", "sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx038 copy     -sNaN101 -> -sNaN101
+	{"cpyx038", "-sNaN101", "-sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// finites
+	// cpyx101 copy          7   -> 7
+	{"cpyx101", "7", "7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx102 copy         -7   -> -7
+	{"cpyx102", "-7", "-7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx103 copy         75   -> 75
+	{"cpyx103", "75", "75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx104 copy        -75   -> -75
+	{"cpyx104", "-75", "-75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx105 copy       7.50   -> 7.50
+	{"cpyx105", "7.50", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx106 copy      -7.50   -> -7.50
+	{"cpyx106", "-7.50", "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx107 copy       7.500  -> 7.500
+	{"cpyx107", "7.500", "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx108 copy      -7.500  -> -7.500
+	{"cpyx108", "-7.500", "-7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// cpyx111 copy          0   -> 0
+	{"cpyx111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx112 copy         -0   -> -0
+	{"cpyx112", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx113 copy       0E+4   -> 0E+4
+	{"cpyx113", "0E+4", "0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx114 copy      -0E+4   -> -0E+4
+	{"cpyx114", "-0E+4", "-0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx115 copy     0.0000   -> 0.0000
+	{"cpyx115", "0.0000", "0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx116 copy    -0.0000   -> -0.0000
+	{"cpyx116", "-0.0000", "-0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx117 copy      0E-141  -> 0E-141
+	{"cpyx117", "0E-141", "0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx118 copy     -0E-141  -> -0E-141
+	{"cpyx118", "-0E-141", "-0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// full coefficients, alternating bits
+	// cpyx121 copy   268268268        -> 268268268
+	{"cpyx121", "268268268", "268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx122 copy  -268268268        -> -268268268
+	{"cpyx122", "-268268268", "-268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx123 copy   134134134        -> 134134134
+	{"cpyx123", "134134134", "134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx124 copy  -134134134        -> -134134134
+	{"cpyx124", "-134134134", "-134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// cpyx131 copy  9.99999999E+999   -> 9.99999999E+999
+	{"cpyx131", "9.99999999E+999", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx132 copy  1E-999            -> 1E-999
+	{"cpyx132", "1E-999", "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx133 copy  1.00000000E-999   -> 1.00000000E-999
+	{"cpyx133", "1.00000000E-999", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx134 copy  1E-1007           -> 1E-1007
+	{"cpyx134", "1E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx135 copy  -1E-1007          -> -1E-1007
+	{"cpyx135", "-1E-1007", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx136 copy  -1.00000000E-999  -> -1.00000000E-999
+	{"cpyx136", "-1.00000000E-999", "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx137 copy  -1E-999           -> -1E-999
+	{"cpyx137", "-1E-999", "-1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpyx138 copy  -9.99999999E+999  -> -9.99999999E+999
+	{"cpyx138", "-9.99999999E+999", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+}
@@ -0,0 +1,116 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var copyabsTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check
+	// cpax001 copyabs       +7.50  -> 7.50
+	{"cpax001", "+7.50", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// Infinities
+	// cpax011 copyabs  Infinity    -> Infinity
+	{"cpax011", "Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax012 copyabs  -Infinity   -> Infinity
+	{"cpax012", "-Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, 0 payload
+	// cpax021 copyabs         NaN  -> NaN
+	{"cpax021", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax022 copyabs        -NaN  -> NaN
+	{"cpax022", "-NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax023 copyabs        sNaN  -> sNaN
+	{"cpax023", "sNaN", "sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax024 copyabs       -sNaN  -> sNaN
+	{"cpax024", "-sNaN", "sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, non-0 payload
+	// cpax031 copyabs       NaN10  -> NaN10
+	{"cpax031", "NaN10", "NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax032 copyabs      -NaN15  -> NaN15
+	{"cpax032", "-NaN15", "NaN15", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax033 copyabs      sNaN15  -> sNaN15
+	{"cpax033", "sNaN15", "sNaN15", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax034 copyabs     -sNaN10  -> sNaN10
+	{"cpax034", "-sNaN10", "sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax035 copyabs       NaN7   -> NaN7
+	{"cpax035", "NaN7", "NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax036 copyabs      -NaN7   -> NaN7
+	{"cpax036", "-NaN7", "NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax037 copyabs      sNaN101 -> sNaN101
+	{"cpax037", "sNaN101", "sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax038 copyabs     -sNaN101 -> sNaN101
+	{"cpax038", "-sNaN101", "sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// finites
+	// cpax101 copyabs          7   -> 7
+	{"cpax101", "7", "7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax102 copyabs         -7   -> 7
+	{"cpax102", "-7", "7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax103 copyabs         75   -> 75
+	{"cpax103", "75", "75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax104 copyabs        -75   -> 75
+	{"cpax104", "-75", "75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax105 copyabs       7.10   -> 7.10
+	{"cpax105", "7.10", "7.10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax106 copyabs      -7.10   -> 7.10
+	{"cpax106", "-7.10", "7.10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax107 copyabs       7.500  -> 7.500
+	{"cpax107", "7.500", "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax108 copyabs      -7.500  -> 7.500
+	{"cpax108", "-7.500", "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// cpax111 copyabs          0   -> 0
+	{"cpax111", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax112 copyabs         -0   -> 0
+	{"cpax112", "-0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax113 copyabs       0E+6   -> 0E+6
+	{"cpax113", "0E+6", "0E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax114 copyabs      -0E+6   -> 0E+6
+	{"cpax114", "-0E+6", "0E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax115 copyabs     0.0000   -> 0.0000
+	{"cpax115", "0.0000", "0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax116 copyabs    -0.0000   -> 0.0000
+	{"cpax116", "-0.0000", "0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax117 copyabs      0E-141  -> 0E-141
+	{"cpax117", "0E-141", "0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax118 copyabs     -0E-141  -> 0E-141
+	{"cpax118", "-0E-141", "0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// full coefficients, alternating bits
+	// cpax121 copyabs   268268268        -> 268268268
+	{"cpax121", "268268268", "268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax122 copyabs  -268268268        -> 268268268
+	{"cpax122", "-268268268", "268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax123 copyabs   134134134        -> 134134134
+	{"cpax123", "134134134", "134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax124 copyabs  -134134134        -> 134134134
+	{"cpax124", "-134134134", "134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// cpax131 copyabs  9.99999999E+999   -> 9.99999999E+999
+	{"cpax131", "9.99999999E+999", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax132 copyabs  1E-999            -> 1E-999
+	{"cpax132", "1E-999", "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax133 copyabs  1.00000000E-999   -> 1.00000000E-999
+	{"cpax133", "1.00000000E-999", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax134 copyabs  1E-1007           -> 1E-1007
+	{"cpax134", "1E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax135 copyabs  -1E-1007          -> 1E-1007
+	{"cpax135", "-1E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax136 copyabs  -1.00000000E-999  -> 1.00000000E-999
+	{"cpax136", "-1.00000000E-999", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax137 copyabs  -1E-999           -> 1E-999
+	{"cpax137", "-1E-999", "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpax199 copyabs  -9.99999999E+999  -> 9.99999999E+999
+	{"cpax199", "-9.99999999E+999", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+}
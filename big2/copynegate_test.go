@@ -0,0 +1,116 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var copynegateTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check
+	// cpnx001 copynegate       +7.50  -> -7.50
+	{"cpnx001", "+7.50", "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// Infinities
+	// cpnx011 copynegate  Infinity    -> -Infinity
+	{"cpnx011", "Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx012 copynegate  -Infinity   -> Infinity
+	{"cpnx012", "-Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, 0 payload
+	// cpnx021 copynegate         NaN  -> -NaN
+	{"cpnx021", "NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx022 copynegate        -NaN  -> NaN
+	{"cpnx022", "-NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx023 copynegate        sNaN  -> -sNaN
+	{"cpnx023", "sNaN", "-sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx024 copynegate       -sNaN  -> sNaN
+	{"cpnx024", "-sNaN", "sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, non-0 payload
+	// cpnx031 copynegate       NaN13  -> -NaN13
+	{"cpnx031", "NaN13", "-NaN13", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx032 copynegate      -NaN13  -> NaN13
+	{"cpnx032", "-NaN13", "NaN13", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx033 copynegate      sNaN13  -> -sNaN13
+	{"cpnx033", "sNaN13", "-sNaN13", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx034 copynegate     -sNaN13  -> sNaN13
+	{"cpnx034", "-sNaN13", "sNaN13", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx035 copynegate       NaN70  -> -NaN70
+	{"cpnx035", "NaN70", "-NaN70", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx036 copynegate      -NaN70  -> NaN70
+	{"cpnx036", "-NaN70", "NaN70", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx037 copynegate      sNaN101 -> -sNaN101
+	{"cpnx037", "sNaN101", "-sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx038 copynegate     -sNaN101 -> sNaN101
+	{"cpnx038", "-sNaN101", "sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// finites
+	// cpnx101 copynegate          7   -> -7
+	{"cpnx101", "7", "-7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx102 copynegate         -7   -> 7
+	{"cpnx102", "-7", "7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx103 copynegate         75   -> -75
+	{"cpnx103", "75", "-75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx104 copynegate        -75   -> 75
+	{"cpnx104", "-75", "75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx105 copynegate       7.50   -> -7.50
+	{"cpnx105", "7.50", "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx106 copynegate      -7.50   -> 7.50
+	{"cpnx106", "-7.50", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx107 copynegate       7.500  -> -7.500
+	{"cpnx107", "7.500", "-7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx108 copynegate      -7.500  -> 7.500
+	{"cpnx108", "-7.500", "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// cpnx111 copynegate          0   -> -0
+	{"cpnx111", "0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx112 copynegate         -0   -> 0
+	{"cpnx112", "-0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx113 copynegate       0E+4   -> -0E+4
+	{"cpnx113", "0E+4", "-0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx114 copynegate      -0E+4   -> 0E+4
+	{"cpnx114", "-0E+4", "0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx115 copynegate     0.0000   -> -0.0000
+	{"cpnx115", "0.0000", "-0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx116 copynegate    -0.0000   -> 0.0000
+	{"cpnx116", "-0.0000", "0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx117 copynegate      0E-141  -> -0E-141
+	{"cpnx117", "0E-141", "-0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx118 copynegate     -0E-141  -> 0E-141
+	{"cpnx118", "-0E-141", "0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// full coefficients, alternating bits
+	// cpnx121 copynegate  268268268         -> -268268268
+	{"cpnx121", "268268268", "-268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx122 copynegate  -268268268        -> 268268268
+	{"cpnx122", "-268268268", "268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx123 copynegate  134134134         -> -134134134
+	{"cpnx123", "134134134", "-134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx124 copynegate  -134134134        -> 134134134
+	{"cpnx124", "-134134134", "134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// cpnx131 copynegate  9.99999999E+999   -> -9.99999999E+999
+	{"cpnx131", "9.99999999E+999", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx132 copynegate  1E-999                     -> -1E-999
+	{"cpnx132", "1E-999", "-1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx133 copynegate  1.00000000E-999   -> -1.00000000E-999
+	{"cpnx133", "1.00000000E-999", "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx134 copynegate  1E-1007                    -> -1E-1007
+	{"cpnx134", "1E-1007", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx135 copynegate  -1E-1007                   -> 1E-1007
+	{"cpnx135", "-1E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx136 copynegate  -1.00000000E-999  -> 1.00000000E-999
+	{"cpnx136", "-1.00000000E-999", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx137 copynegate  -1E-999                    -> 1E-999
+	{"cpnx137", "-1E-999", "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpnx138 copynegate  -9.99999999E+999  -> 9.99999999E+999
+	{"cpnx138", "-9.99999999E+999", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+}
@@ -0,0 +1,262 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var copysignTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check, and examples from decArith
+	// cpsx001 copysign   +7.50       11  -> 7.50
+	{"cpsx001", "+7.50", "11", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx002 copysign   '1.50'   '7.33' -> 1.50
+	{"cpsx002", "1.50", "7.33", "1.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx003 copysign  '-1.50'   '7.33' -> 1.50
+	{"cpsx003", "-1.50", "7.33", "1.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx004 copysign   '1.50'  '-7.33' -> -1.50
+	{"cpsx004", "1.50", "-7.33", "-1.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx005 copysign  '-1.50'  '-7.33' -> -1.50
+	{"cpsx005", "-1.50", "-7.33", "-1.50", 0, 9, ToNearestAway, 999, -999, false},
+	// Infinities
+	// cpsx011 copysign  Infinity       11 -> Infinity
+	{"cpsx011", "Inf", "11", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx012 copysign  -Infinity      11 -> Infinity
+	{"cpsx012", "-Inf", "11", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, 0 payload
+	// cpsx021 copysign         NaN     11 -> NaN
+	{"cpsx021", "NaN", "11", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx022 copysign        -NaN     11 -> NaN
+	{"cpsx022", "-NaN", "11", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx023 copysign        sNaN     11 -> sNaN
+	{"cpsx023", "sNaN", "11", "sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx024 copysign       -sNaN     11 -> sNaN
+	{"cpsx024", "-sNaN", "11", "sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, non-0 payload
+	// cpsx031 copysign       NaN10     11 -> NaN10
+	{"cpsx031", "NaN10", "11", "NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx032 copysign      -NaN10     11 -> NaN10
+	{"cpsx032", "-NaN10", "11", "NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx033 copysign      sNaN10     11 -> sNaN10
+	{"cpsx033", "sNaN10", "11", "sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx034 copysign     -sNaN10     11 -> sNaN10
+	{"cpsx034", "-sNaN10", "11", "sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx035 copysign       NaN7      11 -> NaN7
+	{"cpsx035", "NaN7", "11", "NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx036 copysign      -NaN7      11 -> NaN7
+	{"cpsx036", "-NaN7", "11", "NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx037 copysign      sNaN101    11 -> sNaN101
+	{"cpsx037", "sNaN101", "11", "sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx038 copysign     -sNaN101    11 -> sNaN101
+	{"cpsx038", "-sNaN101", "11", "sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// finites
+	// cpsx101 copysign          7      11 -> 7
+	{"cpsx101", "7", "11", "7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx102 copysign         -7      11 -> 7
+	{"cpsx102", "-7", "11", "7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx103 copysign         75      11 -> 75
+	{"cpsx103", "75", "11", "75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx104 copysign        -75      11 -> 75
+	{"cpsx104", "-75", "11", "75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx105 copysign       7.50      11 -> 7.50
+	{"cpsx105", "7.50", "11", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx106 copysign      -7.50      11 -> 7.50
+	{"cpsx106", "-7.50", "11", "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx107 copysign       7.500     11 -> 7.500
+	{"cpsx107", "7.500", "11", "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx108 copysign      -7.500     11 -> 7.500
+	{"cpsx108", "-7.500", "11", "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// cpsx111 copysign          0      11 -> 0
+	{"cpsx111", "0", "11", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx112 copysign         -0      11 -> 0
+	{"cpsx112", "-0", "11", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx113 copysign       0E+4      11 -> 0E+4
+	{"cpsx113", "0E+4", "11", "0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx114 copysign      -0E+4      11 -> 0E+4
+	{"cpsx114", "-0E+4", "11", "0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx115 copysign     0.0000      11 -> 0.0000
+	{"cpsx115", "0.0000", "11", "0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx116 copysign    -0.0000      11 -> 0.0000
+	{"cpsx116", "-0.0000", "11", "0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx117 copysign      0E-141     11 -> 0E-141
+	{"cpsx117", "0E-141", "11", "0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx118 copysign     -0E-141     11 -> 0E-141
+	{"cpsx118", "-0E-141", "11", "0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// full coefficients, alternating bits
+	// cpsx121 copysign   268268268           11 -> 268268268
+	{"cpsx121", "268268268", "11", "268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx122 copysign  -268268268           11 -> 268268268
+	{"cpsx122", "-268268268", "11", "268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx123 copysign   134134134           11 -> 134134134
+	{"cpsx123", "134134134", "11", "134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx124 copysign  -134134134           11 -> 134134134
+	{"cpsx124", "-134134134", "11", "134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// cpsx131 copysign  9.99999999E+999      11 -> 9.99999999E+999
+	{"cpsx131", "9.99999999E+999", "11", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx132 copysign  1E-999               11 -> 1E-999
+	{"cpsx132", "1E-999", "11", "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx133 copysign  1.00000000E-999      11 -> 1.00000000E-999
+	{"cpsx133", "1.00000000E-999", "11", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx134 copysign  1E-1007              11 -> 1E-1007
+	{"cpsx134", "1E-1007", "11", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx135 copysign  -1E-1007             11 -> 1E-1007
+	{"cpsx135", "-1E-1007", "11", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx136 copysign  -1.00000000E-999     11 -> 1.00000000E-999
+	{"cpsx136", "-1.00000000E-999", "11", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx137 copysign  -1E-999              11 -> 1E-999
+	{"cpsx137", "-1E-999", "11", "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx138 copysign  -9.99999999E+999     11 -> 9.99999999E+999
+	{"cpsx138", "-9.99999999E+999", "11", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// repeat with negative RHS
+	// Infinities
+	// cpsx211 copysign  Infinity       -34 -> -Infinity
+	{"cpsx211", "Inf", "-34", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx212 copysign  -Infinity      -34 -> -Infinity
+	{"cpsx212", "-Inf", "-34", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, 0 payload
+	// cpsx221 copysign         NaN     -34 -> -NaN
+	{"cpsx221", "NaN", "-34", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx222 copysign        -NaN     -34 -> -NaN
+	{"cpsx222", "-NaN", "-34", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx223 copysign        sNaN     -34 -> -sNaN
+	{"cpsx223", "sNaN", "-34", "-sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx224 copysign       -sNaN     -34 -> -sNaN
+	{"cpsx224", "-sNaN", "-34", "-sNaN", 0, 9, ToNearestAway, 999, -999, false},
+	// NaNs, non-0 payload
+	// cpsx231 copysign       NaN10     -34 -> -NaN10
+	{"cpsx231", "NaN10", "-34", "-NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx232 copysign      -NaN10     -34 -> -NaN10
+	{"cpsx232", "-NaN10", "-34", "-NaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx233 copysign      sNaN10     -34 -> -sNaN10
+	{"cpsx233", "sNaN10", "-34", "-sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx234 copysign     -sNaN10     -34 -> -sNaN10
+	{"cpsx234", "-sNaN10", "-34", "-sNaN10", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx235 copysign       NaN7      -34 -> -NaN7
+	{"cpsx235", "NaN7", "-34", "-NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx236 copysign      -NaN7      -34 -> -NaN7
+	{"cpsx236", "-NaN7", "-34", "-NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx237 copysign      sNaN101    -34 -> -sNaN101
+	{"cpsx237", "sNaN101", "-34", "-sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx238 copysign     -sNaN101    -34 -> -sNaN101
+	{"cpsx238", "-sNaN101", "-34", "-sNaN101", 0, 9, ToNearestAway, 999, -999, false},
+	// finites
+	// cpsx301 copysign          7      -34 -> -7
+	{"cpsx301", "7", "-34", "-7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx302 copysign         -7      -34 -> -7
+	{"cpsx302", "-7", "-34", "-7", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx303 copysign         75      -34 -> -75
+	{"cpsx303", "75", "-34", "-75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx304 copysign        -75      -34 -> -75
+	{"cpsx304", "-75", "-34", "-75", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx305 copysign       7.50      -34 -> -7.50
+	{"cpsx305", "7.50", "-34", "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx306 copysign      -7.50      -34 -> -7.50
+	{"cpsx306", "-7.50", "-34", "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx307 copysign       7.500     -34 -> -7.500
+	{"cpsx307", "7.500", "-34", "-7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx308 copysign      -7.500     -34 -> -7.500
+	{"cpsx308", "-7.500", "-34", "-7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// cpsx311 copysign          0      -34 -> -0
+	{"cpsx311", "0", "-34", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx312 copysign         -0      -34 -> -0
+	{"cpsx312", "-0", "-34", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx313 copysign       0E+4      -34 -> -0E+4
+	{"cpsx313", "0E+4", "-34", "-0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx314 copysign      -0E+4      -34 -> -0E+4
+	{"cpsx314", "-0E+4", "-34", "-0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx315 copysign     0.0000      -34 -> -0.0000
+	{"cpsx315", "0.0000", "-34", "-0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx316 copysign    -0.0000      -34 -> -0.0000
+	{"cpsx316", "-0.0000", "-34", "-0.0000", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx317 copysign      0E-141     -34 -> -0E-141
+	{"cpsx317", "0E-141", "-34", "-0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx318 copysign     -0E-141     -34 -> -0E-141
+	{"cpsx318", "-0E-141", "-34", "-0E-141", 0, 9, ToNearestAway, 999, -999, false},
+	// full coefficients, alternating bits
+	// cpsx321 copysign   268268268          -18 -> -268268268
+	{"cpsx321", "268268268", "-18", "-268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx322 copysign  -268268268          -18 -> -268268268
+	{"cpsx322", "-268268268", "-18", "-268268268", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx323 copysign   134134134          -18 -> -134134134
+	{"cpsx323", "134134134", "-18", "-134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx324 copysign  -134134134          -18 -> -134134134
+	{"cpsx324", "-134134134", "-18", "-134134134", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// cpsx331 copysign  9.99999999E+999     -18 -> -9.99999999E+999
+	{"cpsx331", "9.99999999E+999", "-18", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx332 copysign  1E-999              -18 -> -1E-999
+	{"cpsx332", "1E-999", "-18", "-1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx333 copysign  1.00000000E-999     -18 -> -1.00000000E-999
+	{"cpsx333", "1.00000000E-999", "-18", "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx334 copysign  1E-1007             -18 -> -1E-1007
+	{"cpsx334", "1E-1007", "-18", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx335 copysign  -1E-1007            -18 -> -1E-1007
+	{"cpsx335", "-1E-1007", "-18", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx336 copysign  -1.00000000E-999    -18 -> -1.00000000E-999
+	{"cpsx336", "-1.00000000E-999", "-18", "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx337 copysign  -1E-999             -18 -> -1E-999
+	{"cpsx337", "-1E-999", "-18", "-1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx338 copysign  -9.99999999E+999    -18 -> -9.99999999E+999
+	{"cpsx338", "-9.99999999E+999", "-18", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// Other kinds of RHS
+	// cpsx401 copysign          701    -34 -> -701
+	{"cpsx401", "701", "-34", "-701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx402 copysign         -720    -34 -> -720
+	{"cpsx402", "-720", "-34", "-720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx403 copysign          701    -0  -> -701
+	{"cpsx403", "701", "-0", "-701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx404 copysign         -720    -0  -> -720
+	{"cpsx404", "-720", "-0", "-720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx405 copysign          701    +0  ->  701
+	{"cpsx405", "701", "+0", "701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx406 copysign         -720    +0  ->  720
+	{"cpsx406", "-720", "+0", "720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx407 copysign          701    +34 ->  701
+	{"cpsx407", "701", "+34", "701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx408 copysign         -720    +34 ->  720
+	{"cpsx408", "-720", "+34", "720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx413 copysign          701    -Inf  -> -701
+	{"cpsx413", "701", "-Inf", "-701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx414 copysign         -720    -Inf  -> -720
+	{"cpsx414", "-720", "-Inf", "-720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx415 copysign          701    +Inf  ->  701
+	{"cpsx415", "701", "+Inf", "701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx416 copysign         -720    +Inf  ->  720
+	{"cpsx416", "-720", "+Inf", "720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx420 copysign          701    -NaN  -> -701
+	{"cpsx420", "701", "-NaN", "-701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx421 copysign         -720    -NaN  -> -720
+	{"cpsx421", "-720", "-NaN", "-720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx422 copysign          701    +NaN  ->  701
+	{"cpsx422", "701", "+NaN", "701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx423 copysign         -720    +NaN  ->  720
+	{"cpsx423", "-720", "+NaN", "720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx425 copysign         -720    +NaN8 ->  720
+	{"cpsx425", "-720", "+NaN8", "720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx426 copysign          701    -sNaN  -> -701
+	{"cpsx426", "701", "-sNaN", "-701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx427 copysign         -720    -sNaN  -> -720
+	{"cpsx427", "-720", "-sNaN", "-720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx428 copysign          701    +sNaN  ->  701
+	{"cpsx428", "701", "+sNaN", "701", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx429 copysign         -720    +sNaN  ->  720
+	{"cpsx429", "-720", "+sNaN", "720", 0, 9, ToNearestAway, 999, -999, false},
+	// cpsx430 copysign         -720    +sNaN3 ->  720
+	{"cpsx430", "-720", "+sNaN3", "720", 0, 9, ToNearestAway, 999, -999, false},
+}
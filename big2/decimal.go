@@ -7,11 +7,13 @@ package big2
 import (
 	"math"
 	"math/big"
+	"strconv"
 )
 
 // TODO: use math/big.ErrNaN
-// An ErrNaN panic is raised by a Float operation that would lead to
-// a NaN under IEEE-754 rules. An ErrNaN implements the error interface.
+// An ErrNaN panic is raised by an operation that cannot represent a NaN
+// result, such as Cmp with a NaN operand. Arithmetic operations set their
+// result to a NaN instead. An ErrNaN implements the error interface.
 type ErrNaN struct {
 	msg string
 }
@@ -55,22 +57,45 @@ func (err ErrNaN) Error() string {
 // Exponent underflow and overflow lead to a 0 or an Infinity for different
 // values than IEEE-754 because Float exponents have a much larger range.
 //
+// A Decimal may also be a quiet NaN or a signaling NaN (sNaN), either of
+// which may carry a payload of diagnostic digits. An operation with a NaN
+// operand sets its result to a quiet NaN with the sign and payload of that
+// operand; a signaling NaN also raises InvalidOperation. An invalid
+// operation, such as Inf - Inf, sets its result to NaN.
+//
 // The zero (uninitialized) value for a Float is ready to use and represents
 // the number +0.0 exactly, with precision 0 and rounding mode ToNearestEven.
 //
 type Decimal struct {
 	// context
 	prec uint32
-	mode big.RoundingMode
+	mode RoundingMode
 	acc  big.Accuracy
+	cond Condition
+	// exponent limits are stored relative to MaxExp and MinExp so that
+	// they are used by default
+	emaxDiff int32 // MaxExp - Emax()
+	eminDiff int32 // Emin() - MinExp
+	clamp    bool
 
 	// value
-	abs   big.Int
+	abs   big.Int // coefficient, or payload of a NaN
 	scale int32
 	neg   bool
-	inf   bool
+	form  form
 }
 
+// A form value describes the kind of a Decimal value. The zero value is
+// a finite number.
+type form byte
+
+const (
+	finite   form = iota
+	infinite      // ±Inf
+	snan          // signaling NaN
+	qnan          // quiet NaN
+)
+
 // TODO: should float64 be default to create decimal? Or string? Or int64
 // TODO: update docs
 // NewFloat allocates and returns a new Float set to x,
@@ -84,13 +109,50 @@ func NewDecimal(x float64) *Decimal {
 	return new(Decimal).SetFloat64(x)
 }
 
-// Exponent and precision limits.
-// TODO: is it ok to use the same limits as big.Float?
-// const (
-// 	MaxExp  = math.MaxInt32  // largest supported exponent
-// 	MinExp  = math.MinInt32  // smallest supported exponent
-// 	MaxPrec = math.MaxUint32 // largest (theoretically) supported precision; likely memory-limited
-// )
+// Exponent limits. A Decimal uses them if its Emax or Emin are not set.
+// They are chosen so that Etiny = Emin - (prec - 1) can be represented as
+// a scale for any reasonable precision.
+const (
+	MaxExp = math.MaxInt32 / 2 // largest supported exponent
+	MinExp = -MaxExp           // smallest supported exponent
+	// TODO: MaxPrec?
+)
+
+// RoundingMode determines how a Decimal value is rounded to the desired
+// precision. Rounding may change the Decimal value; the rounding error is
+// described by the Decimal's Accuracy. The first six modes have the same
+// meaning and values as the corresponding big.RoundingMode constants.
+type RoundingMode byte
+
+// These constants define supported rounding modes.
+const (
+	ToNearestEven RoundingMode = iota // == IEEE 754-2008 roundTiesToEven
+	ToNearestAway                     // == IEEE 754-2008 roundTiesToAway
+	ToZero                            // == IEEE 754-2008 roundTowardZero
+	AwayFromZero                      // no IEEE 754-2008 equivalent
+	ToNegativeInf                     // == IEEE 754-2008 roundTowardNegative
+	ToPositiveInf                     // == IEEE 754-2008 roundTowardPositive
+	ToNearestZero                     // ties toward zero (half-down)
+	ZeroFiveUp                        // toward zero, away if the last digit would be 0 or 5 (05up)
+)
+
+var roundingModeNames = [...]string{
+	"ToNearestEven",
+	"ToNearestAway",
+	"ToZero",
+	"AwayFromZero",
+	"ToNegativeInf",
+	"ToPositiveInf",
+	"ToNearestZero",
+	"ZeroFiveUp",
+}
+
+func (mode RoundingMode) String() string {
+	if int(mode) < len(roundingModeNames) {
+		return roundingModeNames[mode]
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
 
 // TODO: update docs
 // SetPrec sets z's precision to prec and returns the (possibly) rounded
@@ -108,9 +170,10 @@ func (z *Decimal) SetPrec(prec uint) *Decimal {
 // SetMode sets z's rounding mode to mode and returns an exact z.
 // z remains unchanged otherwise.
 // z.SetMode(z.Mode()) is a cheap way to set z's accuracy to Exact.
-func (z *Decimal) SetMode(mode big.RoundingMode) *Decimal {
+func (z *Decimal) SetMode(mode RoundingMode) *Decimal {
 	z.mode = mode
 	z.acc = big.Exact
+	z.cond = 0
 	return z
 }
 
@@ -130,8 +193,63 @@ func (x *Decimal) MinPrec() uint {
 	return x.Prec()
 }
 
+// SetEmax sets z's maximum exponent to emax and returns z. A result whose
+// adjusted exponent (the exponent of its most significant digit) is greater
+// than Emax overflows: it is set to ±Inf or to the largest finite number
+// depending on the rounding mode. If emax < 0, it is set to 0; if
+// emax > MaxExp, it is set to MaxExp. z remains unchanged otherwise.
+func (z *Decimal) SetEmax(emax int) *Decimal {
+	if emax < 0 {
+		emax = 0
+	} else if emax > MaxExp {
+		emax = MaxExp
+	}
+	z.emaxDiff = int32(MaxExp - emax)
+	return z
+}
+
+// SetEmin sets z's minimum exponent to emin and returns z. A result whose
+// adjusted exponent is less than Emin is subnormal: it is rounded so that its
+// exponent is not less than Etiny = Emin - (Prec() - 1). If emin > 0, it is
+// set to 0; if emin < MinExp, it is set to MinExp. z remains unchanged
+// otherwise.
+func (z *Decimal) SetEmin(emin int) *Decimal {
+	if emin > 0 {
+		emin = 0
+	} else if emin < MinExp {
+		emin = MinExp
+	}
+	z.eminDiff = int32(emin - MinExp)
+	return z
+}
+
+// Emax returns the maximum exponent of x. The default is MaxExp.
+func (x *Decimal) Emax() int {
+	return MaxExp - int(x.emaxDiff)
+}
+
+// Emin returns the minimum exponent of x. The default is MinExp.
+func (x *Decimal) Emin() int {
+	return MinExp + int(x.eminDiff)
+}
+
+// SetClamp sets z's clamping flag and returns z. If clamp is set, the
+// exponent of a finite result is limited to Emax - (Prec() - 1), as in the
+// IEEE 754 interchange formats. A result with a larger exponent is padded
+// with trailing zeros (fold-down) so that its value remains unchanged.
+// z remains unchanged otherwise.
+func (z *Decimal) SetClamp(clamp bool) *Decimal {
+	z.clamp = clamp
+	return z
+}
+
+// Clamp reports whether the clamping flag of x is set.
+func (x *Decimal) Clamp() bool {
+	return x.clamp
+}
+
 // Mode returns the rounding mode of x.
-func (x *Decimal) Mode() big.RoundingMode {
+func (x *Decimal) Mode() RoundingMode {
 	return x.mode
 }
 
@@ -140,15 +258,29 @@ func (x *Decimal) Acc() big.Accuracy {
 	return x.acc
 }
 
-// TODO: update docs (±0?)
+// Conditions returns the conditions raised by the most recent operation
+// that produced x.
+func (x *Decimal) Conditions() Condition {
+	return x.cond
+}
+
 // Sign returns:
 //
 //	-1 if x <   0
 //	 0 if x is ±0
 //	+1 if x >   0
 //
+// Sign panics with ErrNaN if x is a NaN.
 func (x *Decimal) Sign() int {
-	// TODO: implement
+	if x.IsNaN() {
+		panic(ErrNaN{"sign of NaN"})
+	}
+	if x.isZero() {
+		return 0
+	}
+	if x.neg {
+		return -1
+	}
 	return 1
 }
 
@@ -213,23 +345,103 @@ func (x *Decimal) Scale() int32 {
 	return x.scale
 }
 
-// Signbit returns true if x is negative or negative zero.
+// Exponent returns the exponent of x, i.e. -x.Scale(), so that the value
+// of a finite x is x.Unscaled() × 10**x.Exponent(). The result is 0 if x is
+// an infinity or a NaN.
+func (x *Decimal) Exponent() int {
+	if x.form != finite {
+		return 0
+	}
+	return -int(x.scale)
+}
+
+// SameQuantum reports whether x and y have the same exponent. Two
+// infinities or two NaNs (quiet or signaling) have the same quantum, an
+// infinity or a NaN and a finite number do not. No conditions are raised.
+func (x *Decimal) SameQuantum(y *Decimal) bool {
+	if x.IsNaN() || y.IsNaN() {
+		return x.IsNaN() && y.IsNaN()
+	}
+	if x.form == infinite || y.form == infinite {
+		return x.form == y.form
+	}
+	return x.scale == y.scale
+}
+
+// Signbit returns true if x is negative or negative zero. The sign of a NaN
+// is reported as well.
 func (x *Decimal) Signbit() bool {
-	// TODO: implement
-	return true
+	return x.neg
 }
 
 // IsInf reports whether x is +Inf or -Inf.
 func (x *Decimal) IsInf() bool {
-	// TODO: implement
-	return false
+	return x.form == infinite
+}
+
+// IsNaN reports whether x is a quiet or signaling NaN.
+func (x *Decimal) IsNaN() bool {
+	return x.form == qnan || x.form == snan
+}
+
+// IsSNaN reports whether x is a signaling NaN.
+func (x *Decimal) IsSNaN() bool {
+	return x.form == snan
 }
 
 // IsInt reports whether x is an integer.
 // ±Inf values are not integers.
 func (x *Decimal) IsInt() bool {
-	// TODO: implement
-	return false
+	return x.isInteger()
+}
+
+// IsFinite reports whether x is neither an infinity nor a NaN.
+func (x *Decimal) IsFinite() bool {
+	return x.form == finite
+}
+
+// IsZero reports whether x is +0 or -0.
+func (x *Decimal) IsZero() bool {
+	return x.isZero()
+}
+
+// IsNormal reports whether x is a finite non-zero number with an adjusted
+// exponent that is not less than Emin().
+func (x *Decimal) IsNormal() bool {
+	return x.form == finite && !x.isZero() && x.adjExp() >= int64(x.Emin())
+}
+
+// IsSubnormal reports whether x is a finite non-zero number with an
+// adjusted exponent less than Emin().
+func (x *Decimal) IsSubnormal() bool {
+	return x.form == finite && !x.isZero() && x.adjExp() < int64(x.Emin())
+}
+
+// Class returns the class of x. Whether a finite number is normal or
+// subnormal depends on x's Emin().
+func (x *Decimal) Class() Class {
+	switch x.form {
+	case snan:
+		return SignalingNaN
+	case qnan:
+		return QuietNaN
+	}
+	var c Class
+	switch {
+	case x.form == infinite:
+		c = PositiveInfinity
+	case x.isZero():
+		c = PositiveZero
+	case x.adjExp() < int64(x.Emin()):
+		c = PositiveSubnormal
+	default:
+		c = PositiveNormal
+	}
+	if x.neg {
+		// the negative classes are ordered in reverse
+		c = NegativeZero - (c - PositiveZero)
+	}
+	return c
 }
 
 // TODO: update docs
@@ -294,30 +506,47 @@ func (z *Decimal) SetInf(signbit bool) *Decimal {
 // mode; and z's accuracy reports the result error relative to the
 // exact (not rounded) result.
 func (z *Decimal) Set(x *Decimal) *Decimal {
-	// TODO: accuracy
+	if x.IsNaN() {
+		z.acc = big.Exact
+		z.cond = 0
+		z.nan(x, nil)
+		return z
+	}
 	if z != x {
+		z.acc = big.Exact
+		z.cond = 0
 		z.neg = x.neg
-		z.inf = x.inf
-		if !x.inf {
+		z.form = x.form
+		if x.form != infinite {
 			z.scale = x.scale
 			z.abs.Set(&x.abs)
 		}
-		z.acc = big.Exact
 		if z.prec == 0 {
 			z.prec = x.prec
-		} else if z.prec < x.prec {
-			z.round()
+			if z.prec == 0 {
+				// uninitialized x
+				z.prec = x.actualPrec()
+			}
 		}
+		z.round()
 	}
 	return z
 }
 
-// TODO: update docsc
-// Copy sets z to x, with the same precision, rounding mode, and
-// accuracy as x, and returns z. x is not changed even if z and
-// x are the same.
+// Copy sets z to x, with the same precision, rounding mode, exponent
+// limits, clamping, accuracy and conditions as x, and returns z. x is not
+// changed even if z and x are the same.
 func (z *Decimal) Copy(x *Decimal) *Decimal {
-	// TODO: implement
+	if z != x {
+		z.prec = x.prec
+		z.mode = x.mode
+		z.acc = x.acc
+		z.cond = x.cond
+		z.emaxDiff = x.emaxDiff
+		z.eminDiff = x.eminDiff
+		z.clamp = x.clamp
+		z.setValue(x)
+	}
 	return z
 }
 
@@ -389,21 +618,80 @@ func (x *Decimal) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
 }
 
 // Abs sets z to the (possibly rounded) value |x| (the absolute value of x)
-// and returns z.
+// and returns z. The sign of a NaN is not changed.
 func (z *Decimal) Abs(x *Decimal) *Decimal {
-	z.Set(x)
-	z.neg = false
-	return z
+	return z.setNeg(x, false)
 }
 
 // Neg sets z to the (possibly rounded) value of x with its sign negated,
-// and returns z.
+// and returns z. The sign of a NaN is not changed.
 func (z *Decimal) Neg(x *Decimal) *Decimal {
-	z.Set(x)
-	z.neg = !x.neg
+	return z.setNeg(x, !x.neg)
+}
+
+// setNeg sets z to the rounded value of x with the sign neg and returns z.
+// The sign is set before rounding so that directed rounding modes and the
+// accuracy apply to the result. NaN handling is as for Set.
+func (z *Decimal) setNeg(x *Decimal, neg bool) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	if z.prec == 0 {
+		z.prec = x.prec
+		if z.prec == 0 {
+			// uninitialized x
+			z.prec = x.actualPrec()
+		}
+	}
+	z.setValue(x)
+	z.neg = neg
+	z.round()
+	return z
+}
+
+// CopyAbs sets z to |x| (the absolute value of x) and returns z. Unlike
+// Abs, the result is never rounded and no conditions are raised; z's
+// precision, rounding mode and exponent limits are not changed. The sign of
+// a NaN is cleared and a signaling NaN is copied as it is.
+func (z *Decimal) CopyAbs(x *Decimal) *Decimal {
+	return z.copySign(x, false)
+}
+
+// CopyNeg sets z to x with its sign negated and returns z. The sign of a
+// NaN is negated as well. See CopyAbs.
+func (z *Decimal) CopyNeg(x *Decimal) *Decimal {
+	return z.copySign(x, !x.neg)
+}
+
+// CopySign sets z to x with the sign of y and returns z. y may be a NaN or
+// an infinity. See CopyAbs.
+func (z *Decimal) CopySign(x, y *Decimal) *Decimal {
+	return z.copySign(x, y.neg)
+}
+
+// copySign sets z to x with the sign neg and returns z.
+func (z *Decimal) copySign(x *Decimal, neg bool) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+	z.setValue(x)
+	z.neg = neg
 	return z
 }
 
+// setValue sets the form, sign, coefficient (or NaN payload) and scale of z
+// to those of x without rounding.
+func (z *Decimal) setValue(x *Decimal) {
+	if z != x {
+		z.form = x.form
+		z.neg = x.neg
+		z.scale = x.scale
+		z.abs.Set(&x.abs)
+	}
+}
+
 // TODO: update docs
 // Handling of sign bit as defined by IEEE 754-2008, section 6.3:
 //
@@ -424,46 +712,92 @@ func (z *Decimal) Neg(x *Decimal) *Decimal {
 //
 // See also: https://play.golang.org/p/RtH3UCt5IH
 
-// add sets z to the unrounded sum x+y and returns z. x and y must
-// be non-zero finite numbers
-// TODO: better docs
-// TODO: should 0 be a special case?
-func (z *Decimal) add(x *Decimal, y *Decimal) {
-	sdiff := int64(x.scale) - int64(y.scale)
-	if sdiff > 10000 || sdiff < -10000 {
-		// TODO: implement
-		return
-	}
-
-	xa := &x.abs
-	ya := &y.abs
-	// TODO: think if casting to int below is safe
-	z.scale = x.scale
-	if sdiff < 0 {
-		// re-scale x
-		xa = mulPow10(xa, -int(sdiff))
-		z.scale = y.scale
-	} else if sdiff > 0 {
-		// re-scale y
-		ya = mulPow10(ya, int(sdiff))
-		z.scale = x.scale
-	}
+// add sets z to the sum x+y of finite numbers x and y, where the sign of y is
+// replaced with yneg. If z's precision is not 0 an operand that is too small
+// to affect the sum other than by rounding it is replaced by a smaller number
+// with the same effect, so that the exponents of x and y can be arbitrarily
+// far apart.
+func (z *Decimal) add(x *Decimal, y *Decimal, yneg bool) {
+	xneg := x.neg
+	xa, xs := &x.abs, int64(x.scale)
+	ya, ys := &y.abs, int64(y.scale)
 
-	if x.neg == y.neg {
-		z.abs.Add(xa, ya)
-		z.neg = x.neg
+	if x.isZero() && y.isZero() {
+		// the ideal exponent is the smaller one
+		if ys > xs {
+			xs = ys
+		}
+		z.abs.SetInt64(0)
 	} else {
-		if y.neg {
-			z.abs.Sub(xa, ya)
+		if z.prec != 0 {
+			if x.isZero() || !y.isZero() && x.adjExp() < y.adjExp() {
+				ya, ys, xa, xs = sticky(ya, ys, xa, xs, z.prec)
+			} else {
+				xa, xs, ya, ys = sticky(xa, xs, ya, ys, z.prec)
+			}
+		}
+
+		// TODO: think if casting to int below is safe
+		if sdiff := xs - ys; sdiff < 0 {
+			// re-scale x
+			xa = mulPow10(xa, -int(sdiff))
+			xs = ys
+		} else if sdiff > 0 {
+			// re-scale y
+			ya = mulPow10(ya, int(sdiff))
+		}
+
+		if xneg == yneg {
+			z.abs.Add(xa, ya)
 		} else {
-			z.abs.Sub(ya, xa)
+			if yneg {
+				z.abs.Sub(xa, ya)
+			} else {
+				z.abs.Sub(ya, xa)
+			}
+			xneg = false
+			if z.abs.Sign() < 0 {
+				xneg = true
+				z.abs.Neg(&z.abs)
+			}
 		}
-		z.neg = false
-		if z.abs.Sign() < 0 {
-			z.neg = true
-			z.abs.Neg(&z.abs)
+	}
+
+	z.setScale(xs)
+	z.neg = xneg
+	if z.abs.Sign() == 0 && x.neg != yneg {
+		// the sum of two operands with opposite signs is +0 (or -0 when
+		// rounding towards -Inf)
+		z.neg = z.mode == ToNegativeInf
+	}
+}
+
+// sticky returns a (possibly replaced) operand b of a sum a+b, where a is a
+// non-zero number with a larger adjusted exponent than b or b is zero. If b
+// is too small to affect the digits of a sum rounded to prec digits it is
+// replaced with a smaller number that has the same effect on rounding, i.e.
+// a non-zero number below the rounding digit. a is returned unchanged.
+func sticky(a *big.Int, ascale int64, b *big.Int, bscale int64, prec uint32) (*big.Int, int64, *big.Int, int64) {
+	adj := int64(len(a.String())) - 1 - ascale
+	// the exponent of the least significant digit of the result that is
+	// still relevant for rounding
+	low := adj - int64(prec)
+	if -ascale < low {
+		low = -ascale
+	}
+	if b.Sign() == 0 {
+		if -bscale < low {
+			bscale = -low
+		} else if bscale < ascale {
+			// the exponent of the sum is the smaller one
+			bscale = ascale
 		}
+		return a, ascale, b, bscale
+	}
+	if int64(len(b.String()))-1-bscale < low-1 {
+		return a, ascale, big.NewInt(1), -(low - 2)
 	}
+	return a, ascale, b, bscale
 }
 
 // TODO: update docs
@@ -471,145 +805,1225 @@ func (z *Decimal) add(x *Decimal, y *Decimal) {
 // it is changed to the larger of x's or y's precision before the operation.
 // Rounding is performed according to z's precision and rounding mode; and
 // z's accuracy reports the result error relative to the exact (not rounded)
-// result. Add panics with ErrNaN if x and y are infinities with opposite
-// signs. The value of z is undefined in that case.
+// result. If x or y is a NaN, z is set to a NaN as described for Decimal. If
+// x and y are infinities with opposite signs, z is set to NaN and
+// InvalidOperation is raised.
 //
 // TODO: implement properly
 func (z *Decimal) Add(x, y *Decimal) *Decimal {
+	return z.addSub(x, y, y.neg)
+}
+
+// TODO: update docs
+// Sub sets z to the rounded difference x-y and returns z.
+// Precision, rounding, accuracy reporting and NaN handling are as for Add.
+// If x and y are infinities with equal signs, z is set to NaN and
+// InvalidOperation is raised.
+func (z *Decimal) Sub(x, y *Decimal) *Decimal {
+	return z.addSub(x, y, !y.neg)
+}
+
+// addSub sets z to the rounded sum x+y, where the sign of y is replaced with
+// yneg, and returns z.
+func (z *Decimal) addSub(x, y *Decimal, yneg bool) *Decimal {
 	z.acc = big.Exact
+	z.cond = 0
 
-	if x.inf && y.inf && x.neg != y.neg {
-		panic(ErrNaN{"addition of infinities with opposite signs"})
+	if z.nan(x, y) {
+		return z
+	}
+	// +Inf + (-Inf) or -Inf + (+Inf)
+	if x.form == infinite && y.form == infinite && x.neg != yneg {
+		return z.setNaN(InvalidOperation)
 	}
-
 	// +Inf + y = +Inf or -Inf + y = -Inf
-	if x.inf {
-		z.inf = true
+	if x.form == infinite {
+		z.form = infinite
 		z.neg = x.neg
 		return z
 	}
 	// x + +Inf = +Inf or x + (-Inf) = -Inf
-	if y.inf {
-		z.inf = true
-		z.neg = y.neg
+	if y.form == infinite {
+		z.form = infinite
+		z.neg = yneg
 		return z
 	}
 
-	// TODO: 0 + -0, -0 + -0, -0 + 0
-	if x.isZero() {
-		// 0 + y = y
-		z.neg = y.neg
-		z.scale = y.scale
-		z.abs.Set(&y.abs)
-	} else if y.isZero() {
-		// x + 0 = x
-		z.neg = x.neg
-		z.scale = x.scale
-		z.abs.Set(&x.abs)
-	} else {
-		z.add(x, y)
-	}
+	z.form = finite
+	z.add(x, y, yneg)
 
 	if z.prec == 0 {
 		// TODO: optimize using max(x.prec, y.prec)+1? 99+1=100
 		// TODO: what if x.prec = 0 and/or y.prec = 0?
 		// TODO: do we need to set prec here at all?
-		// TODO: round after setting prec?
 		z.prec = z.actualPrec()
 		if x.prec > z.prec {
 			z.prec = x.prec
 		}
 		if y.prec > z.prec {
-			z.prec = x.prec
+			z.prec = y.prec
 		}
-	} else {
-		z.round()
 	}
+	z.round()
 	return z
 }
 
-// TODO: update docs
-// Sub sets z to the rounded difference x-y and returns z.
-// Precision, rounding, and accuracy reporting are as for Add.
-// Sub panics with ErrNaN if x and y are infinities with equal
-// signs. The value of z is undefined in that case.
-func (z *Decimal) Sub(x, y *Decimal) *Decimal {
-	// TODO: avoid copying y
-	ny := new(Decimal).Set(y)
-	ny.Neg(ny)
-	return z.Add(x, ny)
-}
-
 // TODO: update docs
 // Mul sets z to the rounded product x*y and returns z.
-// Precision, rounding, and accuracy reporting are as for Add.
-// Mul panics with ErrNaN if one operand is zero and the other
-// operand an infinity. The value of z is undefined in that case.
+// Precision, rounding, accuracy reporting and NaN handling are as for Add.
+// If one operand is zero and the other operand an infinity, z is set to NaN
+// and InvalidOperation is raised.
 func (z *Decimal) Mul(x, y *Decimal) *Decimal {
-	// TODO: implement
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, y) {
+		return z
+	}
+	if x.form == infinite || y.form == infinite {
+		// ±Inf * 0 or 0 * ±Inf
+		if x.isZero() || y.isZero() {
+			return z.setNaN(InvalidOperation)
+		}
+		// ±Inf * y or x * ±Inf
+		z.form = infinite
+		z.neg = x.neg != y.neg
+		return z
+	}
+
+	z.form = finite
+	z.neg = x.neg != y.neg
+	z.setScale(int64(x.scale) + int64(y.scale))
+	z.abs.Mul(&x.abs, &y.abs)
+
+	if z.prec == 0 {
+		z.prec = z.actualPrec()
+	}
+	z.round()
 	return z
 }
 
+// FMA sets z to x*y+u, computed with only one rounding, and returns z.
+// Precision, rounding and accuracy reporting are as for Add; if z's
+// precision is 0, it is changed to the largest of x's, y's or u's precision.
+// If any of the operands is a signaling NaN, the first one determines the
+// result; otherwise a quiet NaN x or y, an invalid product (zero times an
+// infinity) and a quiet NaN u are considered in that order. If the product
+// and u are infinities with opposite signs, z is set to NaN and
+// InvalidOperation is raised.
+func (z *Decimal) FMA(x, y, u *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	switch {
+	case x.form == snan || y.form == snan:
+		z.nan(x, y)
+		return z
+	case u.form == snan:
+		z.nan(u, nil)
+		return z
+	case z.nan(x, y):
+		return z
+	case x.form == infinite && y.isZero() || x.isZero() && y.form == infinite:
+		return z.setNaN(InvalidOperation)
+	}
+
+	// the exact product
+	var prod Decimal
+	prod.prec = x.prec
+	if y.prec > prod.prec {
+		prod.prec = y.prec
+	}
+	prod.neg = x.neg != y.neg
+	if x.form == infinite || y.form == infinite {
+		prod.form = infinite
+	} else {
+		prod.abs.Mul(&x.abs, &y.abs)
+		prod.setScale(int64(x.scale) + int64(y.scale))
+	}
+	return z.addSub(&prod, u, u.neg)
+}
+
 // TODO: update docs
 // Quo sets z to the rounded quotient x/y and returns z.
-// Precision, rounding, and accuracy reporting are as for Add.
-// Quo panics with ErrNaN if both operands are zero or infinities.
-// The value of z is undefined in that case.
+// Precision, rounding, accuracy reporting and NaN handling are as for Add.
+// If both operands are infinities, z is set to NaN and InvalidOperation is
+// raised; if both operands are zero, z is set to NaN and DivisionUndefined
+// is raised.
 func (z *Decimal) Quo(x, y *Decimal) *Decimal {
-	// TODO: implement
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, y) {
+		return z
+	}
+	if x.form == infinite && y.form == infinite {
+		return z.setNaN(InvalidOperation)
+	}
+	if x.isZero() && y.isZero() {
+		return z.setNaN(DivisionUndefined)
+	}
+
+	z.setQuoPrec(x, y)
+
+	neg := x.neg != y.neg
+	// ±Inf / y = ±Inf or x / 0 = ±Inf
+	if x.form == infinite || y.isZero() {
+		if x.form != infinite {
+			z.cond |= DivisionByZero
+		}
+		z.form = infinite
+		z.neg = neg
+		return z
+	}
+	// x / ±Inf = 0 with the smallest possible exponent
+	if y.form == infinite {
+		z.form = finite
+		z.neg = neg
+		z.setScale(-z.etiny())
+		z.abs.SetInt64(0)
+		z.cond |= Clamped
+		return z
+	}
+
+	z.form = finite
+	z.quo(x, y)
+	z.neg = neg
+	z.round()
 	return z
 }
 
-// mulPow10 returns x * 10^n, i is not modified.
-// TODO: optimize
-func mulPow10(x *big.Int, n int) *big.Int {
-	ten := new(big.Int).SetInt64(10)
-	r := new(big.Int).Set(x)
-	for i := 0; i < n; i++ {
-		r.Mul(r, ten)
+// quo sets z to the quotient x/y of finite numbers x and y != 0 with at
+// least z.prec+1 significant digits. If the quotient cannot be represented
+// exactly an extra non-zero digit is appended so that a subsequent round
+// reports an inexact result. Otherwise the scale of the quotient is reduced
+// as close as possible to the ideal scale x.scale - y.scale.
+func (z *Decimal) quo(x, y *Decimal) {
+	idealScale := int64(x.scale) - int64(y.scale)
+
+	if x.isZero() {
+		z.abs.SetInt64(0)
+		z.setScale(idealScale)
+		return
 	}
-	return r
-}
 
-// isZero checks if x is 0
-func (x *Decimal) isZero() bool {
-	return !x.inf && x.abs.BitLen() == 0
+	// shift the dividend so that the quotient has at least prec+1 digits
+	// (the extra digit is used for rounding)
+	shift := int64(z.prec) + 1 + int64(y.actualPrec()) - int64(x.actualPrec())
+	if shift < 0 {
+		shift = 0
+	}
+	// TODO: avoid huge shifts for very large precisions if the quotient is exact
+	xa := mulPow10(&x.abs, int(shift))
+	r := new(big.Int)
+	z.abs.QuoRem(xa, &y.abs, r)
+	scale := idealScale + shift
+	if r.Sign() != 0 {
+		// sticky digit
+		z.abs.Mul(&z.abs, big.NewInt(10))
+		inc(&z.abs)
+		scale++
+	} else {
+		// remove trailing zeros down to the ideal scale
+		scale -= trimZeros(&z.abs, scale-idealScale)
+	}
+	z.setScale(scale)
 }
 
-// actualPrec returns the precision of x, i.e. the number of digits in the
-// unscaled value.
-// TODO: optimize (probably store as part of Decimal)
-func (x *Decimal) actualPrec() uint32 {
-	return uint32(len((&x.abs).String()))
-}
+// QuoInt sets z to the integer part of the quotient x/y (truncated towards
+// zero) and returns z. The result has a scale of 0 (unless its exponent is
+// clamped, see SetClamp) and is exact unless its adjusted exponent is
+// greater than Emax, in which case it overflows as for Quo. If z's precision
+// is 0, it is changed to the larger of x's or y's precision before the
+// operation. NaN handling is as for Add. If both operands are infinities or
+// zeros, z is set to NaN as for Quo. If the integer part of the quotient
+// requires more than z's precision digits, z is set to NaN and
+// DivisionImpossible is raised.
+func (z *Decimal) QuoInt(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
 
-// ucmp compares absolute values of x and y assuming that both are finite
-// numbers
-func (x *Decimal) ucmp(y *Decimal) int {
-	// compare adjusted exponents first
-	xe := int64(x.actualPrec()) - int64(x.scale)
-	ye := int64(y.actualPrec()) - int64(y.scale)
-	if xe > ye {
-		return 1
+	if z.nan(x, y) {
+		return z
 	}
-	if xe < ye {
-		return -1
+	if x.form == infinite && y.form == infinite {
+		return z.setNaN(InvalidOperation)
+	}
+	if x.isZero() && y.isZero() {
+		return z.setNaN(DivisionUndefined)
 	}
 
-	xa := &x.abs
-	ya := &y.abs
+	neg := x.neg != y.neg
+	// ±Inf / y = ±Inf or x / 0 = ±Inf
+	if x.form == infinite || y.isZero() {
+		if x.form != infinite {
+			z.cond |= DivisionByZero
+		}
+		z.form = infinite
+		z.neg = neg
+		return z
+	}
 
-	sdiff := int64(x.scale) - int64(y.scale)
-	// TODO: think if casting to int below is safe
-	if sdiff < 0 {
-		// re-scale x
-		xa = mulPow10(xa, -int(sdiff))
-	} else if sdiff > 0 {
-		// re-scale y
-		ya = mulPow10(ya, int(sdiff))
+	z.setQuoPrec(x, y)
+	z.form = finite
+	// x / ±Inf = 0
+	if y.form == infinite {
+		z.neg = neg
+		z.scale = 0
+		z.abs.SetInt64(0)
+		z.round()
+		return z
 	}
 
-	return xa.Cmp(ya)
+	q, _, _, ok := intQuoRem(x, y, z.prec)
+	if !ok {
+		return z.setNaN(DivisionImpossible)
+	}
+	z.neg = neg
+	z.scale = 0
+	z.abs.Set(q)
+	z.round()
+	return z
+}
+
+// Rem sets z to the remainder x - y*n, where n is the integer part of the
+// quotient x/y as computed by QuoInt, and returns z. The sign of a non-zero
+// result is the sign of x and its scale is the larger of x's and y's scales.
+// Precision, rounding, accuracy reporting and NaN handling are as for Add.
+// If x is an infinity or y is zero, z is set to NaN and InvalidOperation
+// (DivisionUndefined if both x and y are zero) is raised. If the integer
+// part of the quotient requires more than z's precision digits, z is set to
+// NaN and DivisionImpossible is raised.
+func (z *Decimal) Rem(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.remNaN(x, y) {
+		return z
+	}
+
+	z.setQuoPrec(x, y)
+	// x rem ±Inf = x
+	if y.form == infinite {
+		z.neg = x.neg
+		z.form = finite
+		z.scale = x.scale
+		z.abs.Set(&x.abs)
+		z.round()
+		return z
+	}
+
+	_, r, scale, ok := intQuoRem(x, y, z.prec)
+	if !ok {
+		return z.setNaN(DivisionImpossible)
+	}
+	z.neg = x.neg
+	z.form = finite
+	z.scale = scale
+	z.abs.Set(r)
+	z.round()
+	return z
+}
+
+// RemNear sets z to the remainder x - y*n, where n is the integer nearest
+// to the exact quotient x/y (ties are rounded to even), and returns z. The
+// sign of a zero result is the sign of x and the scale of the result is the
+// larger of x's and y's scales. This is the IEEE 754 remainder operation.
+// Precision, rounding, accuracy reporting and the handling of NaNs and
+// invalid operands are as for Rem. If n requires more than z's precision
+// digits, z is set to NaN and DivisionImpossible is raised.
+func (z *Decimal) RemNear(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.remNaN(x, y) {
+		return z
+	}
+
+	z.setQuoPrec(x, y)
+	// x rem ±Inf = x
+	if y.form == infinite {
+		z.neg = x.neg
+		z.form = finite
+		z.scale = x.scale
+		z.abs.Set(&x.abs)
+		z.round()
+		return z
+	}
+	// |x| < |y|/2: n = 0 and the result is x with the larger scale
+	if int64(x.actualPrec())-int64(x.scale)+1 < int64(y.actualPrec())-int64(y.scale) {
+		scale := int64(x.scale)
+		if int64(y.scale) > scale && x.abs.Sign() == 0 {
+			// a zero is never rounded; round only limits its exponent
+			z.abs.SetInt64(0)
+			scale = int64(y.scale)
+		} else if int64(y.scale) > scale {
+			// appending more than prec+1 zeros does not change the
+			// rounded result
+			pad := int64(y.scale) - scale
+			limit := int64(z.prec) + 1 - int64(x.actualPrec())
+			if limit < 1 {
+				limit = 1
+			}
+			if pad > limit {
+				pad = limit
+			}
+			z.abs.Set(mulPow10(&x.abs, int(pad)))
+			scale += pad
+		} else {
+			z.abs.Set(&x.abs)
+		}
+		z.neg = x.neg
+		z.form = finite
+		z.setScale(scale)
+		z.round()
+		return z
+	}
+
+	q, r, scale, ok := intQuoRem(x, y, z.prec)
+	if !ok {
+		return z.setNaN(DivisionImpossible)
+	}
+
+	// round n to nearest even: compare 2*r with |y|
+	neg := x.neg
+	ya := mulPow10(&y.abs, int(int64(scale)-int64(y.scale)))
+	c := new(big.Int).Lsh(r, 1).Cmp(ya)
+	if c > 0 || c == 0 && q.Bit(0) == 1 {
+		inc(q)
+		if len(q.String()) > int(z.prec) {
+			return z.setNaN(DivisionImpossible)
+		}
+		r.Sub(ya, r)
+		neg = !neg
+	}
+
+	z.neg = neg
+	z.form = finite
+	z.scale = scale
+	z.abs.Set(r)
+	z.round()
+	return z
+}
+
+// QuoRem sets z to the integer part of the quotient x/y and r to the
+// remainder x - y*z, and returns the pair (z, r). The results are as for
+// QuoInt and Rem respectively, but the quotient is computed only once.
+// The precision of z is used to check that the integer part of the quotient
+// can be represented; r is rounded according to its precision and rounding
+// mode. If x or y is a NaN, x is an infinity or y is zero, z is set as for
+// QuoInt and r as for Rem. If the integer part of the quotient requires more
+// than z's precision digits, both z and r are set to NaN. Either result may
+// alias x or y, but z and r must be different; QuoRem panics if they are the
+// same.
+func (z *Decimal) QuoRem(x, y, r *Decimal) (*Decimal, *Decimal) {
+	if z == r {
+		panic("quotient and remainder with the same receiver")
+	}
+
+	z.acc = big.Exact
+	z.cond = 0
+	r.acc = big.Exact
+	r.cond = 0
+	// r may alias x or y, which are still needed for the quotient
+	nr := Decimal{prec: r.prec, clamp: r.clamp}
+	if nr.remNaN(x, y) {
+		z.QuoInt(x, y)
+		r.form = nr.form
+		r.neg = nr.neg
+		r.scale = 0
+		r.abs.Set(&nr.abs)
+		r.cond = nr.cond
+		return z, r
+	}
+	neg := x.neg != y.neg
+	z.setQuoPrec(x, y)
+	r.setQuoPrec(x, y)
+
+	// x / ±Inf = 0, x rem ±Inf = x
+	if y.form == infinite {
+		r.Set(x)
+		z.neg = neg
+		z.form = finite
+		z.scale = 0
+		z.abs.SetInt64(0)
+		z.round()
+		return z, r
+	}
+
+	q, rem, scale, ok := intQuoRem(x, y, z.prec)
+	if !ok {
+		r.setNaN(DivisionImpossible)
+		return z.setNaN(DivisionImpossible), r
+	}
+	r.neg = x.neg
+	r.form = finite
+	r.scale = scale
+	r.abs.Set(rem)
+	r.round()
+
+	z.neg = neg
+	z.form = finite
+	z.scale = 0
+	z.abs.Set(q)
+	z.round()
+	return z, r
+}
+
+// Sqrt sets z to the rounded square root of x and returns z. The result is
+// always rounded using ToNearestEven; z's rounding mode is ignored. If z's
+// precision is 0, it is changed to x's precision (or to the number of
+// digits of x if that is also 0) before the operation. An exact result has
+// the exponent as close as possible to the ideal exponent floor(e/2), where
+// e is the exponent of x; the square root of -0 is -0. If x is negative
+// (including -Inf), z is set to NaN and InvalidOperation is raised.
+// NaN handling is as for Add.
+func (z *Decimal) Sqrt(x *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	if x.neg && !x.isZero() {
+		return z.setNaN(InvalidOperation)
+	}
+
+	z.setQuoPrec(x, x)
+
+	if x.form == infinite {
+		z.form = infinite
+		z.neg = false
+		return z
+	}
+
+	e := -int64(x.scale)
+	ideal := e >> 1 // floor(e/2)
+	if x.isZero() {
+		z.form = finite
+		z.neg = x.neg
+		z.abs.SetInt64(0)
+		z.setScale(-ideal)
+		z.round()
+		return z
+	}
+
+	// shift the coefficient so that the exponent is even and the root has
+	// at least prec+1 digits (the extra digit is used for rounding)
+	shift := 2*(int64(z.prec)+1) - int64(x.actualPrec())
+	if shift < 0 {
+		shift = 0
+	}
+	if (e-shift)&1 != 0 {
+		shift++
+	}
+	n := mulPow10(&x.abs, int(shift))
+	exp := (e - shift) / 2
+
+	z.form = finite
+	z.neg = false
+	z.abs.Sqrt(n)
+	if new(big.Int).Mul(&z.abs, &z.abs).Cmp(n) != 0 {
+		// sticky digit
+		z.abs.Mul(&z.abs, big.NewInt(10))
+		inc(&z.abs)
+		exp--
+	} else {
+		// remove trailing zeros up to the ideal exponent
+		exp += trimZeros(&z.abs, ideal-exp)
+	}
+	z.setScale(-exp)
+	z.roundEven()
+	return z
+}
+
+// Quantize sets z to the value of x rounded to the exponent of y and returns
+// z. Rounding is performed according to z's rounding mode; y's value and
+// sign are ignored. If z's precision is 0, it is changed to the number of
+// digits of the result and Etiny is taken to be Emin. If the exponent of y
+// is outside of the range [Etiny, Emax], or the result requires more than z's precision digits or
+// its adjusted exponent is greater than Emax, z is set to NaN and
+// InvalidOperation is raised. Unlike other operations, a subnormal result
+// raises Subnormal but not Underflow. If both operands are infinities,
+// z is set to the infinity x; if only one of them is an infinity, z is set
+// to NaN and InvalidOperation is raised. NaN handling is as for Add.
+func (z *Decimal) Quantize(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, y) {
+		return z
+	}
+	if x.form == infinite || y.form == infinite {
+		if x.form != y.form {
+			return z.setNaN(InvalidOperation)
+		}
+		z.form = infinite
+		z.neg = x.neg
+		return z
+	}
+	return z.rescale(x, y.scale)
+}
+
+// Rescale sets z to the value of x rounded to the given scale, i.e. to the
+// exponent -scale, and returns z. Precision, rounding and the invalid
+// results are as for Quantize. If x is an infinity, z is set to NaN and
+// InvalidOperation is raised. NaN handling is as for Add.
+func (z *Decimal) Rescale(x *Decimal, scale int32) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	if x.form == infinite {
+		return z.setNaN(InvalidOperation)
+	}
+	return z.rescale(x, scale)
+}
+
+// rescale implements Rescale for a finite x.
+func (z *Decimal) rescale(x *Decimal, scale int32) *Decimal {
+	e := -int64(scale)
+	etiny := z.etiny()
+	if z.prec == 0 {
+		// the precision of the result is not known yet; it must not be
+		// subnormal
+		etiny = int64(z.Emin())
+	}
+	if e < etiny || e > int64(z.Emax()) {
+		return z.setNaN(InvalidOperation)
+	}
+
+	// number of digits to append (n > 0) or to discard (n < 0)
+	n := int64(scale) - int64(x.scale)
+	if z.prec != 0 && !x.isZero() && int64(x.actualPrec())+n > int64(z.prec) {
+		return z.setNaN(InvalidOperation)
+	}
+
+	z.form = finite
+	z.neg = x.neg
+	if x.isZero() {
+		z.abs.SetInt64(0)
+		z.scale = scale
+	} else if n >= 0 {
+		z.abs.Set(mulPow10(&x.abs, int(n)))
+		z.scale = scale
+	} else {
+		z.abs.Set(&x.abs)
+		z.scale = x.scale
+		z.shr(-n, int64(x.actualPrec()))
+	}
+
+	if z.prec == 0 {
+		z.prec = z.actualPrec()
+	}
+	// rounding may have added a digit (999.9 -> 1000)
+	if z.actualPrec() > z.prec || !z.isZero() && z.adjExp() > int64(z.Emax()) {
+		z.cond = 0
+		z.acc = big.Exact
+		return z.setNaN(InvalidOperation)
+	}
+	if !z.isZero() && z.adjExp() < int64(z.Emin()) {
+		z.cond |= Subnormal
+	}
+	if etop := int64(z.Emax()) - int64(z.prec) + 1; z.clamp && e > etop {
+		// fold-down
+		z.abs.Mul(&z.abs, pow10(int(e-etop)))
+		z.setScale(-etop)
+		z.cond |= Clamped
+	}
+	return z
+}
+
+// ScaleB sets z to the (possibly rounded) value x × 10**n and returns z.
+// The result is computed by adding n to the exponent of x, so the digits
+// of the coefficient are not changed unless the result is rounded. If z's
+// precision is 0, it is changed to x's precision (or to the number of
+// digits of x if that is also 0). If |n| > 2 × (Emax() + Prec()), z is set
+// to NaN and InvalidOperation is raised. NaN handling is as for Set.
+func (z *Decimal) ScaleB(x *Decimal, n int) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	z.setQuoPrec(x, x)
+	if lim := 2 * (int64(z.Emax()) + int64(z.prec)); int64(n) < -lim || int64(n) > lim {
+		return z.setNaN(InvalidOperation)
+	}
+	z.form = x.form
+	z.neg = x.neg
+	if x.form == infinite {
+		return z
+	}
+
+	if z != x {
+		z.abs.Set(&x.abs)
+	}
+	z.setScale(int64(x.scale) - int64(n))
+	z.round()
+	return z
+}
+
+// LogB sets z to the (possibly rounded) adjusted exponent of x, i.e. the
+// exponent of its most significant digit, and returns z. The result is an
+// integer with a scale of 0. If z's precision is 0, it is changed to the
+// number of digits of the result. LogB(±Inf) is +Inf. LogB(±0) is -Inf and
+// raises DivisionByZero. NaN handling is as for Set.
+func (z *Decimal) LogB(x *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	if x.form == infinite {
+		z.form = infinite
+		z.neg = false
+		return z
+	}
+	if x.isZero() {
+		z.cond |= DivisionByZero
+		z.form = infinite
+		z.neg = true
+		return z
+	}
+
+	e := x.adjExp()
+	z.form = finite
+	z.neg = e < 0
+	z.scale = 0
+	if e < 0 {
+		e = -e
+	}
+	z.abs.SetInt64(e)
+	if z.prec == 0 {
+		z.prec = z.actualPrec()
+	}
+	z.round()
+	return z
+}
+
+// Reduce sets z to the (possibly rounded) value of x with all trailing
+// zeros removed from its coefficient and returns z. A zero is reduced to
+// ±0 with a scale of 0. If z's clamping flag is set, trailing zeros are only
+// removed while the exponent is not greater than Emax - (Prec() - 1).
+// Precision, rounding and NaN handling are as for Set.
+func (z *Decimal) Reduce(x *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	z.form = x.form
+	z.neg = x.neg
+	if x.form == infinite {
+		return z
+	}
+	if z != x {
+		z.scale = x.scale
+		z.abs.Set(&x.abs)
+	}
+	if z.prec == 0 {
+		z.prec = x.prec
+		if z.prec == 0 {
+			// uninitialized x
+			z.prec = x.actualPrec()
+		}
+	}
+	z.round()
+	if z.form != finite {
+		return z
+	}
+
+	if z.isZero() {
+		z.scale = 0
+		return z
+	}
+	etop := int64(z.Emax())
+	if z.clamp {
+		etop -= int64(z.prec) - 1
+	}
+	z.setScale(int64(z.scale) - trimZeros(&z.abs, etop+int64(z.scale)))
+	return z
+}
+
+// ToIntegral sets z to the value of x rounded to an integer using the given
+// rounding mode and returns z. If x has a negative scale, z is set to x
+// unchanged; otherwise the scale of the result is 0. z's precision and
+// rounding mode are not used or changed. No conditions other than
+// InvalidOperation are raised and the result is reported as exact; see
+// ToIntegralExact. NaN handling is as for Set.
+func (z *Decimal) ToIntegral(x *Decimal, mode RoundingMode) *Decimal {
+	z.toIntegral(x, mode)
+	z.acc = big.Exact
+	z.cond &= InvalidOperation
+	return z
+}
+
+// ToIntegralExact is like ToIntegral but raises Rounded if x is not zero
+// and has a positive scale, and Inexact if the result is not equal to x;
+// z's accuracy reports the rounding direction.
+func (z *Decimal) ToIntegralExact(x *Decimal, mode RoundingMode) *Decimal {
+	z.toIntegral(x, mode)
+	return z
+}
+
+// toIntegral implements ToIntegralExact.
+func (z *Decimal) toIntegral(x *Decimal, mode RoundingMode) {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return
+	}
+	z.form = x.form
+	z.neg = x.neg
+	if x.form == infinite {
+		return
+	}
+	if z != x {
+		z.scale = x.scale
+		z.abs.Set(&x.abs)
+	}
+	if z.scale <= 0 {
+		return
+	}
+	if z.isZero() {
+		z.scale = 0
+		return
+	}
+	zmode := z.mode
+	z.mode = mode
+	z.shr(int64(z.scale), int64(z.actualPrec()))
+	z.mode = zmode
+}
+
+// NextPlus sets z to the smallest number that is greater than x and can be
+// represented with z's precision and exponent limits, and returns z. If z's
+// precision is 0, it is changed to x's precision (or to the number of
+// digits of x if that is also 0). The successor of the largest finite
+// number is +Inf and the successor of -Inf is the most negative finite
+// number. No conditions other than InvalidOperation are raised and the
+// result is reported as exact. NaN handling is as for Set.
+func (z *Decimal) NextPlus(x *Decimal) *Decimal {
+	z.next(x, false)
+	z.acc = big.Exact
+	z.cond &= InvalidOperation
+	return z
+}
+
+// NextMinus sets z to the largest number that is less than x and can be
+// represented with z's precision and exponent limits, and returns z.
+// See NextPlus.
+func (z *Decimal) NextMinus(x *Decimal) *Decimal {
+	z.next(x, true)
+	z.acc = big.Exact
+	z.cond &= InvalidOperation
+	return z
+}
+
+// NextToward sets z to the number closest to x in the direction of y as
+// for NextPlus or NextMinus and returns z. If x and y are numerically
+// equal, z is set to x with the sign of y. An infinite result raises
+// Overflow, and a subnormal or zero result raises Underflow and Subnormal
+// (and Clamped if it is zero); both also raise Inexact and Rounded, and
+// z's accuracy then reports the direction of y. NaN handling is as for
+// Add.
+func (z *Decimal) NextToward(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, y) {
+		return z
+	}
+	c := x.Cmp(y)
+	if c == 0 {
+		z.setQuoPrec(x, x)
+		z.form = x.form
+		z.neg = y.neg
+		if z != x {
+			z.scale = x.scale
+			z.abs.Set(&x.abs)
+		}
+		return z
+	}
+
+	z.next(x, c > 0)
+	z.cond = 0
+	switch {
+	case z.form == infinite:
+		z.cond = Overflow | Inexact | Rounded
+	case z.adjExp() < int64(z.Emin()):
+		z.cond = Underflow | Subnormal | Inexact | Rounded
+		if z.isZero() {
+			z.cond |= Clamped
+		}
+	default:
+		z.acc = big.Exact
+		return z
+	}
+	if c < 0 {
+		z.acc = big.Above
+	} else {
+		z.acc = big.Below
+	}
+	return z
+}
+
+// next sets z to the neighbor of a non-NaN x as described for NextPlus if
+// neg is false and for NextMinus otherwise.
+func (z *Decimal) next(x *Decimal, neg bool) {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return
+	}
+	z.setQuoPrec(x, x)
+	if x.form == infinite {
+		z.neg = x.neg
+		if x.neg == neg {
+			z.form = infinite
+			return
+		}
+		// the largest finite number with the sign of x
+		z.form = finite
+		z.abs.Sub(pow10(int(z.prec)), big.NewInt(1))
+		z.setScale(int64(z.prec) - 1 - int64(z.Emax()))
+		return
+	}
+
+	mode := ToPositiveInf
+	if neg {
+		mode = ToNegativeInf
+	}
+	// if x cannot be represented, rounding it in the right direction gives
+	// the result
+	t := Decimal{prec: z.prec, mode: mode, emaxDiff: z.emaxDiff, eminDiff: z.eminDiff, clamp: z.clamp}
+	t.Set(x)
+	if t.acc != big.Exact {
+		z.Set(&t)
+		return
+	}
+
+	// otherwise add a number that is less than a half of the smallest
+	// subnormal number
+	var tiny Decimal
+	tiny.abs.SetInt64(1)
+	tiny.setScale(1 - z.etiny())
+	zmode := z.mode
+	z.mode = mode
+	z.addSub(&t, &tiny, neg)
+	z.mode = zmode
+}
+
+// nan sets z to the NaN resulting from an operation with the operands x
+// and y (y may be nil) and reports whether any of them is a NaN. The first
+// signaling NaN is converted to a quiet NaN and raises InvalidOperation;
+// otherwise the first quiet NaN is used. The sign and the payload of the
+// NaN are preserved, but the payload is truncated to its least significant
+// digits that fit into z's precision.
+func (z *Decimal) nan(x, y *Decimal) bool {
+	var n *Decimal
+	switch {
+	case x.form == snan:
+		n = x
+	case y != nil && y.form == snan:
+		n = y
+	case x.form == qnan:
+		n = x
+	case y != nil && y.form == qnan:
+		n = y
+	default:
+		return false
+	}
+	if n.form == snan {
+		z.cond |= InvalidOperation
+	}
+	z.form = qnan
+	z.neg = n.neg
+	z.scale = 0
+	z.abs.Set(&n.abs)
+	if max := z.maxPayload(); max >= 0 && int64(z.actualPrec()) > max {
+		z.abs.Rem(&z.abs, pow10(int(max)))
+	}
+	return true
+}
+
+// maxPayload returns the maximum number of digits in the payload of a NaN
+// with z's precision and clamping, or -1 if there is no limit.
+func (z *Decimal) maxPayload() int64 {
+	if z.prec == 0 {
+		return -1
+	}
+	if z.clamp {
+		return int64(z.prec) - 1
+	}
+	return int64(z.prec)
+}
+
+// setNaN sets z to a quiet NaN without a payload, raises cond and returns z.
+func (z *Decimal) setNaN(cond Condition) *Decimal {
+	z.form = qnan
+	z.neg = false
+	z.scale = 0
+	z.abs.SetInt64(0)
+	z.cond |= cond
+	return z
+}
+
+// remNaN sets z to the NaN resulting from a remainder of x and y as
+// described for Rem and reports whether the result is a NaN.
+func (z *Decimal) remNaN(x, y *Decimal) bool {
+	if z.nan(x, y) {
+		return true
+	}
+	if x.form == infinite {
+		z.setNaN(InvalidOperation)
+		return true
+	}
+	if y.isZero() {
+		if x.isZero() {
+			z.setNaN(DivisionUndefined)
+		} else {
+			z.setNaN(InvalidOperation)
+		}
+		return true
+	}
+	return false
+}
+
+// setQuoPrec sets the precision of z to the larger of x's and y's precision
+// if it is 0.
+func (z *Decimal) setQuoPrec(x, y *Decimal) {
+	if z.prec != 0 {
+		return
+	}
+	z.prec = x.prec
+	if y.prec > z.prec {
+		z.prec = y.prec
+	}
+	if z.prec == 0 {
+		// uninitialized operands
+		z.prec = x.actualPrec()
+		if p := y.actualPrec(); p > z.prec {
+			z.prec = p
+		}
+	}
+}
+
+// intQuoRem returns the integer part q of the quotient x/y (truncated towards
+// zero) and the unsigned remainder r = |x| - |y|*q with the given scale. x and
+// y must be finite and y must not be zero. ok is false if q requires more
+// than prec digits.
+func intQuoRem(x, y *Decimal, prec uint32) (q, r *big.Int, scale int32, ok bool) {
+	// |x| < |y|
+	if x.isZero() || x.ucmp(y) < 0 {
+		scale = x.scale
+		if y.scale > scale {
+			scale = y.scale
+		}
+		r = mulPow10(&x.abs, int(int64(scale)-int64(x.scale)))
+		return new(big.Int), r, scale, true
+	}
+
+	// the integer part of the quotient has at least xe-ye digits
+	xe := int64(x.actualPrec()) - int64(x.scale)
+	ye := int64(y.actualPrec()) - int64(y.scale)
+	if xe-ye > int64(prec) {
+		return nil, nil, 0, false
+	}
+
+	// now the difference between the scales is limited by the number of
+	// digits in x and y and prec
+	xa := &x.abs
+	ya := &y.abs
+	scale = x.scale
+	if sdiff := int64(x.scale) - int64(y.scale); sdiff < 0 {
+		xa = mulPow10(xa, -int(sdiff))
+		scale = y.scale
+	} else if sdiff > 0 {
+		ya = mulPow10(ya, int(sdiff))
+	}
+
+	q, r = new(big.Int).QuoRem(xa, ya, new(big.Int))
+	if len(q.String()) > int(prec) {
+		return nil, nil, 0, false
+	}
+	return q, r, scale, true
+}
+
+// mulPow10 returns x * 10^n, x is not modified.
+func mulPow10(x *big.Int, n int) *big.Int {
+	return new(big.Int).Mul(x, pow10(n))
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// trimZeros removes up to n trailing zero digits from x and returns the
+// number of removed digits.
+func trimZeros(x *big.Int, n int64) int64 {
+	if x.Sign() == 0 {
+		return 0
+	}
+	ten := big.NewInt(10)
+	q := new(big.Int)
+	r := new(big.Int)
+	var i int64
+	for ; i < n; i++ {
+		q.QuoRem(x, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		x.Set(q)
+	}
+	return i
+}
+
+// setScale sets the scale of z to s saturated to the range of int32. The
+// exponent limits are well within this range so saturation does not change
+// the result of a subsequent round (it still overflows or underflows).
+func (z *Decimal) setScale(s int64) {
+	if s > math.MaxInt32 {
+		s = math.MaxInt32
+	} else if s < math.MinInt32 {
+		s = math.MinInt32
+	}
+	z.scale = int32(s)
+}
+
+// etiny returns the smallest exponent of a subnormal number with the
+// precision and the exponent limits of x, i.e. Emin - (Prec() - 1).
+func (x *Decimal) etiny() int64 {
+	return int64(x.Emin()) - int64(x.prec) + 1
+}
+
+// adjExp returns the adjusted exponent of a finite x, i.e. the exponent of
+// its most significant digit.
+func (x *Decimal) adjExp() int64 {
+	return int64(x.actualPrec()) - 1 - int64(x.scale)
+}
+
+// isZero checks if x is 0
+func (x *Decimal) isZero() bool {
+	return x.form == finite && x.abs.BitLen() == 0
+}
+
+// isInteger reports whether x is a finite number with an integral value.
+func (x *Decimal) isInteger() bool {
+	if x.form != finite {
+		return false
+	}
+	if x.scale <= 0 || x.abs.Sign() == 0 {
+		return true
+	}
+	c := new(big.Int).Set(&x.abs)
+	return trimZeros(c, int64(x.scale)) == int64(x.scale)
+}
+
+// isOdd reports whether an integral x is odd.
+func (x *Decimal) isOdd() bool {
+	if x.scale < 0 {
+		return false
+	}
+	q := new(big.Int).Quo(&x.abs, pow10(int(x.scale)))
+	return q.Bit(0) == 1
+}
+
+// toInt64 returns the value of an integral x and reports whether it can be
+// represented as an int64. If it cannot, the result is ±maxSat.
+func (x *Decimal) toInt64() (int64, bool) {
+	if x.adjExp() >= 18 {
+		if x.neg {
+			return -maxSat, false
+		}
+		return maxSat, false
+	}
+	var n int64
+	if x.scale <= 0 {
+		n = mulPow10(&x.abs, -int(x.scale)).Int64()
+	} else {
+		n = new(big.Int).Quo(&x.abs, pow10(int(x.scale))).Int64()
+	}
+	if x.neg {
+		n = -n
+	}
+	return n, true
+}
+
+// maxSat is the limit of saturated int64 computations. It leaves enough room
+// for adding exponents and precisions without overflow.
+const maxSat = 1 << 62
+
+// mulSat returns a*b saturated to the range [-maxSat, maxSat].
+func mulSat(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	neg := (a < 0) != (b < 0)
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	if a > maxSat/b {
+		a = maxSat
+	} else {
+		a *= b
+	}
+	if neg {
+		return -a
+	}
+	return a
+}
+
+// actualPrec returns the precision of x, i.e. the number of digits in the
+// unscaled value.
+// TODO: optimize (probably store as part of Decimal)
+func (x *Decimal) actualPrec() uint32 {
+	return uint32(len((&x.abs).String()))
+}
+
+// ucmp compares absolute values of x and y assuming that both are finite
+// numbers
+func (x *Decimal) ucmp(y *Decimal) int {
+	// a zero is less than any other number regardless of its exponent
+	if x.abs.Sign() == 0 || y.abs.Sign() == 0 {
+		return x.abs.Sign() - y.abs.Sign()
+	}
+
+	// compare adjusted exponents first
+	xe := int64(x.actualPrec()) - int64(x.scale)
+	ye := int64(y.actualPrec()) - int64(y.scale)
+	if xe > ye {
+		return 1
+	}
+	if xe < ye {
+		return -1
+	}
+
+	xa := &x.abs
+	ya := &y.abs
+
+	sdiff := int64(x.scale) - int64(y.scale)
+	// TODO: think if casting to int below is safe
+	if sdiff < 0 {
+		// re-scale x
+		xa = mulPow10(xa, -int(sdiff))
+	} else if sdiff > 0 {
+		// re-scale y
+		ya = mulPow10(ya, int(sdiff))
+	}
+
+	return xa.Cmp(ya)
 }
 
 // Cmp compares x and y and returns:
@@ -618,7 +2032,13 @@ func (x *Decimal) ucmp(y *Decimal) int {
 //    0 if x == y (incl. -0 == 0, -Inf == -Inf, and +Inf == +Inf)
 //   +1 if x >  y
 //
+// Cmp panics with ErrNaN if x or y is a NaN; use Compare or CmpTotal to
+// compare NaNs.
 func (x *Decimal) Cmp(y *Decimal) int {
+	if x.IsNaN() || y.IsNaN() {
+		panic(ErrNaN{"comparison with NaN"})
+	}
+
 	// 0 == 0
 	if x.isZero() && y.isZero() {
 		return 0
@@ -634,18 +2054,18 @@ func (x *Decimal) Cmp(y *Decimal) int {
 	}
 
 	// +Inf == +Inf or -Inf == -Inf
-	if x.inf && y.inf {
+	if x.form == infinite && y.form == infinite {
 		return 0
 	}
 
-	if x.inf {
+	if x.form == infinite {
 		if x.neg {
 			return -1 // -Inf < a finite number
 		} else {
 			return 1 // +Inf < a finite number
 		}
 	}
-	if y.inf {
+	if y.form == infinite {
 		if y.neg {
 			return 1 // a finite number > -Inf
 		} else {
@@ -661,8 +2081,33 @@ func (x *Decimal) Cmp(y *Decimal) int {
 	return r
 }
 
+// Compare sets z to -1, 0 or +1 depending on whether x is less than, equal
+// to or greater than y (see Cmp) and returns z. If x or y is a NaN, z is set
+// to a NaN as for Add.
+func (z *Decimal) Compare(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+	if z.nan(x, y) {
+		return z
+	}
+	if z.prec == 0 {
+		z.prec = 1
+	}
+
+	r := x.Cmp(y)
+	z.form = finite
+	z.neg = r < 0
+	z.scale = 0
+	z.abs.SetInt64(int64(r * r))
+	return z
+}
+
 // CmpTotal compares x and y using their abstract representation rather than
-// their numerical value.
+// their numerical value. NaNs are ordered too:
+//
+//   -NaN < -sNaN < -Inf < finite numbers < +Inf < +sNaN < +NaN
+//
+// where NaNs of the same kind and sign are ordered by their payloads.
 //
 // TODO: update docs // (http://speleotrove.com/decimal/damisc.html#refcotot)
 func (x *Decimal) CmpTotal(y *Decimal) int {
@@ -674,8 +2119,24 @@ func (x *Decimal) CmpTotal(y *Decimal) int {
 		return 1
 	}
 
+	if x.IsNaN() || y.IsNaN() {
+		// the forms are declared in the total order of their magnitudes
+		r := 0
+		if x.form < y.form {
+			r = -1
+		} else if x.form > y.form {
+			r = 1
+		} else {
+			r = x.abs.Cmp(&y.abs)
+		}
+		if x.neg {
+			r = -r
+		}
+		return r
+	}
+
 	r := x.Cmp(y)
-	if r != 0 {
+	if r != 0 || x.form == infinite {
 		return r
 	}
 
@@ -689,3 +2150,89 @@ func (x *Decimal) CmpTotal(y *Decimal) int {
 	}
 	return r
 }
+
+// Max sets z to the (possibly rounded) larger of x and y and returns z.
+// Numerically equal operands are ordered as for CmpTotal, so that, for
+// example, the maximum of 1.0 and 1.00 is 1.0 and the maximum of -0 and 0
+// is 0. If one operand is a quiet NaN and the other one is a number, the
+// number is used. Otherwise precision, rounding and NaN handling are as for
+// Set and Add.
+func (z *Decimal) Max(x, y *Decimal) *Decimal {
+	return z.minMax(x, y, func(x, y *Decimal) int {
+		return x.CmpTotal(y)
+	})
+}
+
+// Min sets z to the (possibly rounded) smaller of x and y and returns z.
+// See Max.
+func (z *Decimal) Min(x, y *Decimal) *Decimal {
+	return z.minMax(x, y, func(x, y *Decimal) int {
+		return y.CmpTotal(x)
+	})
+}
+
+// MaxMag sets z to the (possibly rounded) operand with the larger absolute
+// value and returns z. If the absolute values are equal, the result is as
+// for Max. See Max.
+func (z *Decimal) MaxMag(x, y *Decimal) *Decimal {
+	return z.minMax(x, y, func(x, y *Decimal) int {
+		if r := x.cmpAbs(y); r != 0 {
+			return r
+		}
+		return x.CmpTotal(y)
+	})
+}
+
+// MinMag sets z to the (possibly rounded) operand with the smaller absolute
+// value and returns z. If the absolute values are equal, the result is as
+// for Min. See Max.
+func (z *Decimal) MinMag(x, y *Decimal) *Decimal {
+	return z.minMax(x, y, func(x, y *Decimal) int {
+		if r := y.cmpAbs(x); r != 0 {
+			return r
+		}
+		return y.CmpTotal(x)
+	})
+}
+
+// minMax sets z to the rounded value of y if cmp(x, y) < 0 and to that of x
+// otherwise, and returns z. A quiet NaN operand is ignored if the other one
+// is not a NaN.
+func (z *Decimal) minMax(x, y *Decimal, cmp func(x, y *Decimal) int) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	r := x
+	switch {
+	case x.form == qnan && !y.IsNaN():
+		r = y
+	case y.form == qnan && !x.IsNaN():
+		r = x
+	case z.nan(x, y):
+		return z
+	case cmp(x, y) < 0:
+		r = y
+	}
+
+	if z == r {
+		if z.prec == 0 {
+			z.prec = z.actualPrec()
+		}
+		z.round()
+		return z
+	}
+	return z.Set(r)
+}
+
+// cmpAbs compares the absolute values of x and y that are not NaNs.
+func (x *Decimal) cmpAbs(y *Decimal) int {
+	switch {
+	case x.form == infinite && y.form == infinite:
+		return 0
+	case x.form == infinite:
+		return 1
+	case y.form == infinite:
+		return -1
+	}
+	return x.ucmp(y)
+}
@@ -1,29 +1,15 @@
 package big2
 
 import (
+	"math"
 	"math/big"
+	"strconv"
 	"testing"
 )
 
 //go:generate bash -c "dectest < ~/tmp/dectest/abs.decTest > abs_test.go"
 func TestAbs(t *testing.T) {
 	for _, test := range absTests {
-		switch test.id {
-		case "absx120":
-			// TODO: disable in dectest
-			t.Logf("%s: Emax not supported", test.id)
-			continue
-		case "absx213":
-			// TODO: investigate, Cmp = 0 but CmpTotal != 0, probably disable in dectest
-			t.Logf("%s: Emin not supported, subnormal", test.id)
-			continue
-		case "absx215", "absx216", "absx217", "absx218", "absx219", "absx220",
-			"absx233", "absx235", "absx236", "absx237", "absx238", "absx239", "absx240":
-			// TODO: disable in dectest
-			t.Logf("%s: Emin not supported", test.id)
-			continue
-		}
-
 		in := new(Decimal)
 		_, ok := in.SetString(test.in)
 		if !ok {
@@ -41,6 +27,9 @@ func TestAbs(t *testing.T) {
 		r := new(Decimal)
 		r.SetPrec(test.prec)
 		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
 		r2 := r.Abs(in)
 		if r != r2 {
 			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
@@ -50,7 +39,10 @@ func TestAbs(t *testing.T) {
 			t.Errorf("%s: Abs(%s) got: %s want: %s", test.id, test.in, r.String(), out.String())
 			continue
 		}
-		if test.inexact {
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
 			if r.Acc() == big.Exact {
 				t.Errorf("%s: expected inaccurate result", test.id)
 			}
@@ -71,19 +63,6 @@ func TestMinus(t *testing.T) {
 			// TODO: skip in dectest
 			t.Logf("%s: Neg(0) = -0 (not 0) similar to big.Float", test.id)
 			continue
-		case "minx100", "minx101":
-			// TODO: skip in dectest
-			t.Logf("%s: Emax not supported", test.id)
-			continue
-		case "minx113":
-			// TODO: investigate, Cmp = 0 but CmpTotal != 0, probably disable in dectest
-			t.Logf("%s: Emin not supported, subnormal", test.id)
-			continue
-		case "minx115", "minx116", "minx117", "minx118", "minx119", "minx120",
-			"minx133", "minx135", "minx136", "minx137", "minx138", "minx139", "minx140":
-			// TODO: disable in dectest
-			t.Logf("%s: Emin not supported", test.id)
-			continue
 		}
 
 		in := new(Decimal)
@@ -103,6 +82,9 @@ func TestMinus(t *testing.T) {
 		r := new(Decimal)
 		r.SetPrec(test.prec)
 		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
 		r2 := r.Neg(in)
 		if r != r2 {
 			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
@@ -112,7 +94,10 @@ func TestMinus(t *testing.T) {
 			t.Errorf("%s: Neg(%s) got: %s want: %s", test.id, test.in, r.String(), out.String())
 			continue
 		}
-		if test.inexact {
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
 			if r.Acc() == big.Exact {
 				t.Errorf("%s: expected inaccurate result", test.id)
 			}
@@ -124,6 +109,52 @@ func TestMinus(t *testing.T) {
 	}
 }
 
+func TestAbsNegRounding(t *testing.T) {
+	for i, test := range []struct {
+		op   string
+		in   string
+		prec uint
+		mode RoundingMode
+		emax int
+		out  string
+		acc  big.Accuracy
+		cond Condition
+	}{
+		{"abs", "-9894E8", 2, ToNegativeInf, 5, "9.9E+5", big.Below, Overflow | Inexact | Rounded},
+		{"abs", "-9894E8", 2, ToPositiveInf, 5, "Inf", big.Above, Overflow | Inexact | Rounded},
+		{"abs", "-1.25", 2, ToPositiveInf, 0, "1.3", big.Above, Inexact | Rounded},
+		{"abs", "-1.25", 2, ToNegativeInf, 0, "1.2", big.Below, Inexact | Rounded},
+		{"neg", "-48E10", 5, ToPositiveInf, 2, "Inf", big.Above, Overflow | Inexact | Rounded},
+		{"neg", "48E10", 5, ToPositiveInf, 2, "-999.99", big.Above, Overflow | Inexact | Rounded},
+		{"neg", "-566323000E37", 3, ToPositiveInf, 0, "5.67E+45", big.Above, Inexact | Rounded},
+		{"neg", "566323000E37", 3, ToPositiveInf, 0, "-5.66E+45", big.Above, Inexact | Rounded},
+		{"neg", "877940900E1", 3, ToNearestZero, 0, "-8.78E+9", big.Below, Inexact | Rounded},
+		{"neg", "877940900E1", 3, ToZero, 0, "-8.77E+9", big.Above, Inexact | Rounded},
+	} {
+		in, _ := new(Decimal).SetString(test.in)
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		if test.emax != 0 {
+			r.SetEmax(test.emax)
+		}
+		if test.op == "abs" {
+			r.Abs(in)
+		} else {
+			r.Neg(in)
+		}
+		if s := r.String(); s != test.out {
+			t.Errorf("#%d: %s(%s) got: %s want: %s", i, test.op, test.in, s, test.out)
+		}
+		if r.Acc() != test.acc {
+			t.Errorf("#%d: %s(%s) accuracy got: %s want: %s", i, test.op, test.in, r.Acc(), test.acc)
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("#%d: %s(%s) conditions got: %s want: %s", i, test.op, test.in, c, test.cond)
+		}
+	}
+}
+
 //go:generate bash -c "dectest < ~/tmp/dectest/compare.decTest > compare_test.go"
 func TestCompare(t *testing.T) {
 	for _, test := range compareTests {
@@ -141,9 +172,31 @@ func TestCompare(t *testing.T) {
 			continue
 		}
 
-		r := in1.Cmp(in2)
-		if r != test.out {
-			t.Errorf("%s: Cmp(%s, %s) got: %d want: %d", test.id, test.in1, test.in2, r, test.out)
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r2 := r.Compare(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Compare(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+
+		if in1.IsNaN() || in2.IsNaN() {
+			continue
+		}
+		if c := in1.Cmp(in2); strconv.Itoa(c) != test.out {
+			t.Errorf("%s: Cmp(%s, %s) got: %d want: %s", test.id, test.in1, test.in2, c, test.out)
 		}
 	}
 }
@@ -234,6 +287,9 @@ func TestAdd(t *testing.T) {
 		r := new(Decimal)
 		r.SetPrec(test.prec)
 		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
 		r2 := r.Add(in1, in2)
 		if r != r2 {
 			t.Errorf("%s: return value got: %p want: %d", test.id, r, r2)
@@ -243,7 +299,10 @@ func TestAdd(t *testing.T) {
 			t.Errorf("%s: Add(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
 			continue
 		}
-		if test.inexact {
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
 			if r.Acc() == big.Exact {
 				t.Errorf("%s: expected inaccurate result", test.id)
 			}
@@ -282,6 +341,9 @@ func TestSubtract(t *testing.T) {
 		r := new(Decimal)
 		r.SetPrec(test.prec)
 		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
 		r2 := r.Sub(in1, in2)
 		if r != r2 {
 			t.Errorf("%s: return value got: %p want: %d", test.id, r, r2)
@@ -291,7 +353,243 @@ func TestSubtract(t *testing.T) {
 			t.Errorf("%s: Sub(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
 			continue
 		}
-		if test.inexact {
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/multiply.decTest > multiply_test.go"
+func TestMultiply(t *testing.T) {
+	for _, test := range multiplyTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		// the expected result may be a subnormal below MinExp
+		out := new(Decimal)
+		out.SetPrec(test.prec)
+		out.SetEmax(test.emax)
+		out.SetEmin(test.emin)
+		out.SetClamp(test.clamp)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Mul(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Mul(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/fma.decTest > fma_test.go"
+func TestFMA(t *testing.T) {
+	for _, test := range fmaTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		in3 := new(Decimal)
+		_, ok = in3.SetString(test.in3)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in3)
+			continue
+		}
+
+		// the expected result may be a subnormal below MinExp
+		out := new(Decimal)
+		out.SetPrec(test.prec)
+		out.SetEmax(test.emax)
+		out.SetEmin(test.emin)
+		out.SetClamp(test.clamp)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.FMA(in1, in2, in3)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: FMA(%s, %s, %s) got: %s want: %s", test.id, test.in1, test.in2, test.in3, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/divide.decTest > divide_test.go"
+func TestDivide(t *testing.T) {
+	for _, test := range divideTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Quo(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Quo(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/divideint.decTest > divideint_test.go"
+func TestDivideInt(t *testing.T) {
+	for _, test := range divideintTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.QuoInt(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: QuoInt(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
 			if r.Acc() == big.Exact {
 				t.Errorf("%s: expected inaccurate result", test.id)
 			}
@@ -302,3 +600,1311 @@ func TestSubtract(t *testing.T) {
 		}
 	}
 }
+
+func TestQuoIntLimits(t *testing.T) {
+	for i, test := range []struct {
+		in1, in2 string
+		prec     uint
+		emax     int
+		clamp    bool
+		out      string
+		cond     Condition
+	}{
+		{"1234567", "1", 9, 3, false, "Inf", Overflow | Inexact | Rounded},
+		{"-1234567", "1", 9, 3, false, "-Inf", Overflow | Inexact | Rounded},
+		{"1000", "1", 9, 3, false, "1000", 0},
+		{"99999", "-99", 9, 3, false, "-1010", 0},
+		{"43392373E9", "-261740E8", 5, 3, true, "-1657.0", Clamped},
+		{"1", "3", 7, 3, true, "0.000", Clamped},
+		{"1", "Inf", 7, 3, true, "0.000", Clamped},
+		{"-1657", "1", 4, 3, true, "-1657", 0},
+	} {
+		x, _ := new(Decimal).SetString(test.in1)
+		y, _ := new(Decimal).SetString(test.in2)
+		out, _ := new(Decimal).SetString(test.out)
+		r := new(Decimal).SetPrec(test.prec).SetEmax(test.emax).SetClamp(test.clamp)
+		r.QuoInt(x, y)
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("#%d: QuoInt(%s, %s) got: %s want: %s", i, test.in1, test.in2, r.String(), test.out)
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("#%d: QuoInt(%s, %s) conditions got: %s want: %s", i, test.in1, test.in2, c, test.cond)
+		}
+		if (test.cond&Inexact != 0) != (r.Acc() != big.Exact) {
+			t.Errorf("#%d: QuoInt(%s, %s) accuracy got: %s", i, test.in1, test.in2, r.Acc())
+		}
+
+		q := new(Decimal).SetPrec(test.prec).SetEmax(test.emax).SetClamp(test.clamp)
+		q.QuoRem(x, y, new(Decimal))
+		if q.CmpTotal(r) != 0 || q.Conditions() != r.Conditions() {
+			t.Errorf("#%d: QuoRem(%s, %s) quotient got: %s (%s) want: %s (%s)",
+				i, test.in1, test.in2, q.String(), q.Conditions(), r.String(), r.Conditions())
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/remainder.decTest > remainder_test.go"
+func TestRemainder(t *testing.T) {
+	for _, test := range remainderTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Rem(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Rem(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+func TestQuoRem(t *testing.T) {
+	for _, test := range remainderTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		wq := new(Decimal).SetPrec(test.prec).SetMode(test.mode).SetEmax(test.emax).SetEmin(test.emin).SetClamp(test.clamp).QuoInt(in1, in2)
+		wr := new(Decimal).SetPrec(test.prec).SetMode(test.mode).SetEmax(test.emax).SetEmin(test.emin).SetClamp(test.clamp).Rem(in1, in2)
+
+		q := new(Decimal).SetPrec(test.prec).SetMode(test.mode).SetEmax(test.emax).SetEmin(test.emin).SetClamp(test.clamp)
+		r := new(Decimal).SetPrec(test.prec).SetMode(test.mode).SetEmax(test.emax).SetEmin(test.emin).SetClamp(test.clamp)
+		q2, r2 := q.QuoRem(in1, in2, r)
+		if q != q2 || r != r2 {
+			t.Errorf("%s: return values got: %p, %p want: %p, %p", test.id, q2, r2, q, r)
+		}
+
+		if wq.CmpTotal(q) != 0 || wr.CmpTotal(r) != 0 {
+			t.Errorf("%s: QuoRem(%s, %s) got: %s, %s want: %s, %s",
+				test.id, test.in1, test.in2, q.String(), r.String(), wq.String(), wr.String())
+		}
+
+		// x and y as results (parsed again since Set quiets signaling NaNs)
+		x, _ := new(Decimal).SetString(test.in1)
+		y, _ := new(Decimal).SetString(test.in2)
+		x.SetPrec(test.prec).SetMode(test.mode).SetEmax(test.emax).SetEmin(test.emin).SetClamp(test.clamp)
+		y.SetPrec(test.prec).SetMode(test.mode).SetEmax(test.emax).SetEmin(test.emin).SetClamp(test.clamp)
+		x.QuoRem(x, y, y)
+		if wq.CmpTotal(x) != 0 || wr.CmpTotal(y) != 0 {
+			t.Errorf("%s: QuoRem(%s, %s) (aliased) got: %s, %s want: %s, %s",
+				test.id, test.in1, test.in2, x.String(), y.String(), wq.String(), wr.String())
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/remainderNear.decTest > remaindernear_test.go"
+func TestRemainderNear(t *testing.T) {
+	for _, test := range remaindernearTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.RemNear(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: RemNear(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+func TestRemNearScale(t *testing.T) {
+	for i, test := range []struct {
+		in1, in2 string
+		prec     uint
+		out      string
+		cond     Condition
+	}{
+		{"0.1", "100.00", 9, "0.10", 0},
+		{"-62E4", "-78638175249764E2", 16, "-6.200E+5", 0},
+		{"-4269474E-7", "-37130626302940E-11", 9, "-0.426947400", Rounded},
+		{"1E-5", "1E+10", 9, "0.00001", 0},
+		{"7", "100000.000000000000000", 5, "7.0000", Rounded},
+		{"-1234567.89", "1.00000000000000000000E+10", 5, "-1.2346E+6", Inexact | Rounded},
+		{"0.000", "0.169062", 2, "0.000000", 0},
+		{"0", "127.03", 1, "0.00", 0},
+		{"-0", "1E-2", 1, "-0.00", 0},
+	} {
+		x, _ := new(Decimal).SetString(test.in1)
+		y, _ := new(Decimal).SetString(test.in2)
+		out, _ := new(Decimal).SetString(test.out)
+		r := new(Decimal).SetPrec(test.prec)
+		r.RemNear(x, y)
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("#%d: RemNear(%s, %s) got: %s want: %s", i, test.in1, test.in2, r.String(), test.out)
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("#%d: RemNear(%s, %s) conditions got: %s want: %s", i, test.in1, test.in2, c, test.cond)
+		}
+		if (test.cond&Inexact != 0) != (r.Acc() != big.Exact) {
+			t.Errorf("#%d: RemNear(%s, %s) accuracy got: %s", i, test.in1, test.in2, r.Acc())
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/squareroot.decTest > squareroot_test.go"
+func TestSquareRoot(t *testing.T) {
+	for _, test := range squarerootTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Sqrt(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Sqrt(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/quantize.decTest > quantize_test.go"
+func TestQuantize(t *testing.T) {
+	for _, test := range quantizeTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Quantize(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Quantize(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/rescale.decTest > rescale_test.go"
+func TestRescale(t *testing.T) {
+	for _, test := range rescaleTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Rescale(in, test.scale)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Rescale(%s, %d) got: %s want: %s", test.id, test.in, test.scale, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+func TestRescalePrecZero(t *testing.T) {
+	for i, test := range []struct {
+		in    string
+		scale int32
+		emin  int
+		out   string
+		cond  Condition
+	}{
+		{"1.5", 3, 0, "1.500", 0},
+		{"-1.55", 1, 0, "-1.6", Inexact | Rounded},
+		{"0", 5, 0, "0.00000", 0},
+		{"1", math.MaxInt32, 0, "NaN", InvalidOperation},
+		{"1", math.MinInt32, 0, "NaN", InvalidOperation},
+		{"1", 11, -10, "NaN", InvalidOperation},
+		{"1", 10, -10, "1.0000000000", 0},
+	} {
+		x, _ := new(Decimal).SetString(test.in)
+		r := new(Decimal)
+		if test.emin != 0 {
+			r.SetEmin(test.emin)
+		}
+		r.Rescale(x, test.scale)
+		if s := r.String(); s != test.out {
+			t.Errorf("#%d: Rescale(%s, %d) got: %s want: %s", i, test.in, test.scale, s, test.out)
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("#%d: Rescale(%s, %d) conditions got: %s want: %s", i, test.in, test.scale, c, test.cond)
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/reduce.decTest > reduce_test.go"
+func TestReduce(t *testing.T) {
+	for _, test := range reduceTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Reduce(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Reduce(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/tointegral.decTest > tointegral_test.go"
+func TestToIntegral(t *testing.T) {
+	for _, test := range tointegralTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.ToIntegral(in, test.mode)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: ToIntegral(%s, %s) got: %s want: %s", test.id, test.in, test.mode, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/tointegralx.decTest > tointegralx_test.go"
+func TestToIntegralExact(t *testing.T) {
+	for _, test := range tointegralxTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.ToIntegralExact(in, test.mode)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: ToIntegralExact(%s, %s) got: %s want: %s", test.id, test.in, test.mode, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/nextplus.decTest > nextplus_test.go"
+func TestNextPlus(t *testing.T) {
+	for _, test := range nextplusTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.NextPlus(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: NextPlus(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/nextminus.decTest > nextminus_test.go"
+func TestNextMinus(t *testing.T) {
+	for _, test := range nextminusTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.NextMinus(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: NextMinus(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/nexttoward.decTest > nexttoward_test.go"
+func TestNextToward(t *testing.T) {
+	for _, test := range nexttowardTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.NextToward(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: NextToward(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/max.decTest > max_test.go"
+func TestMax(t *testing.T) {
+	for _, test := range maxTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Max(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Max(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/min.decTest > min_test.go"
+func TestMin(t *testing.T) {
+	for _, test := range minTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Min(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Min(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/maxmag.decTest > maxmag_test.go"
+func TestMaxMag(t *testing.T) {
+	for _, test := range maxmagTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.MaxMag(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: MaxMag(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/minmag.decTest > minmag_test.go"
+func TestMinMag(t *testing.T) {
+	for _, test := range minmagTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.MinMag(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: MinMag(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	for _, test := range []struct {
+		in      string
+		sign    int
+		signbit bool
+		inf     bool
+		isInt   bool
+	}{
+		{"0", 0, false, false, true},
+		{"-0.00", 0, true, false, true},
+		{"1", 1, false, false, true},
+		{"-1.5", -1, true, false, false},
+		{"1.500E+3", 1, false, false, true},
+		{"12.30", 1, false, false, false},
+		{"12.00", 1, false, false, true},
+		{"1E-5", 1, false, false, false},
+		{"Inf", 1, false, true, false},
+		{"-Inf", -1, true, true, false},
+	} {
+		x, _ := new(Decimal).SetString(test.in)
+		if s := x.Sign(); s != test.sign {
+			t.Errorf("Sign(%s) got: %d want: %d", test.in, s, test.sign)
+		}
+		if x.Signbit() != test.signbit {
+			t.Errorf("Signbit(%s) got: %t want: %t", test.in, x.Signbit(), test.signbit)
+		}
+		if x.IsInf() != test.inf {
+			t.Errorf("IsInf(%s) got: %t want: %t", test.in, x.IsInf(), test.inf)
+		}
+		if x.IsInt() != test.isInt {
+			t.Errorf("IsInt(%s) got: %t want: %t", test.in, x.IsInt(), test.isInt)
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/class.decTest > class_test.go"
+func TestClass(t *testing.T) {
+	for _, test := range classTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+		in.SetEmin(test.emin)
+
+		c := in.Class()
+		if c.String() != test.out {
+			t.Errorf("%s: Class(%s) got: %s want: %s", test.id, test.in, c, test.out)
+		}
+		if in.IsNormal() != (c == PositiveNormal || c == NegativeNormal) {
+			t.Errorf("%s: IsNormal(%s) got: %t", test.id, test.in, in.IsNormal())
+		}
+		if in.IsSubnormal() != (c == PositiveSubnormal || c == NegativeSubnormal) {
+			t.Errorf("%s: IsSubnormal(%s) got: %t", test.id, test.in, in.IsSubnormal())
+		}
+		if in.IsZero() != (c == PositiveZero || c == NegativeZero) {
+			t.Errorf("%s: IsZero(%s) got: %t", test.id, test.in, in.IsZero())
+		}
+		if in.IsFinite() != (c >= NegativeNormal && c <= PositiveNormal) {
+			t.Errorf("%s: IsFinite(%s) got: %t", test.id, test.in, in.IsFinite())
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/scaleb.decTest > scaleb_test.go"
+func TestScaleB(t *testing.T) {
+	for _, test := range scalebTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.ScaleB(in, test.n)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: ScaleB(%s, %d) got: %s want: %s", test.id, test.in, test.n, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/logb.decTest > logb_test.go"
+func TestLogB(t *testing.T) {
+	for _, test := range logbTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.LogB(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: LogB(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/copy.decTest > copy_test.go"
+func TestCopy(t *testing.T) {
+	for _, test := range copyTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+		in.SetPrec(test.prec)
+		in.SetMode(test.mode)
+		in.SetEmax(test.emax)
+		in.SetEmin(test.emin)
+		in.SetClamp(test.clamp)
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r2 := r.Copy(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Copy(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if r.Prec() != in.Prec() || r.Mode() != in.Mode() || r.Emax() != in.Emax() || r.Emin() != in.Emin() || r.Clamp() != in.Clamp() {
+			t.Errorf("%s: context got: %d %s %d %d %t want: %d %s %d %d %t", test.id,
+				r.Prec(), r.Mode(), r.Emax(), r.Emin(), r.Clamp(),
+				in.Prec(), in.Mode(), in.Emax(), in.Emin(), in.Clamp())
+		}
+		if r.Acc() != in.Acc() || r.Conditions() != in.Conditions() {
+			t.Errorf("%s: accuracy got: %s %s want: %s %s", test.id, r.Acc(), r.Conditions(), in.Acc(), in.Conditions())
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/copyabs.decTest > copyabs_test.go"
+func TestCopyAbs(t *testing.T) {
+	for _, test := range copyabsTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.CopyAbs(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: CopyAbs(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/copynegate.decTest > copynegate_test.go"
+func TestCopyNegate(t *testing.T) {
+	for _, test := range copynegateTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.CopyNeg(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: CopyNeg(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/copysign.decTest > copysign_test.go"
+func TestCopySign(t *testing.T) {
+	for _, test := range copysignTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.CopySign(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: CopySign(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/samequantum.decTest > samequantum_test.go"
+func TestSameQuantum(t *testing.T) {
+	for _, test := range samequantumTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		r := in1.SameQuantum(in2)
+		if r != test.out {
+			t.Errorf("%s: SameQuantum(%s, %s) got: %t want: %t", test.id, test.in1, test.in2, r, test.out)
+		}
+	}
+}
+
+func TestExponent(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		exp int
+	}{
+		{"0", 0},
+		{"1.00", -2},
+		{"-0.0", -1},
+		{"1E+5", 5},
+		{"123.456E-10", -13},
+		{"Inf", 0},
+		{"-Inf", 0},
+		{"NaN", 0},
+		{"sNaN12", 0},
+	} {
+		x, _ := new(Decimal).SetString(test.in)
+		if e := x.Exponent(); e != test.exp {
+			t.Errorf("Exponent(%s) got: %d want: %d", test.in, e, test.exp)
+		}
+	}
+}
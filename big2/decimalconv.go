@@ -9,6 +9,7 @@ package big2
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"strconv"
 	"strings"
@@ -22,101 +23,172 @@ func dec(z *big.Int) {
 	z.Sub(z, big.NewInt(1))
 }
 
-// round rounds z according to its Prec() and Mode()
+// round rounds z according to its Prec(), Mode() and exponent limits.
+// Finite numbers with an adjusted exponent less than Emin() are subnormal;
+// they are rounded so that their exponent is not less than Etiny. If the
+// adjusted exponent of the rounded result is greater than Emax() z is set
+// to ±Inf or to the largest finite number (see overflow). Zeros have their
+// exponent limited to [Etiny, Emax].
 func (z *Decimal) round() {
 	if z.prec == 0 {
 		panic("shouldn't happen (round of z with prec = 0)")
 	}
-	if z.inf {
+	if z.form != finite {
 		return
 	}
 
-	s := (&z.abs).String()
-	extra := len(s) - int(z.prec)
-	if extra <= 0 {
+	etiny := z.etiny()
+	emax := int64(z.Emax())
+	// largest exponent of a result
+	etop := emax
+	if z.clamp {
+		etop = emax - int64(z.prec) + 1
+	}
+
+	if z.abs.Sign() == 0 {
+		if e := -int64(z.scale); e < etiny {
+			z.setScale(-etiny)
+			z.cond |= Clamped
+		} else if e > etop {
+			z.setScale(-etop)
+			z.cond |= Clamped
+		}
 		return
 	}
 
-	// TODO: check overflow
-	z.scale -= int32(extra)
+	if z.adjExp() < int64(z.Emin()) {
+		z.cond |= Subnormal
+	}
 
-	z.abs.SetString(s[:z.prec], 10)
+	// number of digits to discard
+	digits := int64(z.actualPrec())
+	n := digits - int64(z.prec)
+	if e := n - int64(z.scale); e < etiny {
+		// subnormal
+		n += etiny - e
+	}
+	if n > 0 {
+		z.shr(n, digits)
+		// potentially discard one more digit (in case of 999.9 -> 1000)
+		if int64(z.actualPrec()) > int64(z.prec) {
+			z.abs.Quo(&z.abs, big.NewInt(10))
+			z.setScale(int64(z.scale) - 1)
+		}
+	}
 
-	exact := true
-	for _, d := range s[z.prec:] {
-		if d != '0' {
-			exact = false
-			break
+	if z.cond&Subnormal != 0 && z.cond&Inexact != 0 {
+		z.cond |= Underflow
+		if z.abs.Sign() == 0 {
+			z.cond |= Clamped
 		}
 	}
-	if exact {
+
+	if z.adjExp() > emax {
+		z.overflow()
 		return
 	}
+	if e := -int64(z.scale); e > etop {
+		// fold-down
+		z.abs.Mul(&z.abs, pow10(int(e-etop)))
+		z.setScale(-etop)
+		z.cond |= Clamped
+	}
+}
+
+// roundEven rounds z as round does but using ToNearestEven regardless of
+// z's rounding mode.
+func (z *Decimal) roundEven() {
+	mode := z.mode
+	z.mode = ToNearestEven
+	z.round()
+	z.mode = mode
+}
 
-	d := int(s[z.prec] - '0')
-	incAcc, decAcc := big.Above, big.Below
-	if z.neg {
-		incAcc, decAcc = big.Below, big.Above
+// shr discards the n > 0 least significant digits of z.abs that has the
+// given number of digits, rounds the result according to z.mode and adjusts
+// z.scale. It raises Rounded and, if any of the discarded digits is not zero,
+// Inexact; z.acc is then updated to reflect the rounding direction.
+func (z *Decimal) shr(n, digits int64) {
+	r := new(big.Int)
+	// comparison of the discarded digits with a half of the unit in the
+	// last remaining place
+	half := -1
+	if n > digits {
+		r.Set(&z.abs)
+		z.abs.SetInt64(0)
+	} else {
+		d := pow10(int(n))
+		z.abs.QuoRem(&z.abs, d, r)
+		half = new(big.Int).Lsh(r, 1).Cmp(d)
 	}
-	switch z.mode {
-	case big.ToNearestEven:
-		if d > 5 {
-			z.acc = incAcc
-			inc(&z.abs)
-		} else if d == 5 {
-			exact := true
-			for _, d2 := range s[z.prec+1:] {
-				if d2 != '0' {
-					exact = false
-					break
-				}
-			}
+	z.setScale(int64(z.scale) - n)
+	z.cond |= Rounded
 
-			if exact {
-				switch s[z.prec-1] {
-				case '1', '3', '5', '7', '9':
-					z.acc = incAcc
-					inc(&z.abs)
-				default:
-					z.acc = decAcc
-				}
-			} else {
-				z.acc = incAcc
-				inc(&z.abs)
-			}
-		} else {
-			z.acc = decAcc
-		}
-	case big.ToNearestAway:
-		if d >= 5 {
-			z.acc = incAcc
-			inc(&z.abs)
-		} else {
-			z.acc = decAcc
-		}
-	case big.ToZero:
-		z.acc = decAcc
-	case big.AwayFromZero:
-		z.acc = incAcc
+	if r.Sign() == 0 {
+		return
+	}
+	z.cond |= Inexact
+
+	var up bool
+	switch z.mode {
+	case ToNearestEven:
+		up = half > 0 || half == 0 && z.abs.Bit(0) == 1
+	case ToNearestAway:
+		up = half >= 0
+	case ToZero:
+		up = false
+	case AwayFromZero:
+		up = true
+	case ToNegativeInf:
+		up = z.neg
+	case ToPositiveInf:
+		up = !z.neg
+	case ToNearestZero:
+		up = half > 0
+	case ZeroFiveUp:
+		d := new(big.Int).Rem(&z.abs, big.NewInt(10)).Int64()
+		up = d == 0 || d == 5
+	}
+	if up {
 		inc(&z.abs)
-	case big.ToNegativeInf:
-		if z.neg {
-			z.acc = incAcc
-			inc(&z.abs)
-		} else {
-			z.acc = decAcc
-		}
-	case big.ToPositiveInf:
-		if !z.neg {
-			z.acc = incAcc
-			inc(&z.abs)
-		} else {
-			z.acc = decAcc
-		}
 	}
 
-	// potentially round one more time (in case of 999.9 -> 1000)
-	z.round()
+	if up != z.neg {
+		z.acc = big.Above
+	} else {
+		z.acc = big.Below
+	}
+}
+
+// overflow sets z to ±Inf or to the largest finite number with the sign of z
+// depending on its rounding mode.
+func (z *Decimal) overflow() {
+	z.cond |= Overflow | Inexact | Rounded
+	inf := true
+	switch z.mode {
+	case ToZero, ZeroFiveUp:
+		inf = false
+	case ToNegativeInf:
+		inf = z.neg
+	case ToPositiveInf:
+		inf = !z.neg
+	}
+
+	if inf {
+		z.form = infinite
+		z.scale = 0
+		z.abs.SetInt64(0)
+	} else {
+		// (10^prec - 1) * 10^(Emax - prec + 1)
+		z.abs.Sub(pow10(int(z.prec)), big.NewInt(1))
+		z.setScale(int64(z.prec) - 1 - int64(z.Emax()))
+	}
+
+	if inf != z.neg {
+		z.acc = big.Above
+	} else {
+		z.acc = big.Below
+	}
 }
 
 // TODO: update docs
@@ -127,11 +199,11 @@ func (z *Decimal) SetString(s string) (*Decimal, bool) {
 	// TODO: what if z is null?
 	// TODO: when returning nil, false is it ok to change z?
 	// TODO: default to 0 (like Float) or accept base as argument (like Int)?
-	// TODO: handle overflow and underflow (based on Emax, Emin, Elimit)
-	// TODO: rounding if prec != 0
 	if s == "" {
 		return nil, false
 	}
+	z.acc = big.Exact
+	z.cond = 0
 
 	// set sign
 	if s[0] == '-' {
@@ -146,10 +218,15 @@ func (z *Decimal) SetString(s string) (*Decimal, bool) {
 
 	// special values
 	if s == "inf" || s == "Inf" {
-		z.inf = true
+		z.form = infinite
+		return z, true
+	} else if l := strings.ToLower(s); strings.HasPrefix(l, "nan") || strings.HasPrefix(l, "snan") {
+		if !z.setNaNString(l) {
+			return nil, false
+		}
 		return z, true
 	} else {
-		z.inf = false
+		z.form = finite
 	}
 
 	// handle ++, --, etc
@@ -158,33 +235,35 @@ func (z *Decimal) SetString(s string) (*Decimal, bool) {
 	}
 
 	// exponent
-	var exp int32
+	var exp int64
 	e := strings.Index(s, "e")
 	if e < 0 {
 		e = strings.Index(s, "E")
 	}
 	if e > 0 {
-		exp64, err := strconv.ParseInt(s[e+1:], 10, 64)
-		if err != nil {
+		var err error
+		exp, err = strconv.ParseInt(s[e+1:], 10, 64)
+		if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
 			return nil, false
 		}
-		if exp64 > big.MaxExp {
-			z.inf = true
-			return z, true // TODO: return true or false here (check Float)
+		// huge exponents always overflow or underflow, limit them to avoid
+		// overflows when computing the scale
+		if exp > math.MaxInt32 {
+			exp = math.MaxInt32
+		} else if exp < math.MinInt32 {
+			exp = math.MinInt32
 		}
 		s = s[:e]
-		exp = int32(exp64)
 	}
 
 	// scale
+	var scale int64
 	p := strings.LastIndex(s, ".")
 	if p >= 0 {
-		z.scale = int32(len(s) - p - 1)
+		scale = int64(len(s) - p - 1)
 		s = s[:p] + s[p+1:]
-	} else {
-		z.scale = 0
 	}
-	z.scale -= exp
+	z.setScale(scale - exp)
 
 	_, ok := (&z.abs).SetString(s, 10)
 	if !ok {
@@ -192,16 +271,43 @@ func (z *Decimal) SetString(s string) (*Decimal, bool) {
 	}
 
 	// precision
-	z.acc = big.Exact
 	if z.prec == 0 {
 		z.prec = uint32(len((&z.abs).String()))
-	} else {
-		z.round()
 	}
+	z.round()
 
 	return z, true
 }
 
+// setNaNString sets z to the NaN represented by the lower-case string s
+// without a sign, i.e. "nan" or "snan" followed by an optional payload of
+// decimal digits, and reports whether s is valid. The payload must fit into
+// z's precision (see maxPayload).
+func (z *Decimal) setNaNString(s string) bool {
+	if strings.HasPrefix(s, "snan") {
+		z.form = snan
+		s = s[4:]
+	} else {
+		z.form = qnan
+		s = s[3:]
+	}
+	z.scale = 0
+	z.abs.SetInt64(0)
+	if s == "" {
+		return true
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	z.abs.SetString(s, 10)
+	if max := z.maxPayload(); max >= 0 && z.abs.Sign() != 0 && int64(z.actualPrec()) > max {
+		return false
+	}
+	return true
+}
+
 // TODO: update docs
 // TODO: pass scale
 // Parse parses s which must contain a text representation of a floating-
@@ -249,7 +355,7 @@ func (z *Decimal) Parse(s string, base int) (d *Decimal, b int, err error) {
 // TODO: pass sclae?
 // ParseFloat is like f.Parse(s, base) with f set to the given precision
 // and rounding mode.
-func ParseDecimal(s string, base int, prec uint, mode big.RoundingMode) (d *Decimal, b int, err error) {
+func ParseDecimal(s string, base int, prec uint, mode RoundingMode) (d *Decimal, b int, err error) {
 	// TODO: implement
 	return new(Decimal).Parse(s, base)
 }
@@ -263,9 +369,19 @@ func (x *Decimal) String() string {
 	}
 
 	var s string
-	if x.inf {
+	switch x.form {
+	case infinite:
 		s = "Inf"
-	} else {
+	case qnan, snan:
+		if x.form == snan {
+			s = "sNaN"
+		} else {
+			s = "NaN"
+		}
+		if x.abs.Sign() != 0 {
+			s += x.abs.String()
+		}
+	default:
 		s = x.sciString()
 	}
 
@@ -53,6 +53,15 @@ var stringTests = []struct {
 	{in: "2E-1", ok: true, out: "0.2", unscaled: "2", scale: 1, prec: 1},
 	{in: "0.9e99999999991", ok: true, out: "Inf"},
 	{in: "-0.9e99999999991", ok: true, out: "-Inf"},
+	{in: "NaN", ok: true},
+	{in: "-nan", ok: true, out: "-NaN"},
+	{in: "NaN0", ok: true, out: "NaN"},
+	{in: "NaN0123", ok: true, out: "NaN123"},
+	{in: "sNaN", ok: true},
+	{in: "-SNAN45", ok: true, out: "-sNaN45"},
+	{in: "NaN1.2", ok: false},
+	{in: "NaNs", ok: false},
+	{in: "NaN-1", ok: false},
 }
 
 func TestSetGetString(t *testing.T) {
@@ -112,6 +121,28 @@ func TestSetGetString(t *testing.T) {
 	}
 }
 
+func TestIsNaN(t *testing.T) {
+	for _, test := range []struct {
+		in        string
+		nan, snan bool
+	}{
+		{"1", false, false},
+		{"Inf", false, false},
+		{"NaN", true, false},
+		{"-NaN12", true, false},
+		{"sNaN", true, true},
+		{"-sNaN3", true, true},
+	} {
+		x, _ := new(Decimal).SetString(test.in)
+		if x.IsNaN() != test.nan {
+			t.Errorf("IsNaN(%s) got: %t want: %t", test.in, x.IsNaN(), test.nan)
+		}
+		if x.IsSNaN() != test.snan {
+			t.Errorf("IsSNaN(%s) got: %t want: %t", test.in, x.IsSNaN(), test.snan)
+		}
+	}
+}
+
 func TestNotInitializedGetString(t *testing.T) {
 	// nil
 	var x *Decimal = nil
@@ -130,24 +161,12 @@ func TestNotInitializedGetString(t *testing.T) {
 //go:generate bash -c "dectest < ~/tmp/dectest/base.decTest > tosci_test.go"
 func TestToSci(t *testing.T) {
 	for _, test := range toSciTests {
-		switch test.id {
-		case "basx906", "basx907",
-			"basx917", "basx918", "basx919", "basx929", "basx930", "basx933",
-			"basx931", "basx932", "basx937", "basx938", "basx939", "basx940", "basx941",
-			"basx1006", "basx1023", "basx1033":
-			// TODO: skip in dectest (or use proper max exponent)
-			t.Logf("%s: Emax not supported", test.id)
-			continue
-		case "basx913", "basx915", "basx916", "basx925", "basx927", "basx928", "basx934", "basx935", "basx936",
-			"basx1024", "basx1025", "basx1026", "basx1034", "basx1035", "basx1036", "basx1041", "basx1042", "basx1043":
-			// TODO: investigate
-			t.Logf("%s: not implemented", test.id)
-			continue
-		}
-
 		in := new(Decimal)
 		in.SetPrec(test.prec)
 		in.SetMode(test.mode)
+		in.SetEmax(test.emax)
+		in.SetEmin(test.emin)
+		in.SetClamp(test.clamp)
 		_, ok := in.SetString(test.in)
 
 		if test.out == "" {
@@ -167,6 +186,44 @@ func TestToSci(t *testing.T) {
 				t.Errorf("%s: toSci('%s', %d, %s) got %s want %s",
 					test.id, test.in, test.prec, test.mode, s, test.out)
 			}
+			if c := in.Conditions(); c != test.cond {
+				t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/clamp.decTest > clamp_test.go"
+func TestApply(t *testing.T) {
+	for _, test := range applyTests {
+		in := new(Decimal)
+		in.SetPrec(test.prec)
+		in.SetMode(test.mode)
+		in.SetEmax(test.emax)
+		in.SetEmin(test.emin)
+		in.SetClamp(test.clamp)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		s := in.String()
+		if s != test.out {
+			t.Errorf("%s: apply('%s', %d, %s) got %s want %s",
+				test.id, test.in, test.prec, test.mode, s, test.out)
+		}
+		if c := in.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if in.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if in.Acc() != big.Exact {
+				t.Errorf("%s: expected exact result", test.id)
+			}
 		}
 	}
 }
@@ -0,0 +1,153 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the digit-wise logical operations and the shifting
+// and rotation of coefficient digits.
+//
+// The operands of the logical operations are logical numbers: finite
+// non-negative numbers with a scale of 0 whose coefficients consist of the
+// digits 0 and 1 only. For all operations in this file, the coefficients
+// are aligned to exactly Prec() digits by removing excess leading digits or
+// padding with zeros before the operation.
+
+package big2
+
+import (
+	"math/big"
+	"strings"
+)
+
+// And sets z to the digit-wise logical conjunction of x and y and returns
+// z. The result is a logical number with a scale of 0. If z's precision is
+// 0, it is changed to the larger of x's or y's precision (or to the number
+// of digits of the longer operand if both are 0). If x or y is not a
+// logical number (including NaNs), z is set to NaN and InvalidOperation is
+// raised.
+func (z *Decimal) And(x, y *Decimal) *Decimal {
+	return z.logical(x, y, func(a, b byte) byte {
+		return a & b
+	})
+}
+
+// Or sets z to the digit-wise logical disjunction of x and y and returns z.
+// See And.
+func (z *Decimal) Or(x, y *Decimal) *Decimal {
+	return z.logical(x, y, func(a, b byte) byte {
+		return a | b
+	})
+}
+
+// Xor sets z to the digit-wise logical exclusive disjunction of x and y and
+// returns z. See And.
+func (z *Decimal) Xor(x, y *Decimal) *Decimal {
+	return z.logical(x, y, func(a, b byte) byte {
+		return a ^ b
+	})
+}
+
+// Invert sets z to the digit-wise logical negation of x and returns z. All
+// Prec() digits of x are inverted, including the leading zeros. See And.
+func (z *Decimal) Invert(x *Decimal) *Decimal {
+	return z.logical(x, x, func(a, _ byte) byte {
+		return a ^ 1
+	})
+}
+
+// logical sets z to the result of op applied to the corresponding digits
+// of x and y and returns z.
+func (z *Decimal) logical(x, y *Decimal, op func(a, b byte) byte) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if !x.isLogical() || !y.isLogical() {
+		return z.setNaN(InvalidOperation)
+	}
+	z.setQuoPrec(x, y)
+
+	a := alignDigits(&x.abs, int(z.prec))
+	b := alignDigits(&y.abs, int(z.prec))
+	buf := make([]byte, len(a))
+	for i := range buf {
+		buf[i] = '0' + op(a[i]-'0', b[i]-'0')
+	}
+	z.form = finite
+	z.neg = false
+	z.scale = 0
+	z.abs.SetString(string(buf), 10)
+	return z
+}
+
+// Shift sets z to x with the digits of its coefficient shifted n places to
+// the left, or -n places to the right if n is negative, and returns z.
+// Digits shifted out of the Prec() digits of the coefficient are discarded
+// and zeros are shifted in. The sign and the exponent of x are preserved
+// and an infinity is not changed. If z's precision is 0, it is changed to
+// x's precision (or to the number of digits of x if that is also 0). If
+// |n| > Prec(), z is set to NaN and InvalidOperation is raised. NaN handling
+// is as for Set.
+func (z *Decimal) Shift(x *Decimal, n int) *Decimal {
+	return z.shift(x, n, false)
+}
+
+// Rotate sets z to x with the digits of its coefficient rotated n places to
+// the left, or -n places to the right if n is negative, and returns z.
+// Digits rotated out of the Prec() digits of the coefficient at one end
+// are rotated in at the other end. See Shift.
+func (z *Decimal) Rotate(x *Decimal, n int) *Decimal {
+	return z.shift(x, n, true)
+}
+
+// shift implements Shift and Rotate.
+func (z *Decimal) shift(x *Decimal, n int, rotate bool) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+	z.setQuoPrec(x, x)
+	p := int(z.prec)
+	if n < -p || n > p {
+		return z.setNaN(InvalidOperation)
+	}
+	z.form = x.form
+	z.neg = x.neg
+	if x.form == infinite {
+		return z
+	}
+
+	s := alignDigits(&x.abs, p)
+	switch {
+	case rotate:
+		if n < 0 {
+			n += p
+		}
+		s = s[n:] + s[:n]
+	case n < 0:
+		s = strings.Repeat("0", -n) + s[:p+n]
+	default:
+		s = s[n:] + strings.Repeat("0", n)
+	}
+	z.scale = x.scale
+	z.abs.SetString(s, 10)
+	return z
+}
+
+// isLogical reports whether x is a logical number.
+func (x *Decimal) isLogical() bool {
+	if x.form != finite || x.neg || x.scale != 0 {
+		return false
+	}
+	return strings.Trim(x.abs.String(), "01") == ""
+}
+
+// alignDigits returns the n least significant digits of x padded with
+// leading zeros to n digits.
+func alignDigits(x *big.Int, n int) string {
+	s := x.String()
+	if len(s) > n {
+		return s[len(s)-n:]
+	}
+	return strings.Repeat("0", n-len(s)) + s
+}
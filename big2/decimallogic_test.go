@@ -0,0 +1,309 @@
+package big2
+
+import (
+	"math/big"
+	"testing"
+)
+
+//go:generate bash -c "dectest < ~/tmp/dectest/and.decTest > and_test.go"
+func TestAnd(t *testing.T) {
+	for _, test := range andTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.And(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: And(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/or.decTest > or_test.go"
+func TestOr(t *testing.T) {
+	for _, test := range orTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Or(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Or(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/xor.decTest > xor_test.go"
+func TestXor(t *testing.T) {
+	for _, test := range xorTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Xor(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Xor(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/invert.decTest > invert_test.go"
+func TestInvert(t *testing.T) {
+	for _, test := range invertTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Invert(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Invert(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/shift.decTest > shift_test.go"
+func TestShift(t *testing.T) {
+	for _, test := range shiftTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Shift(in, test.n)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Shift(%s, %d) got: %s want: %s", test.id, test.in, test.n, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/rotate.decTest > rotate_test.go"
+func TestRotate(t *testing.T) {
+	for _, test := range rotateTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Rotate(in, test.n)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Rotate(%s, %d) got: %s want: %s", test.id, test.in, test.n, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
@@ -0,0 +1,626 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the exponential and logarithm functions.
+//
+// The results are computed with fixed-point arithmetic on big.Int values
+// scaled by a power of 10. An approximation with an error of less than 2
+// units in its last place is rounded only if both ends of the error interval
+// round to the same value; otherwise it is recomputed with more digits
+// (Ziv's strategy). Since the exact results of these functions are never
+// halfway between two decimal numbers (apart from the special cases that are
+// handled separately), this yields correctly rounded results.
+
+package big2
+
+import (
+	"math"
+	"math/big"
+)
+
+// guard is the number of extra digits used by the fixed-point computations
+// to keep the accumulated error below one unit in the last place.
+const guard = 12
+
+// Exp sets z to the rounded value of e**x and returns z. The result is
+// always rounded using ToNearestEven; z's rounding mode is ignored. If z's
+// precision is 0, it is changed to x's precision (or to the number of
+// digits of x if that is also 0) before the operation. Exp(±0) = 1,
+// Exp(-Inf) = 0 and Exp(+Inf) = +Inf are exact; any other result is inexact
+// and may overflow or underflow. NaN handling is as for Add.
+func (z *Decimal) Exp(x *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return z
+	}
+
+	z.setQuoPrec(x, x)
+
+	if x.form == infinite {
+		if x.neg {
+			// e**-Inf = 0
+			z.form = finite
+			z.neg = false
+			z.scale = 0
+			z.abs.SetInt64(0)
+		} else {
+			z.form = infinite
+			z.neg = false
+		}
+		return z
+	}
+
+	z.form = finite
+	z.neg = false
+	if x.isZero() {
+		z.scale = 0
+		z.abs.SetInt64(1)
+		z.roundEven()
+		return z
+	}
+
+	p := int64(z.prec)
+	switch e := x.adjExp(); {
+	case e >= 10:
+		// |x| >= 1e10, e**x is certainly out of the range of the exponents
+		z.abs.SetInt64(1)
+		if x.neg {
+			z.setScale(math.MaxInt32)
+		} else {
+			z.setScale(math.MinInt32)
+		}
+		z.roundEven()
+
+	case e < -p-1:
+		// |x| < 10**-(p+1), e**x is within 1 ± 10**-(p+1) which rounds to 1
+		z.abs.Set(pow10(int(p + 1)))
+		if x.neg {
+			dec(&z.abs)
+		} else {
+			inc(&z.abs)
+		}
+		z.setScale(p + 1)
+		z.roundEven()
+
+	default:
+		for s := p + 3; ; s *= 2 {
+			a, k := expFixed(fixed(x, s+guard), s)
+			if z.setApprox(a, s-k, ToNearestEven) {
+				break
+			}
+		}
+	}
+	return z
+}
+
+// Ln sets z to the rounded natural logarithm of x and returns z. Rounding
+// and precision are as for Exp. Ln(1) = 0, Ln(±0) = -Inf and
+// Ln(+Inf) = +Inf are exact; any other result is inexact. If x is negative
+// (including -Inf), z is set to NaN and InvalidOperation is raised.
+// NaN handling is as for Add.
+func (z *Decimal) Ln(x *Decimal) *Decimal {
+	if z.logSpecial(x) {
+		return z
+	}
+	if isPow10(x) && x.adjExp() == 0 {
+		// ln(1) = 0
+		z.form = finite
+		z.neg = false
+		z.scale = 0
+		z.abs.SetInt64(0)
+		return z
+	}
+
+	for s := int64(z.prec) + 3; ; s *= 2 {
+		a := lnFixed(x, s+guard)
+		a.Quo(a, pow10(guard))
+		if z.setApprox(a, s, ToNearestEven) {
+			break
+		}
+	}
+	return z
+}
+
+// Log10 sets z to the rounded base-10 logarithm of x and returns z.
+// Rounding and precision are as for Exp. If x is an exact power of ten 10**n
+// the result is the integer n (rounded if it has more than z's precision
+// digits); Log10(±0) = -Inf and Log10(+Inf) = +Inf are exact; any other
+// result is inexact. If x is negative (including -Inf), z is set to NaN and
+// InvalidOperation is raised. NaN handling is as for Add.
+func (z *Decimal) Log10(x *Decimal) *Decimal {
+	if z.logSpecial(x) {
+		return z
+	}
+	if isPow10(x) {
+		n := x.adjExp()
+		z.form = finite
+		z.neg = n < 0
+		if n < 0 {
+			n = -n
+		}
+		z.scale = 0
+		z.abs.SetInt64(n)
+		z.roundEven()
+		return z
+	}
+
+	for s := int64(z.prec) + 3; ; s *= 2 {
+		t := s + guard
+		a := lnFixed(x, t)
+		a.Mul(a, pow10(int(t)))
+		a.Quo(a, ln10Fixed(t))
+		a.Quo(a, pow10(guard))
+		if z.setApprox(a, s, ToNearestEven) {
+			break
+		}
+	}
+	return z
+}
+
+// Pow sets z to the rounded value of x**y and returns z. Precision is as for
+// Quo; the result is rounded according to z's rounding mode. If y is an
+// integer, an exact result has the exponent of x multiplied by y (or, for
+// y < 0, the exponent of 1/x**-y as for Quo). Otherwise x**y is computed as
+// e**(y×ln(x)) and the result is correctly rounded; it is always reported
+// as inexact, and an exact result has its coefficient padded with zeros to
+// z's precision. Special cases are:
+//
+//	x**±0 = 1 for any x other than ±0 or NaN
+//	±0**y = ±0 for y > 0 and ±Inf for y < 0
+//	±Inf**y = ±Inf for y > 0 and ±0 for y < 0
+//	x**+Inf = +0 for |x| < 1 and +Inf for |x| > 1 (and vice versa for -Inf)
+//	1**±Inf = 1 with z's precision, inexact
+//
+// where the sign of the result is negative only if x is negative and y is
+// an odd integer. If both x and y are zero, or if x is negative and y is not
+// an integer (including ±Inf), z is set to NaN and InvalidOperation is
+// raised. NaN handling is as for Add.
+func (z *Decimal) Pow(x, y *Decimal) *Decimal {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, y) {
+		return z
+	}
+	yint := y.isInteger()
+	if x.neg && !x.isZero() && !yint {
+		return z.setNaN(InvalidOperation)
+	}
+	if y.isZero() {
+		if x.isZero() {
+			return z.setNaN(InvalidOperation)
+		}
+		z.setQuoPrec(x, y)
+		z.form = finite
+		z.neg = false
+		z.scale = 0
+		z.abs.SetInt64(1)
+		z.round()
+		return z
+	}
+
+	z.setQuoPrec(x, y)
+	z.neg = x.neg && yint && y.isOdd()
+
+	if x.isZero() || x.form == infinite {
+		if y.neg == x.isZero() {
+			z.form = infinite
+		} else {
+			z.form = finite
+			z.scale = 0
+			z.abs.SetInt64(0)
+		}
+		return z
+	}
+
+	one := new(Decimal)
+	one.abs.SetInt64(1)
+	if y.form == infinite {
+		// x is positive here
+		switch c := x.ucmp(one); {
+		case c == 0:
+			z.form = finite
+			z.scale = 0
+			z.abs.SetInt64(1)
+			z.padInexact()
+		case (c > 0) != y.neg:
+			z.form = infinite
+		default:
+			z.form = finite
+			z.scale = 0
+			z.abs.SetInt64(0)
+		}
+		return z
+	}
+
+	z.form = finite
+	// x = c × 10**e with c without trailing zeros
+	c := new(big.Int).Set(&x.abs)
+	tz := trimZeros(c, int64(x.actualPrec()))
+	e := mulSat(-int64(x.scale), 1) + tz
+	isOne := c.Cmp(&one.abs) == 0 && e == 0
+	if yint {
+		if n, ok := y.toInt64(); (ok || isOne) && z.powInt(c, tz, e, n) {
+			return z
+		}
+	} else if r, re, n, ok := rootExact(c, e, y); ok && z.powInt(r, 0, re, n) {
+		// the result of a non-integer power is always inexact
+		if z.cond&Inexact == 0 {
+			z.padInexact()
+		}
+		return z
+	}
+
+	// x**y = e**(y×ln(|x|))
+	p := int64(z.prec)
+	extra := y.adjExp() + 1
+	if extra < 0 {
+		extra = 0
+	}
+	for s := p + 3; ; s *= 2 {
+		t := s + guard
+		w := t + guard + extra
+		ylnx := fixed(y, w)
+		ylnx.Mul(ylnx, lnFixed(x, w))
+		ylnx.Quo(ylnx, pow10(int(2*w-t)))
+
+		if s == p+3 {
+			if ylnx.CmpAbs(pow10(int(t+10))) >= 0 {
+				// |y×ln(|x|)| >= 1e10, the result certainly overflows or
+				// underflows
+				z.abs.SetInt64(1)
+				if ylnx.Sign() > 0 {
+					z.setScale(math.MinInt32)
+				} else {
+					z.setScale(math.MaxInt32)
+				}
+				z.round()
+				return z
+			}
+			if ylnx.CmpAbs(pow10(int(t-p-2))) < 0 {
+				// |y×ln(|x|)| < 10**-(p+2), the result is within
+				// 1 ± 10**-(p+1) on the same side of 1 as the exact result
+				z.abs.Set(pow10(int(p + 1)))
+				if (x.ucmp(one) > 0) != y.neg {
+					inc(&z.abs)
+				} else {
+					dec(&z.abs)
+				}
+				z.setScale(p + 1)
+				z.round()
+				return z
+			}
+		}
+
+		a, k := expFixed(ylnx, s)
+		if z.neg {
+			a.Neg(a)
+		}
+		if z.setApprox(a, s-k, z.mode) {
+			return z
+		}
+	}
+}
+
+// padInexact pads the coefficient of a finite z with zeros to z's precision
+// (as far as the exponent limits allow) and marks z as inexact. It is used
+// for exact results of operations that are defined to be inexact.
+func (z *Decimal) padInexact() {
+	n := int64(z.prec) - int64(z.actualPrec())
+	if !z.isZero() {
+		if max := -int64(z.scale) - z.etiny(); n > max {
+			n = max
+		}
+	}
+	if n > 0 {
+		z.abs.Mul(&z.abs, pow10(int(n)))
+		z.setScale(int64(z.scale) + n)
+	}
+	z.cond |= Inexact | Rounded
+	// the direction of the error is unknown
+	z.acc = big.Below
+}
+
+// powInt sets z to the rounded value of x**n where x = c × 10**e, c has no
+// trailing zeros and tz is the number of trailing zeros of the coefficient
+// of x. The sign of z must be set. powInt computes the result exactly if
+// that is cheap and reports whether it did so; otherwise the exact result
+// has more than z.prec+1 significant digits and z is not modified.
+func (z *Decimal) powInt(c *big.Int, tz, e, n int64) bool {
+	p := int64(z.prec)
+	an := n
+	if an < 0 {
+		an = -an
+	}
+	// c**an has at least (c.BitLen()-1)×an×log10(2) digits and for n < 0
+	// 1/c**an (if finite) has at least (c.BitLen()-1)×an×log10(5)/log2(5)
+	// digits
+	if b := int64(c.BitLen()) - 1; b > 0 && an > 8*(p+2)/b {
+		return false
+	}
+	cn := new(big.Int).Exp(c, big.NewInt(an), nil)
+
+	if n > 0 {
+		// x**n = cn × 10**(e×n); keep as many of the trailing zeros of
+		// the exact coefficient as fit into the precision so that the
+		// exponent is as close as possible to the exponent of x times n
+		m := mulSat(tz, n)
+		if max := p + 1 - int64(len(cn.String())); m > max {
+			m = max
+		}
+		if m < 0 {
+			m = 0
+		}
+		z.abs.Mul(cn, pow10(int(m)))
+		z.setScale(m - mulSat(e, n))
+		z.round()
+		return true
+	}
+
+	// x**n = 1 / x**-n
+	var d, one Decimal
+	d.abs.Set(cn)
+	d.setScale(-mulSat(e, an))
+	one.abs.SetInt64(1)
+	z.quo(&one, &d)
+	z.round()
+	return true
+}
+
+// rootExact checks whether x**y is exactly c**n × 10**re for an integer n
+// and integers c and re, where x = c0 × 10**e0 (c0 without trailing zeros)
+// and y is not an integer. This is the case if y = n/d in lowest terms and
+// x**(1/d) is a decimal number c × 10**re. n is saturated if it doesn't fit
+// into an int64.
+func rootExact(c0 *big.Int, e0 int64, y *Decimal) (c *big.Int, re, n int64, ok bool) {
+	// y = m / 10**k
+	m := new(big.Int).Set(&y.abs)
+	k := int64(y.scale)
+	k -= trimZeros(m, k)
+	d := pow10(int(k))
+	g := new(big.Int).GCD(nil, nil, m, d)
+	m.Quo(m, g)
+	d.Quo(d, g)
+	n = maxSat
+	if m.IsInt64() && m.Int64() < maxSat {
+		n = m.Int64()
+	}
+	if y.neg {
+		n = -n
+	}
+
+	if c0.Cmp(big.NewInt(1)) == 0 {
+		// x = 10**e0
+		if e0 == 0 {
+			return c0, 0, n, true
+		}
+		if !d.IsInt64() || e0%d.Int64() != 0 {
+			return nil, 0, 0, false
+		}
+		return c0, e0 / d.Int64(), n, true
+	}
+	// c0 >= 2 has a d-th root only if d < c0.BitLen()
+	if !d.IsInt64() || d.Int64() >= int64(c0.BitLen()) {
+		return nil, 0, 0, false
+	}
+	dd := d.Int64()
+	if e0%dd != 0 {
+		return nil, 0, 0, false
+	}
+	c = iroot(c0, dd)
+	if new(big.Int).Exp(c, d, nil).Cmp(c0) != 0 {
+		return nil, 0, 0, false
+	}
+	return c, e0 / dd, n, true
+}
+
+// iroot returns the integer part of the n-th root of x > 0.
+func iroot(x *big.Int, n int64) *big.Int {
+	// Newton's iteration starting above the root
+	bn := big.NewInt(n)
+	bn1 := big.NewInt(n - 1)
+	r := new(big.Int).Lsh(big.NewInt(1), uint((int64(x.BitLen())+n-1)/n))
+	t := new(big.Int)
+	for {
+		// r' = ((n-1)×r + x/r**(n-1)) / n
+		t.Exp(r, bn1, nil)
+		t.Quo(x, t)
+		t.Add(t, new(big.Int).Mul(bn1, r))
+		t.Quo(t, bn)
+		if t.Cmp(r) >= 0 {
+			return r
+		}
+		r.Set(t)
+	}
+}
+
+// logSpecial sets z to the result of a logarithm of x if x is a NaN, a zero,
+// an infinity or a negative number and reports whether it did so. Otherwise
+// it only prepares z for the computation of the logarithm.
+func (z *Decimal) logSpecial(x *Decimal) bool {
+	z.acc = big.Exact
+	z.cond = 0
+
+	if z.nan(x, nil) {
+		return true
+	}
+	if x.neg && !x.isZero() {
+		z.setNaN(InvalidOperation)
+		return true
+	}
+
+	z.setQuoPrec(x, x)
+
+	switch {
+	case x.isZero():
+		// log(±0) = -Inf
+		z.form = infinite
+		z.neg = true
+		return true
+	case x.form == infinite:
+		z.form = infinite
+		z.neg = false
+		return true
+	}
+	return false
+}
+
+// setApprox sets z to the value a × 10**-scale rounded using mode if the
+// exact result, which must be within 2 units of the last place of a, is
+// known to round to the same value. It reports whether z was set.
+func (z *Decimal) setApprox(a *big.Int, scale int64, mode RoundingMode) bool {
+	lo := Decimal{prec: z.prec, mode: mode, emaxDiff: z.emaxDiff, eminDiff: z.eminDiff, clamp: z.clamp}
+	hi := lo
+	lo.setFixed(new(big.Int).Sub(a, big.NewInt(2)), scale)
+	hi.setFixed(new(big.Int).Add(a, big.NewInt(2)), scale)
+	if lo.form != hi.form || lo.neg != hi.neg || lo.scale != hi.scale ||
+		lo.abs.Cmp(&hi.abs) != 0 || lo.acc != hi.acc || lo.cond != hi.cond {
+		return false
+	}
+	z.form = hi.form
+	z.neg = hi.neg
+	z.scale = hi.scale
+	z.abs.Set(&hi.abs)
+	z.acc = hi.acc
+	z.cond |= hi.cond
+	return true
+}
+
+// setFixed sets z to the value a × 10**-scale rounded according to z.
+func (z *Decimal) setFixed(a *big.Int, scale int64) {
+	z.form = finite
+	z.neg = a.Sign() < 0
+	z.abs.Abs(a)
+	z.setScale(scale)
+	z.round()
+}
+
+// isPow10 reports whether a positive finite x is an exact power of ten.
+func isPow10(x *Decimal) bool {
+	c := new(big.Int).Set(&x.abs)
+	trimZeros(c, int64(x.actualPrec()))
+	return c.Cmp(big.NewInt(1)) == 0
+}
+
+// fixed returns a finite x as a fixed-point number with the given scale,
+// i.e. x × 10**scale truncated towards zero.
+func fixed(x *Decimal, scale int64) *big.Int {
+	var a *big.Int
+	if n := scale - int64(x.scale); n >= 0 {
+		a = mulPow10(&x.abs, int(n))
+	} else if -n > int64(x.actualPrec()) {
+		a = new(big.Int)
+	} else {
+		a = new(big.Int).Quo(&x.abs, pow10(int(-n)))
+	}
+	if x.neg {
+		a.Neg(a)
+	}
+	return a
+}
+
+// expFixed returns a and k such that a × 10**(k-s) approximates e**x with
+// an error of less than 2 units in the last place of a. a has at least s
+// digits. x is a fixed-point number with scale s+guard and an error of a few
+// units in the last place; |x| must be less than 1e10.
+func expFixed(x *big.Int, s int64) (a *big.Int, k int64) {
+	// e**x = 10**k × e**r with r = x - k×ln(10), |r| < ln(10)
+	t := s + guard
+	l := ln10Fixed(t)
+	r := new(big.Int).Set(x)
+	kb := new(big.Int).Quo(r, l)
+	r.Sub(r, new(big.Int).Mul(kb, l))
+
+	// Taylor series
+	one := pow10(int(t))
+	a = new(big.Int).Set(one)
+	term := new(big.Int).Set(one)
+	for n := int64(1); term.Sign() != 0; n++ {
+		term.Mul(term, r)
+		term.Quo(term, one)
+		term.Quo(term, big.NewInt(n))
+		a.Add(a, term)
+	}
+	a.Quo(a, pow10(guard))
+	return a, kb.Int64()
+}
+
+// lnFixed returns ln(x) of a positive finite x as a fixed-point number with
+// the given scale. The error is less than 3 units in the last place.
+func lnFixed(x *Decimal, scale int64) *big.Int {
+	// ln(x) = k×ln(10) + j×ln(2) + ln(y) with 0.75 <= y < 1.5 and
+	// ln(y) = 2×atanh((y-1)/(y+1))
+	w := scale + guard
+	c := &x.abs
+	d := pow10(int(x.actualPrec()) - 1)
+	var j int64
+	for c2 := new(big.Int).Lsh(c, 1); c2.Cmp(new(big.Int).Mul(d, big.NewInt(3))) >= 0; j++ {
+		d.Lsh(d, 1)
+	}
+
+	one := pow10(int(w))
+	num := new(big.Int).Sub(c, d)
+	num.Mul(num, one)
+	t := num.Quo(num, new(big.Int).Add(c, d))
+	t2 := new(big.Int).Mul(t, t)
+	t2.Quo(t2, one)
+
+	sum := new(big.Int).Set(t)
+	term := new(big.Int).Set(t)
+	for n := int64(3); ; n += 2 {
+		term.Mul(term, t2)
+		term.Quo(term, one)
+		if term.Sign() == 0 {
+			break
+		}
+		sum.Add(sum, new(big.Int).Quo(term, big.NewInt(n)))
+	}
+	sum.Lsh(sum, 1)
+
+	if k := x.adjExp(); k != 0 {
+		sum.Add(sum, new(big.Int).Mul(big.NewInt(k), ln10Fixed(w)))
+	}
+	if j != 0 {
+		sum.Add(sum, new(big.Int).Mul(big.NewInt(j), ln2Fixed(w)))
+	}
+	return sum.Quo(sum, pow10(guard))
+}
+
+// ln2Fixed returns ln(2) as a fixed-point number with the given scale.
+func ln2Fixed(scale int64) *big.Int {
+	// ln(2) = 2×atanh(1/3)
+	a := atanhInv(3, scale+guard)
+	a.Lsh(a, 1)
+	return a.Quo(a, pow10(guard))
+}
+
+// ln10Fixed returns ln(10) as a fixed-point number with the given scale.
+func ln10Fixed(scale int64) *big.Int {
+	// ln(10) = 3×ln(2) + ln(1.25) = 6×atanh(1/3) + 2×atanh(1/9)
+	a := atanhInv(3, scale+guard)
+	a.Mul(a, big.NewInt(6))
+	b := atanhInv(9, scale+guard)
+	b.Lsh(b, 1)
+	a.Add(a, b)
+	return a.Quo(a, pow10(guard))
+}
+
+// atanhInv returns atanh(1/n) as a fixed-point number with the given scale.
+// The error is at most one unit in the last place per term of the series.
+func atanhInv(n int64, scale int64) *big.Int {
+	// atanh(1/n) = 1/n + 1/(3×n**3) + 1/(5×n**5) + ...
+	nn := big.NewInt(n * n)
+	term := new(big.Int).Quo(pow10(int(scale)), big.NewInt(n))
+	sum := new(big.Int).Set(term)
+	for k := int64(3); ; k += 2 {
+		term.Quo(term, nn)
+		if term.Sign() == 0 {
+			break
+		}
+		sum.Add(sum, new(big.Int).Quo(term, big.NewInt(k)))
+	}
+	return sum
+}
@@ -0,0 +1,201 @@
+package big2
+
+import (
+	"math/big"
+	"testing"
+)
+
+//go:generate bash -c "dectest < ~/tmp/dectest/exp.decTest > exp_test.go"
+func TestExp(t *testing.T) {
+	for _, test := range expTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Exp(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Exp(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/ln.decTest > ln_test.go"
+func TestLn(t *testing.T) {
+	for _, test := range lnTests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Ln(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Ln(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/log10.decTest > log10_test.go"
+func TestLog10(t *testing.T) {
+	for _, test := range log10Tests {
+		in := new(Decimal)
+		_, ok := in.SetString(test.in)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Log10(in)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Log10(%s) got: %s want: %s", test.id, test.in, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
+
+//go:generate bash -c "dectest < ~/tmp/dectest/power.decTest > power_test.go"
+func TestPower(t *testing.T) {
+	for _, test := range powerTests {
+		in1 := new(Decimal)
+		_, ok := in1.SetString(test.in1)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in1)
+			continue
+		}
+
+		in2 := new(Decimal)
+		_, ok = in2.SetString(test.in2)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.in2)
+			continue
+		}
+
+		out := new(Decimal)
+		_, ok = out.SetString(test.out)
+		if !ok {
+			t.Errorf("%s: failed to parse '%s'", test.id, test.out)
+			continue
+		}
+
+		r := new(Decimal)
+		r.SetPrec(test.prec)
+		r.SetMode(test.mode)
+		r.SetEmax(test.emax)
+		r.SetEmin(test.emin)
+		r.SetClamp(test.clamp)
+		r2 := r.Pow(in1, in2)
+		if r != r2 {
+			t.Errorf("%s: return value got: %p want: %p", test.id, r, r2)
+		}
+
+		if out.CmpTotal(r) != 0 {
+			t.Errorf("%s: Pow(%s, %s) got: %s want: %s", test.id, test.in1, test.in2, r.String(), test.out)
+			continue
+		}
+		if c := r.Conditions(); c != test.cond {
+			t.Errorf("%s: conditions got: %s want: %s", test.id, c, test.cond)
+		}
+		if test.cond&Inexact != 0 {
+			if r.Acc() == big.Exact {
+				t.Errorf("%s: expected inaccurate result", test.id)
+			}
+		} else {
+			if r.Acc() != big.Exact {
+				t.Errorf("%s: expected accurate result", test.id)
+			}
+		}
+	}
+}
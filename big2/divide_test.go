@@ -0,0 +1,1410 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var divideTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks
+	// divx001 divide  1     1    ->  1
+	{"divx001", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx002 divide  2     1    ->  2
+	{"divx002", "2", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// divx003 divide  1     2    ->  0.5
+	{"divx003", "1", "2", "0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx004 divide  2     2    ->  1
+	{"divx004", "2", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx005 divide  0     1    ->  0
+	{"divx005", "0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// divx006 divide  0     2    ->  0
+	{"divx006", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// divx007 divide  1     3    ->  0.333333333 Inexact Rounded
+	{"divx007", "1", "3", "0.333333333", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx008 divide  2     3    ->  0.666666667 Inexact Rounded
+	{"divx008", "2", "3", "0.666666667", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx009 divide  3     3    ->  1
+	{"divx009", "3", "3", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx010 divide  2.4   1    ->  2.4
+	{"divx010", "2.4", "1", "2.4", 0, 9, ToNearestAway, 384, -383, false},
+	// divx011 divide  2.4   -1   ->  -2.4
+	{"divx011", "2.4", "-1", "-2.4", 0, 9, ToNearestAway, 384, -383, false},
+	// divx012 divide  -2.4  1    ->  -2.4
+	{"divx012", "-2.4", "1", "-2.4", 0, 9, ToNearestAway, 384, -383, false},
+	// divx013 divide  -2.4  -1   ->  2.4
+	{"divx013", "-2.4", "-1", "2.4", 0, 9, ToNearestAway, 384, -383, false},
+	// divx014 divide  2.40  1    ->  2.40
+	{"divx014", "2.40", "1", "2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// divx015 divide  2.400 1    ->  2.400
+	{"divx015", "2.400", "1", "2.400", 0, 9, ToNearestAway, 384, -383, false},
+	// divx016 divide  2.4   2    ->  1.2
+	{"divx016", "2.4", "2", "1.2", 0, 9, ToNearestAway, 384, -383, false},
+	// divx017 divide  2.400 2    ->  1.200
+	{"divx017", "2.400", "2", "1.200", 0, 9, ToNearestAway, 384, -383, false},
+	// divx018 divide  2.    2    ->  1
+	{"divx018", "2.", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx019 divide  20    20   ->  1
+	{"divx019", "20", "20", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx020 divide  187   187    ->  1
+	{"divx020", "187", "187", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx021 divide  5     2      ->  2.5
+	{"divx021", "5", "2", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx022 divide  50    20     ->  2.5
+	{"divx022", "50", "20", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx023 divide  500   200    ->  2.5
+	{"divx023", "500", "200", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx024 divide  50.0  20.0   ->  2.5
+	{"divx024", "50.0", "20.0", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx025 divide  5.00  2.00   ->  2.5
+	{"divx025", "5.00", "2.00", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx026 divide  5     2.0    ->  2.5
+	{"divx026", "5", "2.0", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx027 divide  5     2.000  ->  2.5
+	{"divx027", "5", "2.000", "2.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx028 divide  5     0.20   ->  25
+	{"divx028", "5", "0.20", "25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx029 divide  5     0.200  ->  25
+	{"divx029", "5", "0.200", "25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx030 divide  10    1      ->  10
+	{"divx030", "10", "1", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// divx031 divide  100   1      ->  100
+	{"divx031", "100", "1", "100", 0, 9, ToNearestAway, 384, -383, false},
+	// divx032 divide  1000  1      ->  1000
+	{"divx032", "1000", "1", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// divx033 divide  1000  100    ->  10
+	{"divx033", "1000", "100", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// divx035 divide  1     2      ->  0.5
+	{"divx035", "1", "2", "0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx036 divide  1     4      ->  0.25
+	{"divx036", "1", "4", "0.25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx037 divide  1     8      ->  0.125
+	{"divx037", "1", "8", "0.125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx038 divide  1     16     ->  0.0625
+	{"divx038", "1", "16", "0.0625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx039 divide  1     32     ->  0.03125
+	{"divx039", "1", "32", "0.03125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx040 divide  1     64     ->  0.015625
+	{"divx040", "1", "64", "0.015625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx041 divide  1    -2      ->  -0.5
+	{"divx041", "1", "-2", "-0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx042 divide  1    -4      ->  -0.25
+	{"divx042", "1", "-4", "-0.25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx043 divide  1    -8      ->  -0.125
+	{"divx043", "1", "-8", "-0.125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx044 divide  1    -16     ->  -0.0625
+	{"divx044", "1", "-16", "-0.0625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx045 divide  1    -32     ->  -0.03125
+	{"divx045", "1", "-32", "-0.03125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx046 divide  1    -64     ->  -0.015625
+	{"divx046", "1", "-64", "-0.015625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx047 divide -1     2      ->  -0.5
+	{"divx047", "-1", "2", "-0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx048 divide -1     4      ->  -0.25
+	{"divx048", "-1", "4", "-0.25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx049 divide -1     8      ->  -0.125
+	{"divx049", "-1", "8", "-0.125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx050 divide -1     16     ->  -0.0625
+	{"divx050", "-1", "16", "-0.0625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx051 divide -1     32     ->  -0.03125
+	{"divx051", "-1", "32", "-0.03125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx052 divide -1     64     ->  -0.015625
+	{"divx052", "-1", "64", "-0.015625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx053 divide -1    -2      ->  0.5
+	{"divx053", "-1", "-2", "0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx054 divide -1    -4      ->  0.25
+	{"divx054", "-1", "-4", "0.25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx055 divide -1    -8      ->  0.125
+	{"divx055", "-1", "-8", "0.125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx056 divide -1    -16     ->  0.0625
+	{"divx056", "-1", "-16", "0.0625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx057 divide -1    -32     ->  0.03125
+	{"divx057", "-1", "-32", "0.03125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx058 divide -1    -64     ->  0.015625
+	{"divx058", "-1", "-64", "0.015625", 0, 9, ToNearestAway, 384, -383, false},
+	// divx070 divide  999999999        1    ->  999999999
+	{"divx070", "999999999", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// divx071 divide  999999999.4      1    ->  999999999 Inexact Rounded
+	{"divx071", "999999999.4", "1", "999999999", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx072 divide  999999999.5      1    ->  1.00000000E+9 Inexact Rounded
+	{"divx072", "999999999.5", "1", "1.00000000E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx073 divide  999999999.9      1    ->  1.00000000E+9 Inexact Rounded
+	{"divx073", "999999999.9", "1", "1.00000000E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx074 divide  999999999.999    1    ->  1.00000000E+9 Inexact Rounded
+	{"divx074", "999999999.999", "1", "1.00000000E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// divx080 divide  999999999     1  ->  1.00000E+9 Inexact Rounded
+	{"divx080", "999999999", "1", "1.00000E+9", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// divx081 divide  99999999      1  ->  1.00000E+8 Inexact Rounded
+	{"divx081", "99999999", "1", "1.00000E+8", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// divx082 divide  9999999       1  ->  1.00000E+7 Inexact Rounded
+	{"divx082", "9999999", "1", "1.00000E+7", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// divx083 divide  999999        1  ->  999999
+	{"divx083", "999999", "1", "999999", 0, 6, ToNearestAway, 384, -383, false},
+	// divx084 divide  99999         1  ->  99999
+	{"divx084", "99999", "1", "99999", 0, 6, ToNearestAway, 384, -383, false},
+	// divx085 divide  9999          1  ->  9999
+	{"divx085", "9999", "1", "9999", 0, 6, ToNearestAway, 384, -383, false},
+	// divx086 divide  999           1  ->  999
+	{"divx086", "999", "1", "999", 0, 6, ToNearestAway, 384, -383, false},
+	// divx087 divide  99            1  ->  99
+	{"divx087", "99", "1", "99", 0, 6, ToNearestAway, 384, -383, false},
+	// divx088 divide  9             1  ->  9
+	{"divx088", "9", "1", "9", 0, 6, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// divx090 divide  0.            1    ->  0
+	{"divx090", "0.", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// divx091 divide  .0            1    ->  0.0
+	{"divx091", ".0", "1", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// divx092 divide  0.00          1    ->  0.00
+	{"divx092", "0.00", "1", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// divx093 divide  0.00E+9       1    ->  0E+7
+	{"divx093", "0.00E+9", "1", "0E+7", 0, 9, ToNearestAway, 384, -383, false},
+	// divx094 divide  0.0000E-50    1    ->  0E-54
+	{"divx094", "0.0000E-50", "1", "0E-54", 0, 9, ToNearestAway, 384, -383, false},
+	// divx095 divide  1            1E-8  ->  1E+8
+	{"divx095", "1", "1E-8", "1E+8", 0, 9, ToNearestAway, 384, -383, false},
+	// divx096 divide  1            1E-9  ->  1E+9
+	{"divx096", "1", "1E-9", "1E+9", 0, 9, ToNearestAway, 384, -383, false},
+	// divx097 divide  1            1E-10 ->  1E+10
+	{"divx097", "1", "1E-10", "1E+10", 0, 9, ToNearestAway, 384, -383, false},
+	// divx098 divide  1            1E-11 ->  1E+11
+	{"divx098", "1", "1E-11", "1E+11", 0, 9, ToNearestAway, 384, -383, false},
+	// divx099 divide  1            1E-12 ->  1E+12
+	{"divx099", "1", "1E-12", "1E+12", 0, 9, ToNearestAway, 384, -383, false},
+	// divx100 divide  1  1   -> 1
+	{"divx100", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx101 divide  1  2   -> 0.5
+	{"divx101", "1", "2", "0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx102 divide  1  3   -> 0.333333333 Inexact Rounded
+	{"divx102", "1", "3", "0.333333333", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx103 divide  1  4   -> 0.25
+	{"divx103", "1", "4", "0.25", 0, 9, ToNearestAway, 384, -383, false},
+	// divx104 divide  1  5   -> 0.2
+	{"divx104", "1", "5", "0.2", 0, 9, ToNearestAway, 384, -383, false},
+	// divx105 divide  1  6   -> 0.166666667 Inexact Rounded
+	{"divx105", "1", "6", "0.166666667", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx106 divide  1  7   -> 0.142857143 Inexact Rounded
+	{"divx106", "1", "7", "0.142857143", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx107 divide  1  8   -> 0.125
+	{"divx107", "1", "8", "0.125", 0, 9, ToNearestAway, 384, -383, false},
+	// divx108 divide  1  9   -> 0.111111111 Inexact Rounded
+	{"divx108", "1", "9", "0.111111111", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx109 divide  1  10  -> 0.1
+	{"divx109", "1", "10", "0.1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx110 divide  1  1   -> 1
+	{"divx110", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// divx111 divide  2  1   -> 2
+	{"divx111", "2", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// divx112 divide  3  1   -> 3
+	{"divx112", "3", "1", "3", 0, 9, ToNearestAway, 384, -383, false},
+	// divx113 divide  4  1   -> 4
+	{"divx113", "4", "1", "4", 0, 9, ToNearestAway, 384, -383, false},
+	// divx114 divide  5  1   -> 5
+	{"divx114", "5", "1", "5", 0, 9, ToNearestAway, 384, -383, false},
+	// divx115 divide  6  1   -> 6
+	{"divx115", "6", "1", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// divx116 divide  7  1   -> 7
+	{"divx116", "7", "1", "7", 0, 9, ToNearestAway, 384, -383, false},
+	// divx117 divide  8  1   -> 8
+	{"divx117", "8", "1", "8", 0, 9, ToNearestAway, 384, -383, false},
+	// divx118 divide  9  1   -> 9
+	{"divx118", "9", "1", "9", 0, 9, ToNearestAway, 384, -383, false},
+	// divx119 divide  10 1   -> 10
+	{"divx119", "10", "1", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// divx120 divide  3E+1 0.001  -> 3E+4
+	{"divx120", "3E+1", "0.001", "3E+4", 0, 9, ToNearestAway, 384, -383, false},
+	// divx121 divide  2.200 2     -> 1.100
+	{"divx121", "2.200", "2", "1.100", 0, 9, ToNearestAway, 384, -383, false},
+	// divx130 divide  12345  4.999  ->  2469.49390 Inexact Rounded
+	{"divx130", "12345", "4.999", "2469.49390", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx131 divide  12345  4.99   ->  2473.94790 Inexact Rounded
+	{"divx131", "12345", "4.99", "2473.94790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx132 divide  12345  4.9    ->  2519.38776 Inexact Rounded
+	{"divx132", "12345", "4.9", "2519.38776", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx133 divide  12345  5      ->  2469
+	{"divx133", "12345", "5", "2469", 0, 9, ToNearestAway, 384, -383, false},
+	// divx134 divide  12345  5.1    ->  2420.58824 Inexact Rounded
+	{"divx134", "12345", "5.1", "2420.58824", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx135 divide  12345  5.01   ->  2464.07186 Inexact Rounded
+	{"divx135", "12345", "5.01", "2464.07186", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// divx136 divide  12345  5.001  ->  2468.50630 Inexact Rounded
+	{"divx136", "12345", "5.001", "2468.50630", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// test possibly imprecise results
+	// divx220 divide 391   597 ->  0.654941374 Inexact Rounded
+	{"divx220", "391", "597", "0.654941374", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx221 divide 391  -597 -> -0.654941374 Inexact Rounded
+	{"divx221", "391", "-597", "-0.654941374", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx222 divide -391  597 -> -0.654941374 Inexact Rounded
+	{"divx222", "-391", "597", "-0.654941374", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx223 divide -391 -597 ->  0.654941374 Inexact Rounded
+	{"divx223", "-391", "-597", "0.654941374", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// test some cases that are close to exponent overflow
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// divx270 divide 1 1e999999999    -> 1E-999999999
+	{"divx270", "1", "1e999999999", "1E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx271 divide 1 0.9e999999999  -> 1.11111111E-999999999 Inexact Rounded
+	{"divx271", "1", "0.9e999999999", "1.11111111E-999999999", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx272 divide 1 0.99e999999999 -> 1.01010101E-999999999 Inexact Rounded
+	{"divx272", "1", "0.99e999999999", "1.01010101E-999999999", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx273 divide 1 0.999999999e999999999 -> 1.00000000E-999999999 Inexact Rounded
+	{"divx273", "1", "0.999999999e999999999", "1.00000000E-999999999", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx274 divide 9e999999999    1 -> 9E+999999999
+	{"divx274", "9e999999999", "1", "9E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx275 divide 9.9e999999999  1 -> 9.9E+999999999
+	{"divx275", "9.9e999999999", "1", "9.9E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx276 divide 9.99e999999999 1 -> 9.99E+999999999
+	{"divx276", "9.99e999999999", "1", "9.99E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx277 divide 9.99999999e999999999 1 -> 9.99999999E+999999999
+	{"divx277", "9.99999999e999999999", "1", "9.99999999E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx280 divide 0.1 9e-999999999   -> 1.11111111E+999999997 Inexact Rounded
+	{"divx280", "0.1", "9e-999999999", "1.11111111E+999999997", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx281 divide 0.1 99e-999999999  -> 1.01010101E+999999996 Inexact Rounded
+	{"divx281", "0.1", "99e-999999999", "1.01010101E+999999996", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx282 divide 0.1 999e-999999999 -> 1.00100100E+999999995 Inexact Rounded
+	{"divx282", "0.1", "999e-999999999", "1.00100100E+999999995", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx283 divide 0.1 9e-999999998     -> 1.11111111E+999999996 Inexact Rounded
+	{"divx283", "0.1", "9e-999999998", "1.11111111E+999999996", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx284 divide 0.1 99e-999999998    -> 1.01010101E+999999995 Inexact Rounded
+	{"divx284", "0.1", "99e-999999998", "1.01010101E+999999995", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx285 divide 0.1 999e-999999998   -> 1.00100100E+999999994 Inexact Rounded
+	{"divx285", "0.1", "999e-999999998", "1.00100100E+999999994", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx286 divide 0.1 999e-999999997   -> 1.00100100E+999999993 Inexact Rounded
+	{"divx286", "0.1", "999e-999999997", "1.00100100E+999999993", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx287 divide 0.1 9999e-999999997  -> 1.00010001E+999999992 Inexact Rounded
+	{"divx287", "0.1", "9999e-999999997", "1.00010001E+999999992", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx288 divide 0.1 99999e-999999997 -> 1.00001000E+999999991 Inexact Rounded
+	{"divx288", "0.1", "99999e-999999997", "1.00001000E+999999991", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// Divide into 0 tests
+	// divx301 divide    0    7     -> 0
+	{"divx301", "0", "7", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx302 divide    0    7E-5  -> 0E+5
+	{"divx302", "0", "7E-5", "0E+5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx303 divide    0    7E-1  -> 0E+1
+	{"divx303", "0", "7E-1", "0E+1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx304 divide    0    7E+1  -> 0.0
+	{"divx304", "0", "7E+1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx305 divide    0    7E+5  -> 0.00000
+	{"divx305", "0", "7E+5", "0.00000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx306 divide    0    7E+6  -> 0.000000
+	{"divx306", "0", "7E+6", "0.000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx307 divide    0    7E+7  -> 0E-7
+	{"divx307", "0", "7E+7", "0E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx308 divide    0   70E-5  -> 0E+5
+	{"divx308", "0", "70E-5", "0E+5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx309 divide    0   70E-1  -> 0E+1
+	{"divx309", "0", "70E-1", "0E+1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx310 divide    0   70E+0  -> 0
+	{"divx310", "0", "70E+0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx311 divide    0   70E+1  -> 0.0
+	{"divx311", "0", "70E+1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx312 divide    0   70E+5  -> 0.00000
+	{"divx312", "0", "70E+5", "0.00000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx313 divide    0   70E+6  -> 0.000000
+	{"divx313", "0", "70E+6", "0.000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx314 divide    0   70E+7  -> 0E-7
+	{"divx314", "0", "70E+7", "0E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx315 divide    0  700E-5  -> 0E+5
+	{"divx315", "0", "700E-5", "0E+5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx316 divide    0  700E-1  -> 0E+1
+	{"divx316", "0", "700E-1", "0E+1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx317 divide    0  700E+0  -> 0
+	{"divx317", "0", "700E+0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx318 divide    0  700E+1  -> 0.0
+	{"divx318", "0", "700E+1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx319 divide    0  700E+5  -> 0.00000
+	{"divx319", "0", "700E+5", "0.00000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx320 divide    0  700E+6  -> 0.000000
+	{"divx320", "0", "700E+6", "0.000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx321 divide    0  700E+7  -> 0E-7
+	{"divx321", "0", "700E+7", "0E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx322 divide    0  700E+77 -> 0E-77
+	{"divx322", "0", "700E+77", "0E-77", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx331 divide 0E-3    7E-5  -> 0E+2
+	{"divx331", "0E-3", "7E-5", "0E+2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx332 divide 0E-3    7E-1  -> 0.00
+	{"divx332", "0E-3", "7E-1", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx333 divide 0E-3    7E+1  -> 0.0000
+	{"divx333", "0E-3", "7E+1", "0.0000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx334 divide 0E-3    7E+5  -> 0E-8
+	{"divx334", "0E-3", "7E+5", "0E-8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx335 divide 0E-1    7E-5  -> 0E+4
+	{"divx335", "0E-1", "7E-5", "0E+4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx336 divide 0E-1    7E-1  -> 0
+	{"divx336", "0E-1", "7E-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx337 divide 0E-1    7E+1  -> 0.00
+	{"divx337", "0E-1", "7E+1", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx338 divide 0E-1    7E+5  -> 0.000000
+	{"divx338", "0E-1", "7E+5", "0.000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx339 divide 0E+1    7E-5  -> 0E+6
+	{"divx339", "0E+1", "7E-5", "0E+6", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx340 divide 0E+1    7E-1  -> 0E+2
+	{"divx340", "0E+1", "7E-1", "0E+2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx341 divide 0E+1    7E+1  -> 0
+	{"divx341", "0E+1", "7E+1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx342 divide 0E+1    7E+5  -> 0.0000
+	{"divx342", "0E+1", "7E+5", "0.0000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx343 divide 0E+3    7E-5  -> 0E+8
+	{"divx343", "0E+3", "7E-5", "0E+8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx344 divide 0E+3    7E-1  -> 0E+4
+	{"divx344", "0E+3", "7E-1", "0E+4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx345 divide 0E+3    7E+1  -> 0E+2
+	{"divx345", "0E+3", "7E+1", "0E+2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx346 divide 0E+3    7E+5  -> 0.00
+	{"divx346", "0E+3", "7E+5", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// maxexponent: 92
+	// minexponent: -92
+	// precision: 7
+	// divx351 divide 0E-92   7E-1  -> 0E-91
+	{"divx351", "0E-92", "7E-1", "0E-91", 0, 7, ToNearestAway, 92, -92, false},
+	// divx352 divide 0E-92   7E+1  -> 0E-93
+	{"divx352", "0E-92", "7E+1", "0E-93", 0, 7, ToNearestAway, 92, -92, false},
+	// divx353 divide 0E-92   7E+5  -> 0E-97
+	{"divx353", "0E-92", "7E+5", "0E-97", 0, 7, ToNearestAway, 92, -92, false},
+	// divx354 divide 0E-92   7E+6  -> 0E-98
+	{"divx354", "0E-92", "7E+6", "0E-98", 0, 7, ToNearestAway, 92, -92, false},
+	// divx355 divide 0E-92   7E+7  -> 0E-98 Clamped
+	{"divx355", "0E-92", "7E+7", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx356 divide 0E-92 777E-1  -> 0E-91
+	{"divx356", "0E-92", "777E-1", "0E-91", 0, 7, ToNearestAway, 92, -92, false},
+	// divx357 divide 0E-92 777E+1  -> 0E-93
+	{"divx357", "0E-92", "777E+1", "0E-93", 0, 7, ToNearestAway, 92, -92, false},
+	// divx358 divide 0E-92 777E+3  -> 0E-95
+	{"divx358", "0E-92", "777E+3", "0E-95", 0, 7, ToNearestAway, 92, -92, false},
+	// divx359 divide 0E-92 777E+4  -> 0E-96
+	{"divx359", "0E-92", "777E+4", "0E-96", 0, 7, ToNearestAway, 92, -92, false},
+	// divx360 divide 0E-92 777E+5  -> 0E-97
+	{"divx360", "0E-92", "777E+5", "0E-97", 0, 7, ToNearestAway, 92, -92, false},
+	// divx361 divide 0E-92 777E+6  -> 0E-98
+	{"divx361", "0E-92", "777E+6", "0E-98", 0, 7, ToNearestAway, 92, -92, false},
+	// divx362 divide 0E-92 777E+7  -> 0E-98 Clamped
+	{"divx362", "0E-92", "777E+7", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx363 divide 0E-92   7E+92 -> 0E-98 Clamped
+	{"divx363", "0E-92", "7E+92", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx371 divide 0E-92 700E-1  -> 0E-91
+	{"divx371", "0E-92", "700E-1", "0E-91", 0, 7, ToNearestAway, 92, -92, false},
+	// divx372 divide 0E-92 700E+1  -> 0E-93
+	{"divx372", "0E-92", "700E+1", "0E-93", 0, 7, ToNearestAway, 92, -92, false},
+	// divx373 divide 0E-92 700E+3  -> 0E-95
+	{"divx373", "0E-92", "700E+3", "0E-95", 0, 7, ToNearestAway, 92, -92, false},
+	// divx374 divide 0E-92 700E+4  -> 0E-96
+	{"divx374", "0E-92", "700E+4", "0E-96", 0, 7, ToNearestAway, 92, -92, false},
+	// divx375 divide 0E-92 700E+5  -> 0E-97
+	{"divx375", "0E-92", "700E+5", "0E-97", 0, 7, ToNearestAway, 92, -92, false},
+	// divx376 divide 0E-92 700E+6  -> 0E-98
+	{"divx376", "0E-92", "700E+6", "0E-98", 0, 7, ToNearestAway, 92, -92, false},
+	// divx377 divide 0E-92 700E+7  -> 0E-98 Clamped
+	{"divx377", "0E-92", "700E+7", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx381 divide 0E+92   7E+1  -> 0E+91
+	{"divx381", "0E+92", "7E+1", "0E+91", 0, 7, ToNearestAway, 92, -92, false},
+	// divx382 divide 0E+92   7E+0  -> 0E+92
+	{"divx382", "0E+92", "7E+0", "0E+92", 0, 7, ToNearestAway, 92, -92, false},
+	// divx383 divide 0E+92   7E-1  -> 0E+92 Clamped
+	{"divx383", "0E+92", "7E-1", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx384 divide 0E+90 777E+1  -> 0E+89
+	{"divx384", "0E+90", "777E+1", "0E+89", 0, 7, ToNearestAway, 92, -92, false},
+	// divx385 divide 0E+90 777E-1  -> 0E+91
+	{"divx385", "0E+90", "777E-1", "0E+91", 0, 7, ToNearestAway, 92, -92, false},
+	// divx386 divide 0E+90 777E-2  -> 0E+92
+	{"divx386", "0E+90", "777E-2", "0E+92", 0, 7, ToNearestAway, 92, -92, false},
+	// divx387 divide 0E+90 777E-3  -> 0E+92 Clamped
+	{"divx387", "0E+90", "777E-3", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx388 divide 0E+90 777E-4  -> 0E+92 Clamped
+	{"divx388", "0E+90", "777E-4", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx391 divide 0E+90 700E+1  -> 0E+89
+	{"divx391", "0E+90", "700E+1", "0E+89", 0, 7, ToNearestAway, 92, -92, false},
+	// divx392 divide 0E+90 700E-1  -> 0E+91
+	{"divx392", "0E+90", "700E-1", "0E+91", 0, 7, ToNearestAway, 92, -92, false},
+	// divx393 divide 0E+90 700E-2  -> 0E+92
+	{"divx393", "0E+90", "700E-2", "0E+92", 0, 7, ToNearestAway, 92, -92, false},
+	// divx394 divide 0E+90 700E-3  -> 0E+92 Clamped
+	{"divx394", "0E+90", "700E-3", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx395 divide 0E+90 700E-4  -> 0E+92 Clamped
+	{"divx395", "0E+90", "700E-4", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// input rounding checks
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// divx401 divide 12345678000 1 -> 1.23456780E+10 Rounded
+	{"divx401", "12345678000", "1", "1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx402 divide 1 12345678000 -> 8.10000066E-11 Inexact Rounded
+	{"divx402", "1", "12345678000", "8.10000066E-11", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx403 divide 1234567800  1 -> 1.23456780E+9  Rounded
+	{"divx403", "1234567800", "1", "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx404 divide 1 1234567800  -> 8.10000066E-10 Inexact Rounded
+	{"divx404", "1", "1234567800", "8.10000066E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx405 divide 1234567890  1 -> 1.23456789E+9  Rounded
+	{"divx405", "1234567890", "1", "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx406 divide 1 1234567890  -> 8.10000007E-10 Inexact Rounded
+	{"divx406", "1", "1234567890", "8.10000007E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx407 divide 1234567891  1 -> 1.23456789E+9  Inexact Rounded
+	{"divx407", "1234567891", "1", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx408 divide 1 1234567891  -> 8.10000007E-10 Inexact Rounded
+	{"divx408", "1", "1234567891", "8.10000007E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx409 divide 12345678901 1 -> 1.23456789E+10 Inexact Rounded
+	{"divx409", "12345678901", "1", "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx410 divide 1 12345678901 -> 8.10000007E-11 Inexact Rounded
+	{"divx410", "1", "12345678901", "8.10000007E-11", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx411 divide 1234567896  1 -> 1.23456790E+9  Inexact Rounded
+	{"divx411", "1234567896", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx412 divide 1 1234567896  -> 8.10000003E-10 Inexact Rounded
+	{"divx412", "1", "1234567896", "8.10000003E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx413 divide 1 1234567897  -> 8.10000003E-10 Inexact Rounded
+	{"divx413", "1", "1234567897", "8.10000003E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx414 divide 1 1234567898  -> 8.10000002E-10 Inexact Rounded
+	{"divx414", "1", "1234567898", "8.10000002E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx415 divide 1 1234567899  -> 8.10000001E-10 Inexact Rounded
+	{"divx415", "1", "1234567899", "8.10000001E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx416 divide 1 1234567900  -> 8.10000001E-10 Inexact Rounded
+	{"divx416", "1", "1234567900", "8.10000001E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx417 divide 1 1234567901  -> 8.10000000E-10 Inexact Rounded
+	{"divx417", "1", "1234567901", "8.10000000E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx418 divide 1 1234567902  -> 8.09999999E-10 Inexact Rounded
+	{"divx418", "1", "1234567902", "8.09999999E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// some longies
+	// divx421 divide 1234567896.000000000000  1 -> 1.23456790E+9  Inexact Rounded
+	{"divx421", "1234567896.000000000000", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx422 divide 1 1234567896.000000000000  -> 8.10000003E-10 Inexact Rounded
+	{"divx422", "1", "1234567896.000000000000", "8.10000003E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx423 divide 1234567896.000000000001  1 -> 1.23456790E+9  Inexact Rounded
+	{"divx423", "1234567896.000000000001", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx424 divide 1 1234567896.000000000001  -> 8.10000003E-10 Inexact Rounded
+	{"divx424", "1", "1234567896.000000000001", "8.10000003E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx425 divide 1234567896.000000000000000000000000000000000000000009  1 -> 1.23456790E+9  Inexact Rounded
+	{"divx425", "1234567896.000000000000000000000000000000000000000009", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx426 divide 1 1234567896.000000000000000000000000000000000000000009  -> 8.10000003E-10 Inexact Rounded
+	{"divx426", "1", "1234567896.000000000000000000000000000000000000000009", "8.10000003E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx427 divide 1234567897.900010000000000000000000000000000000000009  1 -> 1.23456790E+9  Inexact Rounded
+	{"divx427", "1234567897.900010000000000000000000000000000000000009", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// divx428 divide 1 1234567897.900010000000000000000000000000000000000009  -> 8.10000002E-10 Inexact Rounded
+	{"divx428", "1", "1234567897.900010000000000000000000000000000000000009", "8.10000002E-10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// still checking...
+	// divx441 divide 12345678000 1 -> 12345678000
+	{"divx441", "12345678000", "1", "12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// divx442 divide 1 12345678000 -> 8.10000066420005E-11 Inexact Rounded
+	{"divx442", "1", "12345678000", "8.10000066420005E-11", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// divx443 divide 1234567800  1 -> 1234567800
+	{"divx443", "1234567800", "1", "1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// divx444 divide 1 1234567800  -> 8.10000066420005E-10 Inexact Rounded
+	{"divx444", "1", "1234567800", "8.10000066420005E-10", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// divx445 divide 1234567890  1 -> 1234567890
+	{"divx445", "1234567890", "1", "1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// divx446 divide 1 1234567890  -> 8.10000007371000E-10 Inexact Rounded
+	{"divx446", "1", "1234567890", "8.10000007371000E-10", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// divx447 divide 1234567891  1 -> 1234567891
+	{"divx447", "1234567891", "1", "1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// divx448 divide 1 1234567891  -> 8.10000006714900E-10 Inexact Rounded
+	{"divx448", "1", "1234567891", "8.10000006714900E-10", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// divx449 divide 12345678901 1 -> 12345678901
+	{"divx449", "12345678901", "1", "12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// divx450 divide 1 12345678901 -> 8.10000007305390E-11 Inexact Rounded
+	{"divx450", "1", "12345678901", "8.10000007305390E-11", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// divx451 divide 1234567896  1 -> 1234567896
+	{"divx451", "1234567896", "1", "1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// divx452 divide 1 1234567896  -> 8.10000003434400E-10 Inexact Rounded
+	{"divx452", "1", "1234567896", "8.10000003434400E-10", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// high-lows
+	// divx453 divide 1e+1   1    ->   1E+1
+	{"divx453", "1e+1", "1", "1E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// divx454 divide 1e+1   1.0  ->   1E+1
+	{"divx454", "1e+1", "1.0", "1E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// divx455 divide 1e+1   1.00 ->   1E+1
+	{"divx455", "1e+1", "1.00", "1E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// divx456 divide 1e+2   2    ->   5E+1
+	{"divx456", "1e+2", "2", "5E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// divx457 divide 1e+2   2.0  ->   5E+1
+	{"divx457", "1e+2", "2.0", "5E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// divx458 divide 1e+2   2.00 ->   5E+1
+	{"divx458", "1e+2", "2.00", "5E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// some from IEEE discussions
+	// divx460 divide 3e0      2e0     -> 1.5
+	{"divx460", "3e0", "2e0", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx461 divide 30e-1    2e0     -> 1.5
+	{"divx461", "30e-1", "2e0", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx462 divide 300e-2   2e0     -> 1.50
+	{"divx462", "300e-2", "2e0", "1.50", 0, 15, ToNearestAway, 999, -999, false},
+	// divx464 divide 3000e-3  2e0     -> 1.500
+	{"divx464", "3000e-3", "2e0", "1.500", 0, 15, ToNearestAway, 999, -999, false},
+	// divx465 divide 3e0      20e-1   -> 1.5
+	{"divx465", "3e0", "20e-1", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx466 divide 30e-1    20e-1   -> 1.5
+	{"divx466", "30e-1", "20e-1", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx467 divide 300e-2   20e-1   -> 1.5
+	{"divx467", "300e-2", "20e-1", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx468 divide 3000e-3  20e-1   -> 1.50
+	{"divx468", "3000e-3", "20e-1", "1.50", 0, 15, ToNearestAway, 999, -999, false},
+	// divx469 divide 3e0      200e-2  -> 1.5
+	{"divx469", "3e0", "200e-2", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx470 divide 30e-1    200e-2  -> 1.5
+	{"divx470", "30e-1", "200e-2", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx471 divide 300e-2   200e-2  -> 1.5
+	{"divx471", "300e-2", "200e-2", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx472 divide 3000e-3  200e-2  -> 1.5
+	{"divx472", "3000e-3", "200e-2", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx473 divide 3e0      2000e-3 -> 1.5
+	{"divx473", "3e0", "2000e-3", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx474 divide 30e-1    2000e-3 -> 1.5
+	{"divx474", "30e-1", "2000e-3", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx475 divide 300e-2   2000e-3 -> 1.5
+	{"divx475", "300e-2", "2000e-3", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// divx476 divide 3000e-3  2000e-3 -> 1.5
+	{"divx476", "3000e-3", "2000e-3", "1.5", 0, 15, ToNearestAway, 999, -999, false},
+	// some reciprocals
+	// divx480 divide 1        1.0E+33 -> 1E-33
+	{"divx480", "1", "1.0E+33", "1E-33", 0, 15, ToNearestAway, 999, -999, false},
+	// divx481 divide 1        10E+33  -> 1E-34
+	{"divx481", "1", "10E+33", "1E-34", 0, 15, ToNearestAway, 999, -999, false},
+	// divx482 divide 1        1.0E-33 -> 1E+33
+	{"divx482", "1", "1.0E-33", "1E+33", 0, 15, ToNearestAway, 999, -999, false},
+	// divx483 divide 1        10E-33  -> 1E+32
+	{"divx483", "1", "10E-33", "1E+32", 0, 15, ToNearestAway, 999, -999, false},
+	// RMS discussion table
+	// maxexponent: 96
+	// minexponent: -95
+	// precision: 7
+	// divx484 divide 0e5     1e3 ->   0E+2
+	{"divx484", "0e5", "1e3", "0E+2", 0, 7, ToNearestAway, 96, -95, false},
+	// divx485 divide 0e5     2e3 ->   0E+2
+	{"divx485", "0e5", "2e3", "0E+2", 0, 7, ToNearestAway, 96, -95, false},
+	// divx486 divide 0e5    10e2 ->   0E+3
+	{"divx486", "0e5", "10e2", "0E+3", 0, 7, ToNearestAway, 96, -95, false},
+	// divx487 divide 0e5    20e2 ->   0E+3
+	{"divx487", "0e5", "20e2", "0E+3", 0, 7, ToNearestAway, 96, -95, false},
+	// divx488 divide 0e5   100e1 ->   0E+4
+	{"divx488", "0e5", "100e1", "0E+4", 0, 7, ToNearestAway, 96, -95, false},
+	// divx489 divide 0e5   200e1 ->   0E+4
+	{"divx489", "0e5", "200e1", "0E+4", 0, 7, ToNearestAway, 96, -95, false},
+	// divx491 divide 1e5     1e3 ->   1E+2
+	{"divx491", "1e5", "1e3", "1E+2", 0, 7, ToNearestAway, 96, -95, false},
+	// divx492 divide 1e5     2e3 ->   5E+1
+	{"divx492", "1e5", "2e3", "5E+1", 0, 7, ToNearestAway, 96, -95, false},
+	// divx493 divide 1e5    10e2 ->   1E+2
+	{"divx493", "1e5", "10e2", "1E+2", 0, 7, ToNearestAway, 96, -95, false},
+	// divx494 divide 1e5    20e2 ->   5E+1
+	{"divx494", "1e5", "20e2", "5E+1", 0, 7, ToNearestAway, 96, -95, false},
+	// divx495 divide 1e5   100e1 ->   1E+2
+	{"divx495", "1e5", "100e1", "1E+2", 0, 7, ToNearestAway, 96, -95, false},
+	// divx496 divide 1e5   200e1 ->   5E+1
+	{"divx496", "1e5", "200e1", "5E+1", 0, 7, ToNearestAway, 96, -95, false},
+	// tryzeros cases
+	// precision: 7
+	// rounding: half_up
+	// maxexponent: 92
+	// minexponent: -92
+	// divx497  divide  0E+86 1000E-13  -> 0E+92 Clamped
+	{"divx497", "0E+86", "1000E-13", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// divx498  divide  0E-98 1000E+13  -> 0E-98 Clamped
+	{"divx498", "0E-98", "1000E+13", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// focus on trailing zeros issues
+	// precision: 9
+	// divx500 divide  1      9.9    ->  0.101010101  Inexact Rounded
+	{"divx500", "1", "9.9", "0.101010101", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 8
+	// divx501 divide  1      9.9    ->  0.10101010   Inexact Rounded
+	{"divx501", "1", "9.9", "0.10101010", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// precision: 7
+	// divx502 divide  1      9.9    ->  0.1010101    Inexact Rounded
+	{"divx502", "1", "9.9", "0.1010101", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// precision: 6
+	// divx503 divide  1      9.9    ->  0.101010     Inexact Rounded
+	{"divx503", "1", "9.9", "0.101010", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// divx511 divide 1         2    -> 0.5
+	{"divx511", "1", "2", "0.5", 0, 9, ToNearestAway, 999, -999, false},
+	// divx512 divide 1.0       2    -> 0.5
+	{"divx512", "1.0", "2", "0.5", 0, 9, ToNearestAway, 999, -999, false},
+	// divx513 divide 1.00      2    -> 0.50
+	{"divx513", "1.00", "2", "0.50", 0, 9, ToNearestAway, 999, -999, false},
+	// divx514 divide 1.000     2    -> 0.500
+	{"divx514", "1.000", "2", "0.500", 0, 9, ToNearestAway, 999, -999, false},
+	// divx515 divide 1.0000    2    -> 0.5000
+	{"divx515", "1.0000", "2", "0.5000", 0, 9, ToNearestAway, 999, -999, false},
+	// divx516 divide 1.00000   2    -> 0.50000
+	{"divx516", "1.00000", "2", "0.50000", 0, 9, ToNearestAway, 999, -999, false},
+	// divx517 divide 1.000000  2    -> 0.500000
+	{"divx517", "1.000000", "2", "0.500000", 0, 9, ToNearestAway, 999, -999, false},
+	// divx518 divide 1.0000000 2    -> 0.5000000
+	{"divx518", "1.0000000", "2", "0.5000000", 0, 9, ToNearestAway, 999, -999, false},
+	// divx519 divide 1.00      2.00 -> 0.5
+	{"divx519", "1.00", "2.00", "0.5", 0, 9, ToNearestAway, 999, -999, false},
+	// divx521 divide 2    1         -> 2
+	{"divx521", "2", "1", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx522 divide 2    1.0       -> 2
+	{"divx522", "2", "1.0", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx523 divide 2    1.00      -> 2
+	{"divx523", "2", "1.00", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx524 divide 2    1.000     -> 2
+	{"divx524", "2", "1.000", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx525 divide 2    1.0000    -> 2
+	{"divx525", "2", "1.0000", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx526 divide 2    1.00000   -> 2
+	{"divx526", "2", "1.00000", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx527 divide 2    1.000000  -> 2
+	{"divx527", "2", "1.000000", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx528 divide 2    1.0000000 -> 2
+	{"divx528", "2", "1.0000000", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx529 divide 2.00 1.00      -> 2
+	{"divx529", "2.00", "1.00", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx530 divide  2.40   2      ->  1.20
+	{"divx530", "2.40", "2", "1.20", 0, 9, ToNearestAway, 999, -999, false},
+	// divx531 divide  2.40   4      ->  0.60
+	{"divx531", "2.40", "4", "0.60", 0, 9, ToNearestAway, 999, -999, false},
+	// divx532 divide  2.40  10      ->  0.24
+	{"divx532", "2.40", "10", "0.24", 0, 9, ToNearestAway, 999, -999, false},
+	// divx533 divide  2.40   2.0    ->  1.2
+	{"divx533", "2.40", "2.0", "1.2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx534 divide  2.40   4.0    ->  0.6
+	{"divx534", "2.40", "4.0", "0.6", 0, 9, ToNearestAway, 999, -999, false},
+	// divx535 divide  2.40  10.0    ->  0.24
+	{"divx535", "2.40", "10.0", "0.24", 0, 9, ToNearestAway, 999, -999, false},
+	// divx536 divide  2.40   2.00   ->  1.2
+	{"divx536", "2.40", "2.00", "1.2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx537 divide  2.40   4.00   ->  0.6
+	{"divx537", "2.40", "4.00", "0.6", 0, 9, ToNearestAway, 999, -999, false},
+	// divx538 divide  2.40  10.00   ->  0.24
+	{"divx538", "2.40", "10.00", "0.24", 0, 9, ToNearestAway, 999, -999, false},
+	// divx539 divide  0.9    0.1    ->  9
+	{"divx539", "0.9", "0.1", "9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx540 divide  0.9    0.01   ->  9E+1
+	{"divx540", "0.9", "0.01", "9E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// divx541 divide  0.9    0.001  ->  9E+2
+	{"divx541", "0.9", "0.001", "9E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// divx542 divide  5      2      ->  2.5
+	{"divx542", "5", "2", "2.5", 0, 9, ToNearestAway, 999, -999, false},
+	// divx543 divide  5      2.0    ->  2.5
+	{"divx543", "5", "2.0", "2.5", 0, 9, ToNearestAway, 999, -999, false},
+	// divx544 divide  5      2.00   ->  2.5
+	{"divx544", "5", "2.00", "2.5", 0, 9, ToNearestAway, 999, -999, false},
+	// divx545 divide  5      20     ->  0.25
+	{"divx545", "5", "20", "0.25", 0, 9, ToNearestAway, 999, -999, false},
+	// divx546 divide  5      20.0   ->  0.25
+	{"divx546", "5", "20.0", "0.25", 0, 9, ToNearestAway, 999, -999, false},
+	// divx547 divide  2.400  2      ->  1.200
+	{"divx547", "2.400", "2", "1.200", 0, 9, ToNearestAway, 999, -999, false},
+	// divx548 divide  2.400  2.0    ->  1.20
+	{"divx548", "2.400", "2.0", "1.20", 0, 9, ToNearestAway, 999, -999, false},
+	// divx549 divide  2.400  2.400  ->  1
+	{"divx549", "2.400", "2.400", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// divx550 divide  240    1      ->  240
+	{"divx550", "240", "1", "240", 0, 9, ToNearestAway, 999, -999, false},
+	// divx551 divide  240    10     ->  24
+	{"divx551", "240", "10", "24", 0, 9, ToNearestAway, 999, -999, false},
+	// divx552 divide  240    100    ->  2.4
+	{"divx552", "240", "100", "2.4", 0, 9, ToNearestAway, 999, -999, false},
+	// divx553 divide  240    1000   ->  0.24
+	{"divx553", "240", "1000", "0.24", 0, 9, ToNearestAway, 999, -999, false},
+	// divx554 divide  2400   1      ->  2400
+	{"divx554", "2400", "1", "2400", 0, 9, ToNearestAway, 999, -999, false},
+	// divx555 divide  2400   10     ->  240
+	{"divx555", "2400", "10", "240", 0, 9, ToNearestAway, 999, -999, false},
+	// divx556 divide  2400   100    ->  24
+	{"divx556", "2400", "100", "24", 0, 9, ToNearestAway, 999, -999, false},
+	// divx557 divide  2400   1000   ->  2.4
+	{"divx557", "2400", "1000", "2.4", 0, 9, ToNearestAway, 999, -999, false},
+	// +ve exponent
+	// precision: 5
+	// divx570 divide  2.4E+6     2  ->  1.2E+6
+	{"divx570", "2.4E+6", "2", "1.2E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx571 divide  2.40E+6    2  ->  1.20E+6
+	{"divx571", "2.40E+6", "2", "1.20E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx572 divide  2.400E+6   2  ->  1.200E+6
+	{"divx572", "2.400E+6", "2", "1.200E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx573 divide  2.4000E+6  2  ->  1.2000E+6
+	{"divx573", "2.4000E+6", "2", "1.2000E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx574 divide  24E+5      2  ->  1.2E+6
+	{"divx574", "24E+5", "2", "1.2E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx575 divide  240E+4     2  ->  1.20E+6
+	{"divx575", "240E+4", "2", "1.20E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx576 divide  2400E+3    2  ->  1.200E+6
+	{"divx576", "2400E+3", "2", "1.200E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// divx577 divide  24000E+2   2  ->  1.2000E+6
+	{"divx577", "24000E+2", "2", "1.2000E+6", 0, 5, ToNearestAway, 999, -999, false},
+	// precision: 6
+	// divx580 divide  2.4E+6     2  ->  1.2E+6
+	{"divx580", "2.4E+6", "2", "1.2E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx581 divide  2.40E+6    2  ->  1.20E+6
+	{"divx581", "2.40E+6", "2", "1.20E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx582 divide  2.400E+6   2  ->  1.200E+6
+	{"divx582", "2.400E+6", "2", "1.200E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx583 divide  2.4000E+6  2  ->  1.2000E+6
+	{"divx583", "2.4000E+6", "2", "1.2000E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx584 divide  24E+5      2  ->  1.2E+6
+	{"divx584", "24E+5", "2", "1.2E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx585 divide  240E+4     2  ->  1.20E+6
+	{"divx585", "240E+4", "2", "1.20E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx586 divide  2400E+3    2  ->  1.200E+6
+	{"divx586", "2400E+3", "2", "1.200E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// divx587 divide  24000E+2   2  ->  1.2000E+6
+	{"divx587", "24000E+2", "2", "1.2000E+6", 0, 6, ToNearestAway, 999, -999, false},
+	// precision: 7
+	// divx590 divide  2.4E+6     2  ->  1.2E+6
+	{"divx590", "2.4E+6", "2", "1.2E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx591 divide  2.40E+6    2  ->  1.20E+6
+	{"divx591", "2.40E+6", "2", "1.20E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx592 divide  2.400E+6   2  ->  1.200E+6
+	{"divx592", "2.400E+6", "2", "1.200E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx593 divide  2.4000E+6  2  ->  1.2000E+6
+	{"divx593", "2.4000E+6", "2", "1.2000E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx594 divide  24E+5      2  ->  1.2E+6
+	{"divx594", "24E+5", "2", "1.2E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx595 divide  240E+4     2  ->  1.20E+6
+	{"divx595", "240E+4", "2", "1.20E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx596 divide  2400E+3    2  ->  1.200E+6
+	{"divx596", "2400E+3", "2", "1.200E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// divx597 divide  24000E+2   2  ->  1.2000E+6
+	{"divx597", "24000E+2", "2", "1.2000E+6", 0, 7, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// divx600 divide  2.4E+9     2  ->  1.2E+9
+	{"divx600", "2.4E+9", "2", "1.2E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx601 divide  2.40E+9    2  ->  1.20E+9
+	{"divx601", "2.40E+9", "2", "1.20E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx602 divide  2.400E+9   2  ->  1.200E+9
+	{"divx602", "2.400E+9", "2", "1.200E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx603 divide  2.4000E+9  2  ->  1.2000E+9
+	{"divx603", "2.4000E+9", "2", "1.2000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx604 divide  24E+8      2  ->  1.2E+9
+	{"divx604", "24E+8", "2", "1.2E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx605 divide  240E+7     2  ->  1.20E+9
+	{"divx605", "240E+7", "2", "1.20E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx606 divide  2400E+6    2  ->  1.200E+9
+	{"divx606", "2400E+6", "2", "1.200E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// divx607 divide  24000E+5   2  ->  1.2000E+9
+	{"divx607", "24000E+5", "2", "1.2000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// long operand triangle
+	// precision: 33
+	// divx610 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.8131097703792 Inexact Rounded
+	{"divx610", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.8131097703792", Inexact | Rounded, 33, ToNearestAway, 999, -999, false},
+	// precision: 32
+	// divx611 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.813109770379  Inexact Rounded
+	{"divx611", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.813109770379", Inexact | Rounded, 32, ToNearestAway, 999, -999, false},
+	// precision: 31
+	// divx612 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.81310977038   Inexact Rounded
+	{"divx612", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.81310977038", Inexact | Rounded, 31, ToNearestAway, 999, -999, false},
+	// precision: 30
+	// divx613 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.8131097704    Inexact Rounded
+	{"divx613", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.8131097704", Inexact | Rounded, 30, ToNearestAway, 999, -999, false},
+	// precision: 29
+	// divx614 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.813109770     Inexact Rounded
+	{"divx614", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.813109770", Inexact | Rounded, 29, ToNearestAway, 999, -999, false},
+	// precision: 28
+	// divx615 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.81310977      Inexact Rounded
+	{"divx615", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.81310977", Inexact | Rounded, 28, ToNearestAway, 999, -999, false},
+	// precision: 27
+	// divx616 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.8131098       Inexact Rounded
+	{"divx616", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.8131098", Inexact | Rounded, 27, ToNearestAway, 999, -999, false},
+	// precision: 26
+	// divx617 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.813110        Inexact Rounded
+	{"divx617", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.813110", Inexact | Rounded, 26, ToNearestAway, 999, -999, false},
+	// precision: 25
+	// divx618 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.81311         Inexact Rounded
+	{"divx618", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.81311", Inexact | Rounded, 25, ToNearestAway, 999, -999, false},
+	// precision: 24
+	// divx619 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.8131          Inexact Rounded
+	{"divx619", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.8131", Inexact | Rounded, 24, ToNearestAway, 999, -999, false},
+	// precision: 23
+	// divx620 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.813           Inexact Rounded
+	{"divx620", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.813", Inexact | Rounded, 23, ToNearestAway, 999, -999, false},
+	// precision: 22
+	// divx621 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.81            Inexact Rounded
+	{"divx621", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.81", Inexact | Rounded, 22, ToNearestAway, 999, -999, false},
+	// precision: 21
+	// divx622 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817797.8             Inexact Rounded
+	{"divx622", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817797.8", Inexact | Rounded, 21, ToNearestAway, 999, -999, false},
+	// precision: 20
+	// divx623 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -41011408883796817798               Inexact Rounded
+	{"divx623", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-41011408883796817798", Inexact | Rounded, 20, ToNearestAway, 999, -999, false},
+	// precision: 19
+	// divx624 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.101140888379681780E+19         Inexact Rounded
+	{"divx624", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.101140888379681780E+19", Inexact | Rounded, 19, ToNearestAway, 999, -999, false},
+	// precision: 18
+	// divx625 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.10114088837968178E+19         Inexact Rounded
+	{"divx625", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.10114088837968178E+19", Inexact | Rounded, 18, ToNearestAway, 999, -999, false},
+	// precision: 17
+	// divx626 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.1011408883796818E+19         Inexact Rounded
+	{"divx626", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.1011408883796818E+19", Inexact | Rounded, 17, ToNearestAway, 999, -999, false},
+	// precision: 16
+	// divx627 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.101140888379682E+19         Inexact Rounded
+	{"divx627", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.101140888379682E+19", Inexact | Rounded, 16, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// divx628 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.10114088837968E+19         Inexact Rounded
+	{"divx628", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.10114088837968E+19", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// precision: 14
+	// divx629 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.1011408883797E+19         Inexact Rounded
+	{"divx629", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.1011408883797E+19", Inexact | Rounded, 14, ToNearestAway, 999, -999, false},
+	// precision: 13
+	// divx630 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.101140888380E+19         Inexact Rounded
+	{"divx630", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.101140888380E+19", Inexact | Rounded, 13, ToNearestAway, 999, -999, false},
+	// precision: 12
+	// divx631 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.10114088838E+19         Inexact Rounded
+	{"divx631", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.10114088838E+19", Inexact | Rounded, 12, ToNearestAway, 999, -999, false},
+	// precision: 11
+	// divx632 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.1011408884E+19         Inexact Rounded
+	{"divx632", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.1011408884E+19", Inexact | Rounded, 11, ToNearestAway, 999, -999, false},
+	// precision: 10
+	// divx633 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.101140888E+19         Inexact Rounded
+	{"divx633", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.101140888E+19", Inexact | Rounded, 10, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// divx634 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.10114089E+19         Inexact Rounded
+	{"divx634", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.10114089E+19", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 8
+	// divx635 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.1011409E+19         Inexact Rounded
+	{"divx635", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.1011409E+19", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// precision: 7
+	// divx636 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.101141E+19         Inexact Rounded
+	{"divx636", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.101141E+19", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// precision: 6
+	// divx637 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.10114E+19         Inexact Rounded
+	{"divx637", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.10114E+19", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// precision: 5
+	// divx638 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.1011E+19         Inexact Rounded
+	{"divx638", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.1011E+19", Inexact | Rounded, 5, ToNearestAway, 999, -999, false},
+	// precision: 4
+	// divx639 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.101E+19         Inexact Rounded
+	{"divx639", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.101E+19", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// precision: 3
+	// divx640 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.10E+19         Inexact Rounded
+	{"divx640", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.10E+19", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// precision: 2
+	// divx641 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4.1E+19         Inexact Rounded
+	{"divx641", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4.1E+19", Inexact | Rounded, 2, ToNearestAway, 999, -999, false},
+	// precision: 1
+	// divx642 divide -3374988581607586061255542201048 82293895124.90045271504836568681 -> -4E+19         Inexact Rounded
+	{"divx642", "-3374988581607586061255542201048", "82293895124.90045271504836568681", "-4E+19", Inexact | Rounded, 1, ToNearestAway, 999, -999, false},
+	// more zeros, etc.
+	// precision: 16
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// divx731 divide 5.00 1E-3    -> 5.00E+3
+	{"divx731", "5.00", "1E-3", "5.00E+3", 0, 16, ToNearestAway, 384, -383, false},
+	// divx732 divide 00.00 0.000  -> NaN Division_undefined
+	{"divx732", "00.00", "0.000", "NaN", DivisionUndefined, 16, ToNearestAway, 384, -383, false},
+	// divx733 divide 00.00 0E-3   -> NaN Division_undefined
+	{"divx733", "00.00", "0E-3", "NaN", DivisionUndefined, 16, ToNearestAway, 384, -383, false},
+	// divx734 divide  0    -0     -> NaN Division_undefined
+	{"divx734", "0", "-0", "NaN", DivisionUndefined, 16, ToNearestAway, 384, -383, false},
+	// divx735 divide -0     0     -> NaN Division_undefined
+	{"divx735", "-0", "0", "NaN", DivisionUndefined, 16, ToNearestAway, 384, -383, false},
+	// divx736 divide -0    -0     -> NaN Division_undefined
+	{"divx736", "-0", "-0", "NaN", DivisionUndefined, 16, ToNearestAway, 384, -383, false},
+	// divx741 divide  0    -1     -> -0
+	{"divx741", "0", "-1", "-0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx742 divide -0    -1     ->  0
+	{"divx742", "-0", "-1", "0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx743 divide  0     1     ->  0
+	{"divx743", "0", "1", "0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx744 divide -0     1     -> -0
+	{"divx744", "-0", "1", "-0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx745 divide -1     0     -> -Infinity Division_by_zero
+	{"divx745", "-1", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx746 divide -1    -0     ->  Infinity Division_by_zero
+	{"divx746", "-1", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx747 divide  1     0     ->  Infinity Division_by_zero
+	{"divx747", "1", "0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx748 divide  1    -0     -> -Infinity Division_by_zero
+	{"divx748", "1", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx751 divide  0.0  -1     -> -0.0
+	{"divx751", "0.0", "-1", "-0.0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx752 divide -0.0  -1     ->  0.0
+	{"divx752", "-0.0", "-1", "0.0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx753 divide  0.0   1     ->  0.0
+	{"divx753", "0.0", "1", "0.0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx754 divide -0.0   1     -> -0.0
+	{"divx754", "-0.0", "1", "-0.0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx755 divide -1.0   0     -> -Infinity Division_by_zero
+	{"divx755", "-1.0", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx756 divide -1.0  -0     ->  Infinity Division_by_zero
+	{"divx756", "-1.0", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx757 divide  1.0   0     ->  Infinity Division_by_zero
+	{"divx757", "1.0", "0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx758 divide  1.0  -0     -> -Infinity Division_by_zero
+	{"divx758", "1.0", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx761 divide  0    -1.0   -> -0E+1
+	{"divx761", "0", "-1.0", "-0E+1", 0, 16, ToNearestAway, 384, -383, false},
+	// divx762 divide -0    -1.0   ->  0E+1
+	{"divx762", "-0", "-1.0", "0E+1", 0, 16, ToNearestAway, 384, -383, false},
+	// divx763 divide  0     1.0   ->  0E+1
+	{"divx763", "0", "1.0", "0E+1", 0, 16, ToNearestAway, 384, -383, false},
+	// divx764 divide -0     1.0   -> -0E+1
+	{"divx764", "-0", "1.0", "-0E+1", 0, 16, ToNearestAway, 384, -383, false},
+	// divx765 divide -1     0.0   -> -Infinity Division_by_zero
+	{"divx765", "-1", "0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx766 divide -1    -0.0   ->  Infinity Division_by_zero
+	{"divx766", "-1", "-0.0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx767 divide  1     0.0   ->  Infinity Division_by_zero
+	{"divx767", "1", "0.0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx768 divide  1    -0.0   -> -Infinity Division_by_zero
+	{"divx768", "1", "-0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx771 divide  0.0  -1.0   -> -0
+	{"divx771", "0.0", "-1.0", "-0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx772 divide -0.0  -1.0   ->  0
+	{"divx772", "-0.0", "-1.0", "0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx773 divide  0.0   1.0   ->  0
+	{"divx773", "0.0", "1.0", "0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx774 divide -0.0   1.0   -> -0
+	{"divx774", "-0.0", "1.0", "-0", 0, 16, ToNearestAway, 384, -383, false},
+	// divx775 divide -1.0   0.0   -> -Infinity Division_by_zero
+	{"divx775", "-1.0", "0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx776 divide -1.0  -0.0   ->  Infinity Division_by_zero
+	{"divx776", "-1.0", "-0.0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx777 divide  1.0   0.0   ->  Infinity Division_by_zero
+	{"divx777", "1.0", "0.0", "Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// divx778 divide  1.0  -0.0   -> -Infinity Division_by_zero
+	{"divx778", "1.0", "-0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 384, -383, false},
+	// Specials
+	// divx780 divide  Inf  -Inf   ->  NaN Invalid_operation
+	{"divx780", "Inf", "-Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx781 divide  Inf  -1000  -> -Infinity
+	{"divx781", "Inf", "-1000", "-Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx782 divide  Inf  -1     -> -Infinity
+	{"divx782", "Inf", "-1", "-Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx783 divide  Inf  -0     -> -Infinity
+	{"divx783", "Inf", "-0", "-Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx784 divide  Inf   0     ->  Infinity
+	{"divx784", "Inf", "0", "Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx785 divide  Inf   1     ->  Infinity
+	{"divx785", "Inf", "1", "Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx786 divide  Inf   1000  ->  Infinity
+	{"divx786", "Inf", "1000", "Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx787 divide  Inf   Inf   ->  NaN Invalid_operation
+	{"divx787", "Inf", "Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx788 divide -1000  Inf   -> -0E-398 Clamped
+	{"divx788", "-1000", "Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx789 divide -Inf   Inf   ->  NaN Invalid_operation
+	{"divx789", "-Inf", "Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx790 divide -1     Inf   -> -0E-398 Clamped
+	{"divx790", "-1", "Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx791 divide -0     Inf   -> -0E-398 Clamped
+	{"divx791", "-0", "Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx792 divide  0     Inf   ->  0E-398 Clamped
+	{"divx792", "0", "Inf", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx793 divide  1     Inf   ->  0E-398 Clamped
+	{"divx793", "1", "Inf", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx794 divide  1000  Inf   ->  0E-398 Clamped
+	{"divx794", "1000", "Inf", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx795 divide  Inf   Inf   ->  NaN Invalid_operation
+	{"divx795", "Inf", "Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx800 divide -Inf  -Inf   ->  NaN Invalid_operation
+	{"divx800", "-Inf", "-Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx801 divide -Inf  -1000  ->  Infinity
+	{"divx801", "-Inf", "-1000", "Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx802 divide -Inf  -1     ->  Infinity
+	{"divx802", "-Inf", "-1", "Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx803 divide -Inf  -0     ->  Infinity
+	{"divx803", "-Inf", "-0", "Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx804 divide -Inf   0     -> -Infinity
+	{"divx804", "-Inf", "0", "-Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx805 divide -Inf   1     -> -Infinity
+	{"divx805", "-Inf", "1", "-Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx806 divide -Inf   1000  -> -Infinity
+	{"divx806", "-Inf", "1000", "-Inf", 0, 16, ToNearestAway, 384, -383, false},
+	// divx807 divide -Inf   Inf   ->  NaN Invalid_operation
+	{"divx807", "-Inf", "Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx808 divide -1000  Inf   -> -0E-398 Clamped
+	{"divx808", "-1000", "Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx809 divide -Inf  -Inf   ->  NaN Invalid_operation
+	{"divx809", "-Inf", "-Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx810 divide -1    -Inf   ->  0E-398 Clamped
+	{"divx810", "-1", "-Inf", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx811 divide -0    -Inf   ->  0E-398 Clamped
+	{"divx811", "-0", "-Inf", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx812 divide  0    -Inf   -> -0E-398 Clamped
+	{"divx812", "0", "-Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx813 divide  1    -Inf   -> -0E-398 Clamped
+	{"divx813", "1", "-Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx814 divide  1000 -Inf   -> -0E-398 Clamped
+	{"divx814", "1000", "-Inf", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// divx815 divide  Inf  -Inf   ->  NaN Invalid_operation
+	{"divx815", "Inf", "-Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx821 divide  NaN -Inf    ->  NaN
+	{"divx821", "NaN", "-Inf", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx822 divide  NaN -1000   ->  NaN
+	{"divx822", "NaN", "-1000", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx823 divide  NaN -1      ->  NaN
+	{"divx823", "NaN", "-1", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx824 divide  NaN -0      ->  NaN
+	{"divx824", "NaN", "-0", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx825 divide  NaN  0      ->  NaN
+	{"divx825", "NaN", "0", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx826 divide  NaN  1      ->  NaN
+	{"divx826", "NaN", "1", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx827 divide  NaN  1000   ->  NaN
+	{"divx827", "NaN", "1000", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx828 divide  NaN  Inf    ->  NaN
+	{"divx828", "NaN", "Inf", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx829 divide  NaN  NaN    ->  NaN
+	{"divx829", "NaN", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx830 divide -Inf  NaN    ->  NaN
+	{"divx830", "-Inf", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx831 divide -1000 NaN    ->  NaN
+	{"divx831", "-1000", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx832 divide -1    NaN    ->  NaN
+	{"divx832", "-1", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx833 divide -0    NaN    ->  NaN
+	{"divx833", "-0", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx834 divide  0    NaN    ->  NaN
+	{"divx834", "0", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx835 divide  1    NaN    ->  NaN
+	{"divx835", "1", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx836 divide  1000 NaN    ->  NaN
+	{"divx836", "1000", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx837 divide  Inf  NaN    ->  NaN
+	{"divx837", "Inf", "NaN", "NaN", 0, 16, ToNearestAway, 384, -383, false},
+	// divx841 divide  sNaN -Inf   ->  NaN  Invalid_operation
+	{"divx841", "sNaN", "-Inf", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx842 divide  sNaN -1000  ->  NaN  Invalid_operation
+	{"divx842", "sNaN", "-1000", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx843 divide  sNaN -1     ->  NaN  Invalid_operation
+	{"divx843", "sNaN", "-1", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx844 divide  sNaN -0     ->  NaN  Invalid_operation
+	{"divx844", "sNaN", "-0", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx845 divide  sNaN  0     ->  NaN  Invalid_operation
+	{"divx845", "sNaN", "0", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx846 divide  sNaN  1     ->  NaN  Invalid_operation
+	{"divx846", "sNaN", "1", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx847 divide  sNaN  1000  ->  NaN  Invalid_operation
+	{"divx847", "sNaN", "1000", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx848 divide  sNaN  NaN   ->  NaN  Invalid_operation
+	{"divx848", "sNaN", "NaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx849 divide  sNaN sNaN   ->  NaN  Invalid_operation
+	{"divx849", "sNaN", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx850 divide  NaN  sNaN   ->  NaN  Invalid_operation
+	{"divx850", "NaN", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx851 divide -Inf  sNaN   ->  NaN  Invalid_operation
+	{"divx851", "-Inf", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx852 divide -1000 sNaN   ->  NaN  Invalid_operation
+	{"divx852", "-1000", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx853 divide -1    sNaN   ->  NaN  Invalid_operation
+	{"divx853", "-1", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx854 divide -0    sNaN   ->  NaN  Invalid_operation
+	{"divx854", "-0", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx855 divide  0    sNaN   ->  NaN  Invalid_operation
+	{"divx855", "0", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx856 divide  1    sNaN   ->  NaN  Invalid_operation
+	{"divx856", "1", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx857 divide  1000 sNaN   ->  NaN  Invalid_operation
+	{"divx857", "1000", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx858 divide  Inf  sNaN   ->  NaN  Invalid_operation
+	{"divx858", "Inf", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx859 divide  NaN  sNaN   ->  NaN  Invalid_operation
+	{"divx859", "NaN", "sNaN", "NaN", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// divx861 divide  NaN9 -Inf   ->  NaN9
+	{"divx861", "NaN9", "-Inf", "NaN9", 0, 16, ToNearestAway, 384, -383, false},
+	// divx862 divide  NaN8  1000  ->  NaN8
+	{"divx862", "NaN8", "1000", "NaN8", 0, 16, ToNearestAway, 384, -383, false},
+	// divx863 divide  NaN7  Inf   ->  NaN7
+	{"divx863", "NaN7", "Inf", "NaN7", 0, 16, ToNearestAway, 384, -383, false},
+	// divx864 divide  NaN6  NaN5  ->  NaN6
+	{"divx864", "NaN6", "NaN5", "NaN6", 0, 16, ToNearestAway, 384, -383, false},
+	// divx865 divide -Inf   NaN4  ->  NaN4
+	{"divx865", "-Inf", "NaN4", "NaN4", 0, 16, ToNearestAway, 384, -383, false},
+	// divx866 divide -1000  NaN3  ->  NaN3
+	{"divx866", "-1000", "NaN3", "NaN3", 0, 16, ToNearestAway, 384, -383, false},
+	// divx867 divide  Inf   NaN2  ->  NaN2
+	{"divx867", "Inf", "NaN2", "NaN2", 0, 16, ToNearestAway, 384, -383, false},
+	// divx871 divide  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"divx871", "sNaN99", "-Inf", "NaN99", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx872 divide  sNaN98 -1      ->  NaN98 Invalid_operation
+	{"divx872", "sNaN98", "-1", "NaN98", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx873 divide  sNaN97  NaN    ->  NaN97 Invalid_operation
+	{"divx873", "sNaN97", "NaN", "NaN97", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx874 divide  sNaN96 sNaN94  ->  NaN96 Invalid_operation
+	{"divx874", "sNaN96", "sNaN94", "NaN96", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx875 divide  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"divx875", "NaN95", "sNaN93", "NaN93", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx876 divide -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"divx876", "-Inf", "sNaN92", "NaN92", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx877 divide  0      sNaN91  ->  NaN91 Invalid_operation
+	{"divx877", "0", "sNaN91", "NaN91", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx878 divide  Inf    sNaN90  ->  NaN90 Invalid_operation
+	{"divx878", "Inf", "sNaN90", "NaN90", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx879 divide  NaN    sNaN89  ->  NaN89 Invalid_operation
+	{"divx879", "NaN", "sNaN89", "NaN89", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx881 divide  -NaN9  -Inf   ->  -NaN9
+	{"divx881", "-NaN9", "-Inf", "-NaN9", 0, 16, ToNearestAway, 384, -383, false},
+	// divx882 divide  -NaN8   1000  ->  -NaN8
+	{"divx882", "-NaN8", "1000", "-NaN8", 0, 16, ToNearestAway, 384, -383, false},
+	// divx883 divide  -NaN7   Inf   ->  -NaN7
+	{"divx883", "-NaN7", "Inf", "-NaN7", 0, 16, ToNearestAway, 384, -383, false},
+	// divx884 divide  -NaN6  -NaN5  ->  -NaN6
+	{"divx884", "-NaN6", "-NaN5", "-NaN6", 0, 16, ToNearestAway, 384, -383, false},
+	// divx885 divide  -Inf   -NaN4  ->  -NaN4
+	{"divx885", "-Inf", "-NaN4", "-NaN4", 0, 16, ToNearestAway, 384, -383, false},
+	// divx886 divide  -1000  -NaN3  ->  -NaN3
+	{"divx886", "-1000", "-NaN3", "-NaN3", 0, 16, ToNearestAway, 384, -383, false},
+	// divx887 divide   Inf   -NaN2  ->  -NaN2
+	{"divx887", "Inf", "-NaN2", "-NaN2", 0, 16, ToNearestAway, 384, -383, false},
+	// divx891 divide -sNaN99 -Inf    -> -NaN99 Invalid_operation
+	{"divx891", "-sNaN99", "-Inf", "-NaN99", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx892 divide -sNaN98 -1      -> -NaN98 Invalid_operation
+	{"divx892", "-sNaN98", "-1", "-NaN98", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx893 divide -sNaN97  NaN    -> -NaN97 Invalid_operation
+	{"divx893", "-sNaN97", "NaN", "-NaN97", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx894 divide -sNaN96 -sNaN94 -> -NaN96 Invalid_operation
+	{"divx894", "-sNaN96", "-sNaN94", "-NaN96", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx895 divide -NaN95  -sNaN93 -> -NaN93 Invalid_operation
+	{"divx895", "-NaN95", "-sNaN93", "-NaN93", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx896 divide -Inf    -sNaN92 -> -NaN92 Invalid_operation
+	{"divx896", "-Inf", "-sNaN92", "-NaN92", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx897 divide  0      -sNaN91 -> -NaN91 Invalid_operation
+	{"divx897", "0", "-sNaN91", "-NaN91", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx898 divide  Inf    -sNaN90 -> -NaN90 Invalid_operation
+	{"divx898", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// divx899 divide -NaN    -sNaN89 -> -NaN89 Invalid_operation
+	{"divx899", "-NaN", "-sNaN89", "-NaN89", InvalidOperation, 16, ToNearestAway, 384, -383, false},
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// Various flavours of divide by 0
+	// divx901 divide    0       0   ->  NaN Division_undefined
+	{"divx901", "0", "0", "NaN", DivisionUndefined, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx902 divide    0.0E5   0   ->  NaN Division_undefined
+	{"divx902", "0.0E5", "0", "NaN", DivisionUndefined, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx903 divide    0.000   0   ->  NaN Division_undefined
+	{"divx903", "0.000", "0", "NaN", DivisionUndefined, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx904 divide    0.0001  0   ->  Infinity Division_by_zero
+	{"divx904", "0.0001", "0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx905 divide    0.01    0   ->  Infinity Division_by_zero
+	{"divx905", "0.01", "0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx906 divide    0.1     0   ->  Infinity Division_by_zero
+	{"divx906", "0.1", "0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx907 divide    1       0   ->  Infinity Division_by_zero
+	{"divx907", "1", "0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx908 divide    1       0.0 ->  Infinity Division_by_zero
+	{"divx908", "1", "0.0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx909 divide   10       0.0 ->  Infinity Division_by_zero
+	{"divx909", "10", "0.0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx910 divide   1E+100   0.0 ->  Infinity Division_by_zero
+	{"divx910", "1E+100", "0.0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx911 divide   1E+1000  0   ->  Infinity Division_by_zero
+	{"divx911", "1E+1000", "0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx921 divide   -0.0001  0   -> -Infinity Division_by_zero
+	{"divx921", "-0.0001", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx922 divide   -0.01    0   -> -Infinity Division_by_zero
+	{"divx922", "-0.01", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx923 divide   -0.1     0   -> -Infinity Division_by_zero
+	{"divx923", "-0.1", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx924 divide   -1       0   -> -Infinity Division_by_zero
+	{"divx924", "-1", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx925 divide   -1       0.0 -> -Infinity Division_by_zero
+	{"divx925", "-1", "0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx926 divide  -10       0.0 -> -Infinity Division_by_zero
+	{"divx926", "-10", "0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx927 divide  -1E+100   0.0 -> -Infinity Division_by_zero
+	{"divx927", "-1E+100", "0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx928 divide  -1E+1000  0   -> -Infinity Division_by_zero
+	{"divx928", "-1E+1000", "0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx931 divide    0.0001 -0   -> -Infinity Division_by_zero
+	{"divx931", "0.0001", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx932 divide    0.01   -0   -> -Infinity Division_by_zero
+	{"divx932", "0.01", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx933 divide    0.1    -0   -> -Infinity Division_by_zero
+	{"divx933", "0.1", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx934 divide    1      -0   -> -Infinity Division_by_zero
+	{"divx934", "1", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx935 divide    1      -0.0 -> -Infinity Division_by_zero
+	{"divx935", "1", "-0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx936 divide   10      -0.0 -> -Infinity Division_by_zero
+	{"divx936", "10", "-0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx937 divide   1E+100  -0.0 -> -Infinity Division_by_zero
+	{"divx937", "1E+100", "-0.0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx938 divide   1E+1000 -0   -> -Infinity Division_by_zero
+	{"divx938", "1E+1000", "-0", "-Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx941 divide   -0.0001 -0   ->  Infinity Division_by_zero
+	{"divx941", "-0.0001", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx942 divide   -0.01   -0   ->  Infinity Division_by_zero
+	{"divx942", "-0.01", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx943 divide   -0.1    -0   ->  Infinity Division_by_zero
+	{"divx943", "-0.1", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx944 divide   -1      -0   ->  Infinity Division_by_zero
+	{"divx944", "-1", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx945 divide   -1      -0.0 ->  Infinity Division_by_zero
+	{"divx945", "-1", "-0.0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx946 divide  -10      -0.0 ->  Infinity Division_by_zero
+	{"divx946", "-10", "-0.0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx947 divide  -1E+100  -0.0 ->  Infinity Division_by_zero
+	{"divx947", "-1E+100", "-0.0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// divx948 divide  -1E+1000 -0   ->  Infinity Division_by_zero
+	{"divx948", "-1E+1000", "-0", "Inf", DivisionByZero, 16, ToNearestAway, 999999999, -999999999, false},
+	// overflow and underflow tests
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// divx951 divide 9E+999999999 +0.23456789012345E-0 -> Infinity Inexact Overflow Rounded
+	{"divx951", "9E+999999999", "+0.23456789012345E-0", "Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx952 divide +0.100 9E+999999999 -> 1.111111E-1000000001 Inexact Rounded Underflow Subnormal
+	{"divx952", "+0.100", "9E+999999999", "1.111111E-1000000001", Inexact | Rounded | Underflow | Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx953 divide 9E-999999999 +9.100 -> 9.8901099E-1000000000 Inexact Rounded Underflow Subnormal
+	{"divx953", "9E-999999999", "+9.100", "9.8901099E-1000000000", Inexact | Rounded | Underflow | Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx954 divide -1.23456789          9E+999999999 -> -1.3717421E-1000000000 Subnormal
+	{"divx954", "-1.23456789", "9E+999999999", "-1.3717421E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx955 divide -1.23456789012345E-0 9E+999999999 -> -1.3717421E-1000000000 Underflow Subnormal Rounded Inexact
+	{"divx955", "-1.23456789012345E-0", "9E+999999999", "-1.3717421E-1000000000", Underflow | Subnormal | Rounded | Inexact, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx956 divide -1.23456789012345E-0 7E+999999999 -> -1.7636684E-1000000000 Inexact Rounded Underflow Subnormal
+	{"divx956", "-1.23456789012345E-0", "7E+999999999", "-1.7636684E-1000000000", Inexact | Rounded | Underflow | Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx957 divide 9E+999999999 -0.83456789012345E-0 -> -Infinity Inexact Overflow Rounded
+	{"divx957", "9E+999999999", "-0.83456789012345E-0", "-Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx958 divide -0.100 9E+999999999 -> -1.111111E-1000000001 Subnormal Inexact Rounded Underflow
+	{"divx958", "-0.100", "9E+999999999", "-1.111111E-1000000001", Subnormal | Inexact | Rounded | Underflow, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx959 divide 9E-999999999 -9.100 -> -9.8901099E-1000000000 Inexact Rounded Underflow Subnormal
+	{"divx959", "9E-999999999", "-9.100", "-9.8901099E-1000000000", Inexact | Rounded | Underflow | Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// overflow and underflow (additional edge tests in multiply.decTest)
+	// 'subnormal' results now possible (all hard underflow or overflow in
+	// base arithmetic)
+	// divx960 divide 1e-600000000 1e+400000001 -> 1E-1000000001 Subnormal
+	{"divx960", "1e-600000000", "1e+400000001", "1E-1000000001", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx961 divide 1e-600000000 1e+400000002 -> 1E-1000000002 Subnormal
+	{"divx961", "1e-600000000", "1e+400000002", "1E-1000000002", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx962 divide 1e-600000000 1e+400000003 -> 1E-1000000003 Subnormal
+	{"divx962", "1e-600000000", "1e+400000003", "1E-1000000003", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx963 divide 1e-600000000 1e+400000004 -> 1E-1000000004 Subnormal
+	{"divx963", "1e-600000000", "1e+400000004", "1E-1000000004", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx964 divide 1e-600000000 1e+400000005 -> 1E-1000000005 Subnormal
+	{"divx964", "1e-600000000", "1e+400000005", "1E-1000000005", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx965 divide 1e-600000000 1e+400000006 -> 1E-1000000006 Subnormal
+	{"divx965", "1e-600000000", "1e+400000006", "1E-1000000006", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx966 divide 1e-600000000 1e+400000007 -> 1E-1000000007 Subnormal
+	{"divx966", "1e-600000000", "1e+400000007", "1E-1000000007", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx967 divide 1e-600000000 1e+400000008 -> 0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx967", "1e-600000000", "1e+400000008", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx968 divide 1e-600000000 1e+400000009 -> 0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx968", "1e-600000000", "1e+400000009", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx969 divide 1e-600000000 1e+400000010 -> 0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx969", "1e-600000000", "1e+400000010", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// [no equivalent of 'subnormal' for overflow]
+	// divx970 divide 1e+600000000 1e-400000001 -> Infinity Overflow Inexact Rounded
+	{"divx970", "1e+600000000", "1e-400000001", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx971 divide 1e+600000000 1e-400000002 -> Infinity Overflow Inexact Rounded
+	{"divx971", "1e+600000000", "1e-400000002", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx972 divide 1e+600000000 1e-400000003 -> Infinity Overflow Inexact Rounded
+	{"divx972", "1e+600000000", "1e-400000003", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx973 divide 1e+600000000 1e-400000004 -> Infinity Overflow Inexact Rounded
+	{"divx973", "1e+600000000", "1e-400000004", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx974 divide 1e+600000000 1e-400000005 -> Infinity Overflow Inexact Rounded
+	{"divx974", "1e+600000000", "1e-400000005", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx975 divide 1e+600000000 1e-400000006 -> Infinity Overflow Inexact Rounded
+	{"divx975", "1e+600000000", "1e-400000006", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx976 divide 1e+600000000 1e-400000007 -> Infinity Overflow Inexact Rounded
+	{"divx976", "1e+600000000", "1e-400000007", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx977 divide 1e+600000000 1e-400000008 -> Infinity Overflow Inexact Rounded
+	{"divx977", "1e+600000000", "1e-400000008", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx978 divide 1e+600000000 1e-400000009 -> Infinity Overflow Inexact Rounded
+	{"divx978", "1e+600000000", "1e-400000009", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx979 divide 1e+600000000 1e-400000010 -> Infinity Overflow Inexact Rounded
+	{"divx979", "1e+600000000", "1e-400000010", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// Sign after overflow and underflow
+	// divx980 divide  1e-600000000  1e+400000009 ->  0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx980", "1e-600000000", "1e+400000009", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx981 divide  1e-600000000 -1e+400000009 -> -0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx981", "1e-600000000", "-1e+400000009", "-0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx982 divide -1e-600000000  1e+400000009 -> -0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx982", "-1e-600000000", "1e+400000009", "-0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx983 divide -1e-600000000 -1e+400000009 ->  0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"divx983", "-1e-600000000", "-1e+400000009", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx984 divide  1e+600000000  1e-400000009 ->  Infinity Overflow Inexact Rounded
+	{"divx984", "1e+600000000", "1e-400000009", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx985 divide  1e+600000000 -1e-400000009 -> -Infinity Overflow Inexact Rounded
+	{"divx985", "1e+600000000", "-1e-400000009", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx986 divide -1e+600000000  1e-400000009 -> -Infinity Overflow Inexact Rounded
+	{"divx986", "-1e+600000000", "1e-400000009", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// divx987 divide -1e+600000000 -1e-400000009 ->  Infinity Overflow Inexact Rounded
+	{"divx987", "-1e+600000000", "-1e-400000009", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// Long operand overflow may be a different path
+	// precision: 3
+	// divx990 divide 1000  9.999E-999999999      ->  Infinity Inexact Overflow Rounded
+	{"divx990", "1000", "9.999E-999999999", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// divx991 divide 1000 -9.999E-999999999      -> -Infinity Inexact Overflow Rounded
+	{"divx991", "1000", "-9.999E-999999999", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// divx992 divide       9.999E+999999999 0.01 ->  Infinity Inexact Overflow Rounded
+	{"divx992", "9.999E+999999999", "0.01", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// divx993 divide      -9.999E+999999999 0.01 -> -Infinity Inexact Overflow Rounded
+	{"divx993", "-9.999E+999999999", "0.01", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// check for double-rounded subnormals
+	// precision: 5
+	// maxexponent: 79
+	// minexponent: -79
+	// divx1001 divide    1.52444E-80 1      -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"divx1001", "1.52444E-80", "1", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// divx1002 divide    1.52445E-80 1      -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"divx1002", "1.52445E-80", "1", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// divx1003 divide    1.52446E-80 1      -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"divx1003", "1.52446E-80", "1", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// a rounding problem in one implementation
+	// precision: 34
+	// rounding: half_up
+	// maxexponent: 6144
+	// minexponent: -6143
+	// Unbounded answer to 40 digits:
+	//   1.465811965811965811965811965811965811966E+7000
+	// divx1010 divide 343E6000  234E-1000 -> Infinity Overflow Inexact Rounded
+	{"divx1010", "343E6000", "234E-1000", "Inf", Overflow | Inexact | Rounded, 34, ToNearestAway, 6144, -6143, false},
+	// precision: 34
+	// rounding: half_up
+	// maxexponent: 6144
+	// minexponent: -6143
+	// Examples from SQL proposal (Krishna Kulkarni)
+	// precision: 7
+	// divx1021  divide 1E0          1E0 -> 1
+	{"divx1021", "1E0", "1E0", "1", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1022  divide 1E0          2E0 -> 0.5
+	{"divx1022", "1E0", "2E0", "0.5", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1023  divide 1E0          3E0 -> 0.3333333 Inexact Rounded
+	{"divx1023", "1E0", "3E0", "0.3333333", Inexact | Rounded, 7, ToNearestAway, 6144, -6143, false},
+	// divx1024  divide 100E-2   1000E-3 -> 1
+	{"divx1024", "100E-2", "1000E-3", "1", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1025  divide 24E-1        2E0 -> 1.2
+	{"divx1025", "24E-1", "2E0", "1.2", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1026  divide 2400E-3      2E0 -> 1.200
+	{"divx1026", "2400E-3", "2E0", "1.200", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1027  divide 5E0          2E0 -> 2.5
+	{"divx1027", "5E0", "2E0", "2.5", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1028  divide 5E0        20E-1 -> 2.5
+	{"divx1028", "5E0", "20E-1", "2.5", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1029  divide 5E0      2000E-3 -> 2.5
+	{"divx1029", "5E0", "2000E-3", "2.5", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1030  divide 5E0         2E-1 -> 25
+	{"divx1030", "5E0", "2E-1", "25", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1031  divide 5E0        20E-2 -> 25
+	{"divx1031", "5E0", "20E-2", "25", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1032  divide 480E-2       3E0 -> 1.60
+	{"divx1032", "480E-2", "3E0", "1.60", 0, 7, ToNearestAway, 6144, -6143, false},
+	// divx1033  divide 47E-1        2E0 -> 2.35
+	{"divx1033", "47E-1", "2E0", "2.35", 0, 7, ToNearestAway, 6144, -6143, false},
+	// ECMAScript bad examples
+	// rounding: half_down
+	// precision: 7
+	// divx1050  divide 5 9  -> 0.5555556 Inexact Rounded
+	{"divx1050", "5", "9", "0.5555556", Inexact | Rounded, 7, ToNearestZero, 6144, -6143, false},
+	// rounding: half_even
+	// divx1051  divide 5 11 -> 0.4545455 Inexact Rounded
+	{"divx1051", "5", "11", "0.4545455", Inexact | Rounded, 7, ToNearestEven, 6144, -6143, false},
+	// payload decapitate
+	// precision: 5
+	// divx1055  divide   sNaN987654321 1 ->  NaN54321  Invalid_operation
+	{"divx1055", "sNaN987654321", "1", "NaN54321", InvalidOperation, 5, ToNearestEven, 6144, -6143, false},
+	// Null tests
+	// SKIP (encoding not supported): divx9998 divide 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): divx9999 divide  # 10 -> NaN Invalid_operation
+}
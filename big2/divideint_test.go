@@ -0,0 +1,834 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var divideintTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// dvix001 divideint  1     1    ->  1
+	{"dvix001", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix002 divideint  2     1    ->  2
+	{"dvix002", "2", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix003 divideint  1     2    ->  0
+	{"dvix003", "1", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix004 divideint  2     2    ->  1
+	{"dvix004", "2", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix005 divideint  0     1    ->  0
+	{"dvix005", "0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix006 divideint  0     2    ->  0
+	{"dvix006", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix007 divideint  1     3    ->  0
+	{"dvix007", "1", "3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix008 divideint  2     3    ->  0
+	{"dvix008", "2", "3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix009 divideint  3     3    ->  1
+	{"dvix009", "3", "3", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix010 divideint  2.4   1    ->  2
+	{"dvix010", "2.4", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix011 divideint  2.4   -1   ->  -2
+	{"dvix011", "2.4", "-1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix012 divideint  -2.4  1    ->  -2
+	{"dvix012", "-2.4", "1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix013 divideint  -2.4  -1   ->  2
+	{"dvix013", "-2.4", "-1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix014 divideint  2.40  1    ->  2
+	{"dvix014", "2.40", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix015 divideint  2.400 1    ->  2
+	{"dvix015", "2.400", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix016 divideint  2.4   2    ->  1
+	{"dvix016", "2.4", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix017 divideint  2.400 2    ->  1
+	{"dvix017", "2.400", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix018 divideint  2.    2    ->  1
+	{"dvix018", "2.", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix019 divideint  20    20   ->  1
+	{"dvix019", "20", "20", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix020 divideint  187   187  ->  1
+	{"dvix020", "187", "187", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix021 divideint  5     2    ->  2
+	{"dvix021", "5", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix022 divideint  5     2.0    ->  2
+	{"dvix022", "5", "2.0", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix023 divideint  5     2.000  ->  2
+	{"dvix023", "5", "2.000", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix024 divideint  5     0.200  ->  25
+	{"dvix024", "5", "0.200", "25", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix025 divideint  5     0.200  ->  25
+	{"dvix025", "5", "0.200", "25", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix030 divideint  1     2      ->  0
+	{"dvix030", "1", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix031 divideint  1     4      ->  0
+	{"dvix031", "1", "4", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix032 divideint  1     8      ->  0
+	{"dvix032", "1", "8", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix033 divideint  1     16     ->  0
+	{"dvix033", "1", "16", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix034 divideint  1     32     ->  0
+	{"dvix034", "1", "32", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix035 divideint  1     64     ->  0
+	{"dvix035", "1", "64", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix040 divideint  1    -2      -> -0
+	{"dvix040", "1", "-2", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix041 divideint  1    -4      -> -0
+	{"dvix041", "1", "-4", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix042 divideint  1    -8      -> -0
+	{"dvix042", "1", "-8", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix043 divideint  1    -16     -> -0
+	{"dvix043", "1", "-16", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix044 divideint  1    -32     -> -0
+	{"dvix044", "1", "-32", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix045 divideint  1    -64     -> -0
+	{"dvix045", "1", "-64", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix050 divideint -1     2      -> -0
+	{"dvix050", "-1", "2", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix051 divideint -1     4      -> -0
+	{"dvix051", "-1", "4", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix052 divideint -1     8      -> -0
+	{"dvix052", "-1", "8", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix053 divideint -1     16     -> -0
+	{"dvix053", "-1", "16", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix054 divideint -1     32     -> -0
+	{"dvix054", "-1", "32", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix055 divideint -1     64     -> -0
+	{"dvix055", "-1", "64", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix060 divideint -1    -2      ->  0
+	{"dvix060", "-1", "-2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix061 divideint -1    -4      ->  0
+	{"dvix061", "-1", "-4", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix062 divideint -1    -8      ->  0
+	{"dvix062", "-1", "-8", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix063 divideint -1    -16     ->  0
+	{"dvix063", "-1", "-16", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix064 divideint -1    -32     ->  0
+	{"dvix064", "-1", "-32", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix065 divideint -1    -64     ->  0
+	{"dvix065", "-1", "-64", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// similar with powers of ten
+	// dvix160 divideint  1     1         ->  1
+	{"dvix160", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix161 divideint  1     10        ->  0
+	{"dvix161", "1", "10", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix162 divideint  1     100       ->  0
+	{"dvix162", "1", "100", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix163 divideint  1     1000      ->  0
+	{"dvix163", "1", "1000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix164 divideint  1     10000     ->  0
+	{"dvix164", "1", "10000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix165 divideint  1     100000    ->  0
+	{"dvix165", "1", "100000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix166 divideint  1     1000000   ->  0
+	{"dvix166", "1", "1000000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix167 divideint  1     10000000  ->  0
+	{"dvix167", "1", "10000000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix168 divideint  1     100000000 ->  0
+	{"dvix168", "1", "100000000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix170 divideint  1    -1         -> -1
+	{"dvix170", "1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix171 divideint  1    -10        -> -0
+	{"dvix171", "1", "-10", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix172 divideint  1    -100       -> -0
+	{"dvix172", "1", "-100", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix173 divideint  1    -1000      -> -0
+	{"dvix173", "1", "-1000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix174 divideint  1    -10000     -> -0
+	{"dvix174", "1", "-10000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix175 divideint  1    -100000    -> -0
+	{"dvix175", "1", "-100000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix176 divideint  1    -1000000   -> -0
+	{"dvix176", "1", "-1000000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix177 divideint  1    -10000000  -> -0
+	{"dvix177", "1", "-10000000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix178 divideint  1    -100000000 -> -0
+	{"dvix178", "1", "-100000000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix180 divideint -1     1         -> -1
+	{"dvix180", "-1", "1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix181 divideint -1     10        -> -0
+	{"dvix181", "-1", "10", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix182 divideint -1     100       -> -0
+	{"dvix182", "-1", "100", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix183 divideint -1     1000      -> -0
+	{"dvix183", "-1", "1000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix184 divideint -1     10000     -> -0
+	{"dvix184", "-1", "10000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix185 divideint -1     100000    -> -0
+	{"dvix185", "-1", "100000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix186 divideint -1     1000000   -> -0
+	{"dvix186", "-1", "1000000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix187 divideint -1     10000000  -> -0
+	{"dvix187", "-1", "10000000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix188 divideint -1     100000000 -> -0
+	{"dvix188", "-1", "100000000", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix190 divideint -1    -1         ->  1
+	{"dvix190", "-1", "-1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix191 divideint -1    -10        ->  0
+	{"dvix191", "-1", "-10", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix192 divideint -1    -100       ->  0
+	{"dvix192", "-1", "-100", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix193 divideint -1    -1000      ->  0
+	{"dvix193", "-1", "-1000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix194 divideint -1    -10000     ->  0
+	{"dvix194", "-1", "-10000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix195 divideint -1    -100000    ->  0
+	{"dvix195", "-1", "-100000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix196 divideint -1    -1000000   ->  0
+	{"dvix196", "-1", "-1000000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix197 divideint -1    -10000000  ->  0
+	{"dvix197", "-1", "-10000000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix198 divideint -1    -100000000 ->  0
+	{"dvix198", "-1", "-100000000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// some long operand cases here
+	// dvix070 divideint  999999999     1  ->  999999999
+	{"dvix070", "999999999", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix071 divideint  999999999.4   1  ->  999999999
+	{"dvix071", "999999999.4", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix072 divideint  999999999.5   1  ->  999999999
+	{"dvix072", "999999999.5", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix073 divideint  999999999.9   1  ->  999999999
+	{"dvix073", "999999999.9", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix074 divideint  999999999.999 1  ->  999999999
+	{"dvix074", "999999999.999", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// dvix080 divideint  999999999     1  ->  NaN Division_impossible
+	{"dvix080", "999999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// dvix081 divideint  99999999      1  ->  NaN Division_impossible
+	{"dvix081", "99999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// dvix082 divideint  9999999       1  ->  NaN Division_impossible
+	{"dvix082", "9999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// dvix083 divideint  999999        1  ->  999999
+	{"dvix083", "999999", "1", "999999", 0, 6, ToNearestAway, 384, -383, false},
+	// dvix084 divideint  99999         1  ->  99999
+	{"dvix084", "99999", "1", "99999", 0, 6, ToNearestAway, 384, -383, false},
+	// dvix085 divideint  9999          1  ->  9999
+	{"dvix085", "9999", "1", "9999", 0, 6, ToNearestAway, 384, -383, false},
+	// dvix086 divideint  999           1  ->  999
+	{"dvix086", "999", "1", "999", 0, 6, ToNearestAway, 384, -383, false},
+	// dvix087 divideint  99            1  ->  99
+	{"dvix087", "99", "1", "99", 0, 6, ToNearestAway, 384, -383, false},
+	// dvix088 divideint  9             1  ->  9
+	{"dvix088", "9", "1", "9", 0, 6, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// dvix090 divideint  0.            1    ->  0
+	{"dvix090", "0.", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix091 divideint  .0            1    ->  0
+	{"dvix091", ".0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix092 divideint  0.00          1    ->  0
+	{"dvix092", "0.00", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix093 divideint  0.00E+9       1    ->  0
+	{"dvix093", "0.00E+9", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix094 divideint  0.0000E-50    1    ->  0
+	{"dvix094", "0.0000E-50", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix100 divideint  1  1   -> 1
+	{"dvix100", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix101 divideint  1  2   -> 0
+	{"dvix101", "1", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix102 divideint  1  3   -> 0
+	{"dvix102", "1", "3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix103 divideint  1  4   -> 0
+	{"dvix103", "1", "4", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix104 divideint  1  5   -> 0
+	{"dvix104", "1", "5", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix105 divideint  1  6   -> 0
+	{"dvix105", "1", "6", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix106 divideint  1  7   -> 0
+	{"dvix106", "1", "7", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix107 divideint  1  8   -> 0
+	{"dvix107", "1", "8", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix108 divideint  1  9   -> 0
+	{"dvix108", "1", "9", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix109 divideint  1  10  -> 0
+	{"dvix109", "1", "10", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix110 divideint  1  1   -> 1
+	{"dvix110", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix111 divideint  2  1   -> 2
+	{"dvix111", "2", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix112 divideint  3  1   -> 3
+	{"dvix112", "3", "1", "3", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix113 divideint  4  1   -> 4
+	{"dvix113", "4", "1", "4", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix114 divideint  5  1   -> 5
+	{"dvix114", "5", "1", "5", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix115 divideint  6  1   -> 6
+	{"dvix115", "6", "1", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix116 divideint  7  1   -> 7
+	{"dvix116", "7", "1", "7", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix117 divideint  8  1   -> 8
+	{"dvix117", "8", "1", "8", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix118 divideint  9  1   -> 9
+	{"dvix118", "9", "1", "9", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix119 divideint  10 1   -> 10
+	{"dvix119", "10", "1", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// from DiagBigDecimal
+	// dvix131 divideint  101.3   1     ->  101
+	{"dvix131", "101.3", "1", "101", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix132 divideint  101.0   1     ->  101
+	{"dvix132", "101.0", "1", "101", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix133 divideint  101.3   3     ->  33
+	{"dvix133", "101.3", "3", "33", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix134 divideint  101.0   3     ->  33
+	{"dvix134", "101.0", "3", "33", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix135 divideint  2.4     1     ->  2
+	{"dvix135", "2.4", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix136 divideint  2.400   1     ->  2
+	{"dvix136", "2.400", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix137 divideint  18      18    ->  1
+	{"dvix137", "18", "18", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix138 divideint  1120    1000  ->  1
+	{"dvix138", "1120", "1000", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix139 divideint  2.4     2     ->  1
+	{"dvix139", "2.4", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix140 divideint  2.400   2     ->  1
+	{"dvix140", "2.400", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix141 divideint  0.5     2.000 ->  0
+	{"dvix141", "0.5", "2.000", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix142 divideint  8.005   7     ->  1
+	{"dvix142", "8.005", "7", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix143 divideint  5       2     ->  2
+	{"dvix143", "5", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix144 divideint  0       2     ->  0
+	{"dvix144", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix145 divideint  0.00    2     ->  0
+	{"dvix145", "0.00", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// Others
+	// dvix150 divideint  12345  4.999  ->  2469
+	{"dvix150", "12345", "4.999", "2469", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix151 divideint  12345  4.99   ->  2473
+	{"dvix151", "12345", "4.99", "2473", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix152 divideint  12345  4.9    ->  2519
+	{"dvix152", "12345", "4.9", "2519", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix153 divideint  12345  5      ->  2469
+	{"dvix153", "12345", "5", "2469", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix154 divideint  12345  5.1    ->  2420
+	{"dvix154", "12345", "5.1", "2420", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix155 divideint  12345  5.01   ->  2464
+	{"dvix155", "12345", "5.01", "2464", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix156 divideint  12345  5.001  ->  2468
+	{"dvix156", "12345", "5.001", "2468", 0, 9, ToNearestAway, 384, -383, false},
+	// dvix157 divideint    101  7.6    ->  13
+	{"dvix157", "101", "7.6", "13", 0, 9, ToNearestAway, 384, -383, false},
+	// Various flavours of divideint by 0
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// dvix201 divideint  0      0   -> NaN Division_undefined
+	{"dvix201", "0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix202 divideint  0.0E5  0   -> NaN Division_undefined
+	{"dvix202", "0.0E5", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix203 divideint  0.000  0   -> NaN Division_undefined
+	{"dvix203", "0.000", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix204 divideint  0.0001 0   -> Infinity Division_by_zero
+	{"dvix204", "0.0001", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix205 divideint  0.01   0   -> Infinity Division_by_zero
+	{"dvix205", "0.01", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix206 divideint  0.1    0   -> Infinity Division_by_zero
+	{"dvix206", "0.1", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix207 divideint  1      0   -> Infinity Division_by_zero
+	{"dvix207", "1", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix208 divideint  1      0.0 -> Infinity Division_by_zero
+	{"dvix208", "1", "0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix209 divideint 10      0.0 -> Infinity Division_by_zero
+	{"dvix209", "10", "0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix210 divideint 1E+100  0.0 -> Infinity Division_by_zero
+	{"dvix210", "1E+100", "0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix211 divideint 1E+1000 0   -> Infinity Division_by_zero
+	{"dvix211", "1E+1000", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix214 divideint  -0.0001 0   -> -Infinity Division_by_zero
+	{"dvix214", "-0.0001", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix215 divideint  -0.01   0   -> -Infinity Division_by_zero
+	{"dvix215", "-0.01", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix216 divideint  -0.1    0   -> -Infinity Division_by_zero
+	{"dvix216", "-0.1", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix217 divideint  -1      0   -> -Infinity Division_by_zero
+	{"dvix217", "-1", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix218 divideint  -1      0.0 -> -Infinity Division_by_zero
+	{"dvix218", "-1", "0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix219 divideint -10      0.0 -> -Infinity Division_by_zero
+	{"dvix219", "-10", "0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix220 divideint -1E+100  0.0 -> -Infinity Division_by_zero
+	{"dvix220", "-1E+100", "0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix221 divideint -1E+1000 0   -> -Infinity Division_by_zero
+	{"dvix221", "-1E+1000", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999999999, -999999999, false},
+	// test some cases that are close to exponent overflow
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// dvix270 divideint 1 1e999999999    -> 0
+	{"dvix270", "1", "1e999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix271 divideint 1 0.9e999999999  -> 0
+	{"dvix271", "1", "0.9e999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix272 divideint 1 0.99e999999999 -> 0
+	{"dvix272", "1", "0.99e999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix273 divideint 1 0.999999999e999999999 -> 0
+	{"dvix273", "1", "0.999999999e999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix274 divideint 9e999999999    1       -> NaN Division_impossible
+	{"dvix274", "9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix275 divideint 9.9e999999999  1       -> NaN Division_impossible
+	{"dvix275", "9.9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix276 divideint 9.99e999999999 1       -> NaN Division_impossible
+	{"dvix276", "9.99e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix277 divideint 9.99999999e999999999 1 -> NaN Division_impossible
+	{"dvix277", "9.99999999e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix280 divideint 0.1 9e-999999999       -> NaN Division_impossible
+	{"dvix280", "0.1", "9e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix281 divideint 0.1 99e-999999999      -> NaN Division_impossible
+	{"dvix281", "0.1", "99e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix282 divideint 0.1 999e-999999999     -> NaN Division_impossible
+	{"dvix282", "0.1", "999e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix283 divideint 0.1 9e-999999998       -> NaN Division_impossible
+	{"dvix283", "0.1", "9e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix284 divideint 0.1 99e-999999998      -> NaN Division_impossible
+	{"dvix284", "0.1", "99e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix285 divideint 0.1 999e-999999998     -> NaN Division_impossible
+	{"dvix285", "0.1", "999e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix286 divideint 0.1 999e-999999997     -> NaN Division_impossible
+	{"dvix286", "0.1", "999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix287 divideint 0.1 9999e-999999997    -> NaN Division_impossible
+	{"dvix287", "0.1", "9999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix288 divideint 0.1 99999e-999999997   -> NaN Division_impossible
+	{"dvix288", "0.1", "99999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// GD edge cases: lhs smaller than rhs but more digits
+	// dvix301  divideint  0.9      2      ->  0
+	{"dvix301", "0.9", "2", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix302  divideint  0.9      2.0    ->  0
+	{"dvix302", "0.9", "2.0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix303  divideint  0.9      2.1    ->  0
+	{"dvix303", "0.9", "2.1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix304  divideint  0.9      2.00   ->  0
+	{"dvix304", "0.9", "2.00", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix305  divideint  0.9      2.01   ->  0
+	{"dvix305", "0.9", "2.01", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix306  divideint  0.12     1      ->  0
+	{"dvix306", "0.12", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix307  divideint  0.12     1.0    ->  0
+	{"dvix307", "0.12", "1.0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix308  divideint  0.12     1.00   ->  0
+	{"dvix308", "0.12", "1.00", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix309  divideint  0.12     1.0    ->  0
+	{"dvix309", "0.12", "1.0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix310  divideint  0.12     1.00   ->  0
+	{"dvix310", "0.12", "1.00", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix311  divideint  0.12     2      ->  0
+	{"dvix311", "0.12", "2", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix312  divideint  0.12     2.0    ->  0
+	{"dvix312", "0.12", "2.0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix313  divideint  0.12     2.1    ->  0
+	{"dvix313", "0.12", "2.1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix314  divideint  0.12     2.00   ->  0
+	{"dvix314", "0.12", "2.00", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix315  divideint  0.12     2.01   ->  0
+	{"dvix315", "0.12", "2.01", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// overflow and underflow tests [from divide]
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// dvix330 divideint +1.23456789012345E-0 9E+999999999    -> 0
+	{"dvix330", "+1.23456789012345E-0", "9E+999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix331 divideint 9E+999999999 +0.23456789012345E-0 -> NaN Division_impossible
+	{"dvix331", "9E+999999999", "+0.23456789012345E-0", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix332 divideint +0.100 9E+999999999    -> 0
+	{"dvix332", "+0.100", "9E+999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix333 divideint 9E-999999999 +9.100    -> 0
+	{"dvix333", "9E-999999999", "+9.100", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix335 divideint -1.23456789012345E-0 9E+999999999    -> -0
+	{"dvix335", "-1.23456789012345E-0", "9E+999999999", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix336 divideint 9E+999999999 -0.83456789012345E-0 -> NaN Division_impossible
+	{"dvix336", "9E+999999999", "-0.83456789012345E-0", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix337 divideint -0.100 9E+999999999    -> -0
+	{"dvix337", "-0.100", "9E+999999999", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix338 divideint 9E-999999999 -9.100    -> -0
+	{"dvix338", "9E-999999999", "-9.100", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// long operand checks
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// dvix401 divideint 12345678000 100 -> 123456780
+	{"dvix401", "12345678000", "100", "123456780", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix402 divideint 1 12345678000   -> 0
+	{"dvix402", "1", "12345678000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix403 divideint 1234567800  10  -> 123456780
+	{"dvix403", "1234567800", "10", "123456780", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix404 divideint 1 1234567800    -> 0
+	{"dvix404", "1", "1234567800", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix405 divideint 1234567890  10  -> 123456789
+	{"dvix405", "1234567890", "10", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix406 divideint 1 1234567890    -> 0
+	{"dvix406", "1", "1234567890", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix407 divideint 1234567891  10  -> 123456789
+	{"dvix407", "1234567891", "10", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix408 divideint 1 1234567891    -> 0
+	{"dvix408", "1", "1234567891", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix409 divideint 12345678901 100 -> 123456789
+	{"dvix409", "12345678901", "100", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix410 divideint 1 12345678901   -> 0
+	{"dvix410", "1", "12345678901", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix411 divideint 1234567896  10  -> 123456789
+	{"dvix411", "1234567896", "10", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix412 divideint 1 1234567896    -> 0
+	{"dvix412", "1", "1234567896", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix413 divideint 12345678948 100 -> 123456789
+	{"dvix413", "12345678948", "100", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix414 divideint 12345678949 100 -> 123456789
+	{"dvix414", "12345678949", "100", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix415 divideint 12345678950 100 -> 123456789
+	{"dvix415", "12345678950", "100", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix416 divideint 12345678951 100 -> 123456789
+	{"dvix416", "12345678951", "100", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix417 divideint 12345678999 100 -> 123456789
+	{"dvix417", "12345678999", "100", "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// dvix441 divideint 12345678000 1 -> 12345678000
+	{"dvix441", "12345678000", "1", "12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix442 divideint 1 12345678000 -> 0
+	{"dvix442", "1", "12345678000", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix443 divideint 1234567800  1 -> 1234567800
+	{"dvix443", "1234567800", "1", "1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix444 divideint 1 1234567800  -> 0
+	{"dvix444", "1", "1234567800", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix445 divideint 1234567890  1 -> 1234567890
+	{"dvix445", "1234567890", "1", "1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix446 divideint 1 1234567890  -> 0
+	{"dvix446", "1", "1234567890", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix447 divideint 1234567891  1 -> 1234567891
+	{"dvix447", "1234567891", "1", "1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix448 divideint 1 1234567891  -> 0
+	{"dvix448", "1", "1234567891", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix449 divideint 12345678901 1 -> 12345678901
+	{"dvix449", "12345678901", "1", "12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix450 divideint 1 12345678901 -> 0
+	{"dvix450", "1", "12345678901", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix451 divideint 1234567896  1 -> 1234567896
+	{"dvix451", "1234567896", "1", "1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// dvix452 divideint 1 1234567896  -> 0
+	{"dvix452", "1", "1234567896", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// more zeros, etc.
+	// dvix531 divideint 5.00 1E-3    -> 5000
+	{"dvix531", "5.00", "1E-3", "5000", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix532 divideint 00.00 0.000  -> NaN Division_undefined
+	{"dvix532", "00.00", "0.000", "NaN", DivisionUndefined, 9, ToNearestAway, 999, -999, false},
+	// dvix533 divideint 00.00 0E-3   -> NaN Division_undefined
+	{"dvix533", "00.00", "0E-3", "NaN", DivisionUndefined, 9, ToNearestAway, 999, -999, false},
+	// dvix534 divideint  0    -0     -> NaN Division_undefined
+	{"dvix534", "0", "-0", "NaN", DivisionUndefined, 9, ToNearestAway, 999, -999, false},
+	// dvix535 divideint -0     0     -> NaN Division_undefined
+	{"dvix535", "-0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999, -999, false},
+	// dvix536 divideint -0    -0     -> NaN Division_undefined
+	{"dvix536", "-0", "-0", "NaN", DivisionUndefined, 9, ToNearestAway, 999, -999, false},
+	// dvix541 divideint  0    -1     -> -0
+	{"dvix541", "0", "-1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix542 divideint -0    -1     ->  0
+	{"dvix542", "-0", "-1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix543 divideint  0     1     ->  0
+	{"dvix543", "0", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix544 divideint -0     1     -> -0
+	{"dvix544", "-0", "1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix545 divideint -1     0     -> -Infinity Division_by_zero
+	{"dvix545", "-1", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix546 divideint -1    -0     ->  Infinity Division_by_zero
+	{"dvix546", "-1", "-0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix547 divideint  1     0     ->  Infinity Division_by_zero
+	{"dvix547", "1", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix548 divideint  1    -0     -> -Infinity Division_by_zero
+	{"dvix548", "1", "-0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix551 divideint  0.0  -1     -> -0
+	{"dvix551", "0.0", "-1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix552 divideint -0.0  -1     ->  0
+	{"dvix552", "-0.0", "-1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix553 divideint  0.0   1     ->  0
+	{"dvix553", "0.0", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix554 divideint -0.0   1     -> -0
+	{"dvix554", "-0.0", "1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix555 divideint -1.0   0     -> -Infinity Division_by_zero
+	{"dvix555", "-1.0", "0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix556 divideint -1.0  -0     ->  Infinity Division_by_zero
+	{"dvix556", "-1.0", "-0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix557 divideint  1.0   0     ->  Infinity Division_by_zero
+	{"dvix557", "1.0", "0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix558 divideint  1.0  -0     -> -Infinity Division_by_zero
+	{"dvix558", "1.0", "-0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix561 divideint  0    -1.0   -> -0
+	{"dvix561", "0", "-1.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix562 divideint -0    -1.0   ->  0
+	{"dvix562", "-0", "-1.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix563 divideint  0     1.0   ->  0
+	{"dvix563", "0", "1.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix564 divideint -0     1.0   -> -0
+	{"dvix564", "-0", "1.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix565 divideint -1     0.0   -> -Infinity Division_by_zero
+	{"dvix565", "-1", "0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix566 divideint -1    -0.0   ->  Infinity Division_by_zero
+	{"dvix566", "-1", "-0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix567 divideint  1     0.0   ->  Infinity Division_by_zero
+	{"dvix567", "1", "0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix568 divideint  1    -0.0   -> -Infinity Division_by_zero
+	{"dvix568", "1", "-0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix571 divideint  0.0  -1.0   -> -0
+	{"dvix571", "0.0", "-1.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix572 divideint -0.0  -1.0   ->  0
+	{"dvix572", "-0.0", "-1.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix573 divideint  0.0   1.0   ->  0
+	{"dvix573", "0.0", "1.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix574 divideint -0.0   1.0   -> -0
+	{"dvix574", "-0.0", "1.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix575 divideint -1.0   0.0   -> -Infinity Division_by_zero
+	{"dvix575", "-1.0", "0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix576 divideint -1.0  -0.0   ->  Infinity Division_by_zero
+	{"dvix576", "-1.0", "-0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix577 divideint  1.0   0.0   ->  Infinity Division_by_zero
+	{"dvix577", "1.0", "0.0", "Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// dvix578 divideint  1.0  -0.0   -> -Infinity Division_by_zero
+	{"dvix578", "1.0", "-0.0", "-Inf", DivisionByZero, 9, ToNearestAway, 999, -999, false},
+	// Specials
+	// dvix580 divideint  Inf  -Inf   ->  NaN Invalid_operation
+	{"dvix580", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix581 divideint  Inf  -1000  -> -Infinity
+	{"dvix581", "Inf", "-1000", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix582 divideint  Inf  -1     -> -Infinity
+	{"dvix582", "Inf", "-1", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix583 divideint  Inf  -0     -> -Infinity
+	{"dvix583", "Inf", "-0", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix584 divideint  Inf   0     ->  Infinity
+	{"dvix584", "Inf", "0", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix585 divideint  Inf   1     ->  Infinity
+	{"dvix585", "Inf", "1", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix586 divideint  Inf   1000  ->  Infinity
+	{"dvix586", "Inf", "1000", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix587 divideint  Inf   Inf   ->  NaN Invalid_operation
+	{"dvix587", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix588 divideint -1000  Inf   -> -0
+	{"dvix588", "-1000", "Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix589 divideint -Inf   Inf   ->  NaN Invalid_operation
+	{"dvix589", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix590 divideint -1     Inf   -> -0
+	{"dvix590", "-1", "Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix591 divideint -0     Inf   -> -0
+	{"dvix591", "-0", "Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix592 divideint  0     Inf   ->  0
+	{"dvix592", "0", "Inf", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix593 divideint  1     Inf   ->  0
+	{"dvix593", "1", "Inf", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix594 divideint  1000  Inf   ->  0
+	{"dvix594", "1000", "Inf", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix595 divideint  Inf   Inf   ->  NaN Invalid_operation
+	{"dvix595", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix600 divideint -Inf  -Inf   ->  NaN Invalid_operation
+	{"dvix600", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix601 divideint -Inf  -1000  ->  Infinity
+	{"dvix601", "-Inf", "-1000", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix602 divideint -Inf  -1     ->  Infinity
+	{"dvix602", "-Inf", "-1", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix603 divideint -Inf  -0     ->  Infinity
+	{"dvix603", "-Inf", "-0", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix604 divideint -Inf   0     -> -Infinity
+	{"dvix604", "-Inf", "0", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix605 divideint -Inf   1     -> -Infinity
+	{"dvix605", "-Inf", "1", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix606 divideint -Inf   1000  -> -Infinity
+	{"dvix606", "-Inf", "1000", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix607 divideint -Inf   Inf   ->  NaN Invalid_operation
+	{"dvix607", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix608 divideint -1000  Inf   -> -0
+	{"dvix608", "-1000", "Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix609 divideint -Inf  -Inf   ->  NaN Invalid_operation
+	{"dvix609", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix610 divideint -1    -Inf   ->  0
+	{"dvix610", "-1", "-Inf", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix611 divideint -0    -Inf   ->  0
+	{"dvix611", "-0", "-Inf", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix612 divideint  0    -Inf   -> -0
+	{"dvix612", "0", "-Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix613 divideint  1    -Inf   -> -0
+	{"dvix613", "1", "-Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix614 divideint  1000 -Inf   -> -0
+	{"dvix614", "1000", "-Inf", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix615 divideint  Inf  -Inf   ->  NaN Invalid_operation
+	{"dvix615", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix621 divideint  NaN -Inf    ->  NaN
+	{"dvix621", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix622 divideint  NaN -1000   ->  NaN
+	{"dvix622", "NaN", "-1000", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix623 divideint  NaN -1      ->  NaN
+	{"dvix623", "NaN", "-1", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix624 divideint  NaN -0      ->  NaN
+	{"dvix624", "NaN", "-0", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix625 divideint  NaN  0      ->  NaN
+	{"dvix625", "NaN", "0", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix626 divideint  NaN  1      ->  NaN
+	{"dvix626", "NaN", "1", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix627 divideint  NaN  1000   ->  NaN
+	{"dvix627", "NaN", "1000", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix628 divideint  NaN  Inf    ->  NaN
+	{"dvix628", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix629 divideint  NaN  NaN    ->  NaN
+	{"dvix629", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix630 divideint -Inf  NaN    ->  NaN
+	{"dvix630", "-Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix631 divideint -1000 NaN    ->  NaN
+	{"dvix631", "-1000", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix632 divideint -1    NaN    ->  NaN
+	{"dvix632", "-1", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix633 divideint -0    NaN    ->  NaN
+	{"dvix633", "-0", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix634 divideint  0    NaN    ->  NaN
+	{"dvix634", "0", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix635 divideint  1    NaN    ->  NaN
+	{"dvix635", "1", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix636 divideint  1000 NaN    ->  NaN
+	{"dvix636", "1000", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix637 divideint  Inf  NaN    ->  NaN
+	{"dvix637", "Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix641 divideint  sNaN -Inf   ->  NaN  Invalid_operation
+	{"dvix641", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix642 divideint  sNaN -1000  ->  NaN  Invalid_operation
+	{"dvix642", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix643 divideint  sNaN -1     ->  NaN  Invalid_operation
+	{"dvix643", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix644 divideint  sNaN -0     ->  NaN  Invalid_operation
+	{"dvix644", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix645 divideint  sNaN  0     ->  NaN  Invalid_operation
+	{"dvix645", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix646 divideint  sNaN  1     ->  NaN  Invalid_operation
+	{"dvix646", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix647 divideint  sNaN  1000  ->  NaN  Invalid_operation
+	{"dvix647", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix648 divideint  sNaN  NaN   ->  NaN  Invalid_operation
+	{"dvix648", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix649 divideint  sNaN sNaN   ->  NaN  Invalid_operation
+	{"dvix649", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix650 divideint  NaN  sNaN   ->  NaN  Invalid_operation
+	{"dvix650", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix651 divideint -Inf  sNaN   ->  NaN  Invalid_operation
+	{"dvix651", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix652 divideint -1000 sNaN   ->  NaN  Invalid_operation
+	{"dvix652", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix653 divideint -1    sNaN   ->  NaN  Invalid_operation
+	{"dvix653", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix654 divideint -0    sNaN   ->  NaN  Invalid_operation
+	{"dvix654", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix655 divideint  0    sNaN   ->  NaN  Invalid_operation
+	{"dvix655", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix656 divideint  1    sNaN   ->  NaN  Invalid_operation
+	{"dvix656", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix657 divideint  1000 sNaN   ->  NaN  Invalid_operation
+	{"dvix657", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix658 divideint  Inf  sNaN   ->  NaN  Invalid_operation
+	{"dvix658", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix659 divideint  NaN  sNaN   ->  NaN  Invalid_operation
+	{"dvix659", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// dvix661 divideint  NaN9 -Inf   ->  NaN9
+	{"dvix661", "NaN9", "-Inf", "NaN9", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix662 divideint  NaN8  1000  ->  NaN8
+	{"dvix662", "NaN8", "1000", "NaN8", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix663 divideint  NaN7  Inf   ->  NaN7
+	{"dvix663", "NaN7", "Inf", "NaN7", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix664 divideint -NaN6  NaN5  -> -NaN6
+	{"dvix664", "-NaN6", "NaN5", "-NaN6", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix665 divideint -Inf   NaN4  ->  NaN4
+	{"dvix665", "-Inf", "NaN4", "NaN4", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix666 divideint -1000  NaN3  ->  NaN3
+	{"dvix666", "-1000", "NaN3", "NaN3", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix667 divideint  Inf  -NaN2  -> -NaN2
+	{"dvix667", "Inf", "-NaN2", "-NaN2", 0, 9, ToNearestAway, 999, -999, false},
+	// dvix671 divideint -sNaN99 -Inf    -> -NaN99 Invalid_operation
+	{"dvix671", "-sNaN99", "-Inf", "-NaN99", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix672 divideint  sNaN98 -1      ->  NaN98 Invalid_operation
+	{"dvix672", "sNaN98", "-1", "NaN98", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix673 divideint  sNaN97  NaN    ->  NaN97 Invalid_operation
+	{"dvix673", "sNaN97", "NaN", "NaN97", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix674 divideint  sNaN96 sNaN94  ->  NaN96 Invalid_operation
+	{"dvix674", "sNaN96", "sNaN94", "NaN96", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix675 divideint  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"dvix675", "NaN95", "sNaN93", "NaN93", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix676 divideint -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"dvix676", "-Inf", "sNaN92", "NaN92", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix677 divideint  0      sNaN91  ->  NaN91 Invalid_operation
+	{"dvix677", "0", "sNaN91", "NaN91", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix678 divideint  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	{"dvix678", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// dvix679 divideint  NaN    sNaN89  ->  NaN89 Invalid_operation
+	{"dvix679", "NaN", "sNaN89", "NaN89", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// some long operand cases again
+	// precision: 8
+	// dvix710 divideint  100000001     1  ->  NaN Division_impossible
+	{"dvix710", "100000001", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// dvix711 divideint  100000000.4   1  ->  NaN Division_impossible
+	{"dvix711", "100000000.4", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// dvix712 divideint  100000000.5   1  ->  NaN Division_impossible
+	{"dvix712", "100000000.5", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// dvix713 divideint  100000000.9   1  ->  NaN Division_impossible
+	{"dvix713", "100000000.9", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// dvix714 divideint  100000000.999 1  ->  NaN Division_impossible
+	{"dvix714", "100000000.999", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// precision: 6
+	// dvix720 divideint  100000000     1  ->  NaN Division_impossible
+	{"dvix720", "100000000", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 999, -999, false},
+	// dvix721 divideint  10000000      1  ->  NaN Division_impossible
+	{"dvix721", "10000000", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 999, -999, false},
+	// dvix722 divideint  1000000       1  ->  NaN Division_impossible
+	{"dvix722", "1000000", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 999, -999, false},
+	// dvix723 divideint  100000        1  ->  100000
+	{"dvix723", "100000", "1", "100000", 0, 6, ToNearestAway, 999, -999, false},
+	// dvix724 divideint  10000         1  ->  10000
+	{"dvix724", "10000", "1", "10000", 0, 6, ToNearestAway, 999, -999, false},
+	// dvix725 divideint  1000          1  ->  1000
+	{"dvix725", "1000", "1", "1000", 0, 6, ToNearestAway, 999, -999, false},
+	// dvix726 divideint  100           1  ->  100
+	{"dvix726", "100", "1", "100", 0, 6, ToNearestAway, 999, -999, false},
+	// dvix727 divideint  10            1  ->  10
+	{"dvix727", "10", "1", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// dvix728 divideint  1             1  ->  1
+	{"dvix728", "1", "1", "1", 0, 6, ToNearestAway, 999, -999, false},
+	// dvix729 divideint  1            10  ->  0
+	{"dvix729", "1", "10", "0", 0, 6, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// dvix732 divideint 1 0.99e999999999 -> 0
+	{"dvix732", "1", "0.99e999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix733 divideint 1 0.999999999e999999999 -> 0
+	{"dvix733", "1", "0.999999999e999999999", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix734 divideint 9e999999999    1       -> NaN Division_impossible
+	{"dvix734", "9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix735 divideint 9.9e999999999  1       -> NaN Division_impossible
+	{"dvix735", "9.9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix736 divideint 9.99e999999999 1       -> NaN Division_impossible
+	{"dvix736", "9.99e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix737 divideint 9.99999999e999999999 1 -> NaN Division_impossible
+	{"dvix737", "9.99999999e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix740 divideint 0.1 9e-999999999       -> NaN Division_impossible
+	{"dvix740", "0.1", "9e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix741 divideint 0.1 99e-999999999      -> NaN Division_impossible
+	{"dvix741", "0.1", "99e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix742 divideint 0.1 999e-999999999     -> NaN Division_impossible
+	{"dvix742", "0.1", "999e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix743 divideint 0.1 9e-999999998       -> NaN Division_impossible
+	{"dvix743", "0.1", "9e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix744 divideint 0.1 99e-999999998      -> NaN Division_impossible
+	{"dvix744", "0.1", "99e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix745 divideint 0.1 999e-999999998     -> NaN Division_impossible
+	{"dvix745", "0.1", "999e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix746 divideint 0.1 999e-999999997     -> NaN Division_impossible
+	{"dvix746", "0.1", "999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix747 divideint 0.1 9999e-999999997    -> NaN Division_impossible
+	{"dvix747", "0.1", "9999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// dvix748 divideint 0.1 99999e-999999997   -> NaN Division_impossible
+	{"dvix748", "0.1", "99999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// Null tests
+	// SKIP (encoding not supported): dvix900 divideint  10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): dvix901 divideint   # 10 -> NaN Invalid_operation
+}
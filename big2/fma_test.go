@@ -0,0 +1,5752 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var fmaTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	in3   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// These tests comprise three parts:
+	//   1. Sanity checks and other three-operand tests (especially those
+	//      where the fused operation makes a difference)
+	//   2. Multiply tests (third operand is neutral zero [0E+emax])
+	//   3. Addition tests (first operand is 1)
+	// The multiply and addition tests are extensive because FMA may have
+	// its own dedicated multiplication or addition routine(s), and they
+	// also inherently check the left-to-right properties.
+	// Sanity checks
+	// fmax0001 fma  1   1   1 ->   2
+	{"fmax0001", "1", "1", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0002 fma  1   1   2 ->   3
+	{"fmax0002", "1", "1", "2", "3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0003 fma  2   2   3 ->   7
+	{"fmax0003", "2", "2", "3", "7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0004 fma  9   9   9 ->  90
+	{"fmax0004", "9", "9", "9", "90", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0005 fma -1   1   1 ->   0
+	{"fmax0005", "-1", "1", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0006 fma -1   1   2 ->   1
+	{"fmax0006", "-1", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0007 fma -2   2   3 ->  -1
+	{"fmax0007", "-2", "2", "3", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0008 fma -9   9   9 -> -72
+	{"fmax0008", "-9", "9", "9", "-72", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0011 fma  1  -1   1 ->   0
+	{"fmax0011", "1", "-1", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0012 fma  1  -1   2 ->   1
+	{"fmax0012", "1", "-1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0013 fma  2  -2   3 ->  -1
+	{"fmax0013", "2", "-2", "3", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0014 fma  9  -9   9 -> -72
+	{"fmax0014", "9", "-9", "9", "-72", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0015 fma  1   1  -1 ->   0
+	{"fmax0015", "1", "1", "-1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0016 fma  1   1  -2 ->  -1
+	{"fmax0016", "1", "1", "-2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0017 fma  2   2  -3 ->   1
+	{"fmax0017", "2", "2", "-3", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0018 fma  9   9  -9 ->  72
+	{"fmax0018", "9", "9", "-9", "72", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0019 fma  3   5   7 ->  22
+	{"fmax0019", "3", "5", "7", "22", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0029 fma  3  -5   7 ->  -8
+	{"fmax0029", "3", "-5", "7", "-8", 0, 9, ToNearestAway, 384, -383, false},
+	// non-integer exacts
+	// fma0100  fma    25.2   63.6   -438  ->  1164.72
+	{"fma0100", "25.2", "63.6", "-438", "1164.72", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0101  fma   0.301  0.380    334  ->  334.114380
+	{"fma0101", "0.301", "0.380", "334", "334.114380", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0102  fma    49.2   -4.8   23.3  ->  -212.86
+	{"fma0102", "49.2", "-4.8", "23.3", "-212.86", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0103  fma    4.22  0.079  -94.6  ->  -94.26662
+	{"fma0103", "4.22", "0.079", "-94.6", "-94.26662", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0104  fma     903  0.797  0.887  ->  720.578
+	{"fma0104", "903", "0.797", "0.887", "720.578", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0105  fma    6.13   -161   65.9  ->  -921.03
+	{"fma0105", "6.13", "-161", "65.9", "-921.03", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0106  fma    28.2    727   5.45  ->  20506.85
+	{"fma0106", "28.2", "727", "5.45", "20506.85", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0107  fma       4    605    688  ->  3108
+	{"fma0107", "4", "605", "688", "3108", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0108  fma    93.3   0.19  0.226  ->  17.953
+	{"fma0108", "93.3", "0.19", "0.226", "17.953", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0109  fma   0.169   -341   5.61  ->  -52.019
+	{"fma0109", "0.169", "-341", "5.61", "-52.019", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0110  fma   -72.2     30  -51.2  ->  -2217.2
+	{"fma0110", "-72.2", "30", "-51.2", "-2217.2", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0111  fma  -0.409     13   20.4  ->  15.083
+	{"fma0111", "-0.409", "13", "20.4", "15.083", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0112  fma     317   77.0   19.0  ->  24428.0
+	{"fma0112", "317", "77.0", "19.0", "24428.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0113  fma      47   6.58   1.62  ->  310.88
+	{"fma0113", "47", "6.58", "1.62", "310.88", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0114  fma    1.36  0.984  0.493  ->  1.83124
+	{"fma0114", "1.36", "0.984", "0.493", "1.83124", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0115  fma    72.7    274   1.56  ->  19921.36
+	{"fma0115", "72.7", "274", "1.56", "19921.36", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0116  fma     335    847     83  ->  283828
+	{"fma0116", "335", "847", "83", "283828", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0117  fma     666  0.247   25.4  ->  189.902
+	{"fma0117", "666", "0.247", "25.4", "189.902", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0118  fma   -3.87   3.06   78.0  ->  66.1578
+	{"fma0118", "-3.87", "3.06", "78.0", "66.1578", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0119  fma   0.742    192   35.6  ->  178.064
+	{"fma0119", "0.742", "192", "35.6", "178.064", 0, 9, ToNearestAway, 384, -383, false},
+	// fma0120  fma   -91.6   5.29  0.153  ->  -484.411
+	{"fma0120", "-91.6", "5.29", "0.153", "-484.411", 0, 9, ToNearestAway, 384, -383, false},
+	// cases where result is different from separate multiply + add; each
+	// is preceded by the result of unfused multiply and add
+	// [this is about 20% of all similar  cases in general]
+	//               888565290   1557.96930  -86087.7578  -> 1.38435735E+12
+	// fma0201  fma     888565290   1557.96930  -86087.7578  -> 1.38435736E+12  Inexact Rounded
+	{"fma0201", "888565290", "1557.96930", "-86087.7578", "1.38435736E+12", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//             -85519342.9    735155419     42010431  -> -6.28700084E+16
+	// fma0205  fma   -85519342.9    735155419     42010431  -> -6.28700083E+16 Inexact Rounded
+	{"fma0205", "-85519342.9", "735155419", "42010431", "-6.28700083E+16", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//                -98025.5  -294603.472   10414348.2  -> 2.88890669E+10
+	// fma0208  fma      -98025.5  -294603.472   10414348.2  -> 2.88890670E+10  Inexact Rounded
+	{"fma0208", "-98025.5", "-294603.472", "10414348.2", "2.88890670E+10", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//              5967627.39   83526540.6   498494.810  -> 4.98455271E+14
+	// fma0211  fma    5967627.39   83526540.6   498494.810  -> 4.98455272E+14  Inexact Rounded
+	{"fma0211", "5967627.39", "83526540.6", "498494.810", "4.98455272E+14", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//               3456.9433    874.39518   197866.615  ->  3220601.18
+	// fma0216  fma     3456.9433    874.39518   197866.615  ->  3220601.17     Inexact Rounded
+	{"fma0216", "3456.9433", "874.39518", "197866.615", "3220601.17", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//              62769.8287   2096.98927    48.420317  ->  131627705
+	// fma0218  fma    62769.8287   2096.98927    48.420317  ->  131627706      Inexact Rounded
+	{"fma0218", "62769.8287", "2096.98927", "48.420317", "131627706", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//               -68.81500   59961113.9     -8988862  -> -4.13521291E+9
+	// fma0219  fma     -68.81500   59961113.9     -8988862  -> -4.13521292E+9  Inexact Rounded
+	{"fma0219", "-68.81500", "59961113.9", "-8988862", "-4.13521292E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//              2126341.02   63491.5152    302427455  -> 1.35307040E+11
+	// fma0226  fma    2126341.02   63491.5152    302427455  -> 1.35307041E+11  Inexact Rounded
+	{"fma0226", "2126341.02", "63491.5152", "302427455", "1.35307041E+11", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// Infinite combinations
+	// fmax0800 fma  Inf   Inf   Inf    ->  Infinity
+	{"fmax0800", "Inf", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0801 fma  Inf   Inf  -Inf    ->  NaN Invalid_operation
+	{"fmax0801", "Inf", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0802 fma  Inf  -Inf   Inf    ->  NaN Invalid_operation
+	{"fmax0802", "Inf", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0803 fma  Inf  -Inf  -Inf    -> -Infinity
+	{"fmax0803", "Inf", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0804 fma -Inf   Inf   Inf    ->  NaN Invalid_operation
+	{"fmax0804", "-Inf", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0805 fma -Inf   Inf  -Inf    -> -Infinity
+	{"fmax0805", "-Inf", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0806 fma -Inf  -Inf   Inf    ->  Infinity
+	{"fmax0806", "-Inf", "-Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0807 fma -Inf  -Inf  -Inf    ->  NaN Invalid_operation
+	{"fmax0807", "-Inf", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0808 fma -Inf   0       1    ->  NaN Invalid_operation
+	{"fmax0808", "-Inf", "0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0809 fma -Inf   0     NaN    ->  NaN Invalid_operation
+	{"fmax0809", "-Inf", "0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// Triple NaN propagation
+	// fmax0900 fma  NaN2  NaN3  NaN5   ->  NaN2
+	{"fmax0900", "NaN2", "NaN3", "NaN5", "NaN2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0901 fma  0     NaN3  NaN5   ->  NaN3
+	{"fmax0901", "0", "NaN3", "NaN5", "NaN3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax0902 fma  0     0     NaN5   ->  NaN5
+	{"fmax0902", "0", "0", "NaN5", "NaN5", 0, 9, ToNearestAway, 384, -383, false},
+	// first sNaN wins (consider qNaN from earlier sNaN being
+	// overridden by an sNaN in third operand)
+	// fmax0903 fma  sNaN1 sNaN2 sNaN3  ->  NaN1 Invalid_operation
+	{"fmax0903", "sNaN1", "sNaN2", "sNaN3", "NaN1", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0904 fma  0     sNaN2 sNaN3  ->  NaN2 Invalid_operation
+	{"fmax0904", "0", "sNaN2", "sNaN3", "NaN2", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0905 fma  0     0     sNaN3  ->  NaN3 Invalid_operation
+	{"fmax0905", "0", "0", "sNaN3", "NaN3", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0906 fma  sNaN1 sNaN2 sNaN3  ->  NaN1 Invalid_operation
+	{"fmax0906", "sNaN1", "sNaN2", "sNaN3", "NaN1", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0907 fma  NaN7  sNaN2 sNaN3  ->  NaN2 Invalid_operation
+	{"fmax0907", "NaN7", "sNaN2", "sNaN3", "NaN2", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax0908 fma  NaN7  NaN5  sNaN3  ->  NaN3 Invalid_operation
+	{"fmax0908", "NaN7", "NaN5", "sNaN3", "NaN3", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// MULTIPLICATION TESTS ------------------------------------------------
+	// sanity checks (as base, above)
+	// fmax2000 fma 2      2  0E+999999  -> 4
+	{"fmax2000", "2", "2", "0E+999999", "4", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2001 fma 2      3  0E+999999  -> 6
+	{"fmax2001", "2", "3", "0E+999999", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2002 fma 5      1  0E+999999  -> 5
+	{"fmax2002", "5", "1", "0E+999999", "5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2003 fma 5      2  0E+999999  -> 10
+	{"fmax2003", "5", "2", "0E+999999", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2004 fma 1.20   2  0E+999999  -> 2.40
+	{"fmax2004", "1.20", "2", "0E+999999", "2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2005 fma 1.20   0  0E+999999  -> 0.00
+	{"fmax2005", "1.20", "0", "0E+999999", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2006 fma 1.20  -2  0E+999999  -> -2.40
+	{"fmax2006", "1.20", "-2", "0E+999999", "-2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2007 fma -1.20  2  0E+999999  -> -2.40
+	{"fmax2007", "-1.20", "2", "0E+999999", "-2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2008 fma -1.20  0  0E+999999  -> 0.00
+	{"fmax2008", "-1.20", "0", "0E+999999", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2009 fma -1.20 -2  0E+999999  -> 2.40
+	{"fmax2009", "-1.20", "-2", "0E+999999", "2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2010 fma 5.09 7.1  0E+999999  -> 36.139
+	{"fmax2010", "5.09", "7.1", "0E+999999", "36.139", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2011 fma 2.5    4  0E+999999  -> 10.0
+	{"fmax2011", "2.5", "4", "0E+999999", "10.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2012 fma 2.50   4  0E+999999  -> 10.00
+	{"fmax2012", "2.50", "4", "0E+999999", "10.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2013 fma 1.23456789 1.00000000  0E+999999  -> 1.23456789 Rounded
+	{"fmax2013", "1.23456789", "1.00000000", "0E+999999", "1.23456789", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2014 fma 9.999999999 9.999999999  0E+999999  -> 100.000000 Inexact Rounded
+	{"fmax2014", "9.999999999", "9.999999999", "0E+999999", "100.000000", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2015 fma 2.50   4  0E+999999  -> 10.00
+	{"fmax2015", "2.50", "4", "0E+999999", "10.00", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// fmax2016 fma 2.50   4  0E+999999  -> 10.00
+	{"fmax2016", "2.50", "4", "0E+999999", "10.00", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax2017 fma  9.999999  9.999999  0E+999999  ->  100.000 Inexact Rounded
+	{"fmax2017", "9.999999", "9.999999", "0E+999999", "100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// fmax2018 fma  9.999999 -9.999999  0E+999999  -> -100.000 Inexact Rounded
+	{"fmax2018", "9.999999", "-9.999999", "0E+999999", "-100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// fmax2019 fma -9.999999  9.999999  0E+999999  -> -100.000 Inexact Rounded
+	{"fmax2019", "-9.999999", "9.999999", "0E+999999", "-100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// fmax2020 fma -9.999999 -9.999999  0E+999999  ->  100.000 Inexact Rounded
+	{"fmax2020", "-9.999999", "-9.999999", "0E+999999", "100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// 1999.12.21: next one is an edge case if intermediate longs are used
+	// precision: 15
+	// fmax2059 fma 999999999999 9765625  0E+999999  -> 9.76562499999023E+18 Inexact Rounded
+	{"fmax2059", "999999999999", "9765625", "0E+999999", "9.76562499999023E+18", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// precision: 30
+	// fmax2160 fma 999999999999 9765625  0E+999999  -> 9765624999990234375
+	{"fmax2160", "999999999999", "9765625", "0E+999999", "9765624999990234375", 0, 30, ToNearestAway, 384, -383, false},
+	// precision: 9
+	//---
+	// zeros, etc.
+	// fmax2021 fma  0      0      0E+999999  ->  0
+	{"fmax2021", "0", "0", "0E+999999", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2022 fma  0     -0      0E+999999  ->  0
+	{"fmax2022", "0", "-0", "0E+999999", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2023 fma -0      0      0E+999999  ->  0
+	{"fmax2023", "-0", "0", "0E+999999", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2024 fma -0     -0      0E+999999  ->  0
+	{"fmax2024", "-0", "-0", "0E+999999", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2025 fma -0.0   -0.0    0E+999999  ->  0.00
+	{"fmax2025", "-0.0", "-0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2026 fma -0.0   -0.0    0E+999999  ->  0.00
+	{"fmax2026", "-0.0", "-0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2027 fma -0.0   -0.0    0E+999999  ->  0.00
+	{"fmax2027", "-0.0", "-0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2028 fma -0.0   -0.0    0E+999999  ->  0.00
+	{"fmax2028", "-0.0", "-0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2030 fma  5.00   1E-3   0E+999999  ->  0.00500
+	{"fmax2030", "5.00", "1E-3", "0E+999999", "0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2031 fma  00.00  0.000  0E+999999  ->  0.00000
+	{"fmax2031", "00.00", "0.000", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2032 fma  00.00  0E-3   0E+999999  ->  0.00000     -- rhs is 0
+	{"fmax2032", "00.00", "0E-3", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2033 fma  0E-3   00.00  0E+999999  ->  0.00000     -- lhs is 0
+	{"fmax2033", "0E-3", "00.00", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2034 fma -5.00   1E-3   0E+999999  -> -0.00500
+	{"fmax2034", "-5.00", "1E-3", "0E+999999", "-0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2035 fma -00.00  0.000  0E+999999  ->  0.00000
+	{"fmax2035", "-00.00", "0.000", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2036 fma -00.00  0E-3   0E+999999  ->  0.00000     -- rhs is 0
+	{"fmax2036", "-00.00", "0E-3", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2037 fma -0E-3   00.00  0E+999999  ->  0.00000     -- lhs is 0
+	{"fmax2037", "-0E-3", "00.00", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2038 fma  5.00  -1E-3   0E+999999  -> -0.00500
+	{"fmax2038", "5.00", "-1E-3", "0E+999999", "-0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2039 fma  00.00 -0.000  0E+999999  ->  0.00000
+	{"fmax2039", "00.00", "-0.000", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2040 fma  00.00 -0E-3   0E+999999  ->  0.00000     -- rhs is 0
+	{"fmax2040", "00.00", "-0E-3", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2041 fma  0E-3  -00.00  0E+999999  ->  0.00000     -- lhs is 0
+	{"fmax2041", "0E-3", "-00.00", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2042 fma -5.00  -1E-3   0E+999999  ->  0.00500
+	{"fmax2042", "-5.00", "-1E-3", "0E+999999", "0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2043 fma -00.00 -0.000  0E+999999  ->  0.00000
+	{"fmax2043", "-00.00", "-0.000", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2044 fma -00.00 -0E-3   0E+999999  ->  0.00000     -- rhs is 0
+	{"fmax2044", "-00.00", "-0E-3", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2045 fma -0E-3  -00.00  0E+999999  ->  0.00000     -- lhs is 0
+	{"fmax2045", "-0E-3", "-00.00", "0E+999999", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// examples from decarith multiply
+	// fmax2050 fma 1.20 3         0E+999999  -> 3.60
+	{"fmax2050", "1.20", "3", "0E+999999", "3.60", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2051 fma 7    3         0E+999999  -> 21
+	{"fmax2051", "7", "3", "0E+999999", "21", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2052 fma 0.9  0.8       0E+999999  -> 0.72
+	{"fmax2052", "0.9", "0.8", "0E+999999", "0.72", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2053 fma 0.9  -0        0E+999999  -> 0.0
+	{"fmax2053", "0.9", "-0", "0E+999999", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2054 fma 654321 654321  0E+999999  -> 4.28135971E+11  Inexact Rounded
+	{"fmax2054", "654321", "654321", "0E+999999", "4.28135971E+11", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2060 fma 123.45 1e7   0E+999999  ->  1.2345E+9
+	{"fmax2060", "123.45", "1e7", "0E+999999", "1.2345E+9", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2061 fma 123.45 1e8   0E+999999  ->  1.2345E+10
+	{"fmax2061", "123.45", "1e8", "0E+999999", "1.2345E+10", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2062 fma 123.45 1e+9  0E+999999  ->  1.2345E+11
+	{"fmax2062", "123.45", "1e+9", "0E+999999", "1.2345E+11", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2063 fma 123.45 1e10  0E+999999  ->  1.2345E+12
+	{"fmax2063", "123.45", "1e10", "0E+999999", "1.2345E+12", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2064 fma 123.45 1e11  0E+999999  ->  1.2345E+13
+	{"fmax2064", "123.45", "1e11", "0E+999999", "1.2345E+13", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2065 fma 123.45 1e12  0E+999999  ->  1.2345E+14
+	{"fmax2065", "123.45", "1e12", "0E+999999", "1.2345E+14", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2066 fma 123.45 1e13  0E+999999  ->  1.2345E+15
+	{"fmax2066", "123.45", "1e13", "0E+999999", "1.2345E+15", 0, 9, ToNearestAway, 384, -383, false},
+	// test some intermediate lengths
+	// precision: 9
+	// fmax2080 fma 0.1 123456789           0E+999999  -> 12345678.9
+	{"fmax2080", "0.1", "123456789", "0E+999999", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2081 fma 0.1 1234567891          0E+999999  -> 123456789 Inexact Rounded
+	{"fmax2081", "0.1", "1234567891", "0E+999999", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2082 fma 0.1 12345678912         0E+999999  -> 1.23456789E+9 Inexact Rounded
+	{"fmax2082", "0.1", "12345678912", "0E+999999", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2083 fma 0.1 12345678912345      0E+999999  -> 1.23456789E+12 Inexact Rounded
+	{"fmax2083", "0.1", "12345678912345", "0E+999999", "1.23456789E+12", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2084 fma 0.1 123456789           0E+999999  -> 12345678.9
+	{"fmax2084", "0.1", "123456789", "0E+999999", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 8
+	// fmax2085 fma 0.1 12345678912         0E+999999  -> 1.2345679E+9 Inexact Rounded
+	{"fmax2085", "0.1", "12345678912", "0E+999999", "1.2345679E+9", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// fmax2086 fma 0.1 12345678912345      0E+999999  -> 1.2345679E+12 Inexact Rounded
+	{"fmax2086", "0.1", "12345678912345", "0E+999999", "1.2345679E+12", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// precision: 7
+	// fmax2087 fma 0.1 12345678912         0E+999999  -> 1.234568E+9 Inexact Rounded
+	{"fmax2087", "0.1", "12345678912", "0E+999999", "1.234568E+9", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// fmax2088 fma 0.1 12345678912345      0E+999999  -> 1.234568E+12 Inexact Rounded
+	{"fmax2088", "0.1", "12345678912345", "0E+999999", "1.234568E+12", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// fmax2090 fma 123456789          0.1  0E+999999  -> 12345678.9
+	{"fmax2090", "123456789", "0.1", "0E+999999", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax2091 fma 1234567891         0.1  0E+999999  -> 123456789 Inexact Rounded
+	{"fmax2091", "1234567891", "0.1", "0E+999999", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2092 fma 12345678912        0.1  0E+999999  -> 1.23456789E+9 Inexact Rounded
+	{"fmax2092", "12345678912", "0.1", "0E+999999", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2093 fma 12345678912345     0.1  0E+999999  -> 1.23456789E+12 Inexact Rounded
+	{"fmax2093", "12345678912345", "0.1", "0E+999999", "1.23456789E+12", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax2094 fma 123456789          0.1  0E+999999  -> 12345678.9
+	{"fmax2094", "123456789", "0.1", "0E+999999", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 8
+	// fmax2095 fma 12345678912        0.1  0E+999999  -> 1.2345679E+9 Inexact Rounded
+	{"fmax2095", "12345678912", "0.1", "0E+999999", "1.2345679E+9", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// fmax2096 fma 12345678912345     0.1  0E+999999  -> 1.2345679E+12 Inexact Rounded
+	{"fmax2096", "12345678912345", "0.1", "0E+999999", "1.2345679E+12", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// precision: 7
+	// fmax2097 fma 12345678912        0.1  0E+999999  -> 1.234568E+9 Inexact Rounded
+	{"fmax2097", "12345678912", "0.1", "0E+999999", "1.234568E+9", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// fmax2098 fma 12345678912345     0.1  0E+999999  -> 1.234568E+12 Inexact Rounded
+	{"fmax2098", "12345678912345", "0.1", "0E+999999", "1.234568E+12", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// test some more edge cases and carries
+	// maxexponent: 9999
+	// minexponent: -9999
+	// precision: 33
+	// fmax2101 fma 9 9    0E+999999  -> 81
+	{"fmax2101", "9", "9", "0E+999999", "81", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2102 fma 9 90    0E+999999  -> 810
+	{"fmax2102", "9", "90", "0E+999999", "810", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2103 fma 9 900    0E+999999  -> 8100
+	{"fmax2103", "9", "900", "0E+999999", "8100", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2104 fma 9 9000    0E+999999  -> 81000
+	{"fmax2104", "9", "9000", "0E+999999", "81000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2105 fma 9 90000    0E+999999  -> 810000
+	{"fmax2105", "9", "90000", "0E+999999", "810000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2106 fma 9 900000    0E+999999  -> 8100000
+	{"fmax2106", "9", "900000", "0E+999999", "8100000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2107 fma 9 9000000    0E+999999  -> 81000000
+	{"fmax2107", "9", "9000000", "0E+999999", "81000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2108 fma 9 90000000    0E+999999  -> 810000000
+	{"fmax2108", "9", "90000000", "0E+999999", "810000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2109 fma 9 900000000    0E+999999  -> 8100000000
+	{"fmax2109", "9", "900000000", "0E+999999", "8100000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2110 fma 9 9000000000    0E+999999  -> 81000000000
+	{"fmax2110", "9", "9000000000", "0E+999999", "81000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2111 fma 9 90000000000    0E+999999  -> 810000000000
+	{"fmax2111", "9", "90000000000", "0E+999999", "810000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2112 fma 9 900000000000    0E+999999  -> 8100000000000
+	{"fmax2112", "9", "900000000000", "0E+999999", "8100000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2113 fma 9 9000000000000    0E+999999  -> 81000000000000
+	{"fmax2113", "9", "9000000000000", "0E+999999", "81000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2114 fma 9 90000000000000    0E+999999  -> 810000000000000
+	{"fmax2114", "9", "90000000000000", "0E+999999", "810000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2115 fma 9 900000000000000    0E+999999  -> 8100000000000000
+	{"fmax2115", "9", "900000000000000", "0E+999999", "8100000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2116 fma 9 9000000000000000    0E+999999  -> 81000000000000000
+	{"fmax2116", "9", "9000000000000000", "0E+999999", "81000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2117 fma 9 90000000000000000    0E+999999  -> 810000000000000000
+	{"fmax2117", "9", "90000000000000000", "0E+999999", "810000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2118 fma 9 900000000000000000    0E+999999  -> 8100000000000000000
+	{"fmax2118", "9", "900000000000000000", "0E+999999", "8100000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2119 fma 9 9000000000000000000    0E+999999  -> 81000000000000000000
+	{"fmax2119", "9", "9000000000000000000", "0E+999999", "81000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2120 fma 9 90000000000000000000    0E+999999  -> 810000000000000000000
+	{"fmax2120", "9", "90000000000000000000", "0E+999999", "810000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2121 fma 9 900000000000000000000    0E+999999  -> 8100000000000000000000
+	{"fmax2121", "9", "900000000000000000000", "0E+999999", "8100000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2122 fma 9 9000000000000000000000    0E+999999  -> 81000000000000000000000
+	{"fmax2122", "9", "9000000000000000000000", "0E+999999", "81000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2123 fma 9 90000000000000000000000    0E+999999  -> 810000000000000000000000
+	{"fmax2123", "9", "90000000000000000000000", "0E+999999", "810000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// test some more edge cases without carries
+	// fmax2131 fma 3 3    0E+999999  -> 9
+	{"fmax2131", "3", "3", "0E+999999", "9", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2132 fma 3 30    0E+999999  -> 90
+	{"fmax2132", "3", "30", "0E+999999", "90", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2133 fma 3 300    0E+999999  -> 900
+	{"fmax2133", "3", "300", "0E+999999", "900", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2134 fma 3 3000    0E+999999  -> 9000
+	{"fmax2134", "3", "3000", "0E+999999", "9000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2135 fma 3 30000    0E+999999  -> 90000
+	{"fmax2135", "3", "30000", "0E+999999", "90000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2136 fma 3 300000    0E+999999  -> 900000
+	{"fmax2136", "3", "300000", "0E+999999", "900000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2137 fma 3 3000000    0E+999999  -> 9000000
+	{"fmax2137", "3", "3000000", "0E+999999", "9000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2138 fma 3 30000000    0E+999999  -> 90000000
+	{"fmax2138", "3", "30000000", "0E+999999", "90000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2139 fma 3 300000000    0E+999999  -> 900000000
+	{"fmax2139", "3", "300000000", "0E+999999", "900000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2140 fma 3 3000000000    0E+999999  -> 9000000000
+	{"fmax2140", "3", "3000000000", "0E+999999", "9000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2141 fma 3 30000000000    0E+999999  -> 90000000000
+	{"fmax2141", "3", "30000000000", "0E+999999", "90000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2142 fma 3 300000000000    0E+999999  -> 900000000000
+	{"fmax2142", "3", "300000000000", "0E+999999", "900000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2143 fma 3 3000000000000    0E+999999  -> 9000000000000
+	{"fmax2143", "3", "3000000000000", "0E+999999", "9000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2144 fma 3 30000000000000    0E+999999  -> 90000000000000
+	{"fmax2144", "3", "30000000000000", "0E+999999", "90000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2145 fma 3 300000000000000    0E+999999  -> 900000000000000
+	{"fmax2145", "3", "300000000000000", "0E+999999", "900000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2146 fma 3 3000000000000000    0E+999999  -> 9000000000000000
+	{"fmax2146", "3", "3000000000000000", "0E+999999", "9000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2147 fma 3 30000000000000000    0E+999999  -> 90000000000000000
+	{"fmax2147", "3", "30000000000000000", "0E+999999", "90000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2148 fma 3 300000000000000000    0E+999999  -> 900000000000000000
+	{"fmax2148", "3", "300000000000000000", "0E+999999", "900000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2149 fma 3 3000000000000000000    0E+999999  -> 9000000000000000000
+	{"fmax2149", "3", "3000000000000000000", "0E+999999", "9000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2150 fma 3 30000000000000000000    0E+999999  -> 90000000000000000000
+	{"fmax2150", "3", "30000000000000000000", "0E+999999", "90000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2151 fma 3 300000000000000000000    0E+999999  -> 900000000000000000000
+	{"fmax2151", "3", "300000000000000000000", "0E+999999", "900000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2152 fma 3 3000000000000000000000    0E+999999  -> 9000000000000000000000
+	{"fmax2152", "3", "3000000000000000000000", "0E+999999", "9000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// fmax2153 fma 3 30000000000000000000000    0E+999999  -> 90000000000000000000000
+	{"fmax2153", "3", "30000000000000000000000", "0E+999999", "90000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// maxexponent: 999999
+	// minexponent: -999999
+	// precision: 9
+	// test some cases that are close to exponent overflow/underflow
+	// fmax2170 fma 1 9e999999     0E+999999  -> 9E+999999
+	{"fmax2170", "1", "9e999999", "0E+999999", "9E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2171 fma 1 9.9e999999   0E+999999  -> 9.9E+999999
+	{"fmax2171", "1", "9.9e999999", "0E+999999", "9.9E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2172 fma 1 9.99e999999  0E+999999  -> 9.99E+999999
+	{"fmax2172", "1", "9.99e999999", "0E+999999", "9.99E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2173 fma 9e999999    1  0E+999999  -> 9E+999999
+	{"fmax2173", "9e999999", "1", "0E+999999", "9E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2174 fma 9.9e999999  1  0E+999999  -> 9.9E+999999
+	{"fmax2174", "9.9e999999", "1", "0E+999999", "9.9E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2176 fma 9.99e999999 1  0E+999999  -> 9.99E+999999
+	{"fmax2176", "9.99e999999", "1", "0E+999999", "9.99E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2177 fma 1 9.99999e999999  0E+999999  -> 9.99999E+999999
+	{"fmax2177", "1", "9.99999e999999", "0E+999999", "9.99999E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2178 fma 9.99999e999999 1  0E+999999  -> 9.99999E+999999
+	{"fmax2178", "9.99999e999999", "1", "0E+999999", "9.99999E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2180 fma 0.1 9e-999998    0E+999999  -> 9E-999999
+	{"fmax2180", "0.1", "9e-999998", "0E+999999", "9E-999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2181 fma 0.1 99e-999998   0E+999999  -> 9.9E-999998
+	{"fmax2181", "0.1", "99e-999998", "0E+999999", "9.9E-999998", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2182 fma 0.1 999e-999998  0E+999999  -> 9.99E-999997
+	{"fmax2182", "0.1", "999e-999998", "0E+999999", "9.99E-999997", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2183 fma 0.1 9e-999998      0E+999999  -> 9E-999999
+	{"fmax2183", "0.1", "9e-999998", "0E+999999", "9E-999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2184 fma 0.1 99e-999998     0E+999999  -> 9.9E-999998
+	{"fmax2184", "0.1", "99e-999998", "0E+999999", "9.9E-999998", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2185 fma 0.1 999e-999998    0E+999999  -> 9.99E-999997
+	{"fmax2185", "0.1", "999e-999998", "0E+999999", "9.99E-999997", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2186 fma 0.1 999e-999997    0E+999999  -> 9.99E-999996
+	{"fmax2186", "0.1", "999e-999997", "0E+999999", "9.99E-999996", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2187 fma 0.1 9999e-999997   0E+999999  -> 9.999E-999995
+	{"fmax2187", "0.1", "9999e-999997", "0E+999999", "9.999E-999995", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2188 fma 0.1 99999e-999997  0E+999999  -> 9.9999E-999994
+	{"fmax2188", "0.1", "99999e-999997", "0E+999999", "9.9999E-999994", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2190 fma 1 9e-999998    0E+999999  -> 9E-999998
+	{"fmax2190", "1", "9e-999998", "0E+999999", "9E-999998", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2191 fma 1 99e-999998   0E+999999  -> 9.9E-999997
+	{"fmax2191", "1", "99e-999998", "0E+999999", "9.9E-999997", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2192 fma 1 999e-999998  0E+999999  -> 9.99E-999996
+	{"fmax2192", "1", "999e-999998", "0E+999999", "9.99E-999996", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2193 fma 9e-999998   1  0E+999999  -> 9E-999998
+	{"fmax2193", "9e-999998", "1", "0E+999999", "9E-999998", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2194 fma 99e-999998  1  0E+999999  -> 9.9E-999997
+	{"fmax2194", "99e-999998", "1", "0E+999999", "9.9E-999997", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2195 fma 999e-999998 1  0E+999999  -> 9.99E-999996
+	{"fmax2195", "999e-999998", "1", "0E+999999", "9.99E-999996", 0, 9, ToNearestAway, 999999, -999999, false},
+	// long operand triangle
+	// precision: 33
+	// fmax2246 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193369671916511992830 Inexact Rounded
+	{"fmax2246", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193369671916511992830", Inexact | Rounded, 33, ToNearestAway, 999999, -999999, false},
+	// precision: 32
+	// fmax2247 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119336967191651199283  Inexact Rounded
+	{"fmax2247", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119336967191651199283", Inexact | Rounded, 32, ToNearestAway, 999999, -999999, false},
+	// precision: 31
+	// fmax2248 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908011933696719165119928   Inexact Rounded
+	{"fmax2248", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908011933696719165119928", Inexact | Rounded, 31, ToNearestAway, 999999, -999999, false},
+	// precision: 30
+	// fmax2249 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193369671916511993    Inexact Rounded
+	{"fmax2249", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193369671916511993", Inexact | Rounded, 30, ToNearestAway, 999999, -999999, false},
+	// precision: 29
+	// fmax2250 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119336967191651199     Inexact Rounded
+	{"fmax2250", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119336967191651199", Inexact | Rounded, 29, ToNearestAway, 999999, -999999, false},
+	// precision: 28
+	// fmax2251 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908011933696719165120      Inexact Rounded
+	{"fmax2251", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908011933696719165120", Inexact | Rounded, 28, ToNearestAway, 999999, -999999, false},
+	// precision: 27
+	// fmax2252 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193369671916512       Inexact Rounded
+	{"fmax2252", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193369671916512", Inexact | Rounded, 27, ToNearestAway, 999999, -999999, false},
+	// precision: 26
+	// fmax2253 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119336967191651        Inexact Rounded
+	{"fmax2253", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119336967191651", Inexact | Rounded, 26, ToNearestAway, 999999, -999999, false},
+	// precision: 25
+	// fmax2254 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908011933696719165         Inexact Rounded
+	{"fmax2254", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908011933696719165", Inexact | Rounded, 25, ToNearestAway, 999999, -999999, false},
+	// precision: 24
+	// fmax2255 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193369671917          Inexact Rounded
+	{"fmax2255", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193369671917", Inexact | Rounded, 24, ToNearestAway, 999999, -999999, false},
+	// precision: 23
+	// fmax2256 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119336967192           Inexact Rounded
+	{"fmax2256", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119336967192", Inexact | Rounded, 23, ToNearestAway, 999999, -999999, false},
+	// precision: 22
+	// fmax2257 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908011933696719            Inexact Rounded
+	{"fmax2257", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908011933696719", Inexact | Rounded, 22, ToNearestAway, 999999, -999999, false},
+	// precision: 21
+	// fmax2258 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193369672             Inexact Rounded
+	{"fmax2258", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193369672", Inexact | Rounded, 21, ToNearestAway, 999999, -999999, false},
+	// precision: 20
+	// fmax2259 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119336967              Inexact Rounded
+	{"fmax2259", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119336967", Inexact | Rounded, 20, ToNearestAway, 999999, -999999, false},
+	// precision: 19
+	// fmax2260 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908011933697               Inexact Rounded
+	{"fmax2260", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908011933697", Inexact | Rounded, 19, ToNearestAway, 999999, -999999, false},
+	// precision: 18
+	// fmax2261 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193370                Inexact Rounded
+	{"fmax2261", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193370", Inexact | Rounded, 18, ToNearestAway, 999999, -999999, false},
+	// precision: 17
+	// fmax2262 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119337                 Inexact Rounded
+	{"fmax2262", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119337", Inexact | Rounded, 17, ToNearestAway, 999999, -999999, false},
+	// precision: 16
+	// fmax2263 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908011934                  Inexact Rounded
+	{"fmax2263", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908011934", Inexact | Rounded, 16, ToNearestAway, 999999, -999999, false},
+	// precision: 15
+	// fmax2264 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801193                   Inexact Rounded
+	{"fmax2264", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801193", Inexact | Rounded, 15, ToNearestAway, 999999, -999999, false},
+	// precision: 14
+	// fmax2265 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080119                    Inexact Rounded
+	{"fmax2265", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080119", Inexact | Rounded, 14, ToNearestAway, 999999, -999999, false},
+	// precision: 13
+	// fmax2266 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908012                     Inexact Rounded
+	{"fmax2266", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908012", Inexact | Rounded, 13, ToNearestAway, 999999, -999999, false},
+	// precision: 12
+	// fmax2267 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.290801                      Inexact Rounded
+	{"fmax2267", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.290801", Inexact | Rounded, 12, ToNearestAway, 999999, -999999, false},
+	// precision: 11
+	// fmax2268 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29080                       Inexact Rounded
+	{"fmax2268", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29080", Inexact | Rounded, 11, ToNearestAway, 999999, -999999, false},
+	// precision: 10
+	// fmax2269 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.2908                        Inexact Rounded
+	{"fmax2269", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.2908", Inexact | Rounded, 10, ToNearestAway, 999999, -999999, false},
+	// precision: 9
+	// fmax2270 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.291                         Inexact Rounded
+	{"fmax2270", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.291", Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// precision: 8
+	// fmax2271 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.29                          Inexact Rounded
+	{"fmax2271", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.29", Inexact | Rounded, 8, ToNearestAway, 999999, -999999, false},
+	// precision: 7
+	// fmax2272 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433.3                           Inexact Rounded
+	{"fmax2272", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433.3", Inexact | Rounded, 7, ToNearestAway, 999999, -999999, false},
+	// precision: 6
+	// fmax2273 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 145433                            Inexact Rounded
+	{"fmax2273", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "145433", Inexact | Rounded, 6, ToNearestAway, 999999, -999999, false},
+	// precision: 5
+	// fmax2274 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 1.4543E+5                         Inexact Rounded
+	{"fmax2274", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "1.4543E+5", Inexact | Rounded, 5, ToNearestAway, 999999, -999999, false},
+	// precision: 4
+	// fmax2275 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 1.454E+5                         Inexact Rounded
+	{"fmax2275", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "1.454E+5", Inexact | Rounded, 4, ToNearestAway, 999999, -999999, false},
+	// precision: 3
+	// fmax2276 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 1.45E+5                         Inexact Rounded
+	{"fmax2276", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "1.45E+5", Inexact | Rounded, 3, ToNearestAway, 999999, -999999, false},
+	// precision: 2
+	// fmax2277 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 1.5E+5                         Inexact Rounded
+	{"fmax2277", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "1.5E+5", Inexact | Rounded, 2, ToNearestAway, 999999, -999999, false},
+	// precision: 1
+	// fmax2278 fma 30269.587755640502150977251770554 4.8046009735990873395936309640543  0E+999999  -> 1E+5                          Inexact Rounded
+	{"fmax2278", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "0E+999999", "1E+5", Inexact | Rounded, 1, ToNearestAway, 999999, -999999, false},
+	// test some edge cases with exact rounding
+	// maxexponent: 9999
+	// minexponent: -9999
+	// precision: 9
+	// fmax2301 fma 9 9    0E+999999  -> 81
+	{"fmax2301", "9", "9", "0E+999999", "81", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2302 fma 9 90    0E+999999  -> 810
+	{"fmax2302", "9", "90", "0E+999999", "810", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2303 fma 9 900    0E+999999  -> 8100
+	{"fmax2303", "9", "900", "0E+999999", "8100", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2304 fma 9 9000    0E+999999  -> 81000
+	{"fmax2304", "9", "9000", "0E+999999", "81000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2305 fma 9 90000    0E+999999  -> 810000
+	{"fmax2305", "9", "90000", "0E+999999", "810000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2306 fma 9 900000    0E+999999  -> 8100000
+	{"fmax2306", "9", "900000", "0E+999999", "8100000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2307 fma 9 9000000    0E+999999  -> 81000000
+	{"fmax2307", "9", "9000000", "0E+999999", "81000000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2308 fma 9 90000000    0E+999999  -> 810000000
+	{"fmax2308", "9", "90000000", "0E+999999", "810000000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2309 fma 9 900000000    0E+999999  -> 8.10000000E+9   Rounded
+	{"fmax2309", "9", "900000000", "0E+999999", "8.10000000E+9", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2310 fma 9 9000000000    0E+999999  -> 8.10000000E+10  Rounded
+	{"fmax2310", "9", "9000000000", "0E+999999", "8.10000000E+10", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2311 fma 9 90000000000    0E+999999  -> 8.10000000E+11  Rounded
+	{"fmax2311", "9", "90000000000", "0E+999999", "8.10000000E+11", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2312 fma 9 900000000000    0E+999999  -> 8.10000000E+12  Rounded
+	{"fmax2312", "9", "900000000000", "0E+999999", "8.10000000E+12", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2313 fma 9 9000000000000    0E+999999  -> 8.10000000E+13  Rounded
+	{"fmax2313", "9", "9000000000000", "0E+999999", "8.10000000E+13", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2314 fma 9 90000000000000    0E+999999  -> 8.10000000E+14  Rounded
+	{"fmax2314", "9", "90000000000000", "0E+999999", "8.10000000E+14", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2315 fma 9 900000000000000    0E+999999  -> 8.10000000E+15  Rounded
+	{"fmax2315", "9", "900000000000000", "0E+999999", "8.10000000E+15", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2316 fma 9 9000000000000000    0E+999999  -> 8.10000000E+16  Rounded
+	{"fmax2316", "9", "9000000000000000", "0E+999999", "8.10000000E+16", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2317 fma 9 90000000000000000    0E+999999  -> 8.10000000E+17  Rounded
+	{"fmax2317", "9", "90000000000000000", "0E+999999", "8.10000000E+17", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2318 fma 9 900000000000000000    0E+999999  -> 8.10000000E+18  Rounded
+	{"fmax2318", "9", "900000000000000000", "0E+999999", "8.10000000E+18", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2319 fma 9 9000000000000000000    0E+999999  -> 8.10000000E+19  Rounded
+	{"fmax2319", "9", "9000000000000000000", "0E+999999", "8.10000000E+19", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2320 fma 9 90000000000000000000    0E+999999  -> 8.10000000E+20  Rounded
+	{"fmax2320", "9", "90000000000000000000", "0E+999999", "8.10000000E+20", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2321 fma 9 900000000000000000000    0E+999999  -> 8.10000000E+21  Rounded
+	{"fmax2321", "9", "900000000000000000000", "0E+999999", "8.10000000E+21", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2322 fma 9 9000000000000000000000    0E+999999  -> 8.10000000E+22  Rounded
+	{"fmax2322", "9", "9000000000000000000000", "0E+999999", "8.10000000E+22", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fmax2323 fma 9 90000000000000000000000    0E+999999  -> 8.10000000E+23  Rounded
+	{"fmax2323", "9", "90000000000000000000000", "0E+999999", "8.10000000E+23", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fastpath breakers
+	// precision: 29
+	// fmax2330 fma 1.491824697641270317824852952837224 1.105170918075647624811707826490246514675628614562883537345747603  0E+999999  -> 1.6487212707001281468486507878 Inexact Rounded
+	{"fmax2330", "1.491824697641270317824852952837224", "1.105170918075647624811707826490246514675628614562883537345747603", "0E+999999", "1.6487212707001281468486507878", Inexact | Rounded, 29, ToNearestAway, 9999, -9999, false},
+	// precision: 55
+	// fmax2331 fma 0.8958341352965282506768545828765117803873717284891040428 0.8958341352965282506768545828765117803873717284891040428  0E+999999  -> 0.8025187979624784829842553829934069955890983696752228299 Inexact Rounded
+	{"fmax2331", "0.8958341352965282506768545828765117803873717284891040428", "0.8958341352965282506768545828765117803873717284891040428", "0E+999999", "0.8025187979624784829842553829934069955890983696752228299", Inexact | Rounded, 55, ToNearestAway, 9999, -9999, false},
+	// tryzeros cases
+	// precision: 7
+	// rounding: half_up
+	// maxexponent: 92
+	// minexponent: -92
+	// fmax2504  fma  0E-60 1000E-60   0E+999999  -> 0E-98 Clamped
+	{"fmax2504", "0E-60", "1000E-60", "0E+999999", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// fmax2505  fma  100E+60 0E+60    0E+999999  -> 0E+92 Clamped
+	{"fmax2505", "100E+60", "0E+60", "0E+999999", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// mixed with zeros
+	// maxexponent: 999999
+	// minexponent: -999999
+	// precision: 9
+	// fmax2541 fma  0    -1      0E+999999  ->  0
+	{"fmax2541", "0", "-1", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2542 fma -0    -1      0E+999999  ->  0
+	{"fmax2542", "-0", "-1", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2543 fma  0     1      0E+999999  ->  0
+	{"fmax2543", "0", "1", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2544 fma -0     1      0E+999999  ->  0
+	{"fmax2544", "-0", "1", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2545 fma -1     0      0E+999999  ->  0
+	{"fmax2545", "-1", "0", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2546 fma -1    -0      0E+999999  ->  0
+	{"fmax2546", "-1", "-0", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2547 fma  1     0      0E+999999  ->  0
+	{"fmax2547", "1", "0", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2548 fma  1    -0      0E+999999  ->  0
+	{"fmax2548", "1", "-0", "0E+999999", "0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2551 fma  0.0  -1      0E+999999  ->  0.0
+	{"fmax2551", "0.0", "-1", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2552 fma -0.0  -1      0E+999999  ->  0.0
+	{"fmax2552", "-0.0", "-1", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2553 fma  0.0   1      0E+999999  ->  0.0
+	{"fmax2553", "0.0", "1", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2554 fma -0.0   1      0E+999999  ->  0.0
+	{"fmax2554", "-0.0", "1", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2555 fma -1.0   0      0E+999999  ->  0.0
+	{"fmax2555", "-1.0", "0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2556 fma -1.0  -0      0E+999999  ->  0.0
+	{"fmax2556", "-1.0", "-0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2557 fma  1.0   0      0E+999999  ->  0.0
+	{"fmax2557", "1.0", "0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2558 fma  1.0  -0      0E+999999  ->  0.0
+	{"fmax2558", "1.0", "-0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2561 fma  0    -1.0    0E+999999  ->  0.0
+	{"fmax2561", "0", "-1.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2562 fma -0    -1.0    0E+999999  ->  0.0
+	{"fmax2562", "-0", "-1.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2563 fma  0     1.0    0E+999999  ->  0.0
+	{"fmax2563", "0", "1.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2564 fma -0     1.0    0E+999999  ->  0.0
+	{"fmax2564", "-0", "1.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2565 fma -1     0.0    0E+999999  ->  0.0
+	{"fmax2565", "-1", "0.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2566 fma -1    -0.0    0E+999999  ->  0.0
+	{"fmax2566", "-1", "-0.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2567 fma  1     0.0    0E+999999  ->  0.0
+	{"fmax2567", "1", "0.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2568 fma  1    -0.0    0E+999999  ->  0.0
+	{"fmax2568", "1", "-0.0", "0E+999999", "0.0", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2571 fma  0.0  -1.0    0E+999999  ->  0.00
+	{"fmax2571", "0.0", "-1.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2572 fma -0.0  -1.0    0E+999999  ->  0.00
+	{"fmax2572", "-0.0", "-1.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2573 fma  0.0   1.0    0E+999999  ->  0.00
+	{"fmax2573", "0.0", "1.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2574 fma -0.0   1.0    0E+999999  ->  0.00
+	{"fmax2574", "-0.0", "1.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2575 fma -1.0   0.0    0E+999999  ->  0.00
+	{"fmax2575", "-1.0", "0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2576 fma -1.0  -0.0    0E+999999  ->  0.00
+	{"fmax2576", "-1.0", "-0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2577 fma  1.0   0.0    0E+999999  ->  0.00
+	{"fmax2577", "1.0", "0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2578 fma  1.0  -0.0    0E+999999  ->  0.00
+	{"fmax2578", "1.0", "-0.0", "0E+999999", "0.00", 0, 9, ToNearestAway, 999999, -999999, false},
+	// Specials
+	// fmax2580 fma  Inf  -Inf    0E+999999  -> -Infinity
+	{"fmax2580", "Inf", "-Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2581 fma  Inf  -1000   0E+999999  -> -Infinity
+	{"fmax2581", "Inf", "-1000", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2582 fma  Inf  -1      0E+999999  -> -Infinity
+	{"fmax2582", "Inf", "-1", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2583 fma  Inf  -0      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2583", "Inf", "-0", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2584 fma  Inf   0      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2584", "Inf", "0", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2585 fma  Inf   1      0E+999999  ->  Infinity
+	{"fmax2585", "Inf", "1", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2586 fma  Inf   1000   0E+999999  ->  Infinity
+	{"fmax2586", "Inf", "1000", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2587 fma  Inf   Inf    0E+999999  ->  Infinity
+	{"fmax2587", "Inf", "Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2588 fma -1000  Inf    0E+999999  -> -Infinity
+	{"fmax2588", "-1000", "Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2589 fma -Inf   Inf    0E+999999  -> -Infinity
+	{"fmax2589", "-Inf", "Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2590 fma -1     Inf    0E+999999  -> -Infinity
+	{"fmax2590", "-1", "Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2591 fma -0     Inf    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2591", "-0", "Inf", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2592 fma  0     Inf    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2592", "0", "Inf", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2593 fma  1     Inf    0E+999999  ->  Infinity
+	{"fmax2593", "1", "Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2594 fma  1000  Inf    0E+999999  ->  Infinity
+	{"fmax2594", "1000", "Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2595 fma  Inf   Inf    0E+999999  ->  Infinity
+	{"fmax2595", "Inf", "Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2600 fma -Inf  -Inf    0E+999999  ->  Infinity
+	{"fmax2600", "-Inf", "-Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2601 fma -Inf  -1000   0E+999999  ->  Infinity
+	{"fmax2601", "-Inf", "-1000", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2602 fma -Inf  -1      0E+999999  ->  Infinity
+	{"fmax2602", "-Inf", "-1", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2603 fma -Inf  -0      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2603", "-Inf", "-0", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2604 fma -Inf   0      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2604", "-Inf", "0", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2605 fma -Inf   1      0E+999999  -> -Infinity
+	{"fmax2605", "-Inf", "1", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2606 fma -Inf   1000   0E+999999  -> -Infinity
+	{"fmax2606", "-Inf", "1000", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2607 fma -Inf   Inf    0E+999999  -> -Infinity
+	{"fmax2607", "-Inf", "Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2608 fma -1000  Inf    0E+999999  -> -Infinity
+	{"fmax2608", "-1000", "Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2609 fma -Inf  -Inf    0E+999999  ->  Infinity
+	{"fmax2609", "-Inf", "-Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2610 fma -1    -Inf    0E+999999  ->  Infinity
+	{"fmax2610", "-1", "-Inf", "0E+999999", "Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2611 fma -0    -Inf    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2611", "-0", "-Inf", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2612 fma  0    -Inf    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2612", "0", "-Inf", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2613 fma  1    -Inf    0E+999999  -> -Infinity
+	{"fmax2613", "1", "-Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2614 fma  1000 -Inf    0E+999999  -> -Infinity
+	{"fmax2614", "1000", "-Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2615 fma  Inf  -Inf    0E+999999  -> -Infinity
+	{"fmax2615", "Inf", "-Inf", "0E+999999", "-Inf", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2621 fma  NaN -Inf     0E+999999  ->  NaN
+	{"fmax2621", "NaN", "-Inf", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2622 fma  NaN -1000    0E+999999  ->  NaN
+	{"fmax2622", "NaN", "-1000", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2623 fma  NaN -1       0E+999999  ->  NaN
+	{"fmax2623", "NaN", "-1", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2624 fma  NaN -0       0E+999999  ->  NaN
+	{"fmax2624", "NaN", "-0", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2625 fma  NaN  0       0E+999999  ->  NaN
+	{"fmax2625", "NaN", "0", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2626 fma  NaN  1       0E+999999  ->  NaN
+	{"fmax2626", "NaN", "1", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2627 fma  NaN  1000    0E+999999  ->  NaN
+	{"fmax2627", "NaN", "1000", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2628 fma  NaN  Inf     0E+999999  ->  NaN
+	{"fmax2628", "NaN", "Inf", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2629 fma  NaN  NaN     0E+999999  ->  NaN
+	{"fmax2629", "NaN", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2630 fma -Inf  NaN     0E+999999  ->  NaN
+	{"fmax2630", "-Inf", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2631 fma -1000 NaN     0E+999999  ->  NaN
+	{"fmax2631", "-1000", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2632 fma -1    NaN     0E+999999  ->  NaN
+	{"fmax2632", "-1", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2633 fma -0    NaN     0E+999999  ->  NaN
+	{"fmax2633", "-0", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2634 fma  0    NaN     0E+999999  ->  NaN
+	{"fmax2634", "0", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2635 fma  1    NaN     0E+999999  ->  NaN
+	{"fmax2635", "1", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2636 fma  1000 NaN     0E+999999  ->  NaN
+	{"fmax2636", "1000", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2637 fma  Inf  NaN     0E+999999  ->  NaN
+	{"fmax2637", "Inf", "NaN", "0E+999999", "NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2641 fma  sNaN -Inf    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2641", "sNaN", "-Inf", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2642 fma  sNaN -1000   0E+999999  ->  NaN  Invalid_operation
+	{"fmax2642", "sNaN", "-1000", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2643 fma  sNaN -1      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2643", "sNaN", "-1", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2644 fma  sNaN -0      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2644", "sNaN", "-0", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2645 fma  sNaN  0      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2645", "sNaN", "0", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2646 fma  sNaN  1      0E+999999  ->  NaN  Invalid_operation
+	{"fmax2646", "sNaN", "1", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2647 fma  sNaN  1000   0E+999999  ->  NaN  Invalid_operation
+	{"fmax2647", "sNaN", "1000", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2648 fma  sNaN  NaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2648", "sNaN", "NaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2649 fma  sNaN sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2649", "sNaN", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2650 fma  NaN  sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2650", "NaN", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2651 fma -Inf  sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2651", "-Inf", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2652 fma -1000 sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2652", "-1000", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2653 fma -1    sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2653", "-1", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2654 fma -0    sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2654", "-0", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2655 fma  0    sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2655", "0", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2656 fma  1    sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2656", "1", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2657 fma  1000 sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2657", "1000", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2658 fma  Inf  sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2658", "Inf", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2659 fma  NaN  sNaN    0E+999999  ->  NaN  Invalid_operation
+	{"fmax2659", "NaN", "sNaN", "0E+999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// propagating NaNs
+	// fmax2661 fma  NaN9 -Inf    0E+999999  ->  NaN9
+	{"fmax2661", "NaN9", "-Inf", "0E+999999", "NaN9", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2662 fma  NaN8  999    0E+999999  ->  NaN8
+	{"fmax2662", "NaN8", "999", "0E+999999", "NaN8", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2663 fma  NaN71 Inf    0E+999999  ->  NaN71
+	{"fmax2663", "NaN71", "Inf", "0E+999999", "NaN71", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2664 fma  NaN6  NaN5   0E+999999  ->  NaN6
+	{"fmax2664", "NaN6", "NaN5", "0E+999999", "NaN6", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2665 fma -Inf   NaN4   0E+999999  ->  NaN4
+	{"fmax2665", "-Inf", "NaN4", "0E+999999", "NaN4", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2666 fma -999   NaN33  0E+999999  ->  NaN33
+	{"fmax2666", "-999", "NaN33", "0E+999999", "NaN33", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2667 fma  Inf   NaN2   0E+999999  ->  NaN2
+	{"fmax2667", "Inf", "NaN2", "0E+999999", "NaN2", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2671 fma  sNaN99 -Inf     0E+999999  ->  NaN99 Invalid_operation
+	{"fmax2671", "sNaN99", "-Inf", "0E+999999", "NaN99", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2672 fma  sNaN98 -11      0E+999999  ->  NaN98 Invalid_operation
+	{"fmax2672", "sNaN98", "-11", "0E+999999", "NaN98", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2673 fma  sNaN97  NaN     0E+999999  ->  NaN97 Invalid_operation
+	{"fmax2673", "sNaN97", "NaN", "0E+999999", "NaN97", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2674 fma  sNaN16 sNaN94   0E+999999  ->  NaN16 Invalid_operation
+	{"fmax2674", "sNaN16", "sNaN94", "0E+999999", "NaN16", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2675 fma  NaN95  sNaN93   0E+999999  ->  NaN93 Invalid_operation
+	{"fmax2675", "NaN95", "sNaN93", "0E+999999", "NaN93", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2676 fma -Inf    sNaN92   0E+999999  ->  NaN92 Invalid_operation
+	{"fmax2676", "-Inf", "sNaN92", "0E+999999", "NaN92", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2677 fma  088    sNaN91   0E+999999  ->  NaN91 Invalid_operation
+	{"fmax2677", "088", "sNaN91", "0E+999999", "NaN91", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2678 fma  Inf    sNaN90   0E+999999  ->  NaN90 Invalid_operation
+	{"fmax2678", "Inf", "sNaN90", "0E+999999", "NaN90", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2679 fma  NaN    sNaN89   0E+999999  ->  NaN89 Invalid_operation
+	{"fmax2679", "NaN", "sNaN89", "0E+999999", "NaN89", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2681 fma -NaN9 -Inf    0E+999999  -> -NaN9
+	{"fmax2681", "-NaN9", "-Inf", "0E+999999", "-NaN9", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2682 fma -NaN8  999    0E+999999  -> -NaN8
+	{"fmax2682", "-NaN8", "999", "0E+999999", "-NaN8", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2683 fma -NaN71 Inf    0E+999999  -> -NaN71
+	{"fmax2683", "-NaN71", "Inf", "0E+999999", "-NaN71", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2684 fma -NaN6 -NaN5   0E+999999  -> -NaN6
+	{"fmax2684", "-NaN6", "-NaN5", "0E+999999", "-NaN6", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2685 fma -Inf  -NaN4   0E+999999  -> -NaN4
+	{"fmax2685", "-Inf", "-NaN4", "0E+999999", "-NaN4", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2686 fma -999  -NaN33  0E+999999  -> -NaN33
+	{"fmax2686", "-999", "-NaN33", "0E+999999", "-NaN33", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2687 fma  Inf  -NaN2   0E+999999  -> -NaN2
+	{"fmax2687", "Inf", "-NaN2", "0E+999999", "-NaN2", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2691 fma -sNaN99 -Inf     0E+999999  -> -NaN99 Invalid_operation
+	{"fmax2691", "-sNaN99", "-Inf", "0E+999999", "-NaN99", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2692 fma -sNaN98 -11      0E+999999  -> -NaN98 Invalid_operation
+	{"fmax2692", "-sNaN98", "-11", "0E+999999", "-NaN98", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2693 fma -sNaN97  NaN     0E+999999  -> -NaN97 Invalid_operation
+	{"fmax2693", "-sNaN97", "NaN", "0E+999999", "-NaN97", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2694 fma -sNaN16 -sNaN94  0E+999999  -> -NaN16 Invalid_operation
+	{"fmax2694", "-sNaN16", "-sNaN94", "0E+999999", "-NaN16", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2695 fma -NaN95  -sNaN93  0E+999999  -> -NaN93 Invalid_operation
+	{"fmax2695", "-NaN95", "-sNaN93", "0E+999999", "-NaN93", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2696 fma -Inf    -sNaN92  0E+999999  -> -NaN92 Invalid_operation
+	{"fmax2696", "-Inf", "-sNaN92", "0E+999999", "-NaN92", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2697 fma  088    -sNaN91  0E+999999  -> -NaN91 Invalid_operation
+	{"fmax2697", "088", "-sNaN91", "0E+999999", "-NaN91", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2698 fma  Inf    -sNaN90  0E+999999  -> -NaN90 Invalid_operation
+	{"fmax2698", "Inf", "-sNaN90", "0E+999999", "-NaN90", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2699 fma -NaN    -sNaN89  0E+999999  -> -NaN89 Invalid_operation
+	{"fmax2699", "-NaN", "-sNaN89", "0E+999999", "-NaN89", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2701 fma -NaN  -Inf    0E+999999  -> -NaN
+	{"fmax2701", "-NaN", "-Inf", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2702 fma -NaN   999    0E+999999  -> -NaN
+	{"fmax2702", "-NaN", "999", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2703 fma -NaN   Inf    0E+999999  -> -NaN
+	{"fmax2703", "-NaN", "Inf", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2704 fma -NaN  -NaN    0E+999999  -> -NaN
+	{"fmax2704", "-NaN", "-NaN", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2705 fma -Inf  -NaN0   0E+999999  -> -NaN
+	{"fmax2705", "-Inf", "-NaN0", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2706 fma -999  -NaN    0E+999999  -> -NaN
+	{"fmax2706", "-999", "-NaN", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2707 fma  Inf  -NaN    0E+999999  -> -NaN
+	{"fmax2707", "Inf", "-NaN", "0E+999999", "-NaN", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2711 fma -sNaN   -Inf     0E+999999  -> -NaN Invalid_operation
+	{"fmax2711", "-sNaN", "-Inf", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2712 fma -sNaN   -11      0E+999999  -> -NaN Invalid_operation
+	{"fmax2712", "-sNaN", "-11", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2713 fma -sNaN00  NaN     0E+999999  -> -NaN Invalid_operation
+	{"fmax2713", "-sNaN00", "NaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2714 fma -sNaN   -sNaN    0E+999999  -> -NaN Invalid_operation
+	{"fmax2714", "-sNaN", "-sNaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2715 fma -NaN    -sNaN    0E+999999  -> -NaN Invalid_operation
+	{"fmax2715", "-NaN", "-sNaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2716 fma -Inf    -sNaN    0E+999999  -> -NaN Invalid_operation
+	{"fmax2716", "-Inf", "-sNaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2717 fma  088    -sNaN    0E+999999  -> -NaN Invalid_operation
+	{"fmax2717", "088", "-sNaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2718 fma  Inf    -sNaN    0E+999999  -> -NaN Invalid_operation
+	{"fmax2718", "Inf", "-sNaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2719 fma -NaN    -sNaN    0E+999999  -> -NaN Invalid_operation
+	{"fmax2719", "-NaN", "-sNaN", "0E+999999", "-NaN", InvalidOperation, 9, ToNearestAway, 999999, -999999, false},
+	// overflow and underflow tests .. note subnormal results
+	// maxexponent: 999999
+	// minexponent: -999999
+	// fmax2730 fma +1.23456789012345E-0 9E+999999  0E+999999  -> Infinity Inexact Overflow Rounded
+	{"fmax2730", "+1.23456789012345E-0", "9E+999999", "0E+999999", "Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2731 fma 9E+999999 +1.23456789012345E-0  0E+999999  -> Infinity Inexact Overflow Rounded
+	{"fmax2731", "9E+999999", "+1.23456789012345E-0", "0E+999999", "Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2732 fma +0.100 9E-999999  0E+999999  -> 9.00E-1000000 Subnormal
+	{"fmax2732", "+0.100", "9E-999999", "0E+999999", "9.00E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2733 fma 9E-999999 +0.100  0E+999999  -> 9.00E-1000000 Subnormal
+	{"fmax2733", "9E-999999", "+0.100", "0E+999999", "9.00E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2735 fma -1.23456789012345E-0 9E+999999  0E+999999  -> -Infinity Inexact Overflow Rounded
+	{"fmax2735", "-1.23456789012345E-0", "9E+999999", "0E+999999", "-Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2736 fma 9E+999999 -1.23456789012345E-0  0E+999999  -> -Infinity Inexact Overflow Rounded
+	{"fmax2736", "9E+999999", "-1.23456789012345E-0", "0E+999999", "-Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2737 fma -0.100 9E-999999  0E+999999  -> -9.00E-1000000 Subnormal
+	{"fmax2737", "-0.100", "9E-999999", "0E+999999", "-9.00E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2738 fma 9E-999999 -0.100  0E+999999  -> -9.00E-1000000 Subnormal
+	{"fmax2738", "9E-999999", "-0.100", "0E+999999", "-9.00E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// signs
+	// fmax2751 fma  1e+777777  1e+411111  0E+999999  ->  Infinity Overflow Inexact Rounded
+	{"fmax2751", "1e+777777", "1e+411111", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2752 fma  1e+777777 -1e+411111  0E+999999  -> -Infinity Overflow Inexact Rounded
+	{"fmax2752", "1e+777777", "-1e+411111", "0E+999999", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2753 fma -1e+777777  1e+411111  0E+999999  -> -Infinity Overflow Inexact Rounded
+	{"fmax2753", "-1e+777777", "1e+411111", "0E+999999", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2754 fma -1e+777777 -1e+411111  0E+999999  ->  Infinity Overflow Inexact Rounded
+	{"fmax2754", "-1e+777777", "-1e+411111", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2755 fma  1e-777777  1e-411111  0E+999999  ->  0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2755", "1e-777777", "1e-411111", "0E+999999", "0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2756 fma  1e-777777 -1e-411111  0E+999999  -> -0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2756", "1e-777777", "-1e-411111", "0E+999999", "-0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2757 fma -1e-777777  1e-411111  0E+999999  -> -0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2757", "-1e-777777", "1e-411111", "0E+999999", "-0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2758 fma -1e-777777 -1e-411111  0E+999999  ->  0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2758", "-1e-777777", "-1e-411111", "0E+999999", "0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// 'subnormal' boundary (all hard underflow or overflow in base arithmetic)
+	// precision: 9
+	// fmax2760 fma 1e-600000 1e-400001  0E+999999  -> 1E-1000001 Subnormal
+	{"fmax2760", "1e-600000", "1e-400001", "0E+999999", "1E-1000001", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2761 fma 1e-600000 1e-400002  0E+999999  -> 1E-1000002 Subnormal
+	{"fmax2761", "1e-600000", "1e-400002", "0E+999999", "1E-1000002", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2762 fma 1e-600000 1e-400003  0E+999999  -> 1E-1000003 Subnormal
+	{"fmax2762", "1e-600000", "1e-400003", "0E+999999", "1E-1000003", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2763 fma 1e-600000 1e-400004  0E+999999  -> 1E-1000004 Subnormal
+	{"fmax2763", "1e-600000", "1e-400004", "0E+999999", "1E-1000004", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2764 fma 1e-600000 1e-400005  0E+999999  -> 1E-1000005 Subnormal
+	{"fmax2764", "1e-600000", "1e-400005", "0E+999999", "1E-1000005", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2765 fma 1e-600000 1e-400006  0E+999999  -> 1E-1000006 Subnormal
+	{"fmax2765", "1e-600000", "1e-400006", "0E+999999", "1E-1000006", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2766 fma 1e-600000 1e-400007  0E+999999  -> 1E-1000007 Subnormal
+	{"fmax2766", "1e-600000", "1e-400007", "0E+999999", "1E-1000007", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2767 fma 1e-600000 1e-400008  0E+999999  -> 0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2767", "1e-600000", "1e-400008", "0E+999999", "0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2768 fma 1e-600000 1e-400009  0E+999999  -> 0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2768", "1e-600000", "1e-400009", "0E+999999", "0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2769 fma 1e-600000 1e-400010  0E+999999  -> 0E-1000007 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2769", "1e-600000", "1e-400010", "0E+999999", "0E-1000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999, -999999, false},
+	// [no equivalent of 'subnormal' for overflow]
+	// fmax2770 fma 1e+600000 1e+400001  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2770", "1e+600000", "1e+400001", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2771 fma 1e+600000 1e+400002  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2771", "1e+600000", "1e+400002", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2772 fma 1e+600000 1e+400003  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2772", "1e+600000", "1e+400003", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2773 fma 1e+600000 1e+400004  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2773", "1e+600000", "1e+400004", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2774 fma 1e+600000 1e+400005  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2774", "1e+600000", "1e+400005", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2775 fma 1e+600000 1e+400006  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2775", "1e+600000", "1e+400006", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2776 fma 1e+600000 1e+400007  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2776", "1e+600000", "1e+400007", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2777 fma 1e+600000 1e+400008  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2777", "1e+600000", "1e+400008", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2778 fma 1e+600000 1e+400009  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2778", "1e+600000", "1e+400009", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax2779 fma 1e+600000 1e+400010  0E+999999  -> Infinity Overflow Inexact Rounded
+	{"fmax2779", "1e+600000", "1e+400010", "0E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// 'subnormal' test edge condition at higher precisions
+	// precision: 99
+	// fmax2780 fma 1e-600000 1e-400007  0E+999999  -> 1E-1000007 Subnormal
+	{"fmax2780", "1e-600000", "1e-400007", "0E+999999", "1E-1000007", Subnormal, 99, ToNearestAway, 999999, -999999, false},
+	// fmax2781 fma 1e-600000 1e-400008  0E+999999  -> 1E-1000008 Subnormal
+	{"fmax2781", "1e-600000", "1e-400008", "0E+999999", "1E-1000008", Subnormal, 99, ToNearestAway, 999999, -999999, false},
+	// fmax2782 fma 1e-600000 1e-400097  0E+999999  -> 1E-1000097 Subnormal
+	{"fmax2782", "1e-600000", "1e-400097", "0E+999999", "1E-1000097", Subnormal, 99, ToNearestAway, 999999, -999999, false},
+	// fmax2783 fma 1e-600000 1e-400098  0E+999999  -> 0E-1000097 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2783", "1e-600000", "1e-400098", "0E+999999", "0E-1000097", Underflow | Subnormal | Inexact | Rounded | Clamped, 99, ToNearestAway, 999999, -999999, false},
+	// precision: 999
+	// fmax2784 fma 1e-600000 1e-400997  0E+999999  -> 1E-1000997 Subnormal
+	{"fmax2784", "1e-600000", "1e-400997", "0E+999999", "1E-1000997", Subnormal, 999, ToNearestAway, 999999, -999999, false},
+	// fmax2785 fma 1e-600000 1e-400998  0E+999999  -> 0E-1000997 Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2785", "1e-600000", "1e-400998", "0E+999999", "0E-1000997", Underflow | Subnormal | Inexact | Rounded | Clamped, 999, ToNearestAway, 999999, -999999, false},
+	// test subnormals rounding
+	// precision: 5
+	// maxexponent: 999
+	// minexponent: -999
+	// rounding: half_even
+	// fmax2801 fma  1.0000E-999  1      0E+999999  -> 1.0000E-999
+	{"fmax2801", "1.0000E-999", "1", "0E+999999", "1.0000E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// fmax2802 fma  1.000E-999   1e-1   0E+999999  -> 1.000E-1000 Subnormal
+	{"fmax2802", "1.000E-999", "1e-1", "0E+999999", "1.000E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2803 fma  1.00E-999    1e-2   0E+999999  -> 1.00E-1001  Subnormal
+	{"fmax2803", "1.00E-999", "1e-2", "0E+999999", "1.00E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2804 fma  1.0E-999     1e-3   0E+999999  -> 1.0E-1002   Subnormal
+	{"fmax2804", "1.0E-999", "1e-3", "0E+999999", "1.0E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2805 fma  1.0E-999     1e-4   0E+999999  -> 1E-1003     Subnormal Rounded
+	{"fmax2805", "1.0E-999", "1e-4", "0E+999999", "1E-1003", Subnormal | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2806 fma  1.3E-999     1e-4   0E+999999  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2806", "1.3E-999", "1e-4", "0E+999999", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2807 fma  1.5E-999     1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2807", "1.5E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2808 fma  1.7E-999     1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2808", "1.7E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2809 fma  2.3E-999     1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2809", "2.3E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2810 fma  2.5E-999     1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2810", "2.5E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2811 fma  2.7E-999     1e-4   0E+999999  -> 3E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2811", "2.7E-999", "1e-4", "0E+999999", "3E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2812 fma  1.49E-999    1e-4   0E+999999  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2812", "1.49E-999", "1e-4", "0E+999999", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2813 fma  1.50E-999    1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2813", "1.50E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2814 fma  1.51E-999    1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2814", "1.51E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2815 fma  2.49E-999    1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2815", "2.49E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2816 fma  2.50E-999    1e-4   0E+999999  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2816", "2.50E-999", "1e-4", "0E+999999", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2817 fma  2.51E-999    1e-4   0E+999999  -> 3E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2817", "2.51E-999", "1e-4", "0E+999999", "3E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2818 fma  1E-999       1e-4   0E+999999  -> 1E-1003     Subnormal
+	{"fmax2818", "1E-999", "1e-4", "0E+999999", "1E-1003", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2819 fma  3E-999       1e-5   0E+999999  -> 0E-1003     Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2819", "3E-999", "1e-5", "0E+999999", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2820 fma  5E-999       1e-5   0E+999999  -> 0E-1003     Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2820", "5E-999", "1e-5", "0E+999999", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2821 fma  7E-999       1e-5   0E+999999  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2821", "7E-999", "1e-5", "0E+999999", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2822 fma  9E-999       1e-5   0E+999999  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2822", "9E-999", "1e-5", "0E+999999", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2823 fma  9.9E-999     1e-5   0E+999999  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"fmax2823", "9.9E-999", "1e-5", "0E+999999", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2824 fma  1E-999      -1e-4   0E+999999  -> -1E-1003    Subnormal
+	{"fmax2824", "1E-999", "-1e-4", "0E+999999", "-1E-1003", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2825 fma  3E-999      -1e-5   0E+999999  -> -0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2825", "3E-999", "-1e-5", "0E+999999", "-0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2826 fma -5E-999       1e-5   0E+999999  -> -0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2826", "-5E-999", "1e-5", "0E+999999", "-0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2827 fma  7E-999      -1e-5   0E+999999  -> -1E-1003    Underflow Subnormal Inexact Rounded
+	{"fmax2827", "7E-999", "-1e-5", "0E+999999", "-1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2828 fma -9E-999       1e-5   0E+999999  -> -1E-1003    Underflow Subnormal Inexact Rounded
+	{"fmax2828", "-9E-999", "1e-5", "0E+999999", "-1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2829 fma  9.9E-999    -1e-5   0E+999999  -> -1E-1003    Underflow Subnormal Inexact Rounded
+	{"fmax2829", "9.9E-999", "-1e-5", "0E+999999", "-1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// fmax2830 fma  3.0E-999    -1e-5   0E+999999  -> -0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2830", "3.0E-999", "-1e-5", "0E+999999", "-0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2831 fma  1.0E-501     1e-501  0E+999999  -> 1.0E-1002   Subnormal
+	{"fmax2831", "1.0E-501", "1e-501", "0E+999999", "1.0E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2832 fma  2.0E-501     2e-501  0E+999999  -> 4.0E-1002   Subnormal
+	{"fmax2832", "2.0E-501", "2e-501", "0E+999999", "4.0E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2833 fma  4.0E-501     4e-501  0E+999999  -> 1.60E-1001  Subnormal
+	{"fmax2833", "4.0E-501", "4e-501", "0E+999999", "1.60E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2834 fma 10.0E-501    10e-501  0E+999999  -> 1.000E-1000 Subnormal
+	{"fmax2834", "10.0E-501", "10e-501", "0E+999999", "1.000E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2835 fma 30.0E-501    30e-501  0E+999999  -> 9.000E-1000 Subnormal
+	{"fmax2835", "30.0E-501", "30e-501", "0E+999999", "9.000E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2836 fma 40.0E-501    40e-501  0E+999999  -> 1.6000E-999
+	{"fmax2836", "40.0E-501", "40e-501", "0E+999999", "1.6000E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// squares
+	// fmax2840 fma  1E-502       1e-502  0E+999999  -> 0E-1003     Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2840", "1E-502", "1e-502", "0E+999999", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2841 fma  1E-501       1e-501  0E+999999  -> 1E-1002     Subnormal
+	{"fmax2841", "1E-501", "1e-501", "0E+999999", "1E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2842 fma  2E-501       2e-501  0E+999999  -> 4E-1002     Subnormal
+	{"fmax2842", "2E-501", "2e-501", "0E+999999", "4E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2843 fma  4E-501       4e-501  0E+999999  -> 1.6E-1001   Subnormal
+	{"fmax2843", "4E-501", "4e-501", "0E+999999", "1.6E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2844 fma 10E-501      10e-501  0E+999999  -> 1.00E-1000  Subnormal
+	{"fmax2844", "10E-501", "10e-501", "0E+999999", "1.00E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2845 fma 30E-501      30e-501  0E+999999  -> 9.00E-1000  Subnormal
+	{"fmax2845", "30E-501", "30e-501", "0E+999999", "9.00E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2846 fma 40E-501      40e-501  0E+999999  -> 1.600E-999
+	{"fmax2846", "40E-501", "40e-501", "0E+999999", "1.600E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// cubes
+	// fmax2850 fma  1E-670     1e-335  0E+999999  -> 0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"fmax2850", "1E-670", "1e-335", "0E+999999", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// fmax2851 fma  1E-668     1e-334  0E+999999  -> 1E-1002    Subnormal
+	{"fmax2851", "1E-668", "1e-334", "0E+999999", "1E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2852 fma  4E-668     2e-334  0E+999999  -> 8E-1002    Subnormal
+	{"fmax2852", "4E-668", "2e-334", "0E+999999", "8E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2853 fma  9E-668     3e-334  0E+999999  -> 2.7E-1001  Subnormal
+	{"fmax2853", "9E-668", "3e-334", "0E+999999", "2.7E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2854 fma 16E-668     4e-334  0E+999999  -> 6.4E-1001  Subnormal
+	{"fmax2854", "16E-668", "4e-334", "0E+999999", "6.4E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2855 fma 25E-668     5e-334  0E+999999  -> 1.25E-1000 Subnormal
+	{"fmax2855", "25E-668", "5e-334", "0E+999999", "1.25E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// fmax2856 fma 10E-668   100e-334  0E+999999  -> 1.000E-999
+	{"fmax2856", "10E-668", "100e-334", "0E+999999", "1.000E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// test derived from result of 0.099 ** 999 at 15 digits with unlimited exponent
+	// precision: 19
+	// fmax2860 fma  6636851557994578716E-520 6636851557994578716E-520  0E+999999  -> 4.40477986028551E-1003 Underflow Subnormal Inexact Rounded
+	{"fmax2860", "6636851557994578716E-520", "6636851557994578716E-520", "0E+999999", "4.40477986028551E-1003", Underflow | Subnormal | Inexact | Rounded, 19, ToNearestEven, 999, -999, false},
+	// Long operand overflow may be a different path
+	// precision: 3
+	// maxexponent: 999999
+	// minexponent: -999999
+	// fmax2870 fma 1  9.999E+999999    0E+999999  ->  Infinity Inexact Overflow Rounded
+	{"fmax2870", "1", "9.999E+999999", "0E+999999", "Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999, -999999, false},
+	// fmax2871 fma 1 -9.999E+999999    0E+999999  -> -Infinity Inexact Overflow Rounded
+	{"fmax2871", "1", "-9.999E+999999", "0E+999999", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999, -999999, false},
+	// fmax2872 fma    9.999E+999999 1  0E+999999  ->  Infinity Inexact Overflow Rounded
+	{"fmax2872", "9.999E+999999", "1", "0E+999999", "Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999, -999999, false},
+	// fmax2873 fma   -9.999E+999999 1  0E+999999  -> -Infinity Inexact Overflow Rounded
+	{"fmax2873", "-9.999E+999999", "1", "0E+999999", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999, -999999, false},
+	// check for double-rounded subnormals
+	// precision: 5
+	// maxexponent: 79
+	// minexponent: -79
+	// fmax2881 fma  1.2347E-40  1.2347E-40   0E+999999  ->  1.524E-80  Inexact Rounded Subnormal Underflow
+	{"fmax2881", "1.2347E-40", "1.2347E-40", "0E+999999", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2882 fma  1.234E-40  1.234E-40     0E+999999  ->  1.523E-80  Inexact Rounded Subnormal Underflow
+	{"fmax2882", "1.234E-40", "1.234E-40", "0E+999999", "1.523E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2883 fma  1.23E-40   1.23E-40      0E+999999  ->  1.513E-80  Inexact Rounded Subnormal Underflow
+	{"fmax2883", "1.23E-40", "1.23E-40", "0E+999999", "1.513E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2884 fma  1.2E-40    1.2E-40       0E+999999  ->  1.44E-80   Subnormal
+	{"fmax2884", "1.2E-40", "1.2E-40", "0E+999999", "1.44E-80", Subnormal, 5, ToNearestEven, 79, -79, false},
+	// fmax2885 fma  1.2E-40    1.2E-41       0E+999999  ->  1.44E-81   Subnormal
+	{"fmax2885", "1.2E-40", "1.2E-41", "0E+999999", "1.44E-81", Subnormal, 5, ToNearestEven, 79, -79, false},
+	// fmax2886 fma  1.2E-40    1.2E-42       0E+999999  ->  1.4E-82    Subnormal Inexact Rounded Underflow
+	{"fmax2886", "1.2E-40", "1.2E-42", "0E+999999", "1.4E-82", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2887 fma  1.2E-40    1.3E-42       0E+999999  ->  1.6E-82    Subnormal Inexact Rounded Underflow
+	{"fmax2887", "1.2E-40", "1.3E-42", "0E+999999", "1.6E-82", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2888 fma  1.3E-40    1.3E-42       0E+999999  ->  1.7E-82    Subnormal Inexact Rounded Underflow
+	{"fmax2888", "1.3E-40", "1.3E-42", "0E+999999", "1.7E-82", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2889 fma  1.3E-40    1.3E-43       0E+999999  ->    2E-83    Subnormal Inexact Rounded Underflow
+	{"fmax2889", "1.3E-40", "1.3E-43", "0E+999999", "2E-83", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2890 fma  1.3E-41    1.3E-43       0E+999999  ->    0E-83    Clamped Subnormal Inexact Rounded Underflow
+	{"fmax2890", "1.3E-41", "1.3E-43", "0E+999999", "0E-83", Clamped | Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2891 fma  1.2345E-39   1.234E-40   0E+999999  ->  1.5234E-79 Inexact Rounded
+	{"fmax2891", "1.2345E-39", "1.234E-40", "0E+999999", "1.5234E-79", Inexact | Rounded, 5, ToNearestEven, 79, -79, false},
+	// fmax2892 fma  1.23456E-39  1.234E-40   0E+999999  ->  1.5234E-79 Inexact Rounded
+	{"fmax2892", "1.23456E-39", "1.234E-40", "0E+999999", "1.5234E-79", Inexact | Rounded, 5, ToNearestEven, 79, -79, false},
+	// fmax2893 fma  1.2345E-40   1.234E-40   0E+999999  ->  1.523E-80  Inexact Rounded Subnormal Underflow
+	{"fmax2893", "1.2345E-40", "1.234E-40", "0E+999999", "1.523E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2894 fma  1.23456E-40  1.234E-40   0E+999999  ->  1.523E-80  Inexact Rounded Subnormal Underflow
+	{"fmax2894", "1.23456E-40", "1.234E-40", "0E+999999", "1.523E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2895 fma  1.2345E-41   1.234E-40   0E+999999  ->  1.52E-81   Inexact Rounded Subnormal Underflow
+	{"fmax2895", "1.2345E-41", "1.234E-40", "0E+999999", "1.52E-81", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// fmax2896 fma  1.23456E-41  1.234E-40   0E+999999  ->  1.52E-81   Inexact Rounded Subnormal Underflow
+	{"fmax2896", "1.23456E-41", "1.234E-40", "0E+999999", "1.52E-81", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// Now explore the case where we get a normal result with Underflow
+	// precision: 16
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// fmax2900 fma  0.3000000000E-191 0.3000000000E-191  0E+999999  -> 9.00000000000000E-384 Subnormal Rounded
+	{"fmax2900", "0.3000000000E-191", "0.3000000000E-191", "0E+999999", "9.00000000000000E-384", Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2901 fma  0.3000000001E-191 0.3000000001E-191  0E+999999  -> 9.00000000600000E-384 Underflow Inexact Subnormal Rounded
+	{"fmax2901", "0.3000000001E-191", "0.3000000001E-191", "0E+999999", "9.00000000600000E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2902 fma  9.999999999999999E-383  0.0999999999999          0E+999999  -> 9.99999999999000E-384 Underflow Inexact Subnormal Rounded
+	{"fmax2902", "9.999999999999999E-383", "0.0999999999999", "0E+999999", "9.99999999999000E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2903 fma  9.999999999999999E-383  0.09999999999999         0E+999999  -> 9.99999999999900E-384 Underflow Inexact Subnormal Rounded
+	{"fmax2903", "9.999999999999999E-383", "0.09999999999999", "0E+999999", "9.99999999999900E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2904 fma  9.999999999999999E-383  0.099999999999999        0E+999999  -> 9.99999999999990E-384 Underflow Inexact Subnormal Rounded
+	{"fmax2904", "9.999999999999999E-383", "0.099999999999999", "0E+999999", "9.99999999999990E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2905 fma  9.999999999999999E-383  0.0999999999999999       0E+999999  -> 9.99999999999999E-384 Underflow Inexact Subnormal Rounded
+	{"fmax2905", "9.999999999999999E-383", "0.0999999999999999", "0E+999999", "9.99999999999999E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// prove operands are exact
+	// fmax2906 fma  9.999999999999999E-383  1                        0E+999999  -> 9.999999999999999E-383
+	{"fmax2906", "9.999999999999999E-383", "1", "0E+999999", "9.999999999999999E-383", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax2907 fma                       1  0.09999999999999999      0E+999999  -> 0.09999999999999999
+	{"fmax2907", "1", "0.09999999999999999", "0E+999999", "0.09999999999999999", 0, 16, ToNearestAway, 384, -383, false},
+	// the next rounds to Nmin
+	// fmax2908 fma  9.999999999999999E-383  0.09999999999999999      0E+999999  -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"fmax2908", "9.999999999999999E-383", "0.09999999999999999", "0E+999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2909 fma  9.999999999999999E-383  0.099999999999999999     0E+999999  -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"fmax2909", "9.999999999999999E-383", "0.099999999999999999", "0E+999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2910 fma  9.999999999999999E-383  0.0999999999999999999    0E+999999  -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"fmax2910", "9.999999999999999E-383", "0.0999999999999999999", "0E+999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax2911 fma  9.999999999999999E-383  0.09999999999999999999   0E+999999  -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"fmax2911", "9.999999999999999E-383", "0.09999999999999999999", "0E+999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// Examples from SQL proposal (Krishna Kulkarni)
+	// precision: 34
+	// rounding: half_up
+	// maxexponent: 6144
+	// minexponent: -6143
+	// fmax2921  fma 130E-2  120E-2  0E+999999  -> 1.5600
+	{"fmax2921", "130E-2", "120E-2", "0E+999999", "1.5600", 0, 34, ToNearestAway, 6144, -6143, false},
+	// fmax2922  fma 130E-2  12E-1   0E+999999  -> 1.560
+	{"fmax2922", "130E-2", "12E-1", "0E+999999", "1.560", 0, 34, ToNearestAway, 6144, -6143, false},
+	// fmax2923  fma 130E-2  1E0     0E+999999  -> 1.30
+	{"fmax2923", "130E-2", "1E0", "0E+999999", "1.30", 0, 34, ToNearestAway, 6144, -6143, false},
+	// Null tests
+	// SKIP (encoding not supported): fmax2990 fma  # 10  0E+999999  -> NaN Invalid_operation
+	// SKIP (encoding not supported): fmax2991 fma 10  #  0E+999999  -> NaN Invalid_operation
+	// ADDITION TESTS ------------------------------------------------------
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// [first group are 'quick confidence check']
+	// fmax3001 fma  1   1       1       ->  2
+	{"fmax3001", "1", "1", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3002 fma  1   2       3       ->  5
+	{"fmax3002", "1", "2", "3", "5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3003 fma  1   '5.75'  '3.3'   ->  9.05
+	{"fmax3003", "1", "5.75", "3.3", "9.05", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3004 fma  1   '5'     '-3'    ->  2
+	{"fmax3004", "1", "5", "-3", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3005 fma  1   '-5'    '-3'    ->  -8
+	{"fmax3005", "1", "-5", "-3", "-8", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3006 fma  1   '-7'    '2.5'   ->  -4.5
+	{"fmax3006", "1", "-7", "2.5", "-4.5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3007 fma  1   '0.7'   '0.3'   ->  1.0
+	{"fmax3007", "1", "0.7", "0.3", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3008 fma  1   '1.25'  '1.25'  ->  2.50
+	{"fmax3008", "1", "1.25", "1.25", "2.50", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3009 fma  1   '1.23456789'  '1.00000000' -> '2.23456789'
+	{"fmax3009", "1", "1.23456789", "1.00000000", "2.23456789", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3010 fma  1   '1.23456789'  '1.00000011' -> '2.23456800'
+	{"fmax3010", "1", "1.23456789", "1.00000011", "2.23456800", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3011 fma  1   '0.4444444444'  '0.5555555555' -> '1.00000000' Inexact Rounded
+	{"fmax3011", "1", "0.4444444444", "0.5555555555", "1.00000000", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3012 fma  1   '0.4444444440'  '0.5555555555' -> '1.00000000' Inexact Rounded
+	{"fmax3012", "1", "0.4444444440", "0.5555555555", "1.00000000", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3013 fma  1   '0.4444444444'  '0.5555555550' -> '0.999999999' Inexact Rounded
+	{"fmax3013", "1", "0.4444444444", "0.5555555550", "0.999999999", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3014 fma  1   '0.44444444449'    '0' -> '0.444444444' Inexact Rounded
+	{"fmax3014", "1", "0.44444444449", "0", "0.444444444", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3015 fma  1   '0.444444444499'   '0' -> '0.444444444' Inexact Rounded
+	{"fmax3015", "1", "0.444444444499", "0", "0.444444444", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3016 fma  1   '0.4444444444999'  '0' -> '0.444444444' Inexact Rounded
+	{"fmax3016", "1", "0.4444444444999", "0", "0.444444444", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3017 fma  1   '0.4444444445000'  '0' -> '0.444444445' Inexact Rounded
+	{"fmax3017", "1", "0.4444444445000", "0", "0.444444445", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3018 fma  1   '0.4444444445001'  '0' -> '0.444444445' Inexact Rounded
+	{"fmax3018", "1", "0.4444444445001", "0", "0.444444445", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3019 fma  1   '0.444444444501'   '0' -> '0.444444445' Inexact Rounded
+	{"fmax3019", "1", "0.444444444501", "0", "0.444444445", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3020 fma  1   '0.44444444451'    '0' -> '0.444444445' Inexact Rounded
+	{"fmax3020", "1", "0.44444444451", "0", "0.444444445", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3021 fma  1   0 1 -> 1
+	{"fmax3021", "1", "0", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3022 fma  1   1 1 -> 2
+	{"fmax3022", "1", "1", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3023 fma  1   2 1 -> 3
+	{"fmax3023", "1", "2", "1", "3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3024 fma  1   3 1 -> 4
+	{"fmax3024", "1", "3", "1", "4", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3025 fma  1   4 1 -> 5
+	{"fmax3025", "1", "4", "1", "5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3026 fma  1   5 1 -> 6
+	{"fmax3026", "1", "5", "1", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3027 fma  1   6 1 -> 7
+	{"fmax3027", "1", "6", "1", "7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3028 fma  1   7 1 -> 8
+	{"fmax3028", "1", "7", "1", "8", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3029 fma  1   8 1 -> 9
+	{"fmax3029", "1", "8", "1", "9", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3030 fma  1   9 1 -> 10
+	{"fmax3030", "1", "9", "1", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// some carrying effects
+	// fmax3031 fma  1   '0.9998'  '0.0000' -> '0.9998'
+	{"fmax3031", "1", "0.9998", "0.0000", "0.9998", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3032 fma  1   '0.9998'  '0.0001' -> '0.9999'
+	{"fmax3032", "1", "0.9998", "0.0001", "0.9999", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3033 fma  1   '0.9998'  '0.0002' -> '1.0000'
+	{"fmax3033", "1", "0.9998", "0.0002", "1.0000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3034 fma  1   '0.9998'  '0.0003' -> '1.0001'
+	{"fmax3034", "1", "0.9998", "0.0003", "1.0001", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3035 fma  1   '70'  '10000e+9' -> '1.00000000E+13' Inexact Rounded
+	{"fmax3035", "1", "70", "10000e+9", "1.00000000E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3036 fma  1   '700'  '10000e+9' -> '1.00000000E+13' Inexact Rounded
+	{"fmax3036", "1", "700", "10000e+9", "1.00000000E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3037 fma  1   '7000'  '10000e+9' -> '1.00000000E+13' Inexact Rounded
+	{"fmax3037", "1", "7000", "10000e+9", "1.00000000E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3038 fma  1   '70000'  '10000e+9' -> '1.00000001E+13' Inexact Rounded
+	{"fmax3038", "1", "70000", "10000e+9", "1.00000001E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3039 fma  1   '700000'  '10000e+9' -> '1.00000007E+13' Rounded
+	{"fmax3039", "1", "700000", "10000e+9", "1.00000007E+13", Rounded, 9, ToNearestAway, 384, -383, false},
+	// symmetry:
+	// fmax3040 fma  1   '10000e+9'  '70' -> '1.00000000E+13' Inexact Rounded
+	{"fmax3040", "1", "10000e+9", "70", "1.00000000E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3041 fma  1   '10000e+9'  '700' -> '1.00000000E+13' Inexact Rounded
+	{"fmax3041", "1", "10000e+9", "700", "1.00000000E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3042 fma  1   '10000e+9'  '7000' -> '1.00000000E+13' Inexact Rounded
+	{"fmax3042", "1", "10000e+9", "7000", "1.00000000E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3044 fma  1   '10000e+9'  '70000' -> '1.00000001E+13' Inexact Rounded
+	{"fmax3044", "1", "10000e+9", "70000", "1.00000001E+13", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3045 fma  1   '10000e+9'  '700000' -> '1.00000007E+13' Rounded
+	{"fmax3045", "1", "10000e+9", "700000", "1.00000007E+13", Rounded, 9, ToNearestAway, 384, -383, false},
+	// same, higher precision
+	// precision: 15
+	// fmax3046 fma  1   '10000e+9'  '7' -> '10000000000007'
+	{"fmax3046", "1", "10000e+9", "7", "10000000000007", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3047 fma  1   '10000e+9'  '70' -> '10000000000070'
+	{"fmax3047", "1", "10000e+9", "70", "10000000000070", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3048 fma  1   '10000e+9'  '700' -> '10000000000700'
+	{"fmax3048", "1", "10000e+9", "700", "10000000000700", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3049 fma  1   '10000e+9'  '7000' -> '10000000007000'
+	{"fmax3049", "1", "10000e+9", "7000", "10000000007000", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3050 fma  1   '10000e+9'  '70000' -> '10000000070000'
+	{"fmax3050", "1", "10000e+9", "70000", "10000000070000", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3051 fma  1   '10000e+9'  '700000' -> '10000000700000'
+	{"fmax3051", "1", "10000e+9", "700000", "10000000700000", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3052 fma  1   '10000e+9'  '7000000' -> '10000007000000'
+	{"fmax3052", "1", "10000e+9", "7000000", "10000007000000", 0, 15, ToNearestAway, 384, -383, false},
+	// examples from decarith
+	// fmax3053 fma  1   '12' '7.00' -> '19.00'
+	{"fmax3053", "1", "12", "7.00", "19.00", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3054 fma  1   '1.3' '-1.07' -> '0.23'
+	{"fmax3054", "1", "1.3", "-1.07", "0.23", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3055 fma  1   '1.3' '-1.30' -> '0.00'
+	{"fmax3055", "1", "1.3", "-1.30", "0.00", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3056 fma  1   '1.3' '-2.07' -> '-0.77'
+	{"fmax3056", "1", "1.3", "-2.07", "-0.77", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3057 fma  1   '1E+2' '1E+4' -> '1.01E+4'
+	{"fmax3057", "1", "1E+2", "1E+4", "1.01E+4", 0, 15, ToNearestAway, 384, -383, false},
+	// zero preservation
+	// precision: 6
+	// fmax3060 fma  1   '10000e+9'  '70000' -> '1.00000E+13' Inexact Rounded
+	{"fmax3060", "1", "10000e+9", "70000", "1.00000E+13", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// fmax3061 fma  1   1 '0.0001' -> '1.0001'
+	{"fmax3061", "1", "1", "0.0001", "1.0001", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3062 fma  1   1 '0.00001' -> '1.00001'
+	{"fmax3062", "1", "1", "0.00001", "1.00001", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3063 fma  1   1 '0.000001' -> '1.00000' Inexact Rounded
+	{"fmax3063", "1", "1", "0.000001", "1.00000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// fmax3064 fma  1   1 '0.0000001' -> '1.00000' Inexact Rounded
+	{"fmax3064", "1", "1", "0.0000001", "1.00000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// fmax3065 fma  1   1 '0.00000001' -> '1.00000' Inexact Rounded
+	{"fmax3065", "1", "1", "0.00000001", "1.00000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// some funny zeros [in case of bad signum]
+	// fmax3070 fma  1   1  0    -> 1
+	{"fmax3070", "1", "1", "0", "1", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3071 fma  1   1 0.    -> 1
+	{"fmax3071", "1", "1", "0.", "1", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3072 fma  1   1  .0   -> 1.0
+	{"fmax3072", "1", "1", ".0", "1.0", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3073 fma  1   1 0.0   -> 1.0
+	{"fmax3073", "1", "1", "0.0", "1.0", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3074 fma  1   1 0.00  -> 1.00
+	{"fmax3074", "1", "1", "0.00", "1.00", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3075 fma  1    0  1   -> 1
+	{"fmax3075", "1", "0", "1", "1", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3076 fma  1   0.  1   -> 1
+	{"fmax3076", "1", "0.", "1", "1", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3077 fma  1    .0 1   -> 1.0
+	{"fmax3077", "1", ".0", "1", "1.0", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3078 fma  1   0.0 1   -> 1.0
+	{"fmax3078", "1", "0.0", "1", "1.0", 0, 6, ToNearestAway, 384, -383, false},
+	// fmax3079 fma  1   0.00 1  -> 1.00
+	{"fmax3079", "1", "0.00", "1", "1.00", 0, 6, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// some carries
+	// fmax3080 fma  1   999999998 1  -> 999999999
+	{"fmax3080", "1", "999999998", "1", "999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3081 fma  1   999999999 1  -> 1.00000000E+9 Rounded
+	{"fmax3081", "1", "999999999", "1", "1.00000000E+9", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3082 fma  1    99999999 1  -> 100000000
+	{"fmax3082", "1", "99999999", "1", "100000000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3083 fma  1     9999999 1  -> 10000000
+	{"fmax3083", "1", "9999999", "1", "10000000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3084 fma  1      999999 1  -> 1000000
+	{"fmax3084", "1", "999999", "1", "1000000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3085 fma  1       99999 1  -> 100000
+	{"fmax3085", "1", "99999", "1", "100000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3086 fma  1        9999 1  -> 10000
+	{"fmax3086", "1", "9999", "1", "10000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3087 fma  1         999 1  -> 1000
+	{"fmax3087", "1", "999", "1", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3088 fma  1          99 1  -> 100
+	{"fmax3088", "1", "99", "1", "100", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3089 fma  1           9 1  -> 10
+	{"fmax3089", "1", "9", "1", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// more LHS swaps
+	// fmax3090 fma  1   '-56267E-10'   0 ->  '-0.0000056267'
+	{"fmax3090", "1", "-56267E-10", "0", "-0.0000056267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3091 fma  1   '-56267E-6'    0 ->  '-0.056267'
+	{"fmax3091", "1", "-56267E-6", "0", "-0.056267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3092 fma  1   '-56267E-5'    0 ->  '-0.56267'
+	{"fmax3092", "1", "-56267E-5", "0", "-0.56267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3093 fma  1   '-56267E-4'    0 ->  '-5.6267'
+	{"fmax3093", "1", "-56267E-4", "0", "-5.6267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3094 fma  1   '-56267E-3'    0 ->  '-56.267'
+	{"fmax3094", "1", "-56267E-3", "0", "-56.267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3095 fma  1   '-56267E-2'    0 ->  '-562.67'
+	{"fmax3095", "1", "-56267E-2", "0", "-562.67", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3096 fma  1   '-56267E-1'    0 ->  '-5626.7'
+	{"fmax3096", "1", "-56267E-1", "0", "-5626.7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3097 fma  1   '-56267E-0'    0 ->  '-56267'
+	{"fmax3097", "1", "-56267E-0", "0", "-56267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3098 fma  1   '-5E-10'       0 ->  '-5E-10'
+	{"fmax3098", "1", "-5E-10", "0", "-5E-10", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3099 fma  1   '-5E-7'        0 ->  '-5E-7'
+	{"fmax3099", "1", "-5E-7", "0", "-5E-7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3100 fma  1   '-5E-6'        0 ->  '-0.000005'
+	{"fmax3100", "1", "-5E-6", "0", "-0.000005", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3101 fma  1   '-5E-5'        0 ->  '-0.00005'
+	{"fmax3101", "1", "-5E-5", "0", "-0.00005", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3102 fma  1   '-5E-4'        0 ->  '-0.0005'
+	{"fmax3102", "1", "-5E-4", "0", "-0.0005", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3103 fma  1   '-5E-1'        0 ->  '-0.5'
+	{"fmax3103", "1", "-5E-1", "0", "-0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3104 fma  1   '-5E0'         0 ->  '-5'
+	{"fmax3104", "1", "-5E0", "0", "-5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3105 fma  1   '-5E1'         0 ->  '-50'
+	{"fmax3105", "1", "-5E1", "0", "-50", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3106 fma  1   '-5E5'         0 ->  '-500000'
+	{"fmax3106", "1", "-5E5", "0", "-500000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3107 fma  1   '-5E8'         0 ->  '-500000000'
+	{"fmax3107", "1", "-5E8", "0", "-500000000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3108 fma  1   '-5E9'         0 ->  '-5.00000000E+9'   Rounded
+	{"fmax3108", "1", "-5E9", "0", "-5.00000000E+9", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3109 fma  1   '-5E10'        0 ->  '-5.00000000E+10'  Rounded
+	{"fmax3109", "1", "-5E10", "0", "-5.00000000E+10", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3110 fma  1   '-5E11'        0 ->  '-5.00000000E+11'  Rounded
+	{"fmax3110", "1", "-5E11", "0", "-5.00000000E+11", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3111 fma  1   '-5E100'       0 ->  '-5.00000000E+100' Rounded
+	{"fmax3111", "1", "-5E100", "0", "-5.00000000E+100", Rounded, 9, ToNearestAway, 384, -383, false},
+	// more RHS swaps
+	// fmax3113 fma  1   0  '-56267E-10' ->  '-0.0000056267'
+	{"fmax3113", "1", "0", "-56267E-10", "-0.0000056267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3114 fma  1   0  '-56267E-6'  ->  '-0.056267'
+	{"fmax3114", "1", "0", "-56267E-6", "-0.056267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3116 fma  1   0  '-56267E-5'  ->  '-0.56267'
+	{"fmax3116", "1", "0", "-56267E-5", "-0.56267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3117 fma  1   0  '-56267E-4'  ->  '-5.6267'
+	{"fmax3117", "1", "0", "-56267E-4", "-5.6267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3119 fma  1   0  '-56267E-3'  ->  '-56.267'
+	{"fmax3119", "1", "0", "-56267E-3", "-56.267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3120 fma  1   0  '-56267E-2'  ->  '-562.67'
+	{"fmax3120", "1", "0", "-56267E-2", "-562.67", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3121 fma  1   0  '-56267E-1'  ->  '-5626.7'
+	{"fmax3121", "1", "0", "-56267E-1", "-5626.7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3122 fma  1   0  '-56267E-0'  ->  '-56267'
+	{"fmax3122", "1", "0", "-56267E-0", "-56267", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3123 fma  1   0  '-5E-10'     ->  '-5E-10'
+	{"fmax3123", "1", "0", "-5E-10", "-5E-10", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3124 fma  1   0  '-5E-7'      ->  '-5E-7'
+	{"fmax3124", "1", "0", "-5E-7", "-5E-7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3125 fma  1   0  '-5E-6'      ->  '-0.000005'
+	{"fmax3125", "1", "0", "-5E-6", "-0.000005", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3126 fma  1   0  '-5E-5'      ->  '-0.00005'
+	{"fmax3126", "1", "0", "-5E-5", "-0.00005", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3127 fma  1   0  '-5E-4'      ->  '-0.0005'
+	{"fmax3127", "1", "0", "-5E-4", "-0.0005", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3128 fma  1   0  '-5E-1'      ->  '-0.5'
+	{"fmax3128", "1", "0", "-5E-1", "-0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3129 fma  1   0  '-5E0'       ->  '-5'
+	{"fmax3129", "1", "0", "-5E0", "-5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3130 fma  1   0  '-5E1'       ->  '-50'
+	{"fmax3130", "1", "0", "-5E1", "-50", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3131 fma  1   0  '-5E5'       ->  '-500000'
+	{"fmax3131", "1", "0", "-5E5", "-500000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3132 fma  1   0  '-5E8'       ->  '-500000000'
+	{"fmax3132", "1", "0", "-5E8", "-500000000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3133 fma  1   0  '-5E9'       ->  '-5.00000000E+9'    Rounded
+	{"fmax3133", "1", "0", "-5E9", "-5.00000000E+9", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3134 fma  1   0  '-5E10'      ->  '-5.00000000E+10'   Rounded
+	{"fmax3134", "1", "0", "-5E10", "-5.00000000E+10", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3135 fma  1   0  '-5E11'      ->  '-5.00000000E+11'   Rounded
+	{"fmax3135", "1", "0", "-5E11", "-5.00000000E+11", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3136 fma  1   0  '-5E100'     ->  '-5.00000000E+100'  Rounded
+	{"fmax3136", "1", "0", "-5E100", "-5.00000000E+100", Rounded, 9, ToNearestAway, 384, -383, false},
+	// related
+	// fmax3137 fma  1    1  '0E-12'      ->  '1.00000000'  Rounded
+	{"fmax3137", "1", "1", "0E-12", "1.00000000", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3138 fma  1   -1  '0E-12'      ->  '-1.00000000' Rounded
+	{"fmax3138", "1", "-1", "0E-12", "-1.00000000", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3139 fma  1   '0E-12' 1        ->  '1.00000000'  Rounded
+	{"fmax3139", "1", "0E-12", "1", "1.00000000", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3140 fma  1   '0E-12' -1       ->  '-1.00000000' Rounded
+	{"fmax3140", "1", "0E-12", "-1", "-1.00000000", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3141 fma  1   1E+4    0.0000   ->  '10000.0000'
+	{"fmax3141", "1", "1E+4", "0.0000", "10000.0000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3142 fma  1   1E+4    0.00000  ->  '10000.0000'  Rounded
+	{"fmax3142", "1", "1E+4", "0.00000", "10000.0000", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3143 fma  1   0.000   1E+5     ->  '100000.000'
+	{"fmax3143", "1", "0.000", "1E+5", "100000.000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3144 fma  1   0.0000  1E+5     ->  '100000.000'  Rounded
+	{"fmax3144", "1", "0.0000", "1E+5", "100000.000", Rounded, 9, ToNearestAway, 384, -383, false},
+	// [some of the next group are really constructor tests]
+	// fmax3146 fma  1   '00.0'  0       ->  '0.0'
+	{"fmax3146", "1", "00.0", "0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3147 fma  1   '0.00'  0       ->  '0.00'
+	{"fmax3147", "1", "0.00", "0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3148 fma  1    0      '0.00'  ->  '0.00'
+	{"fmax3148", "1", "0", "0.00", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3149 fma  1    0      '00.0'  ->  '0.0'
+	{"fmax3149", "1", "0", "00.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3150 fma  1   '00.0'  '0.00'  ->  '0.00'
+	{"fmax3150", "1", "00.0", "0.00", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3151 fma  1   '0.00'  '00.0'  ->  '0.00'
+	{"fmax3151", "1", "0.00", "00.0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3152 fma  1   '3'     '.3'    ->  '3.3'
+	{"fmax3152", "1", "3", ".3", "3.3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3153 fma  1   '3.'    '.3'    ->  '3.3'
+	{"fmax3153", "1", "3.", ".3", "3.3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3154 fma  1   '3.0'   '.3'    ->  '3.3'
+	{"fmax3154", "1", "3.0", ".3", "3.3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3155 fma  1   '3.00'  '.3'    ->  '3.30'
+	{"fmax3155", "1", "3.00", ".3", "3.30", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3156 fma  1   '3'     '3'     ->  '6'
+	{"fmax3156", "1", "3", "3", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3157 fma  1   '3'     '+3'    ->  '6'
+	{"fmax3157", "1", "3", "+3", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3158 fma  1   '3'     '-3'    ->  '0'
+	{"fmax3158", "1", "3", "-3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3159 fma  1   '0.3'   '-0.3'  ->  '0.0'
+	{"fmax3159", "1", "0.3", "-0.3", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3160 fma  1   '0.03'  '-0.03' ->  '0.00'
+	{"fmax3160", "1", "0.03", "-0.03", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// try borderline precision, with carries, etc.
+	// precision: 15
+	// fmax3161 fma  1   '1E+12' '-1'    -> '999999999999'
+	{"fmax3161", "1", "1E+12", "-1", "999999999999", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3162 fma  1   '1E+12'  '1.11' -> '1000000000001.11'
+	{"fmax3162", "1", "1E+12", "1.11", "1000000000001.11", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3163 fma  1   '1.11'  '1E+12' -> '1000000000001.11'
+	{"fmax3163", "1", "1.11", "1E+12", "1000000000001.11", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3164 fma  1   '-1'    '1E+12' -> '999999999999'
+	{"fmax3164", "1", "-1", "1E+12", "999999999999", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3165 fma  1   '7E+12' '-1'    -> '6999999999999'
+	{"fmax3165", "1", "7E+12", "-1", "6999999999999", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3166 fma  1   '7E+12'  '1.11' -> '7000000000001.11'
+	{"fmax3166", "1", "7E+12", "1.11", "7000000000001.11", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3167 fma  1   '1.11'  '7E+12' -> '7000000000001.11'
+	{"fmax3167", "1", "1.11", "7E+12", "7000000000001.11", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3168 fma  1   '-1'    '7E+12' -> '6999999999999'
+	{"fmax3168", "1", "-1", "7E+12", "6999999999999", 0, 15, ToNearestAway, 384, -383, false},
+	//             123456789012345      123456789012345      1 23456789012345
+	// fmax3170 fma  1   '0.444444444444444'  '0.555555555555563' -> '1.00000000000001' Inexact Rounded
+	{"fmax3170", "1", "0.444444444444444", "0.555555555555563", "1.00000000000001", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3171 fma  1   '0.444444444444444'  '0.555555555555562' -> '1.00000000000001' Inexact Rounded
+	{"fmax3171", "1", "0.444444444444444", "0.555555555555562", "1.00000000000001", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3172 fma  1   '0.444444444444444'  '0.555555555555561' -> '1.00000000000001' Inexact Rounded
+	{"fmax3172", "1", "0.444444444444444", "0.555555555555561", "1.00000000000001", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3173 fma  1   '0.444444444444444'  '0.555555555555560' -> '1.00000000000000' Inexact Rounded
+	{"fmax3173", "1", "0.444444444444444", "0.555555555555560", "1.00000000000000", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3174 fma  1   '0.444444444444444'  '0.555555555555559' -> '1.00000000000000' Inexact Rounded
+	{"fmax3174", "1", "0.444444444444444", "0.555555555555559", "1.00000000000000", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3175 fma  1   '0.444444444444444'  '0.555555555555558' -> '1.00000000000000' Inexact Rounded
+	{"fmax3175", "1", "0.444444444444444", "0.555555555555558", "1.00000000000000", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3176 fma  1   '0.444444444444444'  '0.555555555555557' -> '1.00000000000000' Inexact Rounded
+	{"fmax3176", "1", "0.444444444444444", "0.555555555555557", "1.00000000000000", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3177 fma  1   '0.444444444444444'  '0.555555555555556' -> '1.00000000000000' Rounded
+	{"fmax3177", "1", "0.444444444444444", "0.555555555555556", "1.00000000000000", Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax3178 fma  1   '0.444444444444444'  '0.555555555555555' -> '0.999999999999999'
+	{"fmax3178", "1", "0.444444444444444", "0.555555555555555", "0.999999999999999", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3179 fma  1   '0.444444444444444'  '0.555555555555554' -> '0.999999999999998'
+	{"fmax3179", "1", "0.444444444444444", "0.555555555555554", "0.999999999999998", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3180 fma  1   '0.444444444444444'  '0.555555555555553' -> '0.999999999999997'
+	{"fmax3180", "1", "0.444444444444444", "0.555555555555553", "0.999999999999997", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3181 fma  1   '0.444444444444444'  '0.555555555555552' -> '0.999999999999996'
+	{"fmax3181", "1", "0.444444444444444", "0.555555555555552", "0.999999999999996", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3182 fma  1   '0.444444444444444'  '0.555555555555551' -> '0.999999999999995'
+	{"fmax3182", "1", "0.444444444444444", "0.555555555555551", "0.999999999999995", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax3183 fma  1   '0.444444444444444'  '0.555555555555550' -> '0.999999999999994'
+	{"fmax3183", "1", "0.444444444444444", "0.555555555555550", "0.999999999999994", 0, 15, ToNearestAway, 384, -383, false},
+	// and some more, including residue effects and different roundings
+	// precision: 9
+	// rounding: half_up
+	// fmax3200 fma  1   '123456789' 0             -> '123456789'
+	{"fmax3200", "1", "123456789", "0", "123456789", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3201 fma  1   '123456789' 0.000000001   -> '123456789' Inexact Rounded
+	{"fmax3201", "1", "123456789", "0.000000001", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3202 fma  1   '123456789' 0.000001      -> '123456789' Inexact Rounded
+	{"fmax3202", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3203 fma  1   '123456789' 0.1           -> '123456789' Inexact Rounded
+	{"fmax3203", "1", "123456789", "0.1", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3204 fma  1   '123456789' 0.4           -> '123456789' Inexact Rounded
+	{"fmax3204", "1", "123456789", "0.4", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3205 fma  1   '123456789' 0.49          -> '123456789' Inexact Rounded
+	{"fmax3205", "1", "123456789", "0.49", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3206 fma  1   '123456789' 0.499999      -> '123456789' Inexact Rounded
+	{"fmax3206", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3207 fma  1   '123456789' 0.499999999   -> '123456789' Inexact Rounded
+	{"fmax3207", "1", "123456789", "0.499999999", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3208 fma  1   '123456789' 0.5           -> '123456790' Inexact Rounded
+	{"fmax3208", "1", "123456789", "0.5", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3209 fma  1   '123456789' 0.500000001   -> '123456790' Inexact Rounded
+	{"fmax3209", "1", "123456789", "0.500000001", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3210 fma  1   '123456789' 0.500001      -> '123456790' Inexact Rounded
+	{"fmax3210", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3211 fma  1   '123456789' 0.51          -> '123456790' Inexact Rounded
+	{"fmax3211", "1", "123456789", "0.51", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3212 fma  1   '123456789' 0.6           -> '123456790' Inexact Rounded
+	{"fmax3212", "1", "123456789", "0.6", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3213 fma  1   '123456789' 0.9           -> '123456790' Inexact Rounded
+	{"fmax3213", "1", "123456789", "0.9", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3214 fma  1   '123456789' 0.99999       -> '123456790' Inexact Rounded
+	{"fmax3214", "1", "123456789", "0.99999", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3215 fma  1   '123456789' 0.999999999   -> '123456790' Inexact Rounded
+	{"fmax3215", "1", "123456789", "0.999999999", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3216 fma  1   '123456789' 1             -> '123456790'
+	{"fmax3216", "1", "123456789", "1", "123456790", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3217 fma  1   '123456789' 1.000000001   -> '123456790' Inexact Rounded
+	{"fmax3217", "1", "123456789", "1.000000001", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3218 fma  1   '123456789' 1.00001       -> '123456790' Inexact Rounded
+	{"fmax3218", "1", "123456789", "1.00001", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3219 fma  1   '123456789' 1.1           -> '123456790' Inexact Rounded
+	{"fmax3219", "1", "123456789", "1.1", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3220 fma  1   '123456789' 0             -> '123456789'
+	{"fmax3220", "1", "123456789", "0", "123456789", 0, 9, ToNearestEven, 384, -383, false},
+	// fmax3221 fma  1   '123456789' 0.000000001   -> '123456789' Inexact Rounded
+	{"fmax3221", "1", "123456789", "0.000000001", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3222 fma  1   '123456789' 0.000001      -> '123456789' Inexact Rounded
+	{"fmax3222", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3223 fma  1   '123456789' 0.1           -> '123456789' Inexact Rounded
+	{"fmax3223", "1", "123456789", "0.1", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3224 fma  1   '123456789' 0.4           -> '123456789' Inexact Rounded
+	{"fmax3224", "1", "123456789", "0.4", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3225 fma  1   '123456789' 0.49          -> '123456789' Inexact Rounded
+	{"fmax3225", "1", "123456789", "0.49", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3226 fma  1   '123456789' 0.499999      -> '123456789' Inexact Rounded
+	{"fmax3226", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3227 fma  1   '123456789' 0.499999999   -> '123456789' Inexact Rounded
+	{"fmax3227", "1", "123456789", "0.499999999", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3228 fma  1   '123456789' 0.5           -> '123456790' Inexact Rounded
+	{"fmax3228", "1", "123456789", "0.5", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3229 fma  1   '123456789' 0.500000001   -> '123456790' Inexact Rounded
+	{"fmax3229", "1", "123456789", "0.500000001", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3230 fma  1   '123456789' 0.500001      -> '123456790' Inexact Rounded
+	{"fmax3230", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3231 fma  1   '123456789' 0.51          -> '123456790' Inexact Rounded
+	{"fmax3231", "1", "123456789", "0.51", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3232 fma  1   '123456789' 0.6           -> '123456790' Inexact Rounded
+	{"fmax3232", "1", "123456789", "0.6", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3233 fma  1   '123456789' 0.9           -> '123456790' Inexact Rounded
+	{"fmax3233", "1", "123456789", "0.9", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3234 fma  1   '123456789' 0.99999       -> '123456790' Inexact Rounded
+	{"fmax3234", "1", "123456789", "0.99999", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3235 fma  1   '123456789' 0.999999999   -> '123456790' Inexact Rounded
+	{"fmax3235", "1", "123456789", "0.999999999", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3236 fma  1   '123456789' 1             -> '123456790'
+	{"fmax3236", "1", "123456789", "1", "123456790", 0, 9, ToNearestEven, 384, -383, false},
+	// fmax3237 fma  1   '123456789' 1.00000001    -> '123456790' Inexact Rounded
+	{"fmax3237", "1", "123456789", "1.00000001", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3238 fma  1   '123456789' 1.00001       -> '123456790' Inexact Rounded
+	{"fmax3238", "1", "123456789", "1.00001", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3239 fma  1   '123456789' 1.1           -> '123456790' Inexact Rounded
+	{"fmax3239", "1", "123456789", "1.1", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// critical few with even bottom digit...
+	// fmax3240 fma  1   '123456788' 0.499999999   -> '123456788' Inexact Rounded
+	{"fmax3240", "1", "123456788", "0.499999999", "123456788", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3241 fma  1   '123456788' 0.5           -> '123456788' Inexact Rounded
+	{"fmax3241", "1", "123456788", "0.5", "123456788", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// fmax3242 fma  1   '123456788' 0.500000001   -> '123456789' Inexact Rounded
+	{"fmax3242", "1", "123456788", "0.500000001", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: down
+	// fmax3250 fma  1   '123456789' 0             -> '123456789'
+	{"fmax3250", "1", "123456789", "0", "123456789", 0, 9, ToZero, 384, -383, false},
+	// fmax3251 fma  1   '123456789' 0.000000001   -> '123456789' Inexact Rounded
+	{"fmax3251", "1", "123456789", "0.000000001", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3252 fma  1   '123456789' 0.000001      -> '123456789' Inexact Rounded
+	{"fmax3252", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3253 fma  1   '123456789' 0.1           -> '123456789' Inexact Rounded
+	{"fmax3253", "1", "123456789", "0.1", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3254 fma  1   '123456789' 0.4           -> '123456789' Inexact Rounded
+	{"fmax3254", "1", "123456789", "0.4", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3255 fma  1   '123456789' 0.49          -> '123456789' Inexact Rounded
+	{"fmax3255", "1", "123456789", "0.49", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3256 fma  1   '123456789' 0.499999      -> '123456789' Inexact Rounded
+	{"fmax3256", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3257 fma  1   '123456789' 0.499999999   -> '123456789' Inexact Rounded
+	{"fmax3257", "1", "123456789", "0.499999999", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3258 fma  1   '123456789' 0.5           -> '123456789' Inexact Rounded
+	{"fmax3258", "1", "123456789", "0.5", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3259 fma  1   '123456789' 0.500000001   -> '123456789' Inexact Rounded
+	{"fmax3259", "1", "123456789", "0.500000001", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3260 fma  1   '123456789' 0.500001      -> '123456789' Inexact Rounded
+	{"fmax3260", "1", "123456789", "0.500001", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3261 fma  1   '123456789' 0.51          -> '123456789' Inexact Rounded
+	{"fmax3261", "1", "123456789", "0.51", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3262 fma  1   '123456789' 0.6           -> '123456789' Inexact Rounded
+	{"fmax3262", "1", "123456789", "0.6", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3263 fma  1   '123456789' 0.9           -> '123456789' Inexact Rounded
+	{"fmax3263", "1", "123456789", "0.9", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3264 fma  1   '123456789' 0.99999       -> '123456789' Inexact Rounded
+	{"fmax3264", "1", "123456789", "0.99999", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3265 fma  1   '123456789' 0.999999999   -> '123456789' Inexact Rounded
+	{"fmax3265", "1", "123456789", "0.999999999", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3266 fma  1   '123456789' 1             -> '123456790'
+	{"fmax3266", "1", "123456789", "1", "123456790", 0, 9, ToZero, 384, -383, false},
+	// fmax3267 fma  1   '123456789' 1.00000001    -> '123456790' Inexact Rounded
+	{"fmax3267", "1", "123456789", "1.00000001", "123456790", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3268 fma  1   '123456789' 1.00001       -> '123456790' Inexact Rounded
+	{"fmax3268", "1", "123456789", "1.00001", "123456790", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// fmax3269 fma  1   '123456789' 1.1           -> '123456790' Inexact Rounded
+	{"fmax3269", "1", "123456789", "1.1", "123456790", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// input preparation tests (operands should not be rounded)
+	// precision: 3
+	// rounding: half_up
+	// fmax3270 fma  1   '12345678900000'  9999999999999 ->  '2.23E+13' Inexact Rounded
+	{"fmax3270", "1", "12345678900000", "9999999999999", "2.23E+13", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3271 fma  1    '9999999999999' 12345678900000 ->  '2.23E+13' Inexact Rounded
+	{"fmax3271", "1", "9999999999999", "12345678900000", "2.23E+13", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3272 fma  1   '12E+3'  '3444'   ->  '1.54E+4' Inexact Rounded
+	{"fmax3272", "1", "12E+3", "3444", "1.54E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3273 fma  1   '12E+3'  '3446'   ->  '1.54E+4' Inexact Rounded
+	{"fmax3273", "1", "12E+3", "3446", "1.54E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3274 fma  1   '12E+3'  '3449.9' ->  '1.54E+4' Inexact Rounded
+	{"fmax3274", "1", "12E+3", "3449.9", "1.54E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3275 fma  1   '12E+3'  '3450.0' ->  '1.55E+4' Inexact Rounded
+	{"fmax3275", "1", "12E+3", "3450.0", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3276 fma  1   '12E+3'  '3450.1' ->  '1.55E+4' Inexact Rounded
+	{"fmax3276", "1", "12E+3", "3450.1", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3277 fma  1   '12E+3'  '3454'   ->  '1.55E+4' Inexact Rounded
+	{"fmax3277", "1", "12E+3", "3454", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3278 fma  1   '12E+3'  '3456'   ->  '1.55E+4' Inexact Rounded
+	{"fmax3278", "1", "12E+3", "3456", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3281 fma  1   '3444'   '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3281", "1", "3444", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3282 fma  1   '3446'   '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3282", "1", "3446", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3283 fma  1   '3449.9' '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3283", "1", "3449.9", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3284 fma  1   '3450.0' '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3284", "1", "3450.0", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3285 fma  1   '3450.1' '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3285", "1", "3450.1", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3286 fma  1   '3454'   '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3286", "1", "3454", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// fmax3287 fma  1   '3456'   '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3287", "1", "3456", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// rounding: half_down
+	// fmax3291 fma  1   '3444'   '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3291", "1", "3444", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// fmax3292 fma  1   '3446'   '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3292", "1", "3446", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// fmax3293 fma  1   '3449.9' '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3293", "1", "3449.9", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// fmax3294 fma  1   '3450.0' '12E+3'  ->  '1.54E+4' Inexact Rounded
+	{"fmax3294", "1", "3450.0", "12E+3", "1.54E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// fmax3295 fma  1   '3450.1' '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3295", "1", "3450.1", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// fmax3296 fma  1   '3454'   '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3296", "1", "3454", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// fmax3297 fma  1   '3456'   '12E+3'  ->  '1.55E+4' Inexact Rounded
+	{"fmax3297", "1", "3456", "12E+3", "1.55E+4", Inexact | Rounded, 3, ToNearestZero, 384, -383, false},
+	// 1 in last place tests
+	// rounding: half_up
+	// fmax3301 fma  1    -1   1      ->   0
+	{"fmax3301", "1", "-1", "1", "0", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3302 fma  1     0   1      ->   1
+	{"fmax3302", "1", "0", "1", "1", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3303 fma  1     1   1      ->   2
+	{"fmax3303", "1", "1", "1", "2", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3304 fma  1    12   1      ->  13
+	{"fmax3304", "1", "12", "1", "13", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3305 fma  1    98   1      ->  99
+	{"fmax3305", "1", "98", "1", "99", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3306 fma  1    99   1      -> 100
+	{"fmax3306", "1", "99", "1", "100", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3307 fma  1   100   1      -> 101
+	{"fmax3307", "1", "100", "1", "101", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3308 fma  1   101   1      -> 102
+	{"fmax3308", "1", "101", "1", "102", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3309 fma  1    -1  -1      ->  -2
+	{"fmax3309", "1", "-1", "-1", "-2", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3310 fma  1     0  -1      ->  -1
+	{"fmax3310", "1", "0", "-1", "-1", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3311 fma  1     1  -1      ->   0
+	{"fmax3311", "1", "1", "-1", "0", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3312 fma  1    12  -1      ->  11
+	{"fmax3312", "1", "12", "-1", "11", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3313 fma  1    98  -1      ->  97
+	{"fmax3313", "1", "98", "-1", "97", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3314 fma  1    99  -1      ->  98
+	{"fmax3314", "1", "99", "-1", "98", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3315 fma  1   100  -1      ->  99
+	{"fmax3315", "1", "100", "-1", "99", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3316 fma  1   101  -1      -> 100
+	{"fmax3316", "1", "101", "-1", "100", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3321 fma  1   -0.01  0.01    ->  0.00
+	{"fmax3321", "1", "-0.01", "0.01", "0.00", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3322 fma  1    0.00  0.01    ->  0.01
+	{"fmax3322", "1", "0.00", "0.01", "0.01", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3323 fma  1    0.01  0.01    ->  0.02
+	{"fmax3323", "1", "0.01", "0.01", "0.02", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3324 fma  1    0.12  0.01    ->  0.13
+	{"fmax3324", "1", "0.12", "0.01", "0.13", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3325 fma  1    0.98  0.01    ->  0.99
+	{"fmax3325", "1", "0.98", "0.01", "0.99", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3326 fma  1    0.99  0.01    ->  1.00
+	{"fmax3326", "1", "0.99", "0.01", "1.00", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3327 fma  1    1.00  0.01    ->  1.01
+	{"fmax3327", "1", "1.00", "0.01", "1.01", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3328 fma  1    1.01  0.01    ->  1.02
+	{"fmax3328", "1", "1.01", "0.01", "1.02", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3329 fma  1   -0.01 -0.01    -> -0.02
+	{"fmax3329", "1", "-0.01", "-0.01", "-0.02", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3330 fma  1    0.00 -0.01    -> -0.01
+	{"fmax3330", "1", "0.00", "-0.01", "-0.01", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3331 fma  1    0.01 -0.01    ->  0.00
+	{"fmax3331", "1", "0.01", "-0.01", "0.00", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3332 fma  1    0.12 -0.01    ->  0.11
+	{"fmax3332", "1", "0.12", "-0.01", "0.11", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3333 fma  1    0.98 -0.01    ->  0.97
+	{"fmax3333", "1", "0.98", "-0.01", "0.97", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3334 fma  1    0.99 -0.01    ->  0.98
+	{"fmax3334", "1", "0.99", "-0.01", "0.98", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3335 fma  1    1.00 -0.01    ->  0.99
+	{"fmax3335", "1", "1.00", "-0.01", "0.99", 0, 3, ToNearestAway, 384, -383, false},
+	// fmax3336 fma  1    1.01 -0.01    ->  1.00
+	{"fmax3336", "1", "1.01", "-0.01", "1.00", 0, 3, ToNearestAway, 384, -383, false},
+	// some more cases where fma  1  ing 0 affects the coefficient
+	// precision: 9
+	// fmax3340 fma  1   1E+3    0    ->         1000
+	{"fmax3340", "1", "1E+3", "0", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3341 fma  1   1E+8    0    ->    100000000
+	{"fmax3341", "1", "1E+8", "0", "100000000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3342 fma  1   1E+9    0    ->   1.00000000E+9   Rounded
+	{"fmax3342", "1", "1E+9", "0", "1.00000000E+9", Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3343 fma  1   1E+10   0    ->   1.00000000E+10  Rounded
+	{"fmax3343", "1", "1E+10", "0", "1.00000000E+10", Rounded, 9, ToNearestAway, 384, -383, false},
+	// which simply follow from these cases ...
+	// fmax3344 fma  1   1E+3    1    ->         1001
+	{"fmax3344", "1", "1E+3", "1", "1001", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3345 fma  1   1E+8    1    ->    100000001
+	{"fmax3345", "1", "1E+8", "1", "100000001", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3346 fma  1   1E+9    1    ->   1.00000000E+9   Inexact Rounded
+	{"fmax3346", "1", "1E+9", "1", "1.00000000E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3347 fma  1   1E+10   1    ->   1.00000000E+10  Inexact Rounded
+	{"fmax3347", "1", "1E+10", "1", "1.00000000E+10", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3348 fma  1   1E+3    7    ->         1007
+	{"fmax3348", "1", "1E+3", "7", "1007", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3349 fma  1   1E+8    7    ->    100000007
+	{"fmax3349", "1", "1E+8", "7", "100000007", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3350 fma  1   1E+9    7    ->   1.00000001E+9   Inexact Rounded
+	{"fmax3350", "1", "1E+9", "7", "1.00000001E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// fmax3351 fma  1   1E+10   7    ->   1.00000000E+10  Inexact Rounded
+	{"fmax3351", "1", "1E+10", "7", "1.00000000E+10", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// tryzeros cases
+	// precision: 7
+	// rounding: half_up
+	// maxexponent: 92
+	// minexponent: -92
+	// fmax3361  fma  1   0E+50 10000E+1  -> 1.0000E+5
+	{"fmax3361", "1", "0E+50", "10000E+1", "1.0000E+5", 0, 7, ToNearestAway, 92, -92, false},
+	// fmax3362  fma  1   10000E+1 0E-50  -> 100000.0  Rounded
+	{"fmax3362", "1", "10000E+1", "0E-50", "100000.0", Rounded, 7, ToNearestAway, 92, -92, false},
+	// fmax3363  fma  1   10000E+1 10000E-50  -> 100000.0  Rounded Inexact
+	{"fmax3363", "1", "10000E+1", "10000E-50", "100000.0", Rounded | Inexact, 7, ToNearestAway, 92, -92, false},
+	// fmax3364  fma  1   9.999999E+92 -9.999999E+92 -> 0E+86
+	{"fmax3364", "1", "9.999999E+92", "-9.999999E+92", "0E+86", 0, 7, ToNearestAway, 92, -92, false},
+	// a curiosity from JSR 13 testing
+	// rounding: half_down
+	// precision: 10
+	// fmax3370 fma  1   99999999 81512 -> 100081511
+	{"fmax3370", "1", "99999999", "81512", "100081511", 0, 10, ToNearestZero, 92, -92, false},
+	// precision: 6
+	// fmax3371 fma  1   99999999 81512 -> 1.00082E+8 Rounded Inexact
+	{"fmax3371", "1", "99999999", "81512", "1.00082E+8", Rounded | Inexact, 6, ToNearestZero, 92, -92, false},
+	// rounding: half_up
+	// precision: 10
+	// fmax3372 fma  1   99999999 81512 -> 100081511
+	{"fmax3372", "1", "99999999", "81512", "100081511", 0, 10, ToNearestAway, 92, -92, false},
+	// precision: 6
+	// fmax3373 fma  1   99999999 81512 -> 1.00082E+8 Rounded Inexact
+	{"fmax3373", "1", "99999999", "81512", "1.00082E+8", Rounded | Inexact, 6, ToNearestAway, 92, -92, false},
+	// rounding: half_even
+	// precision: 10
+	// fmax3374 fma  1   99999999 81512 -> 100081511
+	{"fmax3374", "1", "99999999", "81512", "100081511", 0, 10, ToNearestEven, 92, -92, false},
+	// precision: 6
+	// fmax3375 fma  1   99999999 81512 -> 1.00082E+8 Rounded Inexact
+	{"fmax3375", "1", "99999999", "81512", "1.00082E+8", Rounded | Inexact, 6, ToNearestEven, 92, -92, false},
+	// ulp replacement tests
+	// precision: 9
+	// maxexponent: 999999
+	// minexponent: -999999
+	// fmax3400 fma  1     1   77e-7       ->  1.0000077
+	{"fmax3400", "1", "1", "77e-7", "1.0000077", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3401 fma  1     1   77e-8       ->  1.00000077
+	{"fmax3401", "1", "1", "77e-8", "1.00000077", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3402 fma  1     1   77e-9       ->  1.00000008 Inexact Rounded
+	{"fmax3402", "1", "1", "77e-9", "1.00000008", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3403 fma  1     1   77e-10      ->  1.00000001 Inexact Rounded
+	{"fmax3403", "1", "1", "77e-10", "1.00000001", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3404 fma  1     1   77e-11      ->  1.00000000 Inexact Rounded
+	{"fmax3404", "1", "1", "77e-11", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3405 fma  1     1   77e-12      ->  1.00000000 Inexact Rounded
+	{"fmax3405", "1", "1", "77e-12", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3406 fma  1     1   77e-999     ->  1.00000000 Inexact Rounded
+	{"fmax3406", "1", "1", "77e-999", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3407 fma  1     1   77e-999999  ->  1.00000000 Inexact Rounded
+	{"fmax3407", "1", "1", "77e-999999", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3410 fma  1    10   77e-7       ->  10.0000077
+	{"fmax3410", "1", "10", "77e-7", "10.0000077", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3411 fma  1    10   77e-8       ->  10.0000008 Inexact Rounded
+	{"fmax3411", "1", "10", "77e-8", "10.0000008", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3412 fma  1    10   77e-9       ->  10.0000001 Inexact Rounded
+	{"fmax3412", "1", "10", "77e-9", "10.0000001", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3413 fma  1    10   77e-10      ->  10.0000000 Inexact Rounded
+	{"fmax3413", "1", "10", "77e-10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3414 fma  1    10   77e-11      ->  10.0000000 Inexact Rounded
+	{"fmax3414", "1", "10", "77e-11", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3415 fma  1    10   77e-12      ->  10.0000000 Inexact Rounded
+	{"fmax3415", "1", "10", "77e-12", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3416 fma  1    10   77e-999     ->  10.0000000 Inexact Rounded
+	{"fmax3416", "1", "10", "77e-999", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3417 fma  1    10   77e-999999  ->  10.0000000 Inexact Rounded
+	{"fmax3417", "1", "10", "77e-999999", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3420 fma  1    77e-7        1   ->  1.0000077
+	{"fmax3420", "1", "77e-7", "1", "1.0000077", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3421 fma  1    77e-8        1   ->  1.00000077
+	{"fmax3421", "1", "77e-8", "1", "1.00000077", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3422 fma  1    77e-9        1   ->  1.00000008 Inexact Rounded
+	{"fmax3422", "1", "77e-9", "1", "1.00000008", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3423 fma  1    77e-10       1   ->  1.00000001 Inexact Rounded
+	{"fmax3423", "1", "77e-10", "1", "1.00000001", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3424 fma  1    77e-11       1   ->  1.00000000 Inexact Rounded
+	{"fmax3424", "1", "77e-11", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3425 fma  1    77e-12       1   ->  1.00000000 Inexact Rounded
+	{"fmax3425", "1", "77e-12", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3426 fma  1    77e-999      1   ->  1.00000000 Inexact Rounded
+	{"fmax3426", "1", "77e-999", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3427 fma  1    77e-999999   1   ->  1.00000000 Inexact Rounded
+	{"fmax3427", "1", "77e-999999", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3430 fma  1    77e-7       10   ->  10.0000077
+	{"fmax3430", "1", "77e-7", "10", "10.0000077", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3431 fma  1    77e-8       10   ->  10.0000008 Inexact Rounded
+	{"fmax3431", "1", "77e-8", "10", "10.0000008", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3432 fma  1    77e-9       10   ->  10.0000001 Inexact Rounded
+	{"fmax3432", "1", "77e-9", "10", "10.0000001", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3433 fma  1    77e-10      10   ->  10.0000000 Inexact Rounded
+	{"fmax3433", "1", "77e-10", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3434 fma  1    77e-11      10   ->  10.0000000 Inexact Rounded
+	{"fmax3434", "1", "77e-11", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3435 fma  1    77e-12      10   ->  10.0000000 Inexact Rounded
+	{"fmax3435", "1", "77e-12", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3436 fma  1    77e-999     10   ->  10.0000000 Inexact Rounded
+	{"fmax3436", "1", "77e-999", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3437 fma  1    77e-999999  10   ->  10.0000000 Inexact Rounded
+	{"fmax3437", "1", "77e-999999", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// negative ulps
+	// fmax3440 fma  1     1   -77e-7       ->  0.9999923
+	{"fmax3440", "1", "1", "-77e-7", "0.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3441 fma  1     1   -77e-8       ->  0.99999923
+	{"fmax3441", "1", "1", "-77e-8", "0.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3442 fma  1     1   -77e-9       ->  0.999999923
+	{"fmax3442", "1", "1", "-77e-9", "0.999999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3443 fma  1     1   -77e-10      ->  0.999999992 Inexact Rounded
+	{"fmax3443", "1", "1", "-77e-10", "0.999999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3444 fma  1     1   -77e-11      ->  0.999999999 Inexact Rounded
+	{"fmax3444", "1", "1", "-77e-11", "0.999999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3445 fma  1     1   -77e-12      ->  1.00000000 Inexact Rounded
+	{"fmax3445", "1", "1", "-77e-12", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3446 fma  1     1   -77e-999     ->  1.00000000 Inexact Rounded
+	{"fmax3446", "1", "1", "-77e-999", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3447 fma  1     1   -77e-999999  ->  1.00000000 Inexact Rounded
+	{"fmax3447", "1", "1", "-77e-999999", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3450 fma  1    10   -77e-7       ->   9.9999923
+	{"fmax3450", "1", "10", "-77e-7", "9.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3451 fma  1    10   -77e-8       ->   9.99999923
+	{"fmax3451", "1", "10", "-77e-8", "9.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3452 fma  1    10   -77e-9       ->   9.99999992 Inexact Rounded
+	{"fmax3452", "1", "10", "-77e-9", "9.99999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3453 fma  1    10   -77e-10      ->   9.99999999 Inexact Rounded
+	{"fmax3453", "1", "10", "-77e-10", "9.99999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3454 fma  1    10   -77e-11      ->  10.0000000 Inexact Rounded
+	{"fmax3454", "1", "10", "-77e-11", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3455 fma  1    10   -77e-12      ->  10.0000000 Inexact Rounded
+	{"fmax3455", "1", "10", "-77e-12", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3456 fma  1    10   -77e-999     ->  10.0000000 Inexact Rounded
+	{"fmax3456", "1", "10", "-77e-999", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3457 fma  1    10   -77e-999999  ->  10.0000000 Inexact Rounded
+	{"fmax3457", "1", "10", "-77e-999999", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3460 fma  1    -77e-7        1   ->  0.9999923
+	{"fmax3460", "1", "-77e-7", "1", "0.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3461 fma  1    -77e-8        1   ->  0.99999923
+	{"fmax3461", "1", "-77e-8", "1", "0.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3462 fma  1    -77e-9        1   ->  0.999999923
+	{"fmax3462", "1", "-77e-9", "1", "0.999999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3463 fma  1    -77e-10       1   ->  0.999999992 Inexact Rounded
+	{"fmax3463", "1", "-77e-10", "1", "0.999999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3464 fma  1    -77e-11       1   ->  0.999999999 Inexact Rounded
+	{"fmax3464", "1", "-77e-11", "1", "0.999999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3465 fma  1    -77e-12       1   ->  1.00000000 Inexact Rounded
+	{"fmax3465", "1", "-77e-12", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3466 fma  1    -77e-999      1   ->  1.00000000 Inexact Rounded
+	{"fmax3466", "1", "-77e-999", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3467 fma  1    -77e-999999   1   ->  1.00000000 Inexact Rounded
+	{"fmax3467", "1", "-77e-999999", "1", "1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3470 fma  1    -77e-7       10   ->   9.9999923
+	{"fmax3470", "1", "-77e-7", "10", "9.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3471 fma  1    -77e-8       10   ->   9.99999923
+	{"fmax3471", "1", "-77e-8", "10", "9.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3472 fma  1    -77e-9       10   ->   9.99999992 Inexact Rounded
+	{"fmax3472", "1", "-77e-9", "10", "9.99999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3473 fma  1    -77e-10      10   ->   9.99999999 Inexact Rounded
+	{"fmax3473", "1", "-77e-10", "10", "9.99999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3474 fma  1    -77e-11      10   ->  10.0000000 Inexact Rounded
+	{"fmax3474", "1", "-77e-11", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3475 fma  1    -77e-12      10   ->  10.0000000 Inexact Rounded
+	{"fmax3475", "1", "-77e-12", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3476 fma  1    -77e-999     10   ->  10.0000000 Inexact Rounded
+	{"fmax3476", "1", "-77e-999", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3477 fma  1    -77e-999999  10   ->  10.0000000 Inexact Rounded
+	{"fmax3477", "1", "-77e-999999", "10", "10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// negative ulps
+	// fmax3480 fma  1    -1    77e-7       ->  -0.9999923
+	{"fmax3480", "1", "-1", "77e-7", "-0.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3481 fma  1    -1    77e-8       ->  -0.99999923
+	{"fmax3481", "1", "-1", "77e-8", "-0.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3482 fma  1    -1    77e-9       ->  -0.999999923
+	{"fmax3482", "1", "-1", "77e-9", "-0.999999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3483 fma  1    -1    77e-10      ->  -0.999999992 Inexact Rounded
+	{"fmax3483", "1", "-1", "77e-10", "-0.999999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3484 fma  1    -1    77e-11      ->  -0.999999999 Inexact Rounded
+	{"fmax3484", "1", "-1", "77e-11", "-0.999999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3485 fma  1    -1    77e-12      ->  -1.00000000 Inexact Rounded
+	{"fmax3485", "1", "-1", "77e-12", "-1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3486 fma  1    -1    77e-999     ->  -1.00000000 Inexact Rounded
+	{"fmax3486", "1", "-1", "77e-999", "-1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3487 fma  1    -1    77e-999999  ->  -1.00000000 Inexact Rounded
+	{"fmax3487", "1", "-1", "77e-999999", "-1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3490 fma  1   -10    77e-7       ->   -9.9999923
+	{"fmax3490", "1", "-10", "77e-7", "-9.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3491 fma  1   -10    77e-8       ->   -9.99999923
+	{"fmax3491", "1", "-10", "77e-8", "-9.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3492 fma  1   -10    77e-9       ->   -9.99999992 Inexact Rounded
+	{"fmax3492", "1", "-10", "77e-9", "-9.99999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3493 fma  1   -10    77e-10      ->   -9.99999999 Inexact Rounded
+	{"fmax3493", "1", "-10", "77e-10", "-9.99999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3494 fma  1   -10    77e-11      ->  -10.0000000 Inexact Rounded
+	{"fmax3494", "1", "-10", "77e-11", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3495 fma  1   -10    77e-12      ->  -10.0000000 Inexact Rounded
+	{"fmax3495", "1", "-10", "77e-12", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3496 fma  1   -10    77e-999     ->  -10.0000000 Inexact Rounded
+	{"fmax3496", "1", "-10", "77e-999", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3497 fma  1   -10    77e-999999  ->  -10.0000000 Inexact Rounded
+	{"fmax3497", "1", "-10", "77e-999999", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3500 fma  1     77e-7       -1   ->  -0.9999923
+	{"fmax3500", "1", "77e-7", "-1", "-0.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3501 fma  1     77e-8       -1   ->  -0.99999923
+	{"fmax3501", "1", "77e-8", "-1", "-0.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3502 fma  1     77e-9       -1   ->  -0.999999923
+	{"fmax3502", "1", "77e-9", "-1", "-0.999999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3503 fma  1     77e-10      -1   ->  -0.999999992 Inexact Rounded
+	{"fmax3503", "1", "77e-10", "-1", "-0.999999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3504 fma  1     77e-11      -1   ->  -0.999999999 Inexact Rounded
+	{"fmax3504", "1", "77e-11", "-1", "-0.999999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3505 fma  1     77e-12      -1   ->  -1.00000000 Inexact Rounded
+	{"fmax3505", "1", "77e-12", "-1", "-1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3506 fma  1     77e-999     -1   ->  -1.00000000 Inexact Rounded
+	{"fmax3506", "1", "77e-999", "-1", "-1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3507 fma  1     77e-999999  -1   ->  -1.00000000 Inexact Rounded
+	{"fmax3507", "1", "77e-999999", "-1", "-1.00000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3510 fma  1     77e-7       -10  ->   -9.9999923
+	{"fmax3510", "1", "77e-7", "-10", "-9.9999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3511 fma  1     77e-8       -10  ->   -9.99999923
+	{"fmax3511", "1", "77e-8", "-10", "-9.99999923", 0, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3512 fma  1     77e-9       -10  ->   -9.99999992 Inexact Rounded
+	{"fmax3512", "1", "77e-9", "-10", "-9.99999992", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3513 fma  1     77e-10      -10  ->   -9.99999999 Inexact Rounded
+	{"fmax3513", "1", "77e-10", "-10", "-9.99999999", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3514 fma  1     77e-11      -10  ->  -10.0000000 Inexact Rounded
+	{"fmax3514", "1", "77e-11", "-10", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3515 fma  1     77e-12      -10  ->  -10.0000000 Inexact Rounded
+	{"fmax3515", "1", "77e-12", "-10", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3516 fma  1     77e-999     -10  ->  -10.0000000 Inexact Rounded
+	{"fmax3516", "1", "77e-999", "-10", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// fmax3517 fma  1     77e-999999  -10  ->  -10.0000000 Inexact Rounded
+	{"fmax3517", "1", "77e-999999", "-10", "-10.0000000", Inexact | Rounded, 9, ToNearestEven, 999999, -999999, false},
+	// long operands
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// fmax3521 fma  1   12345678000 0 -> 1.23456780E+10 Rounded
+	{"fmax3521", "1", "12345678000", "0", "1.23456780E+10", Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3522 fma  1   0 12345678000 -> 1.23456780E+10 Rounded
+	{"fmax3522", "1", "0", "12345678000", "1.23456780E+10", Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3523 fma  1   1234567800  0 -> 1.23456780E+9 Rounded
+	{"fmax3523", "1", "1234567800", "0", "1.23456780E+9", Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3524 fma  1   0 1234567800  -> 1.23456780E+9 Rounded
+	{"fmax3524", "1", "0", "1234567800", "1.23456780E+9", Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3525 fma  1   1234567890  0 -> 1.23456789E+9 Rounded
+	{"fmax3525", "1", "1234567890", "0", "1.23456789E+9", Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3526 fma  1   0 1234567890  -> 1.23456789E+9 Rounded
+	{"fmax3526", "1", "0", "1234567890", "1.23456789E+9", Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3527 fma  1   1234567891  0 -> 1.23456789E+9 Inexact Rounded
+	{"fmax3527", "1", "1234567891", "0", "1.23456789E+9", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3528 fma  1   0 1234567891  -> 1.23456789E+9 Inexact Rounded
+	{"fmax3528", "1", "0", "1234567891", "1.23456789E+9", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3529 fma  1   12345678901 0 -> 1.23456789E+10 Inexact Rounded
+	{"fmax3529", "1", "12345678901", "0", "1.23456789E+10", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3530 fma  1   0 12345678901 -> 1.23456789E+10 Inexact Rounded
+	{"fmax3530", "1", "0", "12345678901", "1.23456789E+10", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3531 fma  1   1234567896  0 -> 1.23456790E+9 Inexact Rounded
+	{"fmax3531", "1", "1234567896", "0", "1.23456790E+9", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// fmax3532 fma  1   0 1234567896  -> 1.23456790E+9 Inexact Rounded
+	{"fmax3532", "1", "0", "1234567896", "1.23456790E+9", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// precision: 15
+	// still checking
+	// fmax3541 fma  1   12345678000 0 -> 12345678000
+	{"fmax3541", "1", "12345678000", "0", "12345678000", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3542 fma  1   0 12345678000 -> 12345678000
+	{"fmax3542", "1", "0", "12345678000", "12345678000", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3543 fma  1   1234567800  0 -> 1234567800
+	{"fmax3543", "1", "1234567800", "0", "1234567800", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3544 fma  1   0 1234567800  -> 1234567800
+	{"fmax3544", "1", "0", "1234567800", "1234567800", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3545 fma  1   1234567890  0 -> 1234567890
+	{"fmax3545", "1", "1234567890", "0", "1234567890", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3546 fma  1   0 1234567890  -> 1234567890
+	{"fmax3546", "1", "0", "1234567890", "1234567890", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3547 fma  1   1234567891  0 -> 1234567891
+	{"fmax3547", "1", "1234567891", "0", "1234567891", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3548 fma  1   0 1234567891  -> 1234567891
+	{"fmax3548", "1", "0", "1234567891", "1234567891", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3549 fma  1   12345678901 0 -> 12345678901
+	{"fmax3549", "1", "12345678901", "0", "12345678901", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3550 fma  1   0 12345678901 -> 12345678901
+	{"fmax3550", "1", "0", "12345678901", "12345678901", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3551 fma  1   1234567896  0 -> 1234567896
+	{"fmax3551", "1", "1234567896", "0", "1234567896", 0, 15, ToNearestEven, 999, -999, false},
+	// fmax3552 fma  1   0 1234567896  -> 1234567896
+	{"fmax3552", "1", "0", "1234567896", "1234567896", 0, 15, ToNearestEven, 999, -999, false},
+	// verify a query
+	// precision: 16
+	// maxexponent: +394
+	// minexponent: -393
+	// rounding: down
+	// fmax3561 fma  1   1e-398 9.000000000000000E+384 -> 9.000000000000000E+384 Inexact Rounded
+	{"fmax3561", "1", "1e-398", "9.000000000000000E+384", "9.000000000000000E+384", Inexact | Rounded, 16, ToZero, 394, -393, false},
+	// fmax3562 fma  1        0 9.000000000000000E+384 -> 9.000000000000000E+384 Rounded
+	{"fmax3562", "1", "0", "9.000000000000000E+384", "9.000000000000000E+384", Rounded, 16, ToZero, 394, -393, false},
+	// and using decimal64 bounds...
+	// precision: 16
+	// maxexponent: +384
+	// minexponent: -383
+	// rounding: down
+	// fmax3563 fma  1   1e-388 9.000000000000000E+374 -> 9.000000000000000E+374 Inexact Rounded
+	{"fmax3563", "1", "1e-388", "9.000000000000000E+374", "9.000000000000000E+374", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax3564 fma  1        0 9.000000000000000E+374 -> 9.000000000000000E+374 Rounded
+	{"fmax3564", "1", "0", "9.000000000000000E+374", "9.000000000000000E+374", Rounded, 16, ToZero, 384, -383, false},
+	// some more residue effects with extreme rounding
+	// precision: 9
+	// rounding: half_up
+	// fmax3601 fma  1   123456789  0.000001 -> 123456789 Inexact Rounded
+	{"fmax3601", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3602 fma  1   123456789  0.000001 -> 123456789 Inexact Rounded
+	{"fmax3602", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: half_down
+	// fmax3603 fma  1   123456789  0.000001 -> 123456789 Inexact Rounded
+	{"fmax3603", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToNearestZero, 384, -383, false},
+	// rounding: floor
+	// fmax3604 fma  1   123456789  0.000001 -> 123456789 Inexact Rounded
+	{"fmax3604", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToNegativeInf, 384, -383, false},
+	// rounding: ceiling
+	// fmax3605 fma  1   123456789  0.000001 -> 123456790 Inexact Rounded
+	{"fmax3605", "1", "123456789", "0.000001", "123456790", Inexact | Rounded, 9, ToPositiveInf, 384, -383, false},
+	// rounding: up
+	// fmax3606 fma  1   123456789  0.000001 -> 123456790 Inexact Rounded
+	{"fmax3606", "1", "123456789", "0.000001", "123456790", Inexact | Rounded, 9, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// fmax3607 fma  1   123456789  0.000001 -> 123456789 Inexact Rounded
+	{"fmax3607", "1", "123456789", "0.000001", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// rounding: half_up
+	// fmax3611 fma  1   123456789 -0.000001 -> 123456789 Inexact Rounded
+	{"fmax3611", "1", "123456789", "-0.000001", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3612 fma  1   123456789 -0.000001 -> 123456789 Inexact Rounded
+	{"fmax3612", "1", "123456789", "-0.000001", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: half_down
+	// fmax3613 fma  1   123456789 -0.000001 -> 123456789 Inexact Rounded
+	{"fmax3613", "1", "123456789", "-0.000001", "123456789", Inexact | Rounded, 9, ToNearestZero, 384, -383, false},
+	// rounding: floor
+	// fmax3614 fma  1   123456789 -0.000001 -> 123456788 Inexact Rounded
+	{"fmax3614", "1", "123456789", "-0.000001", "123456788", Inexact | Rounded, 9, ToNegativeInf, 384, -383, false},
+	// rounding: ceiling
+	// fmax3615 fma  1   123456789 -0.000001 -> 123456789 Inexact Rounded
+	{"fmax3615", "1", "123456789", "-0.000001", "123456789", Inexact | Rounded, 9, ToPositiveInf, 384, -383, false},
+	// rounding: up
+	// fmax3616 fma  1   123456789 -0.000001 -> 123456789 Inexact Rounded
+	{"fmax3616", "1", "123456789", "-0.000001", "123456789", Inexact | Rounded, 9, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// fmax3617 fma  1   123456789 -0.000001 -> 123456788 Inexact Rounded
+	{"fmax3617", "1", "123456789", "-0.000001", "123456788", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// rounding: half_up
+	// fmax3621 fma  1   123456789  0.499999 -> 123456789 Inexact Rounded
+	{"fmax3621", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3622 fma  1   123456789  0.499999 -> 123456789 Inexact Rounded
+	{"fmax3622", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: half_down
+	// fmax3623 fma  1   123456789  0.499999 -> 123456789 Inexact Rounded
+	{"fmax3623", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToNearestZero, 384, -383, false},
+	// rounding: floor
+	// fmax3624 fma  1   123456789  0.499999 -> 123456789 Inexact Rounded
+	{"fmax3624", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToNegativeInf, 384, -383, false},
+	// rounding: ceiling
+	// fmax3625 fma  1   123456789  0.499999 -> 123456790 Inexact Rounded
+	{"fmax3625", "1", "123456789", "0.499999", "123456790", Inexact | Rounded, 9, ToPositiveInf, 384, -383, false},
+	// rounding: up
+	// fmax3626 fma  1   123456789  0.499999 -> 123456790 Inexact Rounded
+	{"fmax3626", "1", "123456789", "0.499999", "123456790", Inexact | Rounded, 9, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// fmax3627 fma  1   123456789  0.499999 -> 123456789 Inexact Rounded
+	{"fmax3627", "1", "123456789", "0.499999", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// rounding: half_up
+	// fmax3631 fma  1   123456789 -0.499999 -> 123456789 Inexact Rounded
+	{"fmax3631", "1", "123456789", "-0.499999", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3632 fma  1   123456789 -0.499999 -> 123456789 Inexact Rounded
+	{"fmax3632", "1", "123456789", "-0.499999", "123456789", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: half_down
+	// fmax3633 fma  1   123456789 -0.499999 -> 123456789 Inexact Rounded
+	{"fmax3633", "1", "123456789", "-0.499999", "123456789", Inexact | Rounded, 9, ToNearestZero, 384, -383, false},
+	// rounding: floor
+	// fmax3634 fma  1   123456789 -0.499999 -> 123456788 Inexact Rounded
+	{"fmax3634", "1", "123456789", "-0.499999", "123456788", Inexact | Rounded, 9, ToNegativeInf, 384, -383, false},
+	// rounding: ceiling
+	// fmax3635 fma  1   123456789 -0.499999 -> 123456789 Inexact Rounded
+	{"fmax3635", "1", "123456789", "-0.499999", "123456789", Inexact | Rounded, 9, ToPositiveInf, 384, -383, false},
+	// rounding: up
+	// fmax3636 fma  1   123456789 -0.499999 -> 123456789 Inexact Rounded
+	{"fmax3636", "1", "123456789", "-0.499999", "123456789", Inexact | Rounded, 9, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// fmax3637 fma  1   123456789 -0.499999 -> 123456788 Inexact Rounded
+	{"fmax3637", "1", "123456789", "-0.499999", "123456788", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// rounding: half_up
+	// fmax3641 fma  1   123456789  0.500001 -> 123456790 Inexact Rounded
+	{"fmax3641", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3642 fma  1   123456789  0.500001 -> 123456790 Inexact Rounded
+	{"fmax3642", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: half_down
+	// fmax3643 fma  1   123456789  0.500001 -> 123456790 Inexact Rounded
+	{"fmax3643", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, ToNearestZero, 384, -383, false},
+	// rounding: floor
+	// fmax3644 fma  1   123456789  0.500001 -> 123456789 Inexact Rounded
+	{"fmax3644", "1", "123456789", "0.500001", "123456789", Inexact | Rounded, 9, ToNegativeInf, 384, -383, false},
+	// rounding: ceiling
+	// fmax3645 fma  1   123456789  0.500001 -> 123456790 Inexact Rounded
+	{"fmax3645", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, ToPositiveInf, 384, -383, false},
+	// rounding: up
+	// fmax3646 fma  1   123456789  0.500001 -> 123456790 Inexact Rounded
+	{"fmax3646", "1", "123456789", "0.500001", "123456790", Inexact | Rounded, 9, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// fmax3647 fma  1   123456789  0.500001 -> 123456789 Inexact Rounded
+	{"fmax3647", "1", "123456789", "0.500001", "123456789", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// rounding: half_up
+	// fmax3651 fma  1   123456789 -0.500001 -> 123456788 Inexact Rounded
+	{"fmax3651", "1", "123456789", "-0.500001", "123456788", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax3652 fma  1   123456789 -0.500001 -> 123456788 Inexact Rounded
+	{"fmax3652", "1", "123456789", "-0.500001", "123456788", Inexact | Rounded, 9, ToNearestEven, 384, -383, false},
+	// rounding: half_down
+	// fmax3653 fma  1   123456789 -0.500001 -> 123456788 Inexact Rounded
+	{"fmax3653", "1", "123456789", "-0.500001", "123456788", Inexact | Rounded, 9, ToNearestZero, 384, -383, false},
+	// rounding: floor
+	// fmax3654 fma  1   123456789 -0.500001 -> 123456788 Inexact Rounded
+	{"fmax3654", "1", "123456789", "-0.500001", "123456788", Inexact | Rounded, 9, ToNegativeInf, 384, -383, false},
+	// rounding: ceiling
+	// fmax3655 fma  1   123456789 -0.500001 -> 123456789 Inexact Rounded
+	{"fmax3655", "1", "123456789", "-0.500001", "123456789", Inexact | Rounded, 9, ToPositiveInf, 384, -383, false},
+	// rounding: up
+	// fmax3656 fma  1   123456789 -0.500001 -> 123456789 Inexact Rounded
+	{"fmax3656", "1", "123456789", "-0.500001", "123456789", Inexact | Rounded, 9, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// fmax3657 fma  1   123456789 -0.500001 -> 123456788 Inexact Rounded
+	{"fmax3657", "1", "123456789", "-0.500001", "123456788", Inexact | Rounded, 9, ToZero, 384, -383, false},
+	// long operand triangle
+	// rounding: half_up
+	// precision: 37
+	// fmax3660 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211023638922337114834538
+	{"fmax3660", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211023638922337114834538", 0, 37, ToNearestAway, 384, -383, false},
+	// precision: 36
+	// fmax3661 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102363892233711483454  Inexact Rounded
+	{"fmax3661", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102363892233711483454", Inexact | Rounded, 36, ToNearestAway, 384, -383, false},
+	// precision: 35
+	// fmax3662 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110236389223371148345   Inexact Rounded
+	{"fmax3662", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110236389223371148345", Inexact | Rounded, 35, ToNearestAway, 384, -383, false},
+	// precision: 34
+	// fmax3663 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211023638922337114835    Inexact Rounded
+	{"fmax3663", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211023638922337114835", Inexact | Rounded, 34, ToNearestAway, 384, -383, false},
+	// precision: 33
+	// fmax3664 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102363892233711483     Inexact Rounded
+	{"fmax3664", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102363892233711483", Inexact | Rounded, 33, ToNearestAway, 384, -383, false},
+	// precision: 32
+	// fmax3665 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110236389223371148      Inexact Rounded
+	{"fmax3665", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110236389223371148", Inexact | Rounded, 32, ToNearestAway, 384, -383, false},
+	// precision: 31
+	// fmax3666 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211023638922337115       Inexact Rounded
+	{"fmax3666", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211023638922337115", Inexact | Rounded, 31, ToNearestAway, 384, -383, false},
+	// precision: 30
+	// fmax3667 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102363892233711        Inexact Rounded
+	{"fmax3667", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102363892233711", Inexact | Rounded, 30, ToNearestAway, 384, -383, false},
+	// precision: 29
+	// fmax3668 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110236389223371         Inexact Rounded
+	{"fmax3668", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110236389223371", Inexact | Rounded, 29, ToNearestAway, 384, -383, false},
+	// precision: 28
+	// fmax3669 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211023638922337          Inexact Rounded
+	{"fmax3669", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211023638922337", Inexact | Rounded, 28, ToNearestAway, 384, -383, false},
+	// precision: 27
+	// fmax3670 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102363892234           Inexact Rounded
+	{"fmax3670", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102363892234", Inexact | Rounded, 27, ToNearestAway, 384, -383, false},
+	// precision: 26
+	// fmax3671 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110236389223            Inexact Rounded
+	{"fmax3671", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110236389223", Inexact | Rounded, 26, ToNearestAway, 384, -383, false},
+	// precision: 25
+	// fmax3672 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211023638922             Inexact Rounded
+	{"fmax3672", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211023638922", Inexact | Rounded, 25, ToNearestAway, 384, -383, false},
+	// precision: 24
+	// fmax3673 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102363892              Inexact Rounded
+	{"fmax3673", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102363892", Inexact | Rounded, 24, ToNearestAway, 384, -383, false},
+	// precision: 23
+	// fmax3674 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110236389               Inexact Rounded
+	{"fmax3674", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110236389", Inexact | Rounded, 23, ToNearestAway, 384, -383, false},
+	// precision: 22
+	// fmax3675 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211023639                Inexact Rounded
+	{"fmax3675", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211023639", Inexact | Rounded, 22, ToNearestAway, 384, -383, false},
+	// precision: 21
+	// fmax3676 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102364                 Inexact Rounded
+	{"fmax3676", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102364", Inexact | Rounded, 21, ToNearestAway, 384, -383, false},
+	// precision: 20
+	// fmax3677 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110236                  Inexact Rounded
+	{"fmax3677", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110236", Inexact | Rounded, 20, ToNearestAway, 384, -383, false},
+	// precision: 19
+	// fmax3678 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211024                   Inexact Rounded
+	{"fmax3678", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211024", Inexact | Rounded, 19, ToNearestAway, 384, -383, false},
+	// precision: 18
+	// fmax3679 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221102                    Inexact Rounded
+	{"fmax3679", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221102", Inexact | Rounded, 18, ToNearestAway, 384, -383, false},
+	// precision: 17
+	// fmax3680 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422110                     Inexact Rounded
+	{"fmax3680", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422110", Inexact | Rounded, 17, ToNearestAway, 384, -383, false},
+	// precision: 16
+	// fmax3681 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42211                      Inexact Rounded
+	{"fmax3681", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42211", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// precision: 15
+	// fmax3682 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4221                       Inexact Rounded
+	{"fmax3682", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4221", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// precision: 14
+	// fmax3683 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.422                        Inexact Rounded
+	{"fmax3683", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.422", Inexact | Rounded, 14, ToNearestAway, 384, -383, false},
+	// precision: 13
+	// fmax3684 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.42                         Inexact Rounded
+	{"fmax3684", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.42", Inexact | Rounded, 13, ToNearestAway, 384, -383, false},
+	// precision: 12
+	// fmax3685 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166.4                          Inexact Rounded
+	{"fmax3685", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166.4", Inexact | Rounded, 12, ToNearestAway, 384, -383, false},
+	// precision: 11
+	// fmax3686 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 98471174166                            Inexact Rounded
+	{"fmax3686", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "98471174166", Inexact | Rounded, 11, ToNearestAway, 384, -383, false},
+	// precision: 10
+	// fmax3687 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.847117417E+10                        Inexact Rounded
+	{"fmax3687", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.847117417E+10", Inexact | Rounded, 10, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// fmax3688 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.84711742E+10                         Inexact Rounded
+	{"fmax3688", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.84711742E+10", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// precision: 8
+	// fmax3689 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.8471174E+10                          Inexact Rounded
+	{"fmax3689", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.8471174E+10", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// precision: 7
+	// fmax3690 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.847117E+10                          Inexact Rounded
+	{"fmax3690", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.847117E+10", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// fmax3691 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.84712E+10                          Inexact Rounded
+	{"fmax3691", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.84712E+10", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// precision: 5
+	// fmax3692 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.8471E+10                          Inexact Rounded
+	{"fmax3692", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.8471E+10", Inexact | Rounded, 5, ToNearestAway, 384, -383, false},
+	// precision: 4
+	// fmax3693 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.847E+10                          Inexact Rounded
+	{"fmax3693", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.847E+10", Inexact | Rounded, 4, ToNearestAway, 384, -383, false},
+	// precision: 3
+	// fmax3694 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.85E+10                          Inexact Rounded
+	{"fmax3694", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.85E+10", Inexact | Rounded, 3, ToNearestAway, 384, -383, false},
+	// precision: 2
+	// fmax3695 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 9.8E+10                          Inexact Rounded
+	{"fmax3695", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "9.8E+10", Inexact | Rounded, 2, ToNearestAway, 384, -383, false},
+	// precision: 1
+	// fmax3696 fma  1   98471198160.56524417578665886060 -23994.14313393939743548945165462 -> 1E+11                          Inexact Rounded
+	{"fmax3696", "1", "98471198160.56524417578665886060", "-23994.14313393939743548945165462", "1E+11", Inexact | Rounded, 1, ToNearestAway, 384, -383, false},
+	// more zeros, etc.
+	// rounding: half_up
+	// precision: 9
+	// fmax3701 fma  1   5.00 1.00E-3 -> 5.00100
+	{"fmax3701", "1", "5.00", "1.00E-3", "5.00100", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3702 fma  1   00.00 0.000  -> 0.000
+	{"fmax3702", "1", "00.00", "0.000", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3703 fma  1   00.00 0E-3   -> 0.000
+	{"fmax3703", "1", "00.00", "0E-3", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3704 fma  1   0E-3  00.00  -> 0.000
+	{"fmax3704", "1", "0E-3", "00.00", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3710 fma  1   0E+3  00.00  -> 0.00
+	{"fmax3710", "1", "0E+3", "00.00", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3711 fma  1   0E+3  00.0   -> 0.0
+	{"fmax3711", "1", "0E+3", "00.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3712 fma  1   0E+3  00.    -> 0
+	{"fmax3712", "1", "0E+3", "00.", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3713 fma  1   0E+3  00.E+1 -> 0E+1
+	{"fmax3713", "1", "0E+3", "00.E+1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3714 fma  1   0E+3  00.E+2 -> 0E+2
+	{"fmax3714", "1", "0E+3", "00.E+2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3715 fma  1   0E+3  00.E+3 -> 0E+3
+	{"fmax3715", "1", "0E+3", "00.E+3", "0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3716 fma  1   0E+3  00.E+4 -> 0E+3
+	{"fmax3716", "1", "0E+3", "00.E+4", "0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3717 fma  1   0E+3  00.E+5 -> 0E+3
+	{"fmax3717", "1", "0E+3", "00.E+5", "0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3718 fma  1   0E+3  -00.0   -> 0.0
+	{"fmax3718", "1", "0E+3", "-00.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3719 fma  1   0E+3  -00.    -> 0
+	{"fmax3719", "1", "0E+3", "-00.", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3731 fma  1   0E+3  -00.E+1 -> 0E+1
+	{"fmax3731", "1", "0E+3", "-00.E+1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3720 fma  1   00.00  0E+3  -> 0.00
+	{"fmax3720", "1", "00.00", "0E+3", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3721 fma  1   00.0   0E+3  -> 0.0
+	{"fmax3721", "1", "00.0", "0E+3", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3722 fma  1   00.    0E+3  -> 0
+	{"fmax3722", "1", "00.", "0E+3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3723 fma  1   00.E+1 0E+3  -> 0E+1
+	{"fmax3723", "1", "00.E+1", "0E+3", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3724 fma  1   00.E+2 0E+3  -> 0E+2
+	{"fmax3724", "1", "00.E+2", "0E+3", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3725 fma  1   00.E+3 0E+3  -> 0E+3
+	{"fmax3725", "1", "00.E+3", "0E+3", "0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3726 fma  1   00.E+4 0E+3  -> 0E+3
+	{"fmax3726", "1", "00.E+4", "0E+3", "0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3727 fma  1   00.E+5 0E+3  -> 0E+3
+	{"fmax3727", "1", "00.E+5", "0E+3", "0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3728 fma  1   -00.00 0E+3  -> 0.00
+	{"fmax3728", "1", "-00.00", "0E+3", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3729 fma  1   -00.0  0E+3  -> 0.0
+	{"fmax3729", "1", "-00.0", "0E+3", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3730 fma  1   -00.   0E+3  -> 0
+	{"fmax3730", "1", "-00.", "0E+3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3732 fma  1    0     0     ->  0
+	{"fmax3732", "1", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3733 fma  1    0    -0     ->  0
+	{"fmax3733", "1", "0", "-0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3734 fma  1   -0     0     ->  0
+	{"fmax3734", "1", "-0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3735 fma  1   -0    -0     -> -0     -- IEEE 854 special case
+	{"fmax3735", "1", "-0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3736 fma  1    1    -1     ->  0
+	{"fmax3736", "1", "1", "-1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3737 fma  1   -1    -1     -> -2
+	{"fmax3737", "1", "-1", "-1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3738 fma  1    1     1     ->  2
+	{"fmax3738", "1", "1", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3739 fma  1   -1     1     ->  0
+	{"fmax3739", "1", "-1", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3741 fma  1    0    -1     -> -1
+	{"fmax3741", "1", "0", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3742 fma  1   -0    -1     -> -1
+	{"fmax3742", "1", "-0", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3743 fma  1    0     1     ->  1
+	{"fmax3743", "1", "0", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3744 fma  1   -0     1     ->  1
+	{"fmax3744", "1", "-0", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3745 fma  1   -1     0     -> -1
+	{"fmax3745", "1", "-1", "0", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3746 fma  1   -1    -0     -> -1
+	{"fmax3746", "1", "-1", "-0", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3747 fma  1    1     0     ->  1
+	{"fmax3747", "1", "1", "0", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3748 fma  1    1    -0     ->  1
+	{"fmax3748", "1", "1", "-0", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3751 fma  1    0.0  -1     -> -1.0
+	{"fmax3751", "1", "0.0", "-1", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3752 fma  1   -0.0  -1     -> -1.0
+	{"fmax3752", "1", "-0.0", "-1", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3753 fma  1    0.0   1     ->  1.0
+	{"fmax3753", "1", "0.0", "1", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3754 fma  1   -0.0   1     ->  1.0
+	{"fmax3754", "1", "-0.0", "1", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3755 fma  1   -1.0   0     -> -1.0
+	{"fmax3755", "1", "-1.0", "0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3756 fma  1   -1.0  -0     -> -1.0
+	{"fmax3756", "1", "-1.0", "-0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3757 fma  1    1.0   0     ->  1.0
+	{"fmax3757", "1", "1.0", "0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3758 fma  1    1.0  -0     ->  1.0
+	{"fmax3758", "1", "1.0", "-0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3761 fma  1    0    -1.0   -> -1.0
+	{"fmax3761", "1", "0", "-1.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3762 fma  1   -0    -1.0   -> -1.0
+	{"fmax3762", "1", "-0", "-1.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3763 fma  1    0     1.0   ->  1.0
+	{"fmax3763", "1", "0", "1.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3764 fma  1   -0     1.0   ->  1.0
+	{"fmax3764", "1", "-0", "1.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3765 fma  1   -1     0.0   -> -1.0
+	{"fmax3765", "1", "-1", "0.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3766 fma  1   -1    -0.0   -> -1.0
+	{"fmax3766", "1", "-1", "-0.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3767 fma  1    1     0.0   ->  1.0
+	{"fmax3767", "1", "1", "0.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3768 fma  1    1    -0.0   ->  1.0
+	{"fmax3768", "1", "1", "-0.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3771 fma  1    0.0  -1.0   -> -1.0
+	{"fmax3771", "1", "0.0", "-1.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3772 fma  1   -0.0  -1.0   -> -1.0
+	{"fmax3772", "1", "-0.0", "-1.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3773 fma  1    0.0   1.0   ->  1.0
+	{"fmax3773", "1", "0.0", "1.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3774 fma  1   -0.0   1.0   ->  1.0
+	{"fmax3774", "1", "-0.0", "1.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3775 fma  1   -1.0   0.0   -> -1.0
+	{"fmax3775", "1", "-1.0", "0.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3776 fma  1   -1.0  -0.0   -> -1.0
+	{"fmax3776", "1", "-1.0", "-0.0", "-1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3777 fma  1    1.0   0.0   ->  1.0
+	{"fmax3777", "1", "1.0", "0.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3778 fma  1    1.0  -0.0   ->  1.0
+	{"fmax3778", "1", "1.0", "-0.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// Specials
+	// fmax3780 fma  1   -Inf  -Inf   -> -Infinity
+	{"fmax3780", "1", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3781 fma  1   -Inf  -1000  -> -Infinity
+	{"fmax3781", "1", "-Inf", "-1000", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3782 fma  1   -Inf  -1     -> -Infinity
+	{"fmax3782", "1", "-Inf", "-1", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3783 fma  1   -Inf  -0     -> -Infinity
+	{"fmax3783", "1", "-Inf", "-0", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3784 fma  1   -Inf   0     -> -Infinity
+	{"fmax3784", "1", "-Inf", "0", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3785 fma  1   -Inf   1     -> -Infinity
+	{"fmax3785", "1", "-Inf", "1", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3786 fma  1   -Inf   1000  -> -Infinity
+	{"fmax3786", "1", "-Inf", "1000", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3787 fma  1   -1000 -Inf   -> -Infinity
+	{"fmax3787", "1", "-1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3788 fma  1   -Inf  -Inf   -> -Infinity
+	{"fmax3788", "1", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3789 fma  1   -1    -Inf   -> -Infinity
+	{"fmax3789", "1", "-1", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3790 fma  1   -0    -Inf   -> -Infinity
+	{"fmax3790", "1", "-0", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3791 fma  1    0    -Inf   -> -Infinity
+	{"fmax3791", "1", "0", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3792 fma  1    1    -Inf   -> -Infinity
+	{"fmax3792", "1", "1", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3793 fma  1    1000 -Inf   -> -Infinity
+	{"fmax3793", "1", "1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3794 fma  1    Inf  -Inf   ->  NaN  Invalid_operation
+	{"fmax3794", "1", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3800 fma  1    Inf  -Inf   ->  NaN  Invalid_operation
+	{"fmax3800", "1", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3801 fma  1    Inf  -1000  ->  Infinity
+	{"fmax3801", "1", "Inf", "-1000", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3802 fma  1    Inf  -1     ->  Infinity
+	{"fmax3802", "1", "Inf", "-1", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3803 fma  1    Inf  -0     ->  Infinity
+	{"fmax3803", "1", "Inf", "-0", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3804 fma  1    Inf   0     ->  Infinity
+	{"fmax3804", "1", "Inf", "0", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3805 fma  1    Inf   1     ->  Infinity
+	{"fmax3805", "1", "Inf", "1", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3806 fma  1    Inf   1000  ->  Infinity
+	{"fmax3806", "1", "Inf", "1000", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3807 fma  1    Inf   Inf   ->  Infinity
+	{"fmax3807", "1", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3808 fma  1   -1000  Inf   ->  Infinity
+	{"fmax3808", "1", "-1000", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3809 fma  1   -Inf   Inf   ->  NaN  Invalid_operation
+	{"fmax3809", "1", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3810 fma  1   -1     Inf   ->  Infinity
+	{"fmax3810", "1", "-1", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3811 fma  1   -0     Inf   ->  Infinity
+	{"fmax3811", "1", "-0", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3812 fma  1    0     Inf   ->  Infinity
+	{"fmax3812", "1", "0", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3813 fma  1    1     Inf   ->  Infinity
+	{"fmax3813", "1", "1", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3814 fma  1    1000  Inf   ->  Infinity
+	{"fmax3814", "1", "1000", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3815 fma  1    Inf   Inf   ->  Infinity
+	{"fmax3815", "1", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3821 fma  1    NaN -Inf    ->  NaN
+	{"fmax3821", "1", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3822 fma  1    NaN -1000   ->  NaN
+	{"fmax3822", "1", "NaN", "-1000", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3823 fma  1    NaN -1      ->  NaN
+	{"fmax3823", "1", "NaN", "-1", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3824 fma  1    NaN -0      ->  NaN
+	{"fmax3824", "1", "NaN", "-0", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3825 fma  1    NaN  0      ->  NaN
+	{"fmax3825", "1", "NaN", "0", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3826 fma  1    NaN  1      ->  NaN
+	{"fmax3826", "1", "NaN", "1", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3827 fma  1    NaN  1000   ->  NaN
+	{"fmax3827", "1", "NaN", "1000", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3828 fma  1    NaN  Inf    ->  NaN
+	{"fmax3828", "1", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3829 fma  1    NaN  NaN    ->  NaN
+	{"fmax3829", "1", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3830 fma  1   -Inf  NaN    ->  NaN
+	{"fmax3830", "1", "-Inf", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3831 fma  1   -1000 NaN    ->  NaN
+	{"fmax3831", "1", "-1000", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3832 fma  1   -1    NaN    ->  NaN
+	{"fmax3832", "1", "-1", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3833 fma  1   -0    NaN    ->  NaN
+	{"fmax3833", "1", "-0", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3834 fma  1    0    NaN    ->  NaN
+	{"fmax3834", "1", "0", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3835 fma  1    1    NaN    ->  NaN
+	{"fmax3835", "1", "1", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3836 fma  1    1000 NaN    ->  NaN
+	{"fmax3836", "1", "1000", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3837 fma  1    Inf  NaN    ->  NaN
+	{"fmax3837", "1", "Inf", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3841 fma  1    sNaN -Inf   ->  NaN  Invalid_operation
+	{"fmax3841", "1", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3842 fma  1    sNaN -1000  ->  NaN  Invalid_operation
+	{"fmax3842", "1", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3843 fma  1    sNaN -1     ->  NaN  Invalid_operation
+	{"fmax3843", "1", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3844 fma  1    sNaN -0     ->  NaN  Invalid_operation
+	{"fmax3844", "1", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3845 fma  1    sNaN  0     ->  NaN  Invalid_operation
+	{"fmax3845", "1", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3846 fma  1    sNaN  1     ->  NaN  Invalid_operation
+	{"fmax3846", "1", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3847 fma  1    sNaN  1000  ->  NaN  Invalid_operation
+	{"fmax3847", "1", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3848 fma  1    sNaN  NaN   ->  NaN  Invalid_operation
+	{"fmax3848", "1", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3849 fma  1    sNaN sNaN   ->  NaN  Invalid_operation
+	{"fmax3849", "1", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3850 fma  1    NaN  sNaN   ->  NaN  Invalid_operation
+	{"fmax3850", "1", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3851 fma  1   -Inf  sNaN   ->  NaN  Invalid_operation
+	{"fmax3851", "1", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3852 fma  1   -1000 sNaN   ->  NaN  Invalid_operation
+	{"fmax3852", "1", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3853 fma  1   -1    sNaN   ->  NaN  Invalid_operation
+	{"fmax3853", "1", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3854 fma  1   -0    sNaN   ->  NaN  Invalid_operation
+	{"fmax3854", "1", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3855 fma  1    0    sNaN   ->  NaN  Invalid_operation
+	{"fmax3855", "1", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3856 fma  1    1    sNaN   ->  NaN  Invalid_operation
+	{"fmax3856", "1", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3857 fma  1    1000 sNaN   ->  NaN  Invalid_operation
+	{"fmax3857", "1", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3858 fma  1    Inf  sNaN   ->  NaN  Invalid_operation
+	{"fmax3858", "1", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3859 fma  1    NaN  sNaN   ->  NaN  Invalid_operation
+	{"fmax3859", "1", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// fmax3861 fma  1    NaN1   -Inf    ->  NaN1
+	{"fmax3861", "1", "NaN1", "-Inf", "NaN1", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3862 fma  1   +NaN2   -1000   ->  NaN2
+	{"fmax3862", "1", "+NaN2", "-1000", "NaN2", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3863 fma  1    NaN3    1000   ->  NaN3
+	{"fmax3863", "1", "NaN3", "1000", "NaN3", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3864 fma  1    NaN4    Inf    ->  NaN4
+	{"fmax3864", "1", "NaN4", "Inf", "NaN4", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3865 fma  1    NaN5   +NaN6   ->  NaN5
+	{"fmax3865", "1", "NaN5", "+NaN6", "NaN5", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3866 fma  1   -Inf     NaN7   ->  NaN7
+	{"fmax3866", "1", "-Inf", "NaN7", "NaN7", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3867 fma  1   -1000    NaN8   ->  NaN8
+	{"fmax3867", "1", "-1000", "NaN8", "NaN8", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3868 fma  1    1000    NaN9   ->  NaN9
+	{"fmax3868", "1", "1000", "NaN9", "NaN9", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3869 fma  1    Inf    +NaN10  ->  NaN10
+	{"fmax3869", "1", "Inf", "+NaN10", "NaN10", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3871 fma  1    sNaN11  -Inf   ->  NaN11  Invalid_operation
+	{"fmax3871", "1", "sNaN11", "-Inf", "NaN11", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3872 fma  1    sNaN12  -1000  ->  NaN12  Invalid_operation
+	{"fmax3872", "1", "sNaN12", "-1000", "NaN12", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3873 fma  1    sNaN13   1000  ->  NaN13  Invalid_operation
+	{"fmax3873", "1", "sNaN13", "1000", "NaN13", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3874 fma  1    sNaN14   NaN17 ->  NaN14  Invalid_operation
+	{"fmax3874", "1", "sNaN14", "NaN17", "NaN14", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3875 fma  1    sNaN15  sNaN18 ->  NaN15  Invalid_operation
+	{"fmax3875", "1", "sNaN15", "sNaN18", "NaN15", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3876 fma  1    NaN16   sNaN19 ->  NaN19  Invalid_operation
+	{"fmax3876", "1", "NaN16", "sNaN19", "NaN19", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3877 fma  1   -Inf    +sNaN20 ->  NaN20  Invalid_operation
+	{"fmax3877", "1", "-Inf", "+sNaN20", "NaN20", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3878 fma  1   -1000    sNaN21 ->  NaN21  Invalid_operation
+	{"fmax3878", "1", "-1000", "sNaN21", "NaN21", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3879 fma  1    1000    sNaN22 ->  NaN22  Invalid_operation
+	{"fmax3879", "1", "1000", "sNaN22", "NaN22", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3880 fma  1    Inf     sNaN23 ->  NaN23  Invalid_operation
+	{"fmax3880", "1", "Inf", "sNaN23", "NaN23", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3881 fma  1   +NaN25  +sNaN24 ->  NaN24  Invalid_operation
+	{"fmax3881", "1", "+NaN25", "+sNaN24", "NaN24", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3882 fma  1   -NaN26    NaN28 -> -NaN26
+	{"fmax3882", "1", "-NaN26", "NaN28", "-NaN26", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3883 fma  1   -sNaN27  sNaN29 -> -NaN27  Invalid_operation
+	{"fmax3883", "1", "-sNaN27", "sNaN29", "-NaN27", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// fmax3884 fma  1    1000    -NaN30 -> -NaN30
+	{"fmax3884", "1", "1000", "-NaN30", "-NaN30", 0, 9, ToNearestAway, 384, -383, false},
+	// fmax3885 fma  1    1000   -sNaN31 -> -NaN31  Invalid_operation
+	{"fmax3885", "1", "1000", "-sNaN31", "-NaN31", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// overflow, underflow and subnormal tests
+	// maxexponent: 999999
+	// minexponent: -999999
+	// precision: 9
+	// fmax3890 fma  1   1E+999999     9E+999999   -> Infinity Overflow Inexact Rounded
+	{"fmax3890", "1", "1E+999999", "9E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3891 fma  1   9E+999999     1E+999999   -> Infinity Overflow Inexact Rounded
+	{"fmax3891", "1", "9E+999999", "1E+999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3892 fma  1   -1.1E-999999  1E-999999   -> -1E-1000000    Subnormal
+	{"fmax3892", "1", "-1.1E-999999", "1E-999999", "-1E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3893 fma  1   1E-999999    -1.1e-999999 -> -1E-1000000    Subnormal
+	{"fmax3893", "1", "1E-999999", "-1.1e-999999", "-1E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3894 fma  1   -1.0001E-999999  1E-999999   -> -1E-1000003 Subnormal
+	{"fmax3894", "1", "-1.0001E-999999", "1E-999999", "-1E-1000003", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3895 fma  1   1E-999999    -1.0001e-999999 -> -1E-1000003 Subnormal
+	{"fmax3895", "1", "1E-999999", "-1.0001e-999999", "-1E-1000003", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3896 fma  1   -1E+999999   -9E+999999   -> -Infinity Overflow Inexact Rounded
+	{"fmax3896", "1", "-1E+999999", "-9E+999999", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3897 fma  1   -9E+999999   -1E+999999   -> -Infinity Overflow Inexact Rounded
+	{"fmax3897", "1", "-9E+999999", "-1E+999999", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3898 fma  1   +1.1E-999999 -1E-999999   -> 1E-1000000   Subnormal
+	{"fmax3898", "1", "+1.1E-999999", "-1E-999999", "1E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3899 fma  1   -1E-999999   +1.1e-999999 -> 1E-1000000    Subnormal
+	{"fmax3899", "1", "-1E-999999", "+1.1e-999999", "1E-1000000", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3900 fma  1   +1.0001E-999999 -1E-999999   -> 1E-1000003 Subnormal
+	{"fmax3900", "1", "+1.0001E-999999", "-1E-999999", "1E-1000003", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3901 fma  1   -1E-999999   +1.0001e-999999 -> 1E-1000003 Subnormal
+	{"fmax3901", "1", "-1E-999999", "+1.0001e-999999", "1E-1000003", Subnormal, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3902 fma  1   -1E+999999   +9E+999999   ->  8E+999999
+	{"fmax3902", "1", "-1E+999999", "+9E+999999", "8E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// fmax3903 fma  1   -9E+999999   +1E+999999   -> -8E+999999
+	{"fmax3903", "1", "-9E+999999", "+1E+999999", "-8E+999999", 0, 9, ToNearestAway, 999999, -999999, false},
+	// precision: 3
+	// fmax3904 fma  1        0 -9.999E+999999   -> -Infinity Inexact Overflow Rounded
+	{"fmax3904", "1", "0", "-9.999E+999999", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999, -999999, false},
+	// fmax3905 fma  1          -9.999E+999999 0 -> -Infinity Inexact Overflow Rounded
+	{"fmax3905", "1", "-9.999E+999999", "0", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999, -999999, false},
+	// fmax3906 fma  1        0  9.999E+999999   ->  Infinity Inexact Overflow Rounded
+	{"fmax3906", "1", "0", "9.999E+999999", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999, -999999, false},
+	// fmax3907 fma  1           9.999E+999999 0 ->  Infinity Inexact Overflow Rounded
+	{"fmax3907", "1", "9.999E+999999", "0", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999, -999999, false},
+	// precision: 3
+	// maxexponent: 999
+	// minexponent: -999
+	// fmax3910 fma  1    1.00E-999   0    ->   1.00E-999
+	{"fmax3910", "1", "1.00E-999", "0", "1.00E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// fmax3911 fma  1    0.1E-999    0    ->   1E-1000   Subnormal
+	{"fmax3911", "1", "0.1E-999", "0", "1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3912 fma  1    0.10E-999   0    ->   1.0E-1000 Subnormal
+	{"fmax3912", "1", "0.10E-999", "0", "1.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3913 fma  1    0.100E-999  0    ->   1.0E-1000 Subnormal Rounded
+	{"fmax3913", "1", "0.100E-999", "0", "1.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// fmax3914 fma  1    0.01E-999   0    ->   1E-1001   Subnormal
+	{"fmax3914", "1", "0.01E-999", "0", "1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Nmin
+	// fmax3915 fma  1    0.999E-999  0    ->   1.00E-999 Inexact Rounded Subnormal Underflow
+	{"fmax3915", "1", "0.999E-999", "0", "1.00E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3916 fma  1    0.099E-999  0    ->   1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"fmax3916", "1", "0.099E-999", "0", "1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3917 fma  1    0.009E-999  0    ->   1E-1001   Inexact Rounded Subnormal Underflow
+	{"fmax3917", "1", "0.009E-999", "0", "1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3918 fma  1    0.001E-999  0    ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3918", "1", "0.001E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3919 fma  1    0.0009E-999 0    ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3919", "1", "0.0009E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3920 fma  1    0.0001E-999 0    ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3920", "1", "0.0001E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3930 fma  1   -1.00E-999   0    ->  -1.00E-999
+	{"fmax3930", "1", "-1.00E-999", "0", "-1.00E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// fmax3931 fma  1   -0.1E-999    0    ->  -1E-1000   Subnormal
+	{"fmax3931", "1", "-0.1E-999", "0", "-1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3932 fma  1   -0.10E-999   0    ->  -1.0E-1000 Subnormal
+	{"fmax3932", "1", "-0.10E-999", "0", "-1.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3933 fma  1   -0.100E-999  0    ->  -1.0E-1000 Subnormal Rounded
+	{"fmax3933", "1", "-0.100E-999", "0", "-1.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// fmax3934 fma  1   -0.01E-999   0    ->  -1E-1001   Subnormal
+	{"fmax3934", "1", "-0.01E-999", "0", "-1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Nmin
+	// fmax3935 fma  1   -0.999E-999  0    ->  -1.00E-999 Inexact Rounded Subnormal Underflow
+	{"fmax3935", "1", "-0.999E-999", "0", "-1.00E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3936 fma  1   -0.099E-999  0    ->  -1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"fmax3936", "1", "-0.099E-999", "0", "-1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3937 fma  1   -0.009E-999  0    ->  -1E-1001   Inexact Rounded Subnormal Underflow
+	{"fmax3937", "1", "-0.009E-999", "0", "-1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3938 fma  1   -0.001E-999  0    ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3938", "1", "-0.001E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3939 fma  1   -0.0009E-999 0    ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3939", "1", "-0.0009E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3940 fma  1   -0.0001E-999 0    ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3940", "1", "-0.0001E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// some non-zero subnormal fma  1  s
+	// fmax3950 fma  1    1.00E-999    0.1E-999  ->   1.10E-999
+	{"fmax3950", "1", "1.00E-999", "0.1E-999", "1.10E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// fmax3951 fma  1    0.1E-999     0.1E-999  ->   2E-1000    Subnormal
+	{"fmax3951", "1", "0.1E-999", "0.1E-999", "2E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3952 fma  1    0.10E-999    0.1E-999  ->   2.0E-1000  Subnormal
+	{"fmax3952", "1", "0.10E-999", "0.1E-999", "2.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3953 fma  1    0.100E-999   0.1E-999  ->   2.0E-1000  Subnormal Rounded
+	{"fmax3953", "1", "0.100E-999", "0.1E-999", "2.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// fmax3954 fma  1    0.01E-999    0.1E-999  ->   1.1E-1000  Subnormal
+	{"fmax3954", "1", "0.01E-999", "0.1E-999", "1.1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3955 fma  1    0.999E-999   0.1E-999  ->   1.10E-999  Inexact Rounded
+	{"fmax3955", "1", "0.999E-999", "0.1E-999", "1.10E-999", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// fmax3956 fma  1    0.099E-999   0.1E-999  ->   2.0E-1000  Inexact Rounded Subnormal Underflow
+	{"fmax3956", "1", "0.099E-999", "0.1E-999", "2.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3957 fma  1    0.009E-999   0.1E-999  ->   1.1E-1000  Inexact Rounded Subnormal Underflow
+	{"fmax3957", "1", "0.009E-999", "0.1E-999", "1.1E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3958 fma  1    0.001E-999   0.1E-999  ->   1.0E-1000  Inexact Rounded Subnormal Underflow
+	{"fmax3958", "1", "0.001E-999", "0.1E-999", "1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3959 fma  1    0.0009E-999  0.1E-999  ->   1.0E-1000  Inexact Rounded Subnormal Underflow
+	{"fmax3959", "1", "0.0009E-999", "0.1E-999", "1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3960 fma  1    0.0001E-999  0.1E-999  ->   1.0E-1000  Inexact Rounded Subnormal Underflow
+	{"fmax3960", "1", "0.0001E-999", "0.1E-999", "1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// negatives...
+	// fmax3961 fma  1    1.00E-999   -0.1E-999  ->   9.0E-1000  Subnormal
+	{"fmax3961", "1", "1.00E-999", "-0.1E-999", "9.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3962 fma  1    0.1E-999    -0.1E-999  ->   0E-1000
+	{"fmax3962", "1", "0.1E-999", "-0.1E-999", "0E-1000", 0, 3, ToNearestAway, 999, -999, false},
+	// fmax3963 fma  1    0.10E-999   -0.1E-999  ->   0E-1001
+	{"fmax3963", "1", "0.10E-999", "-0.1E-999", "0E-1001", 0, 3, ToNearestAway, 999, -999, false},
+	// fmax3964 fma  1    0.100E-999  -0.1E-999  ->   0E-1001    Clamped
+	{"fmax3964", "1", "0.100E-999", "-0.1E-999", "0E-1001", Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3965 fma  1    0.01E-999   -0.1E-999  ->   -9E-1001   Subnormal
+	{"fmax3965", "1", "0.01E-999", "-0.1E-999", "-9E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// fmax3966 fma  1    0.999E-999  -0.1E-999  ->   9.0E-1000  Inexact Rounded Subnormal Underflow
+	{"fmax3966", "1", "0.999E-999", "-0.1E-999", "9.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3967 fma  1    0.099E-999  -0.1E-999  ->   -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"fmax3967", "1", "0.099E-999", "-0.1E-999", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// fmax3968 fma  1    0.009E-999  -0.1E-999  ->   -9E-1001   Inexact Rounded Subnormal Underflow
+	{"fmax3968", "1", "0.009E-999", "-0.1E-999", "-9E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3969 fma  1    0.001E-999  -0.1E-999  ->   -1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"fmax3969", "1", "0.001E-999", "-0.1E-999", "-1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3970 fma  1    0.0009E-999 -0.1E-999  ->   -1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"fmax3970", "1", "0.0009E-999", "-0.1E-999", "-1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// fmax3971 fma  1    0.0001E-999 -0.1E-999  ->   -1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"fmax3971", "1", "0.0001E-999", "-0.1E-999", "-1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// some 'real' numbers
+	// maxexponent: 384
+	// minexponent: -383
+	// precision: 8
+	// fmax3566 fma  1   99999061735E-394  0E-394 -> 9.999906E-384 Inexact Rounded Underflow Subnormal
+	{"fmax3566", "1", "99999061735E-394", "0E-394", "9.999906E-384", Inexact | Rounded | Underflow | Subnormal, 8, ToNearestAway, 384, -383, false},
+	// precision: 7
+	// fmax3567 fma  1   99999061735E-394  0E-394 -> 9.99991E-384 Inexact Rounded Underflow Subnormal
+	{"fmax3567", "1", "99999061735E-394", "0E-394", "9.99991E-384", Inexact | Rounded | Underflow | Subnormal, 7, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// fmax3568 fma  1   99999061735E-394  0E-394 -> 9.9999E-384 Inexact Rounded Underflow Subnormal
+	{"fmax3568", "1", "99999061735E-394", "0E-394", "9.9999E-384", Inexact | Rounded | Underflow | Subnormal, 6, ToNearestAway, 384, -383, false},
+	// now the case where we can get underflow but the result is normal
+	// [note this can't happen if the operands are also bounded, as we
+	// cannot represent 1E-399, for example]
+	// precision: 16
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// fmax3571 fma  1         1E-383       0  -> 1E-383
+	{"fmax3571", "1", "1E-383", "0", "1E-383", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax3572 fma  1         1E-384       0  -> 1E-384   Subnormal
+	{"fmax3572", "1", "1E-384", "0", "1E-384", Subnormal, 16, ToNearestAway, 384, -383, false},
+	// fmax3573 fma  1         1E-383  1E-384  -> 1.1E-383
+	{"fmax3573", "1", "1E-383", "1E-384", "1.1E-383", 0, 16, ToNearestAway, 384, -383, false},
+	// SKIP (subtract not supported): fmax3574 subtract  1E-383  1E-384  ->   9E-384 Subnormal
+	// Here we explore the boundary of rounding a subnormal to Nmin
+	// SKIP (subtract not supported): fmax3575 subtract  1E-383  1E-398  ->   9.99999999999999E-384  Subnormal
+	// SKIP (subtract not supported): fmax3576 subtract  1E-383  1E-398  ->   9.99999999999999E-384  Subnormal
+	// SKIP (subtract not supported): fmax3577 subtract  1E-383  1E-399  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// SKIP (subtract not supported): fmax3578 subtract  1E-383  1E-400  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// SKIP (subtract not supported): fmax3579 subtract  1E-383  1E-401  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// SKIP (subtract not supported): fmax3580 subtract  1E-383  1E-402  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// check for double-rounded subnormals
+	// precision: 5
+	// maxexponent: 79
+	// minexponent: -79
+	// Add: lhs and rhs 0
+	// fmax31001 fma  1         1.52444E-80 0 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31001", "1", "1.52444E-80", "0", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31002 fma  1         1.52445E-80 0 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31002", "1", "1.52445E-80", "0", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31003 fma  1         1.52446E-80 0 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31003", "1", "1.52446E-80", "0", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31004 fma  1         0 1.52444E-80 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31004", "1", "0", "1.52444E-80", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31005 fma  1         0 1.52445E-80 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31005", "1", "0", "1.52445E-80", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31006 fma  1         0 1.52446E-80 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31006", "1", "0", "1.52446E-80", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// Add: lhs >> rhs and vice versa
+	// fmax31011 fma  1         1.52444E-80 1E-100 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31011", "1", "1.52444E-80", "1E-100", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31012 fma  1         1.52445E-80 1E-100 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31012", "1", "1.52445E-80", "1E-100", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31013 fma  1         1.52446E-80 1E-100 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31013", "1", "1.52446E-80", "1E-100", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31014 fma  1         1E-100 1.52444E-80 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31014", "1", "1E-100", "1.52444E-80", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31015 fma  1         1E-100 1.52445E-80 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31015", "1", "1E-100", "1.52445E-80", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31016 fma  1         1E-100 1.52446E-80 -> 1.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31016", "1", "1E-100", "1.52446E-80", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// Add: lhs + rhs fma  1  ition carried out
+	// fmax31021 fma  1         1.52443E-80 1.00001E-80  -> 2.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31021", "1", "1.52443E-80", "1.00001E-80", "2.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31022 fma  1         1.52444E-80 1.00001E-80  -> 2.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31022", "1", "1.52444E-80", "1.00001E-80", "2.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31023 fma  1         1.52445E-80 1.00001E-80  -> 2.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31023", "1", "1.52445E-80", "1.00001E-80", "2.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31024 fma  1         1.00001E-80  1.52443E-80 -> 2.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31024", "1", "1.00001E-80", "1.52443E-80", "2.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31025 fma  1         1.00001E-80  1.52444E-80 -> 2.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31025", "1", "1.00001E-80", "1.52444E-80", "2.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// fmax31026 fma  1         1.00001E-80  1.52445E-80 -> 2.524E-80 Inexact Rounded Subnormal Underflow
+	{"fmax31026", "1", "1.00001E-80", "1.52445E-80", "2.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestAway, 79, -79, false},
+	// And for round down full and subnormal results
+	// precision: 16
+	// maxexponent: +384
+	// minexponent: -383
+	// rounding: down
+	// fmax31100 fma  1   1e+2 -1e-383    -> 99.99999999999999 Rounded Inexact
+	{"fmax31100", "1", "1e+2", "-1e-383", "99.99999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31101 fma  1   1e+1 -1e-383    -> 9.999999999999999  Rounded Inexact
+	{"fmax31101", "1", "1e+1", "-1e-383", "9.999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31103 fma  1     +1 -1e-383    -> 0.9999999999999999  Rounded Inexact
+	{"fmax31103", "1", "+1", "-1e-383", "0.9999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31104 fma  1   1e-1 -1e-383    -> 0.09999999999999999  Rounded Inexact
+	{"fmax31104", "1", "1e-1", "-1e-383", "0.09999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31105 fma  1   1e-2 -1e-383    -> 0.009999999999999999  Rounded Inexact
+	{"fmax31105", "1", "1e-2", "-1e-383", "0.009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31106 fma  1   1e-3 -1e-383    -> 0.0009999999999999999  Rounded Inexact
+	{"fmax31106", "1", "1e-3", "-1e-383", "0.0009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31107 fma  1   1e-4 -1e-383    -> 0.00009999999999999999  Rounded Inexact
+	{"fmax31107", "1", "1e-4", "-1e-383", "0.00009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31108 fma  1   1e-5 -1e-383    -> 0.000009999999999999999  Rounded Inexact
+	{"fmax31108", "1", "1e-5", "-1e-383", "0.000009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax31109 fma  1   1e-6 -1e-383    -> 9.999999999999999E-7  Rounded Inexact
+	{"fmax31109", "1", "1e-6", "-1e-383", "9.999999999999999E-7", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// rounding: ceiling
+	// fmax31110 fma  1   -1e+2 +1e-383   -> -99.99999999999999 Rounded Inexact
+	{"fmax31110", "1", "-1e+2", "+1e-383", "-99.99999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31111 fma  1   -1e+1 +1e-383   -> -9.999999999999999  Rounded Inexact
+	{"fmax31111", "1", "-1e+1", "+1e-383", "-9.999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31113 fma  1      -1 +1e-383   -> -0.9999999999999999  Rounded Inexact
+	{"fmax31113", "1", "-1", "+1e-383", "-0.9999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31114 fma  1   -1e-1 +1e-383   -> -0.09999999999999999  Rounded Inexact
+	{"fmax31114", "1", "-1e-1", "+1e-383", "-0.09999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31115 fma  1   -1e-2 +1e-383   -> -0.009999999999999999  Rounded Inexact
+	{"fmax31115", "1", "-1e-2", "+1e-383", "-0.009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31116 fma  1   -1e-3 +1e-383   -> -0.0009999999999999999  Rounded Inexact
+	{"fmax31116", "1", "-1e-3", "+1e-383", "-0.0009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31117 fma  1   -1e-4 +1e-383   -> -0.00009999999999999999  Rounded Inexact
+	{"fmax31117", "1", "-1e-4", "+1e-383", "-0.00009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31118 fma  1   -1e-5 +1e-383   -> -0.000009999999999999999  Rounded Inexact
+	{"fmax31118", "1", "-1e-5", "+1e-383", "-0.000009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax31119 fma  1   -1e-6 +1e-383   -> -9.999999999999999E-7  Rounded Inexact
+	{"fmax31119", "1", "-1e-6", "+1e-383", "-9.999999999999999E-7", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// rounding: down
+	// precision: 7
+	// maxexponent: +96
+	// minexponent: -95
+	// fmax31130 fma  1     1            -1e-200  -> 0.9999999  Rounded Inexact
+	{"fmax31130", "1", "1", "-1e-200", "0.9999999", Rounded | Inexact, 7, ToZero, 96, -95, false},
+	// subnormal boundary
+	// fmax31131 fma  1     1.000000E-94  -1e-200  ->  9.999999E-95  Rounded Inexact
+	{"fmax31131", "1", "1.000000E-94", "-1e-200", "9.999999E-95", Rounded | Inexact, 7, ToZero, 96, -95, false},
+	// fmax31132 fma  1     1.000001E-95  -1e-200  ->  1.000000E-95  Rounded Inexact
+	{"fmax31132", "1", "1.000001E-95", "-1e-200", "1.000000E-95", Rounded | Inexact, 7, ToZero, 96, -95, false},
+	// fmax31133 fma  1     1.000000E-95  -1e-200  ->  9.99999E-96  Rounded Inexact Subnormal Underflow
+	{"fmax31133", "1", "1.000000E-95", "-1e-200", "9.99999E-96", Rounded | Inexact | Subnormal | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31134 fma  1     0.999999E-95  -1e-200  ->  9.99998E-96  Rounded Inexact Subnormal Underflow
+	{"fmax31134", "1", "0.999999E-95", "-1e-200", "9.99998E-96", Rounded | Inexact | Subnormal | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31135 fma  1     0.001000E-95  -1e-200  ->  9.99E-99  Rounded Inexact Subnormal Underflow
+	{"fmax31135", "1", "0.001000E-95", "-1e-200", "9.99E-99", Rounded | Inexact | Subnormal | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31136 fma  1     0.000999E-95  -1e-200  ->  9.98E-99  Rounded Inexact Subnormal Underflow
+	{"fmax31136", "1", "0.000999E-95", "-1e-200", "9.98E-99", Rounded | Inexact | Subnormal | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31137 fma  1     1.000000E-95  -1e-101  ->  9.99999E-96  Subnormal
+	{"fmax31137", "1", "1.000000E-95", "-1e-101", "9.99999E-96", Subnormal, 7, ToZero, 96, -95, false},
+	// fmax31138 fma  1        10000E-101 -1e-200  ->  9.999E-98  Subnormal Inexact Rounded Underflow
+	{"fmax31138", "1", "10000E-101", "-1e-200", "9.999E-98", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31139 fma  1         1000E-101 -1e-200  ->  9.99E-99   Subnormal Inexact Rounded Underflow
+	{"fmax31139", "1", "1000E-101", "-1e-200", "9.99E-99", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31140 fma  1          100E-101 -1e-200  ->  9.9E-100   Subnormal Inexact Rounded Underflow
+	{"fmax31140", "1", "100E-101", "-1e-200", "9.9E-100", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31141 fma  1           10E-101 -1e-200  ->  9E-101     Subnormal Inexact Rounded Underflow
+	{"fmax31141", "1", "10E-101", "-1e-200", "9E-101", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31142 fma  1            1E-101 -1e-200  ->  0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31142", "1", "1E-101", "-1e-200", "0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31143 fma  1            0E-101 -1e-200  -> -0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31143", "1", "0E-101", "-1e-200", "-0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31144 fma  1            1E-102 -1e-200  ->  0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31144", "1", "1E-102", "-1e-200", "0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31151 fma  1        10000E-102 -1e-200  ->  9.99E-99  Subnormal Inexact Rounded Underflow
+	{"fmax31151", "1", "10000E-102", "-1e-200", "9.99E-99", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31152 fma  1         1000E-102 -1e-200  ->  9.9E-100  Subnormal Inexact Rounded Underflow
+	{"fmax31152", "1", "1000E-102", "-1e-200", "9.9E-100", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31153 fma  1          100E-102 -1e-200  ->  9E-101   Subnormal Inexact Rounded Underflow
+	{"fmax31153", "1", "100E-102", "-1e-200", "9E-101", Subnormal | Inexact | Rounded | Underflow, 7, ToZero, 96, -95, false},
+	// fmax31154 fma  1           10E-102 -1e-200  ->  0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31154", "1", "10E-102", "-1e-200", "0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31155 fma  1            1E-102 -1e-200  ->  0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31155", "1", "1E-102", "-1e-200", "0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31156 fma  1            0E-102 -1e-200  -> -0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31156", "1", "0E-102", "-1e-200", "-0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31157 fma  1            1E-103 -1e-200  ->  0E-101     Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31157", "1", "1E-103", "-1e-200", "0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31160 fma  1          100E-105 -1e-101  -> -0E-101 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31160", "1", "100E-105", "-1e-101", "-0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// fmax31161 fma  1          100E-105 -1e-201  ->  0E-101 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31161", "1", "100E-105", "-1e-201", "0E-101", Subnormal | Inexact | Rounded | Underflow | Clamped, 7, ToZero, 96, -95, false},
+	// tests based on Gunnar Degnbol's edge case
+	// precision: 15
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// fmax31200 fma  1   1E15  -0.5                 ->  1.00000000000000E+15 Inexact Rounded
+	{"fmax31200", "1", "1E15", "-0.5", "1.00000000000000E+15", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31201 fma  1   1E15  -0.50                ->  1.00000000000000E+15 Inexact Rounded
+	{"fmax31201", "1", "1E15", "-0.50", "1.00000000000000E+15", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31210 fma  1   1E15  -0.51                ->  999999999999999      Inexact Rounded
+	{"fmax31210", "1", "1E15", "-0.51", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31211 fma  1   1E15  -0.501               ->  999999999999999      Inexact Rounded
+	{"fmax31211", "1", "1E15", "-0.501", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31212 fma  1   1E15  -0.5001              ->  999999999999999      Inexact Rounded
+	{"fmax31212", "1", "1E15", "-0.5001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31213 fma  1   1E15  -0.50001             ->  999999999999999      Inexact Rounded
+	{"fmax31213", "1", "1E15", "-0.50001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31214 fma  1   1E15  -0.500001            ->  999999999999999      Inexact Rounded
+	{"fmax31214", "1", "1E15", "-0.500001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31215 fma  1   1E15  -0.5000001           ->  999999999999999      Inexact Rounded
+	{"fmax31215", "1", "1E15", "-0.5000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31216 fma  1   1E15  -0.50000001          ->  999999999999999      Inexact Rounded
+	{"fmax31216", "1", "1E15", "-0.50000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31217 fma  1   1E15  -0.500000001         ->  999999999999999      Inexact Rounded
+	{"fmax31217", "1", "1E15", "-0.500000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31218 fma  1   1E15  -0.5000000001        ->  999999999999999      Inexact Rounded
+	{"fmax31218", "1", "1E15", "-0.5000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31219 fma  1   1E15  -0.50000000001       ->  999999999999999      Inexact Rounded
+	{"fmax31219", "1", "1E15", "-0.50000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31220 fma  1   1E15  -0.500000000001      ->  999999999999999      Inexact Rounded
+	{"fmax31220", "1", "1E15", "-0.500000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31221 fma  1   1E15  -0.5000000000001     ->  999999999999999      Inexact Rounded
+	{"fmax31221", "1", "1E15", "-0.5000000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31222 fma  1   1E15  -0.50000000000001    ->  999999999999999      Inexact Rounded
+	{"fmax31222", "1", "1E15", "-0.50000000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31223 fma  1   1E15  -0.500000000000001   ->  999999999999999      Inexact Rounded
+	{"fmax31223", "1", "1E15", "-0.500000000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31224 fma  1   1E15  -0.5000000000000001  ->  999999999999999      Inexact Rounded
+	{"fmax31224", "1", "1E15", "-0.5000000000000001", "999999999999999", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31225 fma  1   1E15  -0.5000000000000000  ->  1.00000000000000E+15 Inexact Rounded
+	{"fmax31225", "1", "1E15", "-0.5000000000000000", "1.00000000000000E+15", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// fmax31230 fma  1   1E15  -5000000.000000001   ->  999999995000000      Inexact Rounded
+	{"fmax31230", "1", "1E15", "-5000000.000000001", "999999995000000", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// precision: 16
+	// fmax31300 fma  1   1E16  -0.5                 ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31300", "1", "1E16", "-0.5", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31310 fma  1   1E16  -0.51                ->  9999999999999999      Inexact Rounded
+	{"fmax31310", "1", "1E16", "-0.51", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31311 fma  1   1E16  -0.501               ->  9999999999999999      Inexact Rounded
+	{"fmax31311", "1", "1E16", "-0.501", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31312 fma  1   1E16  -0.5001              ->  9999999999999999      Inexact Rounded
+	{"fmax31312", "1", "1E16", "-0.5001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31313 fma  1   1E16  -0.50001             ->  9999999999999999      Inexact Rounded
+	{"fmax31313", "1", "1E16", "-0.50001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31314 fma  1   1E16  -0.500001            ->  9999999999999999      Inexact Rounded
+	{"fmax31314", "1", "1E16", "-0.500001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31315 fma  1   1E16  -0.5000001           ->  9999999999999999      Inexact Rounded
+	{"fmax31315", "1", "1E16", "-0.5000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31316 fma  1   1E16  -0.50000001          ->  9999999999999999      Inexact Rounded
+	{"fmax31316", "1", "1E16", "-0.50000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31317 fma  1   1E16  -0.500000001         ->  9999999999999999      Inexact Rounded
+	{"fmax31317", "1", "1E16", "-0.500000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31318 fma  1   1E16  -0.5000000001        ->  9999999999999999      Inexact Rounded
+	{"fmax31318", "1", "1E16", "-0.5000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31319 fma  1   1E16  -0.50000000001       ->  9999999999999999      Inexact Rounded
+	{"fmax31319", "1", "1E16", "-0.50000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31320 fma  1   1E16  -0.500000000001      ->  9999999999999999      Inexact Rounded
+	{"fmax31320", "1", "1E16", "-0.500000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31321 fma  1   1E16  -0.5000000000001     ->  9999999999999999      Inexact Rounded
+	{"fmax31321", "1", "1E16", "-0.5000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31322 fma  1   1E16  -0.50000000000001    ->  9999999999999999      Inexact Rounded
+	{"fmax31322", "1", "1E16", "-0.50000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31323 fma  1   1E16  -0.500000000000001   ->  9999999999999999      Inexact Rounded
+	{"fmax31323", "1", "1E16", "-0.500000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31324 fma  1   1E16  -0.5000000000000001  ->  9999999999999999      Inexact Rounded
+	{"fmax31324", "1", "1E16", "-0.5000000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31325 fma  1   1E16  -0.5000000000000000  ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31325", "1", "1E16", "-0.5000000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31326 fma  1   1E16  -0.500000000000000   ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31326", "1", "1E16", "-0.500000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31327 fma  1   1E16  -0.50000000000000    ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31327", "1", "1E16", "-0.50000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31328 fma  1   1E16  -0.5000000000000     ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31328", "1", "1E16", "-0.5000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31329 fma  1   1E16  -0.500000000000      ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31329", "1", "1E16", "-0.500000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31330 fma  1   1E16  -0.50000000000       ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31330", "1", "1E16", "-0.50000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31331 fma  1   1E16  -0.5000000000        ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31331", "1", "1E16", "-0.5000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31332 fma  1   1E16  -0.500000000         ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31332", "1", "1E16", "-0.500000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31333 fma  1   1E16  -0.50000000          ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31333", "1", "1E16", "-0.50000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31334 fma  1   1E16  -0.5000000           ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31334", "1", "1E16", "-0.5000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31335 fma  1   1E16  -0.500000            ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31335", "1", "1E16", "-0.500000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31336 fma  1   1E16  -0.50000             ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31336", "1", "1E16", "-0.50000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31337 fma  1   1E16  -0.5000              ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31337", "1", "1E16", "-0.5000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31338 fma  1   1E16  -0.500               ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31338", "1", "1E16", "-0.500", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31339 fma  1   1E16  -0.50                ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31339", "1", "1E16", "-0.50", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31340 fma  1   1E16  -5000000.000010001   ->  9999999995000000      Inexact Rounded
+	{"fmax31340", "1", "1E16", "-5000000.000010001", "9999999995000000", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31341 fma  1   1E16  -5000000.000000001   ->  9999999995000000      Inexact Rounded
+	{"fmax31341", "1", "1E16", "-5000000.000000001", "9999999995000000", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31349 fma  1   9999999999999999 0.4                 ->  9999999999999999      Inexact Rounded
+	{"fmax31349", "1", "9999999999999999", "0.4", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31350 fma  1   9999999999999999 0.49                ->  9999999999999999      Inexact Rounded
+	{"fmax31350", "1", "9999999999999999", "0.49", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31351 fma  1   9999999999999999 0.499               ->  9999999999999999      Inexact Rounded
+	{"fmax31351", "1", "9999999999999999", "0.499", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31352 fma  1   9999999999999999 0.4999              ->  9999999999999999      Inexact Rounded
+	{"fmax31352", "1", "9999999999999999", "0.4999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31353 fma  1   9999999999999999 0.49999             ->  9999999999999999      Inexact Rounded
+	{"fmax31353", "1", "9999999999999999", "0.49999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31354 fma  1   9999999999999999 0.499999            ->  9999999999999999      Inexact Rounded
+	{"fmax31354", "1", "9999999999999999", "0.499999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31355 fma  1   9999999999999999 0.4999999           ->  9999999999999999      Inexact Rounded
+	{"fmax31355", "1", "9999999999999999", "0.4999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31356 fma  1   9999999999999999 0.49999999          ->  9999999999999999      Inexact Rounded
+	{"fmax31356", "1", "9999999999999999", "0.49999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31357 fma  1   9999999999999999 0.499999999         ->  9999999999999999      Inexact Rounded
+	{"fmax31357", "1", "9999999999999999", "0.499999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31358 fma  1   9999999999999999 0.4999999999        ->  9999999999999999      Inexact Rounded
+	{"fmax31358", "1", "9999999999999999", "0.4999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31359 fma  1   9999999999999999 0.49999999999       ->  9999999999999999      Inexact Rounded
+	{"fmax31359", "1", "9999999999999999", "0.49999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31360 fma  1   9999999999999999 0.499999999999      ->  9999999999999999      Inexact Rounded
+	{"fmax31360", "1", "9999999999999999", "0.499999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31361 fma  1   9999999999999999 0.4999999999999     ->  9999999999999999      Inexact Rounded
+	{"fmax31361", "1", "9999999999999999", "0.4999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31362 fma  1   9999999999999999 0.49999999999999    ->  9999999999999999      Inexact Rounded
+	{"fmax31362", "1", "9999999999999999", "0.49999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31363 fma  1   9999999999999999 0.499999999999999   ->  9999999999999999      Inexact Rounded
+	{"fmax31363", "1", "9999999999999999", "0.499999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31364 fma  1   9999999999999999 0.4999999999999999  ->  9999999999999999      Inexact Rounded
+	{"fmax31364", "1", "9999999999999999", "0.4999999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31365 fma  1   9999999999999999 0.5000000000000000  ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31365", "1", "9999999999999999", "0.5000000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31367 fma  1   9999999999999999 0.500000000000000   ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31367", "1", "9999999999999999", "0.500000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31368 fma  1   9999999999999999 0.50000000000000    ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31368", "1", "9999999999999999", "0.50000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31369 fma  1   9999999999999999 0.5000000000000     ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31369", "1", "9999999999999999", "0.5000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31370 fma  1   9999999999999999 0.500000000000      ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31370", "1", "9999999999999999", "0.500000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31371 fma  1   9999999999999999 0.50000000000       ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31371", "1", "9999999999999999", "0.50000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31372 fma  1   9999999999999999 0.5000000000        ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31372", "1", "9999999999999999", "0.5000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31373 fma  1   9999999999999999 0.500000000         ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31373", "1", "9999999999999999", "0.500000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31374 fma  1   9999999999999999 0.50000000          ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31374", "1", "9999999999999999", "0.50000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31375 fma  1   9999999999999999 0.5000000           ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31375", "1", "9999999999999999", "0.5000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31376 fma  1   9999999999999999 0.500000            ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31376", "1", "9999999999999999", "0.500000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31377 fma  1   9999999999999999 0.50000             ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31377", "1", "9999999999999999", "0.50000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31378 fma  1   9999999999999999 0.5000              ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31378", "1", "9999999999999999", "0.5000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31379 fma  1   9999999999999999 0.500               ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31379", "1", "9999999999999999", "0.500", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31380 fma  1   9999999999999999 0.50                ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31380", "1", "9999999999999999", "0.50", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31381 fma  1   9999999999999999 0.5                 ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31381", "1", "9999999999999999", "0.5", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31382 fma  1   9999999999999999 0.5000000000000001  ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31382", "1", "9999999999999999", "0.5000000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31383 fma  1   9999999999999999 0.500000000000001   ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31383", "1", "9999999999999999", "0.500000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31384 fma  1   9999999999999999 0.50000000000001    ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31384", "1", "9999999999999999", "0.50000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31385 fma  1   9999999999999999 0.5000000000001     ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31385", "1", "9999999999999999", "0.5000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31386 fma  1   9999999999999999 0.500000000001      ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31386", "1", "9999999999999999", "0.500000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31387 fma  1   9999999999999999 0.50000000001       ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31387", "1", "9999999999999999", "0.50000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31388 fma  1   9999999999999999 0.5000000001        ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31388", "1", "9999999999999999", "0.5000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31389 fma  1   9999999999999999 0.500000001         ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31389", "1", "9999999999999999", "0.500000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31390 fma  1   9999999999999999 0.50000001          ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31390", "1", "9999999999999999", "0.50000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31391 fma  1   9999999999999999 0.5000001           ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31391", "1", "9999999999999999", "0.5000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31392 fma  1   9999999999999999 0.500001            ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31392", "1", "9999999999999999", "0.500001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31393 fma  1   9999999999999999 0.50001             ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31393", "1", "9999999999999999", "0.50001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31394 fma  1   9999999999999999 0.5001              ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31394", "1", "9999999999999999", "0.5001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31395 fma  1   9999999999999999 0.501               ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31395", "1", "9999999999999999", "0.501", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31396 fma  1   9999999999999999 0.51                ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax31396", "1", "9999999999999999", "0.51", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// More GD edge cases, where difference between the unadjusted
+	// exponents is larger than the maximum precision and one side is 0
+	// precision: 15
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// fmax31400 fma  1    0 1.23456789012345     -> 1.23456789012345
+	{"fmax31400", "1", "0", "1.23456789012345", "1.23456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31401 fma  1    0 1.23456789012345E-1  -> 0.123456789012345
+	{"fmax31401", "1", "0", "1.23456789012345E-1", "0.123456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31402 fma  1    0 1.23456789012345E-2  -> 0.0123456789012345
+	{"fmax31402", "1", "0", "1.23456789012345E-2", "0.0123456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31403 fma  1    0 1.23456789012345E-3  -> 0.00123456789012345
+	{"fmax31403", "1", "0", "1.23456789012345E-3", "0.00123456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31404 fma  1    0 1.23456789012345E-4  -> 0.000123456789012345
+	{"fmax31404", "1", "0", "1.23456789012345E-4", "0.000123456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31405 fma  1    0 1.23456789012345E-5  -> 0.0000123456789012345
+	{"fmax31405", "1", "0", "1.23456789012345E-5", "0.0000123456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31406 fma  1    0 1.23456789012345E-6  -> 0.00000123456789012345
+	{"fmax31406", "1", "0", "1.23456789012345E-6", "0.00000123456789012345", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31407 fma  1    0 1.23456789012345E-7  -> 1.23456789012345E-7
+	{"fmax31407", "1", "0", "1.23456789012345E-7", "1.23456789012345E-7", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31408 fma  1    0 1.23456789012345E-8  -> 1.23456789012345E-8
+	{"fmax31408", "1", "0", "1.23456789012345E-8", "1.23456789012345E-8", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31409 fma  1    0 1.23456789012345E-9  -> 1.23456789012345E-9
+	{"fmax31409", "1", "0", "1.23456789012345E-9", "1.23456789012345E-9", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31410 fma  1    0 1.23456789012345E-10 -> 1.23456789012345E-10
+	{"fmax31410", "1", "0", "1.23456789012345E-10", "1.23456789012345E-10", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31411 fma  1    0 1.23456789012345E-11 -> 1.23456789012345E-11
+	{"fmax31411", "1", "0", "1.23456789012345E-11", "1.23456789012345E-11", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31412 fma  1    0 1.23456789012345E-12 -> 1.23456789012345E-12
+	{"fmax31412", "1", "0", "1.23456789012345E-12", "1.23456789012345E-12", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31413 fma  1    0 1.23456789012345E-13 -> 1.23456789012345E-13
+	{"fmax31413", "1", "0", "1.23456789012345E-13", "1.23456789012345E-13", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31414 fma  1    0 1.23456789012345E-14 -> 1.23456789012345E-14
+	{"fmax31414", "1", "0", "1.23456789012345E-14", "1.23456789012345E-14", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31415 fma  1    0 1.23456789012345E-15 -> 1.23456789012345E-15
+	{"fmax31415", "1", "0", "1.23456789012345E-15", "1.23456789012345E-15", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31416 fma  1    0 1.23456789012345E-16 -> 1.23456789012345E-16
+	{"fmax31416", "1", "0", "1.23456789012345E-16", "1.23456789012345E-16", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31417 fma  1    0 1.23456789012345E-17 -> 1.23456789012345E-17
+	{"fmax31417", "1", "0", "1.23456789012345E-17", "1.23456789012345E-17", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31418 fma  1    0 1.23456789012345E-18 -> 1.23456789012345E-18
+	{"fmax31418", "1", "0", "1.23456789012345E-18", "1.23456789012345E-18", 0, 15, ToNearestAway, 384, -383, false},
+	// fmax31419 fma  1    0 1.23456789012345E-19 -> 1.23456789012345E-19
+	{"fmax31419", "1", "0", "1.23456789012345E-19", "1.23456789012345E-19", 0, 15, ToNearestAway, 384, -383, false},
+	// same, precision 16..
+	// precision: 16
+	// fmax31420 fma  1    0 1.123456789012345     -> 1.123456789012345
+	{"fmax31420", "1", "0", "1.123456789012345", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31421 fma  1    0 1.123456789012345E-1  -> 0.1123456789012345
+	{"fmax31421", "1", "0", "1.123456789012345E-1", "0.1123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31422 fma  1    0 1.123456789012345E-2  -> 0.01123456789012345
+	{"fmax31422", "1", "0", "1.123456789012345E-2", "0.01123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31423 fma  1    0 1.123456789012345E-3  -> 0.001123456789012345
+	{"fmax31423", "1", "0", "1.123456789012345E-3", "0.001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31424 fma  1    0 1.123456789012345E-4  -> 0.0001123456789012345
+	{"fmax31424", "1", "0", "1.123456789012345E-4", "0.0001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31425 fma  1    0 1.123456789012345E-5  -> 0.00001123456789012345
+	{"fmax31425", "1", "0", "1.123456789012345E-5", "0.00001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31426 fma  1    0 1.123456789012345E-6  -> 0.000001123456789012345
+	{"fmax31426", "1", "0", "1.123456789012345E-6", "0.000001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31427 fma  1    0 1.123456789012345E-7  -> 1.123456789012345E-7
+	{"fmax31427", "1", "0", "1.123456789012345E-7", "1.123456789012345E-7", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31428 fma  1    0 1.123456789012345E-8  -> 1.123456789012345E-8
+	{"fmax31428", "1", "0", "1.123456789012345E-8", "1.123456789012345E-8", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31429 fma  1    0 1.123456789012345E-9  -> 1.123456789012345E-9
+	{"fmax31429", "1", "0", "1.123456789012345E-9", "1.123456789012345E-9", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31430 fma  1    0 1.123456789012345E-10 -> 1.123456789012345E-10
+	{"fmax31430", "1", "0", "1.123456789012345E-10", "1.123456789012345E-10", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31431 fma  1    0 1.123456789012345E-11 -> 1.123456789012345E-11
+	{"fmax31431", "1", "0", "1.123456789012345E-11", "1.123456789012345E-11", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31432 fma  1    0 1.123456789012345E-12 -> 1.123456789012345E-12
+	{"fmax31432", "1", "0", "1.123456789012345E-12", "1.123456789012345E-12", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31433 fma  1    0 1.123456789012345E-13 -> 1.123456789012345E-13
+	{"fmax31433", "1", "0", "1.123456789012345E-13", "1.123456789012345E-13", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31434 fma  1    0 1.123456789012345E-14 -> 1.123456789012345E-14
+	{"fmax31434", "1", "0", "1.123456789012345E-14", "1.123456789012345E-14", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31435 fma  1    0 1.123456789012345E-15 -> 1.123456789012345E-15
+	{"fmax31435", "1", "0", "1.123456789012345E-15", "1.123456789012345E-15", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31436 fma  1    0 1.123456789012345E-16 -> 1.123456789012345E-16
+	{"fmax31436", "1", "0", "1.123456789012345E-16", "1.123456789012345E-16", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31437 fma  1    0 1.123456789012345E-17 -> 1.123456789012345E-17
+	{"fmax31437", "1", "0", "1.123456789012345E-17", "1.123456789012345E-17", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31438 fma  1    0 1.123456789012345E-18 -> 1.123456789012345E-18
+	{"fmax31438", "1", "0", "1.123456789012345E-18", "1.123456789012345E-18", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31439 fma  1    0 1.123456789012345E-19 -> 1.123456789012345E-19
+	{"fmax31439", "1", "0", "1.123456789012345E-19", "1.123456789012345E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// same, reversed 0
+	// fmax31440 fma  1   1.123456789012345     0 -> 1.123456789012345
+	{"fmax31440", "1", "1.123456789012345", "0", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31441 fma  1   1.123456789012345E-1  0 -> 0.1123456789012345
+	{"fmax31441", "1", "1.123456789012345E-1", "0", "0.1123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31442 fma  1   1.123456789012345E-2  0 -> 0.01123456789012345
+	{"fmax31442", "1", "1.123456789012345E-2", "0", "0.01123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31443 fma  1   1.123456789012345E-3  0 -> 0.001123456789012345
+	{"fmax31443", "1", "1.123456789012345E-3", "0", "0.001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31444 fma  1   1.123456789012345E-4  0 -> 0.0001123456789012345
+	{"fmax31444", "1", "1.123456789012345E-4", "0", "0.0001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31445 fma  1   1.123456789012345E-5  0 -> 0.00001123456789012345
+	{"fmax31445", "1", "1.123456789012345E-5", "0", "0.00001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31446 fma  1   1.123456789012345E-6  0 -> 0.000001123456789012345
+	{"fmax31446", "1", "1.123456789012345E-6", "0", "0.000001123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31447 fma  1   1.123456789012345E-7  0 -> 1.123456789012345E-7
+	{"fmax31447", "1", "1.123456789012345E-7", "0", "1.123456789012345E-7", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31448 fma  1   1.123456789012345E-8  0 -> 1.123456789012345E-8
+	{"fmax31448", "1", "1.123456789012345E-8", "0", "1.123456789012345E-8", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31449 fma  1   1.123456789012345E-9  0 -> 1.123456789012345E-9
+	{"fmax31449", "1", "1.123456789012345E-9", "0", "1.123456789012345E-9", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31450 fma  1   1.123456789012345E-10 0 -> 1.123456789012345E-10
+	{"fmax31450", "1", "1.123456789012345E-10", "0", "1.123456789012345E-10", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31451 fma  1   1.123456789012345E-11 0 -> 1.123456789012345E-11
+	{"fmax31451", "1", "1.123456789012345E-11", "0", "1.123456789012345E-11", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31452 fma  1   1.123456789012345E-12 0 -> 1.123456789012345E-12
+	{"fmax31452", "1", "1.123456789012345E-12", "0", "1.123456789012345E-12", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31453 fma  1   1.123456789012345E-13 0 -> 1.123456789012345E-13
+	{"fmax31453", "1", "1.123456789012345E-13", "0", "1.123456789012345E-13", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31454 fma  1   1.123456789012345E-14 0 -> 1.123456789012345E-14
+	{"fmax31454", "1", "1.123456789012345E-14", "0", "1.123456789012345E-14", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31455 fma  1   1.123456789012345E-15 0 -> 1.123456789012345E-15
+	{"fmax31455", "1", "1.123456789012345E-15", "0", "1.123456789012345E-15", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31456 fma  1   1.123456789012345E-16 0 -> 1.123456789012345E-16
+	{"fmax31456", "1", "1.123456789012345E-16", "0", "1.123456789012345E-16", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31457 fma  1   1.123456789012345E-17 0 -> 1.123456789012345E-17
+	{"fmax31457", "1", "1.123456789012345E-17", "0", "1.123456789012345E-17", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31458 fma  1   1.123456789012345E-18 0 -> 1.123456789012345E-18
+	{"fmax31458", "1", "1.123456789012345E-18", "0", "1.123456789012345E-18", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31459 fma  1   1.123456789012345E-19 0 -> 1.123456789012345E-19
+	{"fmax31459", "1", "1.123456789012345E-19", "0", "1.123456789012345E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// same, Es on the 0
+	// fmax31460 fma  1   1.123456789012345  0E-0   -> 1.123456789012345
+	{"fmax31460", "1", "1.123456789012345", "0E-0", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31461 fma  1   1.123456789012345  0E-1   -> 1.123456789012345
+	{"fmax31461", "1", "1.123456789012345", "0E-1", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31462 fma  1   1.123456789012345  0E-2   -> 1.123456789012345
+	{"fmax31462", "1", "1.123456789012345", "0E-2", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31463 fma  1   1.123456789012345  0E-3   -> 1.123456789012345
+	{"fmax31463", "1", "1.123456789012345", "0E-3", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31464 fma  1   1.123456789012345  0E-4   -> 1.123456789012345
+	{"fmax31464", "1", "1.123456789012345", "0E-4", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31465 fma  1   1.123456789012345  0E-5   -> 1.123456789012345
+	{"fmax31465", "1", "1.123456789012345", "0E-5", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31466 fma  1   1.123456789012345  0E-6   -> 1.123456789012345
+	{"fmax31466", "1", "1.123456789012345", "0E-6", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31467 fma  1   1.123456789012345  0E-7   -> 1.123456789012345
+	{"fmax31467", "1", "1.123456789012345", "0E-7", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31468 fma  1   1.123456789012345  0E-8   -> 1.123456789012345
+	{"fmax31468", "1", "1.123456789012345", "0E-8", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31469 fma  1   1.123456789012345  0E-9   -> 1.123456789012345
+	{"fmax31469", "1", "1.123456789012345", "0E-9", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31470 fma  1   1.123456789012345  0E-10  -> 1.123456789012345
+	{"fmax31470", "1", "1.123456789012345", "0E-10", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31471 fma  1   1.123456789012345  0E-11  -> 1.123456789012345
+	{"fmax31471", "1", "1.123456789012345", "0E-11", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31472 fma  1   1.123456789012345  0E-12  -> 1.123456789012345
+	{"fmax31472", "1", "1.123456789012345", "0E-12", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31473 fma  1   1.123456789012345  0E-13  -> 1.123456789012345
+	{"fmax31473", "1", "1.123456789012345", "0E-13", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31474 fma  1   1.123456789012345  0E-14  -> 1.123456789012345
+	{"fmax31474", "1", "1.123456789012345", "0E-14", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31475 fma  1   1.123456789012345  0E-15  -> 1.123456789012345
+	{"fmax31475", "1", "1.123456789012345", "0E-15", "1.123456789012345", 0, 16, ToNearestAway, 384, -383, false},
+	// next four flag Rounded because the 0 extends the result
+	// fmax31476 fma  1   1.123456789012345  0E-16  -> 1.123456789012345 Rounded
+	{"fmax31476", "1", "1.123456789012345", "0E-16", "1.123456789012345", Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31477 fma  1   1.123456789012345  0E-17  -> 1.123456789012345 Rounded
+	{"fmax31477", "1", "1.123456789012345", "0E-17", "1.123456789012345", Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31478 fma  1   1.123456789012345  0E-18  -> 1.123456789012345 Rounded
+	{"fmax31478", "1", "1.123456789012345", "0E-18", "1.123456789012345", Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax31479 fma  1   1.123456789012345  0E-19  -> 1.123456789012345 Rounded
+	{"fmax31479", "1", "1.123456789012345", "0E-19", "1.123456789012345", Rounded, 16, ToNearestAway, 384, -383, false},
+	// sum of two opposite-sign operands is exactly 0 and floor => -0
+	// precision: 16
+	// maxexponent: 384
+	// minexponent: -383
+	// rounding: half_up
+	// exact zeros from zeros
+	// fmax31500 fma  1    0        0E-19  ->  0E-19
+	{"fmax31500", "1", "0", "0E-19", "0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31501 fma  1   -0        0E-19  ->  0E-19
+	{"fmax31501", "1", "-0", "0E-19", "0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31502 fma  1    0       -0E-19  ->  0E-19
+	{"fmax31502", "1", "0", "-0E-19", "0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31503 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31503", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax31504 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31504", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31505 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31505", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31506 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax31506", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31507 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31507", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// inexact zeros
+	// fmax31511 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31511", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31512 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31512", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31513 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31513", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31514 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31514", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31515 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31515", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31516 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax31516", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31517 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax31517", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax31518 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31518", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// rounding: half_down
+	// exact zeros from zeros
+	// fmax31520 fma  1    0        0E-19  ->  0E-19
+	{"fmax31520", "1", "0", "0E-19", "0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax31521 fma  1   -0        0E-19  ->  0E-19
+	{"fmax31521", "1", "-0", "0E-19", "0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax31522 fma  1    0       -0E-19  ->  0E-19
+	{"fmax31522", "1", "0", "-0E-19", "0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax31523 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31523", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax31524 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31524", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31525 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31525", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31526 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax31526", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31527 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31527", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// inexact zeros
+	// fmax31531 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31531", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31532 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31532", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31533 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31533", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31534 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31534", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31535 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31535", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31536 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax31536", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31537 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax31537", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax31538 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31538", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// rounding: half_even
+	// exact zeros from zeros
+	// fmax31540 fma  1    0        0E-19  ->  0E-19
+	{"fmax31540", "1", "0", "0E-19", "0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax31541 fma  1   -0        0E-19  ->  0E-19
+	{"fmax31541", "1", "-0", "0E-19", "0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax31542 fma  1    0       -0E-19  ->  0E-19
+	{"fmax31542", "1", "0", "-0E-19", "0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax31543 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31543", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax31544 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31544", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31545 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31545", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31546 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax31546", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31547 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31547", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// inexact zeros
+	// fmax31551 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31551", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31552 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31552", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31553 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31553", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31554 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31554", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31555 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31555", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31556 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax31556", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31557 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax31557", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax31558 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31558", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// rounding: up
+	// exact zeros from zeros
+	// fmax31560 fma  1    0        0E-19  ->  0E-19
+	{"fmax31560", "1", "0", "0E-19", "0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax31561 fma  1   -0        0E-19  ->  0E-19
+	{"fmax31561", "1", "-0", "0E-19", "0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax31562 fma  1    0       -0E-19  ->  0E-19
+	{"fmax31562", "1", "0", "-0E-19", "0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax31563 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31563", "1", "-0", "-0E-19", "-0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax31564 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31564", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax31565 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31565", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax31566 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax31566", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax31567 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31567", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// inexact zeros
+	// fmax31571 fma  1    1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31571", "1", "1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax31572 fma  1   -1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31572", "1", "-1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax31573 fma  1    1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31573", "1", "1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax31574 fma  1   -1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31574", "1", "-1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31575 fma  1    1E-401   1E-401 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31575", "1", "1E-401", "1E-401", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax31576 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax31576", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax31577 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax31577", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax31578 fma  1   -1E-401  -1E-401 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31578", "1", "-1E-401", "-1E-401", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// exact zeros from zeros
+	// fmax31580 fma  1    0        0E-19  ->  0E-19
+	{"fmax31580", "1", "0", "0E-19", "0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax31581 fma  1   -0        0E-19  ->  0E-19
+	{"fmax31581", "1", "-0", "0E-19", "0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax31582 fma  1    0       -0E-19  ->  0E-19
+	{"fmax31582", "1", "0", "-0E-19", "0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax31583 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31583", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax31584 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31584", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax31585 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31585", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax31586 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax31586", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax31587 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31587", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// inexact zeros
+	// fmax31591 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31591", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax31592 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31592", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax31593 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31593", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax31594 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31594", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31595 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31595", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax31596 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax31596", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax31597 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax31597", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax31598 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31598", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// rounding: ceiling
+	// exact zeros from zeros
+	// fmax31600 fma  1    0        0E-19  ->  0E-19
+	{"fmax31600", "1", "0", "0E-19", "0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax31601 fma  1   -0        0E-19  ->  0E-19
+	{"fmax31601", "1", "-0", "0E-19", "0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax31602 fma  1    0       -0E-19  ->  0E-19
+	{"fmax31602", "1", "0", "-0E-19", "0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax31603 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31603", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax31604 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31604", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax31605 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31605", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax31606 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax31606", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax31607 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31607", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// inexact zeros
+	// fmax31611 fma  1    1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31611", "1", "1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToPositiveInf, 384, -383, false},
+	// fmax31612 fma  1   -1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31612", "1", "-1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToPositiveInf, 384, -383, false},
+	// fmax31613 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31613", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax31614 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31614", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToPositiveInf, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31615 fma  1    1E-401   1E-401 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31615", "1", "1E-401", "1E-401", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToPositiveInf, 384, -383, false},
+	// fmax31616 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax31616", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax31617 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax31617", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax31618 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31618", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToPositiveInf, 384, -383, false},
+	// and the extra-special ugly case; unusual minuses marked by -- *
+	// rounding: floor
+	// exact zeros from zeros
+	// fmax31620 fma  1    0        0E-19  ->  0E-19
+	{"fmax31620", "1", "0", "0E-19", "0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax31621 fma  1   -0        0E-19  -> -0E-19           -- *
+	{"fmax31621", "1", "-0", "0E-19", "-0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax31622 fma  1    0       -0E-19  -> -0E-19           -- *
+	{"fmax31622", "1", "0", "-0E-19", "-0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax31623 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax31623", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax31624 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax31624", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31625 fma  1   -0E-400   0E-19  -> -0E-398 Clamped  -- *
+	{"fmax31625", "1", "-0E-400", "0E-19", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31626 fma  1    0E-400  -0E-19  -> -0E-398 Clamped  -- *
+	{"fmax31626", "1", "0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31627 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax31627", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// inexact zeros
+	// fmax31631 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31631", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31632 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31632", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31633 fma  1    1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31633", "1", "1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToNegativeInf, 384, -383, false},
+	// fmax31634 fma  1   -1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31634", "1", "-1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToNegativeInf, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax31635 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax31635", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31636 fma  1   -1E-401   1E-401 -> -0E-398 Clamped  -- *
+	{"fmax31636", "1", "-1E-401", "1E-401", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31637 fma  1    1E-401  -1E-401 -> -0E-398 Clamped  -- *
+	{"fmax31637", "1", "1E-401", "-1E-401", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax31638 fma  1   -1E-401  -1E-401 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax31638", "1", "-1E-401", "-1E-401", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToNegativeInf, 384, -383, false},
+	// BigDecimal problem testcases 2006.01.23
+	// precision: 16
+	// maxexponent: 384
+	// minexponent: -383
+	// rounding: down
+	// precision: 7
+	// fmax31651 fma  1    10001E+2  -2E+1 -> 1.00008E+6
+	{"fmax31651", "1", "10001E+2", "-2E+1", "1.00008E+6", 0, 7, ToZero, 384, -383, false},
+	// precision: 6
+	// fmax31652 fma  1    10001E+2  -2E+1 -> 1.00008E+6
+	{"fmax31652", "1", "10001E+2", "-2E+1", "1.00008E+6", 0, 6, ToZero, 384, -383, false},
+	// precision: 5
+	// fmax31653 fma  1    10001E+2  -2E+1 -> 1.0000E+6   Inexact Rounded
+	{"fmax31653", "1", "10001E+2", "-2E+1", "1.0000E+6", Inexact | Rounded, 5, ToZero, 384, -383, false},
+	// precision: 4
+	// fmax31654 fma  1    10001E+2  -2E+1 -> 1.000E+6    Inexact Rounded
+	{"fmax31654", "1", "10001E+2", "-2E+1", "1.000E+6", Inexact | Rounded, 4, ToZero, 384, -383, false},
+	// precision: 3
+	// fmax31655 fma  1    10001E+2  -2E+1 -> 1.00E+6     Inexact Rounded
+	{"fmax31655", "1", "10001E+2", "-2E+1", "1.00E+6", Inexact | Rounded, 3, ToZero, 384, -383, false},
+	// precision: 2
+	// fmax31656 fma  1    10001E+2  -2E+1 -> 1.0E+6      Inexact Rounded
+	{"fmax31656", "1", "10001E+2", "-2E+1", "1.0E+6", Inexact | Rounded, 2, ToZero, 384, -383, false},
+	// precision: 1
+	// fmax31657 fma  1    10001E+2  -2E+1 -> 1E+6        Inexact Rounded
+	{"fmax31657", "1", "10001E+2", "-2E+1", "1E+6", Inexact | Rounded, 1, ToZero, 384, -383, false},
+	// rounding: half_even
+	// precision: 7
+	// fmax31661 fma  1    10001E+2  -2E+1 -> 1.00008E+6
+	{"fmax31661", "1", "10001E+2", "-2E+1", "1.00008E+6", 0, 7, ToNearestEven, 384, -383, false},
+	// precision: 6
+	// fmax31662 fma  1    10001E+2  -2E+1 -> 1.00008E+6
+	{"fmax31662", "1", "10001E+2", "-2E+1", "1.00008E+6", 0, 6, ToNearestEven, 384, -383, false},
+	// precision: 5
+	// fmax31663 fma  1    10001E+2  -2E+1 -> 1.0001E+6   Inexact Rounded
+	{"fmax31663", "1", "10001E+2", "-2E+1", "1.0001E+6", Inexact | Rounded, 5, ToNearestEven, 384, -383, false},
+	// precision: 4
+	// fmax31664 fma  1    10001E+2  -2E+1 -> 1.000E+6    Inexact Rounded
+	{"fmax31664", "1", "10001E+2", "-2E+1", "1.000E+6", Inexact | Rounded, 4, ToNearestEven, 384, -383, false},
+	// precision: 3
+	// fmax31665 fma  1    10001E+2  -2E+1 -> 1.00E+6     Inexact Rounded
+	{"fmax31665", "1", "10001E+2", "-2E+1", "1.00E+6", Inexact | Rounded, 3, ToNearestEven, 384, -383, false},
+	// precision: 2
+	// fmax31666 fma  1    10001E+2  -2E+1 -> 1.0E+6      Inexact Rounded
+	{"fmax31666", "1", "10001E+2", "-2E+1", "1.0E+6", Inexact | Rounded, 2, ToNearestEven, 384, -383, false},
+	// precision: 1
+	// fmax31667 fma  1    10001E+2  -2E+1 -> 1E+6        Inexact Rounded
+	{"fmax31667", "1", "10001E+2", "-2E+1", "1E+6", Inexact | Rounded, 1, ToNearestEven, 384, -383, false},
+	// rounding: up
+	// precision: 7
+	// fmax31671 fma  1    10001E+2  -2E+1 -> 1.00008E+6
+	{"fmax31671", "1", "10001E+2", "-2E+1", "1.00008E+6", 0, 7, AwayFromZero, 384, -383, false},
+	// precision: 6
+	// fmax31672 fma  1    10001E+2  -2E+1 -> 1.00008E+6
+	{"fmax31672", "1", "10001E+2", "-2E+1", "1.00008E+6", 0, 6, AwayFromZero, 384, -383, false},
+	// precision: 5
+	// fmax31673 fma  1    10001E+2  -2E+1 -> 1.0001E+6   Inexact Rounded
+	{"fmax31673", "1", "10001E+2", "-2E+1", "1.0001E+6", Inexact | Rounded, 5, AwayFromZero, 384, -383, false},
+	// precision: 4
+	// fmax31674 fma  1    10001E+2  -2E+1 -> 1.001E+6    Inexact Rounded
+	{"fmax31674", "1", "10001E+2", "-2E+1", "1.001E+6", Inexact | Rounded, 4, AwayFromZero, 384, -383, false},
+	// precision: 3
+	// fmax31675 fma  1    10001E+2  -2E+1 -> 1.01E+6     Inexact Rounded
+	{"fmax31675", "1", "10001E+2", "-2E+1", "1.01E+6", Inexact | Rounded, 3, AwayFromZero, 384, -383, false},
+	// precision: 2
+	// fmax31676 fma  1    10001E+2  -2E+1 -> 1.1E+6      Inexact Rounded
+	{"fmax31676", "1", "10001E+2", "-2E+1", "1.1E+6", Inexact | Rounded, 2, AwayFromZero, 384, -383, false},
+	// precision: 1
+	// fmax31677 fma  1    10001E+2  -2E+1 -> 2E+6        Inexact Rounded
+	{"fmax31677", "1", "10001E+2", "-2E+1", "2E+6", Inexact | Rounded, 1, AwayFromZero, 384, -383, false},
+	// precision: 34
+	// rounding: half_up
+	// maxexponent: 6144
+	// minexponent: -6143
+	// Examples from SQL proposal (Krishna Kulkarni)
+	// fmax31701  fma  1   130E-2    120E-2    -> 2.50
+	{"fmax31701", "1", "130E-2", "120E-2", "2.50", 0, 34, ToNearestAway, 6144, -6143, false},
+	// fmax31702  fma  1   130E-2    12E-1     -> 2.50
+	{"fmax31702", "1", "130E-2", "12E-1", "2.50", 0, 34, ToNearestAway, 6144, -6143, false},
+	// fmax31703  fma  1   130E-2    1E0       -> 2.30
+	{"fmax31703", "1", "130E-2", "1E0", "2.30", 0, 34, ToNearestAway, 6144, -6143, false},
+	// fmax31704  fma  1   1E2       1E4       -> 1.01E+4
+	{"fmax31704", "1", "1E2", "1E4", "1.01E+4", 0, 34, ToNearestAway, 6144, -6143, false},
+	// SKIP (subtract not supported): fmax31705  subtract 130E-2  120E-2 -> 0.10
+	// SKIP (subtract not supported): fmax31706  subtract 130E-2  12E-1  -> 0.10
+	// SKIP (subtract not supported): fmax31707  subtract 130E-2  1E0    -> 0.30
+	// SKIP (subtract not supported): fmax31708  subtract 1E2     1E4    -> -9.9E+3
+	//----------------------------------------------------------------------
+	// Same as above, using decimal64 default parameters                  --
+	//----------------------------------------------------------------------
+	// precision: 16
+	// rounding: half_even
+	// maxexponent: 384
+	// minexponent: -383
+	// [first group are 'quick confidence check']
+	// fmax36001 fma  1   1       1       ->  2
+	{"fmax36001", "1", "1", "1", "2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36002 fma  1   2       3       ->  5
+	{"fmax36002", "1", "2", "3", "5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36003 fma  1   '5.75'  '3.3'   ->  9.05
+	{"fmax36003", "1", "5.75", "3.3", "9.05", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36004 fma  1   '5'     '-3'    ->  2
+	{"fmax36004", "1", "5", "-3", "2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36005 fma  1   '-5'    '-3'    ->  -8
+	{"fmax36005", "1", "-5", "-3", "-8", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36006 fma  1   '-7'    '2.5'   ->  -4.5
+	{"fmax36006", "1", "-7", "2.5", "-4.5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36007 fma  1   '0.7'   '0.3'   ->  1.0
+	{"fmax36007", "1", "0.7", "0.3", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36008 fma  1   '1.25'  '1.25'  ->  2.50
+	{"fmax36008", "1", "1.25", "1.25", "2.50", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36009 fma  1   '1.23456789'  '1.00000000' -> '2.23456789'
+	{"fmax36009", "1", "1.23456789", "1.00000000", "2.23456789", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36010 fma  1   '1.23456789'  '1.00000011' -> '2.23456800'
+	{"fmax36010", "1", "1.23456789", "1.00000011", "2.23456800", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36011 fma  1   '0.44444444444444444'  '0.55555555555555555' -> '1.000000000000000' Inexact Rounded
+	{"fmax36011", "1", "0.44444444444444444", "0.55555555555555555", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36012 fma  1   '0.44444444444444440'  '0.55555555555555555' -> '1.000000000000000' Inexact Rounded
+	{"fmax36012", "1", "0.44444444444444440", "0.55555555555555555", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36013 fma  1   '0.44444444444444444'  '0.55555555555555550' -> '0.9999999999999999' Inexact Rounded
+	{"fmax36013", "1", "0.44444444444444444", "0.55555555555555550", "0.9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36014 fma  1   '0.444444444444444449'    '0' -> '0.4444444444444444' Inexact Rounded
+	{"fmax36014", "1", "0.444444444444444449", "0", "0.4444444444444444", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36015 fma  1   '0.4444444444444444499'   '0' -> '0.4444444444444444' Inexact Rounded
+	{"fmax36015", "1", "0.4444444444444444499", "0", "0.4444444444444444", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36016 fma  1   '0.44444444444444444999'  '0' -> '0.4444444444444444' Inexact Rounded
+	{"fmax36016", "1", "0.44444444444444444999", "0", "0.4444444444444444", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36017 fma  1   '0.44444444444444445000'  '0' -> '0.4444444444444444' Inexact Rounded
+	{"fmax36017", "1", "0.44444444444444445000", "0", "0.4444444444444444", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36018 fma  1   '0.44444444444444445001'  '0' -> '0.4444444444444445' Inexact Rounded
+	{"fmax36018", "1", "0.44444444444444445001", "0", "0.4444444444444445", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36019 fma  1   '0.4444444444444444501'   '0' -> '0.4444444444444445' Inexact Rounded
+	{"fmax36019", "1", "0.4444444444444444501", "0", "0.4444444444444445", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36020 fma  1   '0.444444444444444451'    '0' -> '0.4444444444444445' Inexact Rounded
+	{"fmax36020", "1", "0.444444444444444451", "0", "0.4444444444444445", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36021 fma  1   0 1 -> 1
+	{"fmax36021", "1", "0", "1", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36022 fma  1   1 1 -> 2
+	{"fmax36022", "1", "1", "1", "2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36023 fma  1   2 1 -> 3
+	{"fmax36023", "1", "2", "1", "3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36024 fma  1   3 1 -> 4
+	{"fmax36024", "1", "3", "1", "4", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36025 fma  1   4 1 -> 5
+	{"fmax36025", "1", "4", "1", "5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36026 fma  1   5 1 -> 6
+	{"fmax36026", "1", "5", "1", "6", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36027 fma  1   6 1 -> 7
+	{"fmax36027", "1", "6", "1", "7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36028 fma  1   7 1 -> 8
+	{"fmax36028", "1", "7", "1", "8", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36029 fma  1   8 1 -> 9
+	{"fmax36029", "1", "8", "1", "9", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36030 fma  1   9 1 -> 10
+	{"fmax36030", "1", "9", "1", "10", 0, 16, ToNearestEven, 384, -383, false},
+	// some carrying effects
+	// fmax36031 fma  1   '0.9998'  '0.0000' -> '0.9998'
+	{"fmax36031", "1", "0.9998", "0.0000", "0.9998", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36032 fma  1   '0.9998'  '0.0001' -> '0.9999'
+	{"fmax36032", "1", "0.9998", "0.0001", "0.9999", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36033 fma  1   '0.9998'  '0.0002' -> '1.0000'
+	{"fmax36033", "1", "0.9998", "0.0002", "1.0000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36034 fma  1   '0.9998'  '0.0003' -> '1.0001'
+	{"fmax36034", "1", "0.9998", "0.0003", "1.0001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36035 fma  1   '70'      '10000e+16' -> '1.000000000000000E+20' Inexact Rounded
+	{"fmax36035", "1", "70", "10000e+16", "1.000000000000000E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36036 fma  1   '700'     '10000e+16' -> '1.000000000000000E+20' Inexact Rounded
+	{"fmax36036", "1", "700", "10000e+16", "1.000000000000000E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36037 fma  1   '7000'    '10000e+16' -> '1.000000000000000E+20' Inexact Rounded
+	{"fmax36037", "1", "7000", "10000e+16", "1.000000000000000E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36038 fma  1   '70000'   '10000e+16' -> '1.000000000000001E+20' Inexact Rounded
+	{"fmax36038", "1", "70000", "10000e+16", "1.000000000000001E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36039 fma  1   '700000'  '10000e+16' -> '1.000000000000007E+20' Rounded
+	{"fmax36039", "1", "700000", "10000e+16", "1.000000000000007E+20", Rounded, 16, ToNearestEven, 384, -383, false},
+	// symmetry:
+	// fmax36040 fma  1   '10000e+16'  '70' -> '1.000000000000000E+20' Inexact Rounded
+	{"fmax36040", "1", "10000e+16", "70", "1.000000000000000E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36041 fma  1   '10000e+16'  '700' -> '1.000000000000000E+20' Inexact Rounded
+	{"fmax36041", "1", "10000e+16", "700", "1.000000000000000E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36042 fma  1   '10000e+16'  '7000' -> '1.000000000000000E+20' Inexact Rounded
+	{"fmax36042", "1", "10000e+16", "7000", "1.000000000000000E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36044 fma  1   '10000e+16'  '70000' -> '1.000000000000001E+20' Inexact Rounded
+	{"fmax36044", "1", "10000e+16", "70000", "1.000000000000001E+20", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36045 fma  1   '10000e+16'  '700000' -> '1.000000000000007E+20' Rounded
+	{"fmax36045", "1", "10000e+16", "700000", "1.000000000000007E+20", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36046 fma  1   '10000e+9'  '7' -> '10000000000007'
+	{"fmax36046", "1", "10000e+9", "7", "10000000000007", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36047 fma  1   '10000e+9'  '70' -> '10000000000070'
+	{"fmax36047", "1", "10000e+9", "70", "10000000000070", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36048 fma  1   '10000e+9'  '700' -> '10000000000700'
+	{"fmax36048", "1", "10000e+9", "700", "10000000000700", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36049 fma  1   '10000e+9'  '7000' -> '10000000007000'
+	{"fmax36049", "1", "10000e+9", "7000", "10000000007000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36050 fma  1   '10000e+9'  '70000' -> '10000000070000'
+	{"fmax36050", "1", "10000e+9", "70000", "10000000070000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36051 fma  1   '10000e+9'  '700000' -> '10000000700000'
+	{"fmax36051", "1", "10000e+9", "700000", "10000000700000", 0, 16, ToNearestEven, 384, -383, false},
+	// examples from decarith
+	// fmax36053 fma  1   '12' '7.00' -> '19.00'
+	{"fmax36053", "1", "12", "7.00", "19.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36054 fma  1   '1.3' '-1.07' -> '0.23'
+	{"fmax36054", "1", "1.3", "-1.07", "0.23", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36055 fma  1   '1.3' '-1.30' -> '0.00'
+	{"fmax36055", "1", "1.3", "-1.30", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36056 fma  1   '1.3' '-2.07' -> '-0.77'
+	{"fmax36056", "1", "1.3", "-2.07", "-0.77", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36057 fma  1   '1E+2' '1E+4' -> '1.01E+4'
+	{"fmax36057", "1", "1E+2", "1E+4", "1.01E+4", 0, 16, ToNearestEven, 384, -383, false},
+	// from above
+	// fmax36061 fma  1   1 '0.1' -> '1.1'
+	{"fmax36061", "1", "1", "0.1", "1.1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36062 fma  1   1 '0.01' -> '1.01'
+	{"fmax36062", "1", "1", "0.01", "1.01", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36063 fma  1   1 '0.001' -> '1.001'
+	{"fmax36063", "1", "1", "0.001", "1.001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36064 fma  1   1 '0.0001' -> '1.0001'
+	{"fmax36064", "1", "1", "0.0001", "1.0001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36065 fma  1   1 '0.00001' -> '1.00001'
+	{"fmax36065", "1", "1", "0.00001", "1.00001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36066 fma  1   1 '0.000001' -> '1.000001'
+	{"fmax36066", "1", "1", "0.000001", "1.000001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36067 fma  1   1 '0.0000001' -> '1.0000001'
+	{"fmax36067", "1", "1", "0.0000001", "1.0000001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36068 fma  1   1 '0.00000001' -> '1.00000001'
+	{"fmax36068", "1", "1", "0.00000001", "1.00000001", 0, 16, ToNearestEven, 384, -383, false},
+	// some funny zeros [in case of bad signum]
+	// fmax36070 fma  1   1  0    -> 1
+	{"fmax36070", "1", "1", "0", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36071 fma  1   1 0.    -> 1
+	{"fmax36071", "1", "1", "0.", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36072 fma  1   1  .0   -> 1.0
+	{"fmax36072", "1", "1", ".0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36073 fma  1   1 0.0   -> 1.0
+	{"fmax36073", "1", "1", "0.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36074 fma  1   1 0.00  -> 1.00
+	{"fmax36074", "1", "1", "0.00", "1.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36075 fma  1    0  1   -> 1
+	{"fmax36075", "1", "0", "1", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36076 fma  1   0.  1   -> 1
+	{"fmax36076", "1", "0.", "1", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36077 fma  1    .0 1   -> 1.0
+	{"fmax36077", "1", ".0", "1", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36078 fma  1   0.0 1   -> 1.0
+	{"fmax36078", "1", "0.0", "1", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36079 fma  1   0.00 1  -> 1.00
+	{"fmax36079", "1", "0.00", "1", "1.00", 0, 16, ToNearestEven, 384, -383, false},
+	// some carries
+	// fmax36080 fma  1   9999999999999998 1  -> 9999999999999999
+	{"fmax36080", "1", "9999999999999998", "1", "9999999999999999", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36081 fma  1   9999999999999999 1  -> 1.000000000000000E+16 Rounded
+	{"fmax36081", "1", "9999999999999999", "1", "1.000000000000000E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36082 fma  1    999999999999999 1  -> 1000000000000000
+	{"fmax36082", "1", "999999999999999", "1", "1000000000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36083 fma  1      9999999999999 1  -> 10000000000000
+	{"fmax36083", "1", "9999999999999", "1", "10000000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36084 fma  1        99999999999 1  -> 100000000000
+	{"fmax36084", "1", "99999999999", "1", "100000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36085 fma  1          999999999 1  -> 1000000000
+	{"fmax36085", "1", "999999999", "1", "1000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36086 fma  1            9999999 1  -> 10000000
+	{"fmax36086", "1", "9999999", "1", "10000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36087 fma  1              99999 1  -> 100000
+	{"fmax36087", "1", "99999", "1", "100000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36088 fma  1                999 1  -> 1000
+	{"fmax36088", "1", "999", "1", "1000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36089 fma  1                  9 1  -> 10
+	{"fmax36089", "1", "9", "1", "10", 0, 16, ToNearestEven, 384, -383, false},
+	// more LHS swaps
+	// fmax36090 fma  1   '-56267E-10'   0 ->  '-0.0000056267'
+	{"fmax36090", "1", "-56267E-10", "0", "-0.0000056267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36091 fma  1   '-56267E-6'    0 ->  '-0.056267'
+	{"fmax36091", "1", "-56267E-6", "0", "-0.056267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36092 fma  1   '-56267E-5'    0 ->  '-0.56267'
+	{"fmax36092", "1", "-56267E-5", "0", "-0.56267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36093 fma  1   '-56267E-4'    0 ->  '-5.6267'
+	{"fmax36093", "1", "-56267E-4", "0", "-5.6267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36094 fma  1   '-56267E-3'    0 ->  '-56.267'
+	{"fmax36094", "1", "-56267E-3", "0", "-56.267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36095 fma  1   '-56267E-2'    0 ->  '-562.67'
+	{"fmax36095", "1", "-56267E-2", "0", "-562.67", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36096 fma  1   '-56267E-1'    0 ->  '-5626.7'
+	{"fmax36096", "1", "-56267E-1", "0", "-5626.7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36097 fma  1   '-56267E-0'    0 ->  '-56267'
+	{"fmax36097", "1", "-56267E-0", "0", "-56267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36098 fma  1   '-5E-10'       0 ->  '-5E-10'
+	{"fmax36098", "1", "-5E-10", "0", "-5E-10", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36099 fma  1   '-5E-7'        0 ->  '-5E-7'
+	{"fmax36099", "1", "-5E-7", "0", "-5E-7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36100 fma  1   '-5E-6'        0 ->  '-0.000005'
+	{"fmax36100", "1", "-5E-6", "0", "-0.000005", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36101 fma  1   '-5E-5'        0 ->  '-0.00005'
+	{"fmax36101", "1", "-5E-5", "0", "-0.00005", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36102 fma  1   '-5E-4'        0 ->  '-0.0005'
+	{"fmax36102", "1", "-5E-4", "0", "-0.0005", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36103 fma  1   '-5E-1'        0 ->  '-0.5'
+	{"fmax36103", "1", "-5E-1", "0", "-0.5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36104 fma  1   '-5E0'         0 ->  '-5'
+	{"fmax36104", "1", "-5E0", "0", "-5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36105 fma  1   '-5E1'         0 ->  '-50'
+	{"fmax36105", "1", "-5E1", "0", "-50", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36106 fma  1   '-5E5'         0 ->  '-500000'
+	{"fmax36106", "1", "-5E5", "0", "-500000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36107 fma  1   '-5E15'        0 ->  '-5000000000000000'
+	{"fmax36107", "1", "-5E15", "0", "-5000000000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36108 fma  1   '-5E16'        0 ->  '-5.000000000000000E+16'   Rounded
+	{"fmax36108", "1", "-5E16", "0", "-5.000000000000000E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36109 fma  1   '-5E17'        0 ->  '-5.000000000000000E+17'  Rounded
+	{"fmax36109", "1", "-5E17", "0", "-5.000000000000000E+17", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36110 fma  1   '-5E18'        0 ->  '-5.000000000000000E+18'  Rounded
+	{"fmax36110", "1", "-5E18", "0", "-5.000000000000000E+18", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36111 fma  1   '-5E100'       0 ->  '-5.000000000000000E+100' Rounded
+	{"fmax36111", "1", "-5E100", "0", "-5.000000000000000E+100", Rounded, 16, ToNearestEven, 384, -383, false},
+	// more RHS swaps
+	// fmax36113 fma  1   0  '-56267E-10' ->  '-0.0000056267'
+	{"fmax36113", "1", "0", "-56267E-10", "-0.0000056267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36114 fma  1   0  '-56267E-6'  ->  '-0.056267'
+	{"fmax36114", "1", "0", "-56267E-6", "-0.056267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36116 fma  1   0  '-56267E-5'  ->  '-0.56267'
+	{"fmax36116", "1", "0", "-56267E-5", "-0.56267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36117 fma  1   0  '-56267E-4'  ->  '-5.6267'
+	{"fmax36117", "1", "0", "-56267E-4", "-5.6267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36119 fma  1   0  '-56267E-3'  ->  '-56.267'
+	{"fmax36119", "1", "0", "-56267E-3", "-56.267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36120 fma  1   0  '-56267E-2'  ->  '-562.67'
+	{"fmax36120", "1", "0", "-56267E-2", "-562.67", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36121 fma  1   0  '-56267E-1'  ->  '-5626.7'
+	{"fmax36121", "1", "0", "-56267E-1", "-5626.7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36122 fma  1   0  '-56267E-0'  ->  '-56267'
+	{"fmax36122", "1", "0", "-56267E-0", "-56267", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36123 fma  1   0  '-5E-10'     ->  '-5E-10'
+	{"fmax36123", "1", "0", "-5E-10", "-5E-10", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36124 fma  1   0  '-5E-7'      ->  '-5E-7'
+	{"fmax36124", "1", "0", "-5E-7", "-5E-7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36125 fma  1   0  '-5E-6'      ->  '-0.000005'
+	{"fmax36125", "1", "0", "-5E-6", "-0.000005", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36126 fma  1   0  '-5E-5'      ->  '-0.00005'
+	{"fmax36126", "1", "0", "-5E-5", "-0.00005", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36127 fma  1   0  '-5E-4'      ->  '-0.0005'
+	{"fmax36127", "1", "0", "-5E-4", "-0.0005", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36128 fma  1   0  '-5E-1'      ->  '-0.5'
+	{"fmax36128", "1", "0", "-5E-1", "-0.5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36129 fma  1   0  '-5E0'       ->  '-5'
+	{"fmax36129", "1", "0", "-5E0", "-5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36130 fma  1   0  '-5E1'       ->  '-50'
+	{"fmax36130", "1", "0", "-5E1", "-50", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36131 fma  1   0  '-5E5'       ->  '-500000'
+	{"fmax36131", "1", "0", "-5E5", "-500000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36132 fma  1   0  '-5E15'      ->  '-5000000000000000'
+	{"fmax36132", "1", "0", "-5E15", "-5000000000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36133 fma  1   0  '-5E16'      ->  '-5.000000000000000E+16'   Rounded
+	{"fmax36133", "1", "0", "-5E16", "-5.000000000000000E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36134 fma  1   0  '-5E17'      ->  '-5.000000000000000E+17'   Rounded
+	{"fmax36134", "1", "0", "-5E17", "-5.000000000000000E+17", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36135 fma  1   0  '-5E18'      ->  '-5.000000000000000E+18'   Rounded
+	{"fmax36135", "1", "0", "-5E18", "-5.000000000000000E+18", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36136 fma  1   0  '-5E100'     ->  '-5.000000000000000E+100'  Rounded
+	{"fmax36136", "1", "0", "-5E100", "-5.000000000000000E+100", Rounded, 16, ToNearestEven, 384, -383, false},
+	// related
+	// fmax36137 fma  1    1  '0E-19'      ->  '1.000000000000000'  Rounded
+	{"fmax36137", "1", "1", "0E-19", "1.000000000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36138 fma  1   -1  '0E-19'      ->  '-1.000000000000000' Rounded
+	{"fmax36138", "1", "-1", "0E-19", "-1.000000000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36139 fma  1   '0E-19' 1        ->  '1.000000000000000'  Rounded
+	{"fmax36139", "1", "0E-19", "1", "1.000000000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36140 fma  1   '0E-19' -1       ->  '-1.000000000000000' Rounded
+	{"fmax36140", "1", "0E-19", "-1", "-1.000000000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36141 fma  1   1E+11   0.0000   ->  '100000000000.0000'
+	{"fmax36141", "1", "1E+11", "0.0000", "100000000000.0000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36142 fma  1   1E+11   0.00000  ->  '100000000000.0000'  Rounded
+	{"fmax36142", "1", "1E+11", "0.00000", "100000000000.0000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36143 fma  1   0.000   1E+12    ->  '1000000000000.000'
+	{"fmax36143", "1", "0.000", "1E+12", "1000000000000.000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36144 fma  1   0.0000  1E+12    ->  '1000000000000.000'  Rounded
+	{"fmax36144", "1", "0.0000", "1E+12", "1000000000000.000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// [some of the next group are really constructor tests]
+	// fmax36146 fma  1   '00.0'  0       ->  '0.0'
+	{"fmax36146", "1", "00.0", "0", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36147 fma  1   '0.00'  0       ->  '0.00'
+	{"fmax36147", "1", "0.00", "0", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36148 fma  1    0      '0.00'  ->  '0.00'
+	{"fmax36148", "1", "0", "0.00", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36149 fma  1    0      '00.0'  ->  '0.0'
+	{"fmax36149", "1", "0", "00.0", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36150 fma  1   '00.0'  '0.00'  ->  '0.00'
+	{"fmax36150", "1", "00.0", "0.00", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36151 fma  1   '0.00'  '00.0'  ->  '0.00'
+	{"fmax36151", "1", "0.00", "00.0", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36152 fma  1   '3'     '.3'    ->  '3.3'
+	{"fmax36152", "1", "3", ".3", "3.3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36153 fma  1   '3.'    '.3'    ->  '3.3'
+	{"fmax36153", "1", "3.", ".3", "3.3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36154 fma  1   '3.0'   '.3'    ->  '3.3'
+	{"fmax36154", "1", "3.0", ".3", "3.3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36155 fma  1   '3.00'  '.3'    ->  '3.30'
+	{"fmax36155", "1", "3.00", ".3", "3.30", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36156 fma  1   '3'     '3'     ->  '6'
+	{"fmax36156", "1", "3", "3", "6", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36157 fma  1   '3'     '+3'    ->  '6'
+	{"fmax36157", "1", "3", "+3", "6", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36158 fma  1   '3'     '-3'    ->  '0'
+	{"fmax36158", "1", "3", "-3", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36159 fma  1   '0.3'   '-0.3'  ->  '0.0'
+	{"fmax36159", "1", "0.3", "-0.3", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36160 fma  1   '0.03'  '-0.03' ->  '0.00'
+	{"fmax36160", "1", "0.03", "-0.03", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// try borderline precision, with carries, etc.
+	// fmax36161 fma  1   '1E+13' '-1'    -> '9999999999999'
+	{"fmax36161", "1", "1E+13", "-1", "9999999999999", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36162 fma  1   '1E+13'  '1.11' -> '10000000000001.11'
+	{"fmax36162", "1", "1E+13", "1.11", "10000000000001.11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36163 fma  1   '1.11'  '1E+13' -> '10000000000001.11'
+	{"fmax36163", "1", "1.11", "1E+13", "10000000000001.11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36164 fma  1   '-1'    '1E+13' -> '9999999999999'
+	{"fmax36164", "1", "-1", "1E+13", "9999999999999", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36165 fma  1   '7E+13' '-1'    -> '69999999999999'
+	{"fmax36165", "1", "7E+13", "-1", "69999999999999", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36166 fma  1   '7E+13'  '1.11' -> '70000000000001.11'
+	{"fmax36166", "1", "7E+13", "1.11", "70000000000001.11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36167 fma  1   '1.11'  '7E+13' -> '70000000000001.11'
+	{"fmax36167", "1", "1.11", "7E+13", "70000000000001.11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36168 fma  1   '-1'    '7E+13' -> '69999999999999'
+	{"fmax36168", "1", "-1", "7E+13", "69999999999999", 0, 16, ToNearestEven, 384, -383, false},
+	//                    1234567890123456      1234567890123456      1 234567890123456
+	// fmax36170 fma  1   '0.4444444444444444'  '0.5555555555555563' -> '1.000000000000001' Inexact Rounded
+	{"fmax36170", "1", "0.4444444444444444", "0.5555555555555563", "1.000000000000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36171 fma  1   '0.4444444444444444'  '0.5555555555555562' -> '1.000000000000001' Inexact Rounded
+	{"fmax36171", "1", "0.4444444444444444", "0.5555555555555562", "1.000000000000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36172 fma  1   '0.4444444444444444'  '0.5555555555555561' -> '1.000000000000000' Inexact Rounded
+	{"fmax36172", "1", "0.4444444444444444", "0.5555555555555561", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36173 fma  1   '0.4444444444444444'  '0.5555555555555560' -> '1.000000000000000' Inexact Rounded
+	{"fmax36173", "1", "0.4444444444444444", "0.5555555555555560", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36174 fma  1   '0.4444444444444444'  '0.5555555555555559' -> '1.000000000000000' Inexact Rounded
+	{"fmax36174", "1", "0.4444444444444444", "0.5555555555555559", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36175 fma  1   '0.4444444444444444'  '0.5555555555555558' -> '1.000000000000000' Inexact Rounded
+	{"fmax36175", "1", "0.4444444444444444", "0.5555555555555558", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36176 fma  1   '0.4444444444444444'  '0.5555555555555557' -> '1.000000000000000' Inexact Rounded
+	{"fmax36176", "1", "0.4444444444444444", "0.5555555555555557", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36177 fma  1   '0.4444444444444444'  '0.5555555555555556' -> '1.000000000000000' Rounded
+	{"fmax36177", "1", "0.4444444444444444", "0.5555555555555556", "1.000000000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36178 fma  1   '0.4444444444444444'  '0.5555555555555555' -> '0.9999999999999999'
+	{"fmax36178", "1", "0.4444444444444444", "0.5555555555555555", "0.9999999999999999", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36179 fma  1   '0.4444444444444444'  '0.5555555555555554' -> '0.9999999999999998'
+	{"fmax36179", "1", "0.4444444444444444", "0.5555555555555554", "0.9999999999999998", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36180 fma  1   '0.4444444444444444'  '0.5555555555555553' -> '0.9999999999999997'
+	{"fmax36180", "1", "0.4444444444444444", "0.5555555555555553", "0.9999999999999997", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36181 fma  1   '0.4444444444444444'  '0.5555555555555552' -> '0.9999999999999996'
+	{"fmax36181", "1", "0.4444444444444444", "0.5555555555555552", "0.9999999999999996", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36182 fma  1   '0.4444444444444444'  '0.5555555555555551' -> '0.9999999999999995'
+	{"fmax36182", "1", "0.4444444444444444", "0.5555555555555551", "0.9999999999999995", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36183 fma  1   '0.4444444444444444'  '0.5555555555555550' -> '0.9999999999999994'
+	{"fmax36183", "1", "0.4444444444444444", "0.5555555555555550", "0.9999999999999994", 0, 16, ToNearestEven, 384, -383, false},
+	// and some more, including residue effects and different roundings
+	// rounding: half_up
+	// fmax36200 fma  1   '6543210123456789' 0             -> '6543210123456789'
+	{"fmax36200", "1", "6543210123456789", "0", "6543210123456789", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax36201 fma  1   '6543210123456789' 0.000000001   -> '6543210123456789' Inexact Rounded
+	{"fmax36201", "1", "6543210123456789", "0.000000001", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36202 fma  1   '6543210123456789' 0.000001      -> '6543210123456789' Inexact Rounded
+	{"fmax36202", "1", "6543210123456789", "0.000001", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36203 fma  1   '6543210123456789' 0.1           -> '6543210123456789' Inexact Rounded
+	{"fmax36203", "1", "6543210123456789", "0.1", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36204 fma  1   '6543210123456789' 0.4           -> '6543210123456789' Inexact Rounded
+	{"fmax36204", "1", "6543210123456789", "0.4", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36205 fma  1   '6543210123456789' 0.49          -> '6543210123456789' Inexact Rounded
+	{"fmax36205", "1", "6543210123456789", "0.49", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36206 fma  1   '6543210123456789' 0.499999      -> '6543210123456789' Inexact Rounded
+	{"fmax36206", "1", "6543210123456789", "0.499999", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36207 fma  1   '6543210123456789' 0.499999   -> '6543210123456789' Inexact Rounded
+	{"fmax36207", "1", "6543210123456789", "0.499999", "6543210123456789", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36208 fma  1   '6543210123456789' 0.5           -> '6543210123456790' Inexact Rounded
+	{"fmax36208", "1", "6543210123456789", "0.5", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36209 fma  1   '6543210123456789' 0.500000001   -> '6543210123456790' Inexact Rounded
+	{"fmax36209", "1", "6543210123456789", "0.500000001", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36210 fma  1   '6543210123456789' 0.500001      -> '6543210123456790' Inexact Rounded
+	{"fmax36210", "1", "6543210123456789", "0.500001", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36211 fma  1   '6543210123456789' 0.51          -> '6543210123456790' Inexact Rounded
+	{"fmax36211", "1", "6543210123456789", "0.51", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36212 fma  1   '6543210123456789' 0.6           -> '6543210123456790' Inexact Rounded
+	{"fmax36212", "1", "6543210123456789", "0.6", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36213 fma  1   '6543210123456789' 0.9           -> '6543210123456790' Inexact Rounded
+	{"fmax36213", "1", "6543210123456789", "0.9", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36214 fma  1   '6543210123456789' 0.99999       -> '6543210123456790' Inexact Rounded
+	{"fmax36214", "1", "6543210123456789", "0.99999", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36215 fma  1   '6543210123456789' 0.999999   -> '6543210123456790' Inexact Rounded
+	{"fmax36215", "1", "6543210123456789", "0.999999", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36216 fma  1   '6543210123456789' 1             -> '6543210123456790'
+	{"fmax36216", "1", "6543210123456789", "1", "6543210123456790", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax36217 fma  1   '6543210123456789' 1.000000001   -> '6543210123456790' Inexact Rounded
+	{"fmax36217", "1", "6543210123456789", "1.000000001", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36218 fma  1   '6543210123456789' 1.00001       -> '6543210123456790' Inexact Rounded
+	{"fmax36218", "1", "6543210123456789", "1.00001", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// fmax36219 fma  1   '6543210123456789' 1.1           -> '6543210123456790' Inexact Rounded
+	{"fmax36219", "1", "6543210123456789", "1.1", "6543210123456790", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// rounding: half_even
+	// fmax36220 fma  1   '6543210123456789' 0             -> '6543210123456789'
+	{"fmax36220", "1", "6543210123456789", "0", "6543210123456789", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36221 fma  1   '6543210123456789' 0.000000001   -> '6543210123456789' Inexact Rounded
+	{"fmax36221", "1", "6543210123456789", "0.000000001", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36222 fma  1   '6543210123456789' 0.000001      -> '6543210123456789' Inexact Rounded
+	{"fmax36222", "1", "6543210123456789", "0.000001", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36223 fma  1   '6543210123456789' 0.1           -> '6543210123456789' Inexact Rounded
+	{"fmax36223", "1", "6543210123456789", "0.1", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36224 fma  1   '6543210123456789' 0.4           -> '6543210123456789' Inexact Rounded
+	{"fmax36224", "1", "6543210123456789", "0.4", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36225 fma  1   '6543210123456789' 0.49          -> '6543210123456789' Inexact Rounded
+	{"fmax36225", "1", "6543210123456789", "0.49", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36226 fma  1   '6543210123456789' 0.499999      -> '6543210123456789' Inexact Rounded
+	{"fmax36226", "1", "6543210123456789", "0.499999", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36227 fma  1   '6543210123456789' 0.499999   -> '6543210123456789' Inexact Rounded
+	{"fmax36227", "1", "6543210123456789", "0.499999", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36228 fma  1   '6543210123456789' 0.5           -> '6543210123456790' Inexact Rounded
+	{"fmax36228", "1", "6543210123456789", "0.5", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36229 fma  1   '6543210123456789' 0.500000001   -> '6543210123456790' Inexact Rounded
+	{"fmax36229", "1", "6543210123456789", "0.500000001", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36230 fma  1   '6543210123456789' 0.500001      -> '6543210123456790' Inexact Rounded
+	{"fmax36230", "1", "6543210123456789", "0.500001", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36231 fma  1   '6543210123456789' 0.51          -> '6543210123456790' Inexact Rounded
+	{"fmax36231", "1", "6543210123456789", "0.51", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36232 fma  1   '6543210123456789' 0.6           -> '6543210123456790' Inexact Rounded
+	{"fmax36232", "1", "6543210123456789", "0.6", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36233 fma  1   '6543210123456789' 0.9           -> '6543210123456790' Inexact Rounded
+	{"fmax36233", "1", "6543210123456789", "0.9", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36234 fma  1   '6543210123456789' 0.99999       -> '6543210123456790' Inexact Rounded
+	{"fmax36234", "1", "6543210123456789", "0.99999", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36235 fma  1   '6543210123456789' 0.999999   -> '6543210123456790' Inexact Rounded
+	{"fmax36235", "1", "6543210123456789", "0.999999", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36236 fma  1   '6543210123456789' 1             -> '6543210123456790'
+	{"fmax36236", "1", "6543210123456789", "1", "6543210123456790", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36237 fma  1   '6543210123456789' 1.00000001    -> '6543210123456790' Inexact Rounded
+	{"fmax36237", "1", "6543210123456789", "1.00000001", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36238 fma  1   '6543210123456789' 1.00001       -> '6543210123456790' Inexact Rounded
+	{"fmax36238", "1", "6543210123456789", "1.00001", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36239 fma  1   '6543210123456789' 1.1           -> '6543210123456790' Inexact Rounded
+	{"fmax36239", "1", "6543210123456789", "1.1", "6543210123456790", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// critical few with even bottom digit...
+	// fmax36240 fma  1   '6543210123456788' 0.499999   -> '6543210123456788' Inexact Rounded
+	{"fmax36240", "1", "6543210123456788", "0.499999", "6543210123456788", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36241 fma  1   '6543210123456788' 0.5           -> '6543210123456788' Inexact Rounded
+	{"fmax36241", "1", "6543210123456788", "0.5", "6543210123456788", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36242 fma  1   '6543210123456788' 0.500000001   -> '6543210123456789' Inexact Rounded
+	{"fmax36242", "1", "6543210123456788", "0.500000001", "6543210123456789", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// rounding: down
+	// fmax36250 fma  1   '6543210123456789' 0             -> '6543210123456789'
+	{"fmax36250", "1", "6543210123456789", "0", "6543210123456789", 0, 16, ToZero, 384, -383, false},
+	// fmax36251 fma  1   '6543210123456789' 0.000000001   -> '6543210123456789' Inexact Rounded
+	{"fmax36251", "1", "6543210123456789", "0.000000001", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36252 fma  1   '6543210123456789' 0.000001      -> '6543210123456789' Inexact Rounded
+	{"fmax36252", "1", "6543210123456789", "0.000001", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36253 fma  1   '6543210123456789' 0.1           -> '6543210123456789' Inexact Rounded
+	{"fmax36253", "1", "6543210123456789", "0.1", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36254 fma  1   '6543210123456789' 0.4           -> '6543210123456789' Inexact Rounded
+	{"fmax36254", "1", "6543210123456789", "0.4", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36255 fma  1   '6543210123456789' 0.49          -> '6543210123456789' Inexact Rounded
+	{"fmax36255", "1", "6543210123456789", "0.49", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36256 fma  1   '6543210123456789' 0.499999      -> '6543210123456789' Inexact Rounded
+	{"fmax36256", "1", "6543210123456789", "0.499999", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36257 fma  1   '6543210123456789' 0.499999   -> '6543210123456789' Inexact Rounded
+	{"fmax36257", "1", "6543210123456789", "0.499999", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36258 fma  1   '6543210123456789' 0.5           -> '6543210123456789' Inexact Rounded
+	{"fmax36258", "1", "6543210123456789", "0.5", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36259 fma  1   '6543210123456789' 0.500000001   -> '6543210123456789' Inexact Rounded
+	{"fmax36259", "1", "6543210123456789", "0.500000001", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36260 fma  1   '6543210123456789' 0.500001      -> '6543210123456789' Inexact Rounded
+	{"fmax36260", "1", "6543210123456789", "0.500001", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36261 fma  1   '6543210123456789' 0.51          -> '6543210123456789' Inexact Rounded
+	{"fmax36261", "1", "6543210123456789", "0.51", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36262 fma  1   '6543210123456789' 0.6           -> '6543210123456789' Inexact Rounded
+	{"fmax36262", "1", "6543210123456789", "0.6", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36263 fma  1   '6543210123456789' 0.9           -> '6543210123456789' Inexact Rounded
+	{"fmax36263", "1", "6543210123456789", "0.9", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36264 fma  1   '6543210123456789' 0.99999       -> '6543210123456789' Inexact Rounded
+	{"fmax36264", "1", "6543210123456789", "0.99999", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36265 fma  1   '6543210123456789' 0.999999   -> '6543210123456789' Inexact Rounded
+	{"fmax36265", "1", "6543210123456789", "0.999999", "6543210123456789", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36266 fma  1   '6543210123456789' 1             -> '6543210123456790'
+	{"fmax36266", "1", "6543210123456789", "1", "6543210123456790", 0, 16, ToZero, 384, -383, false},
+	// fmax36267 fma  1   '6543210123456789' 1.00000001    -> '6543210123456790' Inexact Rounded
+	{"fmax36267", "1", "6543210123456789", "1.00000001", "6543210123456790", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36268 fma  1   '6543210123456789' 1.00001       -> '6543210123456790' Inexact Rounded
+	{"fmax36268", "1", "6543210123456789", "1.00001", "6543210123456790", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36269 fma  1   '6543210123456789' 1.1           -> '6543210123456790' Inexact Rounded
+	{"fmax36269", "1", "6543210123456789", "1.1", "6543210123456790", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// 1 in last place tests
+	// rounding: half_even
+	// fmax36301 fma  1    -1   1      ->   0
+	{"fmax36301", "1", "-1", "1", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36302 fma  1     0   1      ->   1
+	{"fmax36302", "1", "0", "1", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36303 fma  1     1   1      ->   2
+	{"fmax36303", "1", "1", "1", "2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36304 fma  1    12   1      ->  13
+	{"fmax36304", "1", "12", "1", "13", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36305 fma  1    98   1      ->  99
+	{"fmax36305", "1", "98", "1", "99", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36306 fma  1    99   1      -> 100
+	{"fmax36306", "1", "99", "1", "100", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36307 fma  1   100   1      -> 101
+	{"fmax36307", "1", "100", "1", "101", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36308 fma  1   101   1      -> 102
+	{"fmax36308", "1", "101", "1", "102", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36309 fma  1    -1  -1      ->  -2
+	{"fmax36309", "1", "-1", "-1", "-2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36310 fma  1     0  -1      ->  -1
+	{"fmax36310", "1", "0", "-1", "-1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36311 fma  1     1  -1      ->   0
+	{"fmax36311", "1", "1", "-1", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36312 fma  1    12  -1      ->  11
+	{"fmax36312", "1", "12", "-1", "11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36313 fma  1    98  -1      ->  97
+	{"fmax36313", "1", "98", "-1", "97", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36314 fma  1    99  -1      ->  98
+	{"fmax36314", "1", "99", "-1", "98", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36315 fma  1   100  -1      ->  99
+	{"fmax36315", "1", "100", "-1", "99", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36316 fma  1   101  -1      -> 100
+	{"fmax36316", "1", "101", "-1", "100", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36321 fma  1   -0.01  0.01    ->  0.00
+	{"fmax36321", "1", "-0.01", "0.01", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36322 fma  1    0.00  0.01    ->  0.01
+	{"fmax36322", "1", "0.00", "0.01", "0.01", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36323 fma  1    0.01  0.01    ->  0.02
+	{"fmax36323", "1", "0.01", "0.01", "0.02", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36324 fma  1    0.12  0.01    ->  0.13
+	{"fmax36324", "1", "0.12", "0.01", "0.13", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36325 fma  1    0.98  0.01    ->  0.99
+	{"fmax36325", "1", "0.98", "0.01", "0.99", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36326 fma  1    0.99  0.01    ->  1.00
+	{"fmax36326", "1", "0.99", "0.01", "1.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36327 fma  1    1.00  0.01    ->  1.01
+	{"fmax36327", "1", "1.00", "0.01", "1.01", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36328 fma  1    1.01  0.01    ->  1.02
+	{"fmax36328", "1", "1.01", "0.01", "1.02", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36329 fma  1   -0.01 -0.01    -> -0.02
+	{"fmax36329", "1", "-0.01", "-0.01", "-0.02", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36330 fma  1    0.00 -0.01    -> -0.01
+	{"fmax36330", "1", "0.00", "-0.01", "-0.01", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36331 fma  1    0.01 -0.01    ->  0.00
+	{"fmax36331", "1", "0.01", "-0.01", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36332 fma  1    0.12 -0.01    ->  0.11
+	{"fmax36332", "1", "0.12", "-0.01", "0.11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36333 fma  1    0.98 -0.01    ->  0.97
+	{"fmax36333", "1", "0.98", "-0.01", "0.97", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36334 fma  1    0.99 -0.01    ->  0.98
+	{"fmax36334", "1", "0.99", "-0.01", "0.98", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36335 fma  1    1.00 -0.01    ->  0.99
+	{"fmax36335", "1", "1.00", "-0.01", "0.99", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36336 fma  1    1.01 -0.01    ->  1.00
+	{"fmax36336", "1", "1.01", "-0.01", "1.00", 0, 16, ToNearestEven, 384, -383, false},
+	// some more cases where fma  1  ing 0 affects the coefficient
+	// fmax36340 fma  1   1E+3    0    ->         1000
+	{"fmax36340", "1", "1E+3", "0", "1000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36341 fma  1   1E+15   0    ->    1000000000000000
+	{"fmax36341", "1", "1E+15", "0", "1000000000000000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36342 fma  1   1E+16   0    ->   1.000000000000000E+16  Rounded
+	{"fmax36342", "1", "1E+16", "0", "1.000000000000000E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36343 fma  1   1E+17   0    ->   1.000000000000000E+17  Rounded
+	{"fmax36343", "1", "1E+17", "0", "1.000000000000000E+17", Rounded, 16, ToNearestEven, 384, -383, false},
+	// which simply follow from these cases ...
+	// fmax36344 fma  1   1E+3    1    ->         1001
+	{"fmax36344", "1", "1E+3", "1", "1001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36345 fma  1   1E+15   1    ->    1000000000000001
+	{"fmax36345", "1", "1E+15", "1", "1000000000000001", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36346 fma  1   1E+16   1    ->   1.000000000000000E+16  Inexact Rounded
+	{"fmax36346", "1", "1E+16", "1", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36347 fma  1   1E+17   1    ->   1.000000000000000E+17  Inexact Rounded
+	{"fmax36347", "1", "1E+17", "1", "1.000000000000000E+17", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36348 fma  1   1E+3    7    ->         1007
+	{"fmax36348", "1", "1E+3", "7", "1007", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36349 fma  1   1E+15   7    ->    1000000000000007
+	{"fmax36349", "1", "1E+15", "7", "1000000000000007", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36350 fma  1   1E+16   7    ->   1.000000000000001E+16  Inexact Rounded
+	{"fmax36350", "1", "1E+16", "7", "1.000000000000001E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36351 fma  1   1E+17   7    ->   1.000000000000000E+17  Inexact Rounded
+	{"fmax36351", "1", "1E+17", "7", "1.000000000000000E+17", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// tryzeros cases
+	// fmax36361  fma  1   0E+50 10000E+1  -> 1.0000E+5
+	{"fmax36361", "1", "0E+50", "10000E+1", "1.0000E+5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36362  fma  1   10000E+1 0E-50  -> 100000.0000000000  Rounded
+	{"fmax36362", "1", "10000E+1", "0E-50", "100000.0000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36363  fma  1   10000E+1 10000E-50  -> 100000.0000000000  Rounded Inexact
+	{"fmax36363", "1", "10000E+1", "10000E-50", "100000.0000000000", Rounded | Inexact, 16, ToNearestEven, 384, -383, false},
+	// fmax36364  fma  1   12.34    0e-398  -> 12.34000000000000  Rounded
+	{"fmax36364", "1", "12.34", "0e-398", "12.34000000000000", Rounded, 16, ToNearestEven, 384, -383, false},
+	// ulp replacement tests
+	// fmax36400 fma  1     1   77e-14      ->  1.00000000000077
+	{"fmax36400", "1", "1", "77e-14", "1.00000000000077", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36401 fma  1     1   77e-15      ->  1.000000000000077
+	{"fmax36401", "1", "1", "77e-15", "1.000000000000077", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36402 fma  1     1   77e-16      ->  1.000000000000008 Inexact Rounded
+	{"fmax36402", "1", "1", "77e-16", "1.000000000000008", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36403 fma  1     1   77e-17      ->  1.000000000000001 Inexact Rounded
+	{"fmax36403", "1", "1", "77e-17", "1.000000000000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36404 fma  1     1   77e-18      ->  1.000000000000000 Inexact Rounded
+	{"fmax36404", "1", "1", "77e-18", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36405 fma  1     1   77e-19      ->  1.000000000000000 Inexact Rounded
+	{"fmax36405", "1", "1", "77e-19", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36406 fma  1     1   77e-99      ->  1.000000000000000 Inexact Rounded
+	{"fmax36406", "1", "1", "77e-99", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36410 fma  1    10   77e-14      ->  10.00000000000077
+	{"fmax36410", "1", "10", "77e-14", "10.00000000000077", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36411 fma  1    10   77e-15      ->  10.00000000000008 Inexact Rounded
+	{"fmax36411", "1", "10", "77e-15", "10.00000000000008", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36412 fma  1    10   77e-16      ->  10.00000000000001 Inexact Rounded
+	{"fmax36412", "1", "10", "77e-16", "10.00000000000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36413 fma  1    10   77e-17      ->  10.00000000000000 Inexact Rounded
+	{"fmax36413", "1", "10", "77e-17", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36414 fma  1    10   77e-18      ->  10.00000000000000 Inexact Rounded
+	{"fmax36414", "1", "10", "77e-18", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36415 fma  1    10   77e-19      ->  10.00000000000000 Inexact Rounded
+	{"fmax36415", "1", "10", "77e-19", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36416 fma  1    10   77e-99      ->  10.00000000000000 Inexact Rounded
+	{"fmax36416", "1", "10", "77e-99", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36420 fma  1    77e-14       1   ->  1.00000000000077
+	{"fmax36420", "1", "77e-14", "1", "1.00000000000077", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36421 fma  1    77e-15       1   ->  1.000000000000077
+	{"fmax36421", "1", "77e-15", "1", "1.000000000000077", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36422 fma  1    77e-16       1   ->  1.000000000000008 Inexact Rounded
+	{"fmax36422", "1", "77e-16", "1", "1.000000000000008", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36423 fma  1    77e-17       1   ->  1.000000000000001 Inexact Rounded
+	{"fmax36423", "1", "77e-17", "1", "1.000000000000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36424 fma  1    77e-18       1   ->  1.000000000000000 Inexact Rounded
+	{"fmax36424", "1", "77e-18", "1", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36425 fma  1    77e-19       1   ->  1.000000000000000 Inexact Rounded
+	{"fmax36425", "1", "77e-19", "1", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36426 fma  1    77e-99       1   ->  1.000000000000000 Inexact Rounded
+	{"fmax36426", "1", "77e-99", "1", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36430 fma  1    77e-14      10   ->  10.00000000000077
+	{"fmax36430", "1", "77e-14", "10", "10.00000000000077", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36431 fma  1    77e-15      10   ->  10.00000000000008 Inexact Rounded
+	{"fmax36431", "1", "77e-15", "10", "10.00000000000008", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36432 fma  1    77e-16      10   ->  10.00000000000001 Inexact Rounded
+	{"fmax36432", "1", "77e-16", "10", "10.00000000000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36433 fma  1    77e-17      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36433", "1", "77e-17", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36434 fma  1    77e-18      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36434", "1", "77e-18", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36435 fma  1    77e-19      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36435", "1", "77e-19", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36436 fma  1    77e-99      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36436", "1", "77e-99", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// negative ulps
+	// fmax36440 fma  1     1   -77e-14      ->  0.99999999999923
+	{"fmax36440", "1", "1", "-77e-14", "0.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36441 fma  1     1   -77e-15      ->  0.999999999999923
+	{"fmax36441", "1", "1", "-77e-15", "0.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36442 fma  1     1   -77e-16      ->  0.9999999999999923
+	{"fmax36442", "1", "1", "-77e-16", "0.9999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36443 fma  1     1   -77e-17      ->  0.9999999999999992 Inexact Rounded
+	{"fmax36443", "1", "1", "-77e-17", "0.9999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36444 fma  1     1   -77e-18      ->  0.9999999999999999 Inexact Rounded
+	{"fmax36444", "1", "1", "-77e-18", "0.9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36445 fma  1     1   -77e-19      ->  1.000000000000000 Inexact Rounded
+	{"fmax36445", "1", "1", "-77e-19", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36446 fma  1     1   -77e-99      ->  1.000000000000000 Inexact Rounded
+	{"fmax36446", "1", "1", "-77e-99", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36450 fma  1    10   -77e-14      ->   9.99999999999923
+	{"fmax36450", "1", "10", "-77e-14", "9.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36451 fma  1    10   -77e-15      ->   9.999999999999923
+	{"fmax36451", "1", "10", "-77e-15", "9.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36452 fma  1    10   -77e-16      ->   9.999999999999992 Inexact Rounded
+	{"fmax36452", "1", "10", "-77e-16", "9.999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36453 fma  1    10   -77e-17      ->   9.999999999999999 Inexact Rounded
+	{"fmax36453", "1", "10", "-77e-17", "9.999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36454 fma  1    10   -77e-18      ->  10.00000000000000 Inexact Rounded
+	{"fmax36454", "1", "10", "-77e-18", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36455 fma  1    10   -77e-19      ->  10.00000000000000 Inexact Rounded
+	{"fmax36455", "1", "10", "-77e-19", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36456 fma  1    10   -77e-99      ->  10.00000000000000 Inexact Rounded
+	{"fmax36456", "1", "10", "-77e-99", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36460 fma  1    -77e-14       1   ->  0.99999999999923
+	{"fmax36460", "1", "-77e-14", "1", "0.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36461 fma  1    -77e-15       1   ->  0.999999999999923
+	{"fmax36461", "1", "-77e-15", "1", "0.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36462 fma  1    -77e-16       1   ->  0.9999999999999923
+	{"fmax36462", "1", "-77e-16", "1", "0.9999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36463 fma  1    -77e-17       1   ->  0.9999999999999992 Inexact Rounded
+	{"fmax36463", "1", "-77e-17", "1", "0.9999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36464 fma  1    -77e-18       1   ->  0.9999999999999999 Inexact Rounded
+	{"fmax36464", "1", "-77e-18", "1", "0.9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36465 fma  1    -77e-19       1   ->  1.000000000000000 Inexact Rounded
+	{"fmax36465", "1", "-77e-19", "1", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36466 fma  1    -77e-99       1   ->  1.000000000000000 Inexact Rounded
+	{"fmax36466", "1", "-77e-99", "1", "1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36470 fma  1    -77e-14      10   ->   9.99999999999923
+	{"fmax36470", "1", "-77e-14", "10", "9.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36471 fma  1    -77e-15      10   ->   9.999999999999923
+	{"fmax36471", "1", "-77e-15", "10", "9.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36472 fma  1    -77e-16      10   ->   9.999999999999992 Inexact Rounded
+	{"fmax36472", "1", "-77e-16", "10", "9.999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36473 fma  1    -77e-17      10   ->   9.999999999999999 Inexact Rounded
+	{"fmax36473", "1", "-77e-17", "10", "9.999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36474 fma  1    -77e-18      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36474", "1", "-77e-18", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36475 fma  1    -77e-19      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36475", "1", "-77e-19", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36476 fma  1    -77e-99      10   ->  10.00000000000000 Inexact Rounded
+	{"fmax36476", "1", "-77e-99", "10", "10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// negative ulps
+	// fmax36480 fma  1    -1    77e-14      ->  -0.99999999999923
+	{"fmax36480", "1", "-1", "77e-14", "-0.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36481 fma  1    -1    77e-15      ->  -0.999999999999923
+	{"fmax36481", "1", "-1", "77e-15", "-0.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36482 fma  1    -1    77e-16      ->  -0.9999999999999923
+	{"fmax36482", "1", "-1", "77e-16", "-0.9999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36483 fma  1    -1    77e-17      ->  -0.9999999999999992 Inexact Rounded
+	{"fmax36483", "1", "-1", "77e-17", "-0.9999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36484 fma  1    -1    77e-18      ->  -0.9999999999999999 Inexact Rounded
+	{"fmax36484", "1", "-1", "77e-18", "-0.9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36485 fma  1    -1    77e-19      ->  -1.000000000000000 Inexact Rounded
+	{"fmax36485", "1", "-1", "77e-19", "-1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36486 fma  1    -1    77e-99      ->  -1.000000000000000 Inexact Rounded
+	{"fmax36486", "1", "-1", "77e-99", "-1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36490 fma  1   -10    77e-14      ->   -9.99999999999923
+	{"fmax36490", "1", "-10", "77e-14", "-9.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36491 fma  1   -10    77e-15      ->   -9.999999999999923
+	{"fmax36491", "1", "-10", "77e-15", "-9.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36492 fma  1   -10    77e-16      ->   -9.999999999999992 Inexact Rounded
+	{"fmax36492", "1", "-10", "77e-16", "-9.999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36493 fma  1   -10    77e-17      ->   -9.999999999999999 Inexact Rounded
+	{"fmax36493", "1", "-10", "77e-17", "-9.999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36494 fma  1   -10    77e-18      ->  -10.00000000000000 Inexact Rounded
+	{"fmax36494", "1", "-10", "77e-18", "-10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36495 fma  1   -10    77e-19      ->  -10.00000000000000 Inexact Rounded
+	{"fmax36495", "1", "-10", "77e-19", "-10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36496 fma  1   -10    77e-99      ->  -10.00000000000000 Inexact Rounded
+	{"fmax36496", "1", "-10", "77e-99", "-10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36500 fma  1     77e-14      -1   ->  -0.99999999999923
+	{"fmax36500", "1", "77e-14", "-1", "-0.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36501 fma  1     77e-15      -1   ->  -0.999999999999923
+	{"fmax36501", "1", "77e-15", "-1", "-0.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36502 fma  1     77e-16      -1   ->  -0.9999999999999923
+	{"fmax36502", "1", "77e-16", "-1", "-0.9999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36503 fma  1     77e-17      -1   ->  -0.9999999999999992 Inexact Rounded
+	{"fmax36503", "1", "77e-17", "-1", "-0.9999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36504 fma  1     77e-18      -1   ->  -0.9999999999999999 Inexact Rounded
+	{"fmax36504", "1", "77e-18", "-1", "-0.9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36505 fma  1     77e-19      -1   ->  -1.000000000000000 Inexact Rounded
+	{"fmax36505", "1", "77e-19", "-1", "-1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36506 fma  1     77e-99      -1   ->  -1.000000000000000 Inexact Rounded
+	{"fmax36506", "1", "77e-99", "-1", "-1.000000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36510 fma  1     77e-14      -10  ->   -9.99999999999923
+	{"fmax36510", "1", "77e-14", "-10", "-9.99999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36511 fma  1     77e-15      -10  ->   -9.999999999999923
+	{"fmax36511", "1", "77e-15", "-10", "-9.999999999999923", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36512 fma  1     77e-16      -10  ->   -9.999999999999992 Inexact Rounded
+	{"fmax36512", "1", "77e-16", "-10", "-9.999999999999992", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36513 fma  1     77e-17      -10  ->   -9.999999999999999 Inexact Rounded
+	{"fmax36513", "1", "77e-17", "-10", "-9.999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36514 fma  1     77e-18      -10  ->  -10.00000000000000 Inexact Rounded
+	{"fmax36514", "1", "77e-18", "-10", "-10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36515 fma  1     77e-19      -10  ->  -10.00000000000000 Inexact Rounded
+	{"fmax36515", "1", "77e-19", "-10", "-10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36516 fma  1     77e-99      -10  ->  -10.00000000000000 Inexact Rounded
+	{"fmax36516", "1", "77e-99", "-10", "-10.00000000000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// long operands
+	// fmax36521 fma  1   101234562345678000 0 -> 1.012345623456780E+17 Rounded
+	{"fmax36521", "1", "101234562345678000", "0", "1.012345623456780E+17", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36522 fma  1   0 101234562345678000 -> 1.012345623456780E+17 Rounded
+	{"fmax36522", "1", "0", "101234562345678000", "1.012345623456780E+17", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36523 fma  1   10123456234567800  0 -> 1.012345623456780E+16 Rounded
+	{"fmax36523", "1", "10123456234567800", "0", "1.012345623456780E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36524 fma  1   0 10123456234567800  -> 1.012345623456780E+16 Rounded
+	{"fmax36524", "1", "0", "10123456234567800", "1.012345623456780E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36525 fma  1   10123456234567890  0 -> 1.012345623456789E+16 Rounded
+	{"fmax36525", "1", "10123456234567890", "0", "1.012345623456789E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36526 fma  1   0 10123456234567890  -> 1.012345623456789E+16 Rounded
+	{"fmax36526", "1", "0", "10123456234567890", "1.012345623456789E+16", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36527 fma  1   10123456234567891  0 -> 1.012345623456789E+16 Inexact Rounded
+	{"fmax36527", "1", "10123456234567891", "0", "1.012345623456789E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36528 fma  1   0 10123456234567891  -> 1.012345623456789E+16 Inexact Rounded
+	{"fmax36528", "1", "0", "10123456234567891", "1.012345623456789E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36529 fma  1   101234562345678901 0 -> 1.012345623456789E+17 Inexact Rounded
+	{"fmax36529", "1", "101234562345678901", "0", "1.012345623456789E+17", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36530 fma  1   0 101234562345678901 -> 1.012345623456789E+17 Inexact Rounded
+	{"fmax36530", "1", "0", "101234562345678901", "1.012345623456789E+17", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36531 fma  1   10123456234567896  0 -> 1.012345623456790E+16 Inexact Rounded
+	{"fmax36531", "1", "10123456234567896", "0", "1.012345623456790E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36532 fma  1   0 10123456234567896  -> 1.012345623456790E+16 Inexact Rounded
+	{"fmax36532", "1", "0", "10123456234567896", "1.012345623456790E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// verify a query
+	// rounding: down
+	// fmax36561 fma  1   1e-398 9.000000000000000E+384 -> 9.000000000000000E+384 Inexact Rounded
+	{"fmax36561", "1", "1e-398", "9.000000000000000E+384", "9.000000000000000E+384", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36562 fma  1        0 9.000000000000000E+384 -> 9.000000000000000E+384 Rounded
+	{"fmax36562", "1", "0", "9.000000000000000E+384", "9.000000000000000E+384", Rounded, 16, ToZero, 384, -383, false},
+	// and using decimal64 bounds...
+	// rounding: down
+	// fmax36563 fma  1   1e-388 9.000000000000000E+374 -> 9.000000000000000E+374 Inexact Rounded
+	{"fmax36563", "1", "1e-388", "9.000000000000000E+374", "9.000000000000000E+374", Inexact | Rounded, 16, ToZero, 384, -383, false},
+	// fmax36564 fma  1        0 9.000000000000000E+374 -> 9.000000000000000E+374 Rounded
+	{"fmax36564", "1", "0", "9.000000000000000E+374", "9.000000000000000E+374", Rounded, 16, ToZero, 384, -383, false},
+	// more zeros, etc.
+	// rounding: half_even
+	// fmax36701 fma  1   5.00 1.00E-3 -> 5.00100
+	{"fmax36701", "1", "5.00", "1.00E-3", "5.00100", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36702 fma  1   00.00 0.000  -> 0.000
+	{"fmax36702", "1", "00.00", "0.000", "0.000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36703 fma  1   00.00 0E-3   -> 0.000
+	{"fmax36703", "1", "00.00", "0E-3", "0.000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36704 fma  1   0E-3  00.00  -> 0.000
+	{"fmax36704", "1", "0E-3", "00.00", "0.000", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36710 fma  1   0E+3  00.00  -> 0.00
+	{"fmax36710", "1", "0E+3", "00.00", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36711 fma  1   0E+3  00.0   -> 0.0
+	{"fmax36711", "1", "0E+3", "00.0", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36712 fma  1   0E+3  00.    -> 0
+	{"fmax36712", "1", "0E+3", "00.", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36713 fma  1   0E+3  00.E+1 -> 0E+1
+	{"fmax36713", "1", "0E+3", "00.E+1", "0E+1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36714 fma  1   0E+3  00.E+2 -> 0E+2
+	{"fmax36714", "1", "0E+3", "00.E+2", "0E+2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36715 fma  1   0E+3  00.E+3 -> 0E+3
+	{"fmax36715", "1", "0E+3", "00.E+3", "0E+3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36716 fma  1   0E+3  00.E+4 -> 0E+3
+	{"fmax36716", "1", "0E+3", "00.E+4", "0E+3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36717 fma  1   0E+3  00.E+5 -> 0E+3
+	{"fmax36717", "1", "0E+3", "00.E+5", "0E+3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36718 fma  1   0E+3  -00.0   -> 0.0
+	{"fmax36718", "1", "0E+3", "-00.0", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36719 fma  1   0E+3  -00.    -> 0
+	{"fmax36719", "1", "0E+3", "-00.", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36731 fma  1   0E+3  -00.E+1 -> 0E+1
+	{"fmax36731", "1", "0E+3", "-00.E+1", "0E+1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36720 fma  1   00.00  0E+3  -> 0.00
+	{"fmax36720", "1", "00.00", "0E+3", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36721 fma  1   00.0   0E+3  -> 0.0
+	{"fmax36721", "1", "00.0", "0E+3", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36722 fma  1   00.    0E+3  -> 0
+	{"fmax36722", "1", "00.", "0E+3", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36723 fma  1   00.E+1 0E+3  -> 0E+1
+	{"fmax36723", "1", "00.E+1", "0E+3", "0E+1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36724 fma  1   00.E+2 0E+3  -> 0E+2
+	{"fmax36724", "1", "00.E+2", "0E+3", "0E+2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36725 fma  1   00.E+3 0E+3  -> 0E+3
+	{"fmax36725", "1", "00.E+3", "0E+3", "0E+3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36726 fma  1   00.E+4 0E+3  -> 0E+3
+	{"fmax36726", "1", "00.E+4", "0E+3", "0E+3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36727 fma  1   00.E+5 0E+3  -> 0E+3
+	{"fmax36727", "1", "00.E+5", "0E+3", "0E+3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36728 fma  1   -00.00 0E+3  -> 0.00
+	{"fmax36728", "1", "-00.00", "0E+3", "0.00", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36729 fma  1   -00.0  0E+3  -> 0.0
+	{"fmax36729", "1", "-00.0", "0E+3", "0.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36730 fma  1   -00.   0E+3  -> 0
+	{"fmax36730", "1", "-00.", "0E+3", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36732 fma  1    0     0     ->  0
+	{"fmax36732", "1", "0", "0", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36733 fma  1    0    -0     ->  0
+	{"fmax36733", "1", "0", "-0", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36734 fma  1   -0     0     ->  0
+	{"fmax36734", "1", "-0", "0", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36735 fma  1   -0    -0     -> -0     -- IEEE 854 special case
+	{"fmax36735", "1", "-0", "-0", "-0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36736 fma  1    1    -1     ->  0
+	{"fmax36736", "1", "1", "-1", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36737 fma  1   -1    -1     -> -2
+	{"fmax36737", "1", "-1", "-1", "-2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36738 fma  1    1     1     ->  2
+	{"fmax36738", "1", "1", "1", "2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36739 fma  1   -1     1     ->  0
+	{"fmax36739", "1", "-1", "1", "0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36741 fma  1    0    -1     -> -1
+	{"fmax36741", "1", "0", "-1", "-1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36742 fma  1   -0    -1     -> -1
+	{"fmax36742", "1", "-0", "-1", "-1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36743 fma  1    0     1     ->  1
+	{"fmax36743", "1", "0", "1", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36744 fma  1   -0     1     ->  1
+	{"fmax36744", "1", "-0", "1", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36745 fma  1   -1     0     -> -1
+	{"fmax36745", "1", "-1", "0", "-1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36746 fma  1   -1    -0     -> -1
+	{"fmax36746", "1", "-1", "-0", "-1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36747 fma  1    1     0     ->  1
+	{"fmax36747", "1", "1", "0", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36748 fma  1    1    -0     ->  1
+	{"fmax36748", "1", "1", "-0", "1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36751 fma  1    0.0  -1     -> -1.0
+	{"fmax36751", "1", "0.0", "-1", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36752 fma  1   -0.0  -1     -> -1.0
+	{"fmax36752", "1", "-0.0", "-1", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36753 fma  1    0.0   1     ->  1.0
+	{"fmax36753", "1", "0.0", "1", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36754 fma  1   -0.0   1     ->  1.0
+	{"fmax36754", "1", "-0.0", "1", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36755 fma  1   -1.0   0     -> -1.0
+	{"fmax36755", "1", "-1.0", "0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36756 fma  1   -1.0  -0     -> -1.0
+	{"fmax36756", "1", "-1.0", "-0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36757 fma  1    1.0   0     ->  1.0
+	{"fmax36757", "1", "1.0", "0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36758 fma  1    1.0  -0     ->  1.0
+	{"fmax36758", "1", "1.0", "-0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36761 fma  1    0    -1.0   -> -1.0
+	{"fmax36761", "1", "0", "-1.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36762 fma  1   -0    -1.0   -> -1.0
+	{"fmax36762", "1", "-0", "-1.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36763 fma  1    0     1.0   ->  1.0
+	{"fmax36763", "1", "0", "1.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36764 fma  1   -0     1.0   ->  1.0
+	{"fmax36764", "1", "-0", "1.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36765 fma  1   -1     0.0   -> -1.0
+	{"fmax36765", "1", "-1", "0.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36766 fma  1   -1    -0.0   -> -1.0
+	{"fmax36766", "1", "-1", "-0.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36767 fma  1    1     0.0   ->  1.0
+	{"fmax36767", "1", "1", "0.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36768 fma  1    1    -0.0   ->  1.0
+	{"fmax36768", "1", "1", "-0.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36771 fma  1    0.0  -1.0   -> -1.0
+	{"fmax36771", "1", "0.0", "-1.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36772 fma  1   -0.0  -1.0   -> -1.0
+	{"fmax36772", "1", "-0.0", "-1.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36773 fma  1    0.0   1.0   ->  1.0
+	{"fmax36773", "1", "0.0", "1.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36774 fma  1   -0.0   1.0   ->  1.0
+	{"fmax36774", "1", "-0.0", "1.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36775 fma  1   -1.0   0.0   -> -1.0
+	{"fmax36775", "1", "-1.0", "0.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36776 fma  1   -1.0  -0.0   -> -1.0
+	{"fmax36776", "1", "-1.0", "-0.0", "-1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36777 fma  1    1.0   0.0   ->  1.0
+	{"fmax36777", "1", "1.0", "0.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36778 fma  1    1.0  -0.0   ->  1.0
+	{"fmax36778", "1", "1.0", "-0.0", "1.0", 0, 16, ToNearestEven, 384, -383, false},
+	// Specials
+	// fmax36780 fma  1   -Inf  -Inf   -> -Infinity
+	{"fmax36780", "1", "-Inf", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36781 fma  1   -Inf  -1000  -> -Infinity
+	{"fmax36781", "1", "-Inf", "-1000", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36782 fma  1   -Inf  -1     -> -Infinity
+	{"fmax36782", "1", "-Inf", "-1", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36783 fma  1   -Inf  -0     -> -Infinity
+	{"fmax36783", "1", "-Inf", "-0", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36784 fma  1   -Inf   0     -> -Infinity
+	{"fmax36784", "1", "-Inf", "0", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36785 fma  1   -Inf   1     -> -Infinity
+	{"fmax36785", "1", "-Inf", "1", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36786 fma  1   -Inf   1000  -> -Infinity
+	{"fmax36786", "1", "-Inf", "1000", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36787 fma  1   -1000 -Inf   -> -Infinity
+	{"fmax36787", "1", "-1000", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36788 fma  1   -Inf  -Inf   -> -Infinity
+	{"fmax36788", "1", "-Inf", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36789 fma  1   -1    -Inf   -> -Infinity
+	{"fmax36789", "1", "-1", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36790 fma  1   -0    -Inf   -> -Infinity
+	{"fmax36790", "1", "-0", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36791 fma  1    0    -Inf   -> -Infinity
+	{"fmax36791", "1", "0", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36792 fma  1    1    -Inf   -> -Infinity
+	{"fmax36792", "1", "1", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36793 fma  1    1000 -Inf   -> -Infinity
+	{"fmax36793", "1", "1000", "-Inf", "-Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36794 fma  1    Inf  -Inf   ->  NaN  Invalid_operation
+	{"fmax36794", "1", "Inf", "-Inf", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36800 fma  1    Inf  -Inf   ->  NaN  Invalid_operation
+	{"fmax36800", "1", "Inf", "-Inf", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36801 fma  1    Inf  -1000  ->  Infinity
+	{"fmax36801", "1", "Inf", "-1000", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36802 fma  1    Inf  -1     ->  Infinity
+	{"fmax36802", "1", "Inf", "-1", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36803 fma  1    Inf  -0     ->  Infinity
+	{"fmax36803", "1", "Inf", "-0", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36804 fma  1    Inf   0     ->  Infinity
+	{"fmax36804", "1", "Inf", "0", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36805 fma  1    Inf   1     ->  Infinity
+	{"fmax36805", "1", "Inf", "1", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36806 fma  1    Inf   1000  ->  Infinity
+	{"fmax36806", "1", "Inf", "1000", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36807 fma  1    Inf   Inf   ->  Infinity
+	{"fmax36807", "1", "Inf", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36808 fma  1   -1000  Inf   ->  Infinity
+	{"fmax36808", "1", "-1000", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36809 fma  1   -Inf   Inf   ->  NaN  Invalid_operation
+	{"fmax36809", "1", "-Inf", "Inf", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36810 fma  1   -1     Inf   ->  Infinity
+	{"fmax36810", "1", "-1", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36811 fma  1   -0     Inf   ->  Infinity
+	{"fmax36811", "1", "-0", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36812 fma  1    0     Inf   ->  Infinity
+	{"fmax36812", "1", "0", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36813 fma  1    1     Inf   ->  Infinity
+	{"fmax36813", "1", "1", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36814 fma  1    1000  Inf   ->  Infinity
+	{"fmax36814", "1", "1000", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36815 fma  1    Inf   Inf   ->  Infinity
+	{"fmax36815", "1", "Inf", "Inf", "Inf", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36821 fma  1    NaN -Inf    ->  NaN
+	{"fmax36821", "1", "NaN", "-Inf", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36822 fma  1    NaN -1000   ->  NaN
+	{"fmax36822", "1", "NaN", "-1000", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36823 fma  1    NaN -1      ->  NaN
+	{"fmax36823", "1", "NaN", "-1", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36824 fma  1    NaN -0      ->  NaN
+	{"fmax36824", "1", "NaN", "-0", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36825 fma  1    NaN  0      ->  NaN
+	{"fmax36825", "1", "NaN", "0", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36826 fma  1    NaN  1      ->  NaN
+	{"fmax36826", "1", "NaN", "1", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36827 fma  1    NaN  1000   ->  NaN
+	{"fmax36827", "1", "NaN", "1000", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36828 fma  1    NaN  Inf    ->  NaN
+	{"fmax36828", "1", "NaN", "Inf", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36829 fma  1    NaN  NaN    ->  NaN
+	{"fmax36829", "1", "NaN", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36830 fma  1   -Inf  NaN    ->  NaN
+	{"fmax36830", "1", "-Inf", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36831 fma  1   -1000 NaN    ->  NaN
+	{"fmax36831", "1", "-1000", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36832 fma  1   -1    NaN    ->  NaN
+	{"fmax36832", "1", "-1", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36833 fma  1   -0    NaN    ->  NaN
+	{"fmax36833", "1", "-0", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36834 fma  1    0    NaN    ->  NaN
+	{"fmax36834", "1", "0", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36835 fma  1    1    NaN    ->  NaN
+	{"fmax36835", "1", "1", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36836 fma  1    1000 NaN    ->  NaN
+	{"fmax36836", "1", "1000", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36837 fma  1    Inf  NaN    ->  NaN
+	{"fmax36837", "1", "Inf", "NaN", "NaN", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36841 fma  1    sNaN -Inf   ->  NaN  Invalid_operation
+	{"fmax36841", "1", "sNaN", "-Inf", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36842 fma  1    sNaN -1000  ->  NaN  Invalid_operation
+	{"fmax36842", "1", "sNaN", "-1000", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36843 fma  1    sNaN -1     ->  NaN  Invalid_operation
+	{"fmax36843", "1", "sNaN", "-1", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36844 fma  1    sNaN -0     ->  NaN  Invalid_operation
+	{"fmax36844", "1", "sNaN", "-0", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36845 fma  1    sNaN  0     ->  NaN  Invalid_operation
+	{"fmax36845", "1", "sNaN", "0", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36846 fma  1    sNaN  1     ->  NaN  Invalid_operation
+	{"fmax36846", "1", "sNaN", "1", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36847 fma  1    sNaN  1000  ->  NaN  Invalid_operation
+	{"fmax36847", "1", "sNaN", "1000", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36848 fma  1    sNaN  NaN   ->  NaN  Invalid_operation
+	{"fmax36848", "1", "sNaN", "NaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36849 fma  1    sNaN sNaN   ->  NaN  Invalid_operation
+	{"fmax36849", "1", "sNaN", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36850 fma  1    NaN  sNaN   ->  NaN  Invalid_operation
+	{"fmax36850", "1", "NaN", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36851 fma  1   -Inf  sNaN   ->  NaN  Invalid_operation
+	{"fmax36851", "1", "-Inf", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36852 fma  1   -1000 sNaN   ->  NaN  Invalid_operation
+	{"fmax36852", "1", "-1000", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36853 fma  1   -1    sNaN   ->  NaN  Invalid_operation
+	{"fmax36853", "1", "-1", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36854 fma  1   -0    sNaN   ->  NaN  Invalid_operation
+	{"fmax36854", "1", "-0", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36855 fma  1    0    sNaN   ->  NaN  Invalid_operation
+	{"fmax36855", "1", "0", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36856 fma  1    1    sNaN   ->  NaN  Invalid_operation
+	{"fmax36856", "1", "1", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36857 fma  1    1000 sNaN   ->  NaN  Invalid_operation
+	{"fmax36857", "1", "1000", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36858 fma  1    Inf  sNaN   ->  NaN  Invalid_operation
+	{"fmax36858", "1", "Inf", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36859 fma  1    NaN  sNaN   ->  NaN  Invalid_operation
+	{"fmax36859", "1", "NaN", "sNaN", "NaN", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// propagating NaNs
+	// fmax36861 fma  1    NaN1   -Inf    ->  NaN1
+	{"fmax36861", "1", "NaN1", "-Inf", "NaN1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36862 fma  1   +NaN2   -1000   ->  NaN2
+	{"fmax36862", "1", "+NaN2", "-1000", "NaN2", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36863 fma  1    NaN3    1000   ->  NaN3
+	{"fmax36863", "1", "NaN3", "1000", "NaN3", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36864 fma  1    NaN4    Inf    ->  NaN4
+	{"fmax36864", "1", "NaN4", "Inf", "NaN4", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36865 fma  1    NaN5   +NaN6   ->  NaN5
+	{"fmax36865", "1", "NaN5", "+NaN6", "NaN5", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36866 fma  1   -Inf     NaN7   ->  NaN7
+	{"fmax36866", "1", "-Inf", "NaN7", "NaN7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36867 fma  1   -1000    NaN8   ->  NaN8
+	{"fmax36867", "1", "-1000", "NaN8", "NaN8", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36868 fma  1    1000    NaN9   ->  NaN9
+	{"fmax36868", "1", "1000", "NaN9", "NaN9", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36869 fma  1    Inf    +NaN10  ->  NaN10
+	{"fmax36869", "1", "Inf", "+NaN10", "NaN10", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36871 fma  1    sNaN11  -Inf   ->  NaN11  Invalid_operation
+	{"fmax36871", "1", "sNaN11", "-Inf", "NaN11", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36872 fma  1    sNaN12  -1000  ->  NaN12  Invalid_operation
+	{"fmax36872", "1", "sNaN12", "-1000", "NaN12", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36873 fma  1    sNaN13   1000  ->  NaN13  Invalid_operation
+	{"fmax36873", "1", "sNaN13", "1000", "NaN13", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36874 fma  1    sNaN14   NaN17 ->  NaN14  Invalid_operation
+	{"fmax36874", "1", "sNaN14", "NaN17", "NaN14", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36875 fma  1    sNaN15  sNaN18 ->  NaN15  Invalid_operation
+	{"fmax36875", "1", "sNaN15", "sNaN18", "NaN15", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36876 fma  1    NaN16   sNaN19 ->  NaN19  Invalid_operation
+	{"fmax36876", "1", "NaN16", "sNaN19", "NaN19", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36877 fma  1   -Inf    +sNaN20 ->  NaN20  Invalid_operation
+	{"fmax36877", "1", "-Inf", "+sNaN20", "NaN20", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36878 fma  1   -1000    sNaN21 ->  NaN21  Invalid_operation
+	{"fmax36878", "1", "-1000", "sNaN21", "NaN21", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36879 fma  1    1000    sNaN22 ->  NaN22  Invalid_operation
+	{"fmax36879", "1", "1000", "sNaN22", "NaN22", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36880 fma  1    Inf     sNaN23 ->  NaN23  Invalid_operation
+	{"fmax36880", "1", "Inf", "sNaN23", "NaN23", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36881 fma  1   +NaN25  +sNaN24 ->  NaN24  Invalid_operation
+	{"fmax36881", "1", "+NaN25", "+sNaN24", "NaN24", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36882 fma  1   -NaN26    NaN28 -> -NaN26
+	{"fmax36882", "1", "-NaN26", "NaN28", "-NaN26", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36883 fma  1   -sNaN27  sNaN29 -> -NaN27  Invalid_operation
+	{"fmax36883", "1", "-sNaN27", "sNaN29", "-NaN27", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// fmax36884 fma  1    1000    -NaN30 -> -NaN30
+	{"fmax36884", "1", "1000", "-NaN30", "-NaN30", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36885 fma  1    1000   -sNaN31 -> -NaN31  Invalid_operation
+	{"fmax36885", "1", "1000", "-sNaN31", "-NaN31", InvalidOperation, 16, ToNearestEven, 384, -383, false},
+	// now the case where we can get underflow but the result is normal
+	// [note this can't happen if the operands are also bounded, as we
+	// cannot represent 1E-399, for example]
+	// fmax36571 fma  1         1E-383       0  -> 1E-383
+	{"fmax36571", "1", "1E-383", "0", "1E-383", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax36572 fma  1         1E-384       0  -> 1E-384   Subnormal
+	{"fmax36572", "1", "1E-384", "0", "1E-384", Subnormal, 16, ToNearestEven, 384, -383, false},
+	// fmax36573 fma  1         1E-383  1E-384  -> 1.1E-383
+	{"fmax36573", "1", "1E-383", "1E-384", "1.1E-383", 0, 16, ToNearestEven, 384, -383, false},
+	// SKIP (subtract not supported): fmax36574 subtract  1E-383  1E-384  ->   9E-384 Subnormal
+	// Here we explore the boundary of rounding a subnormal to Nmin
+	// SKIP (subtract not supported): fmax36575 subtract  1E-383  1E-398  ->   9.99999999999999E-384  Subnormal
+	// SKIP (subtract not supported): fmax36576 subtract  1E-383  1E-398  ->   9.99999999999999E-384  Subnormal
+	// SKIP (subtract not supported): fmax36577 subtract  1E-383  1E-399  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// SKIP (subtract not supported): fmax36578 subtract  1E-383  1E-400  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// SKIP (subtract not supported): fmax36579 subtract  1E-383  1E-401  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// SKIP (subtract not supported): fmax36580 subtract  1E-383  1E-402  ->   1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	// check overflow edge case
+	//               1234567890123456
+	// SKIP (apply not supported): fmax36972 apply        9.999999999999999E+384         -> 9.999999999999999E+384
+	// fmax36973 fma  1       9.999999999999999E+384  1      -> 9.999999999999999E+384 Inexact Rounded
+	{"fmax36973", "1", "9.999999999999999E+384", "1", "9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36974 fma  1        9999999999999999E+369  1      -> 9.999999999999999E+384 Inexact Rounded
+	{"fmax36974", "1", "9999999999999999E+369", "1", "9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36975 fma  1        9999999999999999E+369  1E+369  -> Infinity Overflow Inexact Rounded
+	{"fmax36975", "1", "9999999999999999E+369", "1E+369", "Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36976 fma  1        9999999999999999E+369  9E+368  -> Infinity Overflow Inexact Rounded
+	{"fmax36976", "1", "9999999999999999E+369", "9E+368", "Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36977 fma  1        9999999999999999E+369  8E+368  -> Infinity Overflow Inexact Rounded
+	{"fmax36977", "1", "9999999999999999E+369", "8E+368", "Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36978 fma  1        9999999999999999E+369  7E+368  -> Infinity Overflow Inexact Rounded
+	{"fmax36978", "1", "9999999999999999E+369", "7E+368", "Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36979 fma  1        9999999999999999E+369  6E+368  -> Infinity Overflow Inexact Rounded
+	{"fmax36979", "1", "9999999999999999E+369", "6E+368", "Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36980 fma  1        9999999999999999E+369  5E+368  -> Infinity Overflow Inexact Rounded
+	{"fmax36980", "1", "9999999999999999E+369", "5E+368", "Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36981 fma  1        9999999999999999E+369  4E+368  -> 9.999999999999999E+384 Inexact Rounded
+	{"fmax36981", "1", "9999999999999999E+369", "4E+368", "9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36982 fma  1        9999999999999999E+369  3E+368  -> 9.999999999999999E+384 Inexact Rounded
+	{"fmax36982", "1", "9999999999999999E+369", "3E+368", "9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36983 fma  1        9999999999999999E+369  2E+368  -> 9.999999999999999E+384 Inexact Rounded
+	{"fmax36983", "1", "9999999999999999E+369", "2E+368", "9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36984 fma  1        9999999999999999E+369  1E+368  -> 9.999999999999999E+384 Inexact Rounded
+	{"fmax36984", "1", "9999999999999999E+369", "1E+368", "9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// SKIP (apply not supported): fmax36985 apply       -9.999999999999999E+384         -> -9.999999999999999E+384
+	// fmax36986 fma  1      -9.999999999999999E+384 -1      -> -9.999999999999999E+384 Inexact Rounded
+	{"fmax36986", "1", "-9.999999999999999E+384", "-1", "-9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36987 fma  1       -9999999999999999E+369 -1      -> -9.999999999999999E+384 Inexact Rounded
+	{"fmax36987", "1", "-9999999999999999E+369", "-1", "-9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36988 fma  1       -9999999999999999E+369 -1E+369  -> -Infinity Overflow Inexact Rounded
+	{"fmax36988", "1", "-9999999999999999E+369", "-1E+369", "-Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36989 fma  1       -9999999999999999E+369 -9E+368  -> -Infinity Overflow Inexact Rounded
+	{"fmax36989", "1", "-9999999999999999E+369", "-9E+368", "-Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36990 fma  1       -9999999999999999E+369 -8E+368  -> -Infinity Overflow Inexact Rounded
+	{"fmax36990", "1", "-9999999999999999E+369", "-8E+368", "-Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36991 fma  1       -9999999999999999E+369 -7E+368  -> -Infinity Overflow Inexact Rounded
+	{"fmax36991", "1", "-9999999999999999E+369", "-7E+368", "-Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36992 fma  1       -9999999999999999E+369 -6E+368  -> -Infinity Overflow Inexact Rounded
+	{"fmax36992", "1", "-9999999999999999E+369", "-6E+368", "-Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36993 fma  1       -9999999999999999E+369 -5E+368  -> -Infinity Overflow Inexact Rounded
+	{"fmax36993", "1", "-9999999999999999E+369", "-5E+368", "-Inf", Overflow | Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36994 fma  1       -9999999999999999E+369 -4E+368  -> -9.999999999999999E+384 Inexact Rounded
+	{"fmax36994", "1", "-9999999999999999E+369", "-4E+368", "-9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36995 fma  1       -9999999999999999E+369 -3E+368  -> -9.999999999999999E+384 Inexact Rounded
+	{"fmax36995", "1", "-9999999999999999E+369", "-3E+368", "-9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36996 fma  1       -9999999999999999E+369 -2E+368  -> -9.999999999999999E+384 Inexact Rounded
+	{"fmax36996", "1", "-9999999999999999E+369", "-2E+368", "-9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax36997 fma  1       -9999999999999999E+369 -1E+368  -> -9.999999999999999E+384 Inexact Rounded
+	{"fmax36997", "1", "-9999999999999999E+369", "-1E+368", "-9.999999999999999E+384", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// And for round down full and subnormal results
+	// rounding: down
+	// fmax361100 fma  1   1e+2 -1e-383    -> 99.99999999999999 Rounded Inexact
+	{"fmax361100", "1", "1e+2", "-1e-383", "99.99999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361101 fma  1   1e+1 -1e-383    -> 9.999999999999999  Rounded Inexact
+	{"fmax361101", "1", "1e+1", "-1e-383", "9.999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361103 fma  1     +1 -1e-383    -> 0.9999999999999999  Rounded Inexact
+	{"fmax361103", "1", "+1", "-1e-383", "0.9999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361104 fma  1   1e-1 -1e-383    -> 0.09999999999999999  Rounded Inexact
+	{"fmax361104", "1", "1e-1", "-1e-383", "0.09999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361105 fma  1   1e-2 -1e-383    -> 0.009999999999999999  Rounded Inexact
+	{"fmax361105", "1", "1e-2", "-1e-383", "0.009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361106 fma  1   1e-3 -1e-383    -> 0.0009999999999999999  Rounded Inexact
+	{"fmax361106", "1", "1e-3", "-1e-383", "0.0009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361107 fma  1   1e-4 -1e-383    -> 0.00009999999999999999  Rounded Inexact
+	{"fmax361107", "1", "1e-4", "-1e-383", "0.00009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361108 fma  1   1e-5 -1e-383    -> 0.000009999999999999999  Rounded Inexact
+	{"fmax361108", "1", "1e-5", "-1e-383", "0.000009999999999999999", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// fmax361109 fma  1   1e-6 -1e-383    -> 9.999999999999999E-7  Rounded Inexact
+	{"fmax361109", "1", "1e-6", "-1e-383", "9.999999999999999E-7", Rounded | Inexact, 16, ToZero, 384, -383, false},
+	// rounding: ceiling
+	// fmax361110 fma  1   -1e+2 +1e-383   -> -99.99999999999999 Rounded Inexact
+	{"fmax361110", "1", "-1e+2", "+1e-383", "-99.99999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361111 fma  1   -1e+1 +1e-383   -> -9.999999999999999  Rounded Inexact
+	{"fmax361111", "1", "-1e+1", "+1e-383", "-9.999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361113 fma  1      -1 +1e-383   -> -0.9999999999999999  Rounded Inexact
+	{"fmax361113", "1", "-1", "+1e-383", "-0.9999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361114 fma  1   -1e-1 +1e-383   -> -0.09999999999999999  Rounded Inexact
+	{"fmax361114", "1", "-1e-1", "+1e-383", "-0.09999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361115 fma  1   -1e-2 +1e-383   -> -0.009999999999999999  Rounded Inexact
+	{"fmax361115", "1", "-1e-2", "+1e-383", "-0.009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361116 fma  1   -1e-3 +1e-383   -> -0.0009999999999999999  Rounded Inexact
+	{"fmax361116", "1", "-1e-3", "+1e-383", "-0.0009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361117 fma  1   -1e-4 +1e-383   -> -0.00009999999999999999  Rounded Inexact
+	{"fmax361117", "1", "-1e-4", "+1e-383", "-0.00009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361118 fma  1   -1e-5 +1e-383   -> -0.000009999999999999999  Rounded Inexact
+	{"fmax361118", "1", "-1e-5", "+1e-383", "-0.000009999999999999999", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// fmax361119 fma  1   -1e-6 +1e-383   -> -9.999999999999999E-7  Rounded Inexact
+	{"fmax361119", "1", "-1e-6", "+1e-383", "-9.999999999999999E-7", Rounded | Inexact, 16, ToPositiveInf, 384, -383, false},
+	// tests based on Gunnar Degnbol's edge case
+	// rounding: half_even
+	// fmax361300 fma  1   1E16  -0.5                 ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361300", "1", "1E16", "-0.5", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361310 fma  1   1E16  -0.51                ->  9999999999999999      Inexact Rounded
+	{"fmax361310", "1", "1E16", "-0.51", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361311 fma  1   1E16  -0.501               ->  9999999999999999      Inexact Rounded
+	{"fmax361311", "1", "1E16", "-0.501", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361312 fma  1   1E16  -0.5001              ->  9999999999999999      Inexact Rounded
+	{"fmax361312", "1", "1E16", "-0.5001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361313 fma  1   1E16  -0.50001             ->  9999999999999999      Inexact Rounded
+	{"fmax361313", "1", "1E16", "-0.50001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361314 fma  1   1E16  -0.500001            ->  9999999999999999      Inexact Rounded
+	{"fmax361314", "1", "1E16", "-0.500001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361315 fma  1   1E16  -0.5000001           ->  9999999999999999      Inexact Rounded
+	{"fmax361315", "1", "1E16", "-0.5000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361316 fma  1   1E16  -0.50000001          ->  9999999999999999      Inexact Rounded
+	{"fmax361316", "1", "1E16", "-0.50000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361317 fma  1   1E16  -0.500000001         ->  9999999999999999      Inexact Rounded
+	{"fmax361317", "1", "1E16", "-0.500000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361318 fma  1   1E16  -0.5000000001        ->  9999999999999999      Inexact Rounded
+	{"fmax361318", "1", "1E16", "-0.5000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361319 fma  1   1E16  -0.50000000001       ->  9999999999999999      Inexact Rounded
+	{"fmax361319", "1", "1E16", "-0.50000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361320 fma  1   1E16  -0.500000000001      ->  9999999999999999      Inexact Rounded
+	{"fmax361320", "1", "1E16", "-0.500000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361321 fma  1   1E16  -0.5000000000001     ->  9999999999999999      Inexact Rounded
+	{"fmax361321", "1", "1E16", "-0.5000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361322 fma  1   1E16  -0.50000000000001    ->  9999999999999999      Inexact Rounded
+	{"fmax361322", "1", "1E16", "-0.50000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361323 fma  1   1E16  -0.500000000000001   ->  9999999999999999      Inexact Rounded
+	{"fmax361323", "1", "1E16", "-0.500000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361324 fma  1   1E16  -0.5000000000000001  ->  9999999999999999      Inexact Rounded
+	{"fmax361324", "1", "1E16", "-0.5000000000000001", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361325 fma  1   1E16  -0.5000000000000000  ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361325", "1", "1E16", "-0.5000000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361326 fma  1   1E16  -0.500000000000000   ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361326", "1", "1E16", "-0.500000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361327 fma  1   1E16  -0.50000000000000    ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361327", "1", "1E16", "-0.50000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361328 fma  1   1E16  -0.5000000000000     ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361328", "1", "1E16", "-0.5000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361329 fma  1   1E16  -0.500000000000      ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361329", "1", "1E16", "-0.500000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361330 fma  1   1E16  -0.50000000000       ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361330", "1", "1E16", "-0.50000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361331 fma  1   1E16  -0.5000000000        ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361331", "1", "1E16", "-0.5000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361332 fma  1   1E16  -0.500000000         ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361332", "1", "1E16", "-0.500000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361333 fma  1   1E16  -0.50000000          ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361333", "1", "1E16", "-0.50000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361334 fma  1   1E16  -0.5000000           ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361334", "1", "1E16", "-0.5000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361335 fma  1   1E16  -0.500000            ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361335", "1", "1E16", "-0.500000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361336 fma  1   1E16  -0.50000             ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361336", "1", "1E16", "-0.50000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361337 fma  1   1E16  -0.5000              ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361337", "1", "1E16", "-0.5000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361338 fma  1   1E16  -0.500               ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361338", "1", "1E16", "-0.500", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361339 fma  1   1E16  -0.50                ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361339", "1", "1E16", "-0.50", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361340 fma  1   1E16  -5000000.000010001   ->  9999999995000000      Inexact Rounded
+	{"fmax361340", "1", "1E16", "-5000000.000010001", "9999999995000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361341 fma  1   1E16  -5000000.000000001   ->  9999999995000000      Inexact Rounded
+	{"fmax361341", "1", "1E16", "-5000000.000000001", "9999999995000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361349 fma  1   9999999999999999 0.4                 ->  9999999999999999      Inexact Rounded
+	{"fmax361349", "1", "9999999999999999", "0.4", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361350 fma  1   9999999999999999 0.49                ->  9999999999999999      Inexact Rounded
+	{"fmax361350", "1", "9999999999999999", "0.49", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361351 fma  1   9999999999999999 0.499               ->  9999999999999999      Inexact Rounded
+	{"fmax361351", "1", "9999999999999999", "0.499", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361352 fma  1   9999999999999999 0.4999              ->  9999999999999999      Inexact Rounded
+	{"fmax361352", "1", "9999999999999999", "0.4999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361353 fma  1   9999999999999999 0.49999             ->  9999999999999999      Inexact Rounded
+	{"fmax361353", "1", "9999999999999999", "0.49999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361354 fma  1   9999999999999999 0.499999            ->  9999999999999999      Inexact Rounded
+	{"fmax361354", "1", "9999999999999999", "0.499999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361355 fma  1   9999999999999999 0.4999999           ->  9999999999999999      Inexact Rounded
+	{"fmax361355", "1", "9999999999999999", "0.4999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361356 fma  1   9999999999999999 0.49999999          ->  9999999999999999      Inexact Rounded
+	{"fmax361356", "1", "9999999999999999", "0.49999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361357 fma  1   9999999999999999 0.499999999         ->  9999999999999999      Inexact Rounded
+	{"fmax361357", "1", "9999999999999999", "0.499999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361358 fma  1   9999999999999999 0.4999999999        ->  9999999999999999      Inexact Rounded
+	{"fmax361358", "1", "9999999999999999", "0.4999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361359 fma  1   9999999999999999 0.49999999999       ->  9999999999999999      Inexact Rounded
+	{"fmax361359", "1", "9999999999999999", "0.49999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361360 fma  1   9999999999999999 0.499999999999      ->  9999999999999999      Inexact Rounded
+	{"fmax361360", "1", "9999999999999999", "0.499999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361361 fma  1   9999999999999999 0.4999999999999     ->  9999999999999999      Inexact Rounded
+	{"fmax361361", "1", "9999999999999999", "0.4999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361362 fma  1   9999999999999999 0.49999999999999    ->  9999999999999999      Inexact Rounded
+	{"fmax361362", "1", "9999999999999999", "0.49999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361363 fma  1   9999999999999999 0.499999999999999   ->  9999999999999999      Inexact Rounded
+	{"fmax361363", "1", "9999999999999999", "0.499999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361364 fma  1   9999999999999999 0.4999999999999999  ->  9999999999999999      Inexact Rounded
+	{"fmax361364", "1", "9999999999999999", "0.4999999999999999", "9999999999999999", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361365 fma  1   9999999999999999 0.5000000000000000  ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361365", "1", "9999999999999999", "0.5000000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361367 fma  1   9999999999999999 0.500000000000000   ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361367", "1", "9999999999999999", "0.500000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361368 fma  1   9999999999999999 0.50000000000000    ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361368", "1", "9999999999999999", "0.50000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361369 fma  1   9999999999999999 0.5000000000000     ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361369", "1", "9999999999999999", "0.5000000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361370 fma  1   9999999999999999 0.500000000000      ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361370", "1", "9999999999999999", "0.500000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361371 fma  1   9999999999999999 0.50000000000       ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361371", "1", "9999999999999999", "0.50000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361372 fma  1   9999999999999999 0.5000000000        ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361372", "1", "9999999999999999", "0.5000000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361373 fma  1   9999999999999999 0.500000000         ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361373", "1", "9999999999999999", "0.500000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361374 fma  1   9999999999999999 0.50000000          ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361374", "1", "9999999999999999", "0.50000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361375 fma  1   9999999999999999 0.5000000           ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361375", "1", "9999999999999999", "0.5000000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361376 fma  1   9999999999999999 0.500000            ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361376", "1", "9999999999999999", "0.500000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361377 fma  1   9999999999999999 0.50000             ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361377", "1", "9999999999999999", "0.50000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361378 fma  1   9999999999999999 0.5000              ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361378", "1", "9999999999999999", "0.5000", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361379 fma  1   9999999999999999 0.500               ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361379", "1", "9999999999999999", "0.500", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361380 fma  1   9999999999999999 0.50                ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361380", "1", "9999999999999999", "0.50", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361381 fma  1   9999999999999999 0.5                 ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361381", "1", "9999999999999999", "0.5", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361382 fma  1   9999999999999999 0.5000000000000001  ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361382", "1", "9999999999999999", "0.5000000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361383 fma  1   9999999999999999 0.500000000000001   ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361383", "1", "9999999999999999", "0.500000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361384 fma  1   9999999999999999 0.50000000000001    ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361384", "1", "9999999999999999", "0.50000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361385 fma  1   9999999999999999 0.5000000000001     ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361385", "1", "9999999999999999", "0.5000000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361386 fma  1   9999999999999999 0.500000000001      ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361386", "1", "9999999999999999", "0.500000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361387 fma  1   9999999999999999 0.50000000001       ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361387", "1", "9999999999999999", "0.50000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361388 fma  1   9999999999999999 0.5000000001        ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361388", "1", "9999999999999999", "0.5000000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361389 fma  1   9999999999999999 0.500000001         ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361389", "1", "9999999999999999", "0.500000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361390 fma  1   9999999999999999 0.50000001          ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361390", "1", "9999999999999999", "0.50000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361391 fma  1   9999999999999999 0.5000001           ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361391", "1", "9999999999999999", "0.5000001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361392 fma  1   9999999999999999 0.500001            ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361392", "1", "9999999999999999", "0.500001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361393 fma  1   9999999999999999 0.50001             ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361393", "1", "9999999999999999", "0.50001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361394 fma  1   9999999999999999 0.5001              ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361394", "1", "9999999999999999", "0.5001", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361395 fma  1   9999999999999999 0.501               ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361395", "1", "9999999999999999", "0.501", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361396 fma  1   9999999999999999 0.51                ->  1.000000000000000E+16 Inexact Rounded
+	{"fmax361396", "1", "9999999999999999", "0.51", "1.000000000000000E+16", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// More GD edge cases, where difference between the unadjusted
+	// exponents is larger than the maximum precision and one side is 0
+	// fmax361420 fma  1    0 1.123456789012345     -> 1.123456789012345
+	{"fmax361420", "1", "0", "1.123456789012345", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361421 fma  1    0 1.123456789012345E-1  -> 0.1123456789012345
+	{"fmax361421", "1", "0", "1.123456789012345E-1", "0.1123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361422 fma  1    0 1.123456789012345E-2  -> 0.01123456789012345
+	{"fmax361422", "1", "0", "1.123456789012345E-2", "0.01123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361423 fma  1    0 1.123456789012345E-3  -> 0.001123456789012345
+	{"fmax361423", "1", "0", "1.123456789012345E-3", "0.001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361424 fma  1    0 1.123456789012345E-4  -> 0.0001123456789012345
+	{"fmax361424", "1", "0", "1.123456789012345E-4", "0.0001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361425 fma  1    0 1.123456789012345E-5  -> 0.00001123456789012345
+	{"fmax361425", "1", "0", "1.123456789012345E-5", "0.00001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361426 fma  1    0 1.123456789012345E-6  -> 0.000001123456789012345
+	{"fmax361426", "1", "0", "1.123456789012345E-6", "0.000001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361427 fma  1    0 1.123456789012345E-7  -> 1.123456789012345E-7
+	{"fmax361427", "1", "0", "1.123456789012345E-7", "1.123456789012345E-7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361428 fma  1    0 1.123456789012345E-8  -> 1.123456789012345E-8
+	{"fmax361428", "1", "0", "1.123456789012345E-8", "1.123456789012345E-8", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361429 fma  1    0 1.123456789012345E-9  -> 1.123456789012345E-9
+	{"fmax361429", "1", "0", "1.123456789012345E-9", "1.123456789012345E-9", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361430 fma  1    0 1.123456789012345E-10 -> 1.123456789012345E-10
+	{"fmax361430", "1", "0", "1.123456789012345E-10", "1.123456789012345E-10", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361431 fma  1    0 1.123456789012345E-11 -> 1.123456789012345E-11
+	{"fmax361431", "1", "0", "1.123456789012345E-11", "1.123456789012345E-11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361432 fma  1    0 1.123456789012345E-12 -> 1.123456789012345E-12
+	{"fmax361432", "1", "0", "1.123456789012345E-12", "1.123456789012345E-12", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361433 fma  1    0 1.123456789012345E-13 -> 1.123456789012345E-13
+	{"fmax361433", "1", "0", "1.123456789012345E-13", "1.123456789012345E-13", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361434 fma  1    0 1.123456789012345E-14 -> 1.123456789012345E-14
+	{"fmax361434", "1", "0", "1.123456789012345E-14", "1.123456789012345E-14", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361435 fma  1    0 1.123456789012345E-15 -> 1.123456789012345E-15
+	{"fmax361435", "1", "0", "1.123456789012345E-15", "1.123456789012345E-15", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361436 fma  1    0 1.123456789012345E-16 -> 1.123456789012345E-16
+	{"fmax361436", "1", "0", "1.123456789012345E-16", "1.123456789012345E-16", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361437 fma  1    0 1.123456789012345E-17 -> 1.123456789012345E-17
+	{"fmax361437", "1", "0", "1.123456789012345E-17", "1.123456789012345E-17", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361438 fma  1    0 1.123456789012345E-18 -> 1.123456789012345E-18
+	{"fmax361438", "1", "0", "1.123456789012345E-18", "1.123456789012345E-18", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361439 fma  1    0 1.123456789012345E-19 -> 1.123456789012345E-19
+	{"fmax361439", "1", "0", "1.123456789012345E-19", "1.123456789012345E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// same, reversed 0
+	// fmax361440 fma  1   1.123456789012345     0 -> 1.123456789012345
+	{"fmax361440", "1", "1.123456789012345", "0", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361441 fma  1   1.123456789012345E-1  0 -> 0.1123456789012345
+	{"fmax361441", "1", "1.123456789012345E-1", "0", "0.1123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361442 fma  1   1.123456789012345E-2  0 -> 0.01123456789012345
+	{"fmax361442", "1", "1.123456789012345E-2", "0", "0.01123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361443 fma  1   1.123456789012345E-3  0 -> 0.001123456789012345
+	{"fmax361443", "1", "1.123456789012345E-3", "0", "0.001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361444 fma  1   1.123456789012345E-4  0 -> 0.0001123456789012345
+	{"fmax361444", "1", "1.123456789012345E-4", "0", "0.0001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361445 fma  1   1.123456789012345E-5  0 -> 0.00001123456789012345
+	{"fmax361445", "1", "1.123456789012345E-5", "0", "0.00001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361446 fma  1   1.123456789012345E-6  0 -> 0.000001123456789012345
+	{"fmax361446", "1", "1.123456789012345E-6", "0", "0.000001123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361447 fma  1   1.123456789012345E-7  0 -> 1.123456789012345E-7
+	{"fmax361447", "1", "1.123456789012345E-7", "0", "1.123456789012345E-7", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361448 fma  1   1.123456789012345E-8  0 -> 1.123456789012345E-8
+	{"fmax361448", "1", "1.123456789012345E-8", "0", "1.123456789012345E-8", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361449 fma  1   1.123456789012345E-9  0 -> 1.123456789012345E-9
+	{"fmax361449", "1", "1.123456789012345E-9", "0", "1.123456789012345E-9", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361450 fma  1   1.123456789012345E-10 0 -> 1.123456789012345E-10
+	{"fmax361450", "1", "1.123456789012345E-10", "0", "1.123456789012345E-10", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361451 fma  1   1.123456789012345E-11 0 -> 1.123456789012345E-11
+	{"fmax361451", "1", "1.123456789012345E-11", "0", "1.123456789012345E-11", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361452 fma  1   1.123456789012345E-12 0 -> 1.123456789012345E-12
+	{"fmax361452", "1", "1.123456789012345E-12", "0", "1.123456789012345E-12", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361453 fma  1   1.123456789012345E-13 0 -> 1.123456789012345E-13
+	{"fmax361453", "1", "1.123456789012345E-13", "0", "1.123456789012345E-13", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361454 fma  1   1.123456789012345E-14 0 -> 1.123456789012345E-14
+	{"fmax361454", "1", "1.123456789012345E-14", "0", "1.123456789012345E-14", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361455 fma  1   1.123456789012345E-15 0 -> 1.123456789012345E-15
+	{"fmax361455", "1", "1.123456789012345E-15", "0", "1.123456789012345E-15", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361456 fma  1   1.123456789012345E-16 0 -> 1.123456789012345E-16
+	{"fmax361456", "1", "1.123456789012345E-16", "0", "1.123456789012345E-16", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361457 fma  1   1.123456789012345E-17 0 -> 1.123456789012345E-17
+	{"fmax361457", "1", "1.123456789012345E-17", "0", "1.123456789012345E-17", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361458 fma  1   1.123456789012345E-18 0 -> 1.123456789012345E-18
+	{"fmax361458", "1", "1.123456789012345E-18", "0", "1.123456789012345E-18", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361459 fma  1   1.123456789012345E-19 0 -> 1.123456789012345E-19
+	{"fmax361459", "1", "1.123456789012345E-19", "0", "1.123456789012345E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// same, Es on the 0
+	// fmax361460 fma  1   1.123456789012345  0E-0   -> 1.123456789012345
+	{"fmax361460", "1", "1.123456789012345", "0E-0", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361461 fma  1   1.123456789012345  0E-1   -> 1.123456789012345
+	{"fmax361461", "1", "1.123456789012345", "0E-1", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361462 fma  1   1.123456789012345  0E-2   -> 1.123456789012345
+	{"fmax361462", "1", "1.123456789012345", "0E-2", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361463 fma  1   1.123456789012345  0E-3   -> 1.123456789012345
+	{"fmax361463", "1", "1.123456789012345", "0E-3", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361464 fma  1   1.123456789012345  0E-4   -> 1.123456789012345
+	{"fmax361464", "1", "1.123456789012345", "0E-4", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361465 fma  1   1.123456789012345  0E-5   -> 1.123456789012345
+	{"fmax361465", "1", "1.123456789012345", "0E-5", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361466 fma  1   1.123456789012345  0E-6   -> 1.123456789012345
+	{"fmax361466", "1", "1.123456789012345", "0E-6", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361467 fma  1   1.123456789012345  0E-7   -> 1.123456789012345
+	{"fmax361467", "1", "1.123456789012345", "0E-7", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361468 fma  1   1.123456789012345  0E-8   -> 1.123456789012345
+	{"fmax361468", "1", "1.123456789012345", "0E-8", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361469 fma  1   1.123456789012345  0E-9   -> 1.123456789012345
+	{"fmax361469", "1", "1.123456789012345", "0E-9", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361470 fma  1   1.123456789012345  0E-10  -> 1.123456789012345
+	{"fmax361470", "1", "1.123456789012345", "0E-10", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361471 fma  1   1.123456789012345  0E-11  -> 1.123456789012345
+	{"fmax361471", "1", "1.123456789012345", "0E-11", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361472 fma  1   1.123456789012345  0E-12  -> 1.123456789012345
+	{"fmax361472", "1", "1.123456789012345", "0E-12", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361473 fma  1   1.123456789012345  0E-13  -> 1.123456789012345
+	{"fmax361473", "1", "1.123456789012345", "0E-13", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361474 fma  1   1.123456789012345  0E-14  -> 1.123456789012345
+	{"fmax361474", "1", "1.123456789012345", "0E-14", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361475 fma  1   1.123456789012345  0E-15  -> 1.123456789012345
+	{"fmax361475", "1", "1.123456789012345", "0E-15", "1.123456789012345", 0, 16, ToNearestEven, 384, -383, false},
+	// next four flag Rounded because the 0 extends the result
+	// fmax361476 fma  1   1.123456789012345  0E-16  -> 1.123456789012345 Rounded
+	{"fmax361476", "1", "1.123456789012345", "0E-16", "1.123456789012345", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361477 fma  1   1.123456789012345  0E-17  -> 1.123456789012345 Rounded
+	{"fmax361477", "1", "1.123456789012345", "0E-17", "1.123456789012345", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361478 fma  1   1.123456789012345  0E-18  -> 1.123456789012345 Rounded
+	{"fmax361478", "1", "1.123456789012345", "0E-18", "1.123456789012345", Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax361479 fma  1   1.123456789012345  0E-19  -> 1.123456789012345 Rounded
+	{"fmax361479", "1", "1.123456789012345", "0E-19", "1.123456789012345", Rounded, 16, ToNearestEven, 384, -383, false},
+	// sum of two opposite-sign operands is exactly 0 and floor => -0
+	// rounding: half_up
+	// exact zeros from zeros
+	// fmax361500 fma  1    0        0E-19  ->  0E-19
+	{"fmax361500", "1", "0", "0E-19", "0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax361501 fma  1   -0        0E-19  ->  0E-19
+	{"fmax361501", "1", "-0", "0E-19", "0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax361502 fma  1    0       -0E-19  ->  0E-19
+	{"fmax361502", "1", "0", "-0E-19", "0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax361503 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361503", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNearestAway, 384, -383, false},
+	// fmax361504 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361504", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361505 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361505", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361506 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax361506", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361507 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361507", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// inexact zeros
+	// fmax361511 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361511", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361512 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361512", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361513 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361513", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361514 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361514", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361515 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361515", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361516 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax361516", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361517 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax361517", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToNearestAway, 384, -383, false},
+	// fmax361518 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361518", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestAway, 384, -383, false},
+	// rounding: half_down
+	// exact zeros from zeros
+	// fmax361520 fma  1    0        0E-19  ->  0E-19
+	{"fmax361520", "1", "0", "0E-19", "0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax361521 fma  1   -0        0E-19  ->  0E-19
+	{"fmax361521", "1", "-0", "0E-19", "0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax361522 fma  1    0       -0E-19  ->  0E-19
+	{"fmax361522", "1", "0", "-0E-19", "0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax361523 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361523", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNearestZero, 384, -383, false},
+	// fmax361524 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361524", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361525 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361525", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361526 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax361526", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361527 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361527", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// inexact zeros
+	// fmax361531 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361531", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361532 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361532", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361533 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361533", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361534 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361534", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361535 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361535", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361536 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax361536", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361537 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax361537", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToNearestZero, 384, -383, false},
+	// fmax361538 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361538", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestZero, 384, -383, false},
+	// rounding: half_even
+	// exact zeros from zeros
+	// fmax361540 fma  1    0        0E-19  ->  0E-19
+	{"fmax361540", "1", "0", "0E-19", "0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361541 fma  1   -0        0E-19  ->  0E-19
+	{"fmax361541", "1", "-0", "0E-19", "0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361542 fma  1    0       -0E-19  ->  0E-19
+	{"fmax361542", "1", "0", "-0E-19", "0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361543 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361543", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax361544 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361544", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361545 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361545", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361546 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax361546", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361547 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361547", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// inexact zeros
+	// fmax361551 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361551", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361552 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361552", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361553 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361553", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361554 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361554", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361555 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361555", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361556 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax361556", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361557 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax361557", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToNearestEven, 384, -383, false},
+	// fmax361558 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361558", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNearestEven, 384, -383, false},
+	// rounding: up
+	// exact zeros from zeros
+	// fmax361560 fma  1    0        0E-19  ->  0E-19
+	{"fmax361560", "1", "0", "0E-19", "0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax361561 fma  1   -0        0E-19  ->  0E-19
+	{"fmax361561", "1", "-0", "0E-19", "0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax361562 fma  1    0       -0E-19  ->  0E-19
+	{"fmax361562", "1", "0", "-0E-19", "0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax361563 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361563", "1", "-0", "-0E-19", "-0E-19", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax361564 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361564", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax361565 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361565", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax361566 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax361566", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax361567 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361567", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// inexact zeros
+	// fmax361571 fma  1    1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361571", "1", "1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax361572 fma  1   -1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361572", "1", "-1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax361573 fma  1    1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361573", "1", "1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax361574 fma  1   -1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361574", "1", "-1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361575 fma  1    1E-401   1E-401 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361575", "1", "1E-401", "1E-401", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// fmax361576 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax361576", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax361577 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax361577", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, AwayFromZero, 384, -383, false},
+	// fmax361578 fma  1   -1E-401  -1E-401 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361578", "1", "-1E-401", "-1E-401", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, AwayFromZero, 384, -383, false},
+	// rounding: down
+	// exact zeros from zeros
+	// fmax361580 fma  1    0        0E-19  ->  0E-19
+	{"fmax361580", "1", "0", "0E-19", "0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax361581 fma  1   -0        0E-19  ->  0E-19
+	{"fmax361581", "1", "-0", "0E-19", "0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax361582 fma  1    0       -0E-19  ->  0E-19
+	{"fmax361582", "1", "0", "-0E-19", "0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax361583 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361583", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToZero, 384, -383, false},
+	// fmax361584 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361584", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax361585 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361585", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax361586 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax361586", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax361587 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361587", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// inexact zeros
+	// fmax361591 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361591", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax361592 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361592", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax361593 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361593", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax361594 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361594", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361595 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361595", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// fmax361596 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax361596", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax361597 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax361597", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToZero, 384, -383, false},
+	// fmax361598 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361598", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToZero, 384, -383, false},
+	// rounding: ceiling
+	// exact zeros from zeros
+	// fmax361600 fma  1    0        0E-19  ->  0E-19
+	{"fmax361600", "1", "0", "0E-19", "0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax361601 fma  1   -0        0E-19  ->  0E-19
+	{"fmax361601", "1", "-0", "0E-19", "0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax361602 fma  1    0       -0E-19  ->  0E-19
+	{"fmax361602", "1", "0", "-0E-19", "0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax361603 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361603", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToPositiveInf, 384, -383, false},
+	// fmax361604 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361604", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax361605 fma  1   -0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361605", "1", "-0E-400", "0E-19", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax361606 fma  1    0E-400  -0E-19  ->  0E-398 Clamped
+	{"fmax361606", "1", "0E-400", "-0E-19", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax361607 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361607", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// inexact zeros
+	// fmax361611 fma  1    1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361611", "1", "1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToPositiveInf, 384, -383, false},
+	// fmax361612 fma  1   -1E-401   1E-400 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361612", "1", "-1E-401", "1E-400", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToPositiveInf, 384, -383, false},
+	// fmax361613 fma  1    1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361613", "1", "1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax361614 fma  1   -1E-401  -1E-400 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361614", "1", "-1E-401", "-1E-400", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToPositiveInf, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361615 fma  1    1E-401   1E-401 ->  1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361615", "1", "1E-401", "1E-401", "1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToPositiveInf, 384, -383, false},
+	// fmax361616 fma  1   -1E-401   1E-401 ->  0E-398 Clamped
+	{"fmax361616", "1", "-1E-401", "1E-401", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax361617 fma  1    1E-401  -1E-401 ->  0E-398 Clamped
+	{"fmax361617", "1", "1E-401", "-1E-401", "0E-398", Clamped, 16, ToPositiveInf, 384, -383, false},
+	// fmax361618 fma  1   -1E-401  -1E-401 -> -0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361618", "1", "-1E-401", "-1E-401", "-0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToPositiveInf, 384, -383, false},
+	// and the extra-special ugly case; unusual minuses marked by -- *
+	// rounding: floor
+	// exact zeros from zeros
+	// fmax361620 fma  1    0        0E-19  ->  0E-19
+	{"fmax361620", "1", "0", "0E-19", "0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361621 fma  1   -0        0E-19  -> -0E-19           -- *
+	{"fmax361621", "1", "-0", "0E-19", "-0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361622 fma  1    0       -0E-19  -> -0E-19           -- *
+	{"fmax361622", "1", "0", "-0E-19", "-0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361623 fma  1   -0       -0E-19  -> -0E-19
+	{"fmax361623", "1", "-0", "-0E-19", "-0E-19", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361624 fma  1    0E-400   0E-19  ->  0E-398 Clamped
+	{"fmax361624", "1", "0E-400", "0E-19", "0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361625 fma  1   -0E-400   0E-19  -> -0E-398 Clamped  -- *
+	{"fmax361625", "1", "-0E-400", "0E-19", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361626 fma  1    0E-400  -0E-19  -> -0E-398 Clamped  -- *
+	{"fmax361626", "1", "0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361627 fma  1   -0E-400  -0E-19  -> -0E-398 Clamped
+	{"fmax361627", "1", "-0E-400", "-0E-19", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// inexact zeros
+	// fmax361631 fma  1    1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361631", "1", "1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361632 fma  1   -1E-401   1E-400 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361632", "1", "-1E-401", "1E-400", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361633 fma  1    1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361633", "1", "1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToNegativeInf, 384, -383, false},
+	// fmax361634 fma  1   -1E-401  -1E-400 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361634", "1", "-1E-401", "-1E-400", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToNegativeInf, 384, -383, false},
+	// some exact zeros from non-zeros
+	// fmax361635 fma  1    1E-401   1E-401 ->  0E-398 Subnormal Inexact Rounded Underflow Clamped
+	{"fmax361635", "1", "1E-401", "1E-401", "0E-398", Subnormal | Inexact | Rounded | Underflow | Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361636 fma  1   -1E-401   1E-401 -> -0E-398 Clamped  -- *
+	{"fmax361636", "1", "-1E-401", "1E-401", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361637 fma  1    1E-401  -1E-401 -> -0E-398 Clamped  -- *
+	{"fmax361637", "1", "1E-401", "-1E-401", "-0E-398", Clamped, 16, ToNegativeInf, 384, -383, false},
+	// fmax361638 fma  1   -1E-401  -1E-401 -> -1E-398 Subnormal Inexact Rounded Underflow
+	{"fmax361638", "1", "-1E-401", "-1E-401", "-1E-398", Subnormal | Inexact | Rounded | Underflow, 16, ToNegativeInf, 384, -383, false},
+	// Examples from SQL proposal (Krishna Kulkarni)
+	// fmax361701 fma  1   130E-2    120E-2    -> 2.50
+	{"fmax361701", "1", "130E-2", "120E-2", "2.50", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361702 fma  1   130E-2    12E-1     -> 2.50
+	{"fmax361702", "1", "130E-2", "12E-1", "2.50", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361703 fma  1   130E-2    1E0       -> 2.30
+	{"fmax361703", "1", "130E-2", "1E0", "2.30", 0, 16, ToNegativeInf, 384, -383, false},
+	// fmax361704 fma  1   1E2       1E4       -> 1.01E+4
+	{"fmax361704", "1", "1E2", "1E4", "1.01E+4", 0, 16, ToNegativeInf, 384, -383, false},
+	// SKIP (subtract not supported): fmax361705 subtract 130E-2  120E-2 -> 0.10
+	// SKIP (subtract not supported): fmax361706 subtract 130E-2  12E-1  -> 0.10
+	// SKIP (subtract not supported): fmax361707 subtract 130E-2  1E0    -> 0.30
+	// SKIP (subtract not supported): fmax361708 subtract 1E2     1E4    -> -9.9E+3
+	// Gappy coefficients; check residue handling even with full coefficient gap
+	// rounding: half_even
+	// fmax362001 fma  1   1234567890123456 1      -> 1234567890123457
+	{"fmax362001", "1", "1234567890123456", "1", "1234567890123457", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362002 fma  1   1234567890123456 0.6    -> 1234567890123457  Inexact Rounded
+	{"fmax362002", "1", "1234567890123456", "0.6", "1234567890123457", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362003 fma  1   1234567890123456 0.06   -> 1234567890123456  Inexact Rounded
+	{"fmax362003", "1", "1234567890123456", "0.06", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362004 fma  1   1234567890123456 6E-3   -> 1234567890123456  Inexact Rounded
+	{"fmax362004", "1", "1234567890123456", "6E-3", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362005 fma  1   1234567890123456 6E-4   -> 1234567890123456  Inexact Rounded
+	{"fmax362005", "1", "1234567890123456", "6E-4", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362006 fma  1   1234567890123456 6E-5   -> 1234567890123456  Inexact Rounded
+	{"fmax362006", "1", "1234567890123456", "6E-5", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362007 fma  1   1234567890123456 6E-6   -> 1234567890123456  Inexact Rounded
+	{"fmax362007", "1", "1234567890123456", "6E-6", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362008 fma  1   1234567890123456 6E-7   -> 1234567890123456  Inexact Rounded
+	{"fmax362008", "1", "1234567890123456", "6E-7", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362009 fma  1   1234567890123456 6E-8   -> 1234567890123456  Inexact Rounded
+	{"fmax362009", "1", "1234567890123456", "6E-8", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362010 fma  1   1234567890123456 6E-9   -> 1234567890123456  Inexact Rounded
+	{"fmax362010", "1", "1234567890123456", "6E-9", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362011 fma  1   1234567890123456 6E-10  -> 1234567890123456  Inexact Rounded
+	{"fmax362011", "1", "1234567890123456", "6E-10", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362012 fma  1   1234567890123456 6E-11  -> 1234567890123456  Inexact Rounded
+	{"fmax362012", "1", "1234567890123456", "6E-11", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362013 fma  1   1234567890123456 6E-12  -> 1234567890123456  Inexact Rounded
+	{"fmax362013", "1", "1234567890123456", "6E-12", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362014 fma  1   1234567890123456 6E-13  -> 1234567890123456  Inexact Rounded
+	{"fmax362014", "1", "1234567890123456", "6E-13", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362015 fma  1   1234567890123456 6E-14  -> 1234567890123456  Inexact Rounded
+	{"fmax362015", "1", "1234567890123456", "6E-14", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362016 fma  1   1234567890123456 6E-15  -> 1234567890123456  Inexact Rounded
+	{"fmax362016", "1", "1234567890123456", "6E-15", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362017 fma  1   1234567890123456 6E-16  -> 1234567890123456  Inexact Rounded
+	{"fmax362017", "1", "1234567890123456", "6E-16", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362018 fma  1   1234567890123456 6E-17  -> 1234567890123456  Inexact Rounded
+	{"fmax362018", "1", "1234567890123456", "6E-17", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362019 fma  1   1234567890123456 6E-18  -> 1234567890123456  Inexact Rounded
+	{"fmax362019", "1", "1234567890123456", "6E-18", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362020 fma  1   1234567890123456 6E-19  -> 1234567890123456  Inexact Rounded
+	{"fmax362020", "1", "1234567890123456", "6E-19", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362021 fma  1   1234567890123456 6E-20  -> 1234567890123456  Inexact Rounded
+	{"fmax362021", "1", "1234567890123456", "6E-20", "1234567890123456", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// widening second argument at gap
+	// fmax362030 fma  1   12345678 1                       -> 12345679
+	{"fmax362030", "1", "12345678", "1", "12345679", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362031 fma  1   12345678 0.1                     -> 12345678.1
+	{"fmax362031", "1", "12345678", "0.1", "12345678.1", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362032 fma  1   12345678 0.12                    -> 12345678.12
+	{"fmax362032", "1", "12345678", "0.12", "12345678.12", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362033 fma  1   12345678 0.123                   -> 12345678.123
+	{"fmax362033", "1", "12345678", "0.123", "12345678.123", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362034 fma  1   12345678 0.1234                  -> 12345678.1234
+	{"fmax362034", "1", "12345678", "0.1234", "12345678.1234", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362035 fma  1   12345678 0.12345                 -> 12345678.12345
+	{"fmax362035", "1", "12345678", "0.12345", "12345678.12345", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362036 fma  1   12345678 0.123456                -> 12345678.123456
+	{"fmax362036", "1", "12345678", "0.123456", "12345678.123456", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362037 fma  1   12345678 0.1234567               -> 12345678.1234567
+	{"fmax362037", "1", "12345678", "0.1234567", "12345678.1234567", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362038 fma  1   12345678 0.12345678              -> 12345678.12345678
+	{"fmax362038", "1", "12345678", "0.12345678", "12345678.12345678", 0, 16, ToNearestEven, 384, -383, false},
+	// fmax362039 fma  1   12345678 0.123456789             -> 12345678.12345679 Inexact Rounded
+	{"fmax362039", "1", "12345678", "0.123456789", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362040 fma  1   12345678 0.123456785             -> 12345678.12345678 Inexact Rounded
+	{"fmax362040", "1", "12345678", "0.123456785", "12345678.12345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362041 fma  1   12345678 0.1234567850            -> 12345678.12345678 Inexact Rounded
+	{"fmax362041", "1", "12345678", "0.1234567850", "12345678.12345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362042 fma  1   12345678 0.1234567851            -> 12345678.12345679 Inexact Rounded
+	{"fmax362042", "1", "12345678", "0.1234567851", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362043 fma  1   12345678 0.12345678501           -> 12345678.12345679 Inexact Rounded
+	{"fmax362043", "1", "12345678", "0.12345678501", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362044 fma  1   12345678 0.123456785001          -> 12345678.12345679 Inexact Rounded
+	{"fmax362044", "1", "12345678", "0.123456785001", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362045 fma  1   12345678 0.1234567850001         -> 12345678.12345679 Inexact Rounded
+	{"fmax362045", "1", "12345678", "0.1234567850001", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362046 fma  1   12345678 0.12345678500001        -> 12345678.12345679 Inexact Rounded
+	{"fmax362046", "1", "12345678", "0.12345678500001", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362047 fma  1   12345678 0.123456785000001       -> 12345678.12345679 Inexact Rounded
+	{"fmax362047", "1", "12345678", "0.123456785000001", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362048 fma  1   12345678 0.1234567850000001      -> 12345678.12345679 Inexact Rounded
+	{"fmax362048", "1", "12345678", "0.1234567850000001", "12345678.12345679", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362049 fma  1   12345678 0.1234567850000000      -> 12345678.12345678 Inexact Rounded
+	{"fmax362049", "1", "12345678", "0.1234567850000000", "12345678.12345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	//                               90123456
+	// rounding: half_even
+	// fmax362050 fma  1   12345678 0.0234567750000000      -> 12345678.02345678 Inexact Rounded
+	{"fmax362050", "1", "12345678", "0.0234567750000000", "12345678.02345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362051 fma  1   12345678 0.0034567750000000      -> 12345678.00345678 Inexact Rounded
+	{"fmax362051", "1", "12345678", "0.0034567750000000", "12345678.00345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362052 fma  1   12345678 0.0004567750000000      -> 12345678.00045678 Inexact Rounded
+	{"fmax362052", "1", "12345678", "0.0004567750000000", "12345678.00045678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362053 fma  1   12345678 0.0000567750000000      -> 12345678.00005678 Inexact Rounded
+	{"fmax362053", "1", "12345678", "0.0000567750000000", "12345678.00005678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362054 fma  1   12345678 0.0000067750000000      -> 12345678.00000678 Inexact Rounded
+	{"fmax362054", "1", "12345678", "0.0000067750000000", "12345678.00000678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362055 fma  1   12345678 0.0000007750000000      -> 12345678.00000078 Inexact Rounded
+	{"fmax362055", "1", "12345678", "0.0000007750000000", "12345678.00000078", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362056 fma  1   12345678 0.0000000750000000      -> 12345678.00000008 Inexact Rounded
+	{"fmax362056", "1", "12345678", "0.0000000750000000", "12345678.00000008", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362057 fma  1   12345678 0.0000000050000000      -> 12345678.00000000 Inexact Rounded
+	{"fmax362057", "1", "12345678", "0.0000000050000000", "12345678.00000000", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362060 fma  1   12345678 0.0234567750000001      -> 12345678.02345678 Inexact Rounded
+	{"fmax362060", "1", "12345678", "0.0234567750000001", "12345678.02345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362061 fma  1   12345678 0.0034567750000001      -> 12345678.00345678 Inexact Rounded
+	{"fmax362061", "1", "12345678", "0.0034567750000001", "12345678.00345678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362062 fma  1   12345678 0.0004567750000001      -> 12345678.00045678 Inexact Rounded
+	{"fmax362062", "1", "12345678", "0.0004567750000001", "12345678.00045678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362063 fma  1   12345678 0.0000567750000001      -> 12345678.00005678 Inexact Rounded
+	{"fmax362063", "1", "12345678", "0.0000567750000001", "12345678.00005678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362064 fma  1   12345678 0.0000067750000001      -> 12345678.00000678 Inexact Rounded
+	{"fmax362064", "1", "12345678", "0.0000067750000001", "12345678.00000678", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362065 fma  1   12345678 0.0000007750000001      -> 12345678.00000078 Inexact Rounded
+	{"fmax362065", "1", "12345678", "0.0000007750000001", "12345678.00000078", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362066 fma  1   12345678 0.0000000750000001      -> 12345678.00000008 Inexact Rounded
+	{"fmax362066", "1", "12345678", "0.0000000750000001", "12345678.00000008", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// fmax362067 fma  1   12345678 0.0000000050000001      -> 12345678.00000001 Inexact Rounded
+	{"fmax362067", "1", "12345678", "0.0000000050000001", "12345678.00000001", Inexact | Rounded, 16, ToNearestEven, 384, -383, false},
+	// far-out residues (full coefficient gap is 16+15 digits)
+	// rounding: up
+	// fmax362070 fma  1   12345678 1E-8                    -> 12345678.00000001
+	{"fmax362070", "1", "12345678", "1E-8", "12345678.00000001", 0, 16, AwayFromZero, 384, -383, false},
+	// fmax362071 fma  1   12345678 1E-9                    -> 12345678.00000001 Inexact Rounded
+	{"fmax362071", "1", "12345678", "1E-9", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362072 fma  1   12345678 1E-10                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362072", "1", "12345678", "1E-10", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362073 fma  1   12345678 1E-11                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362073", "1", "12345678", "1E-11", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362074 fma  1   12345678 1E-12                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362074", "1", "12345678", "1E-12", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362075 fma  1   12345678 1E-13                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362075", "1", "12345678", "1E-13", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362076 fma  1   12345678 1E-14                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362076", "1", "12345678", "1E-14", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362077 fma  1   12345678 1E-15                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362077", "1", "12345678", "1E-15", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362078 fma  1   12345678 1E-16                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362078", "1", "12345678", "1E-16", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362079 fma  1   12345678 1E-17                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362079", "1", "12345678", "1E-17", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362080 fma  1   12345678 1E-18                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362080", "1", "12345678", "1E-18", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362081 fma  1   12345678 1E-19                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362081", "1", "12345678", "1E-19", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362082 fma  1   12345678 1E-20                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362082", "1", "12345678", "1E-20", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362083 fma  1   12345678 1E-25                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362083", "1", "12345678", "1E-25", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362084 fma  1   12345678 1E-30                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362084", "1", "12345678", "1E-30", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362085 fma  1   12345678 1E-31                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362085", "1", "12345678", "1E-31", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362086 fma  1   12345678 1E-32                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362086", "1", "12345678", "1E-32", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362087 fma  1   12345678 1E-33                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362087", "1", "12345678", "1E-33", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362088 fma  1   12345678 1E-34                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362088", "1", "12345678", "1E-34", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// fmax362089 fma  1   12345678 1E-35                   -> 12345678.00000001 Inexact Rounded
+	{"fmax362089", "1", "12345678", "1E-35", "12345678.00000001", Inexact | Rounded, 16, AwayFromZero, 384, -383, false},
+	// payload decapitate x3
+	// precision: 5
+	// fmax363000 fma  1 1  sNaN1234567890     ->  NaN67890  Invalid_operation
+	{"fmax363000", "1", "1", "sNaN1234567890", "NaN67890", InvalidOperation, 5, AwayFromZero, 384, -383, false},
+	// fmax363001 fma    1 -sNaN1234512345 1   -> -NaN12345  Invalid_operation
+	{"fmax363001", "1", "-sNaN1234512345", "1", "-NaN12345", InvalidOperation, 5, AwayFromZero, 384, -383, false},
+	// fmax363002 fma       sNaN1234554321 1 1 ->  NaN54321  Invalid_operation
+	{"fmax363002", "sNaN1234554321", "1", "1", "NaN54321", InvalidOperation, 5, AwayFromZero, 384, -383, false},
+	// Null tests
+	// SKIP (encoding not supported): fmax39990 fma  1   10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): fmax39991 fma  1    # 10 -> NaN Invalid_operation
+}
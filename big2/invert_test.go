@@ -0,0 +1,293 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var invertTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check (truth table), and examples from decArith
+	// invx001 invert             0 -> 111111111
+	{"invx001", "0", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// invx002 invert             1 -> 111111110
+	{"invx002", "1", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// invx003 invert            10 -> 111111101
+	{"invx003", "10", "111111101", 0, 9, ToNearestAway, 999, -999, false},
+	// invx004 invert     111111111 ->         0
+	{"invx004", "111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx005 invert     000000000 -> 111111111
+	{"invx005", "000000000", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// invx006 invert     101010101 -> '10101010'
+	{"invx006", "101010101", "10101010", 0, 9, ToNearestAway, 999, -999, false},
+	// and at msd and msd-1
+	// invx007 invert 000000000 ->   111111111
+	{"invx007", "000000000", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// invx009 invert 100000000 ->    11111111
+	{"invx009", "100000000", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// invx011 invert 000000000 ->   111111111
+	{"invx011", "000000000", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// invx013 invert 010000000 ->   101111111
+	{"invx013", "010000000", "101111111", 0, 9, ToNearestAway, 999, -999, false},
+	// Various lengths
+	//             123456789         123456789
+	// invx021 invert 111111111     ->  0
+	{"invx021", "111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx022 invert 111111111111  ->  0
+	{"invx022", "111111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx023 invert  11111111     ->  100000000
+	{"invx023", "11111111", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx025 invert   1111111     ->  110000000
+	{"invx025", "1111111", "110000000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx026 invert    111111     ->  111000000
+	{"invx026", "111111", "111000000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx027 invert     11111     ->  111100000
+	{"invx027", "11111", "111100000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx028 invert      1111     ->  111110000
+	{"invx028", "1111", "111110000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx029 invert       111     ->  111111000
+	{"invx029", "111", "111111000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx031 invert        11     ->  111111100
+	{"invx031", "11", "111111100", 0, 9, ToNearestAway, 999, -999, false},
+	// invx032 invert         1     ->  111111110
+	{"invx032", "1", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// invx033 invert 111111111111  ->  0
+	{"invx033", "111111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx034 invert 11111111111   ->  0
+	{"invx034", "11111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx035 invert 1111111111    ->  0
+	{"invx035", "1111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx036 invert 111111111     ->  0
+	{"invx036", "111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// invx080 invert 011111111   ->  100000000
+	{"invx080", "011111111", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx081 invert 101111111   ->   10000000
+	{"invx081", "101111111", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx082 invert 110111111   ->    1000000
+	{"invx082", "110111111", "1000000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx083 invert 111011111   ->     100000
+	{"invx083", "111011111", "100000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx084 invert 111101111   ->      10000
+	{"invx084", "111101111", "10000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx085 invert 111110111   ->       1000
+	{"invx085", "111110111", "1000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx086 invert 111111011   ->        100
+	{"invx086", "111111011", "100", 0, 9, ToNearestAway, 999, -999, false},
+	// invx087 invert 111111101   ->         10
+	{"invx087", "111111101", "10", 0, 9, ToNearestAway, 999, -999, false},
+	// invx088 invert 111111110   ->          1
+	{"invx088", "111111110", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// invx089 invert 011111011   ->  100000100
+	{"invx089", "011111011", "100000100", 0, 9, ToNearestAway, 999, -999, false},
+	// invx090 invert 101111101   ->   10000010
+	{"invx090", "101111101", "10000010", 0, 9, ToNearestAway, 999, -999, false},
+	// invx091 invert 110111110   ->    1000001
+	{"invx091", "110111110", "1000001", 0, 9, ToNearestAway, 999, -999, false},
+	// invx092 invert 111011101   ->     100010
+	{"invx092", "111011101", "100010", 0, 9, ToNearestAway, 999, -999, false},
+	// invx093 invert 111101011   ->      10100
+	{"invx093", "111101011", "10100", 0, 9, ToNearestAway, 999, -999, false},
+	// invx094 invert 111110111   ->       1000
+	{"invx094", "111110111", "1000", 0, 9, ToNearestAway, 999, -999, false},
+	// invx095 invert 111101011   ->      10100
+	{"invx095", "111101011", "10100", 0, 9, ToNearestAway, 999, -999, false},
+	// invx096 invert 111011101   ->     100010
+	{"invx096", "111011101", "100010", 0, 9, ToNearestAway, 999, -999, false},
+	// invx097 invert 110111110   ->    1000001
+	{"invx097", "110111110", "1000001", 0, 9, ToNearestAway, 999, -999, false},
+	// invx098 invert 101111101   ->   10000010
+	{"invx098", "101111101", "10000010", 0, 9, ToNearestAway, 999, -999, false},
+	// invx099 invert 011111011   ->  100000100
+	{"invx099", "011111011", "100000100", 0, 9, ToNearestAway, 999, -999, false},
+	// non-0/1 should not be accepted, nor should signs
+	// invx220 invert 111111112   ->  NaN Invalid_operation
+	{"invx220", "111111112", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx221 invert 333333333   ->  NaN Invalid_operation
+	{"invx221", "333333333", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx222 invert 555555555   ->  NaN Invalid_operation
+	{"invx222", "555555555", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx223 invert 777777777   ->  NaN Invalid_operation
+	{"invx223", "777777777", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx224 invert 999999999   ->  NaN Invalid_operation
+	{"invx224", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx225 invert 222222222   ->  NaN Invalid_operation
+	{"invx225", "222222222", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx226 invert 444444444   ->  NaN Invalid_operation
+	{"invx226", "444444444", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx227 invert 666666666   ->  NaN Invalid_operation
+	{"invx227", "666666666", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx228 invert 888888888   ->  NaN Invalid_operation
+	{"invx228", "888888888", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx229 invert 999999999   ->  NaN Invalid_operation
+	{"invx229", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx230 invert 999999999   ->  NaN Invalid_operation
+	{"invx230", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx231 invert 999999999   ->  NaN Invalid_operation
+	{"invx231", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx232 invert 999999999   ->  NaN Invalid_operation
+	{"invx232", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// a few randoms
+	// invx240 invert  567468689  ->  NaN Invalid_operation
+	{"invx240", "567468689", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx241 invert  567367689  ->  NaN Invalid_operation
+	{"invx241", "567367689", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx242 invert -631917772  ->  NaN Invalid_operation
+	{"invx242", "-631917772", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx243 invert -756253257  ->  NaN Invalid_operation
+	{"invx243", "-756253257", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx244 invert  835590149  ->  NaN Invalid_operation
+	{"invx244", "835590149", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD
+	// invx250 invert  200000000  ->  NaN Invalid_operation
+	{"invx250", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx251 invert  300000000  ->  NaN Invalid_operation
+	{"invx251", "300000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx252 invert  400000000  ->  NaN Invalid_operation
+	{"invx252", "400000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx253 invert  500000000  ->  NaN Invalid_operation
+	{"invx253", "500000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx254 invert  600000000  ->  NaN Invalid_operation
+	{"invx254", "600000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx255 invert  700000000  ->  NaN Invalid_operation
+	{"invx255", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx256 invert  800000000  ->  NaN Invalid_operation
+	{"invx256", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx257 invert  900000000  ->  NaN Invalid_operation
+	{"invx257", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD-1
+	// invx270 invert  021000000  ->  NaN Invalid_operation
+	{"invx270", "021000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx271 invert  030100000  ->  NaN Invalid_operation
+	{"invx271", "030100000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx272 invert  040010000  ->  NaN Invalid_operation
+	{"invx272", "040010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx273 invert  050001000  ->  NaN Invalid_operation
+	{"invx273", "050001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx274 invert  160000100  ->  NaN Invalid_operation
+	{"invx274", "160000100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx275 invert  170000010  ->  NaN Invalid_operation
+	{"invx275", "170000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx276 invert  180000000  ->  NaN Invalid_operation
+	{"invx276", "180000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx277 invert  190000000  ->  NaN Invalid_operation
+	{"invx277", "190000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test LSD
+	// invx280 invert  000000002  ->  NaN Invalid_operation
+	{"invx280", "000000002", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx281 invert  000000003  ->  NaN Invalid_operation
+	{"invx281", "000000003", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx282 invert  000000004  ->  NaN Invalid_operation
+	{"invx282", "000000004", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx283 invert  000000005  ->  NaN Invalid_operation
+	{"invx283", "000000005", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx284 invert  101000006  ->  NaN Invalid_operation
+	{"invx284", "101000006", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx285 invert  100100007  ->  NaN Invalid_operation
+	{"invx285", "100100007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx286 invert  100010008  ->  NaN Invalid_operation
+	{"invx286", "100010008", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx287 invert  100001009  ->  NaN Invalid_operation
+	{"invx287", "100001009", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test Middie
+	// invx288 invert  000020000  ->  NaN Invalid_operation
+	{"invx288", "000020000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx289 invert  000030001  ->  NaN Invalid_operation
+	{"invx289", "000030001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx290 invert  000040000  ->  NaN Invalid_operation
+	{"invx290", "000040000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx291 invert  000050000  ->  NaN Invalid_operation
+	{"invx291", "000050000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx292 invert  101060000  ->  NaN Invalid_operation
+	{"invx292", "101060000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx293 invert  100170010  ->  NaN Invalid_operation
+	{"invx293", "100170010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx294 invert  100080100  ->  NaN Invalid_operation
+	{"invx294", "100080100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx295 invert  100091000  ->  NaN Invalid_operation
+	{"invx295", "100091000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// signs
+	// invx296 invert -100001000  ->  NaN Invalid_operation
+	{"invx296", "-100001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx299 invert  100001000  ->  11110111
+	{"invx299", "100001000", "11110111", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// invx341 invert  9.99999999E+999   -> NaN Invalid_operation
+	{"invx341", "9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx342 invert  1E-999            -> NaN Invalid_operation
+	{"invx342", "1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx343 invert  1.00000000E-999   -> NaN Invalid_operation
+	{"invx343", "1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx344 invert  1E-1007           -> NaN Invalid_operation
+	{"invx344", "1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx345 invert  -1E-1007          -> NaN Invalid_operation
+	{"invx345", "-1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx346 invert  -1.00000000E-999  -> NaN Invalid_operation
+	{"invx346", "-1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx347 invert  -1E-999           -> NaN Invalid_operation
+	{"invx347", "-1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx348 invert  -9.99999999E+999  -> NaN Invalid_operation
+	{"invx348", "-9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// A few other non-integers
+	// invx361 invert  1.0               -> NaN Invalid_operation
+	{"invx361", "1.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx362 invert  1E+1              -> NaN Invalid_operation
+	{"invx362", "1E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx363 invert  0.0               -> NaN Invalid_operation
+	{"invx363", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx364 invert  0E+1              -> NaN Invalid_operation
+	{"invx364", "0E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx365 invert  9.9               -> NaN Invalid_operation
+	{"invx365", "9.9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx366 invert  9E+1              -> NaN Invalid_operation
+	{"invx366", "9E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// All Specials are in error
+	// invx788 invert -Inf     -> NaN  Invalid_operation
+	{"invx788", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx794 invert  Inf     -> NaN  Invalid_operation
+	{"invx794", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx821 invert  NaN     -> NaN  Invalid_operation
+	{"invx821", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx841 invert  sNaN    -> NaN  Invalid_operation
+	{"invx841", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// invx861 invert  NaN1    -> NaN Invalid_operation
+	{"invx861", "NaN1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx862 invert +NaN2    -> NaN Invalid_operation
+	{"invx862", "+NaN2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx863 invert  NaN3    -> NaN Invalid_operation
+	{"invx863", "NaN3", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx864 invert  NaN4    -> NaN Invalid_operation
+	{"invx864", "NaN4", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx865 invert  NaN5    -> NaN Invalid_operation
+	{"invx865", "NaN5", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx871 invert  sNaN11  -> NaN Invalid_operation
+	{"invx871", "sNaN11", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx872 invert  sNaN12  -> NaN Invalid_operation
+	{"invx872", "sNaN12", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx873 invert  sNaN13  -> NaN Invalid_operation
+	{"invx873", "sNaN13", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx874 invert  sNaN14  -> NaN Invalid_operation
+	{"invx874", "sNaN14", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx875 invert  sNaN15  -> NaN Invalid_operation
+	{"invx875", "sNaN15", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx876 invert  NaN16   -> NaN Invalid_operation
+	{"invx876", "NaN16", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx881 invert +NaN25   -> NaN Invalid_operation
+	{"invx881", "+NaN25", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx882 invert -NaN26   -> NaN Invalid_operation
+	{"invx882", "-NaN26", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// invx883 invert -sNaN27  -> NaN Invalid_operation
+	{"invx883", "-sNaN27", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+}
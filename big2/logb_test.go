@@ -0,0 +1,296 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var logbTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// This emphasises the testing of notable cases, as they will often
+	// have unusual paths (especially the 10**n results).
+	// extended: 1
+	// rounding: half_even
+	// maxexponent: 999
+	// minexponent: -999
+	// basics & examples
+	// precision: 9
+	// logbx001 logb  0                 -> -Infinity  Division_by_zero
+	{"logbx001", "0", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx002 logb  1E-999            -> -999
+	{"logbx002", "1E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx003 logb  9E-999            -> -999
+	{"logbx003", "9E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx004 logb  0.001             -> -3
+	{"logbx004", "0.001", "-3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx005 logb  0.03              -> -2
+	{"logbx005", "0.03", "-2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx006 logb  1                 ->  0
+	{"logbx006", "1", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx007 logb  2                 ->  0
+	{"logbx007", "2", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx008 logb  2.5               ->  0
+	{"logbx008", "2.5", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx009 logb  2.50              ->  0
+	{"logbx009", "2.50", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx010 logb  10                ->  1
+	{"logbx010", "10", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx011 logb  70                ->  1
+	{"logbx011", "70", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx012 logb  100               ->  2
+	{"logbx012", "100", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx013 logb  250               ->  2
+	{"logbx013", "250", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx014 logb +Infinity          ->  Infinity
+	{"logbx014", "Inf", "Inf", 0, 9, ToNearestEven, 999, -999, false},
+	// negatives are treated as positives
+	// logbx021 logb -0                 -> -Infinity  Division_by_zero
+	{"logbx021", "-0", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx022 logb -1E-999            -> -999
+	{"logbx022", "-1E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx023 logb -9E-999            -> -999
+	{"logbx023", "-9E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx024 logb -0.001             -> -3
+	{"logbx024", "-0.001", "-3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx025 logb -1                 ->  0
+	{"logbx025", "-1", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx026 logb -2                 ->  0
+	{"logbx026", "-2", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx027 logb -10                ->  1
+	{"logbx027", "-10", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx028 logb -70                ->  1
+	{"logbx028", "-70", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx029 logb -100               ->  2
+	{"logbx029", "-100", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx030 logb -100000000         ->  8
+	{"logbx030", "-100000000", "8", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx031 logb -Infinity          ->  Infinity
+	{"logbx031", "-Inf", "Inf", 0, 9, ToNearestEven, 999, -999, false},
+	// zeros
+	// logbx111 logb          0   -> -Infinity  Division_by_zero
+	{"logbx111", "0", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx112 logb         -0   -> -Infinity  Division_by_zero
+	{"logbx112", "-0", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx113 logb       0E+4   -> -Infinity  Division_by_zero
+	{"logbx113", "0E+4", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx114 logb      -0E+4   -> -Infinity  Division_by_zero
+	{"logbx114", "-0E+4", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx115 logb     0.0000   -> -Infinity  Division_by_zero
+	{"logbx115", "0.0000", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx116 logb    -0.0000   -> -Infinity  Division_by_zero
+	{"logbx116", "-0.0000", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx117 logb      0E-141  -> -Infinity  Division_by_zero
+	{"logbx117", "0E-141", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx118 logb     -0E-141  -> -Infinity  Division_by_zero
+	{"logbx118", "-0E-141", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// full coefficients, alternating bits
+	// logbx121 logb   268268268        -> 8
+	{"logbx121", "268268268", "8", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx122 logb  -268268268        -> 8
+	{"logbx122", "-268268268", "8", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx123 logb   134134134        -> 8
+	{"logbx123", "134134134", "8", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx124 logb  -134134134        -> 8
+	{"logbx124", "-134134134", "8", 0, 9, ToNearestEven, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// logbx131 logb  9.99999999E+999   -> 999
+	{"logbx131", "9.99999999E+999", "999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx132 logb  1E-999            -> -999
+	{"logbx132", "1E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx133 logb  1.00000000E-999   -> -999
+	{"logbx133", "1.00000000E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx134 logb  1E-1007           -> -1007
+	{"logbx134", "1E-1007", "-1007", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx135 logb  -1E-1007          -> -1007
+	{"logbx135", "-1E-1007", "-1007", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx136 logb  -1.00000000E-999  -> -999
+	{"logbx136", "-1.00000000E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx137 logb  -1E-999           -> -999
+	{"logbx137", "-1E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx138 logb  -9.99999999E+999  ->  999
+	{"logbx138", "-9.99999999E+999", "999", 0, 9, ToNearestEven, 999, -999, false},
+	// ones
+	// logbx0061 logb  1                 ->   0
+	{"logbx0061", "1", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx0062 logb  1.0               ->   0
+	{"logbx0062", "1.0", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx0063 logb  1.000000000000000 ->   0
+	{"logbx0063", "1.000000000000000", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx0064 logb  1.000000000000000000 ->   0
+	{"logbx0064", "1.000000000000000000", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// notable cases -- exact powers of 10
+	// logbx1100 logb 1             -> 0
+	{"logbx1100", "1", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1101 logb 10            -> 1
+	{"logbx1101", "10", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1102 logb 100           -> 2
+	{"logbx1102", "100", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1103 logb 1000          -> 3
+	{"logbx1103", "1000", "3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1104 logb 10000         -> 4
+	{"logbx1104", "10000", "4", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1105 logb 100000        -> 5
+	{"logbx1105", "100000", "5", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1106 logb 1000000       -> 6
+	{"logbx1106", "1000000", "6", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1107 logb 10000000      -> 7
+	{"logbx1107", "10000000", "7", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1108 logb 100000000     -> 8
+	{"logbx1108", "100000000", "8", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1109 logb 1000000000    -> 9
+	{"logbx1109", "1000000000", "9", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1110 logb 10000000000   -> 10
+	{"logbx1110", "10000000000", "10", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1111 logb 100000000000  -> 11
+	{"logbx1111", "100000000000", "11", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1112 logb 1000000000000 -> 12
+	{"logbx1112", "1000000000000", "12", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1113 logb 0.00000000001 -> -11
+	{"logbx1113", "0.00000000001", "-11", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1114 logb 0.0000000001 -> -10
+	{"logbx1114", "0.0000000001", "-10", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1115 logb 0.000000001 -> -9
+	{"logbx1115", "0.000000001", "-9", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1116 logb 0.00000001 -> -8
+	{"logbx1116", "0.00000001", "-8", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1117 logb 0.0000001 -> -7
+	{"logbx1117", "0.0000001", "-7", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1118 logb 0.000001 -> -6
+	{"logbx1118", "0.000001", "-6", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1119 logb 0.00001 -> -5
+	{"logbx1119", "0.00001", "-5", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1120 logb 0.0001 -> -4
+	{"logbx1120", "0.0001", "-4", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1121 logb 0.001 -> -3
+	{"logbx1121", "0.001", "-3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1122 logb 0.01 -> -2
+	{"logbx1122", "0.01", "-2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1123 logb 0.1 -> -1
+	{"logbx1123", "0.1", "-1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1124 logb 1E-99  -> -99
+	{"logbx1124", "1E-99", "-99", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1125 logb 1E-100 -> -100
+	{"logbx1125", "1E-100", "-100", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1126 logb 1E-383 -> -383
+	{"logbx1126", "1E-383", "-383", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1127 logb 1E-999 -> -999
+	{"logbx1127", "1E-999", "-999", 0, 9, ToNearestEven, 999, -999, false},
+	// suggestions from Ilan Nehama
+	// logbx1400 logb 10E-3    -> -2
+	{"logbx1400", "10E-3", "-2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1401 logb 10E-2    -> -1
+	{"logbx1401", "10E-2", "-1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1402 logb 100E-2   ->  0
+	{"logbx1402", "100E-2", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1403 logb 1000E-2  ->  1
+	{"logbx1403", "1000E-2", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1404 logb 10000E-2 ->  2
+	{"logbx1404", "10000E-2", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1405 logb 10E-1    ->  0
+	{"logbx1405", "10E-1", "0", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1406 logb 100E-1   ->  1
+	{"logbx1406", "100E-1", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1407 logb 1000E-1  ->  2
+	{"logbx1407", "1000E-1", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1408 logb 10000E-1 ->  3
+	{"logbx1408", "10000E-1", "3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1409 logb 10E0     ->  1
+	{"logbx1409", "10E0", "1", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1410 logb 100E0    ->  2
+	{"logbx1410", "100E0", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1411 logb 1000E0   ->  3
+	{"logbx1411", "1000E0", "3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1412 logb 10000E0  ->  4
+	{"logbx1412", "10000E0", "4", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1413 logb 10E1     ->  2
+	{"logbx1413", "10E1", "2", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1414 logb 100E1    ->  3
+	{"logbx1414", "100E1", "3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1415 logb 1000E1   ->  4
+	{"logbx1415", "1000E1", "4", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1416 logb 10000E1  ->  5
+	{"logbx1416", "10000E1", "5", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1417 logb 10E2     ->  3
+	{"logbx1417", "10E2", "3", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1418 logb 100E2    ->  4
+	{"logbx1418", "100E2", "4", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1419 logb 1000E2   ->  5
+	{"logbx1419", "1000E2", "5", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1420 logb 10000E2  ->  6
+	{"logbx1420", "10000E2", "6", 0, 9, ToNearestEven, 999, -999, false},
+	// inexacts
+	// precision: 2
+	// logbx1500 logb 10000E2       ->  6
+	{"logbx1500", "10000E2", "6", 0, 2, ToNearestEven, 999, -999, false},
+	// logbx1501 logb 1E+99         ->  99
+	{"logbx1501", "1E+99", "99", 0, 2, ToNearestEven, 999, -999, false},
+	// logbx1502 logb 1E-99         -> -99
+	{"logbx1502", "1E-99", "-99", 0, 2, ToNearestEven, 999, -999, false},
+	// logbx1503 logb 1E+100        ->  1.0E+2  Rounded
+	{"logbx1503", "1E+100", "1.0E+2", Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1504 logb 1E+999        ->  1.0E+3  Inexact Rounded
+	{"logbx1504", "1E+999", "1.0E+3", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1505 logb 1E-100        -> -1.0E+2  Rounded
+	{"logbx1505", "1E-100", "-1.0E+2", Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1506 logb 1E-999        -> -1.0E+3  Inexact Rounded
+	{"logbx1506", "1E-999", "-1.0E+3", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1507 logb 1E-1111       -> -1.1E+3  Inexact Rounded
+	{"logbx1507", "1E-1111", "-1.1E+3", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1508 logb 1E-3333       -> -3.3E+3  Inexact Rounded
+	{"logbx1508", "1E-3333", "-3.3E+3", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1509 logb 1E-6666       -> -6.7E+3  Inexact Rounded
+	{"logbx1509", "1E-6666", "-6.7E+3", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1510 logb 1E+999999999  ->  1.0E+9  Inexact Rounded
+	{"logbx1510", "1E+999999999", "1.0E+9", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// logbx1511 logb 1E-999999999  -> -1.0E+9  Inexact Rounded
+	{"logbx1511", "1E-999999999", "-1.0E+9", Inexact | Rounded, 2, ToNearestEven, 999, -999, false},
+	// precision: 1
+	// logbx1517 logb 1E-1111       -> -1E+3    Inexact Rounded
+	{"logbx1517", "1E-1111", "-1E+3", Inexact | Rounded, 1, ToNearestEven, 999, -999, false},
+	// logbx1518 logb 1E-3333       -> -3E+3    Inexact Rounded
+	{"logbx1518", "1E-3333", "-3E+3", Inexact | Rounded, 1, ToNearestEven, 999, -999, false},
+	// logbx1519 logb 1E-6666       -> -7E+3    Inexact Rounded
+	{"logbx1519", "1E-6666", "-7E+3", Inexact | Rounded, 1, ToNearestEven, 999, -999, false},
+	// precision: 8
+	// logbx1520 logb 1E+999999999  ->  1.0000000E+9 Inexact Rounded
+	{"logbx1520", "1E+999999999", "1.0000000E+9", Inexact | Rounded, 8, ToNearestEven, 999, -999, false},
+	// logbx1521 logb 1E-999999999  -> -1.0000000E+9 Inexact Rounded
+	{"logbx1521", "1E-999999999", "-1.0000000E+9", Inexact | Rounded, 8, ToNearestEven, 999, -999, false},
+	// precision: 9
+	// logbx1523 logb 1E+999999999  ->  999999999
+	{"logbx1523", "1E+999999999", "999999999", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx1524 logb 1E-999999999  -> -999999999
+	{"logbx1524", "1E-999999999", "-999999999", 0, 9, ToNearestEven, 999, -999, false},
+	// special values
+	// precision: 9
+	// logbx820  logb   Infinity ->   Infinity
+	{"logbx820", "Inf", "Inf", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx821  logb  -Infinity ->   Infinity
+	{"logbx821", "-Inf", "Inf", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx822  logb   0        ->  -Infinity Division_by_zero
+	{"logbx822", "0", "-Inf", DivisionByZero, 9, ToNearestEven, 999, -999, false},
+	// logbx823  logb   NaN      ->   NaN
+	{"logbx823", "NaN", "NaN", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx824  logb   sNaN     ->   NaN     Invalid_operation
+	{"logbx824", "sNaN", "NaN", InvalidOperation, 9, ToNearestEven, 999, -999, false},
+	// propagating NaNs
+	// logbx825  logb   sNaN123  ->   NaN123  Invalid_operation
+	{"logbx825", "sNaN123", "NaN123", InvalidOperation, 9, ToNearestEven, 999, -999, false},
+	// logbx826  logb   -sNaN321 ->  -NaN321  Invalid_operation
+	{"logbx826", "-sNaN321", "-NaN321", InvalidOperation, 9, ToNearestEven, 999, -999, false},
+	// logbx827  logb   NaN456   ->   NaN456
+	{"logbx827", "NaN456", "NaN456", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx828  logb   -NaN654  ->  -NaN654
+	{"logbx828", "-NaN654", "-NaN654", 0, 9, ToNearestEven, 999, -999, false},
+	// logbx829  logb   NaN1     ->   NaN1
+	{"logbx829", "NaN1", "NaN1", 0, 9, ToNearestEven, 999, -999, false},
+	// Null test
+	// SKIP (encoding not supported): logbx900  logb #   -> NaN Invalid_operation
+}
@@ -0,0 +1,716 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var maxTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// we assume that base comparison is tested in compare.decTest, so
+	// these mainly cover special cases and rounding
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks
+	// maxx001 max  -2  -2  -> -2
+	{"maxx001", "-2", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx002 max  -2  -1  -> -1
+	{"maxx002", "-2", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx003 max  -2   0  ->  0
+	{"maxx003", "-2", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx004 max  -2   1  ->  1
+	{"maxx004", "-2", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx005 max  -2   2  ->  2
+	{"maxx005", "-2", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx006 max  -1  -2  -> -1
+	{"maxx006", "-1", "-2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx007 max  -1  -1  -> -1
+	{"maxx007", "-1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx008 max  -1   0  ->  0
+	{"maxx008", "-1", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx009 max  -1   1  ->  1
+	{"maxx009", "-1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx010 max  -1   2  ->  2
+	{"maxx010", "-1", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx011 max   0  -2  ->  0
+	{"maxx011", "0", "-2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx012 max   0  -1  ->  0
+	{"maxx012", "0", "-1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx013 max   0   0  ->  0
+	{"maxx013", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx014 max   0   1  ->  1
+	{"maxx014", "0", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx015 max   0   2  ->  2
+	{"maxx015", "0", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx016 max   1  -2  ->  1
+	{"maxx016", "1", "-2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx017 max   1  -1  ->  1
+	{"maxx017", "1", "-1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx018 max   1   0  ->  1
+	{"maxx018", "1", "0", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx019 max   1   1  ->  1
+	{"maxx019", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx020 max   1   2  ->  2
+	{"maxx020", "1", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx021 max   2  -2  ->  2
+	{"maxx021", "2", "-2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx022 max   2  -1  ->  2
+	{"maxx022", "2", "-1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx023 max   2   0  ->  2
+	{"maxx023", "2", "0", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx025 max   2   1  ->  2
+	{"maxx025", "2", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx026 max   2   2  ->  2
+	{"maxx026", "2", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// extended zeros
+	// maxx030 max   0     0   ->  0
+	{"maxx030", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx031 max   0    -0   ->  0
+	{"maxx031", "0", "-0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx032 max   0    -0.0 ->  0
+	{"maxx032", "0", "-0.0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx033 max   0     0.0 ->  0
+	{"maxx033", "0", "0.0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx034 max  -0     0   ->  0    -- note: -0 = 0, but 0 chosen
+	// maxx035 max  -0    -0   -> -0
+	{"maxx035", "-0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx036 max  -0    -0.0 -> -0.0
+	{"maxx036", "-0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx037 max  -0     0.0 ->  0.0
+	{"maxx037", "-0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx038 max   0.0   0   ->  0
+	{"maxx038", "0.0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx039 max   0.0  -0   ->  0.0
+	{"maxx039", "0.0", "-0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx040 max   0.0  -0.0 ->  0.0
+	{"maxx040", "0.0", "-0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx041 max   0.0   0.0 ->  0.0
+	{"maxx041", "0.0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx042 max  -0.0   0   ->  0
+	{"maxx042", "-0.0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx043 max  -0.0  -0   -> -0.0
+	{"maxx043", "-0.0", "-0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx044 max  -0.0  -0.0 -> -0.0
+	{"maxx044", "-0.0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx045 max  -0.0   0.0 ->  0.0
+	{"maxx045", "-0.0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx050 max  -0E1   0E1 ->  0E+1
+	{"maxx050", "-0E1", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx051 max  -0E2   0E2 ->  0E+2
+	{"maxx051", "-0E2", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx052 max  -0E2   0E1 ->  0E+1
+	{"maxx052", "-0E2", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx053 max  -0E1   0E2 ->  0E+2
+	{"maxx053", "-0E1", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx054 max   0E1  -0E1 ->  0E+1
+	{"maxx054", "0E1", "-0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx055 max   0E2  -0E2 ->  0E+2
+	{"maxx055", "0E2", "-0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx056 max   0E2  -0E1 ->  0E+2
+	{"maxx056", "0E2", "-0E1", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx057 max   0E1  -0E2 ->  0E+1
+	{"maxx057", "0E1", "-0E2", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx058 max   0E1   0E1 ->  0E+1
+	{"maxx058", "0E1", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx059 max   0E2   0E2 ->  0E+2
+	{"maxx059", "0E2", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx060 max   0E2   0E1 ->  0E+2
+	{"maxx060", "0E2", "0E1", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx061 max   0E1   0E2 ->  0E+2
+	{"maxx061", "0E1", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx062 max  -0E1  -0E1 -> -0E+1
+	{"maxx062", "-0E1", "-0E1", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx063 max  -0E2  -0E2 -> -0E+2
+	{"maxx063", "-0E2", "-0E2", "-0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx064 max  -0E2  -0E1 -> -0E+1
+	{"maxx064", "-0E2", "-0E1", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx065 max  -0E1  -0E2 -> -0E+1
+	{"maxx065", "-0E1", "-0E2", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// Specials
+	// precision: 9
+	// maxx090 max  Inf  -Inf   ->  Infinity
+	{"maxx090", "Inf", "-Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx091 max  Inf  -1000  ->  Infinity
+	{"maxx091", "Inf", "-1000", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx092 max  Inf  -1     ->  Infinity
+	{"maxx092", "Inf", "-1", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx093 max  Inf  -0     ->  Infinity
+	{"maxx093", "Inf", "-0", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx094 max  Inf   0     ->  Infinity
+	{"maxx094", "Inf", "0", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx095 max  Inf   1     ->  Infinity
+	{"maxx095", "Inf", "1", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx096 max  Inf   1000  ->  Infinity
+	{"maxx096", "Inf", "1000", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx097 max  Inf   Inf   ->  Infinity
+	{"maxx097", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx098 max -1000  Inf   ->  Infinity
+	{"maxx098", "-1000", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx099 max -Inf   Inf   ->  Infinity
+	{"maxx099", "-Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx100 max -1     Inf   ->  Infinity
+	{"maxx100", "-1", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx101 max -0     Inf   ->  Infinity
+	{"maxx101", "-0", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx102 max  0     Inf   ->  Infinity
+	{"maxx102", "0", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx103 max  1     Inf   ->  Infinity
+	{"maxx103", "1", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx104 max  1000  Inf   ->  Infinity
+	{"maxx104", "1000", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx105 max  Inf   Inf   ->  Infinity
+	{"maxx105", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx120 max -Inf  -Inf   -> -Infinity
+	{"maxx120", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx121 max -Inf  -1000  -> -1000
+	{"maxx121", "-Inf", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx122 max -Inf  -1     -> -1
+	{"maxx122", "-Inf", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx123 max -Inf  -0     -> -0
+	{"maxx123", "-Inf", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx124 max -Inf   0     ->  0
+	{"maxx124", "-Inf", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx125 max -Inf   1     ->  1
+	{"maxx125", "-Inf", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx126 max -Inf   1000  ->  1000
+	{"maxx126", "-Inf", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx127 max -Inf   Inf   ->  Infinity
+	{"maxx127", "-Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx128 max -Inf  -Inf   ->  -Infinity
+	{"maxx128", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx129 max -1000 -Inf   ->  -1000
+	{"maxx129", "-1000", "-Inf", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx130 max -1    -Inf   ->  -1
+	{"maxx130", "-1", "-Inf", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx131 max -0    -Inf   ->  -0
+	{"maxx131", "-0", "-Inf", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx132 max  0    -Inf   ->  0
+	{"maxx132", "0", "-Inf", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx133 max  1    -Inf   ->  1
+	{"maxx133", "1", "-Inf", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx134 max  1000 -Inf   ->  1000
+	{"maxx134", "1000", "-Inf", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx135 max  Inf  -Inf   ->  Infinity
+	{"maxx135", "Inf", "-Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// 2004.08.02 754r chooses number over NaN in mixed cases
+	// maxx141 max  NaN -Inf    -> -Infinity
+	{"maxx141", "NaN", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx142 max  NaN -1000   -> -1000
+	{"maxx142", "NaN", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx143 max  NaN -1      -> -1
+	{"maxx143", "NaN", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx144 max  NaN -0      -> -0
+	{"maxx144", "NaN", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx145 max  NaN  0      ->  0
+	{"maxx145", "NaN", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx146 max  NaN  1      ->  1
+	{"maxx146", "NaN", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx147 max  NaN  1000   ->  1000
+	{"maxx147", "NaN", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx148 max  NaN  Inf    ->  Infinity
+	{"maxx148", "NaN", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx149 max  NaN  NaN    ->  NaN
+	{"maxx149", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx150 max -Inf  NaN    -> -Infinity
+	{"maxx150", "-Inf", "NaN", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx151 max -1000 NaN    -> -1000
+	{"maxx151", "-1000", "NaN", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx152 max -1    NaN    -> -1
+	{"maxx152", "-1", "NaN", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx153 max -0    NaN    -> -0
+	{"maxx153", "-0", "NaN", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx154 max  0    NaN    ->  0
+	{"maxx154", "0", "NaN", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx155 max  1    NaN    ->  1
+	{"maxx155", "1", "NaN", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx156 max  1000 NaN    ->  1000
+	{"maxx156", "1000", "NaN", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx157 max  Inf  NaN    ->  Infinity
+	{"maxx157", "Inf", "NaN", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx161 max  sNaN -Inf   ->  NaN  Invalid_operation
+	{"maxx161", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx162 max  sNaN -1000  ->  NaN  Invalid_operation
+	{"maxx162", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx163 max  sNaN -1     ->  NaN  Invalid_operation
+	{"maxx163", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx164 max  sNaN -0     ->  NaN  Invalid_operation
+	{"maxx164", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx165 max  sNaN  0     ->  NaN  Invalid_operation
+	{"maxx165", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx166 max  sNaN  1     ->  NaN  Invalid_operation
+	{"maxx166", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx167 max  sNaN  1000  ->  NaN  Invalid_operation
+	{"maxx167", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx168 max  sNaN  NaN   ->  NaN  Invalid_operation
+	{"maxx168", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx169 max  sNaN sNaN   ->  NaN  Invalid_operation
+	{"maxx169", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx170 max  NaN  sNaN   ->  NaN  Invalid_operation
+	{"maxx170", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx171 max -Inf  sNaN   ->  NaN  Invalid_operation
+	{"maxx171", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx172 max -1000 sNaN   ->  NaN  Invalid_operation
+	{"maxx172", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx173 max -1    sNaN   ->  NaN  Invalid_operation
+	{"maxx173", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx174 max -0    sNaN   ->  NaN  Invalid_operation
+	{"maxx174", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx175 max  0    sNaN   ->  NaN  Invalid_operation
+	{"maxx175", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx176 max  1    sNaN   ->  NaN  Invalid_operation
+	{"maxx176", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx177 max  1000 sNaN   ->  NaN  Invalid_operation
+	{"maxx177", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx178 max  Inf  sNaN   ->  NaN  Invalid_operation
+	{"maxx178", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx179 max  NaN  sNaN   ->  NaN  Invalid_operation
+	{"maxx179", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// maxx181 max  NaN9  -Inf   -> -Infinity
+	{"maxx181", "NaN9", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx182 max  NaN8     9   ->  9
+	{"maxx182", "NaN8", "9", "9", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx183 max -NaN7   Inf   ->  Infinity
+	{"maxx183", "-NaN7", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx184 max -NaN1   NaN11 -> -NaN1
+	{"maxx184", "-NaN1", "NaN11", "-NaN1", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx185 max  NaN2   NaN12 ->  NaN2
+	{"maxx185", "NaN2", "NaN12", "NaN2", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx186 max -NaN13 -NaN7  -> -NaN13
+	{"maxx186", "-NaN13", "-NaN7", "-NaN13", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx187 max  NaN14 -NaN5  ->  NaN14
+	{"maxx187", "NaN14", "-NaN5", "NaN14", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx188 max -Inf    NaN4  -> -Infinity
+	{"maxx188", "-Inf", "NaN4", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx189 max -9     -NaN3  -> -9
+	{"maxx189", "-9", "-NaN3", "-9", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx190 max  Inf    NaN2  ->  Infinity
+	{"maxx190", "Inf", "NaN2", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// maxx191 max  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"maxx191", "sNaN99", "-Inf", "NaN99", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx192 max  sNaN98 -1      ->  NaN98 Invalid_operation
+	{"maxx192", "sNaN98", "-1", "NaN98", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx193 max -sNaN97  NaN    -> -NaN97 Invalid_operation
+	{"maxx193", "-sNaN97", "NaN", "-NaN97", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx194 max  sNaN96 sNaN94  ->  NaN96 Invalid_operation
+	{"maxx194", "sNaN96", "sNaN94", "NaN96", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx195 max  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"maxx195", "NaN95", "sNaN93", "NaN93", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx196 max -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"maxx196", "-Inf", "sNaN92", "NaN92", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx197 max  0      sNaN91  ->  NaN91 Invalid_operation
+	{"maxx197", "0", "sNaN91", "NaN91", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx198 max  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	{"maxx198", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// maxx199 max  NaN    sNaN89  ->  NaN89 Invalid_operation
+	{"maxx199", "NaN", "sNaN89", "NaN89", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// rounding checks
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// maxx201 max 12345678000 1  -> 1.23456780E+10 Rounded
+	{"maxx201", "12345678000", "1", "1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx202 max 1 12345678000  -> 1.23456780E+10 Rounded
+	{"maxx202", "1", "12345678000", "1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx203 max 1234567800  1  -> 1.23456780E+9 Rounded
+	{"maxx203", "1234567800", "1", "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx204 max 1 1234567800   -> 1.23456780E+9 Rounded
+	{"maxx204", "1", "1234567800", "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx205 max 1234567890  1  -> 1.23456789E+9 Rounded
+	{"maxx205", "1234567890", "1", "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx206 max 1 1234567890   -> 1.23456789E+9 Rounded
+	{"maxx206", "1", "1234567890", "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx207 max 1234567891  1  -> 1.23456789E+9 Inexact Rounded
+	{"maxx207", "1234567891", "1", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx208 max 1 1234567891   -> 1.23456789E+9 Inexact Rounded
+	{"maxx208", "1", "1234567891", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx209 max 12345678901 1  -> 1.23456789E+10 Inexact Rounded
+	{"maxx209", "12345678901", "1", "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx210 max 1 12345678901  -> 1.23456789E+10 Inexact Rounded
+	{"maxx210", "1", "12345678901", "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx211 max 1234567896  1  -> 1.23456790E+9 Inexact Rounded
+	{"maxx211", "1234567896", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx212 max 1 1234567896   -> 1.23456790E+9 Inexact Rounded
+	{"maxx212", "1", "1234567896", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// maxx213 max -1234567891  1 -> 1
+	{"maxx213", "-1234567891", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx214 max 1 -1234567891  -> 1
+	{"maxx214", "1", "-1234567891", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx215 max -12345678901 1 -> 1
+	{"maxx215", "-12345678901", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx216 max 1 -12345678901 -> 1
+	{"maxx216", "1", "-12345678901", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx217 max -1234567896  1 -> 1
+	{"maxx217", "-1234567896", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx218 max 1 -1234567896  -> 1
+	{"maxx218", "1", "-1234567896", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// maxx221 max 12345678000 1  -> 12345678000
+	{"maxx221", "12345678000", "1", "12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx222 max 1 12345678000  -> 12345678000
+	{"maxx222", "1", "12345678000", "12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx223 max 1234567800  1  -> 1234567800
+	{"maxx223", "1234567800", "1", "1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx224 max 1 1234567800   -> 1234567800
+	{"maxx224", "1", "1234567800", "1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx225 max 1234567890  1  -> 1234567890
+	{"maxx225", "1234567890", "1", "1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx226 max 1 1234567890   -> 1234567890
+	{"maxx226", "1", "1234567890", "1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx227 max 1234567891  1  -> 1234567891
+	{"maxx227", "1234567891", "1", "1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx228 max 1 1234567891   -> 1234567891
+	{"maxx228", "1", "1234567891", "1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx229 max 12345678901 1  -> 12345678901
+	{"maxx229", "12345678901", "1", "12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx230 max 1 12345678901  -> 12345678901
+	{"maxx230", "1", "12345678901", "12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx231 max 1234567896  1  -> 1234567896
+	{"maxx231", "1234567896", "1", "1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx232 max 1 1234567896   -> 1234567896
+	{"maxx232", "1", "1234567896", "1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx233 max -1234567891  1 -> 1
+	{"maxx233", "-1234567891", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx234 max 1 -1234567891  -> 1
+	{"maxx234", "1", "-1234567891", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx235 max -12345678901 1 -> 1
+	{"maxx235", "-12345678901", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx236 max 1 -12345678901 -> 1
+	{"maxx236", "1", "-12345678901", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx237 max -1234567896  1 -> 1
+	{"maxx237", "-1234567896", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx238 max 1 -1234567896  -> 1
+	{"maxx238", "1", "-1234567896", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// from examples
+	// maxx280 max '3'   '2'  ->  '3'
+	{"maxx280", "3", "2", "3", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx281 max '-10' '3'  ->  '3'
+	{"maxx281", "-10", "3", "3", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx282 max '1.0' '1'  ->  '1'
+	{"maxx282", "1.0", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx283 max '1' '1.0'  ->  '1'
+	{"maxx283", "1", "1.0", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// maxx284 max '7' 'NaN'  ->  '7'
+	{"maxx284", "7", "NaN", "7", 0, 15, ToNearestAway, 999, -999, false},
+	// overflow and underflow tests ...
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// maxx330 max +1.23456789012345E-0 9E+999999999 ->  9E+999999999
+	{"maxx330", "+1.23456789012345E-0", "9E+999999999", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx331 max 9E+999999999 +1.23456789012345E-0 ->  9E+999999999
+	{"maxx331", "9E+999999999", "+1.23456789012345E-0", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx332 max +0.100 9E-999999999               ->  0.100
+	{"maxx332", "+0.100", "9E-999999999", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx333 max 9E-999999999 +0.100               ->  0.100
+	{"maxx333", "9E-999999999", "+0.100", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx335 max -1.23456789012345E-0 9E+999999999 ->  9E+999999999
+	{"maxx335", "-1.23456789012345E-0", "9E+999999999", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx336 max 9E+999999999 -1.23456789012345E-0 ->  9E+999999999
+	{"maxx336", "9E+999999999", "-1.23456789012345E-0", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx337 max -0.100 9E-999999999               ->  9E-999999999
+	{"maxx337", "-0.100", "9E-999999999", "9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx338 max 9E-999999999 -0.100               ->  9E-999999999
+	{"maxx338", "9E-999999999", "-0.100", "9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx339 max 1e-599999999 1e-400000001   ->  1E-400000001
+	{"maxx339", "1e-599999999", "1e-400000001", "1E-400000001", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx340 max 1e-599999999 1e-400000000   ->  1E-400000000
+	{"maxx340", "1e-599999999", "1e-400000000", "1E-400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx341 max 1e-600000000 1e-400000000   ->  1E-400000000
+	{"maxx341", "1e-600000000", "1e-400000000", "1E-400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx342 max 9e-999999998 0.01           ->  0.01
+	{"maxx342", "9e-999999998", "0.01", "0.01", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx343 max 9e-999999998 0.1            ->  0.1
+	{"maxx343", "9e-999999998", "0.1", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx344 max 0.01 9e-999999998           ->  0.01
+	{"maxx344", "0.01", "9e-999999998", "0.01", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx345 max 1e599999999 1e400000001     ->  1E+599999999
+	{"maxx345", "1e599999999", "1e400000001", "1E+599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx346 max 1e599999999 1e400000000     ->  1E+599999999
+	{"maxx346", "1e599999999", "1e400000000", "1E+599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx347 max 1e600000000 1e400000000     ->  1E+600000000
+	{"maxx347", "1e600000000", "1e400000000", "1E+600000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx348 max 9e999999998 100             ->  9E+999999998
+	{"maxx348", "9e999999998", "100", "9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx349 max 9e999999998 10              ->  9E+999999998
+	{"maxx349", "9e999999998", "10", "9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx350 max 100  9e999999998            ->  9E+999999998
+	{"maxx350", "100", "9e999999998", "9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// signs
+	// maxx351 max  1e+777777777  1e+411111111 ->  1E+777777777
+	{"maxx351", "1e+777777777", "1e+411111111", "1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx352 max  1e+777777777 -1e+411111111 ->  1E+777777777
+	{"maxx352", "1e+777777777", "-1e+411111111", "1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx353 max -1e+777777777  1e+411111111 ->  1E+411111111
+	{"maxx353", "-1e+777777777", "1e+411111111", "1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx354 max -1e+777777777 -1e+411111111 -> -1E+411111111
+	{"maxx354", "-1e+777777777", "-1e+411111111", "-1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx355 max  1e-777777777  1e-411111111 ->  1E-411111111
+	{"maxx355", "1e-777777777", "1e-411111111", "1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx356 max  1e-777777777 -1e-411111111 ->  1E-777777777
+	{"maxx356", "1e-777777777", "-1e-411111111", "1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx357 max -1e-777777777  1e-411111111 ->  1E-411111111
+	{"maxx357", "-1e-777777777", "1e-411111111", "1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx358 max -1e-777777777 -1e-411111111 -> -1E-777777777
+	{"maxx358", "-1e-777777777", "-1e-411111111", "-1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// expanded list from min/max 754r purple prose
+	// [explicit tests for exponent ordering]
+	// maxx401 max  Inf    1.1     ->  Infinity
+	{"maxx401", "Inf", "1.1", "Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx402 max  1.1    1       ->  1.1
+	{"maxx402", "1.1", "1", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx403 max  1      1.0     ->  1
+	{"maxx403", "1", "1.0", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx404 max  1.0    0.1     ->  1.0
+	{"maxx404", "1.0", "0.1", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx405 max  0.1    0.10    ->  0.1
+	{"maxx405", "0.1", "0.10", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx406 max  0.10   0.100   ->  0.10
+	{"maxx406", "0.10", "0.100", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx407 max  0.10   0       ->  0.10
+	{"maxx407", "0.10", "0", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx408 max  0      0.0     ->  0
+	{"maxx408", "0", "0.0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx409 max  0.0   -0       ->  0.0
+	{"maxx409", "0.0", "-0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx410 max  0.0   -0.0     ->  0.0
+	{"maxx410", "0.0", "-0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx411 max  0.00  -0.0     ->  0.00
+	{"maxx411", "0.00", "-0.0", "0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx412 max  0.0   -0.00    ->  0.0
+	{"maxx412", "0.0", "-0.00", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx413 max  0     -0.0     ->  0
+	{"maxx413", "0", "-0.0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx414 max  0     -0       ->  0
+	{"maxx414", "0", "-0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx415 max -0.0   -0       -> -0.0
+	{"maxx415", "-0.0", "-0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx416 max -0     -0.100   -> -0
+	{"maxx416", "-0", "-0.100", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx417 max -0.100 -0.10    -> -0.100
+	{"maxx417", "-0.100", "-0.10", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx418 max -0.10  -0.1     -> -0.10
+	{"maxx418", "-0.10", "-0.1", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx419 max -0.1   -1.0     -> -0.1
+	{"maxx419", "-0.1", "-1.0", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx420 max -1.0   -1       -> -1.0
+	{"maxx420", "-1.0", "-1", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx421 max -1     -1.1     -> -1
+	{"maxx421", "-1", "-1.1", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx423 max -1.1   -Inf     -> -1.1
+	{"maxx423", "-1.1", "-Inf", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// same with operands reversed
+	// maxx431 max  1.1    Inf     ->  Infinity
+	{"maxx431", "1.1", "Inf", "Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx432 max  1      1.1     ->  1.1
+	{"maxx432", "1", "1.1", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx433 max  1.0    1       ->  1
+	{"maxx433", "1.0", "1", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx434 max  0.1    1.0     ->  1.0
+	{"maxx434", "0.1", "1.0", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx435 max  0.10   0.1     ->  0.1
+	{"maxx435", "0.10", "0.1", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx436 max  0.100  0.10    ->  0.10
+	{"maxx436", "0.100", "0.10", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx437 max  0      0.10    ->  0.10
+	{"maxx437", "0", "0.10", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx438 max  0.0    0       ->  0
+	{"maxx438", "0.0", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx439 max -0      0.0     ->  0.0
+	{"maxx439", "-0", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx440 max -0.0    0.0     ->  0.0
+	{"maxx440", "-0.0", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx441 max -0.0    0.00    ->  0.00
+	{"maxx441", "-0.0", "0.00", "0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx442 max -0.00   0.0     ->  0.0
+	{"maxx442", "-0.00", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx443 max -0.0    0       ->  0
+	{"maxx443", "-0.0", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx444 max -0      0       ->  0
+	{"maxx444", "-0", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx445 max -0     -0.0     -> -0.0
+	{"maxx445", "-0", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx446 max -0.100 -0       -> -0
+	{"maxx446", "-0.100", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx447 max -0.10  -0.100   -> -0.100
+	{"maxx447", "-0.10", "-0.100", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx448 max -0.1   -0.10    -> -0.10
+	{"maxx448", "-0.1", "-0.10", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx449 max -1.0   -0.1     -> -0.1
+	{"maxx449", "-1.0", "-0.1", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx450 max -1     -1.0     -> -1.0
+	{"maxx450", "-1", "-1.0", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx451 max -1.1   -1       -> -1
+	{"maxx451", "-1.1", "-1", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx453 max -Inf   -1.1     -> -1.1
+	{"maxx453", "-Inf", "-1.1", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// largies
+	// maxx460 max  1000   1E+3    ->  1E+3
+	{"maxx460", "1000", "1E+3", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx461 max  1E+3   1000    ->  1E+3
+	{"maxx461", "1E+3", "1000", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx462 max  1000  -1E+3    ->  1000
+	{"maxx462", "1000", "-1E+3", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx463 max  1E+3  -1000    ->  1E+3
+	{"maxx463", "1E+3", "-1000", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx464 max -1000   1E+3    ->  1E+3
+	{"maxx464", "-1000", "1E+3", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx465 max -1E+3   1000    ->  1000
+	{"maxx465", "-1E+3", "1000", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx466 max -1000  -1E+3    -> -1000
+	{"maxx466", "-1000", "-1E+3", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxx467 max -1E+3  -1000    -> -1000
+	{"maxx467", "-1E+3", "-1000", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// rounding (results treated as though plus)
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// maxx470 max  1      .5     ->  1
+	{"maxx470", "1", ".5", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx471 max  10     5      ->  10
+	{"maxx471", "10", "5", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx472 max  100    50     ->  100
+	{"maxx472", "100", "50", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx473 max  1000   500    ->  1.00E+3 Rounded
+	{"maxx473", "1000", "500", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx474 max  10000  5000   ->  1.00E+4 Rounded
+	{"maxx474", "10000", "5000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx475 max  6      .5     ->  6
+	{"maxx475", "6", ".5", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx476 max  66     5      ->  66
+	{"maxx476", "66", "5", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx477 max  666    50     ->  666
+	{"maxx477", "666", "50", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx478 max  6666   500    ->  6.67E+3 Rounded Inexact
+	{"maxx478", "6666", "500", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx479 max  66666  5000   ->  6.67E+4 Rounded Inexact
+	{"maxx479", "66666", "5000", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx480 max  33333  5000   ->  3.33E+4 Rounded Inexact
+	{"maxx480", "33333", "5000", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx481 max  .5     1      ->  1
+	{"maxx481", ".5", "1", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx482 max  .5     10     ->  10
+	{"maxx482", ".5", "10", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx483 max  .5     100    ->  100
+	{"maxx483", ".5", "100", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx484 max  .5     1000   ->  1.00E+3 Rounded
+	{"maxx484", ".5", "1000", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx485 max  .5     10000  ->  1.00E+4 Rounded
+	{"maxx485", ".5", "10000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx486 max  .5     6      ->  6
+	{"maxx486", ".5", "6", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx487 max  .5     66     ->  66
+	{"maxx487", ".5", "66", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx488 max  .5     666    ->  666
+	{"maxx488", ".5", "666", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx489 max  .5     6666   ->  6.67E+3 Rounded Inexact
+	{"maxx489", ".5", "6666", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx490 max  .5     66666  ->  6.67E+4 Rounded Inexact
+	{"maxx490", ".5", "66666", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx491 max  .5     33333  ->  3.33E+4 Rounded Inexact
+	{"maxx491", ".5", "33333", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// overflow tests
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// maxx500 max 9.999E+999999999  0 ->  Infinity Inexact Overflow Rounded
+	{"maxx500", "9.999E+999999999", "0", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// maxx501 max -9.999E+999999999 0 ->  0
+	{"maxx501", "-9.999E+999999999", "0", "0", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// subnormals and underflow
+	// precision: 3
+	// maxexponent: 999
+	// minexponent: -999
+	// maxx510 max  1.00E-999       0  ->   1.00E-999
+	{"maxx510", "1.00E-999", "0", "1.00E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx511 max  0.1E-999        0  ->   1E-1000   Subnormal
+	{"maxx511", "0.1E-999", "0", "1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// maxx512 max  0.10E-999       0  ->   1.0E-1000 Subnormal
+	{"maxx512", "0.10E-999", "0", "1.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// maxx513 max  0.100E-999      0  ->   1.0E-1000 Subnormal Rounded
+	{"maxx513", "0.100E-999", "0", "1.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// maxx514 max  0.01E-999       0  ->   1E-1001   Subnormal
+	{"maxx514", "0.01E-999", "0", "1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Nmin
+	// maxx515 max  0.999E-999      0  ->   1.00E-999 Inexact Rounded Subnormal Underflow
+	{"maxx515", "0.999E-999", "0", "1.00E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// maxx516 max  0.099E-999      0  ->   1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"maxx516", "0.099E-999", "0", "1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// maxx517 max  0.009E-999      0  ->   1E-1001   Inexact Rounded Subnormal Underflow
+	{"maxx517", "0.009E-999", "0", "1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// maxx518 max  0.001E-999      0  ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"maxx518", "0.001E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// maxx519 max  0.0009E-999     0  ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"maxx519", "0.0009E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// maxx520 max  0.0001E-999     0  ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"maxx520", "0.0001E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// maxx530 max -1.00E-999       0  ->   0
+	{"maxx530", "-1.00E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx531 max -0.1E-999        0  ->   0
+	{"maxx531", "-0.1E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx532 max -0.10E-999       0  ->   0
+	{"maxx532", "-0.10E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx533 max -0.100E-999      0  ->   0
+	{"maxx533", "-0.100E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx534 max -0.01E-999       0  ->   0
+	{"maxx534", "-0.01E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx535 max -0.999E-999      0  ->   0
+	{"maxx535", "-0.999E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx536 max -0.099E-999      0  ->   0
+	{"maxx536", "-0.099E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx537 max -0.009E-999      0  ->   0
+	{"maxx537", "-0.009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx538 max -0.001E-999      0  ->   0
+	{"maxx538", "-0.001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx539 max -0.0009E-999     0  ->   0
+	{"maxx539", "-0.0009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// maxx540 max -0.0001E-999     0  ->   0
+	{"maxx540", "-0.0001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// misalignment traps for little-endian
+	// precision: 9
+	// maxx551 max      1.0       0.1  -> 1.0
+	{"maxx551", "1.0", "0.1", "1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx552 max      0.1       1.0  -> 1.0
+	{"maxx552", "0.1", "1.0", "1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx553 max     10.0       0.1  -> 10.0
+	{"maxx553", "10.0", "0.1", "10.0", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx554 max      0.1      10.0  -> 10.0
+	{"maxx554", "0.1", "10.0", "10.0", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx555 max      100       1.0  -> 100
+	{"maxx555", "100", "1.0", "100", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx556 max      1.0       100  -> 100
+	{"maxx556", "1.0", "100", "100", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx557 max     1000      10.0  -> 1000
+	{"maxx557", "1000", "10.0", "1000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx558 max     10.0      1000  -> 1000
+	{"maxx558", "10.0", "1000", "1000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx559 max    10000     100.0  -> 10000
+	{"maxx559", "10000", "100.0", "10000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx560 max    100.0     10000  -> 10000
+	{"maxx560", "100.0", "10000", "10000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx661 max   100000    1000.0  -> 100000
+	{"maxx661", "100000", "1000.0", "100000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx662 max   1000.0    100000  -> 100000
+	{"maxx662", "1000.0", "100000", "100000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx663 max  1000000   10000.0  -> 1000000
+	{"maxx663", "1000000", "10000.0", "1000000", 0, 9, ToNearestAway, 999, -999, false},
+	// maxx664 max  10000.0   1000000  -> 1000000
+	{"maxx664", "10000.0", "1000000", "1000000", 0, 9, ToNearestAway, 999, -999, false},
+	// payload decapitate
+	// precision: 5
+	// maxx670 max      11 -sNaN12345678901 -> -NaN78901  Invalid_operation
+	{"maxx670", "11", "-sNaN12345678901", "-NaN78901", InvalidOperation, 5, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): maxx900 max 10  #  -> NaN Invalid_operation
+	// SKIP (encoding not supported): maxx901 max  # 10  -> NaN Invalid_operation
+}
@@ -0,0 +1,683 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var maxmagTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// we assume that base comparison is tested in compare.decTest, so
+	// these mainly cover special cases and rounding
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks
+	// mxgx001 maxmag  -2  -2  -> -2
+	{"mxgx001", "-2", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx002 maxmag  -2  -1  -> -2
+	{"mxgx002", "-2", "-1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx003 maxmag  -2   0  -> -2
+	{"mxgx003", "-2", "0", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx004 maxmag  -2   1  -> -2
+	{"mxgx004", "-2", "1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx005 maxmag  -2   2  ->  2
+	{"mxgx005", "-2", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx006 maxmag  -1  -2  -> -2
+	{"mxgx006", "-1", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx007 maxmag  -1  -1  -> -1
+	{"mxgx007", "-1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx008 maxmag  -1   0  -> -1
+	{"mxgx008", "-1", "0", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx009 maxmag  -1   1  ->  1
+	{"mxgx009", "-1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx010 maxmag  -1   2  ->  2
+	{"mxgx010", "-1", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx011 maxmag   0  -2  -> -2
+	{"mxgx011", "0", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx012 maxmag   0  -1  -> -1
+	{"mxgx012", "0", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx013 maxmag   0   0  ->  0
+	{"mxgx013", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx014 maxmag   0   1  ->  1
+	{"mxgx014", "0", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx015 maxmag   0   2  ->  2
+	{"mxgx015", "0", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx016 maxmag   1  -2  -> -2
+	{"mxgx016", "1", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx017 maxmag   1  -1  ->  1
+	{"mxgx017", "1", "-1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx018 maxmag   1   0  ->  1
+	{"mxgx018", "1", "0", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx019 maxmag   1   1  ->  1
+	{"mxgx019", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx020 maxmag   1   2  ->  2
+	{"mxgx020", "1", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx021 maxmag   2  -2  ->  2
+	{"mxgx021", "2", "-2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx022 maxmag   2  -1  ->  2
+	{"mxgx022", "2", "-1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx023 maxmag   2   0  ->  2
+	{"mxgx023", "2", "0", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx025 maxmag   2   1  ->  2
+	{"mxgx025", "2", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx026 maxmag   2   2  ->  2
+	{"mxgx026", "2", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// extended zeros
+	// mxgx030 maxmag   0     0   ->  0
+	{"mxgx030", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx031 maxmag   0    -0   ->  0
+	{"mxgx031", "0", "-0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx032 maxmag   0    -0.0 ->  0
+	{"mxgx032", "0", "-0.0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx033 maxmag   0     0.0 ->  0
+	{"mxgx033", "0", "0.0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx034 maxmag  -0     0   ->  0    -- note: -0 = 0, but 0 chosen
+	// mxgx035 maxmag  -0    -0   -> -0
+	{"mxgx035", "-0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx036 maxmag  -0    -0.0 -> -0.0
+	{"mxgx036", "-0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx037 maxmag  -0     0.0 ->  0.0
+	{"mxgx037", "-0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx038 maxmag   0.0   0   ->  0
+	{"mxgx038", "0.0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx039 maxmag   0.0  -0   ->  0.0
+	{"mxgx039", "0.0", "-0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx040 maxmag   0.0  -0.0 ->  0.0
+	{"mxgx040", "0.0", "-0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx041 maxmag   0.0   0.0 ->  0.0
+	{"mxgx041", "0.0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx042 maxmag  -0.0   0   ->  0
+	{"mxgx042", "-0.0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx043 maxmag  -0.0  -0   -> -0.0
+	{"mxgx043", "-0.0", "-0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx044 maxmag  -0.0  -0.0 -> -0.0
+	{"mxgx044", "-0.0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx045 maxmag  -0.0   0.0 ->  0.0
+	{"mxgx045", "-0.0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx050 maxmag  -0E1   0E1 ->  0E+1
+	{"mxgx050", "-0E1", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx051 maxmag  -0E2   0E2 ->  0E+2
+	{"mxgx051", "-0E2", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx052 maxmag  -0E2   0E1 ->  0E+1
+	{"mxgx052", "-0E2", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx053 maxmag  -0E1   0E2 ->  0E+2
+	{"mxgx053", "-0E1", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx054 maxmag   0E1  -0E1 ->  0E+1
+	{"mxgx054", "0E1", "-0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx055 maxmag   0E2  -0E2 ->  0E+2
+	{"mxgx055", "0E2", "-0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx056 maxmag   0E2  -0E1 ->  0E+2
+	{"mxgx056", "0E2", "-0E1", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx057 maxmag   0E1  -0E2 ->  0E+1
+	{"mxgx057", "0E1", "-0E2", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx058 maxmag   0E1   0E1 ->  0E+1
+	{"mxgx058", "0E1", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx059 maxmag   0E2   0E2 ->  0E+2
+	{"mxgx059", "0E2", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx060 maxmag   0E2   0E1 ->  0E+2
+	{"mxgx060", "0E2", "0E1", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx061 maxmag   0E1   0E2 ->  0E+2
+	{"mxgx061", "0E1", "0E2", "0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx062 maxmag  -0E1  -0E1 -> -0E+1
+	{"mxgx062", "-0E1", "-0E1", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx063 maxmag  -0E2  -0E2 -> -0E+2
+	{"mxgx063", "-0E2", "-0E2", "-0E+2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx064 maxmag  -0E2  -0E1 -> -0E+1
+	{"mxgx064", "-0E2", "-0E1", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx065 maxmag  -0E1  -0E2 -> -0E+1
+	{"mxgx065", "-0E1", "-0E2", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// Specials
+	// precision: 9
+	// mxgx090 maxmag  Inf  -Inf   ->  Infinity
+	{"mxgx090", "Inf", "-Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx091 maxmag  Inf  -1000  ->  Infinity
+	{"mxgx091", "Inf", "-1000", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx092 maxmag  Inf  -1     ->  Infinity
+	{"mxgx092", "Inf", "-1", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx093 maxmag  Inf  -0     ->  Infinity
+	{"mxgx093", "Inf", "-0", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx094 maxmag  Inf   0     ->  Infinity
+	{"mxgx094", "Inf", "0", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx095 maxmag  Inf   1     ->  Infinity
+	{"mxgx095", "Inf", "1", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx096 maxmag  Inf   1000  ->  Infinity
+	{"mxgx096", "Inf", "1000", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx097 maxmag  Inf   Inf   ->  Infinity
+	{"mxgx097", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx098 maxmag -1000  Inf   ->  Infinity
+	{"mxgx098", "-1000", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx099 maxmag -Inf   Inf   ->  Infinity
+	{"mxgx099", "-Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx100 maxmag -1     Inf   ->  Infinity
+	{"mxgx100", "-1", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx101 maxmag -0     Inf   ->  Infinity
+	{"mxgx101", "-0", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx102 maxmag  0     Inf   ->  Infinity
+	{"mxgx102", "0", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx103 maxmag  1     Inf   ->  Infinity
+	{"mxgx103", "1", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx104 maxmag  1000  Inf   ->  Infinity
+	{"mxgx104", "1000", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx105 maxmag  Inf   Inf   ->  Infinity
+	{"mxgx105", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx120 maxmag -Inf  -Inf   -> -Infinity
+	{"mxgx120", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx121 maxmag -Inf  -1000  -> -Infinity
+	{"mxgx121", "-Inf", "-1000", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx122 maxmag -Inf  -1     -> -Infinity
+	{"mxgx122", "-Inf", "-1", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx123 maxmag -Inf  -0     -> -Infinity
+	{"mxgx123", "-Inf", "-0", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx124 maxmag -Inf   0     -> -Infinity
+	{"mxgx124", "-Inf", "0", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx125 maxmag -Inf   1     -> -Infinity
+	{"mxgx125", "-Inf", "1", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx126 maxmag -Inf   1000  -> -Infinity
+	{"mxgx126", "-Inf", "1000", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx127 maxmag -Inf   Inf   ->  Infinity
+	{"mxgx127", "-Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx128 maxmag -Inf  -Inf   -> -Infinity
+	{"mxgx128", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx129 maxmag -1000 -Inf   -> -Infinity
+	{"mxgx129", "-1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx130 maxmag -1    -Inf   -> -Infinity
+	{"mxgx130", "-1", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx131 maxmag -0    -Inf   -> -Infinity
+	{"mxgx131", "-0", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx132 maxmag  0    -Inf   -> -Infinity
+	{"mxgx132", "0", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx133 maxmag  1    -Inf   -> -Infinity
+	{"mxgx133", "1", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx134 maxmag  1000 -Inf   -> -Infinity
+	{"mxgx134", "1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx135 maxmag  Inf  -Inf   ->  Infinity
+	{"mxgx135", "Inf", "-Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// 2004.08.02 754r chooses number over NaN in mixed cases
+	// mxgx141 maxmag  NaN -Inf    -> -Infinity
+	{"mxgx141", "NaN", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx142 maxmag  NaN -1000   -> -1000
+	{"mxgx142", "NaN", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx143 maxmag  NaN -1      -> -1
+	{"mxgx143", "NaN", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx144 maxmag  NaN -0      -> -0
+	{"mxgx144", "NaN", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx145 maxmag  NaN  0      ->  0
+	{"mxgx145", "NaN", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx146 maxmag  NaN  1      ->  1
+	{"mxgx146", "NaN", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx147 maxmag  NaN  1000   ->  1000
+	{"mxgx147", "NaN", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx148 maxmag  NaN  Inf    ->  Infinity
+	{"mxgx148", "NaN", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx149 maxmag  NaN  NaN    ->  NaN
+	{"mxgx149", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx150 maxmag -Inf  NaN    -> -Infinity
+	{"mxgx150", "-Inf", "NaN", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx151 maxmag -1000 NaN    -> -1000
+	{"mxgx151", "-1000", "NaN", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx152 maxmag -1    NaN    -> -1
+	{"mxgx152", "-1", "NaN", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx153 maxmag -0    NaN    -> -0
+	{"mxgx153", "-0", "NaN", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx154 maxmag  0    NaN    ->  0
+	{"mxgx154", "0", "NaN", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx155 maxmag  1    NaN    ->  1
+	{"mxgx155", "1", "NaN", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx156 maxmag  1000 NaN    ->  1000
+	{"mxgx156", "1000", "NaN", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx157 maxmag  Inf  NaN    ->  Infinity
+	{"mxgx157", "Inf", "NaN", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx161 maxmag  sNaN -Inf   ->  NaN  Invalid_operation
+	{"mxgx161", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx162 maxmag  sNaN -1000  ->  NaN  Invalid_operation
+	{"mxgx162", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx163 maxmag  sNaN -1     ->  NaN  Invalid_operation
+	{"mxgx163", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx164 maxmag  sNaN -0     ->  NaN  Invalid_operation
+	{"mxgx164", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx165 maxmag  sNaN  0     ->  NaN  Invalid_operation
+	{"mxgx165", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx166 maxmag  sNaN  1     ->  NaN  Invalid_operation
+	{"mxgx166", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx167 maxmag  sNaN  1000  ->  NaN  Invalid_operation
+	{"mxgx167", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx168 maxmag  sNaN  NaN   ->  NaN  Invalid_operation
+	{"mxgx168", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx169 maxmag  sNaN sNaN   ->  NaN  Invalid_operation
+	{"mxgx169", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx170 maxmag  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mxgx170", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx171 maxmag -Inf  sNaN   ->  NaN  Invalid_operation
+	{"mxgx171", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx172 maxmag -1000 sNaN   ->  NaN  Invalid_operation
+	{"mxgx172", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx173 maxmag -1    sNaN   ->  NaN  Invalid_operation
+	{"mxgx173", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx174 maxmag -0    sNaN   ->  NaN  Invalid_operation
+	{"mxgx174", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx175 maxmag  0    sNaN   ->  NaN  Invalid_operation
+	{"mxgx175", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx176 maxmag  1    sNaN   ->  NaN  Invalid_operation
+	{"mxgx176", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx177 maxmag  1000 sNaN   ->  NaN  Invalid_operation
+	{"mxgx177", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx178 maxmag  Inf  sNaN   ->  NaN  Invalid_operation
+	{"mxgx178", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx179 maxmag  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mxgx179", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// mxgx181 maxmag  NaN9  -Inf   -> -Infinity
+	{"mxgx181", "NaN9", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx182 maxmag  NaN8     9   ->  9
+	{"mxgx182", "NaN8", "9", "9", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx183 maxmag -NaN7   Inf   ->  Infinity
+	{"mxgx183", "-NaN7", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx184 maxmag -NaN1   NaN11 -> -NaN1
+	{"mxgx184", "-NaN1", "NaN11", "-NaN1", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx185 maxmag  NaN2   NaN12 ->  NaN2
+	{"mxgx185", "NaN2", "NaN12", "NaN2", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx186 maxmag -NaN13 -NaN7  -> -NaN13
+	{"mxgx186", "-NaN13", "-NaN7", "-NaN13", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx187 maxmag  NaN14 -NaN5  ->  NaN14
+	{"mxgx187", "NaN14", "-NaN5", "NaN14", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx188 maxmag -Inf    NaN4  -> -Infinity
+	{"mxgx188", "-Inf", "NaN4", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx189 maxmag -9     -NaN3  -> -9
+	{"mxgx189", "-9", "-NaN3", "-9", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx190 maxmag  Inf    NaN2  ->  Infinity
+	{"mxgx190", "Inf", "NaN2", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mxgx191 maxmag  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"mxgx191", "sNaN99", "-Inf", "NaN99", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx192 maxmag  sNaN98 -1      ->  NaN98 Invalid_operation
+	{"mxgx192", "sNaN98", "-1", "NaN98", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx193 maxmag -sNaN97  NaN    -> -NaN97 Invalid_operation
+	{"mxgx193", "-sNaN97", "NaN", "-NaN97", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx194 maxmag  sNaN96 sNaN94  ->  NaN96 Invalid_operation
+	{"mxgx194", "sNaN96", "sNaN94", "NaN96", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx195 maxmag  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"mxgx195", "NaN95", "sNaN93", "NaN93", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx196 maxmag -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"mxgx196", "-Inf", "sNaN92", "NaN92", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx197 maxmag  0      sNaN91  ->  NaN91 Invalid_operation
+	{"mxgx197", "0", "sNaN91", "NaN91", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx198 maxmag  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	{"mxgx198", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mxgx199 maxmag  NaN    sNaN89  ->  NaN89 Invalid_operation
+	{"mxgx199", "NaN", "sNaN89", "NaN89", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// rounding checks
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// mxgx201 maxmag 12345678000 1  -> 1.23456780E+10 Rounded
+	{"mxgx201", "12345678000", "1", "1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx202 maxmag 1 12345678000  -> 1.23456780E+10 Rounded
+	{"mxgx202", "1", "12345678000", "1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx203 maxmag 1234567800  1  -> 1.23456780E+9 Rounded
+	{"mxgx203", "1234567800", "1", "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx204 maxmag 1 1234567800   -> 1.23456780E+9 Rounded
+	{"mxgx204", "1", "1234567800", "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx205 maxmag 1234567890  1  -> 1.23456789E+9 Rounded
+	{"mxgx205", "1234567890", "1", "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx206 maxmag 1 1234567890   -> 1.23456789E+9 Rounded
+	{"mxgx206", "1", "1234567890", "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx207 maxmag 1234567891  1  -> 1.23456789E+9 Inexact Rounded
+	{"mxgx207", "1234567891", "1", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx208 maxmag 1 1234567891   -> 1.23456789E+9 Inexact Rounded
+	{"mxgx208", "1", "1234567891", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx209 maxmag 12345678901 1  -> 1.23456789E+10 Inexact Rounded
+	{"mxgx209", "12345678901", "1", "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx210 maxmag 1 12345678901  -> 1.23456789E+10 Inexact Rounded
+	{"mxgx210", "1", "12345678901", "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx211 maxmag 1234567896  1  -> 1.23456790E+9 Inexact Rounded
+	{"mxgx211", "1234567896", "1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx212 maxmag 1 1234567896   -> 1.23456790E+9 Inexact Rounded
+	{"mxgx212", "1", "1234567896", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx213 maxmag -1234567891  1 -> -1.23456789E+9   Inexact Rounded
+	{"mxgx213", "-1234567891", "1", "-1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx214 maxmag 1 -1234567891  -> -1.23456789E+9   Inexact Rounded
+	{"mxgx214", "1", "-1234567891", "-1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx215 maxmag -12345678901 1 -> -1.23456789E+10  Inexact Rounded
+	{"mxgx215", "-12345678901", "1", "-1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx216 maxmag 1 -12345678901 -> -1.23456789E+10  Inexact Rounded
+	{"mxgx216", "1", "-12345678901", "-1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx217 maxmag -1234567896  1 -> -1.23456790E+9   Inexact Rounded
+	{"mxgx217", "-1234567896", "1", "-1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mxgx218 maxmag 1 -1234567896  -> -1.23456790E+9   Inexact Rounded
+	{"mxgx218", "1", "-1234567896", "-1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// mxgx221 maxmag 12345678000 1  -> 12345678000
+	{"mxgx221", "12345678000", "1", "12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx222 maxmag 1 12345678000  -> 12345678000
+	{"mxgx222", "1", "12345678000", "12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx223 maxmag 1234567800  1  -> 1234567800
+	{"mxgx223", "1234567800", "1", "1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx224 maxmag 1 1234567800   -> 1234567800
+	{"mxgx224", "1", "1234567800", "1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx225 maxmag 1234567890  1  -> 1234567890
+	{"mxgx225", "1234567890", "1", "1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx226 maxmag 1 1234567890   -> 1234567890
+	{"mxgx226", "1", "1234567890", "1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx227 maxmag 1234567891  1  -> 1234567891
+	{"mxgx227", "1234567891", "1", "1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx228 maxmag 1 1234567891   -> 1234567891
+	{"mxgx228", "1", "1234567891", "1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx229 maxmag 12345678901 1  -> 12345678901
+	{"mxgx229", "12345678901", "1", "12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx230 maxmag 1 12345678901  -> 12345678901
+	{"mxgx230", "1", "12345678901", "12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx231 maxmag 1234567896  1  -> 1234567896
+	{"mxgx231", "1234567896", "1", "1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx232 maxmag 1 1234567896   -> 1234567896
+	{"mxgx232", "1", "1234567896", "1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx233 maxmag -1234567891  1 -> -1234567891
+	{"mxgx233", "-1234567891", "1", "-1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx234 maxmag 1 -1234567891  -> -1234567891
+	{"mxgx234", "1", "-1234567891", "-1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx235 maxmag -12345678901 1 -> -12345678901
+	{"mxgx235", "-12345678901", "1", "-12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx236 maxmag 1 -12345678901 -> -12345678901
+	{"mxgx236", "1", "-12345678901", "-12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx237 maxmag -1234567896  1 -> -1234567896
+	{"mxgx237", "-1234567896", "1", "-1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx238 maxmag 1 -1234567896  -> -1234567896
+	{"mxgx238", "1", "-1234567896", "-1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// from examples
+	// mxgx280 maxmag '3'   '2'  ->  '3'
+	{"mxgx280", "3", "2", "3", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx281 maxmag '-10' '3'  ->  '-10'
+	{"mxgx281", "-10", "3", "-10", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx282 maxmag '1.0' '1'  ->  '1'
+	{"mxgx282", "1.0", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx283 maxmag '1' '1.0'  ->  '1'
+	{"mxgx283", "1", "1.0", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mxgx284 maxmag '7' 'NaN'  ->  '7'
+	{"mxgx284", "7", "NaN", "7", 0, 15, ToNearestAway, 999, -999, false},
+	// overflow and underflow tests ...
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// mxgx330 maxmag +1.23456789012345E-0 9E+999999999 ->  9E+999999999
+	{"mxgx330", "+1.23456789012345E-0", "9E+999999999", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx331 maxmag 9E+999999999 +1.23456789012345E-0 ->  9E+999999999
+	{"mxgx331", "9E+999999999", "+1.23456789012345E-0", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx332 maxmag +0.100 9E-999999999               ->  0.100
+	{"mxgx332", "+0.100", "9E-999999999", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx333 maxmag 9E-999999999 +0.100               ->  0.100
+	{"mxgx333", "9E-999999999", "+0.100", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx335 maxmag -1.23456789012345E-0 9E+999999999 ->  9E+999999999
+	{"mxgx335", "-1.23456789012345E-0", "9E+999999999", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx336 maxmag 9E+999999999 -1.23456789012345E-0 ->  9E+999999999
+	{"mxgx336", "9E+999999999", "-1.23456789012345E-0", "9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx337 maxmag -0.100 9E-999999999               ->  -0.100
+	{"mxgx337", "-0.100", "9E-999999999", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx338 maxmag 9E-999999999 -0.100               ->  -0.100
+	{"mxgx338", "9E-999999999", "-0.100", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx339 maxmag 1e-599999999 1e-400000001   ->  1E-400000001
+	{"mxgx339", "1e-599999999", "1e-400000001", "1E-400000001", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx340 maxmag 1e-599999999 1e-400000000   ->  1E-400000000
+	{"mxgx340", "1e-599999999", "1e-400000000", "1E-400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx341 maxmag 1e-600000000 1e-400000000   ->  1E-400000000
+	{"mxgx341", "1e-600000000", "1e-400000000", "1E-400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx342 maxmag 9e-999999998 0.01           ->  0.01
+	{"mxgx342", "9e-999999998", "0.01", "0.01", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx343 maxmag 9e-999999998 0.1            ->  0.1
+	{"mxgx343", "9e-999999998", "0.1", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx344 maxmag 0.01 9e-999999998           ->  0.01
+	{"mxgx344", "0.01", "9e-999999998", "0.01", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx345 maxmag 1e599999999 1e400000001     ->  1E+599999999
+	{"mxgx345", "1e599999999", "1e400000001", "1E+599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx346 maxmag 1e599999999 1e400000000     ->  1E+599999999
+	{"mxgx346", "1e599999999", "1e400000000", "1E+599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx347 maxmag 1e600000000 1e400000000     ->  1E+600000000
+	{"mxgx347", "1e600000000", "1e400000000", "1E+600000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx348 maxmag 9e999999998 100             ->  9E+999999998
+	{"mxgx348", "9e999999998", "100", "9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx349 maxmag 9e999999998 10              ->  9E+999999998
+	{"mxgx349", "9e999999998", "10", "9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx350 maxmag 100  9e999999998            ->  9E+999999998
+	{"mxgx350", "100", "9e999999998", "9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// signs
+	// mxgx351 maxmag  1e+777777777  1e+411111111 ->  1E+777777777
+	{"mxgx351", "1e+777777777", "1e+411111111", "1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx352 maxmag  1e+777777777 -1e+411111111 ->  1E+777777777
+	{"mxgx352", "1e+777777777", "-1e+411111111", "1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx353 maxmag -1e+777777777  1e+411111111 -> -1E+777777777
+	{"mxgx353", "-1e+777777777", "1e+411111111", "-1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx354 maxmag -1e+777777777 -1e+411111111 -> -1E+777777777
+	{"mxgx354", "-1e+777777777", "-1e+411111111", "-1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx355 maxmag  1e-777777777  1e-411111111 ->  1E-411111111
+	{"mxgx355", "1e-777777777", "1e-411111111", "1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx356 maxmag  1e-777777777 -1e-411111111 -> -1E-411111111
+	{"mxgx356", "1e-777777777", "-1e-411111111", "-1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx357 maxmag -1e-777777777  1e-411111111 ->  1E-411111111
+	{"mxgx357", "-1e-777777777", "1e-411111111", "1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx358 maxmag -1e-777777777 -1e-411111111 -> -1E-411111111
+	{"mxgx358", "-1e-777777777", "-1e-411111111", "-1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// expanded list from min/max 754r purple prose
+	// [explicit tests for exponent ordering]
+	// mxgx401 maxmag  Inf    1.1     ->  Infinity
+	{"mxgx401", "Inf", "1.1", "Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx402 maxmag  1.1    1       ->  1.1
+	{"mxgx402", "1.1", "1", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx403 maxmag  1      1.0     ->  1
+	{"mxgx403", "1", "1.0", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx404 maxmag  1.0    0.1     ->  1.0
+	{"mxgx404", "1.0", "0.1", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx405 maxmag  0.1    0.10    ->  0.1
+	{"mxgx405", "0.1", "0.10", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx406 maxmag  0.10   0.100   ->  0.10
+	{"mxgx406", "0.10", "0.100", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx407 maxmag  0.10   0       ->  0.10
+	{"mxgx407", "0.10", "0", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx408 maxmag  0      0.0     ->  0
+	{"mxgx408", "0", "0.0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx409 maxmag  0.0   -0       ->  0.0
+	{"mxgx409", "0.0", "-0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx410 maxmag  0.0   -0.0     ->  0.0
+	{"mxgx410", "0.0", "-0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx411 maxmag  0.00  -0.0     ->  0.00
+	{"mxgx411", "0.00", "-0.0", "0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx412 maxmag  0.0   -0.00    ->  0.0
+	{"mxgx412", "0.0", "-0.00", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx413 maxmag  0     -0.0     ->  0
+	{"mxgx413", "0", "-0.0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx414 maxmag  0     -0       ->  0
+	{"mxgx414", "0", "-0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx415 maxmag -0.0   -0       -> -0.0
+	{"mxgx415", "-0.0", "-0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx416 maxmag -0     -0.100   -> -0.100
+	{"mxgx416", "-0", "-0.100", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx417 maxmag -0.100 -0.10    -> -0.100
+	{"mxgx417", "-0.100", "-0.10", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx418 maxmag -0.10  -0.1     -> -0.10
+	{"mxgx418", "-0.10", "-0.1", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx419 maxmag -0.1   -1.0     -> -1.0
+	{"mxgx419", "-0.1", "-1.0", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx420 maxmag -1.0   -1       -> -1.0
+	{"mxgx420", "-1.0", "-1", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx421 maxmag -1     -1.1     -> -1.1
+	{"mxgx421", "-1", "-1.1", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx423 maxmag -1.1   -Inf     -> -Infinity
+	{"mxgx423", "-1.1", "-Inf", "-Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// same with operands reversed
+	// mxgx431 maxmag  1.1    Inf     ->  Infinity
+	{"mxgx431", "1.1", "Inf", "Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx432 maxmag  1      1.1     ->  1.1
+	{"mxgx432", "1", "1.1", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx433 maxmag  1.0    1       ->  1
+	{"mxgx433", "1.0", "1", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx434 maxmag  0.1    1.0     ->  1.0
+	{"mxgx434", "0.1", "1.0", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx435 maxmag  0.10   0.1     ->  0.1
+	{"mxgx435", "0.10", "0.1", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx436 maxmag  0.100  0.10    ->  0.10
+	{"mxgx436", "0.100", "0.10", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx437 maxmag  0      0.10    ->  0.10
+	{"mxgx437", "0", "0.10", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx438 maxmag  0.0    0       ->  0
+	{"mxgx438", "0.0", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx439 maxmag -0      0.0     ->  0.0
+	{"mxgx439", "-0", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx440 maxmag -0.0    0.0     ->  0.0
+	{"mxgx440", "-0.0", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx441 maxmag -0.0    0.00    ->  0.00
+	{"mxgx441", "-0.0", "0.00", "0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx442 maxmag -0.00   0.0     ->  0.0
+	{"mxgx442", "-0.00", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx443 maxmag -0.0    0       ->  0
+	{"mxgx443", "-0.0", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx444 maxmag -0      0       ->  0
+	{"mxgx444", "-0", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx445 maxmag -0     -0.0     -> -0.0
+	{"mxgx445", "-0", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx446 maxmag -0.100 -0       -> -0.100
+	{"mxgx446", "-0.100", "-0", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx447 maxmag -0.10  -0.100   -> -0.100
+	{"mxgx447", "-0.10", "-0.100", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx448 maxmag -0.1   -0.10    -> -0.10
+	{"mxgx448", "-0.1", "-0.10", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx449 maxmag -1.0   -0.1     -> -1.0
+	{"mxgx449", "-1.0", "-0.1", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx450 maxmag -1     -1.0     -> -1.0
+	{"mxgx450", "-1", "-1.0", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx451 maxmag -1.1   -1       -> -1.1
+	{"mxgx451", "-1.1", "-1", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx453 maxmag -Inf   -1.1     -> -Infinity
+	{"mxgx453", "-Inf", "-1.1", "-Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// largies
+	// mxgx460 maxmag  1000   1E+3    ->  1E+3
+	{"mxgx460", "1000", "1E+3", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx461 maxmag  1E+3   1000    ->  1E+3
+	{"mxgx461", "1E+3", "1000", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx462 maxmag  1000  -1E+3    ->  1000
+	{"mxgx462", "1000", "-1E+3", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx463 maxmag  1E+3  -1000    ->  1E+3
+	{"mxgx463", "1E+3", "-1000", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx464 maxmag -1000   1E+3    ->  1E+3
+	{"mxgx464", "-1000", "1E+3", "1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx465 maxmag -1E+3   1000    ->  1000
+	{"mxgx465", "-1E+3", "1000", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx466 maxmag -1000  -1E+3    -> -1000
+	{"mxgx466", "-1000", "-1E+3", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mxgx467 maxmag -1E+3  -1000    -> -1000
+	{"mxgx467", "-1E+3", "-1000", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// rounding (results treated as though plus)
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// mxgx470 maxmag  1      .5     ->  1
+	{"mxgx470", "1", ".5", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx471 maxmag  10     5      ->  10
+	{"mxgx471", "10", "5", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx472 maxmag  100    50     ->  100
+	{"mxgx472", "100", "50", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx473 maxmag  1000   500    ->  1.00E+3 Rounded
+	{"mxgx473", "1000", "500", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx474 maxmag  10000  5000   ->  1.00E+4 Rounded
+	{"mxgx474", "10000", "5000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx475 maxmag  6      .5     ->  6
+	{"mxgx475", "6", ".5", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx476 maxmag  66     5      ->  66
+	{"mxgx476", "66", "5", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx477 maxmag  666    50     ->  666
+	{"mxgx477", "666", "50", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx478 maxmag  6666   500    ->  6.67E+3 Rounded Inexact
+	{"mxgx478", "6666", "500", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx479 maxmag  66666  5000   ->  6.67E+4 Rounded Inexact
+	{"mxgx479", "66666", "5000", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx480 maxmag  33333  5000   ->  3.33E+4 Rounded Inexact
+	{"mxgx480", "33333", "5000", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx481 maxmag  .5     1      ->  1
+	{"mxgx481", ".5", "1", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx482 maxmag  .5     10     ->  10
+	{"mxgx482", ".5", "10", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx483 maxmag  .5     100    ->  100
+	{"mxgx483", ".5", "100", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx484 maxmag  .5     1000   ->  1.00E+3 Rounded
+	{"mxgx484", ".5", "1000", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx485 maxmag  .5     10000  ->  1.00E+4 Rounded
+	{"mxgx485", ".5", "10000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx486 maxmag  .5     6      ->  6
+	{"mxgx486", ".5", "6", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx487 maxmag  .5     66     ->  66
+	{"mxgx487", ".5", "66", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx488 maxmag  .5     666    ->  666
+	{"mxgx488", ".5", "666", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx489 maxmag  .5     6666   ->  6.67E+3 Rounded Inexact
+	{"mxgx489", ".5", "6666", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx490 maxmag  .5     66666  ->  6.67E+4 Rounded Inexact
+	{"mxgx490", ".5", "66666", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx491 maxmag  .5     33333  ->  3.33E+4 Rounded Inexact
+	{"mxgx491", ".5", "33333", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// overflow tests
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// mxgx500 maxmag 9.999E+999999999  0 ->  Infinity Inexact Overflow Rounded
+	{"mxgx500", "9.999E+999999999", "0", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mxgx501 maxmag -9.999E+999999999 0 -> -Infinity Inexact Overflow Rounded
+	{"mxgx501", "-9.999E+999999999", "0", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// subnormals and underflow
+	// precision: 3
+	// maxexponent: 999
+	// minexponent: -999
+	// mxgx510 maxmag  1.00E-999       0  ->   1.00E-999
+	{"mxgx510", "1.00E-999", "0", "1.00E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// mxgx511 maxmag  0.1E-999        0  ->   1E-1000   Subnormal
+	{"mxgx511", "0.1E-999", "0", "1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// mxgx512 maxmag  0.10E-999       0  ->   1.0E-1000 Subnormal
+	{"mxgx512", "0.10E-999", "0", "1.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// mxgx513 maxmag  0.100E-999      0  ->   1.0E-1000 Subnormal Rounded
+	{"mxgx513", "0.100E-999", "0", "1.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// mxgx514 maxmag  0.01E-999       0  ->   1E-1001   Subnormal
+	{"mxgx514", "0.01E-999", "0", "1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Nmin
+	// mxgx515 maxmag  0.999E-999      0  ->   1.00E-999 Inexact Rounded Subnormal Underflow
+	{"mxgx515", "0.999E-999", "0", "1.00E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mxgx516 maxmag  0.099E-999      0  ->   1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"mxgx516", "0.099E-999", "0", "1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mxgx517 maxmag  0.009E-999      0  ->   1E-1001   Inexact Rounded Subnormal Underflow
+	{"mxgx517", "0.009E-999", "0", "1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mxgx518 maxmag  0.001E-999      0  ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mxgx518", "0.001E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mxgx519 maxmag  0.0009E-999     0  ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mxgx519", "0.0009E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mxgx520 maxmag  0.0001E-999     0  ->   0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mxgx520", "0.0001E-999", "0", "0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mxgx530 maxmag -1.00E-999       0  ->  -1.00E-999
+	{"mxgx530", "-1.00E-999", "0", "-1.00E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// mxgx531 maxmag -0.1E-999        0  ->  -1E-1000   Subnormal
+	{"mxgx531", "-0.1E-999", "0", "-1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// mxgx532 maxmag -0.10E-999       0  ->  -1.0E-1000 Subnormal
+	{"mxgx532", "-0.10E-999", "0", "-1.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// mxgx533 maxmag -0.100E-999      0  ->  -1.0E-1000 Subnormal Rounded
+	{"mxgx533", "-0.100E-999", "0", "-1.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// mxgx534 maxmag -0.01E-999       0  ->  -1E-1001   Subnormal
+	{"mxgx534", "-0.01E-999", "0", "-1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to -Nmin
+	// mxgx535 maxmag -0.999E-999      0  ->  -1.00E-999 Inexact Rounded Subnormal Underflow
+	{"mxgx535", "-0.999E-999", "0", "-1.00E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mxgx536 maxmag -0.099E-999      0  ->  -1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"mxgx536", "-0.099E-999", "0", "-1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mxgx537 maxmag -0.009E-999      0  ->  -1E-1001   Inexact Rounded Subnormal Underflow
+	{"mxgx537", "-0.009E-999", "0", "-1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mxgx538 maxmag -0.001E-999      0  ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mxgx538", "-0.001E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mxgx539 maxmag -0.0009E-999     0  ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mxgx539", "-0.0009E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mxgx540 maxmag -0.0001E-999     0  ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mxgx540", "-0.0001E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): mxgx900 maxmag 10  #  -> NaN Invalid_operation
+	// SKIP (encoding not supported): mxgx901 maxmag  # 10  -> NaN Invalid_operation
+}
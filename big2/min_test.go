@@ -0,0 +1,693 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var minTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// we assume that base comparison is tested in compare.decTest, so
+	// these mainly cover special cases and rounding
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks
+	// mnmx001 min  -2  -2  -> -2
+	{"mnmx001", "-2", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx002 min  -2  -1  -> -2
+	{"mnmx002", "-2", "-1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx003 min  -2   0  -> -2
+	{"mnmx003", "-2", "0", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx004 min  -2   1  -> -2
+	{"mnmx004", "-2", "1", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx005 min  -2   2  -> -2
+	{"mnmx005", "-2", "2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx006 min  -1  -2  -> -2
+	{"mnmx006", "-1", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx007 min  -1  -1  -> -1
+	{"mnmx007", "-1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx008 min  -1   0  -> -1
+	{"mnmx008", "-1", "0", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx009 min  -1   1  -> -1
+	{"mnmx009", "-1", "1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx010 min  -1   2  -> -1
+	{"mnmx010", "-1", "2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx011 min   0  -2  -> -2
+	{"mnmx011", "0", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx012 min   0  -1  -> -1
+	{"mnmx012", "0", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx013 min   0   0  ->  0
+	{"mnmx013", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx014 min   0   1  ->  0
+	{"mnmx014", "0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx015 min   0   2  ->  0
+	{"mnmx015", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx016 min   1  -2  -> -2
+	{"mnmx016", "1", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx017 min   1  -1  -> -1
+	{"mnmx017", "1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx018 min   1   0  ->  0
+	{"mnmx018", "1", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx019 min   1   1  ->  1
+	{"mnmx019", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx020 min   1   2  ->  1
+	{"mnmx020", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx021 min   2  -2  -> -2
+	{"mnmx021", "2", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx022 min   2  -1  -> -1
+	{"mnmx022", "2", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx023 min   2   0  ->  0
+	{"mnmx023", "2", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx025 min   2   1  ->  1
+	{"mnmx025", "2", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx026 min   2   2  ->  2
+	{"mnmx026", "2", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// extended zeros
+	// mnmx030 min   0     0   ->  0
+	{"mnmx030", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx031 min   0    -0   -> -0
+	{"mnmx031", "0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx032 min   0    -0.0 -> -0.0
+	{"mnmx032", "0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx033 min   0     0.0 ->  0.0
+	{"mnmx033", "0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx034 min  -0     0   -> -0
+	{"mnmx034", "-0", "0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx035 min  -0    -0   -> -0
+	{"mnmx035", "-0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx036 min  -0    -0.0 -> -0
+	{"mnmx036", "-0", "-0.0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx037 min  -0     0.0 -> -0
+	{"mnmx037", "-0", "0.0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx038 min   0.0   0   ->  0.0
+	{"mnmx038", "0.0", "0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx039 min   0.0  -0   -> -0
+	{"mnmx039", "0.0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx040 min   0.0  -0.0 -> -0.0
+	{"mnmx040", "0.0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx041 min   0.0   0.0 ->  0.0
+	{"mnmx041", "0.0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx042 min  -0.0   0   -> -0.0
+	{"mnmx042", "-0.0", "0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx043 min  -0.0  -0   -> -0
+	{"mnmx043", "-0.0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx044 min  -0.0  -0.0 -> -0.0
+	{"mnmx044", "-0.0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx045 min  -0.0   0.0 -> -0.0
+	{"mnmx045", "-0.0", "0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx046 min   0E1  -0E1 -> -0E+1
+	{"mnmx046", "0E1", "-0E1", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx047 min  -0E1   0E2 -> -0E+1
+	{"mnmx047", "-0E1", "0E2", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx048 min   0E2   0E1 ->  0E+1
+	{"mnmx048", "0E2", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx049 min   0E1   0E2 ->  0E+1
+	{"mnmx049", "0E1", "0E2", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx050 min  -0E3  -0E2 -> -0E+3
+	{"mnmx050", "-0E3", "-0E2", "-0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx051 min  -0E2  -0E3 -> -0E+3
+	{"mnmx051", "-0E2", "-0E3", "-0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// Specials
+	// precision: 9
+	// mnmx090 min  Inf  -Inf   -> -Infinity
+	{"mnmx090", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx091 min  Inf  -1000  -> -1000
+	{"mnmx091", "Inf", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx092 min  Inf  -1     -> -1
+	{"mnmx092", "Inf", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx093 min  Inf  -0     -> -0
+	{"mnmx093", "Inf", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx094 min  Inf   0     ->  0
+	{"mnmx094", "Inf", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx095 min  Inf   1     ->  1
+	{"mnmx095", "Inf", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx096 min  Inf   1000  ->  1000
+	{"mnmx096", "Inf", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx097 min  Inf   Inf   ->  Infinity
+	{"mnmx097", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx098 min -1000  Inf   -> -1000
+	{"mnmx098", "-1000", "Inf", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx099 min -Inf   Inf   -> -Infinity
+	{"mnmx099", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx100 min -1     Inf   -> -1
+	{"mnmx100", "-1", "Inf", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx101 min -0     Inf   -> -0
+	{"mnmx101", "-0", "Inf", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx102 min  0     Inf   ->  0
+	{"mnmx102", "0", "Inf", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx103 min  1     Inf   ->  1
+	{"mnmx103", "1", "Inf", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx104 min  1000  Inf   ->  1000
+	{"mnmx104", "1000", "Inf", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx105 min  Inf   Inf   ->  Infinity
+	{"mnmx105", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx120 min -Inf  -Inf   -> -Infinity
+	{"mnmx120", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx121 min -Inf  -1000  -> -Infinity
+	{"mnmx121", "-Inf", "-1000", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx122 min -Inf  -1     -> -Infinity
+	{"mnmx122", "-Inf", "-1", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx123 min -Inf  -0     -> -Infinity
+	{"mnmx123", "-Inf", "-0", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx124 min -Inf   0     -> -Infinity
+	{"mnmx124", "-Inf", "0", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx125 min -Inf   1     -> -Infinity
+	{"mnmx125", "-Inf", "1", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx126 min -Inf   1000  -> -Infinity
+	{"mnmx126", "-Inf", "1000", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx127 min -Inf   Inf   -> -Infinity
+	{"mnmx127", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx128 min -Inf  -Inf   -> -Infinity
+	{"mnmx128", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx129 min -1000 -Inf   -> -Infinity
+	{"mnmx129", "-1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx130 min -1    -Inf   -> -Infinity
+	{"mnmx130", "-1", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx131 min -0    -Inf   -> -Infinity
+	{"mnmx131", "-0", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx132 min  0    -Inf   -> -Infinity
+	{"mnmx132", "0", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx133 min  1    -Inf   -> -Infinity
+	{"mnmx133", "1", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx134 min  1000 -Inf   -> -Infinity
+	{"mnmx134", "1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx135 min  Inf  -Inf   -> -Infinity
+	{"mnmx135", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// 2004.08.02 754r chooses number over NaN in mixed cases
+	// mnmx141 min  NaN -Inf    ->  -Infinity
+	{"mnmx141", "NaN", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx142 min  NaN -1000   ->  -1000
+	{"mnmx142", "NaN", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx143 min  NaN -1      ->  -1
+	{"mnmx143", "NaN", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx144 min  NaN -0      ->  -0
+	{"mnmx144", "NaN", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx145 min  NaN  0      ->  0
+	{"mnmx145", "NaN", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx146 min  NaN  1      ->  1
+	{"mnmx146", "NaN", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx147 min  NaN  1000   ->  1000
+	{"mnmx147", "NaN", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx148 min  NaN  Inf    ->  Infinity
+	{"mnmx148", "NaN", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx149 min  NaN  NaN    ->  NaN
+	{"mnmx149", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx150 min -Inf  NaN    -> -Infinity
+	{"mnmx150", "-Inf", "NaN", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx151 min -1000 NaN    -> -1000
+	{"mnmx151", "-1000", "NaN", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx152 min -1   -NaN    -> -1
+	{"mnmx152", "-1", "-NaN", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx153 min -0    NaN    -> -0
+	{"mnmx153", "-0", "NaN", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx154 min  0   -NaN    ->  0
+	{"mnmx154", "0", "-NaN", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx155 min  1    NaN    ->  1
+	{"mnmx155", "1", "NaN", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx156 min  1000 NaN    ->  1000
+	{"mnmx156", "1000", "NaN", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx157 min  Inf  NaN    ->  Infinity
+	{"mnmx157", "Inf", "NaN", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx161 min  sNaN -Inf   ->  NaN  Invalid_operation
+	{"mnmx161", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx162 min  sNaN -1000  ->  NaN  Invalid_operation
+	{"mnmx162", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx163 min  sNaN -1     ->  NaN  Invalid_operation
+	{"mnmx163", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx164 min  sNaN -0     ->  NaN  Invalid_operation
+	{"mnmx164", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx165 min -sNaN  0     -> -NaN  Invalid_operation
+	{"mnmx165", "-sNaN", "0", "-NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx166 min -sNaN  1     -> -NaN  Invalid_operation
+	{"mnmx166", "-sNaN", "1", "-NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx167 min  sNaN  1000  ->  NaN  Invalid_operation
+	{"mnmx167", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx168 min  sNaN  NaN   ->  NaN  Invalid_operation
+	{"mnmx168", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx169 min  sNaN sNaN   ->  NaN  Invalid_operation
+	{"mnmx169", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx170 min  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mnmx170", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx171 min -Inf  sNaN   ->  NaN  Invalid_operation
+	{"mnmx171", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx172 min -1000 sNaN   ->  NaN  Invalid_operation
+	{"mnmx172", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx173 min -1    sNaN   ->  NaN  Invalid_operation
+	{"mnmx173", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx174 min -0    sNaN   ->  NaN  Invalid_operation
+	{"mnmx174", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx175 min  0    sNaN   ->  NaN  Invalid_operation
+	{"mnmx175", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx176 min  1    sNaN   ->  NaN  Invalid_operation
+	{"mnmx176", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx177 min  1000 sNaN   ->  NaN  Invalid_operation
+	{"mnmx177", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx178 min  Inf  sNaN   ->  NaN  Invalid_operation
+	{"mnmx178", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx179 min  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mnmx179", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// mnmx181 min  NaN9   -Inf   -> -Infinity
+	{"mnmx181", "NaN9", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx182 min -NaN8    9990  ->  9990
+	{"mnmx182", "-NaN8", "9990", "9990", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx183 min  NaN71   Inf   ->  Infinity
+	{"mnmx183", "NaN71", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx184 min  NaN1    NaN54 ->  NaN1
+	{"mnmx184", "NaN1", "NaN54", "NaN1", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx185 min  NaN22  -NaN53 ->  NaN22
+	{"mnmx185", "NaN22", "-NaN53", "NaN22", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx186 min -NaN3    NaN6  -> -NaN3
+	{"mnmx186", "-NaN3", "NaN6", "-NaN3", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx187 min -NaN44   NaN7  -> -NaN44
+	{"mnmx187", "-NaN44", "NaN7", "-NaN44", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx188 min -Inf     NaN41 -> -Infinity
+	{"mnmx188", "-Inf", "NaN41", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx189 min -9999   -NaN33 -> -9999
+	{"mnmx189", "-9999", "-NaN33", "-9999", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx190 min  Inf     NaN2  ->  Infinity
+	{"mnmx190", "Inf", "NaN2", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mnmx191 min  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"mnmx191", "sNaN99", "-Inf", "NaN99", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx192 min  sNaN98 -11     ->  NaN98 Invalid_operation
+	{"mnmx192", "sNaN98", "-11", "NaN98", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx193 min -sNaN97  NaN8   -> -NaN97 Invalid_operation
+	{"mnmx193", "-sNaN97", "NaN8", "-NaN97", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx194 min  sNaN69 sNaN94  ->  NaN69 Invalid_operation
+	{"mnmx194", "sNaN69", "sNaN94", "NaN69", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx195 min  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"mnmx195", "NaN95", "sNaN93", "NaN93", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx196 min -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"mnmx196", "-Inf", "sNaN92", "NaN92", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx197 min  088    sNaN91  ->  NaN91 Invalid_operation
+	{"mnmx197", "088", "sNaN91", "NaN91", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx198 min  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	{"mnmx198", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mnmx199 min  NaN    sNaN86  ->  NaN86 Invalid_operation
+	{"mnmx199", "NaN", "sNaN86", "NaN86", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// rounding checks -- chosen is rounded, or not
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// mnmx201 min -12345678000 1  -> -1.23456780E+10 Rounded
+	{"mnmx201", "-12345678000", "1", "-1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx202 min 1 -12345678000  -> -1.23456780E+10 Rounded
+	{"mnmx202", "1", "-12345678000", "-1.23456780E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx203 min -1234567800  1  -> -1.23456780E+9 Rounded
+	{"mnmx203", "-1234567800", "1", "-1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx204 min 1 -1234567800   -> -1.23456780E+9 Rounded
+	{"mnmx204", "1", "-1234567800", "-1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx205 min -1234567890  1  -> -1.23456789E+9 Rounded
+	{"mnmx205", "-1234567890", "1", "-1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx206 min 1 -1234567890   -> -1.23456789E+9 Rounded
+	{"mnmx206", "1", "-1234567890", "-1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx207 min -1234567891  1  -> -1.23456789E+9 Inexact Rounded
+	{"mnmx207", "-1234567891", "1", "-1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx208 min 1 -1234567891   -> -1.23456789E+9 Inexact Rounded
+	{"mnmx208", "1", "-1234567891", "-1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx209 min -12345678901 1  -> -1.23456789E+10 Inexact Rounded
+	{"mnmx209", "-12345678901", "1", "-1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx210 min 1 -12345678901  -> -1.23456789E+10 Inexact Rounded
+	{"mnmx210", "1", "-12345678901", "-1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx211 min -1234567896  1  -> -1.23456790E+9 Inexact Rounded
+	{"mnmx211", "-1234567896", "1", "-1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx212 min 1 -1234567896   -> -1.23456790E+9 Inexact Rounded
+	{"mnmx212", "1", "-1234567896", "-1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// mnmx213 min 1234567891  1   -> 1
+	{"mnmx213", "1234567891", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx214 min 1 1234567891    -> 1
+	{"mnmx214", "1", "1234567891", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx215 min 12345678901 1   -> 1
+	{"mnmx215", "12345678901", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx216 min 1 12345678901   -> 1
+	{"mnmx216", "1", "12345678901", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx217 min 1234567896  1   -> 1
+	{"mnmx217", "1234567896", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx218 min 1 1234567896    -> 1
+	{"mnmx218", "1", "1234567896", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// mnmx221 min -12345678000 1  -> -12345678000
+	{"mnmx221", "-12345678000", "1", "-12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx222 min 1 -12345678000  -> -12345678000
+	{"mnmx222", "1", "-12345678000", "-12345678000", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx223 min -1234567800  1  -> -1234567800
+	{"mnmx223", "-1234567800", "1", "-1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx224 min 1 -1234567800   -> -1234567800
+	{"mnmx224", "1", "-1234567800", "-1234567800", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx225 min -1234567890  1  -> -1234567890
+	{"mnmx225", "-1234567890", "1", "-1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx226 min 1 -1234567890   -> -1234567890
+	{"mnmx226", "1", "-1234567890", "-1234567890", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx227 min -1234567891  1  -> -1234567891
+	{"mnmx227", "-1234567891", "1", "-1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx228 min 1 -1234567891   -> -1234567891
+	{"mnmx228", "1", "-1234567891", "-1234567891", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx229 min -12345678901 1  -> -12345678901
+	{"mnmx229", "-12345678901", "1", "-12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx230 min 1 -12345678901  -> -12345678901
+	{"mnmx230", "1", "-12345678901", "-12345678901", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx231 min -1234567896  1  -> -1234567896
+	{"mnmx231", "-1234567896", "1", "-1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx232 min 1 -1234567896   -> -1234567896
+	{"mnmx232", "1", "-1234567896", "-1234567896", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx233 min 1234567891  1   -> 1
+	{"mnmx233", "1234567891", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx234 min 1 1234567891    -> 1
+	{"mnmx234", "1", "1234567891", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx235 min 12345678901 1   -> 1
+	{"mnmx235", "12345678901", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx236 min 1 12345678901   -> 1
+	{"mnmx236", "1", "12345678901", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx237 min 1234567896  1   -> 1
+	{"mnmx237", "1234567896", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx238 min 1 1234567896    -> 1
+	{"mnmx238", "1", "1234567896", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// from examples
+	// mnmx280 min '3'   '2'  ->  '2'
+	{"mnmx280", "3", "2", "2", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx281 min '-10' '3'  ->  '-10'
+	{"mnmx281", "-10", "3", "-10", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx282 min '1.0' '1'  ->  '1.0'
+	{"mnmx282", "1.0", "1", "1.0", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx283 min '1' '1.0'  ->  '1.0'
+	{"mnmx283", "1", "1.0", "1.0", 0, 15, ToNearestAway, 999, -999, false},
+	// mnmx284 min '7' 'NaN'  ->  '7'
+	{"mnmx284", "7", "NaN", "7", 0, 15, ToNearestAway, 999, -999, false},
+	// overflow and underflow tests .. subnormal results [inputs] now allowed
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// mnmx330 min -1.23456789012345E-0 -9E+999999999 -> -9E+999999999
+	{"mnmx330", "-1.23456789012345E-0", "-9E+999999999", "-9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx331 min -9E+999999999 -1.23456789012345E-0 -> -9E+999999999
+	{"mnmx331", "-9E+999999999", "-1.23456789012345E-0", "-9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx332 min -0.100 -9E-999999999               -> -0.100
+	{"mnmx332", "-0.100", "-9E-999999999", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx333 min -9E-999999999 -0.100               -> -0.100
+	{"mnmx333", "-9E-999999999", "-0.100", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx335 min +1.23456789012345E-0 -9E+999999999 -> -9E+999999999
+	{"mnmx335", "+1.23456789012345E-0", "-9E+999999999", "-9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx336 min -9E+999999999 1.23456789012345E-0  -> -9E+999999999
+	{"mnmx336", "-9E+999999999", "1.23456789012345E-0", "-9E+999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx337 min +0.100 -9E-999999999               -> -9E-999999999
+	{"mnmx337", "+0.100", "-9E-999999999", "-9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx338 min -9E-999999999 0.100                -> -9E-999999999
+	{"mnmx338", "-9E-999999999", "0.100", "-9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx339 min -1e-599999999 -1e-400000001   ->  -1E-400000001
+	{"mnmx339", "-1e-599999999", "-1e-400000001", "-1E-400000001", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx340 min -1e-599999999 -1e-400000000   ->  -1E-400000000
+	{"mnmx340", "-1e-599999999", "-1e-400000000", "-1E-400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx341 min -1e-600000000 -1e-400000000   ->  -1E-400000000
+	{"mnmx341", "-1e-600000000", "-1e-400000000", "-1E-400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx342 min -9e-999999998 -0.01           ->  -0.01
+	{"mnmx342", "-9e-999999998", "-0.01", "-0.01", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx343 min -9e-999999998 -0.1            ->  -0.1
+	{"mnmx343", "-9e-999999998", "-0.1", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx344 min -0.01         -9e-999999998   ->  -0.01
+	{"mnmx344", "-0.01", "-9e-999999998", "-0.01", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx345 min -1e599999999  -1e400000001    ->  -1E+599999999
+	{"mnmx345", "-1e599999999", "-1e400000001", "-1E+599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx346 min -1e599999999  -1e400000000    ->  -1E+599999999
+	{"mnmx346", "-1e599999999", "-1e400000000", "-1E+599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx347 min -1e600000000  -1e400000000    ->  -1E+600000000
+	{"mnmx347", "-1e600000000", "-1e400000000", "-1E+600000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx348 min -9e999999998  -100            ->  -9E+999999998
+	{"mnmx348", "-9e999999998", "-100", "-9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx349 min -9e999999998  -10             ->  -9E+999999998
+	{"mnmx349", "-9e999999998", "-10", "-9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx350 min -100          -9e999999998    ->  -9E+999999998
+	{"mnmx350", "-100", "-9e999999998", "-9E+999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// signs
+	// mnmx351 min -1e+777777777 -1e+411111111 -> -1E+777777777
+	{"mnmx351", "-1e+777777777", "-1e+411111111", "-1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx352 min -1e+777777777 +1e+411111111 -> -1E+777777777
+	{"mnmx352", "-1e+777777777", "+1e+411111111", "-1E+777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx353 min +1e+777777777 -1e+411111111 -> -1E+411111111
+	{"mnmx353", "+1e+777777777", "-1e+411111111", "-1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx354 min +1e+777777777 +1e+411111111 ->  1E+411111111
+	{"mnmx354", "+1e+777777777", "+1e+411111111", "1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx355 min -1e-777777777 -1e-411111111 -> -1E-411111111
+	{"mnmx355", "-1e-777777777", "-1e-411111111", "-1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx356 min -1e-777777777 +1e-411111111 -> -1E-777777777
+	{"mnmx356", "-1e-777777777", "+1e-411111111", "-1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx357 min +1e-777777777 -1e-411111111 -> -1E-411111111
+	{"mnmx357", "+1e-777777777", "-1e-411111111", "-1E-411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx358 min +1e-777777777 +1e-411111111 ->  1E-777777777
+	{"mnmx358", "+1e-777777777", "+1e-411111111", "1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// expanded list from min/max 754r purple prose
+	// [explicit tests for exponent ordering]
+	// mnmx401 min  Inf    1.1     ->  1.1
+	{"mnmx401", "Inf", "1.1", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx402 min  1.1    1       ->  1
+	{"mnmx402", "1.1", "1", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx403 min  1      1.0     ->  1.0
+	{"mnmx403", "1", "1.0", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx404 min  1.0    0.1     ->  0.1
+	{"mnmx404", "1.0", "0.1", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx405 min  0.1    0.10    ->  0.10
+	{"mnmx405", "0.1", "0.10", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx406 min  0.10   0.100   ->  0.100
+	{"mnmx406", "0.10", "0.100", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx407 min  0.10   0       ->  0
+	{"mnmx407", "0.10", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx408 min  0      0.0     ->  0.0
+	{"mnmx408", "0", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx409 min  0.0   -0       -> -0
+	{"mnmx409", "0.0", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx410 min  0.0   -0.0     -> -0.0
+	{"mnmx410", "0.0", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx411 min  0.00  -0.0     -> -0.0
+	{"mnmx411", "0.00", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx412 min  0.0   -0.00    -> -0.00
+	{"mnmx412", "0.0", "-0.00", "-0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx413 min  0     -0.0     -> -0.0
+	{"mnmx413", "0", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx414 min  0     -0       -> -0
+	{"mnmx414", "0", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx415 min -0.0   -0       -> -0
+	{"mnmx415", "-0.0", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx416 min -0     -0.100   -> -0.100
+	{"mnmx416", "-0", "-0.100", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx417 min -0.100 -0.10    -> -0.10
+	{"mnmx417", "-0.100", "-0.10", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx418 min -0.10  -0.1     -> -0.1
+	{"mnmx418", "-0.10", "-0.1", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx419 min -0.1   -1.0     -> -1.0
+	{"mnmx419", "-0.1", "-1.0", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx420 min -1.0   -1       -> -1
+	{"mnmx420", "-1.0", "-1", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx421 min -1     -1.1     -> -1.1
+	{"mnmx421", "-1", "-1.1", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx423 min -1.1   -Inf     -> -Infinity
+	{"mnmx423", "-1.1", "-Inf", "-Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// same with operands reversed
+	// mnmx431 min  1.1    Inf     ->  1.1
+	{"mnmx431", "1.1", "Inf", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx432 min  1      1.1     ->  1
+	{"mnmx432", "1", "1.1", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx433 min  1.0    1       ->  1.0
+	{"mnmx433", "1.0", "1", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx434 min  0.1    1.0     ->  0.1
+	{"mnmx434", "0.1", "1.0", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx435 min  0.10   0.1     ->  0.10
+	{"mnmx435", "0.10", "0.1", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx436 min  0.100  0.10    ->  0.100
+	{"mnmx436", "0.100", "0.10", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx437 min  0      0.10    ->  0
+	{"mnmx437", "0", "0.10", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx438 min  0.0    0       ->  0.0
+	{"mnmx438", "0.0", "0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx439 min -0      0.0     -> -0
+	{"mnmx439", "-0", "0.0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx440 min -0.0    0.0     -> -0.0
+	{"mnmx440", "-0.0", "0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx441 min -0.0    0.00    -> -0.0
+	{"mnmx441", "-0.0", "0.00", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx442 min -0.00   0.0     -> -0.00
+	{"mnmx442", "-0.00", "0.0", "-0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx443 min -0.0    0       -> -0.0
+	{"mnmx443", "-0.0", "0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx444 min -0      0       -> -0
+	{"mnmx444", "-0", "0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx445 min -0     -0.0     -> -0
+	{"mnmx445", "-0", "-0.0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx446 min -0.100 -0       -> -0.100
+	{"mnmx446", "-0.100", "-0", "-0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx447 min -0.10  -0.100   -> -0.10
+	{"mnmx447", "-0.10", "-0.100", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx448 min -0.1   -0.10    -> -0.1
+	{"mnmx448", "-0.1", "-0.10", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx449 min -1.0   -0.1     -> -1.0
+	{"mnmx449", "-1.0", "-0.1", "-1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx450 min -1     -1.0     -> -1
+	{"mnmx450", "-1", "-1.0", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx451 min -1.1   -1       -> -1.1
+	{"mnmx451", "-1.1", "-1", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx453 min -Inf   -1.1     -> -Infinity
+	{"mnmx453", "-Inf", "-1.1", "-Inf", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// largies
+	// mnmx460 min  1000   1E+3    ->  1000
+	{"mnmx460", "1000", "1E+3", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx461 min  1E+3   1000    ->  1000
+	{"mnmx461", "1E+3", "1000", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx462 min  1000  -1E+3    -> -1E+3
+	{"mnmx462", "1000", "-1E+3", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx463 min  1E+3  -1000    -> -1000
+	{"mnmx463", "1E+3", "-1000", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx464 min -1000   1E+3    -> -1000
+	{"mnmx464", "-1000", "1E+3", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx465 min -1E+3   1000    -> -1E+3
+	{"mnmx465", "-1E+3", "1000", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx466 min -1000  -1E+3    -> -1E+3
+	{"mnmx466", "-1000", "-1E+3", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mnmx467 min -1E+3  -1000    -> -1E+3
+	{"mnmx467", "-1E+3", "-1000", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// rounding (results treated as though plus)
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// mnmx470 min  1      5      ->  1
+	{"mnmx470", "1", "5", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx471 min  10     50     ->  10
+	{"mnmx471", "10", "50", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx472 min  100    500    ->  100
+	{"mnmx472", "100", "500", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx473 min  1000   5000   ->  1.00E+3 Rounded
+	{"mnmx473", "1000", "5000", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx474 min  10000  50000  ->  1.00E+4 Rounded
+	{"mnmx474", "10000", "50000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx475 min  6      50     ->  6
+	{"mnmx475", "6", "50", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx476 min  66     500    ->  66
+	{"mnmx476", "66", "500", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx477 min  666    5000   ->  666
+	{"mnmx477", "666", "5000", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx478 min  6666   50000  ->  6.67E+3 Rounded Inexact
+	{"mnmx478", "6666", "50000", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx479 min  66666  500000 ->  6.67E+4 Rounded Inexact
+	{"mnmx479", "66666", "500000", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx480 min  33333  500000 ->  3.33E+4 Rounded Inexact
+	{"mnmx480", "33333", "500000", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx481 min  75401  1      ->  1
+	{"mnmx481", "75401", "1", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx482 min  75402  10     ->  10
+	{"mnmx482", "75402", "10", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx483 min  75403  100    ->  100
+	{"mnmx483", "75403", "100", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx484 min  75404  1000   ->  1.00E+3 Rounded
+	{"mnmx484", "75404", "1000", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx485 min  75405  10000  ->  1.00E+4 Rounded
+	{"mnmx485", "75405", "10000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx486 min  75406  6      ->  6
+	{"mnmx486", "75406", "6", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx487 min  75407  66     ->  66
+	{"mnmx487", "75407", "66", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx488 min  75408  666    ->  666
+	{"mnmx488", "75408", "666", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx489 min  75409  6666   ->  6.67E+3 Rounded Inexact
+	{"mnmx489", "75409", "6666", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx490 min  75410  66666  ->  6.67E+4 Rounded Inexact
+	{"mnmx490", "75410", "66666", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx491 min  75411  33333  ->  3.33E+4 Rounded Inexact
+	{"mnmx491", "75411", "33333", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// overflow tests
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// mnmx500 min 9.999E+999999999  0 ->  0
+	{"mnmx500", "9.999E+999999999", "0", "0", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mnmx501 min -9.999E+999999999 0 -> -Infinity Inexact Overflow Rounded
+	{"mnmx501", "-9.999E+999999999", "0", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// subnormals and underflow
+	// precision: 3
+	// maxexponent: 999
+	// minexponent: -999
+	// mnmx510 min  1.00E-999       0  ->   0
+	{"mnmx510", "1.00E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx511 min  0.1E-999        0  ->   0
+	{"mnmx511", "0.1E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx512 min  0.10E-999       0  ->   0
+	{"mnmx512", "0.10E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx513 min  0.100E-999      0  ->   0
+	{"mnmx513", "0.100E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx514 min  0.01E-999       0  ->   0
+	{"mnmx514", "0.01E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx515 min  0.999E-999      0  ->   0
+	{"mnmx515", "0.999E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx516 min  0.099E-999      0  ->   0
+	{"mnmx516", "0.099E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx517 min  0.009E-999      0  ->   0
+	{"mnmx517", "0.009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx518 min  0.001E-999      0  ->   0
+	{"mnmx518", "0.001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx519 min  0.0009E-999     0  ->   0
+	{"mnmx519", "0.0009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx520 min  0.0001E-999     0  ->   0
+	{"mnmx520", "0.0001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx530 min -1.00E-999       0  ->  -1.00E-999
+	{"mnmx530", "-1.00E-999", "0", "-1.00E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// mnmx531 min -0.1E-999        0  ->  -1E-1000   Subnormal
+	{"mnmx531", "-0.1E-999", "0", "-1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// mnmx532 min -0.10E-999       0  ->  -1.0E-1000 Subnormal
+	{"mnmx532", "-0.10E-999", "0", "-1.0E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// mnmx533 min -0.100E-999      0  ->  -1.0E-1000 Subnormal Rounded
+	{"mnmx533", "-0.100E-999", "0", "-1.0E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// mnmx534 min -0.01E-999       0  ->  -1E-1001   Subnormal
+	{"mnmx534", "-0.01E-999", "0", "-1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Nmin
+	// mnmx535 min -0.999E-999      0  ->  -1.00E-999 Inexact Rounded Subnormal Underflow
+	{"mnmx535", "-0.999E-999", "0", "-1.00E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mnmx536 min -0.099E-999      0  ->  -1.0E-1000 Inexact Rounded Subnormal Underflow
+	{"mnmx536", "-0.099E-999", "0", "-1.0E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mnmx537 min -0.009E-999      0  ->  -1E-1001   Inexact Rounded Subnormal Underflow
+	{"mnmx537", "-0.009E-999", "0", "-1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// mnmx538 min -0.001E-999      0  ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mnmx538", "-0.001E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mnmx539 min -0.0009E-999     0  ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mnmx539", "-0.0009E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// mnmx540 min -0.0001E-999     0  ->  -0E-1001   Inexact Rounded Subnormal Underflow Clamped
+	{"mnmx540", "-0.0001E-999", "0", "-0E-1001", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// misalignment traps for little-endian
+	// precision: 9
+	// mnmx551 min      1.0       0.1  -> 0.1
+	{"mnmx551", "1.0", "0.1", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx552 min      0.1       1.0  -> 0.1
+	{"mnmx552", "0.1", "1.0", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx553 min     10.0       0.1  -> 0.1
+	{"mnmx553", "10.0", "0.1", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx554 min      0.1      10.0  -> 0.1
+	{"mnmx554", "0.1", "10.0", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx555 min      100       1.0  -> 1.0
+	{"mnmx555", "100", "1.0", "1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx556 min      1.0       100  -> 1.0
+	{"mnmx556", "1.0", "100", "1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx557 min     1000      10.0  -> 10.0
+	{"mnmx557", "1000", "10.0", "10.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx558 min     10.0      1000  -> 10.0
+	{"mnmx558", "10.0", "1000", "10.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx559 min    10000     100.0  -> 100.0
+	{"mnmx559", "10000", "100.0", "100.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx560 min    100.0     10000  -> 100.0
+	{"mnmx560", "100.0", "10000", "100.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx561 min   100000    1000.0  -> 1000.0
+	{"mnmx561", "100000", "1000.0", "1000.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx562 min   1000.0    100000  -> 1000.0
+	{"mnmx562", "1000.0", "100000", "1000.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx563 min  1000000   10000.0  -> 10000.0
+	{"mnmx563", "1000000", "10000.0", "10000.0", 0, 9, ToNearestAway, 999, -999, false},
+	// mnmx564 min  10000.0   1000000  -> 10000.0
+	{"mnmx564", "10000.0", "1000000", "10000.0", 0, 9, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): mnm900 min 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): mnm901 min  # 10 -> NaN Invalid_operation
+}
@@ -0,0 +1,662 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var minmagTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// we assume that base comparison is tested in compare.decTest, so
+	// these mainly cover special cases and rounding
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks
+	// mngx001 minmag  -2  -2  -> -2
+	{"mngx001", "-2", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx002 minmag  -2  -1  -> -1
+	{"mngx002", "-2", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx003 minmag  -2   0  ->  0
+	{"mngx003", "-2", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx004 minmag  -2   1  ->  1
+	{"mngx004", "-2", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx005 minmag  -2   2  -> -2
+	{"mngx005", "-2", "2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx006 minmag  -1  -2  -> -1
+	{"mngx006", "-1", "-2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx007 minmag  -1  -1  -> -1
+	{"mngx007", "-1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx008 minmag  -1   0  ->  0
+	{"mngx008", "-1", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx009 minmag  -1   1  -> -1
+	{"mngx009", "-1", "1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx010 minmag  -1   2  -> -1
+	{"mngx010", "-1", "2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx011 minmag   0  -2  ->  0
+	{"mngx011", "0", "-2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx012 minmag   0  -1  ->  0
+	{"mngx012", "0", "-1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx013 minmag   0   0  ->  0
+	{"mngx013", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx014 minmag   0   1  ->  0
+	{"mngx014", "0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx015 minmag   0   2  ->  0
+	{"mngx015", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx016 minmag   1  -2  ->  1
+	{"mngx016", "1", "-2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx017 minmag   1  -1  -> -1
+	{"mngx017", "1", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx018 minmag   1   0  ->  0
+	{"mngx018", "1", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx019 minmag   1   1  ->  1
+	{"mngx019", "1", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx020 minmag   1   2  ->  1
+	{"mngx020", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx021 minmag   2  -2  -> -2
+	{"mngx021", "2", "-2", "-2", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx022 minmag   2  -1  -> -1
+	{"mngx022", "2", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx023 minmag   2   0  ->  0
+	{"mngx023", "2", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx025 minmag   2   1  ->  1
+	{"mngx025", "2", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx026 minmag   2   2  ->  2
+	{"mngx026", "2", "2", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// extended zeros
+	// mngx030 minmag   0     0   ->  0
+	{"mngx030", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx031 minmag   0    -0   -> -0
+	{"mngx031", "0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx032 minmag   0    -0.0 -> -0.0
+	{"mngx032", "0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx033 minmag   0     0.0 ->  0.0
+	{"mngx033", "0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx034 minmag  -0     0   -> -0
+	{"mngx034", "-0", "0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx035 minmag  -0    -0   -> -0
+	{"mngx035", "-0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx036 minmag  -0    -0.0 -> -0
+	{"mngx036", "-0", "-0.0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx037 minmag  -0     0.0 -> -0
+	{"mngx037", "-0", "0.0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx038 minmag   0.0   0   ->  0.0
+	{"mngx038", "0.0", "0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx039 minmag   0.0  -0   -> -0
+	{"mngx039", "0.0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx040 minmag   0.0  -0.0 -> -0.0
+	{"mngx040", "0.0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx041 minmag   0.0   0.0 ->  0.0
+	{"mngx041", "0.0", "0.0", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx042 minmag  -0.0   0   -> -0.0
+	{"mngx042", "-0.0", "0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx043 minmag  -0.0  -0   -> -0
+	{"mngx043", "-0.0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx044 minmag  -0.0  -0.0 -> -0.0
+	{"mngx044", "-0.0", "-0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx045 minmag  -0.0   0.0 -> -0.0
+	{"mngx045", "-0.0", "0.0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx046 minmag   0E1  -0E1 -> -0E+1
+	{"mngx046", "0E1", "-0E1", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx047 minmag  -0E1   0E2 -> -0E+1
+	{"mngx047", "-0E1", "0E2", "-0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx048 minmag   0E2   0E1 ->  0E+1
+	{"mngx048", "0E2", "0E1", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx049 minmag   0E1   0E2 ->  0E+1
+	{"mngx049", "0E1", "0E2", "0E+1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx050 minmag  -0E3  -0E2 -> -0E+3
+	{"mngx050", "-0E3", "-0E2", "-0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx051 minmag  -0E2  -0E3 -> -0E+3
+	{"mngx051", "-0E2", "-0E3", "-0E+3", 0, 9, ToNearestAway, 384, -383, false},
+	// Specials
+	// precision: 9
+	// mngx090 minmag  Inf  -Inf   -> -Infinity
+	{"mngx090", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx091 minmag  Inf  -1000  -> -1000
+	{"mngx091", "Inf", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx092 minmag  Inf  -1     -> -1
+	{"mngx092", "Inf", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx093 minmag  Inf  -0     -> -0
+	{"mngx093", "Inf", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx094 minmag  Inf   0     ->  0
+	{"mngx094", "Inf", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx095 minmag  Inf   1     ->  1
+	{"mngx095", "Inf", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx096 minmag  Inf   1000  ->  1000
+	{"mngx096", "Inf", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx097 minmag  Inf   Inf   ->  Infinity
+	{"mngx097", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx098 minmag -1000  Inf   -> -1000
+	{"mngx098", "-1000", "Inf", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx099 minmag -Inf   Inf   -> -Infinity
+	{"mngx099", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx100 minmag -1     Inf   -> -1
+	{"mngx100", "-1", "Inf", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx101 minmag -0     Inf   -> -0
+	{"mngx101", "-0", "Inf", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx102 minmag  0     Inf   ->  0
+	{"mngx102", "0", "Inf", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx103 minmag  1     Inf   ->  1
+	{"mngx103", "1", "Inf", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx104 minmag  1000  Inf   ->  1000
+	{"mngx104", "1000", "Inf", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx105 minmag  Inf   Inf   ->  Infinity
+	{"mngx105", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx120 minmag -Inf  -Inf   -> -Infinity
+	{"mngx120", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx121 minmag -Inf  -1000  -> -1000
+	{"mngx121", "-Inf", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx122 minmag -Inf  -1     -> -1
+	{"mngx122", "-Inf", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx123 minmag -Inf  -0     -> -0
+	{"mngx123", "-Inf", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx124 minmag -Inf   0     ->  0
+	{"mngx124", "-Inf", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx125 minmag -Inf   1     ->  1
+	{"mngx125", "-Inf", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx126 minmag -Inf   1000  ->  1000
+	{"mngx126", "-Inf", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx127 minmag -Inf   Inf   -> -Infinity
+	{"mngx127", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx128 minmag -Inf  -Inf   -> -Infinity
+	{"mngx128", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx129 minmag -1000 -Inf   -> -1000
+	{"mngx129", "-1000", "-Inf", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx130 minmag -1    -Inf   -> -1
+	{"mngx130", "-1", "-Inf", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx131 minmag -0    -Inf   -> -0
+	{"mngx131", "-0", "-Inf", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx132 minmag  0    -Inf   ->  0
+	{"mngx132", "0", "-Inf", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx133 minmag  1    -Inf   ->  1
+	{"mngx133", "1", "-Inf", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx134 minmag  1000 -Inf   ->  1000
+	{"mngx134", "1000", "-Inf", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx135 minmag  Inf  -Inf   -> -Infinity
+	{"mngx135", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// 2004.08.02 754r chooses number over NaN in mixed cases
+	// mngx141 minmag  NaN -Inf    ->  -Infinity
+	{"mngx141", "NaN", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx142 minmag  NaN -1000   ->  -1000
+	{"mngx142", "NaN", "-1000", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx143 minmag  NaN -1      ->  -1
+	{"mngx143", "NaN", "-1", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx144 minmag  NaN -0      ->  -0
+	{"mngx144", "NaN", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx145 minmag  NaN  0      ->  0
+	{"mngx145", "NaN", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx146 minmag  NaN  1      ->  1
+	{"mngx146", "NaN", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx147 minmag  NaN  1000   ->  1000
+	{"mngx147", "NaN", "1000", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx148 minmag  NaN  Inf    ->  Infinity
+	{"mngx148", "NaN", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx149 minmag  NaN  NaN    ->  NaN
+	{"mngx149", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx150 minmag -Inf  NaN    -> -Infinity
+	{"mngx150", "-Inf", "NaN", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx151 minmag -1000 NaN    -> -1000
+	{"mngx151", "-1000", "NaN", "-1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx152 minmag -1   -NaN    -> -1
+	{"mngx152", "-1", "-NaN", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx153 minmag -0    NaN    -> -0
+	{"mngx153", "-0", "NaN", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx154 minmag  0   -NaN    ->  0
+	{"mngx154", "0", "-NaN", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx155 minmag  1    NaN    ->  1
+	{"mngx155", "1", "NaN", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx156 minmag  1000 NaN    ->  1000
+	{"mngx156", "1000", "NaN", "1000", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx157 minmag  Inf  NaN    ->  Infinity
+	{"mngx157", "Inf", "NaN", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx161 minmag  sNaN -Inf   ->  NaN  Invalid_operation
+	{"mngx161", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx162 minmag  sNaN -1000  ->  NaN  Invalid_operation
+	{"mngx162", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx163 minmag  sNaN -1     ->  NaN  Invalid_operation
+	{"mngx163", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx164 minmag  sNaN -0     ->  NaN  Invalid_operation
+	{"mngx164", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx165 minmag -sNaN  0     -> -NaN  Invalid_operation
+	{"mngx165", "-sNaN", "0", "-NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx166 minmag -sNaN  1     -> -NaN  Invalid_operation
+	{"mngx166", "-sNaN", "1", "-NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx167 minmag  sNaN  1000  ->  NaN  Invalid_operation
+	{"mngx167", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx168 minmag  sNaN  NaN   ->  NaN  Invalid_operation
+	{"mngx168", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx169 minmag  sNaN sNaN   ->  NaN  Invalid_operation
+	{"mngx169", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx170 minmag  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mngx170", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx171 minmag -Inf  sNaN   ->  NaN  Invalid_operation
+	{"mngx171", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx172 minmag -1000 sNaN   ->  NaN  Invalid_operation
+	{"mngx172", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx173 minmag -1    sNaN   ->  NaN  Invalid_operation
+	{"mngx173", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx174 minmag -0    sNaN   ->  NaN  Invalid_operation
+	{"mngx174", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx175 minmag  0    sNaN   ->  NaN  Invalid_operation
+	{"mngx175", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx176 minmag  1    sNaN   ->  NaN  Invalid_operation
+	{"mngx176", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx177 minmag  1000 sNaN   ->  NaN  Invalid_operation
+	{"mngx177", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx178 minmag  Inf  sNaN   ->  NaN  Invalid_operation
+	{"mngx178", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx179 minmag  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mngx179", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// mngx181 minmag  NaN9   -Inf   -> -Infinity
+	{"mngx181", "NaN9", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx182 minmag -NaN8    9990  ->  9990
+	{"mngx182", "-NaN8", "9990", "9990", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx183 minmag  NaN71   Inf   ->  Infinity
+	{"mngx183", "NaN71", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx184 minmag  NaN1    NaN54 ->  NaN1
+	{"mngx184", "NaN1", "NaN54", "NaN1", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx185 minmag  NaN22  -NaN53 ->  NaN22
+	{"mngx185", "NaN22", "-NaN53", "NaN22", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx186 minmag -NaN3    NaN6  -> -NaN3
+	{"mngx186", "-NaN3", "NaN6", "-NaN3", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx187 minmag -NaN44   NaN7  -> -NaN44
+	{"mngx187", "-NaN44", "NaN7", "-NaN44", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx188 minmag -Inf     NaN41 -> -Infinity
+	{"mngx188", "-Inf", "NaN41", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx189 minmag -9999   -NaN33 -> -9999
+	{"mngx189", "-9999", "-NaN33", "-9999", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx190 minmag  Inf     NaN2  ->  Infinity
+	{"mngx190", "Inf", "NaN2", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// mngx191 minmag  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"mngx191", "sNaN99", "-Inf", "NaN99", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx192 minmag  sNaN98 -11     ->  NaN98 Invalid_operation
+	{"mngx192", "sNaN98", "-11", "NaN98", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx193 minmag -sNaN97  NaN8   -> -NaN97 Invalid_operation
+	{"mngx193", "-sNaN97", "NaN8", "-NaN97", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx194 minmag  sNaN69 sNaN94  ->  NaN69 Invalid_operation
+	{"mngx194", "sNaN69", "sNaN94", "NaN69", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx195 minmag  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"mngx195", "NaN95", "sNaN93", "NaN93", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx196 minmag -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"mngx196", "-Inf", "sNaN92", "NaN92", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx197 minmag  088    sNaN91  ->  NaN91 Invalid_operation
+	{"mngx197", "088", "sNaN91", "NaN91", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx198 minmag  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	{"mngx198", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// mngx199 minmag  NaN    sNaN86  ->  NaN86 Invalid_operation
+	{"mngx199", "NaN", "sNaN86", "NaN86", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// rounding checks -- chosen is rounded, or not
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// mngx201 minmag -12345678000 1  -> 1
+	{"mngx201", "-12345678000", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx202 minmag 1 -12345678000  -> 1
+	{"mngx202", "1", "-12345678000", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx203 minmag -1234567800  1  -> 1
+	{"mngx203", "-1234567800", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx204 minmag 1 -1234567800   -> 1
+	{"mngx204", "1", "-1234567800", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx205 minmag -1234567890  1  -> 1
+	{"mngx205", "-1234567890", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx206 minmag 1 -1234567890   -> 1
+	{"mngx206", "1", "-1234567890", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx207 minmag -1234567891  1  -> 1
+	{"mngx207", "-1234567891", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx208 minmag 1 -1234567891   -> 1
+	{"mngx208", "1", "-1234567891", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx209 minmag -12345678901 1  -> 1
+	{"mngx209", "-12345678901", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx210 minmag 1 -12345678901  -> 1
+	{"mngx210", "1", "-12345678901", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx211 minmag -1234567896  1  -> 1
+	{"mngx211", "-1234567896", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx212 minmag 1 -1234567896   -> 1
+	{"mngx212", "1", "-1234567896", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx213 minmag 1234567891  1   -> 1
+	{"mngx213", "1234567891", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx214 minmag 1 1234567891    -> 1
+	{"mngx214", "1", "1234567891", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx215 minmag 12345678901 1   -> 1
+	{"mngx215", "12345678901", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx216 minmag 1 12345678901   -> 1
+	{"mngx216", "1", "12345678901", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx217 minmag 1234567896  1   -> 1
+	{"mngx217", "1234567896", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// mngx218 minmag 1 1234567896    -> 1
+	{"mngx218", "1", "1234567896", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// mngx221 minmag -12345678000 1  -> 1
+	{"mngx221", "-12345678000", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx222 minmag 1 -12345678000  -> 1
+	{"mngx222", "1", "-12345678000", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx223 minmag -1234567800  1  -> 1
+	{"mngx223", "-1234567800", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx224 minmag 1 -1234567800   -> 1
+	{"mngx224", "1", "-1234567800", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx225 minmag -1234567890  1  -> 1
+	{"mngx225", "-1234567890", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx226 minmag 1 -1234567890   -> 1
+	{"mngx226", "1", "-1234567890", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx227 minmag -1234567891  1  -> 1
+	{"mngx227", "-1234567891", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx228 minmag 1 -1234567891   -> 1
+	{"mngx228", "1", "-1234567891", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx229 minmag -12345678901 1  -> 1
+	{"mngx229", "-12345678901", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx230 minmag 1 -12345678901  -> 1
+	{"mngx230", "1", "-12345678901", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx231 minmag -1234567896  1  -> 1
+	{"mngx231", "-1234567896", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx232 minmag 1 -1234567896   -> 1
+	{"mngx232", "1", "-1234567896", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx233 minmag 1234567891  1   -> 1
+	{"mngx233", "1234567891", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx234 minmag 1 1234567891    -> 1
+	{"mngx234", "1", "1234567891", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx235 minmag 12345678901 1   -> 1
+	{"mngx235", "12345678901", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx236 minmag 1 12345678901   -> 1
+	{"mngx236", "1", "12345678901", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx237 minmag 1234567896  1   -> 1
+	{"mngx237", "1234567896", "1", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx238 minmag 1 1234567896    -> 1
+	{"mngx238", "1", "1234567896", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// from examples
+	// mngx280 minmag '3'   '2'  ->  '2'
+	{"mngx280", "3", "2", "2", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx281 minmag '-10' '3'  ->  '3'
+	{"mngx281", "-10", "3", "3", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx282 minmag '1.0' '1'  ->  '1.0'
+	{"mngx282", "1.0", "1", "1.0", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx283 minmag '1' '1.0'  ->  '1.0'
+	{"mngx283", "1", "1.0", "1.0", 0, 15, ToNearestAway, 999, -999, false},
+	// mngx284 minmag '7' 'NaN'  ->  '7'
+	{"mngx284", "7", "NaN", "7", 0, 15, ToNearestAway, 999, -999, false},
+	// overflow and underflow tests .. subnormal results [inputs] now allowed
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// mngx330 minmag -1.23456789012345E-0 -9E+999999999 -> -1.23456789012345
+	{"mngx330", "-1.23456789012345E-0", "-9E+999999999", "-1.23456789012345", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx331 minmag -9E+999999999 -1.23456789012345E-0 -> -1.23456789012345
+	{"mngx331", "-9E+999999999", "-1.23456789012345E-0", "-1.23456789012345", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx332 minmag -0.100 -9E-999999999               -> -9E-999999999
+	{"mngx332", "-0.100", "-9E-999999999", "-9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx333 minmag -9E-999999999 -0.100               -> -9E-999999999
+	{"mngx333", "-9E-999999999", "-0.100", "-9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx335 minmag +1.23456789012345E-0 -9E+999999999 ->  1.23456789012345
+	{"mngx335", "+1.23456789012345E-0", "-9E+999999999", "1.23456789012345", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx336 minmag -9E+999999999 1.23456789012345E-0  ->  1.23456789012345
+	{"mngx336", "-9E+999999999", "1.23456789012345E-0", "1.23456789012345", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx337 minmag +0.100 -9E-999999999               -> -9E-999999999
+	{"mngx337", "+0.100", "-9E-999999999", "-9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx338 minmag -9E-999999999 0.100                -> -9E-999999999
+	{"mngx338", "-9E-999999999", "0.100", "-9E-999999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx339 minmag -1e-599999999 -1e-400000001   ->  -1E-599999999
+	{"mngx339", "-1e-599999999", "-1e-400000001", "-1E-599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx340 minmag -1e-599999999 -1e-400000000   ->  -1E-599999999
+	{"mngx340", "-1e-599999999", "-1e-400000000", "-1E-599999999", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx341 minmag -1e-600000000 -1e-400000000   ->  -1E-600000000
+	{"mngx341", "-1e-600000000", "-1e-400000000", "-1E-600000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx342 minmag -9e-999999998 -0.01           ->  -9E-999999998
+	{"mngx342", "-9e-999999998", "-0.01", "-9E-999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx343 minmag -9e-999999998 -0.1            ->  -9E-999999998
+	{"mngx343", "-9e-999999998", "-0.1", "-9E-999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx344 minmag -0.01         -9e-999999998   ->  -9E-999999998
+	{"mngx344", "-0.01", "-9e-999999998", "-9E-999999998", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx345 minmag -1e599999999  -1e400000001    ->  -1E+400000001
+	{"mngx345", "-1e599999999", "-1e400000001", "-1E+400000001", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx346 minmag -1e599999999  -1e400000000    ->  -1E+400000000
+	{"mngx346", "-1e599999999", "-1e400000000", "-1E+400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx347 minmag -1e600000000  -1e400000000    ->  -1E+400000000
+	{"mngx347", "-1e600000000", "-1e400000000", "-1E+400000000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx348 minmag -9e999999998  -100            ->  -100
+	{"mngx348", "-9e999999998", "-100", "-100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx349 minmag -9e999999998  -10             ->  -10
+	{"mngx349", "-9e999999998", "-10", "-10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx350 minmag -100          -9e999999998    ->  -100
+	{"mngx350", "-100", "-9e999999998", "-100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// signs
+	// mngx351 minmag -1e+777777777 -1e+411111111 -> -1E+411111111
+	{"mngx351", "-1e+777777777", "-1e+411111111", "-1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx352 minmag -1e+777777777 +1e+411111111 ->  1E+411111111
+	{"mngx352", "-1e+777777777", "+1e+411111111", "1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx353 minmag +1e+777777777 -1e+411111111 -> -1E+411111111
+	{"mngx353", "+1e+777777777", "-1e+411111111", "-1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx354 minmag +1e+777777777 +1e+411111111 ->  1E+411111111
+	{"mngx354", "+1e+777777777", "+1e+411111111", "1E+411111111", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx355 minmag -1e-777777777 -1e-411111111 -> -1E-777777777
+	{"mngx355", "-1e-777777777", "-1e-411111111", "-1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx356 minmag -1e-777777777 +1e-411111111 -> -1E-777777777
+	{"mngx356", "-1e-777777777", "+1e-411111111", "-1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx357 minmag +1e-777777777 -1e-411111111 ->  1E-777777777
+	{"mngx357", "+1e-777777777", "-1e-411111111", "1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx358 minmag +1e-777777777 +1e-411111111 ->  1E-777777777
+	{"mngx358", "+1e-777777777", "+1e-411111111", "1E-777777777", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// expanded list from min/max 754r purple prose
+	// [explicit tests for exponent ordering]
+	// mngx401 minmag  Inf    1.1     ->  1.1
+	{"mngx401", "Inf", "1.1", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx402 minmag  1.1    1       ->  1
+	{"mngx402", "1.1", "1", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx403 minmag  1      1.0     ->  1.0
+	{"mngx403", "1", "1.0", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx404 minmag  1.0    0.1     ->  0.1
+	{"mngx404", "1.0", "0.1", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx405 minmag  0.1    0.10    ->  0.10
+	{"mngx405", "0.1", "0.10", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx406 minmag  0.10   0.100   ->  0.100
+	{"mngx406", "0.10", "0.100", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx407 minmag  0.10   0       ->  0
+	{"mngx407", "0.10", "0", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx408 minmag  0      0.0     ->  0.0
+	{"mngx408", "0", "0.0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx409 minmag  0.0   -0       -> -0
+	{"mngx409", "0.0", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx410 minmag  0.0   -0.0     -> -0.0
+	{"mngx410", "0.0", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx411 minmag  0.00  -0.0     -> -0.0
+	{"mngx411", "0.00", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx412 minmag  0.0   -0.00    -> -0.00
+	{"mngx412", "0.0", "-0.00", "-0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx413 minmag  0     -0.0     -> -0.0
+	{"mngx413", "0", "-0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx414 minmag  0     -0       -> -0
+	{"mngx414", "0", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx415 minmag -0.0   -0       -> -0
+	{"mngx415", "-0.0", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx416 minmag -0     -0.100   -> -0
+	{"mngx416", "-0", "-0.100", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx417 minmag -0.100 -0.10    -> -0.10
+	{"mngx417", "-0.100", "-0.10", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx418 minmag -0.10  -0.1     -> -0.1
+	{"mngx418", "-0.10", "-0.1", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx419 minmag -0.1   -1.0     -> -0.1
+	{"mngx419", "-0.1", "-1.0", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx420 minmag -1.0   -1       -> -1
+	{"mngx420", "-1.0", "-1", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx421 minmag -1     -1.1     -> -1
+	{"mngx421", "-1", "-1.1", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx423 minmag -1.1   -Inf     -> -1.1
+	{"mngx423", "-1.1", "-Inf", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// same with operands reversed
+	// mngx431 minmag  1.1    Inf     ->  1.1
+	{"mngx431", "1.1", "Inf", "1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx432 minmag  1      1.1     ->  1
+	{"mngx432", "1", "1.1", "1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx433 minmag  1.0    1       ->  1.0
+	{"mngx433", "1.0", "1", "1.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx434 minmag  0.1    1.0     ->  0.1
+	{"mngx434", "0.1", "1.0", "0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx435 minmag  0.10   0.1     ->  0.10
+	{"mngx435", "0.10", "0.1", "0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx436 minmag  0.100  0.10    ->  0.100
+	{"mngx436", "0.100", "0.10", "0.100", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx437 minmag  0      0.10    ->  0
+	{"mngx437", "0", "0.10", "0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx438 minmag  0.0    0       ->  0.0
+	{"mngx438", "0.0", "0", "0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx439 minmag -0      0.0     -> -0
+	{"mngx439", "-0", "0.0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx440 minmag -0.0    0.0     -> -0.0
+	{"mngx440", "-0.0", "0.0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx441 minmag -0.0    0.00    -> -0.0
+	{"mngx441", "-0.0", "0.00", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx442 minmag -0.00   0.0     -> -0.00
+	{"mngx442", "-0.00", "0.0", "-0.00", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx443 minmag -0.0    0       -> -0.0
+	{"mngx443", "-0.0", "0", "-0.0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx444 minmag -0      0       -> -0
+	{"mngx444", "-0", "0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx445 minmag -0     -0.0     -> -0
+	{"mngx445", "-0", "-0.0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx446 minmag -0.100 -0       -> -0
+	{"mngx446", "-0.100", "-0", "-0", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx447 minmag -0.10  -0.100   -> -0.10
+	{"mngx447", "-0.10", "-0.100", "-0.10", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx448 minmag -0.1   -0.10    -> -0.1
+	{"mngx448", "-0.1", "-0.10", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx449 minmag -1.0   -0.1     -> -0.1
+	{"mngx449", "-1.0", "-0.1", "-0.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx450 minmag -1     -1.0     -> -1
+	{"mngx450", "-1", "-1.0", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx451 minmag -1.1   -1       -> -1
+	{"mngx451", "-1.1", "-1", "-1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx453 minmag -Inf   -1.1     -> -1.1
+	{"mngx453", "-Inf", "-1.1", "-1.1", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// largies
+	// mngx460 minmag  1000   1E+3    ->  1000
+	{"mngx460", "1000", "1E+3", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx461 minmag  1E+3   1000    ->  1000
+	{"mngx461", "1E+3", "1000", "1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx462 minmag  1000  -1E+3    -> -1E+3
+	{"mngx462", "1000", "-1E+3", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx463 minmag  1E+3  -1000    -> -1000
+	{"mngx463", "1E+3", "-1000", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx464 minmag -1000   1E+3    -> -1000
+	{"mngx464", "-1000", "1E+3", "-1000", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx465 minmag -1E+3   1000    -> -1E+3
+	{"mngx465", "-1E+3", "1000", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx466 minmag -1000  -1E+3    -> -1E+3
+	{"mngx466", "-1000", "-1E+3", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// mngx467 minmag -1E+3  -1000    -> -1E+3
+	{"mngx467", "-1E+3", "-1000", "-1E+3", 0, 15, ToNearestAway, 999999999, -999999999, false},
+	// rounding (results treated as though plus)
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// mngx470 minmag  1      5      ->  1
+	{"mngx470", "1", "5", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx471 minmag  10     50     ->  10
+	{"mngx471", "10", "50", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx472 minmag  100    500    ->  100
+	{"mngx472", "100", "500", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx473 minmag  1000   5000   ->  1.00E+3 Rounded
+	{"mngx473", "1000", "5000", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx474 minmag  10000  50000  ->  1.00E+4 Rounded
+	{"mngx474", "10000", "50000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx475 minmag  6      50     ->  6
+	{"mngx475", "6", "50", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx476 minmag  66     500    ->  66
+	{"mngx476", "66", "500", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx477 minmag  666    5000   ->  666
+	{"mngx477", "666", "5000", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx478 minmag  6666   50000  ->  6.67E+3 Rounded Inexact
+	{"mngx478", "6666", "50000", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx479 minmag  66666  500000 ->  6.67E+4 Rounded Inexact
+	{"mngx479", "66666", "500000", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx480 minmag  33333  500000 ->  3.33E+4 Rounded Inexact
+	{"mngx480", "33333", "500000", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx481 minmag  75401  1      ->  1
+	{"mngx481", "75401", "1", "1", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx482 minmag  75402  10     ->  10
+	{"mngx482", "75402", "10", "10", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx483 minmag  75403  100    ->  100
+	{"mngx483", "75403", "100", "100", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx484 minmag  75404  1000   ->  1.00E+3 Rounded
+	{"mngx484", "75404", "1000", "1.00E+3", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx485 minmag  75405  10000  ->  1.00E+4 Rounded
+	{"mngx485", "75405", "10000", "1.00E+4", Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx486 minmag  75406  6      ->  6
+	{"mngx486", "75406", "6", "6", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx487 minmag  75407  66     ->  66
+	{"mngx487", "75407", "66", "66", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx488 minmag  75408  666    ->  666
+	{"mngx488", "75408", "666", "666", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx489 minmag  75409  6666   ->  6.67E+3 Rounded Inexact
+	{"mngx489", "75409", "6666", "6.67E+3", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx490 minmag  75410  66666  ->  6.67E+4 Rounded Inexact
+	{"mngx490", "75410", "66666", "6.67E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx491 minmag  75411  33333  ->  3.33E+4 Rounded Inexact
+	{"mngx491", "75411", "33333", "3.33E+4", Rounded | Inexact, 3, ToNearestAway, 999999999, -999999999, false},
+	// overflow tests
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// mngx500 minmag 9.999E+999999999  0 ->  0
+	{"mngx500", "9.999E+999999999", "0", "0", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// mngx501 minmag -9.999E+999999999 0 ->  0
+	{"mngx501", "-9.999E+999999999", "0", "0", 0, 3, ToNearestAway, 999999999, -999999999, false},
+	// subnormals and underflow
+	// precision: 3
+	// maxexponent: 999
+	// minexponent: -999
+	// mngx510 minmag  1.00E-999       0  ->   0
+	{"mngx510", "1.00E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx511 minmag  0.1E-999        0  ->   0
+	{"mngx511", "0.1E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx512 minmag  0.10E-999       0  ->   0
+	{"mngx512", "0.10E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx513 minmag  0.100E-999      0  ->   0
+	{"mngx513", "0.100E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx514 minmag  0.01E-999       0  ->   0
+	{"mngx514", "0.01E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx515 minmag  0.999E-999      0  ->   0
+	{"mngx515", "0.999E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx516 minmag  0.099E-999      0  ->   0
+	{"mngx516", "0.099E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx517 minmag  0.009E-999      0  ->   0
+	{"mngx517", "0.009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx518 minmag  0.001E-999      0  ->   0
+	{"mngx518", "0.001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx519 minmag  0.0009E-999     0  ->   0
+	{"mngx519", "0.0009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx520 minmag  0.0001E-999     0  ->   0
+	{"mngx520", "0.0001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx530 minmag -1.00E-999       0  ->   0
+	{"mngx530", "-1.00E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx531 minmag -0.1E-999        0  ->   0
+	{"mngx531", "-0.1E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx532 minmag -0.10E-999       0  ->   0
+	{"mngx532", "-0.10E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx533 minmag -0.100E-999      0  ->   0
+	{"mngx533", "-0.100E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx534 minmag -0.01E-999       0  ->   0
+	{"mngx534", "-0.01E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx535 minmag -0.999E-999      0  ->   0
+	{"mngx535", "-0.999E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx536 minmag -0.099E-999      0  ->   0
+	{"mngx536", "-0.099E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx537 minmag -0.009E-999      0  ->   0
+	{"mngx537", "-0.009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx538 minmag -0.001E-999      0  ->   0
+	{"mngx538", "-0.001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx539 minmag -0.0009E-999     0  ->   0
+	{"mngx539", "-0.0009E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// mngx540 minmag -0.0001E-999     0  ->   0
+	{"mngx540", "-0.0001E-999", "0", "0", 0, 3, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): mng900 minmag 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): mng901 minmag  # 10 -> NaN Invalid_operation
+}
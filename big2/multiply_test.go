@@ -0,0 +1,1189 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var multiplyTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks (as base, above)
+	// mulx000 multiply 2      2 -> 4
+	{"mulx000", "2", "2", "4", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx001 multiply 2      3 -> 6
+	{"mulx001", "2", "3", "6", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx002 multiply 5      1 -> 5
+	{"mulx002", "5", "1", "5", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx003 multiply 5      2 -> 10
+	{"mulx003", "5", "2", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx004 multiply 1.20   2 -> 2.40
+	{"mulx004", "1.20", "2", "2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx005 multiply 1.20   0 -> 0.00
+	{"mulx005", "1.20", "0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx006 multiply 1.20  -2 -> -2.40
+	{"mulx006", "1.20", "-2", "-2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx007 multiply -1.20  2 -> -2.40
+	{"mulx007", "-1.20", "2", "-2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx008 multiply -1.20  0 -> -0.00
+	{"mulx008", "-1.20", "0", "-0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx009 multiply -1.20 -2 -> 2.40
+	{"mulx009", "-1.20", "-2", "2.40", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx010 multiply 5.09 7.1 -> 36.139
+	{"mulx010", "5.09", "7.1", "36.139", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx011 multiply 2.5    4 -> 10.0
+	{"mulx011", "2.5", "4", "10.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx012 multiply 2.50   4 -> 10.00
+	{"mulx012", "2.50", "4", "10.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx013 multiply 1.23456789 1.00000000 -> 1.23456789 Rounded
+	{"mulx013", "1.23456789", "1.00000000", "1.23456789", Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx014 multiply 9.999999999 9.999999999 -> 100.000000 Inexact Rounded
+	{"mulx014", "9.999999999", "9.999999999", "100.000000", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx015 multiply 2.50   4 -> 10.00
+	{"mulx015", "2.50", "4", "10.00", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// mulx016 multiply 2.50   4 -> 10.00
+	{"mulx016", "2.50", "4", "10.00", 0, 6, ToNearestAway, 384, -383, false},
+	// mulx017 multiply  9.999999999  9.999999999 ->  100.000 Inexact Rounded
+	{"mulx017", "9.999999999", "9.999999999", "100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// mulx018 multiply  9.999999999 -9.999999999 -> -100.000 Inexact Rounded
+	{"mulx018", "9.999999999", "-9.999999999", "-100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// mulx019 multiply -9.999999999  9.999999999 -> -100.000 Inexact Rounded
+	{"mulx019", "-9.999999999", "9.999999999", "-100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// mulx020 multiply -9.999999999 -9.999999999 ->  100.000 Inexact Rounded
+	{"mulx020", "-9.999999999", "-9.999999999", "100.000", Inexact | Rounded, 6, ToNearestAway, 384, -383, false},
+	// 1999.12.21: next one is an edge case if intermediate longs are used
+	// precision: 15
+	// mulx059 multiply 999999999999 9765625 -> 9.76562499999023E+18 Inexact Rounded
+	{"mulx059", "999999999999", "9765625", "9.76562499999023E+18", Inexact | Rounded, 15, ToNearestAway, 384, -383, false},
+	// precision: 30
+	// mulx160 multiply 999999999999 9765625 -> 9765624999990234375
+	{"mulx160", "999999999999", "9765625", "9765624999990234375", 0, 30, ToNearestAway, 384, -383, false},
+	// precision: 9
+	//---
+	// zeros, etc.
+	// mulx021 multiply  0      0     ->  0
+	{"mulx021", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx022 multiply  0     -0     -> -0
+	{"mulx022", "0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx023 multiply -0      0     -> -0
+	{"mulx023", "-0", "0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx024 multiply -0     -0     ->  0
+	{"mulx024", "-0", "-0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx025 multiply -0.0   -0.0   ->  0.00
+	{"mulx025", "-0.0", "-0.0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx026 multiply -0.0   -0.0   ->  0.00
+	{"mulx026", "-0.0", "-0.0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx027 multiply -0.0   -0.0   ->  0.00
+	{"mulx027", "-0.0", "-0.0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx028 multiply -0.0   -0.0   ->  0.00
+	{"mulx028", "-0.0", "-0.0", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx030 multiply  5.00   1E-3  ->  0.00500
+	{"mulx030", "5.00", "1E-3", "0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx031 multiply  00.00  0.000 ->  0.00000
+	{"mulx031", "00.00", "0.000", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx032 multiply  00.00  0E-3  ->  0.00000     -- rhs is 0
+	{"mulx032", "00.00", "0E-3", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx033 multiply  0E-3   00.00 ->  0.00000     -- lhs is 0
+	{"mulx033", "0E-3", "00.00", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx034 multiply -5.00   1E-3  -> -0.00500
+	{"mulx034", "-5.00", "1E-3", "-0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx035 multiply -00.00  0.000 -> -0.00000
+	{"mulx035", "-00.00", "0.000", "-0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx036 multiply -00.00  0E-3  -> -0.00000     -- rhs is 0
+	{"mulx036", "-00.00", "0E-3", "-0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx037 multiply -0E-3   00.00 -> -0.00000     -- lhs is 0
+	{"mulx037", "-0E-3", "00.00", "-0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx038 multiply  5.00  -1E-3  -> -0.00500
+	{"mulx038", "5.00", "-1E-3", "-0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx039 multiply  00.00 -0.000 -> -0.00000
+	{"mulx039", "00.00", "-0.000", "-0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx040 multiply  00.00 -0E-3  -> -0.00000     -- rhs is 0
+	{"mulx040", "00.00", "-0E-3", "-0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx041 multiply  0E-3  -00.00 -> -0.00000     -- lhs is 0
+	{"mulx041", "0E-3", "-00.00", "-0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx042 multiply -5.00  -1E-3  ->  0.00500
+	{"mulx042", "-5.00", "-1E-3", "0.00500", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx043 multiply -00.00 -0.000 ->  0.00000
+	{"mulx043", "-00.00", "-0.000", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx044 multiply -00.00 -0E-3  ->  0.00000     -- rhs is 0
+	{"mulx044", "-00.00", "-0E-3", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx045 multiply -0E-3  -00.00 ->  0.00000     -- lhs is 0
+	{"mulx045", "-0E-3", "-00.00", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// examples from decarith
+	// mulx050 multiply 1.20 3        -> 3.60
+	{"mulx050", "1.20", "3", "3.60", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx051 multiply 7    3        -> 21
+	{"mulx051", "7", "3", "21", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx052 multiply 0.9  0.8      -> 0.72
+	{"mulx052", "0.9", "0.8", "0.72", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx053 multiply 0.9  -0       -> -0.0
+	{"mulx053", "0.9", "-0", "-0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx054 multiply 654321 654321 -> 4.28135971E+11  Inexact Rounded
+	{"mulx054", "654321", "654321", "4.28135971E+11", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx060 multiply 123.45 1e7  ->  1.2345E+9
+	{"mulx060", "123.45", "1e7", "1.2345E+9", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx061 multiply 123.45 1e8  ->  1.2345E+10
+	{"mulx061", "123.45", "1e8", "1.2345E+10", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx062 multiply 123.45 1e+9 ->  1.2345E+11
+	{"mulx062", "123.45", "1e+9", "1.2345E+11", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx063 multiply 123.45 1e10 ->  1.2345E+12
+	{"mulx063", "123.45", "1e10", "1.2345E+12", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx064 multiply 123.45 1e11 ->  1.2345E+13
+	{"mulx064", "123.45", "1e11", "1.2345E+13", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx065 multiply 123.45 1e12 ->  1.2345E+14
+	{"mulx065", "123.45", "1e12", "1.2345E+14", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx066 multiply 123.45 1e13 ->  1.2345E+15
+	{"mulx066", "123.45", "1e13", "1.2345E+15", 0, 9, ToNearestAway, 384, -383, false},
+	// test some intermediate lengths
+	// precision: 9
+	// mulx080 multiply 0.1 123456789          -> 12345678.9
+	{"mulx080", "0.1", "123456789", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx081 multiply 0.1 1234567891         -> 123456789 Inexact Rounded
+	{"mulx081", "0.1", "1234567891", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx082 multiply 0.1 12345678912        -> 1.23456789E+9 Inexact Rounded
+	{"mulx082", "0.1", "12345678912", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx083 multiply 0.1 12345678912345     -> 1.23456789E+12 Inexact Rounded
+	{"mulx083", "0.1", "12345678912345", "1.23456789E+12", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx084 multiply 0.1 123456789          -> 12345678.9
+	{"mulx084", "0.1", "123456789", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 8
+	// mulx085 multiply 0.1 12345678912        -> 1.2345679E+9 Inexact Rounded
+	{"mulx085", "0.1", "12345678912", "1.2345679E+9", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// mulx086 multiply 0.1 12345678912345     -> 1.2345679E+12 Inexact Rounded
+	{"mulx086", "0.1", "12345678912345", "1.2345679E+12", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// precision: 7
+	// mulx087 multiply 0.1 12345678912        -> 1.234568E+9 Inexact Rounded
+	{"mulx087", "0.1", "12345678912", "1.234568E+9", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// mulx088 multiply 0.1 12345678912345     -> 1.234568E+12 Inexact Rounded
+	{"mulx088", "0.1", "12345678912345", "1.234568E+12", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// mulx090 multiply 123456789          0.1 -> 12345678.9
+	{"mulx090", "123456789", "0.1", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// mulx091 multiply 1234567891         0.1 -> 123456789 Inexact Rounded
+	{"mulx091", "1234567891", "0.1", "123456789", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx092 multiply 12345678912        0.1 -> 1.23456789E+9 Inexact Rounded
+	{"mulx092", "12345678912", "0.1", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx093 multiply 12345678912345     0.1 -> 1.23456789E+12 Inexact Rounded
+	{"mulx093", "12345678912345", "0.1", "1.23456789E+12", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// mulx094 multiply 123456789          0.1 -> 12345678.9
+	{"mulx094", "123456789", "0.1", "12345678.9", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 8
+	// mulx095 multiply 12345678912        0.1 -> 1.2345679E+9 Inexact Rounded
+	{"mulx095", "12345678912", "0.1", "1.2345679E+9", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// mulx096 multiply 12345678912345     0.1 -> 1.2345679E+12 Inexact Rounded
+	{"mulx096", "12345678912345", "0.1", "1.2345679E+12", Inexact | Rounded, 8, ToNearestAway, 384, -383, false},
+	// precision: 7
+	// mulx097 multiply 12345678912        0.1 -> 1.234568E+9 Inexact Rounded
+	{"mulx097", "12345678912", "0.1", "1.234568E+9", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// mulx098 multiply 12345678912345     0.1 -> 1.234568E+12 Inexact Rounded
+	{"mulx098", "12345678912345", "0.1", "1.234568E+12", Inexact | Rounded, 7, ToNearestAway, 384, -383, false},
+	// test some more edge cases and carries
+	// maxexponent: 9999
+	// minexponent: -9999
+	// precision: 33
+	// mulx101 multiply 9 9   -> 81
+	{"mulx101", "9", "9", "81", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx102 multiply 9 90   -> 810
+	{"mulx102", "9", "90", "810", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx103 multiply 9 900   -> 8100
+	{"mulx103", "9", "900", "8100", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx104 multiply 9 9000   -> 81000
+	{"mulx104", "9", "9000", "81000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx105 multiply 9 90000   -> 810000
+	{"mulx105", "9", "90000", "810000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx106 multiply 9 900000   -> 8100000
+	{"mulx106", "9", "900000", "8100000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx107 multiply 9 9000000   -> 81000000
+	{"mulx107", "9", "9000000", "81000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx108 multiply 9 90000000   -> 810000000
+	{"mulx108", "9", "90000000", "810000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx109 multiply 9 900000000   -> 8100000000
+	{"mulx109", "9", "900000000", "8100000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx110 multiply 9 9000000000   -> 81000000000
+	{"mulx110", "9", "9000000000", "81000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx111 multiply 9 90000000000   -> 810000000000
+	{"mulx111", "9", "90000000000", "810000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx112 multiply 9 900000000000   -> 8100000000000
+	{"mulx112", "9", "900000000000", "8100000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx113 multiply 9 9000000000000   -> 81000000000000
+	{"mulx113", "9", "9000000000000", "81000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx114 multiply 9 90000000000000   -> 810000000000000
+	{"mulx114", "9", "90000000000000", "810000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx115 multiply 9 900000000000000   -> 8100000000000000
+	{"mulx115", "9", "900000000000000", "8100000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx116 multiply 9 9000000000000000   -> 81000000000000000
+	{"mulx116", "9", "9000000000000000", "81000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx117 multiply 9 90000000000000000   -> 810000000000000000
+	{"mulx117", "9", "90000000000000000", "810000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx118 multiply 9 900000000000000000   -> 8100000000000000000
+	{"mulx118", "9", "900000000000000000", "8100000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx119 multiply 9 9000000000000000000   -> 81000000000000000000
+	{"mulx119", "9", "9000000000000000000", "81000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx120 multiply 9 90000000000000000000   -> 810000000000000000000
+	{"mulx120", "9", "90000000000000000000", "810000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx121 multiply 9 900000000000000000000   -> 8100000000000000000000
+	{"mulx121", "9", "900000000000000000000", "8100000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx122 multiply 9 9000000000000000000000   -> 81000000000000000000000
+	{"mulx122", "9", "9000000000000000000000", "81000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx123 multiply 9 90000000000000000000000   -> 810000000000000000000000
+	{"mulx123", "9", "90000000000000000000000", "810000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// test some more edge cases without carries
+	// mulx131 multiply 3 3   -> 9
+	{"mulx131", "3", "3", "9", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx132 multiply 3 30   -> 90
+	{"mulx132", "3", "30", "90", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx133 multiply 3 300   -> 900
+	{"mulx133", "3", "300", "900", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx134 multiply 3 3000   -> 9000
+	{"mulx134", "3", "3000", "9000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx135 multiply 3 30000   -> 90000
+	{"mulx135", "3", "30000", "90000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx136 multiply 3 300000   -> 900000
+	{"mulx136", "3", "300000", "900000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx137 multiply 3 3000000   -> 9000000
+	{"mulx137", "3", "3000000", "9000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx138 multiply 3 30000000   -> 90000000
+	{"mulx138", "3", "30000000", "90000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx139 multiply 3 300000000   -> 900000000
+	{"mulx139", "3", "300000000", "900000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx140 multiply 3 3000000000   -> 9000000000
+	{"mulx140", "3", "3000000000", "9000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx141 multiply 3 30000000000   -> 90000000000
+	{"mulx141", "3", "30000000000", "90000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx142 multiply 3 300000000000   -> 900000000000
+	{"mulx142", "3", "300000000000", "900000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx143 multiply 3 3000000000000   -> 9000000000000
+	{"mulx143", "3", "3000000000000", "9000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx144 multiply 3 30000000000000   -> 90000000000000
+	{"mulx144", "3", "30000000000000", "90000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx145 multiply 3 300000000000000   -> 900000000000000
+	{"mulx145", "3", "300000000000000", "900000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx146 multiply 3 3000000000000000   -> 9000000000000000
+	{"mulx146", "3", "3000000000000000", "9000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx147 multiply 3 30000000000000000   -> 90000000000000000
+	{"mulx147", "3", "30000000000000000", "90000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx148 multiply 3 300000000000000000   -> 900000000000000000
+	{"mulx148", "3", "300000000000000000", "900000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx149 multiply 3 3000000000000000000   -> 9000000000000000000
+	{"mulx149", "3", "3000000000000000000", "9000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx150 multiply 3 30000000000000000000   -> 90000000000000000000
+	{"mulx150", "3", "30000000000000000000", "90000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx151 multiply 3 300000000000000000000   -> 900000000000000000000
+	{"mulx151", "3", "300000000000000000000", "900000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx152 multiply 3 3000000000000000000000   -> 9000000000000000000000
+	{"mulx152", "3", "3000000000000000000000", "9000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// mulx153 multiply 3 30000000000000000000000   -> 90000000000000000000000
+	{"mulx153", "3", "30000000000000000000000", "90000000000000000000000", 0, 33, ToNearestAway, 9999, -9999, false},
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 9
+	// test some cases that are close to exponent overflow/underflow
+	// mulx170 multiply 1 9e999999999    -> 9E+999999999
+	{"mulx170", "1", "9e999999999", "9E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx171 multiply 1 9.9e999999999  -> 9.9E+999999999
+	{"mulx171", "1", "9.9e999999999", "9.9E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx172 multiply 1 9.99e999999999 -> 9.99E+999999999
+	{"mulx172", "1", "9.99e999999999", "9.99E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx173 multiply 9e999999999    1 -> 9E+999999999
+	{"mulx173", "9e999999999", "1", "9E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx174 multiply 9.9e999999999  1 -> 9.9E+999999999
+	{"mulx174", "9.9e999999999", "1", "9.9E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx176 multiply 9.99e999999999 1 -> 9.99E+999999999
+	{"mulx176", "9.99e999999999", "1", "9.99E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx177 multiply 1 9.99999999e999999999 -> 9.99999999E+999999999
+	{"mulx177", "1", "9.99999999e999999999", "9.99999999E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx178 multiply 9.99999999e999999999 1 -> 9.99999999E+999999999
+	{"mulx178", "9.99999999e999999999", "1", "9.99999999E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx180 multiply 0.1 9e-999999998   -> 9E-999999999
+	{"mulx180", "0.1", "9e-999999998", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx181 multiply 0.1 99e-999999998  -> 9.9E-999999998
+	{"mulx181", "0.1", "99e-999999998", "9.9E-999999998", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx182 multiply 0.1 999e-999999998 -> 9.99E-999999997
+	{"mulx182", "0.1", "999e-999999998", "9.99E-999999997", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx183 multiply 0.1 9e-999999998     -> 9E-999999999
+	{"mulx183", "0.1", "9e-999999998", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx184 multiply 0.1 99e-999999998    -> 9.9E-999999998
+	{"mulx184", "0.1", "99e-999999998", "9.9E-999999998", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx185 multiply 0.1 999e-999999998   -> 9.99E-999999997
+	{"mulx185", "0.1", "999e-999999998", "9.99E-999999997", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx186 multiply 0.1 999e-999999997   -> 9.99E-999999996
+	{"mulx186", "0.1", "999e-999999997", "9.99E-999999996", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx187 multiply 0.1 9999e-999999997  -> 9.999E-999999995
+	{"mulx187", "0.1", "9999e-999999997", "9.999E-999999995", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx188 multiply 0.1 99999e-999999997 -> 9.9999E-999999994
+	{"mulx188", "0.1", "99999e-999999997", "9.9999E-999999994", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx190 multiply 1 9e-999999998   -> 9E-999999998
+	{"mulx190", "1", "9e-999999998", "9E-999999998", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx191 multiply 1 99e-999999998  -> 9.9E-999999997
+	{"mulx191", "1", "99e-999999998", "9.9E-999999997", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx192 multiply 1 999e-999999998 -> 9.99E-999999996
+	{"mulx192", "1", "999e-999999998", "9.99E-999999996", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx193 multiply 9e-999999998   1 -> 9E-999999998
+	{"mulx193", "9e-999999998", "1", "9E-999999998", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx194 multiply 99e-999999998  1 -> 9.9E-999999997
+	{"mulx194", "99e-999999998", "1", "9.9E-999999997", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx195 multiply 999e-999999998 1 -> 9.99E-999999996
+	{"mulx195", "999e-999999998", "1", "9.99E-999999996", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx196 multiply 1e-599999999 1e-400000000 -> 1E-999999999
+	{"mulx196", "1e-599999999", "1e-400000000", "1E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx197 multiply 1e-600000000 1e-399999999 -> 1E-999999999
+	{"mulx197", "1e-600000000", "1e-399999999", "1E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx198 multiply 1.2e-599999999 1.2e-400000000 -> 1.44E-999999999
+	{"mulx198", "1.2e-599999999", "1.2e-400000000", "1.44E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx199 multiply 1.2e-600000000 1.2e-399999999 -> 1.44E-999999999
+	{"mulx199", "1.2e-600000000", "1.2e-399999999", "1.44E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx201 multiply 1e599999999 1e400000000 -> 1E+999999999
+	{"mulx201", "1e599999999", "1e400000000", "1E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx202 multiply 1e600000000 1e399999999 -> 1E+999999999
+	{"mulx202", "1e600000000", "1e399999999", "1E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx203 multiply 1.2e599999999 1.2e400000000 -> 1.44E+999999999
+	{"mulx203", "1.2e599999999", "1.2e400000000", "1.44E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx204 multiply 1.2e600000000 1.2e399999999 -> 1.44E+999999999
+	{"mulx204", "1.2e600000000", "1.2e399999999", "1.44E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// long operand triangle
+	// precision: 33
+	// mulx246 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193369671916511992830 Inexact Rounded
+	{"mulx246", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193369671916511992830", Inexact | Rounded, 33, ToNearestAway, 999999999, -999999999, false},
+	// precision: 32
+	// mulx247 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119336967191651199283  Inexact Rounded
+	{"mulx247", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119336967191651199283", Inexact | Rounded, 32, ToNearestAway, 999999999, -999999999, false},
+	// precision: 31
+	// mulx248 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908011933696719165119928   Inexact Rounded
+	{"mulx248", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908011933696719165119928", Inexact | Rounded, 31, ToNearestAway, 999999999, -999999999, false},
+	// precision: 30
+	// mulx249 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193369671916511993    Inexact Rounded
+	{"mulx249", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193369671916511993", Inexact | Rounded, 30, ToNearestAway, 999999999, -999999999, false},
+	// precision: 29
+	// mulx250 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119336967191651199     Inexact Rounded
+	{"mulx250", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119336967191651199", Inexact | Rounded, 29, ToNearestAway, 999999999, -999999999, false},
+	// precision: 28
+	// mulx251 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908011933696719165120      Inexact Rounded
+	{"mulx251", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908011933696719165120", Inexact | Rounded, 28, ToNearestAway, 999999999, -999999999, false},
+	// precision: 27
+	// mulx252 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193369671916512       Inexact Rounded
+	{"mulx252", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193369671916512", Inexact | Rounded, 27, ToNearestAway, 999999999, -999999999, false},
+	// precision: 26
+	// mulx253 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119336967191651        Inexact Rounded
+	{"mulx253", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119336967191651", Inexact | Rounded, 26, ToNearestAway, 999999999, -999999999, false},
+	// precision: 25
+	// mulx254 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908011933696719165         Inexact Rounded
+	{"mulx254", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908011933696719165", Inexact | Rounded, 25, ToNearestAway, 999999999, -999999999, false},
+	// precision: 24
+	// mulx255 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193369671917          Inexact Rounded
+	{"mulx255", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193369671917", Inexact | Rounded, 24, ToNearestAway, 999999999, -999999999, false},
+	// precision: 23
+	// mulx256 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119336967192           Inexact Rounded
+	{"mulx256", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119336967192", Inexact | Rounded, 23, ToNearestAway, 999999999, -999999999, false},
+	// precision: 22
+	// mulx257 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908011933696719            Inexact Rounded
+	{"mulx257", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908011933696719", Inexact | Rounded, 22, ToNearestAway, 999999999, -999999999, false},
+	// precision: 21
+	// mulx258 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193369672             Inexact Rounded
+	{"mulx258", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193369672", Inexact | Rounded, 21, ToNearestAway, 999999999, -999999999, false},
+	// precision: 20
+	// mulx259 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119336967              Inexact Rounded
+	{"mulx259", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119336967", Inexact | Rounded, 20, ToNearestAway, 999999999, -999999999, false},
+	// precision: 19
+	// mulx260 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908011933697               Inexact Rounded
+	{"mulx260", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908011933697", Inexact | Rounded, 19, ToNearestAway, 999999999, -999999999, false},
+	// precision: 18
+	// mulx261 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193370                Inexact Rounded
+	{"mulx261", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193370", Inexact | Rounded, 18, ToNearestAway, 999999999, -999999999, false},
+	// precision: 17
+	// mulx262 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119337                 Inexact Rounded
+	{"mulx262", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119337", Inexact | Rounded, 17, ToNearestAway, 999999999, -999999999, false},
+	// precision: 16
+	// mulx263 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908011934                  Inexact Rounded
+	{"mulx263", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908011934", Inexact | Rounded, 16, ToNearestAway, 999999999, -999999999, false},
+	// precision: 15
+	// mulx264 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801193                   Inexact Rounded
+	{"mulx264", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801193", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// precision: 14
+	// mulx265 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080119                    Inexact Rounded
+	{"mulx265", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080119", Inexact | Rounded, 14, ToNearestAway, 999999999, -999999999, false},
+	// precision: 13
+	// mulx266 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908012                     Inexact Rounded
+	{"mulx266", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908012", Inexact | Rounded, 13, ToNearestAway, 999999999, -999999999, false},
+	// precision: 12
+	// mulx267 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.290801                      Inexact Rounded
+	{"mulx267", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.290801", Inexact | Rounded, 12, ToNearestAway, 999999999, -999999999, false},
+	// precision: 11
+	// mulx268 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29080                       Inexact Rounded
+	{"mulx268", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29080", Inexact | Rounded, 11, ToNearestAway, 999999999, -999999999, false},
+	// precision: 10
+	// mulx269 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.2908                        Inexact Rounded
+	{"mulx269", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.2908", Inexact | Rounded, 10, ToNearestAway, 999999999, -999999999, false},
+	// precision: 9
+	// mulx270 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.291                         Inexact Rounded
+	{"mulx270", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.291", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// precision: 8
+	// mulx271 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.29                          Inexact Rounded
+	{"mulx271", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.29", Inexact | Rounded, 8, ToNearestAway, 999999999, -999999999, false},
+	// precision: 7
+	// mulx272 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433.3                           Inexact Rounded
+	{"mulx272", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433.3", Inexact | Rounded, 7, ToNearestAway, 999999999, -999999999, false},
+	// precision: 6
+	// mulx273 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 145433                            Inexact Rounded
+	{"mulx273", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "145433", Inexact | Rounded, 6, ToNearestAway, 999999999, -999999999, false},
+	// precision: 5
+	// mulx274 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 1.4543E+5                         Inexact Rounded
+	{"mulx274", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "1.4543E+5", Inexact | Rounded, 5, ToNearestAway, 999999999, -999999999, false},
+	// precision: 4
+	// mulx275 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 1.454E+5                         Inexact Rounded
+	{"mulx275", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "1.454E+5", Inexact | Rounded, 4, ToNearestAway, 999999999, -999999999, false},
+	// precision: 3
+	// mulx276 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 1.45E+5                         Inexact Rounded
+	{"mulx276", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "1.45E+5", Inexact | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// precision: 2
+	// mulx277 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 1.5E+5                         Inexact Rounded
+	{"mulx277", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "1.5E+5", Inexact | Rounded, 2, ToNearestAway, 999999999, -999999999, false},
+	// precision: 1
+	// mulx278 multiply 30269.587755640502150977251770554 4.8046009735990873395936309640543 -> 1E+5                          Inexact Rounded
+	{"mulx278", "30269.587755640502150977251770554", "4.8046009735990873395936309640543", "1E+5", Inexact | Rounded, 1, ToNearestAway, 999999999, -999999999, false},
+	// test some edge cases with exact rounding
+	// maxexponent: 9999
+	// minexponent: -9999
+	// precision: 9
+	// mulx301 multiply 9 9   -> 81
+	{"mulx301", "9", "9", "81", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx302 multiply 9 90   -> 810
+	{"mulx302", "9", "90", "810", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx303 multiply 9 900   -> 8100
+	{"mulx303", "9", "900", "8100", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx304 multiply 9 9000   -> 81000
+	{"mulx304", "9", "9000", "81000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx305 multiply 9 90000   -> 810000
+	{"mulx305", "9", "90000", "810000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx306 multiply 9 900000   -> 8100000
+	{"mulx306", "9", "900000", "8100000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx307 multiply 9 9000000   -> 81000000
+	{"mulx307", "9", "9000000", "81000000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx308 multiply 9 90000000   -> 810000000
+	{"mulx308", "9", "90000000", "810000000", 0, 9, ToNearestAway, 9999, -9999, false},
+	// mulx309 multiply 9 900000000   -> 8.10000000E+9   Rounded
+	{"mulx309", "9", "900000000", "8.10000000E+9", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx310 multiply 9 9000000000   -> 8.10000000E+10  Rounded
+	{"mulx310", "9", "9000000000", "8.10000000E+10", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx311 multiply 9 90000000000   -> 8.10000000E+11  Rounded
+	{"mulx311", "9", "90000000000", "8.10000000E+11", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx312 multiply 9 900000000000   -> 8.10000000E+12  Rounded
+	{"mulx312", "9", "900000000000", "8.10000000E+12", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx313 multiply 9 9000000000000   -> 8.10000000E+13  Rounded
+	{"mulx313", "9", "9000000000000", "8.10000000E+13", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx314 multiply 9 90000000000000   -> 8.10000000E+14  Rounded
+	{"mulx314", "9", "90000000000000", "8.10000000E+14", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx315 multiply 9 900000000000000   -> 8.10000000E+15  Rounded
+	{"mulx315", "9", "900000000000000", "8.10000000E+15", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx316 multiply 9 9000000000000000   -> 8.10000000E+16  Rounded
+	{"mulx316", "9", "9000000000000000", "8.10000000E+16", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx317 multiply 9 90000000000000000   -> 8.10000000E+17  Rounded
+	{"mulx317", "9", "90000000000000000", "8.10000000E+17", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx318 multiply 9 900000000000000000   -> 8.10000000E+18  Rounded
+	{"mulx318", "9", "900000000000000000", "8.10000000E+18", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx319 multiply 9 9000000000000000000   -> 8.10000000E+19  Rounded
+	{"mulx319", "9", "9000000000000000000", "8.10000000E+19", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx320 multiply 9 90000000000000000000   -> 8.10000000E+20  Rounded
+	{"mulx320", "9", "90000000000000000000", "8.10000000E+20", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx321 multiply 9 900000000000000000000   -> 8.10000000E+21  Rounded
+	{"mulx321", "9", "900000000000000000000", "8.10000000E+21", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx322 multiply 9 9000000000000000000000   -> 8.10000000E+22  Rounded
+	{"mulx322", "9", "9000000000000000000000", "8.10000000E+22", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// mulx323 multiply 9 90000000000000000000000   -> 8.10000000E+23  Rounded
+	{"mulx323", "9", "90000000000000000000000", "8.10000000E+23", Rounded, 9, ToNearestAway, 9999, -9999, false},
+	// fastpath breakers
+	// precision: 29
+	// mulx330 multiply 1.491824697641270317824852952837224 1.105170918075647624811707826490246514675628614562883537345747603 -> 1.6487212707001281468486507878 Inexact Rounded
+	{"mulx330", "1.491824697641270317824852952837224", "1.105170918075647624811707826490246514675628614562883537345747603", "1.6487212707001281468486507878", Inexact | Rounded, 29, ToNearestAway, 9999, -9999, false},
+	// precision: 55
+	// mulx331 multiply 0.8958341352965282506768545828765117803873717284891040428 0.8958341352965282506768545828765117803873717284891040428 -> 0.8025187979624784829842553829934069955890983696752228299 Inexact Rounded
+	{"mulx331", "0.8958341352965282506768545828765117803873717284891040428", "0.8958341352965282506768545828765117803873717284891040428", "0.8025187979624784829842553829934069955890983696752228299", Inexact | Rounded, 55, ToNearestAway, 9999, -9999, false},
+	// tryzeros cases
+	// precision: 7
+	// rounding: half_up
+	// maxexponent: 92
+	// minexponent: -92
+	// mulx504  multiply  0E-60 1000E-60  -> 0E-98 Clamped
+	{"mulx504", "0E-60", "1000E-60", "0E-98", Clamped, 7, ToNearestAway, 92, -92, false},
+	// mulx505  multiply  100E+60 0E+60   -> 0E+92 Clamped
+	{"mulx505", "100E+60", "0E+60", "0E+92", Clamped, 7, ToNearestAway, 92, -92, false},
+	// mixed with zeros
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 9
+	// mulx541 multiply  0    -1     -> -0
+	{"mulx541", "0", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx542 multiply -0    -1     ->  0
+	{"mulx542", "-0", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx543 multiply  0     1     ->  0
+	{"mulx543", "0", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx544 multiply -0     1     -> -0
+	{"mulx544", "-0", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx545 multiply -1     0     -> -0
+	{"mulx545", "-1", "0", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx546 multiply -1    -0     ->  0
+	{"mulx546", "-1", "-0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx547 multiply  1     0     ->  0
+	{"mulx547", "1", "0", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx548 multiply  1    -0     -> -0
+	{"mulx548", "1", "-0", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx551 multiply  0.0  -1     -> -0.0
+	{"mulx551", "0.0", "-1", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx552 multiply -0.0  -1     ->  0.0
+	{"mulx552", "-0.0", "-1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx553 multiply  0.0   1     ->  0.0
+	{"mulx553", "0.0", "1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx554 multiply -0.0   1     -> -0.0
+	{"mulx554", "-0.0", "1", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx555 multiply -1.0   0     -> -0.0
+	{"mulx555", "-1.0", "0", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx556 multiply -1.0  -0     ->  0.0
+	{"mulx556", "-1.0", "-0", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx557 multiply  1.0   0     ->  0.0
+	{"mulx557", "1.0", "0", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx558 multiply  1.0  -0     -> -0.0
+	{"mulx558", "1.0", "-0", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx561 multiply  0    -1.0   -> -0.0
+	{"mulx561", "0", "-1.0", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx562 multiply -0    -1.0   ->  0.0
+	{"mulx562", "-0", "-1.0", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx563 multiply  0     1.0   ->  0.0
+	{"mulx563", "0", "1.0", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx564 multiply -0     1.0   -> -0.0
+	{"mulx564", "-0", "1.0", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx565 multiply -1     0.0   -> -0.0
+	{"mulx565", "-1", "0.0", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx566 multiply -1    -0.0   ->  0.0
+	{"mulx566", "-1", "-0.0", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx567 multiply  1     0.0   ->  0.0
+	{"mulx567", "1", "0.0", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx568 multiply  1    -0.0   -> -0.0
+	{"mulx568", "1", "-0.0", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx571 multiply  0.0  -1.0   -> -0.00
+	{"mulx571", "0.0", "-1.0", "-0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx572 multiply -0.0  -1.0   ->  0.00
+	{"mulx572", "-0.0", "-1.0", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx573 multiply  0.0   1.0   ->  0.00
+	{"mulx573", "0.0", "1.0", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx574 multiply -0.0   1.0   -> -0.00
+	{"mulx574", "-0.0", "1.0", "-0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx575 multiply -1.0   0.0   -> -0.00
+	{"mulx575", "-1.0", "0.0", "-0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx576 multiply -1.0  -0.0   ->  0.00
+	{"mulx576", "-1.0", "-0.0", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx577 multiply  1.0   0.0   ->  0.00
+	{"mulx577", "1.0", "0.0", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx578 multiply  1.0  -0.0   -> -0.00
+	{"mulx578", "1.0", "-0.0", "-0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// Specials
+	// mulx580 multiply  Inf  -Inf   -> -Infinity
+	{"mulx580", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx581 multiply  Inf  -1000  -> -Infinity
+	{"mulx581", "Inf", "-1000", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx582 multiply  Inf  -1     -> -Infinity
+	{"mulx582", "Inf", "-1", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx583 multiply  Inf  -0     ->  NaN  Invalid_operation
+	{"mulx583", "Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx584 multiply  Inf   0     ->  NaN  Invalid_operation
+	{"mulx584", "Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx585 multiply  Inf   1     ->  Infinity
+	{"mulx585", "Inf", "1", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx586 multiply  Inf   1000  ->  Infinity
+	{"mulx586", "Inf", "1000", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx587 multiply  Inf   Inf   ->  Infinity
+	{"mulx587", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx588 multiply -1000  Inf   -> -Infinity
+	{"mulx588", "-1000", "Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx589 multiply -Inf   Inf   -> -Infinity
+	{"mulx589", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx590 multiply -1     Inf   -> -Infinity
+	{"mulx590", "-1", "Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx591 multiply -0     Inf   ->  NaN  Invalid_operation
+	{"mulx591", "-0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx592 multiply  0     Inf   ->  NaN  Invalid_operation
+	{"mulx592", "0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx593 multiply  1     Inf   ->  Infinity
+	{"mulx593", "1", "Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx594 multiply  1000  Inf   ->  Infinity
+	{"mulx594", "1000", "Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx595 multiply  Inf   Inf   ->  Infinity
+	{"mulx595", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx600 multiply -Inf  -Inf   ->  Infinity
+	{"mulx600", "-Inf", "-Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx601 multiply -Inf  -1000  ->  Infinity
+	{"mulx601", "-Inf", "-1000", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx602 multiply -Inf  -1     ->  Infinity
+	{"mulx602", "-Inf", "-1", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx603 multiply -Inf  -0     ->  NaN  Invalid_operation
+	{"mulx603", "-Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx604 multiply -Inf   0     ->  NaN  Invalid_operation
+	{"mulx604", "-Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx605 multiply -Inf   1     -> -Infinity
+	{"mulx605", "-Inf", "1", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx606 multiply -Inf   1000  -> -Infinity
+	{"mulx606", "-Inf", "1000", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx607 multiply -Inf   Inf   -> -Infinity
+	{"mulx607", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx608 multiply -1000  Inf   -> -Infinity
+	{"mulx608", "-1000", "Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx609 multiply -Inf  -Inf   ->  Infinity
+	{"mulx609", "-Inf", "-Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx610 multiply -1    -Inf   ->  Infinity
+	{"mulx610", "-1", "-Inf", "Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx611 multiply -0    -Inf   ->  NaN  Invalid_operation
+	{"mulx611", "-0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx612 multiply  0    -Inf   ->  NaN  Invalid_operation
+	{"mulx612", "0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx613 multiply  1    -Inf   -> -Infinity
+	{"mulx613", "1", "-Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx614 multiply  1000 -Inf   -> -Infinity
+	{"mulx614", "1000", "-Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx615 multiply  Inf  -Inf   -> -Infinity
+	{"mulx615", "Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx621 multiply  NaN -Inf    ->  NaN
+	{"mulx621", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx622 multiply  NaN -1000   ->  NaN
+	{"mulx622", "NaN", "-1000", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx623 multiply  NaN -1      ->  NaN
+	{"mulx623", "NaN", "-1", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx624 multiply  NaN -0      ->  NaN
+	{"mulx624", "NaN", "-0", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx625 multiply  NaN  0      ->  NaN
+	{"mulx625", "NaN", "0", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx626 multiply  NaN  1      ->  NaN
+	{"mulx626", "NaN", "1", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx627 multiply  NaN  1000   ->  NaN
+	{"mulx627", "NaN", "1000", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx628 multiply  NaN  Inf    ->  NaN
+	{"mulx628", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx629 multiply  NaN  NaN    ->  NaN
+	{"mulx629", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx630 multiply -Inf  NaN    ->  NaN
+	{"mulx630", "-Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx631 multiply -1000 NaN    ->  NaN
+	{"mulx631", "-1000", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx632 multiply -1    NaN    ->  NaN
+	{"mulx632", "-1", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx633 multiply -0    NaN    ->  NaN
+	{"mulx633", "-0", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx634 multiply  0    NaN    ->  NaN
+	{"mulx634", "0", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx635 multiply  1    NaN    ->  NaN
+	{"mulx635", "1", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx636 multiply  1000 NaN    ->  NaN
+	{"mulx636", "1000", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx637 multiply  Inf  NaN    ->  NaN
+	{"mulx637", "Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx641 multiply  sNaN -Inf   ->  NaN  Invalid_operation
+	{"mulx641", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx642 multiply  sNaN -1000  ->  NaN  Invalid_operation
+	{"mulx642", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx643 multiply  sNaN -1     ->  NaN  Invalid_operation
+	{"mulx643", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx644 multiply  sNaN -0     ->  NaN  Invalid_operation
+	{"mulx644", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx645 multiply  sNaN  0     ->  NaN  Invalid_operation
+	{"mulx645", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx646 multiply  sNaN  1     ->  NaN  Invalid_operation
+	{"mulx646", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx647 multiply  sNaN  1000  ->  NaN  Invalid_operation
+	{"mulx647", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx648 multiply  sNaN  NaN   ->  NaN  Invalid_operation
+	{"mulx648", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx649 multiply  sNaN sNaN   ->  NaN  Invalid_operation
+	{"mulx649", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx650 multiply  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mulx650", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx651 multiply -Inf  sNaN   ->  NaN  Invalid_operation
+	{"mulx651", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx652 multiply -1000 sNaN   ->  NaN  Invalid_operation
+	{"mulx652", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx653 multiply -1    sNaN   ->  NaN  Invalid_operation
+	{"mulx653", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx654 multiply -0    sNaN   ->  NaN  Invalid_operation
+	{"mulx654", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx655 multiply  0    sNaN   ->  NaN  Invalid_operation
+	{"mulx655", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx656 multiply  1    sNaN   ->  NaN  Invalid_operation
+	{"mulx656", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx657 multiply  1000 sNaN   ->  NaN  Invalid_operation
+	{"mulx657", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx658 multiply  Inf  sNaN   ->  NaN  Invalid_operation
+	{"mulx658", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx659 multiply  NaN  sNaN   ->  NaN  Invalid_operation
+	{"mulx659", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// propagating NaNs
+	// mulx661 multiply  NaN9 -Inf   ->  NaN9
+	{"mulx661", "NaN9", "-Inf", "NaN9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx662 multiply  NaN8  999   ->  NaN8
+	{"mulx662", "NaN8", "999", "NaN8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx663 multiply  NaN71 Inf   ->  NaN71
+	{"mulx663", "NaN71", "Inf", "NaN71", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx664 multiply  NaN6  NaN5  ->  NaN6
+	{"mulx664", "NaN6", "NaN5", "NaN6", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx665 multiply -Inf   NaN4  ->  NaN4
+	{"mulx665", "-Inf", "NaN4", "NaN4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx666 multiply -999   NaN33 ->  NaN33
+	{"mulx666", "-999", "NaN33", "NaN33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx667 multiply  Inf   NaN2  ->  NaN2
+	{"mulx667", "Inf", "NaN2", "NaN2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx671 multiply  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"mulx671", "sNaN99", "-Inf", "NaN99", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx672 multiply  sNaN98 -11     ->  NaN98 Invalid_operation
+	{"mulx672", "sNaN98", "-11", "NaN98", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx673 multiply  sNaN97  NaN    ->  NaN97 Invalid_operation
+	{"mulx673", "sNaN97", "NaN", "NaN97", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx674 multiply  sNaN16 sNaN94  ->  NaN16 Invalid_operation
+	{"mulx674", "sNaN16", "sNaN94", "NaN16", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx675 multiply  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"mulx675", "NaN95", "sNaN93", "NaN93", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx676 multiply -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"mulx676", "-Inf", "sNaN92", "NaN92", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx677 multiply  088    sNaN91  ->  NaN91 Invalid_operation
+	{"mulx677", "088", "sNaN91", "NaN91", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx678 multiply  Inf    sNaN90  ->  NaN90 Invalid_operation
+	{"mulx678", "Inf", "sNaN90", "NaN90", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx679 multiply  NaN    sNaN89  ->  NaN89 Invalid_operation
+	{"mulx679", "NaN", "sNaN89", "NaN89", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx681 multiply -NaN9 -Inf   -> -NaN9
+	{"mulx681", "-NaN9", "-Inf", "-NaN9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx682 multiply -NaN8  999   -> -NaN8
+	{"mulx682", "-NaN8", "999", "-NaN8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx683 multiply -NaN71 Inf   -> -NaN71
+	{"mulx683", "-NaN71", "Inf", "-NaN71", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx684 multiply -NaN6 -NaN5  -> -NaN6
+	{"mulx684", "-NaN6", "-NaN5", "-NaN6", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx685 multiply -Inf  -NaN4  -> -NaN4
+	{"mulx685", "-Inf", "-NaN4", "-NaN4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx686 multiply -999  -NaN33 -> -NaN33
+	{"mulx686", "-999", "-NaN33", "-NaN33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx687 multiply  Inf  -NaN2  -> -NaN2
+	{"mulx687", "Inf", "-NaN2", "-NaN2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx691 multiply -sNaN99 -Inf    -> -NaN99 Invalid_operation
+	{"mulx691", "-sNaN99", "-Inf", "-NaN99", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx692 multiply -sNaN98 -11     -> -NaN98 Invalid_operation
+	{"mulx692", "-sNaN98", "-11", "-NaN98", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx693 multiply -sNaN97  NaN    -> -NaN97 Invalid_operation
+	{"mulx693", "-sNaN97", "NaN", "-NaN97", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx694 multiply -sNaN16 -sNaN94 -> -NaN16 Invalid_operation
+	{"mulx694", "-sNaN16", "-sNaN94", "-NaN16", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx695 multiply -NaN95  -sNaN93 -> -NaN93 Invalid_operation
+	{"mulx695", "-NaN95", "-sNaN93", "-NaN93", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx696 multiply -Inf    -sNaN92 -> -NaN92 Invalid_operation
+	{"mulx696", "-Inf", "-sNaN92", "-NaN92", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx697 multiply  088    -sNaN91 -> -NaN91 Invalid_operation
+	{"mulx697", "088", "-sNaN91", "-NaN91", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx698 multiply  Inf    -sNaN90 -> -NaN90 Invalid_operation
+	{"mulx698", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx699 multiply -NaN    -sNaN89 -> -NaN89 Invalid_operation
+	{"mulx699", "-NaN", "-sNaN89", "-NaN89", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx701 multiply -NaN  -Inf   -> -NaN
+	{"mulx701", "-NaN", "-Inf", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx702 multiply -NaN   999   -> -NaN
+	{"mulx702", "-NaN", "999", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx703 multiply -NaN   Inf   -> -NaN
+	{"mulx703", "-NaN", "Inf", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx704 multiply -NaN  -NaN   -> -NaN
+	{"mulx704", "-NaN", "-NaN", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx705 multiply -Inf  -NaN0  -> -NaN
+	{"mulx705", "-Inf", "-NaN0", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx706 multiply -999  -NaN   -> -NaN
+	{"mulx706", "-999", "-NaN", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx707 multiply  Inf  -NaN   -> -NaN
+	{"mulx707", "Inf", "-NaN", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx711 multiply -sNaN   -Inf    -> -NaN Invalid_operation
+	{"mulx711", "-sNaN", "-Inf", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx712 multiply -sNaN   -11     -> -NaN Invalid_operation
+	{"mulx712", "-sNaN", "-11", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx713 multiply -sNaN00  NaN    -> -NaN Invalid_operation
+	{"mulx713", "-sNaN00", "NaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx714 multiply -sNaN   -sNaN   -> -NaN Invalid_operation
+	{"mulx714", "-sNaN", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx715 multiply -NaN    -sNaN   -> -NaN Invalid_operation
+	{"mulx715", "-NaN", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx716 multiply -Inf    -sNaN   -> -NaN Invalid_operation
+	{"mulx716", "-Inf", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx717 multiply  088    -sNaN   -> -NaN Invalid_operation
+	{"mulx717", "088", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx718 multiply  Inf    -sNaN   -> -NaN Invalid_operation
+	{"mulx718", "Inf", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx719 multiply -NaN    -sNaN   -> -NaN Invalid_operation
+	{"mulx719", "-NaN", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// overflow and underflow tests .. note subnormal results
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// mulx730 multiply +1.23456789012345E-0 9E+999999999 -> Infinity Inexact Overflow Rounded
+	{"mulx730", "+1.23456789012345E-0", "9E+999999999", "Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx731 multiply 9E+999999999 +1.23456789012345E-0 -> Infinity Inexact Overflow Rounded
+	{"mulx731", "9E+999999999", "+1.23456789012345E-0", "Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx732 multiply +0.100 9E-999999999 -> 9.00E-1000000000 Subnormal
+	{"mulx732", "+0.100", "9E-999999999", "9.00E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx733 multiply 9E-999999999 +0.100 -> 9.00E-1000000000 Subnormal
+	{"mulx733", "9E-999999999", "+0.100", "9.00E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx735 multiply -1.23456789012345E-0 9E+999999999 -> -Infinity Inexact Overflow Rounded
+	{"mulx735", "-1.23456789012345E-0", "9E+999999999", "-Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx736 multiply 9E+999999999 -1.23456789012345E-0 -> -Infinity Inexact Overflow Rounded
+	{"mulx736", "9E+999999999", "-1.23456789012345E-0", "-Inf", Inexact | Overflow | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx737 multiply -0.100 9E-999999999 -> -9.00E-1000000000 Subnormal
+	{"mulx737", "-0.100", "9E-999999999", "-9.00E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx738 multiply 9E-999999999 -0.100 -> -9.00E-1000000000 Subnormal
+	{"mulx738", "9E-999999999", "-0.100", "-9.00E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx739 multiply 1e-599999999 1e-400000001 -> 1E-1000000000 Subnormal
+	{"mulx739", "1e-599999999", "1e-400000001", "1E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx740 multiply 1e-599999999 1e-400000000 -> 1E-999999999
+	{"mulx740", "1e-599999999", "1e-400000000", "1E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx741 multiply 1e-600000000 1e-400000000 -> 1E-1000000000 Subnormal
+	{"mulx741", "1e-600000000", "1e-400000000", "1E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx742 multiply 9e-999999998 0.01 -> 9E-1000000000 Subnormal
+	{"mulx742", "9e-999999998", "0.01", "9E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx743 multiply 9e-999999998 0.1  -> 9E-999999999
+	{"mulx743", "9e-999999998", "0.1", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx744 multiply 0.01 9e-999999998 -> 9E-1000000000 Subnormal
+	{"mulx744", "0.01", "9e-999999998", "9E-1000000000", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx745 multiply 1e599999999 1e400000001 -> Infinity Overflow Inexact Rounded
+	{"mulx745", "1e599999999", "1e400000001", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx746 multiply 1e599999999 1e400000000 -> 1E+999999999
+	{"mulx746", "1e599999999", "1e400000000", "1E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx747 multiply 1e600000000 1e400000000 -> Infinity Overflow Inexact Rounded
+	{"mulx747", "1e600000000", "1e400000000", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx748 multiply 9e999999998 100  -> Infinity Overflow Inexact Rounded
+	{"mulx748", "9e999999998", "100", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx749 multiply 9e999999998 10   -> 9.0E+999999999
+	{"mulx749", "9e999999998", "10", "9.0E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx750 multiply 100  9e999999998 -> Infinity Overflow Inexact Rounded
+	{"mulx750", "100", "9e999999998", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// signs
+	// mulx751 multiply  1e+777777777  1e+411111111 ->  Infinity Overflow Inexact Rounded
+	{"mulx751", "1e+777777777", "1e+411111111", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx752 multiply  1e+777777777 -1e+411111111 -> -Infinity Overflow Inexact Rounded
+	{"mulx752", "1e+777777777", "-1e+411111111", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx753 multiply -1e+777777777  1e+411111111 -> -Infinity Overflow Inexact Rounded
+	{"mulx753", "-1e+777777777", "1e+411111111", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx754 multiply -1e+777777777 -1e+411111111 ->  Infinity Overflow Inexact Rounded
+	{"mulx754", "-1e+777777777", "-1e+411111111", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx755 multiply  1e-777777777  1e-411111111 ->  0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx755", "1e-777777777", "1e-411111111", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx756 multiply  1e-777777777 -1e-411111111 -> -0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx756", "1e-777777777", "-1e-411111111", "-0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx757 multiply -1e-777777777  1e-411111111 -> -0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx757", "-1e-777777777", "1e-411111111", "-0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx758 multiply -1e-777777777 -1e-411111111 ->  0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx758", "-1e-777777777", "-1e-411111111", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// 'subnormal' boundary (all hard underflow or overflow in base arithmetic)
+	// precision: 9
+	// mulx760 multiply 1e-600000000 1e-400000001 -> 1E-1000000001 Subnormal
+	{"mulx760", "1e-600000000", "1e-400000001", "1E-1000000001", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx761 multiply 1e-600000000 1e-400000002 -> 1E-1000000002 Subnormal
+	{"mulx761", "1e-600000000", "1e-400000002", "1E-1000000002", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx762 multiply 1e-600000000 1e-400000003 -> 1E-1000000003 Subnormal
+	{"mulx762", "1e-600000000", "1e-400000003", "1E-1000000003", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx763 multiply 1e-600000000 1e-400000004 -> 1E-1000000004 Subnormal
+	{"mulx763", "1e-600000000", "1e-400000004", "1E-1000000004", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx764 multiply 1e-600000000 1e-400000005 -> 1E-1000000005 Subnormal
+	{"mulx764", "1e-600000000", "1e-400000005", "1E-1000000005", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx765 multiply 1e-600000000 1e-400000006 -> 1E-1000000006 Subnormal
+	{"mulx765", "1e-600000000", "1e-400000006", "1E-1000000006", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx766 multiply 1e-600000000 1e-400000007 -> 1E-1000000007 Subnormal
+	{"mulx766", "1e-600000000", "1e-400000007", "1E-1000000007", Subnormal, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx767 multiply 1e-600000000 1e-400000008 -> 0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx767", "1e-600000000", "1e-400000008", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx768 multiply 1e-600000000 1e-400000009 -> 0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx768", "1e-600000000", "1e-400000009", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx769 multiply 1e-600000000 1e-400000010 -> 0E-1000000007 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx769", "1e-600000000", "1e-400000010", "0E-1000000007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999999999, -999999999, false},
+	// [no equivalent of 'subnormal' for overflow]
+	// mulx770 multiply 1e+600000000 1e+400000001 -> Infinity Overflow Inexact Rounded
+	{"mulx770", "1e+600000000", "1e+400000001", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx771 multiply 1e+600000000 1e+400000002 -> Infinity Overflow Inexact Rounded
+	{"mulx771", "1e+600000000", "1e+400000002", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx772 multiply 1e+600000000 1e+400000003 -> Infinity Overflow Inexact Rounded
+	{"mulx772", "1e+600000000", "1e+400000003", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx773 multiply 1e+600000000 1e+400000004 -> Infinity Overflow Inexact Rounded
+	{"mulx773", "1e+600000000", "1e+400000004", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx774 multiply 1e+600000000 1e+400000005 -> Infinity Overflow Inexact Rounded
+	{"mulx774", "1e+600000000", "1e+400000005", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx775 multiply 1e+600000000 1e+400000006 -> Infinity Overflow Inexact Rounded
+	{"mulx775", "1e+600000000", "1e+400000006", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx776 multiply 1e+600000000 1e+400000007 -> Infinity Overflow Inexact Rounded
+	{"mulx776", "1e+600000000", "1e+400000007", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx777 multiply 1e+600000000 1e+400000008 -> Infinity Overflow Inexact Rounded
+	{"mulx777", "1e+600000000", "1e+400000008", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx778 multiply 1e+600000000 1e+400000009 -> Infinity Overflow Inexact Rounded
+	{"mulx778", "1e+600000000", "1e+400000009", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// mulx779 multiply 1e+600000000 1e+400000010 -> Infinity Overflow Inexact Rounded
+	{"mulx779", "1e+600000000", "1e+400000010", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// 'subnormal' test edge condition at higher precisions
+	// precision: 99
+	// mulx780 multiply 1e-600000000 1e-400000007 -> 1E-1000000007 Subnormal
+	{"mulx780", "1e-600000000", "1e-400000007", "1E-1000000007", Subnormal, 99, ToNearestAway, 999999999, -999999999, false},
+	// mulx781 multiply 1e-600000000 1e-400000008 -> 1E-1000000008 Subnormal
+	{"mulx781", "1e-600000000", "1e-400000008", "1E-1000000008", Subnormal, 99, ToNearestAway, 999999999, -999999999, false},
+	// mulx782 multiply 1e-600000000 1e-400000097 -> 1E-1000000097 Subnormal
+	{"mulx782", "1e-600000000", "1e-400000097", "1E-1000000097", Subnormal, 99, ToNearestAway, 999999999, -999999999, false},
+	// mulx783 multiply 1e-600000000 1e-400000098 -> 0E-1000000097 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx783", "1e-600000000", "1e-400000098", "0E-1000000097", Underflow | Subnormal | Inexact | Rounded | Clamped, 99, ToNearestAway, 999999999, -999999999, false},
+	// precision: 999
+	// mulx784 multiply 1e-600000000 1e-400000997 -> 1E-1000000997 Subnormal
+	{"mulx784", "1e-600000000", "1e-400000997", "1E-1000000997", Subnormal, 999, ToNearestAway, 999999999, -999999999, false},
+	// mulx785 multiply 1e-600000000 1e-400000998 -> 0E-1000000997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx785", "1e-600000000", "1e-400000998", "0E-1000000997", Underflow | Subnormal | Inexact | Rounded | Clamped, 999, ToNearestAway, 999999999, -999999999, false},
+	// following testcases [through mulx800] not yet run against code
+	// precision: 9999
+	// mulx786 multiply 1e-600000000 1e-400009997 -> 1E-1000009997 Subnormal
+	{"mulx786", "1e-600000000", "1e-400009997", "1E-1000009997", Subnormal, 9999, ToNearestAway, 999999999, -999999999, false},
+	// mulx787 multiply 1e-600000000 1e-400009998 -> 0E-1000009997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx787", "1e-600000000", "1e-400009998", "0E-1000009997", Underflow | Subnormal | Inexact | Rounded | Clamped, 9999, ToNearestAway, 999999999, -999999999, false},
+	// precision: 99999
+	// mulx788 multiply 1e-600000000 1e-400099997 -> 1E-1000099997 Subnormal
+	{"mulx788", "1e-600000000", "1e-400099997", "1E-1000099997", Subnormal, 99999, ToNearestAway, 999999999, -999999999, false},
+	// mulx789 multiply 1e-600000000 1e-400099998 -> 0E-1000099997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx789", "1e-600000000", "1e-400099998", "0E-1000099997", Underflow | Subnormal | Inexact | Rounded | Clamped, 99999, ToNearestAway, 999999999, -999999999, false},
+	// precision: 999999
+	// mulx790 multiply 1e-600000000 1e-400999997 -> 1E-1000999997 Subnormal
+	{"mulx790", "1e-600000000", "1e-400999997", "1E-1000999997", Subnormal, 999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx791 multiply 1e-600000000 1e-400999998 -> 0E-1000999997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx791", "1e-600000000", "1e-400999998", "0E-1000999997", Underflow | Subnormal | Inexact | Rounded | Clamped, 999999, ToNearestAway, 999999999, -999999999, false},
+	// precision: 9999999
+	// mulx792 multiply 1e-600000000 1e-409999997 -> 1E-1009999997 Subnormal
+	{"mulx792", "1e-600000000", "1e-409999997", "1E-1009999997", Subnormal, 9999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx793 multiply 1e-600000000 1e-409999998 -> 0E-1009999997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx793", "1e-600000000", "1e-409999998", "0E-1009999997", Underflow | Subnormal | Inexact | Rounded | Clamped, 9999999, ToNearestAway, 999999999, -999999999, false},
+	// precision: 99999999
+	// mulx794 multiply 1e-600000000 1e-499999997 -> 1E-1099999997 Subnormal
+	{"mulx794", "1e-600000000", "1e-499999997", "1E-1099999997", Subnormal, 99999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx795 multiply 1e-600000000 1e-499999998 -> 0E-1099999997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx795", "1e-600000000", "1e-499999998", "0E-1099999997", Underflow | Subnormal | Inexact | Rounded | Clamped, 99999999, ToNearestAway, 999999999, -999999999, false},
+	// precision: 999999999
+	// mulx796 multiply 1e-999999999 1e-999999997 -> 1E-1999999996 Subnormal
+	{"mulx796", "1e-999999999", "1e-999999997", "1E-1999999996", Subnormal, 999999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx797 multiply 1e-999999999 1e-999999998 -> 1E-1999999997 Subnormal
+	{"mulx797", "1e-999999999", "1e-999999998", "1E-1999999997", Subnormal, 999999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx798 multiply 1e-999999999 1e-999999999 -> 0E-1999999997 Underflow Subnormal Inexact Rounded Clamped
+	{"mulx798", "1e-999999999", "1e-999999999", "0E-1999999997", Underflow | Subnormal | Inexact | Rounded | Clamped, 999999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx799 multiply 1e-600000000 1e-400000007 -> 1E-1000000007 Subnormal
+	{"mulx799", "1e-600000000", "1e-400000007", "1E-1000000007", Subnormal, 999999999, ToNearestAway, 999999999, -999999999, false},
+	// mulx800 multiply 1e-600000000 1e-400000008 -> 1E-1000000008 Subnormal
+	{"mulx800", "1e-600000000", "1e-400000008", "1E-1000000008", Subnormal, 999999999, ToNearestAway, 999999999, -999999999, false},
+	// test subnormals rounding
+	// precision: 5
+	// maxexponent: 999
+	// minexponent: -999
+	// rounding: half_even
+	// mulx801 multiply  1.0000E-999  1     -> 1.0000E-999
+	{"mulx801", "1.0000E-999", "1", "1.0000E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// mulx802 multiply  1.000E-999   1e-1  -> 1.000E-1000 Subnormal
+	{"mulx802", "1.000E-999", "1e-1", "1.000E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx803 multiply  1.00E-999    1e-2  -> 1.00E-1001  Subnormal
+	{"mulx803", "1.00E-999", "1e-2", "1.00E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx804 multiply  1.0E-999     1e-3  -> 1.0E-1002   Subnormal
+	{"mulx804", "1.0E-999", "1e-3", "1.0E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx805 multiply  1.0E-999     1e-4  -> 1E-1003     Subnormal Rounded
+	{"mulx805", "1.0E-999", "1e-4", "1E-1003", Subnormal | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx806 multiply  1.3E-999     1e-4  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx806", "1.3E-999", "1e-4", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx807 multiply  1.5E-999     1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx807", "1.5E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx808 multiply  1.7E-999     1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx808", "1.7E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx809 multiply  2.3E-999     1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx809", "2.3E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx810 multiply  2.5E-999     1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx810", "2.5E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx811 multiply  2.7E-999     1e-4  -> 3E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx811", "2.7E-999", "1e-4", "3E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx812 multiply  1.49E-999    1e-4  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx812", "1.49E-999", "1e-4", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx813 multiply  1.50E-999    1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx813", "1.50E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx814 multiply  1.51E-999    1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx814", "1.51E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx815 multiply  2.49E-999    1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx815", "2.49E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx816 multiply  2.50E-999    1e-4  -> 2E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx816", "2.50E-999", "1e-4", "2E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx817 multiply  2.51E-999    1e-4  -> 3E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx817", "2.51E-999", "1e-4", "3E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx818 multiply  1E-999       1e-4  -> 1E-1003     Subnormal
+	{"mulx818", "1E-999", "1e-4", "1E-1003", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx819 multiply  3E-999       1e-5  -> 0E-1003     Underflow Subnormal Inexact Rounded Clamped
+	{"mulx819", "3E-999", "1e-5", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx820 multiply  5E-999       1e-5  -> 0E-1003     Underflow Subnormal Inexact Rounded Clamped
+	{"mulx820", "5E-999", "1e-5", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx821 multiply  7E-999       1e-5  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx821", "7E-999", "1e-5", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx822 multiply  9E-999       1e-5  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx822", "9E-999", "1e-5", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx823 multiply  9.9E-999     1e-5  -> 1E-1003     Underflow Subnormal Inexact Rounded
+	{"mulx823", "9.9E-999", "1e-5", "1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx824 multiply  1E-999      -1e-4  -> -1E-1003    Subnormal
+	{"mulx824", "1E-999", "-1e-4", "-1E-1003", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx825 multiply  3E-999      -1e-5  -> -0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"mulx825", "3E-999", "-1e-5", "-0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx826 multiply -5E-999       1e-5  -> -0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"mulx826", "-5E-999", "1e-5", "-0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx827 multiply  7E-999      -1e-5  -> -1E-1003    Underflow Subnormal Inexact Rounded
+	{"mulx827", "7E-999", "-1e-5", "-1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx828 multiply -9E-999       1e-5  -> -1E-1003    Underflow Subnormal Inexact Rounded
+	{"mulx828", "-9E-999", "1e-5", "-1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx829 multiply  9.9E-999    -1e-5  -> -1E-1003    Underflow Subnormal Inexact Rounded
+	{"mulx829", "9.9E-999", "-1e-5", "-1E-1003", Underflow | Subnormal | Inexact | Rounded, 5, ToNearestEven, 999, -999, false},
+	// mulx830 multiply  3.0E-999    -1e-5  -> -0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"mulx830", "3.0E-999", "-1e-5", "-0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx831 multiply  1.0E-501     1e-501 -> 1.0E-1002   Subnormal
+	{"mulx831", "1.0E-501", "1e-501", "1.0E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx832 multiply  2.0E-501     2e-501 -> 4.0E-1002   Subnormal
+	{"mulx832", "2.0E-501", "2e-501", "4.0E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx833 multiply  4.0E-501     4e-501 -> 1.60E-1001  Subnormal
+	{"mulx833", "4.0E-501", "4e-501", "1.60E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx834 multiply 10.0E-501    10e-501 -> 1.000E-1000 Subnormal
+	{"mulx834", "10.0E-501", "10e-501", "1.000E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx835 multiply 30.0E-501    30e-501 -> 9.000E-1000 Subnormal
+	{"mulx835", "30.0E-501", "30e-501", "9.000E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx836 multiply 40.0E-501    40e-501 -> 1.6000E-999
+	{"mulx836", "40.0E-501", "40e-501", "1.6000E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// squares
+	// mulx840 multiply  1E-502       1e-502 -> 0E-1003     Underflow Subnormal Inexact Rounded Clamped
+	{"mulx840", "1E-502", "1e-502", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx841 multiply  1E-501       1e-501 -> 1E-1002     Subnormal
+	{"mulx841", "1E-501", "1e-501", "1E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx842 multiply  2E-501       2e-501 -> 4E-1002     Subnormal
+	{"mulx842", "2E-501", "2e-501", "4E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx843 multiply  4E-501       4e-501 -> 1.6E-1001   Subnormal
+	{"mulx843", "4E-501", "4e-501", "1.6E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx844 multiply 10E-501      10e-501 -> 1.00E-1000  Subnormal
+	{"mulx844", "10E-501", "10e-501", "1.00E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx845 multiply 30E-501      30e-501 -> 9.00E-1000  Subnormal
+	{"mulx845", "30E-501", "30e-501", "9.00E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx846 multiply 40E-501      40e-501 -> 1.600E-999
+	{"mulx846", "40E-501", "40e-501", "1.600E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// cubes
+	// mulx850 multiply  1E-670     1e-335 -> 0E-1003    Underflow Subnormal Inexact Rounded Clamped
+	{"mulx850", "1E-670", "1e-335", "0E-1003", Underflow | Subnormal | Inexact | Rounded | Clamped, 5, ToNearestEven, 999, -999, false},
+	// mulx851 multiply  1E-668     1e-334 -> 1E-1002    Subnormal
+	{"mulx851", "1E-668", "1e-334", "1E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx852 multiply  4E-668     2e-334 -> 8E-1002    Subnormal
+	{"mulx852", "4E-668", "2e-334", "8E-1002", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx853 multiply  9E-668     3e-334 -> 2.7E-1001  Subnormal
+	{"mulx853", "9E-668", "3e-334", "2.7E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx854 multiply 16E-668     4e-334 -> 6.4E-1001  Subnormal
+	{"mulx854", "16E-668", "4e-334", "6.4E-1001", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx855 multiply 25E-668     5e-334 -> 1.25E-1000 Subnormal
+	{"mulx855", "25E-668", "5e-334", "1.25E-1000", Subnormal, 5, ToNearestEven, 999, -999, false},
+	// mulx856 multiply 10E-668   100e-334 -> 1.000E-999
+	{"mulx856", "10E-668", "100e-334", "1.000E-999", 0, 5, ToNearestEven, 999, -999, false},
+	// test derived from result of 0.099 ** 999 at 15 digits with unlimited exponent
+	// precision: 19
+	// mulx860 multiply  6636851557994578716E-520 6636851557994578716E-520 -> 4.40477986028551E-1003 Underflow Subnormal Inexact Rounded
+	{"mulx860", "6636851557994578716E-520", "6636851557994578716E-520", "4.40477986028551E-1003", Underflow | Subnormal | Inexact | Rounded, 19, ToNearestEven, 999, -999, false},
+	// Long operand overflow may be a different path
+	// precision: 3
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// mulx870 multiply 1  9.999E+999999999   ->  Infinity Inexact Overflow Rounded
+	{"mulx870", "1", "9.999E+999999999", "Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999999, -999999999, false},
+	// mulx871 multiply 1 -9.999E+999999999   -> -Infinity Inexact Overflow Rounded
+	{"mulx871", "1", "-9.999E+999999999", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999999, -999999999, false},
+	// mulx872 multiply    9.999E+999999999 1 ->  Infinity Inexact Overflow Rounded
+	{"mulx872", "9.999E+999999999", "1", "Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999999, -999999999, false},
+	// mulx873 multiply   -9.999E+999999999 1 -> -Infinity Inexact Overflow Rounded
+	{"mulx873", "-9.999E+999999999", "1", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestEven, 999999999, -999999999, false},
+	// check for double-rounded subnormals
+	// precision: 5
+	// maxexponent: 79
+	// minexponent: -79
+	// mulx881 multiply  1.2347E-40  1.2347E-40  ->  1.524E-80  Inexact Rounded Subnormal Underflow
+	{"mulx881", "1.2347E-40", "1.2347E-40", "1.524E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx882 multiply  1.234E-40  1.234E-40    ->  1.523E-80  Inexact Rounded Subnormal Underflow
+	{"mulx882", "1.234E-40", "1.234E-40", "1.523E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx883 multiply  1.23E-40   1.23E-40     ->  1.513E-80  Inexact Rounded Subnormal Underflow
+	{"mulx883", "1.23E-40", "1.23E-40", "1.513E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx884 multiply  1.2E-40    1.2E-40      ->  1.44E-80   Subnormal
+	{"mulx884", "1.2E-40", "1.2E-40", "1.44E-80", Subnormal, 5, ToNearestEven, 79, -79, false},
+	// mulx885 multiply  1.2E-40    1.2E-41      ->  1.44E-81   Subnormal
+	{"mulx885", "1.2E-40", "1.2E-41", "1.44E-81", Subnormal, 5, ToNearestEven, 79, -79, false},
+	// mulx886 multiply  1.2E-40    1.2E-42      ->  1.4E-82    Subnormal Inexact Rounded Underflow
+	{"mulx886", "1.2E-40", "1.2E-42", "1.4E-82", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx887 multiply  1.2E-40    1.3E-42      ->  1.6E-82    Subnormal Inexact Rounded Underflow
+	{"mulx887", "1.2E-40", "1.3E-42", "1.6E-82", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx888 multiply  1.3E-40    1.3E-42      ->  1.7E-82    Subnormal Inexact Rounded Underflow
+	{"mulx888", "1.3E-40", "1.3E-42", "1.7E-82", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx889 multiply  1.3E-40    1.3E-43      ->    2E-83    Subnormal Inexact Rounded Underflow
+	{"mulx889", "1.3E-40", "1.3E-43", "2E-83", Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx890 multiply  1.3E-41    1.3E-43      ->    0E-83    Clamped Subnormal Inexact Rounded Underflow
+	{"mulx890", "1.3E-41", "1.3E-43", "0E-83", Clamped | Subnormal | Inexact | Rounded | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx891 multiply  1.2345E-39   1.234E-40  ->  1.5234E-79 Inexact Rounded
+	{"mulx891", "1.2345E-39", "1.234E-40", "1.5234E-79", Inexact | Rounded, 5, ToNearestEven, 79, -79, false},
+	// mulx892 multiply  1.23456E-39  1.234E-40  ->  1.5234E-79 Inexact Rounded
+	{"mulx892", "1.23456E-39", "1.234E-40", "1.5234E-79", Inexact | Rounded, 5, ToNearestEven, 79, -79, false},
+	// mulx893 multiply  1.2345E-40   1.234E-40  ->  1.523E-80  Inexact Rounded Subnormal Underflow
+	{"mulx893", "1.2345E-40", "1.234E-40", "1.523E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx894 multiply  1.23456E-40  1.234E-40  ->  1.523E-80  Inexact Rounded Subnormal Underflow
+	{"mulx894", "1.23456E-40", "1.234E-40", "1.523E-80", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx895 multiply  1.2345E-41   1.234E-40  ->  1.52E-81   Inexact Rounded Subnormal Underflow
+	{"mulx895", "1.2345E-41", "1.234E-40", "1.52E-81", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// mulx896 multiply  1.23456E-41  1.234E-40  ->  1.52E-81   Inexact Rounded Subnormal Underflow
+	{"mulx896", "1.23456E-41", "1.234E-40", "1.52E-81", Inexact | Rounded | Subnormal | Underflow, 5, ToNearestEven, 79, -79, false},
+	// Now explore the case where we get a normal result with Underflow
+	// precision: 16
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// mulx900 multiply  0.3000000000E-191 0.3000000000E-191 -> 9.00000000000000E-384 Subnormal Rounded
+	{"mulx900", "0.3000000000E-191", "0.3000000000E-191", "9.00000000000000E-384", Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx901 multiply  0.3000000001E-191 0.3000000001E-191 -> 9.00000000600000E-384 Underflow Inexact Subnormal Rounded
+	{"mulx901", "0.3000000001E-191", "0.3000000001E-191", "9.00000000600000E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx902 multiply  9.999999999999999E-383  0.0999999999999         -> 9.99999999999000E-384 Underflow Inexact Subnormal Rounded
+	{"mulx902", "9.999999999999999E-383", "0.0999999999999", "9.99999999999000E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx903 multiply  9.999999999999999E-383  0.09999999999999        -> 9.99999999999900E-384 Underflow Inexact Subnormal Rounded
+	{"mulx903", "9.999999999999999E-383", "0.09999999999999", "9.99999999999900E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx904 multiply  9.999999999999999E-383  0.099999999999999       -> 9.99999999999990E-384 Underflow Inexact Subnormal Rounded
+	{"mulx904", "9.999999999999999E-383", "0.099999999999999", "9.99999999999990E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx905 multiply  9.999999999999999E-383  0.0999999999999999      -> 9.99999999999999E-384 Underflow Inexact Subnormal Rounded
+	{"mulx905", "9.999999999999999E-383", "0.0999999999999999", "9.99999999999999E-384", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// prove operands are exact
+	// mulx906 multiply  9.999999999999999E-383  1                       -> 9.999999999999999E-383
+	{"mulx906", "9.999999999999999E-383", "1", "9.999999999999999E-383", 0, 16, ToNearestAway, 384, -383, false},
+	// mulx907 multiply                       1  0.09999999999999999     -> 0.09999999999999999
+	{"mulx907", "1", "0.09999999999999999", "0.09999999999999999", 0, 16, ToNearestAway, 384, -383, false},
+	// the next rounds to Nmin
+	// mulx908 multiply  9.999999999999999E-383  0.09999999999999999     -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"mulx908", "9.999999999999999E-383", "0.09999999999999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx909 multiply  9.999999999999999E-383  0.099999999999999999    -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"mulx909", "9.999999999999999E-383", "0.099999999999999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx910 multiply  9.999999999999999E-383  0.0999999999999999999   -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"mulx910", "9.999999999999999E-383", "0.0999999999999999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// mulx911 multiply  9.999999999999999E-383  0.09999999999999999999  -> 1.000000000000000E-383 Underflow Inexact Subnormal Rounded
+	{"mulx911", "9.999999999999999E-383", "0.09999999999999999999", "1.000000000000000E-383", Underflow | Inexact | Subnormal | Rounded, 16, ToNearestAway, 384, -383, false},
+	// Examples from SQL proposal (Krishna Kulkarni)
+	// precision: 34
+	// rounding: half_up
+	// maxexponent: 6144
+	// minexponent: -6143
+	// mulx1001  multiply 130E-2  120E-2 -> 1.5600
+	{"mulx1001", "130E-2", "120E-2", "1.5600", 0, 34, ToNearestAway, 6144, -6143, false},
+	// mulx1002  multiply 130E-2  12E-1  -> 1.560
+	{"mulx1002", "130E-2", "12E-1", "1.560", 0, 34, ToNearestAway, 6144, -6143, false},
+	// mulx1003  multiply 130E-2  1E0    -> 1.30
+	{"mulx1003", "130E-2", "1E0", "1.30", 0, 34, ToNearestAway, 6144, -6143, false},
+	// mulx1004  multiply 1E2     1E4    -> 1E+6
+	{"mulx1004", "1E2", "1E4", "1E+6", 0, 34, ToNearestAway, 6144, -6143, false},
+	// payload decapitate
+	// precision: 5
+	// mulx1010  multiply 11 -sNaN1234567890 -> -NaN67890  Invalid_operation
+	{"mulx1010", "11", "-sNaN1234567890", "-NaN67890", InvalidOperation, 5, ToNearestAway, 6144, -6143, false},
+	// Null tests
+	// SKIP (encoding not supported): mulx990 multiply 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): mulx991 multiply  # 10 -> NaN Invalid_operation
+}
@@ -0,0 +1,238 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var nextminusTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// nextm001 nextminus  0.999999995 ->   0.999999994
+	{"nextm001", "0.999999995", "0.999999994", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm002 nextminus  0.999999996 ->   0.999999995
+	{"nextm002", "0.999999996", "0.999999995", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm003 nextminus  0.999999997 ->   0.999999996
+	{"nextm003", "0.999999997", "0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm004 nextminus  0.999999998 ->   0.999999997
+	{"nextm004", "0.999999998", "0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm005 nextminus  0.999999999 ->   0.999999998
+	{"nextm005", "0.999999999", "0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm006 nextminus  1.00000000  ->   0.999999999
+	{"nextm006", "1.00000000", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm007 nextminus  1.0         ->   0.999999999
+	{"nextm007", "1.0", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm008 nextminus  1           ->   0.999999999
+	{"nextm008", "1", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm009 nextminus  1.00000001  ->   1.00000000
+	{"nextm009", "1.00000001", "1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm010 nextminus  1.00000002  ->   1.00000001
+	{"nextm010", "1.00000002", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm011 nextminus  1.00000003  ->   1.00000002
+	{"nextm011", "1.00000003", "1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm012 nextminus  1.00000004  ->   1.00000003
+	{"nextm012", "1.00000004", "1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm013 nextminus  1.00000005  ->   1.00000004
+	{"nextm013", "1.00000005", "1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm014 nextminus  1.00000006  ->   1.00000005
+	{"nextm014", "1.00000006", "1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm015 nextminus  1.00000007  ->   1.00000006
+	{"nextm015", "1.00000007", "1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm016 nextminus  1.00000008  ->   1.00000007
+	{"nextm016", "1.00000008", "1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm017 nextminus  1.00000009  ->   1.00000008
+	{"nextm017", "1.00000009", "1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm018 nextminus  1.00000010  ->   1.00000009
+	{"nextm018", "1.00000010", "1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm019 nextminus  1.00000011  ->   1.00000010
+	{"nextm019", "1.00000011", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm020 nextminus  1.00000012  ->   1.00000011
+	{"nextm020", "1.00000012", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm021 nextminus -0.999999995 ->  -0.999999996
+	{"nextm021", "-0.999999995", "-0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm022 nextminus -0.999999996 ->  -0.999999997
+	{"nextm022", "-0.999999996", "-0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm023 nextminus -0.999999997 ->  -0.999999998
+	{"nextm023", "-0.999999997", "-0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm024 nextminus -0.999999998 ->  -0.999999999
+	{"nextm024", "-0.999999998", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm025 nextminus -0.999999999 ->  -1.00000000
+	{"nextm025", "-0.999999999", "-1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm026 nextminus -1.00000000  ->  -1.00000001
+	{"nextm026", "-1.00000000", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm027 nextminus -1.0         ->  -1.00000001
+	{"nextm027", "-1.0", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm028 nextminus -1           ->  -1.00000001
+	{"nextm028", "-1", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm029 nextminus -1.00000001  ->  -1.00000002
+	{"nextm029", "-1.00000001", "-1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm030 nextminus -1.00000002  ->  -1.00000003
+	{"nextm030", "-1.00000002", "-1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm031 nextminus -1.00000003  ->  -1.00000004
+	{"nextm031", "-1.00000003", "-1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm032 nextminus -1.00000004  ->  -1.00000005
+	{"nextm032", "-1.00000004", "-1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm033 nextminus -1.00000005  ->  -1.00000006
+	{"nextm033", "-1.00000005", "-1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm034 nextminus -1.00000006  ->  -1.00000007
+	{"nextm034", "-1.00000006", "-1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm035 nextminus -1.00000007  ->  -1.00000008
+	{"nextm035", "-1.00000007", "-1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm036 nextminus -1.00000008  ->  -1.00000009
+	{"nextm036", "-1.00000008", "-1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm037 nextminus -1.00000009  ->  -1.00000010
+	{"nextm037", "-1.00000009", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm038 nextminus -1.00000010  ->  -1.00000011
+	{"nextm038", "-1.00000010", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm039 nextminus -1.00000011  ->  -1.00000012
+	{"nextm039", "-1.00000011", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// input operand is >precision
+	// nextm041 nextminus  1.00000010998  ->   1.00000010
+	{"nextm041", "1.00000010998", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm042 nextminus  1.00000010999  ->   1.00000010
+	{"nextm042", "1.00000010999", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm043 nextminus  1.00000011000  ->   1.00000010
+	{"nextm043", "1.00000011000", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm044 nextminus  1.00000011001  ->   1.00000011
+	{"nextm044", "1.00000011001", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm045 nextminus  1.00000011002  ->   1.00000011
+	{"nextm045", "1.00000011002", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm046 nextminus  1.00000011002  ->   1.00000011
+	{"nextm046", "1.00000011002", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm047 nextminus  1.00000011052  ->   1.00000011
+	{"nextm047", "1.00000011052", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm048 nextminus  1.00000011552  ->   1.00000011
+	{"nextm048", "1.00000011552", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm049 nextminus -1.00000010998  ->  -1.00000011
+	{"nextm049", "-1.00000010998", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm050 nextminus -1.00000010999  ->  -1.00000011
+	{"nextm050", "-1.00000010999", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm051 nextminus -1.00000011000  ->  -1.00000012
+	{"nextm051", "-1.00000011000", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm052 nextminus -1.00000011001  ->  -1.00000012
+	{"nextm052", "-1.00000011001", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm053 nextminus -1.00000011002  ->  -1.00000012
+	{"nextm053", "-1.00000011002", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm054 nextminus -1.00000011002  ->  -1.00000012
+	{"nextm054", "-1.00000011002", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm055 nextminus -1.00000011052  ->  -1.00000012
+	{"nextm055", "-1.00000011052", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm056 nextminus -1.00000011552  ->  -1.00000012
+	{"nextm056", "-1.00000011552", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// ultra-tiny inputs
+	// nextm060 nextminus  1E-99999       ->   0E-391
+	{"nextm060", "1E-99999", "0E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm061 nextminus  1E-999999999   ->   0E-391
+	{"nextm061", "1E-999999999", "0E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm062 nextminus  1E-391         ->   0E-391
+	{"nextm062", "1E-391", "0E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm063 nextminus -1E-99999       ->  -1E-391
+	{"nextm063", "-1E-99999", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm064 nextminus -1E-999999999   ->  -1E-391
+	{"nextm064", "-1E-999999999", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm065 nextminus -1E-391         ->  -2E-391
+	{"nextm065", "-1E-391", "-2E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// Zeros
+	// nextm100 nextminus -0           -> -1E-391
+	{"nextm100", "-0", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm101 nextminus  0           -> -1E-391
+	{"nextm101", "0", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm102 nextminus  0.00        -> -1E-391
+	{"nextm102", "0.00", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm103 nextminus -0.00        -> -1E-391
+	{"nextm103", "-0.00", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm104 nextminus  0E-300      -> -1E-391
+	{"nextm104", "0E-300", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm105 nextminus  0E+300      -> -1E-391
+	{"nextm105", "0E+300", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm106 nextminus  0E+30000    -> -1E-391
+	{"nextm106", "0E+30000", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextm107 nextminus -0E+30000    -> -1E-391
+	{"nextm107", "-0E+30000", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// maxexponent: 999
+	// minexponent: -999
+	// specials
+	// nextm150 nextminus   Inf    ->  9.99999999E+999
+	{"nextm150", "Inf", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm151 nextminus  -Inf    -> -Infinity
+	{"nextm151", "-Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm152 nextminus   NaN    ->  NaN
+	{"nextm152", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm153 nextminus  sNaN    ->  NaN   Invalid_operation
+	{"nextm153", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// nextm154 nextminus   NaN77  ->  NaN77
+	{"nextm154", "NaN77", "NaN77", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm155 nextminus  sNaN88  ->  NaN88 Invalid_operation
+	{"nextm155", "sNaN88", "NaN88", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// nextm156 nextminus  -NaN    -> -NaN
+	{"nextm156", "-NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm157 nextminus -sNaN    -> -NaN   Invalid_operation
+	{"nextm157", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// nextm158 nextminus  -NaN77  -> -NaN77
+	{"nextm158", "-NaN77", "-NaN77", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm159 nextminus -sNaN88  -> -NaN88 Invalid_operation
+	{"nextm159", "-sNaN88", "-NaN88", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny, subnormals
+	// nextm170 nextminus  9.99999999E+999   -> 9.99999998E+999
+	{"nextm170", "9.99999999E+999", "9.99999998E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm171 nextminus  9.99999998E+999   -> 9.99999997E+999
+	{"nextm171", "9.99999998E+999", "9.99999997E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm172 nextminus  1E-999            -> 9.9999999E-1000
+	{"nextm172", "1E-999", "9.9999999E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm173 nextminus  1.00000000E-999   -> 9.9999999E-1000
+	{"nextm173", "1.00000000E-999", "9.9999999E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm174 nextminus  9E-1007           -> 8E-1007
+	{"nextm174", "9E-1007", "8E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm175 nextminus  9.9E-1006         -> 9.8E-1006
+	{"nextm175", "9.9E-1006", "9.8E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm176 nextminus  9.9999E-1003      -> 9.9998E-1003
+	{"nextm176", "9.9999E-1003", "9.9998E-1003", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm177 nextminus  9.9999999E-1000   -> 9.9999998E-1000
+	{"nextm177", "9.9999999E-1000", "9.9999998E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm178 nextminus  9.9999998E-1000   -> 9.9999997E-1000
+	{"nextm178", "9.9999998E-1000", "9.9999997E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm179 nextminus  9.9999997E-1000   -> 9.9999996E-1000
+	{"nextm179", "9.9999997E-1000", "9.9999996E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm180 nextminus  0E-1007           -> -1E-1007
+	{"nextm180", "0E-1007", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm181 nextminus  1E-1007           -> 0E-1007
+	{"nextm181", "1E-1007", "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm182 nextminus  2E-1007           -> 1E-1007
+	{"nextm182", "2E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm183 nextminus  -0E-1007          -> -1E-1007
+	{"nextm183", "-0E-1007", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm184 nextminus  -1E-1007          -> -2E-1007
+	{"nextm184", "-1E-1007", "-2E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm185 nextminus  -2E-1007          -> -3E-1007
+	{"nextm185", "-2E-1007", "-3E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm186 nextminus  -10E-1007         -> -1.1E-1006
+	{"nextm186", "-10E-1007", "-1.1E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm187 nextminus  -100E-1007        -> -1.01E-1005
+	{"nextm187", "-100E-1007", "-1.01E-1005", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm188 nextminus  -100000E-1007     -> -1.00001E-1002
+	{"nextm188", "-100000E-1007", "-1.00001E-1002", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm189 nextminus  -1.0000E-999      -> -1.00000001E-999
+	{"nextm189", "-1.0000E-999", "-1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm190 nextminus  -1.00000000E-999  -> -1.00000001E-999
+	{"nextm190", "-1.00000000E-999", "-1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm191 nextminus  -1E-999           -> -1.00000001E-999
+	{"nextm191", "-1E-999", "-1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm192 nextminus  -9.99999998E+999  -> -9.99999999E+999
+	{"nextm192", "-9.99999998E+999", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextm193 nextminus  -9.99999999E+999  -> -Infinity
+	{"nextm193", "-9.99999999E+999", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): nextm900 nextminus  # -> NaN Invalid_operation
+}
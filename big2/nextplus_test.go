@@ -0,0 +1,242 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var nextplusTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// nextp001 nextplus  0.999999995 ->   0.999999996
+	{"nextp001", "0.999999995", "0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp002 nextplus  0.999999996 ->   0.999999997
+	{"nextp002", "0.999999996", "0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp003 nextplus  0.999999997 ->   0.999999998
+	{"nextp003", "0.999999997", "0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp004 nextplus  0.999999998 ->   0.999999999
+	{"nextp004", "0.999999998", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp005 nextplus  0.999999999 ->   1.00000000
+	{"nextp005", "0.999999999", "1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp006 nextplus  1.00000000  ->   1.00000001
+	{"nextp006", "1.00000000", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp007 nextplus  1.0         ->   1.00000001
+	{"nextp007", "1.0", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp008 nextplus  1           ->   1.00000001
+	{"nextp008", "1", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp009 nextplus  1.00000001  ->   1.00000002
+	{"nextp009", "1.00000001", "1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp010 nextplus  1.00000002  ->   1.00000003
+	{"nextp010", "1.00000002", "1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp011 nextplus  1.00000003  ->   1.00000004
+	{"nextp011", "1.00000003", "1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp012 nextplus  1.00000004  ->   1.00000005
+	{"nextp012", "1.00000004", "1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp013 nextplus  1.00000005  ->   1.00000006
+	{"nextp013", "1.00000005", "1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp014 nextplus  1.00000006  ->   1.00000007
+	{"nextp014", "1.00000006", "1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp015 nextplus  1.00000007  ->   1.00000008
+	{"nextp015", "1.00000007", "1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp016 nextplus  1.00000008  ->   1.00000009
+	{"nextp016", "1.00000008", "1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp017 nextplus  1.00000009  ->   1.00000010
+	{"nextp017", "1.00000009", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp018 nextplus  1.00000010  ->   1.00000011
+	{"nextp018", "1.00000010", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp019 nextplus  1.00000011  ->   1.00000012
+	{"nextp019", "1.00000011", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp021 nextplus -0.999999995 ->  -0.999999994
+	{"nextp021", "-0.999999995", "-0.999999994", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp022 nextplus -0.999999996 ->  -0.999999995
+	{"nextp022", "-0.999999996", "-0.999999995", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp023 nextplus -0.999999997 ->  -0.999999996
+	{"nextp023", "-0.999999997", "-0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp024 nextplus -0.999999998 ->  -0.999999997
+	{"nextp024", "-0.999999998", "-0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp025 nextplus -0.999999999 ->  -0.999999998
+	{"nextp025", "-0.999999999", "-0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp026 nextplus -1.00000000  ->  -0.999999999
+	{"nextp026", "-1.00000000", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp027 nextplus -1.0         ->  -0.999999999
+	{"nextp027", "-1.0", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp028 nextplus -1           ->  -0.999999999
+	{"nextp028", "-1", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp029 nextplus -1.00000001  ->  -1.00000000
+	{"nextp029", "-1.00000001", "-1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp030 nextplus -1.00000002  ->  -1.00000001
+	{"nextp030", "-1.00000002", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp031 nextplus -1.00000003  ->  -1.00000002
+	{"nextp031", "-1.00000003", "-1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp032 nextplus -1.00000004  ->  -1.00000003
+	{"nextp032", "-1.00000004", "-1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp033 nextplus -1.00000005  ->  -1.00000004
+	{"nextp033", "-1.00000005", "-1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp034 nextplus -1.00000006  ->  -1.00000005
+	{"nextp034", "-1.00000006", "-1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp035 nextplus -1.00000007  ->  -1.00000006
+	{"nextp035", "-1.00000007", "-1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp036 nextplus -1.00000008  ->  -1.00000007
+	{"nextp036", "-1.00000008", "-1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp037 nextplus -1.00000009  ->  -1.00000008
+	{"nextp037", "-1.00000009", "-1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp038 nextplus -1.00000010  ->  -1.00000009
+	{"nextp038", "-1.00000010", "-1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp039 nextplus -1.00000011  ->  -1.00000010
+	{"nextp039", "-1.00000011", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp040 nextplus -1.00000012  ->  -1.00000011
+	{"nextp040", "-1.00000012", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// input operand is >precision
+	// nextp041 nextplus  1.00000010998  ->   1.00000011
+	{"nextp041", "1.00000010998", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp042 nextplus  1.00000010999  ->   1.00000011
+	{"nextp042", "1.00000010999", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp043 nextplus  1.00000011000  ->   1.00000012
+	{"nextp043", "1.00000011000", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp044 nextplus  1.00000011001  ->   1.00000012
+	{"nextp044", "1.00000011001", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp045 nextplus  1.00000011002  ->   1.00000012
+	{"nextp045", "1.00000011002", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp046 nextplus  1.00000011002  ->   1.00000012
+	{"nextp046", "1.00000011002", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp047 nextplus  1.00000011052  ->   1.00000012
+	{"nextp047", "1.00000011052", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp048 nextplus  1.00000011552  ->   1.00000012
+	{"nextp048", "1.00000011552", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp049 nextplus -1.00000010998  ->  -1.00000010
+	{"nextp049", "-1.00000010998", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp050 nextplus -1.00000010999  ->  -1.00000010
+	{"nextp050", "-1.00000010999", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp051 nextplus -1.00000011000  ->  -1.00000010
+	{"nextp051", "-1.00000011000", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp052 nextplus -1.00000011001  ->  -1.00000011
+	{"nextp052", "-1.00000011001", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp053 nextplus -1.00000011002  ->  -1.00000011
+	{"nextp053", "-1.00000011002", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp054 nextplus -1.00000011002  ->  -1.00000011
+	{"nextp054", "-1.00000011002", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp055 nextplus -1.00000011052  ->  -1.00000011
+	{"nextp055", "-1.00000011052", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp056 nextplus -1.00000011552  ->  -1.00000011
+	{"nextp056", "-1.00000011552", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// ultra-tiny inputs
+	// nextp060 nextplus  1E-99999       ->   1E-391
+	{"nextp060", "1E-99999", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp061 nextplus  1E-999999999   ->   1E-391
+	{"nextp061", "1E-999999999", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp062 nextplus  1E-391         ->   2E-391
+	{"nextp062", "1E-391", "2E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp063 nextplus -1E-99999       ->  -0E-391
+	{"nextp063", "-1E-99999", "-0E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp064 nextplus -1E-999999999   ->  -0E-391
+	{"nextp064", "-1E-999999999", "-0E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp065 nextplus -1E-391         ->  -0E-391
+	{"nextp065", "-1E-391", "-0E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// Zeros
+	// nextp100 nextplus  0           ->  1E-391
+	{"nextp100", "0", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp101 nextplus  0.00        ->  1E-391
+	{"nextp101", "0.00", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp102 nextplus  0E-300      ->  1E-391
+	{"nextp102", "0E-300", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp103 nextplus  0E+300      ->  1E-391
+	{"nextp103", "0E+300", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp104 nextplus  0E+30000    ->  1E-391
+	{"nextp104", "0E+30000", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp105 nextplus -0           ->  1E-391
+	{"nextp105", "-0", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp106 nextplus -0.00        ->  1E-391
+	{"nextp106", "-0.00", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp107 nextplus -0E-300      ->  1E-391
+	{"nextp107", "-0E-300", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp108 nextplus -0E+300      ->  1E-391
+	{"nextp108", "-0E+300", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextp109 nextplus -0E+30000    ->  1E-391
+	{"nextp109", "-0E+30000", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// specials
+	// nextp150 nextplus   Inf    ->  Infinity
+	{"nextp150", "Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp151 nextplus  -Inf    -> -9.99999999E+999
+	{"nextp151", "-Inf", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp152 nextplus   NaN    ->  NaN
+	{"nextp152", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp153 nextplus  sNaN    ->  NaN   Invalid_operation
+	{"nextp153", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// nextp154 nextplus   NaN77  ->  NaN77
+	{"nextp154", "NaN77", "NaN77", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp155 nextplus  sNaN88  ->  NaN88 Invalid_operation
+	{"nextp155", "sNaN88", "NaN88", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// nextp156 nextplus  -NaN    -> -NaN
+	{"nextp156", "-NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp157 nextplus -sNaN    -> -NaN   Invalid_operation
+	{"nextp157", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// nextp158 nextplus  -NaN77  -> -NaN77
+	{"nextp158", "-NaN77", "-NaN77", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp159 nextplus -sNaN88  -> -NaN88 Invalid_operation
+	{"nextp159", "-sNaN88", "-NaN88", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny, subnormals
+	// nextp170 nextplus  9.99999999E+999   -> Infinity
+	{"nextp170", "9.99999999E+999", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp171 nextplus  9.99999998E+999   -> 9.99999999E+999
+	{"nextp171", "9.99999998E+999", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp172 nextplus  1E-999            -> 1.00000001E-999
+	{"nextp172", "1E-999", "1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp173 nextplus  1.00000000E-999   -> 1.00000001E-999
+	{"nextp173", "1.00000000E-999", "1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp174 nextplus  9E-1007           -> 1.0E-1006
+	{"nextp174", "9E-1007", "1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp175 nextplus  9.9E-1006         -> 1.00E-1005
+	{"nextp175", "9.9E-1006", "1.00E-1005", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp176 nextplus  9.9999E-1003      -> 1.00000E-1002
+	{"nextp176", "9.9999E-1003", "1.00000E-1002", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp177 nextplus  9.9999999E-1000   -> 1.00000000E-999
+	{"nextp177", "9.9999999E-1000", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp178 nextplus  9.9999998E-1000   -> 9.9999999E-1000
+	{"nextp178", "9.9999998E-1000", "9.9999999E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp179 nextplus  9.9999997E-1000   -> 9.9999998E-1000
+	{"nextp179", "9.9999997E-1000", "9.9999998E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp180 nextplus  0E-1007           -> 1E-1007
+	{"nextp180", "0E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp181 nextplus  1E-1007           -> 2E-1007
+	{"nextp181", "1E-1007", "2E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp182 nextplus  2E-1007           -> 3E-1007
+	{"nextp182", "2E-1007", "3E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp183 nextplus  -0E-1007          ->  1E-1007
+	{"nextp183", "-0E-1007", "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp184 nextplus  -1E-1007          -> -0E-1007
+	{"nextp184", "-1E-1007", "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp185 nextplus  -2E-1007          -> -1E-1007
+	{"nextp185", "-2E-1007", "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp186 nextplus  -10E-1007         -> -9E-1007
+	{"nextp186", "-10E-1007", "-9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp187 nextplus  -100E-1007        -> -9.9E-1006
+	{"nextp187", "-100E-1007", "-9.9E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp188 nextplus  -100000E-1007     -> -9.9999E-1003
+	{"nextp188", "-100000E-1007", "-9.9999E-1003", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp189 nextplus  -1.0000E-999      -> -9.9999999E-1000
+	{"nextp189", "-1.0000E-999", "-9.9999999E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp190 nextplus  -1.00000000E-999  -> -9.9999999E-1000
+	{"nextp190", "-1.00000000E-999", "-9.9999999E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp191 nextplus  -1E-999           -> -9.9999999E-1000
+	{"nextp191", "-1E-999", "-9.9999999E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp192 nextplus  -9.99999998E+999  -> -9.99999997E+999
+	{"nextp192", "-9.99999998E+999", "-9.99999997E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextp193 nextplus  -9.99999999E+999  -> -9.99999998E+999
+	{"nextp193", "-9.99999999E+999", "-9.99999998E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): nextp900 nextplus  # -> NaN Invalid_operation
+}
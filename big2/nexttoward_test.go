@@ -0,0 +1,732 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var nexttowardTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// Sanity check with a scattering of numerics
+	// nextt001 nexttoward   10    10   ->  10
+	{"nextt001", "10", "10", "10", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt002 nexttoward  -10   -10   -> -10
+	{"nextt002", "-10", "-10", "-10", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt003 nexttoward   1     10   ->  1.00000001
+	{"nextt003", "1", "10", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt004 nexttoward   1    -10   ->  0.999999999
+	{"nextt004", "1", "-10", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt005 nexttoward  -1     10   -> -0.999999999
+	{"nextt005", "-1", "10", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt006 nexttoward  -1    -10   -> -1.00000001
+	{"nextt006", "-1", "-10", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt007 nexttoward   0     10   ->  1E-391       Underflow Subnormal Inexact Rounded
+	{"nextt007", "0", "10", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt008 nexttoward   0    -10   -> -1E-391       Underflow Subnormal Inexact Rounded
+	{"nextt008", "0", "-10", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt009 nexttoward   9.99999999E+384 +Infinity ->  Infinity Overflow Inexact Rounded
+	{"nextt009", "9.99999999E+384", "Inf", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt010 nexttoward  -9.99999999E+384 -Infinity -> -Infinity Overflow Inexact Rounded
+	{"nextt010", "-9.99999999E+384", "-Inf", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	//----- lhs=rhs
+	// finites
+	// nextt101 nexttoward          7       7 ->  7
+	{"nextt101", "7", "7", "7", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt102 nexttoward         -7      -7 -> -7
+	{"nextt102", "-7", "-7", "-7", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt103 nexttoward         75      75 ->  75
+	{"nextt103", "75", "75", "75", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt104 nexttoward        -75     -75 -> -75
+	{"nextt104", "-75", "-75", "-75", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt105 nexttoward       7.50     7.5 ->  7.50
+	{"nextt105", "7.50", "7.5", "7.50", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt106 nexttoward      -7.50   -7.50 -> -7.50
+	{"nextt106", "-7.50", "-7.50", "-7.50", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt107 nexttoward       7.500 7.5000 ->  7.500
+	{"nextt107", "7.500", "7.5000", "7.500", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt108 nexttoward      -7.500   -7.5 -> -7.500
+	{"nextt108", "-7.500", "-7.5", "-7.500", 0, 9, ToNearestAway, 384, -383, false},
+	// zeros
+	// nextt111 nexttoward          0       0 ->  0
+	{"nextt111", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt112 nexttoward         -0      -0 -> -0
+	{"nextt112", "-0", "-0", "-0", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt113 nexttoward       0E+4       0 ->  0E+4
+	{"nextt113", "0E+4", "0", "0E+4", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt114 nexttoward      -0E+4      -0 -> -0E+4
+	{"nextt114", "-0E+4", "-0", "-0E+4", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt115 nexttoward     0.0000 0.00000 ->  0.0000
+	{"nextt115", "0.0000", "0.00000", "0.0000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt116 nexttoward    -0.0000   -0.00 -> -0.0000
+	{"nextt116", "-0.0000", "-0.00", "-0.0000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt117 nexttoward      0E-141      0 ->  0E-141
+	{"nextt117", "0E-141", "0", "0E-141", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt118 nexttoward     -0E-141   -000 -> -0E-141
+	{"nextt118", "-0E-141", "-000", "-0E-141", 0, 9, ToNearestAway, 384, -383, false},
+	// full coefficients, alternating bits
+	// nextt121 nexttoward   268268268    268268268 ->   268268268
+	{"nextt121", "268268268", "268268268", "268268268", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt122 nexttoward  -268268268   -268268268 ->  -268268268
+	{"nextt122", "-268268268", "-268268268", "-268268268", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt123 nexttoward   134134134    134134134 ->   134134134
+	{"nextt123", "134134134", "134134134", "134134134", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt124 nexttoward  -134134134   -134134134 ->  -134134134
+	{"nextt124", "-134134134", "-134134134", "-134134134", 0, 9, ToNearestAway, 384, -383, false},
+	// Nmax, Nmin, Ntiny
+	// nextt131 nexttoward  9.99999999E+384  9.99999999E+384   ->   9.99999999E+384
+	{"nextt131", "9.99999999E+384", "9.99999999E+384", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt132 nexttoward  1E-383           1E-383            ->   1E-383
+	{"nextt132", "1E-383", "1E-383", "1E-383", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt133 nexttoward  1.00000000E-383  1.00000000E-383   ->   1.00000000E-383
+	{"nextt133", "1.00000000E-383", "1.00000000E-383", "1.00000000E-383", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt134 nexttoward  1E-391           1E-391            ->   1E-391
+	{"nextt134", "1E-391", "1E-391", "1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt135 nexttoward  -1E-391          -1E-391           ->  -1E-391
+	{"nextt135", "-1E-391", "-1E-391", "-1E-391", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt136 nexttoward  -1.00000000E-383 -1.00000000E-383  ->  -1.00000000E-383
+	{"nextt136", "-1.00000000E-383", "-1.00000000E-383", "-1.00000000E-383", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt137 nexttoward  -1E-383          -1E-383           ->  -1E-383
+	{"nextt137", "-1E-383", "-1E-383", "-1E-383", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt138 nexttoward  -9.99999999E+384 -9.99999999E+384  ->  -9.99999999E+384
+	{"nextt138", "-9.99999999E+384", "-9.99999999E+384", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	//----- lhs<rhs
+	// nextt201 nexttoward  0.999999995 Infinity ->   0.999999996
+	{"nextt201", "0.999999995", "Inf", "0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt202 nexttoward  0.999999996 Infinity ->   0.999999997
+	{"nextt202", "0.999999996", "Inf", "0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt203 nexttoward  0.999999997 Infinity ->   0.999999998
+	{"nextt203", "0.999999997", "Inf", "0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt204 nexttoward  0.999999998 Infinity ->   0.999999999
+	{"nextt204", "0.999999998", "Inf", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt205 nexttoward  0.999999999 Infinity ->   1.00000000
+	{"nextt205", "0.999999999", "Inf", "1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt206 nexttoward  1.00000000  Infinity ->   1.00000001
+	{"nextt206", "1.00000000", "Inf", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt207 nexttoward  1.0         Infinity ->   1.00000001
+	{"nextt207", "1.0", "Inf", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt208 nexttoward  1           Infinity ->   1.00000001
+	{"nextt208", "1", "Inf", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt209 nexttoward  1.00000001  Infinity ->   1.00000002
+	{"nextt209", "1.00000001", "Inf", "1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt210 nexttoward  1.00000002  Infinity ->   1.00000003
+	{"nextt210", "1.00000002", "Inf", "1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt211 nexttoward  1.00000003  Infinity ->   1.00000004
+	{"nextt211", "1.00000003", "Inf", "1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt212 nexttoward  1.00000004  Infinity ->   1.00000005
+	{"nextt212", "1.00000004", "Inf", "1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt213 nexttoward  1.00000005  Infinity ->   1.00000006
+	{"nextt213", "1.00000005", "Inf", "1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt214 nexttoward  1.00000006  Infinity ->   1.00000007
+	{"nextt214", "1.00000006", "Inf", "1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt215 nexttoward  1.00000007  Infinity ->   1.00000008
+	{"nextt215", "1.00000007", "Inf", "1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt216 nexttoward  1.00000008  Infinity ->   1.00000009
+	{"nextt216", "1.00000008", "Inf", "1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt217 nexttoward  1.00000009  Infinity ->   1.00000010
+	{"nextt217", "1.00000009", "Inf", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt218 nexttoward  1.00000010  Infinity ->   1.00000011
+	{"nextt218", "1.00000010", "Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt219 nexttoward  1.00000011  Infinity ->   1.00000012
+	{"nextt219", "1.00000011", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt221 nexttoward -0.999999995 Infinity ->  -0.999999994
+	{"nextt221", "-0.999999995", "Inf", "-0.999999994", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt222 nexttoward -0.999999996 Infinity ->  -0.999999995
+	{"nextt222", "-0.999999996", "Inf", "-0.999999995", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt223 nexttoward -0.999999997 Infinity ->  -0.999999996
+	{"nextt223", "-0.999999997", "Inf", "-0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt224 nexttoward -0.999999998 Infinity ->  -0.999999997
+	{"nextt224", "-0.999999998", "Inf", "-0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt225 nexttoward -0.999999999 Infinity ->  -0.999999998
+	{"nextt225", "-0.999999999", "Inf", "-0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt226 nexttoward -1.00000000  Infinity ->  -0.999999999
+	{"nextt226", "-1.00000000", "Inf", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt227 nexttoward -1.0         Infinity ->  -0.999999999
+	{"nextt227", "-1.0", "Inf", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt228 nexttoward -1           Infinity ->  -0.999999999
+	{"nextt228", "-1", "Inf", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt229 nexttoward -1.00000001  Infinity ->  -1.00000000
+	{"nextt229", "-1.00000001", "Inf", "-1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt230 nexttoward -1.00000002  Infinity ->  -1.00000001
+	{"nextt230", "-1.00000002", "Inf", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt231 nexttoward -1.00000003  Infinity ->  -1.00000002
+	{"nextt231", "-1.00000003", "Inf", "-1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt232 nexttoward -1.00000004  Infinity ->  -1.00000003
+	{"nextt232", "-1.00000004", "Inf", "-1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt233 nexttoward -1.00000005  Infinity ->  -1.00000004
+	{"nextt233", "-1.00000005", "Inf", "-1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt234 nexttoward -1.00000006  Infinity ->  -1.00000005
+	{"nextt234", "-1.00000006", "Inf", "-1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt235 nexttoward -1.00000007  Infinity ->  -1.00000006
+	{"nextt235", "-1.00000007", "Inf", "-1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt236 nexttoward -1.00000008  Infinity ->  -1.00000007
+	{"nextt236", "-1.00000008", "Inf", "-1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt237 nexttoward -1.00000009  Infinity ->  -1.00000008
+	{"nextt237", "-1.00000009", "Inf", "-1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt238 nexttoward -1.00000010  Infinity ->  -1.00000009
+	{"nextt238", "-1.00000010", "Inf", "-1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt239 nexttoward -1.00000011  Infinity ->  -1.00000010
+	{"nextt239", "-1.00000011", "Inf", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt240 nexttoward -1.00000012  Infinity ->  -1.00000011
+	{"nextt240", "-1.00000012", "Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// input operand is >precision
+	// nextt241 nexttoward  1.00000010998  Infinity ->   1.00000011
+	{"nextt241", "1.00000010998", "Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt242 nexttoward  1.00000010999  Infinity ->   1.00000011
+	{"nextt242", "1.00000010999", "Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt243 nexttoward  1.00000011000  Infinity ->   1.00000012
+	{"nextt243", "1.00000011000", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt244 nexttoward  1.00000011001  Infinity ->   1.00000012
+	{"nextt244", "1.00000011001", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt245 nexttoward  1.00000011002  Infinity ->   1.00000012
+	{"nextt245", "1.00000011002", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt246 nexttoward  1.00000011002  Infinity ->   1.00000012
+	{"nextt246", "1.00000011002", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt247 nexttoward  1.00000011052  Infinity ->   1.00000012
+	{"nextt247", "1.00000011052", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt248 nexttoward  1.00000011552  Infinity ->   1.00000012
+	{"nextt248", "1.00000011552", "Inf", "1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt249 nexttoward -1.00000010998  Infinity ->  -1.00000010
+	{"nextt249", "-1.00000010998", "Inf", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt250 nexttoward -1.00000010999  Infinity ->  -1.00000010
+	{"nextt250", "-1.00000010999", "Inf", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt251 nexttoward -1.00000011000  Infinity ->  -1.00000010
+	{"nextt251", "-1.00000011000", "Inf", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt252 nexttoward -1.00000011001  Infinity ->  -1.00000011
+	{"nextt252", "-1.00000011001", "Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt253 nexttoward -1.00000011002  Infinity ->  -1.00000011
+	{"nextt253", "-1.00000011002", "Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt254 nexttoward -1.00000011002  Infinity ->  -1.00000011
+	{"nextt254", "-1.00000011002", "Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt255 nexttoward -1.00000011052  Infinity ->  -1.00000011
+	{"nextt255", "-1.00000011052", "Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt256 nexttoward -1.00000011552  Infinity ->  -1.00000011
+	{"nextt256", "-1.00000011552", "Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// ultra-tiny inputs
+	// nextt260 nexttoward  1E-99999       Infinity ->   1E-391          Underflow Subnormal Inexact Rounded
+	{"nextt260", "1E-99999", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt261 nexttoward  1E-999999999   Infinity ->   1E-391          Underflow Subnormal Inexact Rounded
+	{"nextt261", "1E-999999999", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt262 nexttoward  1E-391         Infinity ->   2E-391          Underflow Subnormal Inexact Rounded
+	{"nextt262", "1E-391", "Inf", "2E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt263 nexttoward -1E-99999       Infinity ->  -0E-391          Underflow Subnormal Inexact Rounded Clamped
+	{"nextt263", "-1E-99999", "Inf", "-0E-391", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 384, -383, false},
+	// nextt264 nexttoward -1E-999999999   Infinity ->  -0E-391          Underflow Subnormal Inexact Rounded Clamped
+	{"nextt264", "-1E-999999999", "Inf", "-0E-391", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 384, -383, false},
+	// nextt265 nexttoward -1E-391         Infinity ->  -0E-391          Underflow Subnormal Inexact Rounded Clamped
+	{"nextt265", "-1E-391", "Inf", "-0E-391", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 384, -383, false},
+	// Zeros
+	// nextt300 nexttoward  0           Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt300", "0", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt301 nexttoward  0.00        Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt301", "0.00", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt302 nexttoward  0E-300      Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt302", "0E-300", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt303 nexttoward  0E+300      Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt303", "0E+300", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt304 nexttoward  0E+30000    Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt304", "0E+30000", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt305 nexttoward -0           Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt305", "-0", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt306 nexttoward -0.00        Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt306", "-0.00", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt307 nexttoward -0E-300      Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt307", "-0E-300", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt308 nexttoward -0E+300      Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt308", "-0E+300", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt309 nexttoward -0E+30000    Infinity ->  1E-391              Underflow Subnormal Inexact Rounded
+	{"nextt309", "-0E+30000", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// specials
+	// nextt350 nexttoward   Inf    Infinity ->  Infinity
+	{"nextt350", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt351 nexttoward  -Inf    Infinity -> -9.99999999E+384
+	{"nextt351", "-Inf", "Inf", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt352 nexttoward   NaN    Infinity ->  NaN
+	{"nextt352", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt353 nexttoward  sNaN    Infinity ->  NaN   Invalid_operation
+	{"nextt353", "sNaN", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt354 nexttoward   NaN77  Infinity ->  NaN77
+	{"nextt354", "NaN77", "Inf", "NaN77", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt355 nexttoward  sNaN88  Infinity ->  NaN88 Invalid_operation
+	{"nextt355", "sNaN88", "Inf", "NaN88", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt356 nexttoward  -NaN    Infinity -> -NaN
+	{"nextt356", "-NaN", "Inf", "-NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt357 nexttoward -sNaN    Infinity -> -NaN   Invalid_operation
+	{"nextt357", "-sNaN", "Inf", "-NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt358 nexttoward  -NaN77  Infinity -> -NaN77
+	{"nextt358", "-NaN77", "Inf", "-NaN77", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt359 nexttoward -sNaN88  Infinity -> -NaN88 Invalid_operation
+	{"nextt359", "-sNaN88", "Inf", "-NaN88", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// Nmax, Nmin, Ntiny, subnormals
+	// maxexponent: 999
+	// minexponent: -999
+	// nextt370 nexttoward  9.99999999E+999   Infinity -> Infinity        Overflow Inexact Rounded
+	{"nextt370", "9.99999999E+999", "Inf", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt371 nexttoward  9.99999998E+999   Infinity -> 9.99999999E+999
+	{"nextt371", "9.99999998E+999", "Inf", "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt372 nexttoward  1E-999            Infinity -> 1.00000001E-999
+	{"nextt372", "1E-999", "Inf", "1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt373 nexttoward  1.00000000E-999   Infinity -> 1.00000001E-999
+	{"nextt373", "1.00000000E-999", "Inf", "1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt374 nexttoward  0.999999999E-999  Infinity -> 1.00000000E-999
+	{"nextt374", "0.999999999E-999", "Inf", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt375 nexttoward  0.99999999E-999   Infinity -> 1.00000000E-999
+	{"nextt375", "0.99999999E-999", "Inf", "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt376 nexttoward  9E-1007           Infinity -> 1.0E-1006       Underflow Subnormal Inexact Rounded
+	{"nextt376", "9E-1007", "Inf", "1.0E-1006", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt377 nexttoward  9.9E-1006         Infinity -> 1.00E-1005      Underflow Subnormal Inexact Rounded
+	{"nextt377", "9.9E-1006", "Inf", "1.00E-1005", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt378 nexttoward  9.9999E-1003      Infinity -> 1.00000E-1002   Underflow Subnormal Inexact Rounded
+	{"nextt378", "9.9999E-1003", "Inf", "1.00000E-1002", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt379 nexttoward  9.9999998E-1000   Infinity -> 9.9999999E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt379", "9.9999998E-1000", "Inf", "9.9999999E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt380 nexttoward  9.9999997E-1000   Infinity -> 9.9999998E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt380", "9.9999997E-1000", "Inf", "9.9999998E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt381 nexttoward  0E-1007           Infinity -> 1E-1007         Underflow Subnormal Inexact Rounded
+	{"nextt381", "0E-1007", "Inf", "1E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt382 nexttoward  1E-1007           Infinity -> 2E-1007         Underflow Subnormal Inexact Rounded
+	{"nextt382", "1E-1007", "Inf", "2E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt383 nexttoward  2E-1007           Infinity -> 3E-1007         Underflow Subnormal Inexact Rounded
+	{"nextt383", "2E-1007", "Inf", "3E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt385 nexttoward  -0E-1007          Infinity ->  1E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt385", "-0E-1007", "Inf", "1E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt386 nexttoward  -1E-1007          Infinity -> -0E-1007        Underflow Subnormal Inexact Rounded Clamped
+	{"nextt386", "-1E-1007", "Inf", "-0E-1007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999, -999, false},
+	// nextt387 nexttoward  -2E-1007          Infinity -> -1E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt387", "-2E-1007", "Inf", "-1E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt388 nexttoward  -10E-1007         Infinity -> -9E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt388", "-10E-1007", "Inf", "-9E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt389 nexttoward  -100E-1007        Infinity -> -9.9E-1006      Underflow Subnormal Inexact Rounded
+	{"nextt389", "-100E-1007", "Inf", "-9.9E-1006", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt390 nexttoward  -100000E-1007     Infinity -> -9.9999E-1003   Underflow Subnormal Inexact Rounded
+	{"nextt390", "-100000E-1007", "Inf", "-9.9999E-1003", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt391 nexttoward  -1.0000E-999      Infinity -> -9.9999999E-1000  Underflow Subnormal Inexact Rounded
+	{"nextt391", "-1.0000E-999", "Inf", "-9.9999999E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt392 nexttoward  -1.00000000E-999  Infinity -> -9.9999999E-1000  Underflow Subnormal Inexact Rounded
+	{"nextt392", "-1.00000000E-999", "Inf", "-9.9999999E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt393 nexttoward  -1E-999           Infinity -> -9.9999999E-1000  Underflow Subnormal Inexact Rounded
+	{"nextt393", "-1E-999", "Inf", "-9.9999999E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt394 nexttoward  -9.99999998E+999  Infinity -> -9.99999997E+999
+	{"nextt394", "-9.99999998E+999", "Inf", "-9.99999997E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt395 nexttoward  -9.99999999E+999  Infinity -> -9.99999998E+999
+	{"nextt395", "-9.99999999E+999", "Inf", "-9.99999998E+999", 0, 9, ToNearestAway, 999, -999, false},
+	//----- lhs>rhs
+	// maxexponent: 384
+	// minexponent: -383
+	// nextt401 nexttoward  0.999999995  -Infinity ->   0.999999994
+	{"nextt401", "0.999999995", "-Inf", "0.999999994", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt402 nexttoward  0.999999996  -Infinity ->   0.999999995
+	{"nextt402", "0.999999996", "-Inf", "0.999999995", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt403 nexttoward  0.999999997  -Infinity ->   0.999999996
+	{"nextt403", "0.999999997", "-Inf", "0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt404 nexttoward  0.999999998  -Infinity ->   0.999999997
+	{"nextt404", "0.999999998", "-Inf", "0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt405 nexttoward  0.999999999  -Infinity ->   0.999999998
+	{"nextt405", "0.999999999", "-Inf", "0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt406 nexttoward  1.00000000   -Infinity ->   0.999999999
+	{"nextt406", "1.00000000", "-Inf", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt407 nexttoward  1.0          -Infinity ->   0.999999999
+	{"nextt407", "1.0", "-Inf", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt408 nexttoward  1            -Infinity ->   0.999999999
+	{"nextt408", "1", "-Inf", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt409 nexttoward  1.00000001   -Infinity ->   1.00000000
+	{"nextt409", "1.00000001", "-Inf", "1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt410 nexttoward  1.00000002   -Infinity ->   1.00000001
+	{"nextt410", "1.00000002", "-Inf", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt411 nexttoward  1.00000003   -Infinity ->   1.00000002
+	{"nextt411", "1.00000003", "-Inf", "1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt412 nexttoward  1.00000004   -Infinity ->   1.00000003
+	{"nextt412", "1.00000004", "-Inf", "1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt413 nexttoward  1.00000005   -Infinity ->   1.00000004
+	{"nextt413", "1.00000005", "-Inf", "1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt414 nexttoward  1.00000006   -Infinity ->   1.00000005
+	{"nextt414", "1.00000006", "-Inf", "1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt415 nexttoward  1.00000007   -Infinity ->   1.00000006
+	{"nextt415", "1.00000007", "-Inf", "1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt416 nexttoward  1.00000008   -Infinity ->   1.00000007
+	{"nextt416", "1.00000008", "-Inf", "1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt417 nexttoward  1.00000009   -Infinity ->   1.00000008
+	{"nextt417", "1.00000009", "-Inf", "1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt418 nexttoward  1.00000010   -Infinity ->   1.00000009
+	{"nextt418", "1.00000010", "-Inf", "1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt419 nexttoward  1.00000011   -Infinity ->   1.00000010
+	{"nextt419", "1.00000011", "-Inf", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt420 nexttoward  1.00000012   -Infinity ->   1.00000011
+	{"nextt420", "1.00000012", "-Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt421 nexttoward -0.999999995  -Infinity ->  -0.999999996
+	{"nextt421", "-0.999999995", "-Inf", "-0.999999996", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt422 nexttoward -0.999999996  -Infinity ->  -0.999999997
+	{"nextt422", "-0.999999996", "-Inf", "-0.999999997", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt423 nexttoward -0.999999997  -Infinity ->  -0.999999998
+	{"nextt423", "-0.999999997", "-Inf", "-0.999999998", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt424 nexttoward -0.999999998  -Infinity ->  -0.999999999
+	{"nextt424", "-0.999999998", "-Inf", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt425 nexttoward -0.999999999  -Infinity ->  -1.00000000
+	{"nextt425", "-0.999999999", "-Inf", "-1.00000000", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt426 nexttoward -1.00000000   -Infinity ->  -1.00000001
+	{"nextt426", "-1.00000000", "-Inf", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt427 nexttoward -1.0          -Infinity ->  -1.00000001
+	{"nextt427", "-1.0", "-Inf", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt428 nexttoward -1            -Infinity ->  -1.00000001
+	{"nextt428", "-1", "-Inf", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt429 nexttoward -1.00000001   -Infinity ->  -1.00000002
+	{"nextt429", "-1.00000001", "-Inf", "-1.00000002", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt430 nexttoward -1.00000002   -Infinity ->  -1.00000003
+	{"nextt430", "-1.00000002", "-Inf", "-1.00000003", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt431 nexttoward -1.00000003   -Infinity ->  -1.00000004
+	{"nextt431", "-1.00000003", "-Inf", "-1.00000004", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt432 nexttoward -1.00000004   -Infinity ->  -1.00000005
+	{"nextt432", "-1.00000004", "-Inf", "-1.00000005", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt433 nexttoward -1.00000005   -Infinity ->  -1.00000006
+	{"nextt433", "-1.00000005", "-Inf", "-1.00000006", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt434 nexttoward -1.00000006   -Infinity ->  -1.00000007
+	{"nextt434", "-1.00000006", "-Inf", "-1.00000007", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt435 nexttoward -1.00000007   -Infinity ->  -1.00000008
+	{"nextt435", "-1.00000007", "-Inf", "-1.00000008", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt436 nexttoward -1.00000008   -Infinity ->  -1.00000009
+	{"nextt436", "-1.00000008", "-Inf", "-1.00000009", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt437 nexttoward -1.00000009   -Infinity ->  -1.00000010
+	{"nextt437", "-1.00000009", "-Inf", "-1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt438 nexttoward -1.00000010   -Infinity ->  -1.00000011
+	{"nextt438", "-1.00000010", "-Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt439 nexttoward -1.00000011   -Infinity ->  -1.00000012
+	{"nextt439", "-1.00000011", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// input operand is >precision
+	// nextt441 nexttoward  1.00000010998   -Infinity ->   1.00000010
+	{"nextt441", "1.00000010998", "-Inf", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt442 nexttoward  1.00000010999   -Infinity ->   1.00000010
+	{"nextt442", "1.00000010999", "-Inf", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt443 nexttoward  1.00000011000   -Infinity ->   1.00000010
+	{"nextt443", "1.00000011000", "-Inf", "1.00000010", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt444 nexttoward  1.00000011001   -Infinity ->   1.00000011
+	{"nextt444", "1.00000011001", "-Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt445 nexttoward  1.00000011002   -Infinity ->   1.00000011
+	{"nextt445", "1.00000011002", "-Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt446 nexttoward  1.00000011002   -Infinity ->   1.00000011
+	{"nextt446", "1.00000011002", "-Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt447 nexttoward  1.00000011052   -Infinity ->   1.00000011
+	{"nextt447", "1.00000011052", "-Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt448 nexttoward  1.00000011552   -Infinity ->   1.00000011
+	{"nextt448", "1.00000011552", "-Inf", "1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt449 nexttoward -1.00000010998   -Infinity ->  -1.00000011
+	{"nextt449", "-1.00000010998", "-Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt450 nexttoward -1.00000010999   -Infinity ->  -1.00000011
+	{"nextt450", "-1.00000010999", "-Inf", "-1.00000011", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt451 nexttoward -1.00000011000   -Infinity ->  -1.00000012
+	{"nextt451", "-1.00000011000", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt452 nexttoward -1.00000011001   -Infinity ->  -1.00000012
+	{"nextt452", "-1.00000011001", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt453 nexttoward -1.00000011002   -Infinity ->  -1.00000012
+	{"nextt453", "-1.00000011002", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt454 nexttoward -1.00000011002   -Infinity ->  -1.00000012
+	{"nextt454", "-1.00000011002", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt455 nexttoward -1.00000011052   -Infinity ->  -1.00000012
+	{"nextt455", "-1.00000011052", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt456 nexttoward -1.00000011552   -Infinity ->  -1.00000012
+	{"nextt456", "-1.00000011552", "-Inf", "-1.00000012", 0, 9, ToNearestAway, 384, -383, false},
+	// ultra-tiny inputs
+	// nextt460 nexttoward  1E-99999        -Infinity ->   0E-391     Underflow Subnormal Inexact Rounded Clamped
+	{"nextt460", "1E-99999", "-Inf", "0E-391", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 384, -383, false},
+	// nextt461 nexttoward  1E-999999999    -Infinity ->   0E-391     Underflow Subnormal Inexact Rounded Clamped
+	{"nextt461", "1E-999999999", "-Inf", "0E-391", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 384, -383, false},
+	// nextt462 nexttoward  1E-391          -Infinity ->   0E-391     Underflow Subnormal Inexact Rounded Clamped
+	{"nextt462", "1E-391", "-Inf", "0E-391", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 384, -383, false},
+	// nextt463 nexttoward -1E-99999        -Infinity ->  -1E-391     Underflow Subnormal Inexact Rounded
+	{"nextt463", "-1E-99999", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt464 nexttoward -1E-999999999    -Infinity ->  -1E-391     Underflow Subnormal Inexact Rounded
+	{"nextt464", "-1E-999999999", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt465 nexttoward -1E-391          -Infinity ->  -2E-391     Underflow Subnormal Inexact Rounded
+	{"nextt465", "-1E-391", "-Inf", "-2E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// Zeros
+	// nextt500 nexttoward -0            -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt500", "-0", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt501 nexttoward  0            -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt501", "0", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt502 nexttoward  0.00         -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt502", "0.00", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt503 nexttoward -0.00         -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt503", "-0.00", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt504 nexttoward  0E-300       -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt504", "0E-300", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt505 nexttoward  0E+300       -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt505", "0E+300", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt506 nexttoward  0E+30000     -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt506", "0E+30000", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt507 nexttoward -0E+30000     -Infinity -> -1E-391         Underflow Subnormal Inexact Rounded
+	{"nextt507", "-0E+30000", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt508 nexttoward  0.00         -0.0000   -> -0.00
+	{"nextt508", "0.00", "-0.0000", "-0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// specials
+	// nextt550 nexttoward   Inf     -Infinity ->  9.99999999E+384
+	{"nextt550", "Inf", "-Inf", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt551 nexttoward  -Inf     -Infinity -> -Infinity
+	{"nextt551", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt552 nexttoward   NaN     -Infinity ->  NaN
+	{"nextt552", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt553 nexttoward  sNaN     -Infinity ->  NaN   Invalid_operation
+	{"nextt553", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt554 nexttoward   NaN77   -Infinity ->  NaN77
+	{"nextt554", "NaN77", "-Inf", "NaN77", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt555 nexttoward  sNaN88   -Infinity ->  NaN88 Invalid_operation
+	{"nextt555", "sNaN88", "-Inf", "NaN88", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt556 nexttoward  -NaN     -Infinity -> -NaN
+	{"nextt556", "-NaN", "-Inf", "-NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt557 nexttoward -sNaN     -Infinity -> -NaN   Invalid_operation
+	{"nextt557", "-sNaN", "-Inf", "-NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt558 nexttoward  -NaN77   -Infinity -> -NaN77
+	{"nextt558", "-NaN77", "-Inf", "-NaN77", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt559 nexttoward -sNaN88   -Infinity -> -NaN88 Invalid_operation
+	{"nextt559", "-sNaN88", "-Inf", "-NaN88", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// Nmax, Nmin, Ntiny, subnormals
+	// maxexponent: 999
+	// minexponent: -999
+	// nextt570 nexttoward  9.99999999E+999    -Infinity -> 9.99999998E+999
+	{"nextt570", "9.99999999E+999", "-Inf", "9.99999998E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt571 nexttoward  9.99999998E+999    -Infinity -> 9.99999997E+999
+	{"nextt571", "9.99999998E+999", "-Inf", "9.99999997E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt572 nexttoward  1E-999             -Infinity -> 9.9999999E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt572", "1E-999", "-Inf", "9.9999999E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt573 nexttoward  1.00000000E-999    -Infinity -> 9.9999999E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt573", "1.00000000E-999", "-Inf", "9.9999999E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt574 nexttoward  9E-1007            -Infinity -> 8E-1007         Underflow Subnormal Inexact Rounded
+	{"nextt574", "9E-1007", "-Inf", "8E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt575 nexttoward  9.9E-1006          -Infinity -> 9.8E-1006       Underflow Subnormal Inexact Rounded
+	{"nextt575", "9.9E-1006", "-Inf", "9.8E-1006", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt576 nexttoward  9.9999E-1003       -Infinity -> 9.9998E-1003    Underflow Subnormal Inexact Rounded
+	{"nextt576", "9.9999E-1003", "-Inf", "9.9998E-1003", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt577 nexttoward  9.9999999E-1000    -Infinity -> 9.9999998E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt577", "9.9999999E-1000", "-Inf", "9.9999998E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt578 nexttoward  9.9999998E-1000    -Infinity -> 9.9999997E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt578", "9.9999998E-1000", "-Inf", "9.9999997E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt579 nexttoward  9.9999997E-1000    -Infinity -> 9.9999996E-1000 Underflow Subnormal Inexact Rounded
+	{"nextt579", "9.9999997E-1000", "-Inf", "9.9999996E-1000", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt580 nexttoward  0E-1007            -Infinity -> -1E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt580", "0E-1007", "-Inf", "-1E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt581 nexttoward  1E-1007            -Infinity -> 0E-1007         Underflow Subnormal Inexact Rounded Clamped
+	{"nextt581", "1E-1007", "-Inf", "0E-1007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999, -999, false},
+	// nextt582 nexttoward  2E-1007            -Infinity -> 1E-1007         Underflow Subnormal Inexact Rounded
+	{"nextt582", "2E-1007", "-Inf", "1E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt583 nexttoward  -0E-1007           -Infinity -> -1E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt583", "-0E-1007", "-Inf", "-1E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt584 nexttoward  -1E-1007           -Infinity -> -2E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt584", "-1E-1007", "-Inf", "-2E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt585 nexttoward  -2E-1007           -Infinity -> -3E-1007        Underflow Subnormal Inexact Rounded
+	{"nextt585", "-2E-1007", "-Inf", "-3E-1007", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt586 nexttoward  -10E-1007          -Infinity -> -1.1E-1006      Underflow Subnormal Inexact Rounded
+	{"nextt586", "-10E-1007", "-Inf", "-1.1E-1006", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt587 nexttoward  -100E-1007         -Infinity -> -1.01E-1005     Underflow Subnormal Inexact Rounded
+	{"nextt587", "-100E-1007", "-Inf", "-1.01E-1005", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt588 nexttoward  -100000E-1007      -Infinity -> -1.00001E-1002  Underflow Subnormal Inexact Rounded
+	{"nextt588", "-100000E-1007", "-Inf", "-1.00001E-1002", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// nextt589 nexttoward  -1.0000E-999       -Infinity -> -1.00000001E-999
+	{"nextt589", "-1.0000E-999", "-Inf", "-1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt590 nexttoward  -1.00000000E-999   -Infinity -> -1.00000001E-999
+	{"nextt590", "-1.00000000E-999", "-Inf", "-1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt591 nexttoward  -1E-999            -Infinity -> -1.00000001E-999
+	{"nextt591", "-1E-999", "-Inf", "-1.00000001E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt592 nexttoward  -9.99999998E+999   -Infinity -> -9.99999999E+999
+	{"nextt592", "-9.99999998E+999", "-Inf", "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// nextt593 nexttoward  -9.99999999E+999   -Infinity -> -Infinity Overflow Inexact Rounded
+	{"nextt593", "-9.99999999E+999", "-Inf", "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	//----- Specials
+	// maxexponent: 384
+	// minexponent: -383
+	// nextt780 nexttoward -Inf  -Inf   -> -Infinity
+	{"nextt780", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt781 nexttoward -Inf  -1000  -> -9.99999999E+384
+	{"nextt781", "-Inf", "-1000", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt782 nexttoward -Inf  -1     -> -9.99999999E+384
+	{"nextt782", "-Inf", "-1", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt783 nexttoward -Inf  -0     -> -9.99999999E+384
+	{"nextt783", "-Inf", "-0", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt784 nexttoward -Inf   0     -> -9.99999999E+384
+	{"nextt784", "-Inf", "0", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt785 nexttoward -Inf   1     -> -9.99999999E+384
+	{"nextt785", "-Inf", "1", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt786 nexttoward -Inf   1000  -> -9.99999999E+384
+	{"nextt786", "-Inf", "1000", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt787 nexttoward -1000 -Inf   -> -1000.00001
+	{"nextt787", "-1000", "-Inf", "-1000.00001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt788 nexttoward -Inf  -Inf   -> -Infinity
+	{"nextt788", "-Inf", "-Inf", "-Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt789 nexttoward -1    -Inf   -> -1.00000001
+	{"nextt789", "-1", "-Inf", "-1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt790 nexttoward -0    -Inf   -> -1E-391           Underflow Subnormal Inexact Rounded
+	{"nextt790", "-0", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt791 nexttoward  0    -Inf   -> -1E-391           Underflow Subnormal Inexact Rounded
+	{"nextt791", "0", "-Inf", "-1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt792 nexttoward  1    -Inf   ->  0.999999999
+	{"nextt792", "1", "-Inf", "0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt793 nexttoward  1000 -Inf   ->  999.999999
+	{"nextt793", "1000", "-Inf", "999.999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt794 nexttoward  Inf  -Inf   ->  9.99999999E+384
+	{"nextt794", "Inf", "-Inf", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt800 nexttoward  Inf  -Inf   ->  9.99999999E+384
+	{"nextt800", "Inf", "-Inf", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt801 nexttoward  Inf  -1000  ->  9.99999999E+384
+	{"nextt801", "Inf", "-1000", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt802 nexttoward  Inf  -1     ->  9.99999999E+384
+	{"nextt802", "Inf", "-1", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt803 nexttoward  Inf  -0     ->  9.99999999E+384
+	{"nextt803", "Inf", "-0", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt804 nexttoward  Inf   0     ->  9.99999999E+384
+	{"nextt804", "Inf", "0", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt805 nexttoward  Inf   1     ->  9.99999999E+384
+	{"nextt805", "Inf", "1", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt806 nexttoward  Inf   1000  ->  9.99999999E+384
+	{"nextt806", "Inf", "1000", "9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt807 nexttoward  Inf   Inf   ->  Infinity
+	{"nextt807", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt808 nexttoward -1000  Inf   -> -999.999999
+	{"nextt808", "-1000", "Inf", "-999.999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt809 nexttoward -Inf   Inf   -> -9.99999999E+384
+	{"nextt809", "-Inf", "Inf", "-9.99999999E+384", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt810 nexttoward -1     Inf   -> -0.999999999
+	{"nextt810", "-1", "Inf", "-0.999999999", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt811 nexttoward -0     Inf   ->  1E-391           Underflow Subnormal Inexact Rounded
+	{"nextt811", "-0", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt812 nexttoward  0     Inf   ->  1E-391           Underflow Subnormal Inexact Rounded
+	{"nextt812", "0", "Inf", "1E-391", Underflow | Subnormal | Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// nextt813 nexttoward  1     Inf   ->  1.00000001
+	{"nextt813", "1", "Inf", "1.00000001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt814 nexttoward  1000  Inf   ->  1000.00001
+	{"nextt814", "1000", "Inf", "1000.00001", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt815 nexttoward  Inf   Inf   ->  Infinity
+	{"nextt815", "Inf", "Inf", "Inf", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt821 nexttoward  NaN -Inf    ->  NaN
+	{"nextt821", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt822 nexttoward  NaN -1000   ->  NaN
+	{"nextt822", "NaN", "-1000", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt823 nexttoward  NaN -1      ->  NaN
+	{"nextt823", "NaN", "-1", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt824 nexttoward  NaN -0      ->  NaN
+	{"nextt824", "NaN", "-0", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt825 nexttoward  NaN  0      ->  NaN
+	{"nextt825", "NaN", "0", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt826 nexttoward  NaN  1      ->  NaN
+	{"nextt826", "NaN", "1", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt827 nexttoward  NaN  1000   ->  NaN
+	{"nextt827", "NaN", "1000", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt828 nexttoward  NaN  Inf    ->  NaN
+	{"nextt828", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt829 nexttoward  NaN  NaN    ->  NaN
+	{"nextt829", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt830 nexttoward -Inf  NaN    ->  NaN
+	{"nextt830", "-Inf", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt831 nexttoward -1000 NaN    ->  NaN
+	{"nextt831", "-1000", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt832 nexttoward -1    NaN    ->  NaN
+	{"nextt832", "-1", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt833 nexttoward -0    NaN    ->  NaN
+	{"nextt833", "-0", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt834 nexttoward  0    NaN    ->  NaN
+	{"nextt834", "0", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt835 nexttoward  1    NaN    ->  NaN
+	{"nextt835", "1", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt836 nexttoward  1000 NaN    ->  NaN
+	{"nextt836", "1000", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt837 nexttoward  Inf  NaN    ->  NaN
+	{"nextt837", "Inf", "NaN", "NaN", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt841 nexttoward  sNaN -Inf   ->  NaN  Invalid_operation
+	{"nextt841", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt842 nexttoward  sNaN -1000  ->  NaN  Invalid_operation
+	{"nextt842", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt843 nexttoward  sNaN -1     ->  NaN  Invalid_operation
+	{"nextt843", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt844 nexttoward  sNaN -0     ->  NaN  Invalid_operation
+	{"nextt844", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt845 nexttoward  sNaN  0     ->  NaN  Invalid_operation
+	{"nextt845", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt846 nexttoward  sNaN  1     ->  NaN  Invalid_operation
+	{"nextt846", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt847 nexttoward  sNaN  1000  ->  NaN  Invalid_operation
+	{"nextt847", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt848 nexttoward  sNaN  NaN   ->  NaN  Invalid_operation
+	{"nextt848", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt849 nexttoward  sNaN sNaN   ->  NaN  Invalid_operation
+	{"nextt849", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt850 nexttoward  NaN  sNaN   ->  NaN  Invalid_operation
+	{"nextt850", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt851 nexttoward -Inf  sNaN   ->  NaN  Invalid_operation
+	{"nextt851", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt852 nexttoward -1000 sNaN   ->  NaN  Invalid_operation
+	{"nextt852", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt853 nexttoward -1    sNaN   ->  NaN  Invalid_operation
+	{"nextt853", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt854 nexttoward -0    sNaN   ->  NaN  Invalid_operation
+	{"nextt854", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt855 nexttoward  0    sNaN   ->  NaN  Invalid_operation
+	{"nextt855", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt856 nexttoward  1    sNaN   ->  NaN  Invalid_operation
+	{"nextt856", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt857 nexttoward  1000 sNaN   ->  NaN  Invalid_operation
+	{"nextt857", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt858 nexttoward  Inf  sNaN   ->  NaN  Invalid_operation
+	{"nextt858", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt859 nexttoward  NaN  sNaN   ->  NaN  Invalid_operation
+	{"nextt859", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// propagating NaNs
+	// nextt861 nexttoward  NaN1   -Inf    ->  NaN1
+	{"nextt861", "NaN1", "-Inf", "NaN1", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt862 nexttoward +NaN2   -1000   ->  NaN2
+	{"nextt862", "+NaN2", "-1000", "NaN2", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt863 nexttoward  NaN3    1000   ->  NaN3
+	{"nextt863", "NaN3", "1000", "NaN3", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt864 nexttoward  NaN4    Inf    ->  NaN4
+	{"nextt864", "NaN4", "Inf", "NaN4", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt865 nexttoward  NaN5   +NaN6   ->  NaN5
+	{"nextt865", "NaN5", "+NaN6", "NaN5", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt866 nexttoward -Inf     NaN7   ->  NaN7
+	{"nextt866", "-Inf", "NaN7", "NaN7", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt867 nexttoward -1000    NaN8   ->  NaN8
+	{"nextt867", "-1000", "NaN8", "NaN8", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt868 nexttoward  1000    NaN9   ->  NaN9
+	{"nextt868", "1000", "NaN9", "NaN9", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt869 nexttoward  Inf    +NaN10  ->  NaN10
+	{"nextt869", "Inf", "+NaN10", "NaN10", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt871 nexttoward  sNaN11  -Inf   ->  NaN11  Invalid_operation
+	{"nextt871", "sNaN11", "-Inf", "NaN11", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt872 nexttoward  sNaN12  -1000  ->  NaN12  Invalid_operation
+	{"nextt872", "sNaN12", "-1000", "NaN12", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt873 nexttoward  sNaN13   1000  ->  NaN13  Invalid_operation
+	{"nextt873", "sNaN13", "1000", "NaN13", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt874 nexttoward  sNaN14   NaN17 ->  NaN14  Invalid_operation
+	{"nextt874", "sNaN14", "NaN17", "NaN14", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt875 nexttoward  sNaN15  sNaN18 ->  NaN15  Invalid_operation
+	{"nextt875", "sNaN15", "sNaN18", "NaN15", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt876 nexttoward  NaN16   sNaN19 ->  NaN19  Invalid_operation
+	{"nextt876", "NaN16", "sNaN19", "NaN19", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt877 nexttoward -Inf    +sNaN20 ->  NaN20  Invalid_operation
+	{"nextt877", "-Inf", "+sNaN20", "NaN20", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt878 nexttoward -1000    sNaN21 ->  NaN21  Invalid_operation
+	{"nextt878", "-1000", "sNaN21", "NaN21", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt879 nexttoward  1000    sNaN22 ->  NaN22  Invalid_operation
+	{"nextt879", "1000", "sNaN22", "NaN22", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt880 nexttoward  Inf     sNaN23 ->  NaN23  Invalid_operation
+	{"nextt880", "Inf", "sNaN23", "NaN23", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt881 nexttoward +NaN25  +sNaN24 ->  NaN24  Invalid_operation
+	{"nextt881", "+NaN25", "+sNaN24", "NaN24", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt882 nexttoward -NaN26    NaN28 -> -NaN26
+	{"nextt882", "-NaN26", "NaN28", "-NaN26", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt883 nexttoward -sNaN27  sNaN29 -> -NaN27  Invalid_operation
+	{"nextt883", "-sNaN27", "sNaN29", "-NaN27", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// nextt884 nexttoward  1000    -NaN30 -> -NaN30
+	{"nextt884", "1000", "-NaN30", "-NaN30", 0, 9, ToNearestAway, 384, -383, false},
+	// nextt885 nexttoward  1000   -sNaN31 -> -NaN31  Invalid_operation
+	{"nextt885", "1000", "-sNaN31", "-NaN31", InvalidOperation, 9, ToNearestAway, 384, -383, false},
+	// Null tests
+	// SKIP (encoding not supported): nextt900 nexttoward 1  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): nextt901 nexttoward #  1 -> NaN Invalid_operation
+}
@@ -0,0 +1,590 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var orTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check (truth table)
+	// orx001 or             0    0 ->    0
+	{"orx001", "0", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// orx002 or             0    1 ->    1
+	{"orx002", "0", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx003 or             1    0 ->    1
+	{"orx003", "1", "0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx004 or             1    1 ->    1
+	{"orx004", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx005 or          1100 1010 -> 1110
+	{"orx005", "1100", "1010", "1110", 0, 9, ToNearestAway, 999, -999, false},
+	// and at msd and msd-1
+	// orx006 or 000000000 000000000 ->           0
+	{"orx006", "000000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// orx007 or 000000000 100000000 ->   100000000
+	{"orx007", "000000000", "100000000", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// orx008 or 100000000 000000000 ->   100000000
+	{"orx008", "100000000", "000000000", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// orx009 or 100000000 100000000 ->   100000000
+	{"orx009", "100000000", "100000000", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// orx010 or 000000000 000000000 ->           0
+	{"orx010", "000000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// orx011 or 000000000 010000000 ->    10000000
+	{"orx011", "000000000", "010000000", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// orx012 or 010000000 000000000 ->    10000000
+	{"orx012", "010000000", "000000000", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// orx013 or 010000000 010000000 ->    10000000
+	{"orx013", "010000000", "010000000", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// Various lengths
+	//        123456789     123456789      123456789
+	// orx021 or 111111111     111111111  ->  111111111
+	{"orx021", "111111111", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx022 or 111111111111  111111111  ->  111111111
+	{"orx022", "111111111111", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx023 or  11111111      11111111  ->   11111111
+	{"orx023", "11111111", "11111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx025 or   1111111       1111111  ->    1111111
+	{"orx025", "1111111", "1111111", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx026 or    111111        111111  ->     111111
+	{"orx026", "111111", "111111", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx027 or     11111         11111  ->      11111
+	{"orx027", "11111", "11111", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx028 or      1111          1111  ->       1111
+	{"orx028", "1111", "1111", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx029 or       111           111  ->        111
+	{"orx029", "111", "111", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx031 or        11            11  ->         11
+	{"orx031", "11", "11", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// orx032 or         1             1  ->          1
+	{"orx032", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx033 or 111111111111 1111111111  ->  111111111
+	{"orx033", "111111111111", "1111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx034 or 11111111111 11111111111  ->  111111111
+	{"orx034", "11111111111", "11111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx035 or 1111111111 111111111111  ->  111111111
+	{"orx035", "1111111111", "111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx036 or 111111111 1111111111111  ->  111111111
+	{"orx036", "111111111", "1111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx040 or 111111111  111111111111  ->  111111111
+	{"orx040", "111111111", "111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx041 or  11111111  111111111111  ->  111111111
+	{"orx041", "11111111", "111111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx042 or  11111111     111111111  ->  111111111
+	{"orx042", "11111111", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx043 or   1111111     100000010  ->  101111111
+	{"orx043", "1111111", "100000010", "101111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx044 or    111111     100000100  ->  100111111
+	{"orx044", "111111", "100000100", "100111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx045 or     11111     100001000  ->  100011111
+	{"orx045", "11111", "100001000", "100011111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx046 or      1111     100010000  ->  100011111
+	{"orx046", "1111", "100010000", "100011111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx047 or       111     100100000  ->  100100111
+	{"orx047", "111", "100100000", "100100111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx048 or        11     101000000  ->  101000011
+	{"orx048", "11", "101000000", "101000011", 0, 9, ToNearestAway, 999, -999, false},
+	// orx049 or         1     110000000  ->  110000001
+	{"orx049", "1", "110000000", "110000001", 0, 9, ToNearestAway, 999, -999, false},
+	// orx050 or 1111111111  1  ->  111111111
+	{"orx050", "1111111111", "1", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx051 or  111111111  1  ->  111111111
+	{"orx051", "111111111", "1", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx052 or   11111111  1  ->  11111111
+	{"orx052", "11111111", "1", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx053 or    1111111  1  ->  1111111
+	{"orx053", "1111111", "1", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx054 or     111111  1  ->  111111
+	{"orx054", "111111", "1", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx055 or      11111  1  ->  11111
+	{"orx055", "11111", "1", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx056 or       1111  1  ->  1111
+	{"orx056", "1111", "1", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx057 or        111  1  ->  111
+	{"orx057", "111", "1", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx058 or         11  1  ->  11
+	{"orx058", "11", "1", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// orx059 or          1  1  ->  1
+	{"orx059", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx060 or 1111111111  0  ->  111111111
+	{"orx060", "1111111111", "0", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx061 or  111111111  0  ->  111111111
+	{"orx061", "111111111", "0", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx062 or   11111111  0  ->  11111111
+	{"orx062", "11111111", "0", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx063 or    1111111  0  ->  1111111
+	{"orx063", "1111111", "0", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx064 or     111111  0  ->  111111
+	{"orx064", "111111", "0", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx065 or      11111  0  ->  11111
+	{"orx065", "11111", "0", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx066 or       1111  0  ->  1111
+	{"orx066", "1111", "0", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx067 or        111  0  ->  111
+	{"orx067", "111", "0", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx068 or         11  0  ->  11
+	{"orx068", "11", "0", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// orx069 or          1  0  ->  1
+	{"orx069", "1", "0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx070 or 1  1111111111  ->  111111111
+	{"orx070", "1", "1111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx071 or 1   111111111  ->  111111111
+	{"orx071", "1", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx072 or 1    11111111  ->  11111111
+	{"orx072", "1", "11111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx073 or 1     1111111  ->  1111111
+	{"orx073", "1", "1111111", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx074 or 1      111111  ->  111111
+	{"orx074", "1", "111111", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx075 or 1       11111  ->  11111
+	{"orx075", "1", "11111", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx076 or 1        1111  ->  1111
+	{"orx076", "1", "1111", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx077 or 1         111  ->  111
+	{"orx077", "1", "111", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx078 or 1          11  ->  11
+	{"orx078", "1", "11", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// orx079 or 1           1  ->  1
+	{"orx079", "1", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx080 or 0  1111111111  ->  111111111
+	{"orx080", "0", "1111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx081 or 0   111111111  ->  111111111
+	{"orx081", "0", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx082 or 0    11111111  ->  11111111
+	{"orx082", "0", "11111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx083 or 0     1111111  ->  1111111
+	{"orx083", "0", "1111111", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx084 or 0      111111  ->  111111
+	{"orx084", "0", "111111", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx085 or 0       11111  ->  11111
+	{"orx085", "0", "11111", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx086 or 0        1111  ->  1111
+	{"orx086", "0", "1111", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx087 or 0         111  ->  111
+	{"orx087", "0", "111", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx088 or 0          11  ->  11
+	{"orx088", "0", "11", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// orx089 or 0           1  ->  1
+	{"orx089", "0", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// orx090 or 011111111  111101111  ->  111111111
+	{"orx090", "011111111", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx091 or 101111111  111101111  ->  111111111
+	{"orx091", "101111111", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx092 or 110111111  111101111  ->  111111111
+	{"orx092", "110111111", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx093 or 111011111  111101111  ->  111111111
+	{"orx093", "111011111", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx094 or 111101111  111101111  ->  111101111
+	{"orx094", "111101111", "111101111", "111101111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx095 or 111110111  111101111  ->  111111111
+	{"orx095", "111110111", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx096 or 111111011  111101111  ->  111111111
+	{"orx096", "111111011", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx097 or 111111101  111101111  ->  111111111
+	{"orx097", "111111101", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx098 or 111111110  111101111  ->  111111111
+	{"orx098", "111111110", "111101111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx100 or 111101111  011111111  ->  111111111
+	{"orx100", "111101111", "011111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx101 or 111101111  101111111  ->  111111111
+	{"orx101", "111101111", "101111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx102 or 111101111  110111111  ->  111111111
+	{"orx102", "111101111", "110111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx103 or 111101111  111011111  ->  111111111
+	{"orx103", "111101111", "111011111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx104 or 111101111  111101111  ->  111101111
+	{"orx104", "111101111", "111101111", "111101111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx105 or 111101111  111110111  ->  111111111
+	{"orx105", "111101111", "111110111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx106 or 111101111  111111011  ->  111111111
+	{"orx106", "111101111", "111111011", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx107 or 111101111  111111101  ->  111111111
+	{"orx107", "111101111", "111111101", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// orx108 or 111101111  111111110  ->  111111111
+	{"orx108", "111101111", "111111110", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// non-0/1 should not be accepted, nor should signs
+	// orx220 or 111111112  111111111  ->  NaN Invalid_operation
+	{"orx220", "111111112", "111111111", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx221 or 333333333  333333333  ->  NaN Invalid_operation
+	{"orx221", "333333333", "333333333", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx222 or 555555555  555555555  ->  NaN Invalid_operation
+	{"orx222", "555555555", "555555555", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx223 or 777777777  777777777  ->  NaN Invalid_operation
+	{"orx223", "777777777", "777777777", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx224 or 999999999  999999999  ->  NaN Invalid_operation
+	{"orx224", "999999999", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx225 or 222222222  999999999  ->  NaN Invalid_operation
+	{"orx225", "222222222", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx226 or 444444444  999999999  ->  NaN Invalid_operation
+	{"orx226", "444444444", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx227 or 666666666  999999999  ->  NaN Invalid_operation
+	{"orx227", "666666666", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx228 or 888888888  999999999  ->  NaN Invalid_operation
+	{"orx228", "888888888", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx229 or 999999999  222222222  ->  NaN Invalid_operation
+	{"orx229", "999999999", "222222222", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx230 or 999999999  444444444  ->  NaN Invalid_operation
+	{"orx230", "999999999", "444444444", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx231 or 999999999  666666666  ->  NaN Invalid_operation
+	{"orx231", "999999999", "666666666", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx232 or 999999999  888888888  ->  NaN Invalid_operation
+	{"orx232", "999999999", "888888888", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// a few randoms
+	// orx240 or  567468689 -934981942 ->  NaN Invalid_operation
+	{"orx240", "567468689", "-934981942", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx241 or  567367689  934981942 ->  NaN Invalid_operation
+	{"orx241", "567367689", "934981942", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx242 or -631917772 -706014634 ->  NaN Invalid_operation
+	{"orx242", "-631917772", "-706014634", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx243 or -756253257  138579234 ->  NaN Invalid_operation
+	{"orx243", "-756253257", "138579234", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx244 or  835590149  567435400 ->  NaN Invalid_operation
+	{"orx244", "835590149", "567435400", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD
+	// orx250 or  200000000 100000000 ->  NaN Invalid_operation
+	{"orx250", "200000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx251 or  700000000 100000000 ->  NaN Invalid_operation
+	{"orx251", "700000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx252 or  800000000 100000000 ->  NaN Invalid_operation
+	{"orx252", "800000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx253 or  900000000 100000000 ->  NaN Invalid_operation
+	{"orx253", "900000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx254 or  200000000 000000000 ->  NaN Invalid_operation
+	{"orx254", "200000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx255 or  700000000 000000000 ->  NaN Invalid_operation
+	{"orx255", "700000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx256 or  800000000 000000000 ->  NaN Invalid_operation
+	{"orx256", "800000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx257 or  900000000 000000000 ->  NaN Invalid_operation
+	{"orx257", "900000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx258 or  100000000 200000000 ->  NaN Invalid_operation
+	{"orx258", "100000000", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx259 or  100000000 700000000 ->  NaN Invalid_operation
+	{"orx259", "100000000", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx260 or  100000000 800000000 ->  NaN Invalid_operation
+	{"orx260", "100000000", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx261 or  100000000 900000000 ->  NaN Invalid_operation
+	{"orx261", "100000000", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx262 or  000000000 200000000 ->  NaN Invalid_operation
+	{"orx262", "000000000", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx263 or  000000000 700000000 ->  NaN Invalid_operation
+	{"orx263", "000000000", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx264 or  000000000 800000000 ->  NaN Invalid_operation
+	{"orx264", "000000000", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx265 or  000000000 900000000 ->  NaN Invalid_operation
+	{"orx265", "000000000", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD-1
+	// orx270 or  020000000 100000000 ->  NaN Invalid_operation
+	{"orx270", "020000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx271 or  070100000 100000000 ->  NaN Invalid_operation
+	{"orx271", "070100000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx272 or  080010000 100000001 ->  NaN Invalid_operation
+	{"orx272", "080010000", "100000001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx273 or  090001000 100000010 ->  NaN Invalid_operation
+	{"orx273", "090001000", "100000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx274 or  100000100 020010100 ->  NaN Invalid_operation
+	{"orx274", "100000100", "020010100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx275 or  100000000 070001000 ->  NaN Invalid_operation
+	{"orx275", "100000000", "070001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx276 or  100000010 080010100 ->  NaN Invalid_operation
+	{"orx276", "100000010", "080010100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx277 or  100000000 090000010 ->  NaN Invalid_operation
+	{"orx277", "100000000", "090000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test LSD
+	// orx280 or  001000002 100000000 ->  NaN Invalid_operation
+	{"orx280", "001000002", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx281 or  000000007 100000000 ->  NaN Invalid_operation
+	{"orx281", "000000007", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx282 or  000000008 100000000 ->  NaN Invalid_operation
+	{"orx282", "000000008", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx283 or  000000009 100000000 ->  NaN Invalid_operation
+	{"orx283", "000000009", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx284 or  100000000 000100002 ->  NaN Invalid_operation
+	{"orx284", "100000000", "000100002", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx285 or  100100000 001000007 ->  NaN Invalid_operation
+	{"orx285", "100100000", "001000007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx286 or  100010000 010000008 ->  NaN Invalid_operation
+	{"orx286", "100010000", "010000008", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx287 or  100001000 100000009 ->  NaN Invalid_operation
+	{"orx287", "100001000", "100000009", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test Middie
+	// orx288 or  001020000 100000000 ->  NaN Invalid_operation
+	{"orx288", "001020000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx289 or  000070001 100000000 ->  NaN Invalid_operation
+	{"orx289", "000070001", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx290 or  000080000 100010000 ->  NaN Invalid_operation
+	{"orx290", "000080000", "100010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx291 or  000090000 100001000 ->  NaN Invalid_operation
+	{"orx291", "000090000", "100001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx292 or  100000010 000020100 ->  NaN Invalid_operation
+	{"orx292", "100000010", "000020100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx293 or  100100000 000070010 ->  NaN Invalid_operation
+	{"orx293", "100100000", "000070010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx294 or  100010100 000080001 ->  NaN Invalid_operation
+	{"orx294", "100010100", "000080001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx295 or  100001000 000090000 ->  NaN Invalid_operation
+	{"orx295", "100001000", "000090000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// signs
+	// orx296 or -100001000 -000000000 ->  NaN Invalid_operation
+	{"orx296", "-100001000", "-000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx297 or -100001000  000010000 ->  NaN Invalid_operation
+	{"orx297", "-100001000", "000010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx298 or  100001000 -000000000 ->  NaN Invalid_operation
+	{"orx298", "100001000", "-000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx299 or  100001000  000011000 ->  100011000
+	{"orx299", "100001000", "000011000", "100011000", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// orx331 or  2   9.99999999E+999     -> NaN Invalid_operation
+	{"orx331", "2", "9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx332 or  3   1E-999              -> NaN Invalid_operation
+	{"orx332", "3", "1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx333 or  4   1.00000000E-999     -> NaN Invalid_operation
+	{"orx333", "4", "1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx334 or  5   1E-1007             -> NaN Invalid_operation
+	{"orx334", "5", "1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx335 or  6   -1E-1007            -> NaN Invalid_operation
+	{"orx335", "6", "-1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx336 or  7   -1.00000000E-999    -> NaN Invalid_operation
+	{"orx336", "7", "-1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx337 or  8   -1E-999             -> NaN Invalid_operation
+	{"orx337", "8", "-1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx338 or  9   -9.99999999E+999    -> NaN Invalid_operation
+	{"orx338", "9", "-9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx341 or  9.99999999E+999     -18 -> NaN Invalid_operation
+	{"orx341", "9.99999999E+999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx342 or  1E-999               01 -> NaN Invalid_operation
+	{"orx342", "1E-999", "01", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx343 or  1.00000000E-999     -18 -> NaN Invalid_operation
+	{"orx343", "1.00000000E-999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx344 or  1E-1007              18 -> NaN Invalid_operation
+	{"orx344", "1E-1007", "18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx345 or  -1E-1007            -10 -> NaN Invalid_operation
+	{"orx345", "-1E-1007", "-10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx346 or  -1.00000000E-999     18 -> NaN Invalid_operation
+	{"orx346", "-1.00000000E-999", "18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx347 or  -1E-999              10 -> NaN Invalid_operation
+	{"orx347", "-1E-999", "10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx348 or  -9.99999999E+999    -18 -> NaN Invalid_operation
+	{"orx348", "-9.99999999E+999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// A few other non-integers
+	// orx361 or  1.0                  1  -> NaN Invalid_operation
+	{"orx361", "1.0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx362 or  1E+1                 1  -> NaN Invalid_operation
+	{"orx362", "1E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx363 or  0.0                  1  -> NaN Invalid_operation
+	{"orx363", "0.0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx364 or  0E+1                 1  -> NaN Invalid_operation
+	{"orx364", "0E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx365 or  9.9                  1  -> NaN Invalid_operation
+	{"orx365", "9.9", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx366 or  9E+1                 1  -> NaN Invalid_operation
+	{"orx366", "9E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx371 or  0 1.0                   -> NaN Invalid_operation
+	{"orx371", "0", "1.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx372 or  0 1E+1                  -> NaN Invalid_operation
+	{"orx372", "0", "1E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx373 or  0 0.0                   -> NaN Invalid_operation
+	{"orx373", "0", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx374 or  0 0E+1                  -> NaN Invalid_operation
+	{"orx374", "0", "0E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx375 or  0 9.9                   -> NaN Invalid_operation
+	{"orx375", "0", "9.9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx376 or  0 9E+1                  -> NaN Invalid_operation
+	{"orx376", "0", "9E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// All Specials are in error
+	// orx780 or -Inf  -Inf   -> NaN Invalid_operation
+	{"orx780", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx781 or -Inf  -1000  -> NaN Invalid_operation
+	{"orx781", "-Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx782 or -Inf  -1     -> NaN Invalid_operation
+	{"orx782", "-Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx783 or -Inf  -0     -> NaN Invalid_operation
+	{"orx783", "-Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx784 or -Inf   0     -> NaN Invalid_operation
+	{"orx784", "-Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx785 or -Inf   1     -> NaN Invalid_operation
+	{"orx785", "-Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx786 or -Inf   1000  -> NaN Invalid_operation
+	{"orx786", "-Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx787 or -1000 -Inf   -> NaN Invalid_operation
+	{"orx787", "-1000", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx788 or -Inf  -Inf   -> NaN Invalid_operation
+	{"orx788", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx789 or -1    -Inf   -> NaN Invalid_operation
+	{"orx789", "-1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx790 or -0    -Inf   -> NaN Invalid_operation
+	{"orx790", "-0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx791 or  0    -Inf   -> NaN Invalid_operation
+	{"orx791", "0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx792 or  1    -Inf   -> NaN Invalid_operation
+	{"orx792", "1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx793 or  1000 -Inf   -> NaN Invalid_operation
+	{"orx793", "1000", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx794 or  Inf  -Inf   -> NaN Invalid_operation
+	{"orx794", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx800 or  Inf  -Inf   -> NaN Invalid_operation
+	{"orx800", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx801 or  Inf  -1000  -> NaN Invalid_operation
+	{"orx801", "Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx802 or  Inf  -1     -> NaN Invalid_operation
+	{"orx802", "Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx803 or  Inf  -0     -> NaN Invalid_operation
+	{"orx803", "Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx804 or  Inf   0     -> NaN Invalid_operation
+	{"orx804", "Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx805 or  Inf   1     -> NaN Invalid_operation
+	{"orx805", "Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx806 or  Inf   1000  -> NaN Invalid_operation
+	{"orx806", "Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx807 or  Inf   Inf   -> NaN Invalid_operation
+	{"orx807", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx808 or -1000  Inf   -> NaN Invalid_operation
+	{"orx808", "-1000", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx809 or -Inf   Inf   -> NaN Invalid_operation
+	{"orx809", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx810 or -1     Inf   -> NaN Invalid_operation
+	{"orx810", "-1", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx811 or -0     Inf   -> NaN Invalid_operation
+	{"orx811", "-0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx812 or  0     Inf   -> NaN Invalid_operation
+	{"orx812", "0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx813 or  1     Inf   -> NaN Invalid_operation
+	{"orx813", "1", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx814 or  1000  Inf   -> NaN Invalid_operation
+	{"orx814", "1000", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx815 or  Inf   Inf   -> NaN Invalid_operation
+	{"orx815", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx821 or  NaN -Inf    -> NaN Invalid_operation
+	{"orx821", "NaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx822 or  NaN -1000   -> NaN Invalid_operation
+	{"orx822", "NaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx823 or  NaN -1      -> NaN Invalid_operation
+	{"orx823", "NaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx824 or  NaN -0      -> NaN Invalid_operation
+	{"orx824", "NaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx825 or  NaN  0      -> NaN Invalid_operation
+	{"orx825", "NaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx826 or  NaN  1      -> NaN Invalid_operation
+	{"orx826", "NaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx827 or  NaN  1000   -> NaN Invalid_operation
+	{"orx827", "NaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx828 or  NaN  Inf    -> NaN Invalid_operation
+	{"orx828", "NaN", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx829 or  NaN  NaN    -> NaN Invalid_operation
+	{"orx829", "NaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx830 or -Inf  NaN    -> NaN Invalid_operation
+	{"orx830", "-Inf", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx831 or -1000 NaN    -> NaN Invalid_operation
+	{"orx831", "-1000", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx832 or -1    NaN    -> NaN Invalid_operation
+	{"orx832", "-1", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx833 or -0    NaN    -> NaN Invalid_operation
+	{"orx833", "-0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx834 or  0    NaN    -> NaN Invalid_operation
+	{"orx834", "0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx835 or  1    NaN    -> NaN Invalid_operation
+	{"orx835", "1", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx836 or  1000 NaN    -> NaN Invalid_operation
+	{"orx836", "1000", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx837 or  Inf  NaN    -> NaN Invalid_operation
+	{"orx837", "Inf", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx841 or  sNaN -Inf   ->  NaN  Invalid_operation
+	{"orx841", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx842 or  sNaN -1000  ->  NaN  Invalid_operation
+	{"orx842", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx843 or  sNaN -1     ->  NaN  Invalid_operation
+	{"orx843", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx844 or  sNaN -0     ->  NaN  Invalid_operation
+	{"orx844", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx845 or  sNaN  0     ->  NaN  Invalid_operation
+	{"orx845", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx846 or  sNaN  1     ->  NaN  Invalid_operation
+	{"orx846", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx847 or  sNaN  1000  ->  NaN  Invalid_operation
+	{"orx847", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx848 or  sNaN  NaN   ->  NaN  Invalid_operation
+	{"orx848", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx849 or  sNaN sNaN   ->  NaN  Invalid_operation
+	{"orx849", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx850 or  NaN  sNaN   ->  NaN  Invalid_operation
+	{"orx850", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx851 or -Inf  sNaN   ->  NaN  Invalid_operation
+	{"orx851", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx852 or -1000 sNaN   ->  NaN  Invalid_operation
+	{"orx852", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx853 or -1    sNaN   ->  NaN  Invalid_operation
+	{"orx853", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx854 or -0    sNaN   ->  NaN  Invalid_operation
+	{"orx854", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx855 or  0    sNaN   ->  NaN  Invalid_operation
+	{"orx855", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx856 or  1    sNaN   ->  NaN  Invalid_operation
+	{"orx856", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx857 or  1000 sNaN   ->  NaN  Invalid_operation
+	{"orx857", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx858 or  Inf  sNaN   ->  NaN  Invalid_operation
+	{"orx858", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx859 or  NaN  sNaN   ->  NaN  Invalid_operation
+	{"orx859", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// orx861 or  NaN1   -Inf    -> NaN Invalid_operation
+	{"orx861", "NaN1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx862 or +NaN2   -1000   -> NaN Invalid_operation
+	{"orx862", "+NaN2", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx863 or  NaN3    1000   -> NaN Invalid_operation
+	{"orx863", "NaN3", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx864 or  NaN4    Inf    -> NaN Invalid_operation
+	{"orx864", "NaN4", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx865 or  NaN5   +NaN6   -> NaN Invalid_operation
+	{"orx865", "NaN5", "+NaN6", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx866 or -Inf     NaN7   -> NaN Invalid_operation
+	{"orx866", "-Inf", "NaN7", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx867 or -1000    NaN8   -> NaN Invalid_operation
+	{"orx867", "-1000", "NaN8", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx868 or  1000    NaN9   -> NaN Invalid_operation
+	{"orx868", "1000", "NaN9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx869 or  Inf    +NaN10  -> NaN Invalid_operation
+	{"orx869", "Inf", "+NaN10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx871 or  sNaN11  -Inf   -> NaN Invalid_operation
+	{"orx871", "sNaN11", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx872 or  sNaN12  -1000  -> NaN Invalid_operation
+	{"orx872", "sNaN12", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx873 or  sNaN13   1000  -> NaN Invalid_operation
+	{"orx873", "sNaN13", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx874 or  sNaN14   NaN17 -> NaN Invalid_operation
+	{"orx874", "sNaN14", "NaN17", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx875 or  sNaN15  sNaN18 -> NaN Invalid_operation
+	{"orx875", "sNaN15", "sNaN18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx876 or  NaN16   sNaN19 -> NaN Invalid_operation
+	{"orx876", "NaN16", "sNaN19", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx877 or -Inf    +sNaN20 -> NaN Invalid_operation
+	{"orx877", "-Inf", "+sNaN20", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx878 or -1000    sNaN21 -> NaN Invalid_operation
+	{"orx878", "-1000", "sNaN21", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx879 or  1000    sNaN22 -> NaN Invalid_operation
+	{"orx879", "1000", "sNaN22", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx880 or  Inf     sNaN23 -> NaN Invalid_operation
+	{"orx880", "Inf", "sNaN23", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx881 or +NaN25  +sNaN24 -> NaN Invalid_operation
+	{"orx881", "+NaN25", "+sNaN24", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx882 or -NaN26    NaN28 -> NaN Invalid_operation
+	{"orx882", "-NaN26", "NaN28", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx883 or -sNaN27  sNaN29 -> NaN Invalid_operation
+	{"orx883", "-sNaN27", "sNaN29", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx884 or  1000    -NaN30 -> NaN Invalid_operation
+	{"orx884", "1000", "-NaN30", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// orx885 or  1000   -sNaN31 -> NaN Invalid_operation
+	{"orx885", "1000", "-sNaN31", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+}
@@ -0,0 +1,1643 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var quantizeTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// Most of the tests here assume a "regular pattern", where the
+	// sign and coefficient are +1.
+	// 2004.03.15 Underflow for quantize is suppressed
+	// 2005.06.08 More extensive tests for 'does not fit'
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// sanity checks
+	// quax001 quantize 0       1e0   -> 0
+	{"quax001", "0", "1e0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax002 quantize 1       1e0   -> 1
+	{"quax002", "1", "1e0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax003 quantize 0.1    1e+2   -> 0E+2 Inexact Rounded
+	{"quax003", "0.1", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax005 quantize 0.1    1e+1   -> 0E+1 Inexact Rounded
+	{"quax005", "0.1", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax006 quantize 0.1     1e0   -> 0 Inexact Rounded
+	{"quax006", "0.1", "1e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax007 quantize 0.1    1e-1   -> 0.1
+	{"quax007", "0.1", "1e-1", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax008 quantize 0.1    1e-2   -> 0.10
+	{"quax008", "0.1", "1e-2", "0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// quax009 quantize 0.1    1e-3   -> 0.100
+	{"quax009", "0.1", "1e-3", "0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// quax010 quantize 0.9    1e+2   -> 0E+2 Inexact Rounded
+	{"quax010", "0.9", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax011 quantize 0.9    1e+1   -> 0E+1 Inexact Rounded
+	{"quax011", "0.9", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax012 quantize 0.9    1e+0   -> 1 Inexact Rounded
+	{"quax012", "0.9", "1e+0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax013 quantize 0.9    1e-1   -> 0.9
+	{"quax013", "0.9", "1e-1", "0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax014 quantize 0.9    1e-2   -> 0.90
+	{"quax014", "0.9", "1e-2", "0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// quax015 quantize 0.9    1e-3   -> 0.900
+	{"quax015", "0.9", "1e-3", "0.900", 0, 9, ToNearestAway, 999, -999, false},
+	// negatives
+	// quax021 quantize -0      1e0   -> -0
+	{"quax021", "-0", "1e0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax022 quantize -1      1e0   -> -1
+	{"quax022", "-1", "1e0", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax023 quantize -0.1   1e+2   -> -0E+2 Inexact Rounded
+	{"quax023", "-0.1", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax025 quantize -0.1   1e+1   -> -0E+1 Inexact Rounded
+	{"quax025", "-0.1", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax026 quantize -0.1    1e0   -> -0 Inexact Rounded
+	{"quax026", "-0.1", "1e0", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax027 quantize -0.1   1e-1   -> -0.1
+	{"quax027", "-0.1", "1e-1", "-0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax028 quantize -0.1   1e-2   -> -0.10
+	{"quax028", "-0.1", "1e-2", "-0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// quax029 quantize -0.1   1e-3   -> -0.100
+	{"quax029", "-0.1", "1e-3", "-0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// quax030 quantize -0.9   1e+2   -> -0E+2 Inexact Rounded
+	{"quax030", "-0.9", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax031 quantize -0.9   1e+1   -> -0E+1 Inexact Rounded
+	{"quax031", "-0.9", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax032 quantize -0.9   1e+0   -> -1 Inexact Rounded
+	{"quax032", "-0.9", "1e+0", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax033 quantize -0.9   1e-1   -> -0.9
+	{"quax033", "-0.9", "1e-1", "-0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax034 quantize -0.9   1e-2   -> -0.90
+	{"quax034", "-0.9", "1e-2", "-0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// quax035 quantize -0.9   1e-3   -> -0.900
+	{"quax035", "-0.9", "1e-3", "-0.900", 0, 9, ToNearestAway, 999, -999, false},
+	// quax036 quantize -0.5   1e+2   -> -0E+2 Inexact Rounded
+	{"quax036", "-0.5", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax037 quantize -0.5   1e+1   -> -0E+1 Inexact Rounded
+	{"quax037", "-0.5", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax038 quantize -0.5   1e+0   -> -1 Inexact Rounded
+	{"quax038", "-0.5", "1e+0", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax039 quantize -0.5   1e-1   -> -0.5
+	{"quax039", "-0.5", "1e-1", "-0.5", 0, 9, ToNearestAway, 999, -999, false},
+	// quax040 quantize -0.5   1e-2   -> -0.50
+	{"quax040", "-0.5", "1e-2", "-0.50", 0, 9, ToNearestAway, 999, -999, false},
+	// quax041 quantize -0.5   1e-3   -> -0.500
+	{"quax041", "-0.5", "1e-3", "-0.500", 0, 9, ToNearestAway, 999, -999, false},
+	// quax042 quantize -0.9   1e+2   -> -0E+2 Inexact Rounded
+	{"quax042", "-0.9", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax043 quantize -0.9   1e+1   -> -0E+1 Inexact Rounded
+	{"quax043", "-0.9", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax044 quantize -0.9   1e+0   -> -1 Inexact Rounded
+	{"quax044", "-0.9", "1e+0", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax045 quantize -0.9   1e-1   -> -0.9
+	{"quax045", "-0.9", "1e-1", "-0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax046 quantize -0.9   1e-2   -> -0.90
+	{"quax046", "-0.9", "1e-2", "-0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// quax047 quantize -0.9   1e-3   -> -0.900
+	{"quax047", "-0.9", "1e-3", "-0.900", 0, 9, ToNearestAway, 999, -999, false},
+	// examples from Specification
+	// quax060 quantize 2.17   0.001  -> 2.170
+	{"quax060", "2.17", "0.001", "2.170", 0, 9, ToNearestAway, 999, -999, false},
+	// quax061 quantize 2.17   0.01   -> 2.17
+	{"quax061", "2.17", "0.01", "2.17", 0, 9, ToNearestAway, 999, -999, false},
+	// quax062 quantize 2.17   0.1    -> 2.2 Inexact Rounded
+	{"quax062", "2.17", "0.1", "2.2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax063 quantize 2.17   1e+0   -> 2 Inexact Rounded
+	{"quax063", "2.17", "1e+0", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax064 quantize 2.17   1e+1   -> 0E+1 Inexact Rounded
+	{"quax064", "2.17", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax065 quantize -Inf    Inf   -> -Infinity
+	{"quax065", "-Inf", "Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// quax066 quantize 2       Inf   -> NaN Invalid_operation
+	{"quax066", "2", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax067 quantize -0.1    1     -> -0 Inexact Rounded
+	{"quax067", "-0.1", "1", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax068 quantize -0      1e+5     -> -0E+5
+	{"quax068", "-0", "1e+5", "-0E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// quax069 quantize +35236450.6 1e-2 -> NaN Invalid_operation
+	{"quax069", "+35236450.6", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax070 quantize -35236450.6 1e-2 -> NaN Invalid_operation
+	{"quax070", "-35236450.6", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax071 quantize 217    1e-1   -> 217.0
+	{"quax071", "217", "1e-1", "217.0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax072 quantize 217    1e+0   -> 217
+	{"quax072", "217", "1e+0", "217", 0, 9, ToNearestAway, 999, -999, false},
+	// quax073 quantize 217    1e+1   -> 2.2E+2 Inexact Rounded
+	{"quax073", "217", "1e+1", "2.2E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax074 quantize 217    1e+2   -> 2E+2 Inexact Rounded
+	{"quax074", "217", "1e+2", "2E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// general tests ..
+	// quax089 quantize 12     1e+4   -> 0E+4 Inexact Rounded
+	{"quax089", "12", "1e+4", "0E+4", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax090 quantize 12     1e+3   -> 0E+3 Inexact Rounded
+	{"quax090", "12", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax091 quantize 12     1e+2   -> 0E+2 Inexact Rounded
+	{"quax091", "12", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax092 quantize 12     1e+1   -> 1E+1 Inexact Rounded
+	{"quax092", "12", "1e+1", "1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax093 quantize 1.2345 1e-2   -> 1.23 Inexact Rounded
+	{"quax093", "1.2345", "1e-2", "1.23", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax094 quantize 1.2355 1e-2   -> 1.24 Inexact Rounded
+	{"quax094", "1.2355", "1e-2", "1.24", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax095 quantize 1.2345 1e-6   -> 1.234500
+	{"quax095", "1.2345", "1e-6", "1.234500", 0, 9, ToNearestAway, 999, -999, false},
+	// quax096 quantize 9.9999 1e-2   -> 10.00 Inexact Rounded
+	{"quax096", "9.9999", "1e-2", "10.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax097 quantize 0.0001 1e-2   -> 0.00 Inexact Rounded
+	{"quax097", "0.0001", "1e-2", "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax098 quantize 0.001  1e-2   -> 0.00 Inexact Rounded
+	{"quax098", "0.001", "1e-2", "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax099 quantize 0.009  1e-2   -> 0.01 Inexact Rounded
+	{"quax099", "0.009", "1e-2", "0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax100 quantize 92     1e+2   -> 1E+2 Inexact Rounded
+	{"quax100", "92", "1e+2", "1E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax101 quantize -1      1e0   ->  -1
+	{"quax101", "-1", "1e0", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax102 quantize -1     1e-1   ->  -1.0
+	{"quax102", "-1", "1e-1", "-1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax103 quantize -1     1e-2   ->  -1.00
+	{"quax103", "-1", "1e-2", "-1.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax104 quantize  0      1e0   ->  0
+	{"quax104", "0", "1e0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax105 quantize  0     1e-1   ->  0.0
+	{"quax105", "0", "1e-1", "0.0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax106 quantize  0     1e-2   ->  0.00
+	{"quax106", "0", "1e-2", "0.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax107 quantize  0.00   1e0   ->  0
+	{"quax107", "0.00", "1e0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax108 quantize  0     1e+1   ->  0E+1
+	{"quax108", "0", "1e+1", "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax109 quantize  0     1e+2   ->  0E+2
+	{"quax109", "0", "1e+2", "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// quax110 quantize +1      1e0   ->  1
+	{"quax110", "+1", "1e0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax111 quantize +1     1e-1   ->  1.0
+	{"quax111", "+1", "1e-1", "1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax112 quantize +1     1e-2   ->  1.00
+	{"quax112", "+1", "1e-2", "1.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax120 quantize   1.04  1e-3 ->  1.040
+	{"quax120", "1.04", "1e-3", "1.040", 0, 9, ToNearestAway, 999, -999, false},
+	// quax121 quantize   1.04  1e-2 ->  1.04
+	{"quax121", "1.04", "1e-2", "1.04", 0, 9, ToNearestAway, 999, -999, false},
+	// quax122 quantize   1.04  1e-1 ->  1.0 Inexact Rounded
+	{"quax122", "1.04", "1e-1", "1.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax123 quantize   1.04   1e0 ->  1 Inexact Rounded
+	{"quax123", "1.04", "1e0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax124 quantize   1.05  1e-3 ->  1.050
+	{"quax124", "1.05", "1e-3", "1.050", 0, 9, ToNearestAway, 999, -999, false},
+	// quax125 quantize   1.05  1e-2 ->  1.05
+	{"quax125", "1.05", "1e-2", "1.05", 0, 9, ToNearestAway, 999, -999, false},
+	// quax126 quantize   1.05  1e-1 ->  1.1 Inexact Rounded
+	{"quax126", "1.05", "1e-1", "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax131 quantize   1.05   1e0 ->  1 Inexact Rounded
+	{"quax131", "1.05", "1e0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax132 quantize   1.06  1e-3 ->  1.060
+	{"quax132", "1.06", "1e-3", "1.060", 0, 9, ToNearestAway, 999, -999, false},
+	// quax133 quantize   1.06  1e-2 ->  1.06
+	{"quax133", "1.06", "1e-2", "1.06", 0, 9, ToNearestAway, 999, -999, false},
+	// quax134 quantize   1.06  1e-1 ->  1.1 Inexact Rounded
+	{"quax134", "1.06", "1e-1", "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax135 quantize   1.06   1e0 ->  1 Inexact Rounded
+	{"quax135", "1.06", "1e0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax140 quantize   -10    1e-2  ->  -10.00
+	{"quax140", "-10", "1e-2", "-10.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax141 quantize   +1     1e-2  ->  1.00
+	{"quax141", "+1", "1e-2", "1.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax142 quantize   +10    1e-2  ->  10.00
+	{"quax142", "+10", "1e-2", "10.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax143 quantize   1E+10  1e-2  ->  NaN Invalid_operation
+	{"quax143", "1E+10", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax144 quantize   1E-10  1e-2  ->  0.00 Inexact Rounded
+	{"quax144", "1E-10", "1e-2", "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax145 quantize   1E-3   1e-2  ->  0.00 Inexact Rounded
+	{"quax145", "1E-3", "1e-2", "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax146 quantize   1E-2   1e-2  ->  0.01
+	{"quax146", "1E-2", "1e-2", "0.01", 0, 9, ToNearestAway, 999, -999, false},
+	// quax147 quantize   1E-1   1e-2  ->  0.10
+	{"quax147", "1E-1", "1e-2", "0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// quax148 quantize   0E-10  1e-2  ->  0.00
+	{"quax148", "0E-10", "1e-2", "0.00", 0, 9, ToNearestAway, 999, -999, false},
+	// quax150 quantize   1.0600 1e-5 ->  1.06000
+	{"quax150", "1.0600", "1e-5", "1.06000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax151 quantize   1.0600 1e-4 ->  1.0600
+	{"quax151", "1.0600", "1e-4", "1.0600", 0, 9, ToNearestAway, 999, -999, false},
+	// quax152 quantize   1.0600 1e-3 ->  1.060 Rounded
+	{"quax152", "1.0600", "1e-3", "1.060", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax153 quantize   1.0600 1e-2 ->  1.06 Rounded
+	{"quax153", "1.0600", "1e-2", "1.06", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax154 quantize   1.0600 1e-1 ->  1.1 Inexact Rounded
+	{"quax154", "1.0600", "1e-1", "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax155 quantize   1.0600  1e0 ->  1 Inexact Rounded
+	{"quax155", "1.0600", "1e0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// base tests with non-1 coefficients
+	// quax161 quantize 0      -9e0   -> 0
+	{"quax161", "0", "-9e0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax162 quantize 1      -7e0   -> 1
+	{"quax162", "1", "-7e0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax163 quantize 0.1   -1e+2   -> 0E+2 Inexact Rounded
+	{"quax163", "0.1", "-1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax165 quantize 0.1    0e+1   -> 0E+1 Inexact Rounded
+	{"quax165", "0.1", "0e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax166 quantize 0.1     2e0   -> 0 Inexact Rounded
+	{"quax166", "0.1", "2e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax167 quantize 0.1    3e-1   -> 0.1
+	{"quax167", "0.1", "3e-1", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax168 quantize 0.1   44e-2   -> 0.10
+	{"quax168", "0.1", "44e-2", "0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// quax169 quantize 0.1  555e-3   -> 0.100
+	{"quax169", "0.1", "555e-3", "0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// quax170 quantize 0.9 6666e+2   -> 0E+2 Inexact Rounded
+	{"quax170", "0.9", "6666e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax171 quantize 0.9 -777e+1   -> 0E+1 Inexact Rounded
+	{"quax171", "0.9", "-777e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax172 quantize 0.9  -88e+0   -> 1 Inexact Rounded
+	{"quax172", "0.9", "-88e+0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax173 quantize 0.9   -9e-1   -> 0.9
+	{"quax173", "0.9", "-9e-1", "0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax174 quantize 0.9    0e-2   -> 0.90
+	{"quax174", "0.9", "0e-2", "0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// quax175 quantize 0.9  1.1e-3   -> 0.9000
+	{"quax175", "0.9", "1.1e-3", "0.9000", 0, 9, ToNearestAway, 999, -999, false},
+	// negatives
+	// quax181 quantize -0    1.1e0   -> -0.0
+	{"quax181", "-0", "1.1e0", "-0.0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax182 quantize -1     -1e0   -> -1
+	{"quax182", "-1", "-1e0", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax183 quantize -0.1  11e+2   -> -0E+2 Inexact Rounded
+	{"quax183", "-0.1", "11e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax185 quantize -0.1 111e+1   -> -0E+1 Inexact Rounded
+	{"quax185", "-0.1", "111e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax186 quantize -0.1   71e0   -> -0 Inexact Rounded
+	{"quax186", "-0.1", "71e0", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax187 quantize -0.1 -91e-1   -> -0.1
+	{"quax187", "-0.1", "-91e-1", "-0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax188 quantize -0.1 -.1e-2   -> -0.100
+	{"quax188", "-0.1", "-.1e-2", "-0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// quax189 quantize -0.1  -1e-3   -> -0.100
+	{"quax189", "-0.1", "-1e-3", "-0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// quax190 quantize -0.9   0e+2   -> -0E+2 Inexact Rounded
+	{"quax190", "-0.9", "0e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax191 quantize -0.9  -0e+1   -> -0E+1 Inexact Rounded
+	{"quax191", "-0.9", "-0e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax192 quantize -0.9 -10e+0   -> -1 Inexact Rounded
+	{"quax192", "-0.9", "-10e+0", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax193 quantize -0.9 100e-1   -> -0.9
+	{"quax193", "-0.9", "100e-1", "-0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax194 quantize -0.9 999e-2   -> -0.90
+	{"quax194", "-0.9", "999e-2", "-0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// +ve exponents ..
+	// quax201 quantize   -1   1e+0 ->  -1
+	{"quax201", "-1", "1e+0", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax202 quantize   -1   1e+1 ->  -0E+1 Inexact Rounded
+	{"quax202", "-1", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax203 quantize   -1   1e+2 ->  -0E+2 Inexact Rounded
+	{"quax203", "-1", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax204 quantize    0   1e+0 ->  0
+	{"quax204", "0", "1e+0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// quax205 quantize    0   1e+1 ->  0E+1
+	{"quax205", "0", "1e+1", "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax206 quantize    0   1e+2 ->  0E+2
+	{"quax206", "0", "1e+2", "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// quax207 quantize   +1   1e+0 ->  1
+	{"quax207", "+1", "1e+0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax208 quantize   +1   1e+1 ->  0E+1 Inexact Rounded
+	{"quax208", "+1", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax209 quantize   +1   1e+2 ->  0E+2 Inexact Rounded
+	{"quax209", "+1", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax220 quantize   1.04 1e+3 ->  0E+3 Inexact Rounded
+	{"quax220", "1.04", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax221 quantize   1.04 1e+2 ->  0E+2 Inexact Rounded
+	{"quax221", "1.04", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax222 quantize   1.04 1e+1 ->  0E+1 Inexact Rounded
+	{"quax222", "1.04", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax223 quantize   1.04 1e+0 ->  1 Inexact Rounded
+	{"quax223", "1.04", "1e+0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax224 quantize   1.05 1e+3 ->  0E+3 Inexact Rounded
+	{"quax224", "1.05", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax225 quantize   1.05 1e+2 ->  0E+2 Inexact Rounded
+	{"quax225", "1.05", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax226 quantize   1.05 1e+1 ->  0E+1 Inexact Rounded
+	{"quax226", "1.05", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax227 quantize   1.05 1e+0 ->  1 Inexact Rounded
+	{"quax227", "1.05", "1e+0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax228 quantize   1.05 1e+3 ->  0E+3 Inexact Rounded
+	{"quax228", "1.05", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax229 quantize   1.05 1e+2 ->  0E+2 Inexact Rounded
+	{"quax229", "1.05", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax230 quantize   1.05 1e+1 ->  0E+1 Inexact Rounded
+	{"quax230", "1.05", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax231 quantize   1.05 1e+0 ->  1 Inexact Rounded
+	{"quax231", "1.05", "1e+0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax232 quantize   1.06 1e+3 ->  0E+3 Inexact Rounded
+	{"quax232", "1.06", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax233 quantize   1.06 1e+2 ->  0E+2 Inexact Rounded
+	{"quax233", "1.06", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax234 quantize   1.06 1e+1 ->  0E+1 Inexact Rounded
+	{"quax234", "1.06", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax235 quantize   1.06 1e+0 ->  1 Inexact Rounded
+	{"quax235", "1.06", "1e+0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax240 quantize   -10   1e+1  ->  -1E+1 Rounded
+	{"quax240", "-10", "1e+1", "-1E+1", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax241 quantize   +1    1e+1  ->  0E+1 Inexact Rounded
+	{"quax241", "+1", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax242 quantize   +10   1e+1  ->  1E+1 Rounded
+	{"quax242", "+10", "1e+1", "1E+1", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax243 quantize   1E+1  1e+1  ->  1E+1          -- underneath this is E+1
+	{"quax243", "1E+1", "1e+1", "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax244 quantize   1E+2  1e+1  ->  1.0E+2        -- underneath this is E+1
+	{"quax244", "1E+2", "1e+1", "1.0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// quax245 quantize   1E+3  1e+1  ->  1.00E+3       -- underneath this is E+1
+	{"quax245", "1E+3", "1e+1", "1.00E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// quax246 quantize   1E+4  1e+1  ->  1.000E+4      -- underneath this is E+1
+	{"quax246", "1E+4", "1e+1", "1.000E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// quax247 quantize   1E+5  1e+1  ->  1.0000E+5     -- underneath this is E+1
+	{"quax247", "1E+5", "1e+1", "1.0000E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// quax248 quantize   1E+6  1e+1  ->  1.00000E+6    -- underneath this is E+1
+	{"quax248", "1E+6", "1e+1", "1.00000E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// quax249 quantize   1E+7  1e+1  ->  1.000000E+7   -- underneath this is E+1
+	{"quax249", "1E+7", "1e+1", "1.000000E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// quax250 quantize   1E+8  1e+1  ->  1.0000000E+8  -- underneath this is E+1
+	{"quax250", "1E+8", "1e+1", "1.0000000E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// quax251 quantize   1E+9  1e+1  ->  1.00000000E+9 -- underneath this is E+1
+	{"quax251", "1E+9", "1e+1", "1.00000000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// next one tries to add 9 zeros
+	// quax252 quantize   1E+10 1e+1  ->  NaN Invalid_operation
+	{"quax252", "1E+10", "1e+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax253 quantize   1E-10 1e+1  ->  0E+1 Inexact Rounded
+	{"quax253", "1E-10", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax254 quantize   1E-2  1e+1  ->  0E+1 Inexact Rounded
+	{"quax254", "1E-2", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax255 quantize   0E-10 1e+1  ->  0E+1
+	{"quax255", "0E-10", "1e+1", "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax256 quantize  -0E-10 1e+1  -> -0E+1
+	{"quax256", "-0E-10", "1e+1", "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax257 quantize  -0E-1  1e+1  -> -0E+1
+	{"quax257", "-0E-1", "1e+1", "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax258 quantize  -0     1e+1  -> -0E+1
+	{"quax258", "-0", "1e+1", "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax259 quantize  -0E+1  1e+1  -> -0E+1
+	{"quax259", "-0E+1", "1e+1", "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// quax260 quantize   -10   1e+2  ->  -0E+2 Inexact Rounded
+	{"quax260", "-10", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax261 quantize   +1    1e+2  ->  0E+2 Inexact Rounded
+	{"quax261", "+1", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax262 quantize   +10   1e+2  ->  0E+2 Inexact Rounded
+	{"quax262", "+10", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax263 quantize   1E+1  1e+2  ->  0E+2 Inexact Rounded
+	{"quax263", "1E+1", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax264 quantize   1E+2  1e+2  ->  1E+2
+	{"quax264", "1E+2", "1e+2", "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// quax265 quantize   1E+3  1e+2  ->  1.0E+3
+	{"quax265", "1E+3", "1e+2", "1.0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// quax266 quantize   1E+4  1e+2  ->  1.00E+4
+	{"quax266", "1E+4", "1e+2", "1.00E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// quax267 quantize   1E+5  1e+2  ->  1.000E+5
+	{"quax267", "1E+5", "1e+2", "1.000E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// quax268 quantize   1E+6  1e+2  ->  1.0000E+6
+	{"quax268", "1E+6", "1e+2", "1.0000E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// quax269 quantize   1E+7  1e+2  ->  1.00000E+7
+	{"quax269", "1E+7", "1e+2", "1.00000E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// quax270 quantize   1E+8  1e+2  ->  1.000000E+8
+	{"quax270", "1E+8", "1e+2", "1.000000E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// quax271 quantize   1E+9  1e+2  ->  1.0000000E+9
+	{"quax271", "1E+9", "1e+2", "1.0000000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax272 quantize   1E+10 1e+2  ->  1.00000000E+10
+	{"quax272", "1E+10", "1e+2", "1.00000000E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// quax273 quantize   1E-10 1e+2  ->  0E+2 Inexact Rounded
+	{"quax273", "1E-10", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax274 quantize   1E-2  1e+2  ->  0E+2 Inexact Rounded
+	{"quax274", "1E-2", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax275 quantize   0E-10 1e+2  ->  0E+2
+	{"quax275", "0E-10", "1e+2", "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// quax280 quantize   -10   1e+3  ->  -0E+3 Inexact Rounded
+	{"quax280", "-10", "1e+3", "-0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax281 quantize   +1    1e+3  ->  0E+3 Inexact Rounded
+	{"quax281", "+1", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax282 quantize   +10   1e+3  ->  0E+3 Inexact Rounded
+	{"quax282", "+10", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax283 quantize   1E+1  1e+3  ->  0E+3 Inexact Rounded
+	{"quax283", "1E+1", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax284 quantize   1E+2  1e+3  ->  0E+3 Inexact Rounded
+	{"quax284", "1E+2", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax285 quantize   1E+3  1e+3  ->  1E+3
+	{"quax285", "1E+3", "1e+3", "1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// quax286 quantize   1E+4  1e+3  ->  1.0E+4
+	{"quax286", "1E+4", "1e+3", "1.0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// quax287 quantize   1E+5  1e+3  ->  1.00E+5
+	{"quax287", "1E+5", "1e+3", "1.00E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// quax288 quantize   1E+6  1e+3  ->  1.000E+6
+	{"quax288", "1E+6", "1e+3", "1.000E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// quax289 quantize   1E+7  1e+3  ->  1.0000E+7
+	{"quax289", "1E+7", "1e+3", "1.0000E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// quax290 quantize   1E+8  1e+3  ->  1.00000E+8
+	{"quax290", "1E+8", "1e+3", "1.00000E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// quax291 quantize   1E+9  1e+3  ->  1.000000E+9
+	{"quax291", "1E+9", "1e+3", "1.000000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// quax292 quantize   1E+10 1e+3  ->  1.0000000E+10
+	{"quax292", "1E+10", "1e+3", "1.0000000E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// quax293 quantize   1E-10 1e+3  ->  0E+3 Inexact Rounded
+	{"quax293", "1E-10", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax294 quantize   1E-2  1e+3  ->  0E+3 Inexact Rounded
+	{"quax294", "1E-2", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax295 quantize   0E-10 1e+3  ->  0E+3
+	{"quax295", "0E-10", "1e+3", "0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// round up from below [sign wrong in JIT compiler once]
+	// quax300 quantize   0.0078 1e-5 ->  0.00780
+	{"quax300", "0.0078", "1e-5", "0.00780", 0, 9, ToNearestAway, 999, -999, false},
+	// quax301 quantize   0.0078 1e-4 ->  0.0078
+	{"quax301", "0.0078", "1e-4", "0.0078", 0, 9, ToNearestAway, 999, -999, false},
+	// quax302 quantize   0.0078 1e-3 ->  0.008 Inexact Rounded
+	{"quax302", "0.0078", "1e-3", "0.008", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax303 quantize   0.0078 1e-2 ->  0.01 Inexact Rounded
+	{"quax303", "0.0078", "1e-2", "0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax304 quantize   0.0078 1e-1 ->  0.0 Inexact Rounded
+	{"quax304", "0.0078", "1e-1", "0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax305 quantize   0.0078  1e0 ->  0 Inexact Rounded
+	{"quax305", "0.0078", "1e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax306 quantize   0.0078 1e+1 ->  0E+1 Inexact Rounded
+	{"quax306", "0.0078", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax307 quantize   0.0078 1e+2 ->  0E+2 Inexact Rounded
+	{"quax307", "0.0078", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax310 quantize  -0.0078 1e-5 -> -0.00780
+	{"quax310", "-0.0078", "1e-5", "-0.00780", 0, 9, ToNearestAway, 999, -999, false},
+	// quax311 quantize  -0.0078 1e-4 -> -0.0078
+	{"quax311", "-0.0078", "1e-4", "-0.0078", 0, 9, ToNearestAway, 999, -999, false},
+	// quax312 quantize  -0.0078 1e-3 -> -0.008 Inexact Rounded
+	{"quax312", "-0.0078", "1e-3", "-0.008", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax313 quantize  -0.0078 1e-2 -> -0.01 Inexact Rounded
+	{"quax313", "-0.0078", "1e-2", "-0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax314 quantize  -0.0078 1e-1 -> -0.0 Inexact Rounded
+	{"quax314", "-0.0078", "1e-1", "-0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax315 quantize  -0.0078  1e0 -> -0 Inexact Rounded
+	{"quax315", "-0.0078", "1e0", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax316 quantize  -0.0078 1e+1 -> -0E+1 Inexact Rounded
+	{"quax316", "-0.0078", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax317 quantize  -0.0078 1e+2 -> -0E+2 Inexact Rounded
+	{"quax317", "-0.0078", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax320 quantize   0.078 1e-5 ->  0.07800
+	{"quax320", "0.078", "1e-5", "0.07800", 0, 9, ToNearestAway, 999, -999, false},
+	// quax321 quantize   0.078 1e-4 ->  0.0780
+	{"quax321", "0.078", "1e-4", "0.0780", 0, 9, ToNearestAway, 999, -999, false},
+	// quax322 quantize   0.078 1e-3 ->  0.078
+	{"quax322", "0.078", "1e-3", "0.078", 0, 9, ToNearestAway, 999, -999, false},
+	// quax323 quantize   0.078 1e-2 ->  0.08 Inexact Rounded
+	{"quax323", "0.078", "1e-2", "0.08", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax324 quantize   0.078 1e-1 ->  0.1 Inexact Rounded
+	{"quax324", "0.078", "1e-1", "0.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax325 quantize   0.078  1e0 ->  0 Inexact Rounded
+	{"quax325", "0.078", "1e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax326 quantize   0.078 1e+1 ->  0E+1 Inexact Rounded
+	{"quax326", "0.078", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax327 quantize   0.078 1e+2 ->  0E+2 Inexact Rounded
+	{"quax327", "0.078", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax330 quantize  -0.078 1e-5 -> -0.07800
+	{"quax330", "-0.078", "1e-5", "-0.07800", 0, 9, ToNearestAway, 999, -999, false},
+	// quax331 quantize  -0.078 1e-4 -> -0.0780
+	{"quax331", "-0.078", "1e-4", "-0.0780", 0, 9, ToNearestAway, 999, -999, false},
+	// quax332 quantize  -0.078 1e-3 -> -0.078
+	{"quax332", "-0.078", "1e-3", "-0.078", 0, 9, ToNearestAway, 999, -999, false},
+	// quax333 quantize  -0.078 1e-2 -> -0.08 Inexact Rounded
+	{"quax333", "-0.078", "1e-2", "-0.08", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax334 quantize  -0.078 1e-1 -> -0.1 Inexact Rounded
+	{"quax334", "-0.078", "1e-1", "-0.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax335 quantize  -0.078  1e0 -> -0 Inexact Rounded
+	{"quax335", "-0.078", "1e0", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax336 quantize  -0.078 1e+1 -> -0E+1 Inexact Rounded
+	{"quax336", "-0.078", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax337 quantize  -0.078 1e+2 -> -0E+2 Inexact Rounded
+	{"quax337", "-0.078", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax340 quantize   0.78 1e-5 ->  0.78000
+	{"quax340", "0.78", "1e-5", "0.78000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax341 quantize   0.78 1e-4 ->  0.7800
+	{"quax341", "0.78", "1e-4", "0.7800", 0, 9, ToNearestAway, 999, -999, false},
+	// quax342 quantize   0.78 1e-3 ->  0.780
+	{"quax342", "0.78", "1e-3", "0.780", 0, 9, ToNearestAway, 999, -999, false},
+	// quax343 quantize   0.78 1e-2 ->  0.78
+	{"quax343", "0.78", "1e-2", "0.78", 0, 9, ToNearestAway, 999, -999, false},
+	// quax344 quantize   0.78 1e-1 ->  0.8 Inexact Rounded
+	{"quax344", "0.78", "1e-1", "0.8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax345 quantize   0.78  1e0 ->  1 Inexact Rounded
+	{"quax345", "0.78", "1e0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax346 quantize   0.78 1e+1 ->  0E+1 Inexact Rounded
+	{"quax346", "0.78", "1e+1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax347 quantize   0.78 1e+2 ->  0E+2 Inexact Rounded
+	{"quax347", "0.78", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax350 quantize  -0.78 1e-5 -> -0.78000
+	{"quax350", "-0.78", "1e-5", "-0.78000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax351 quantize  -0.78 1e-4 -> -0.7800
+	{"quax351", "-0.78", "1e-4", "-0.7800", 0, 9, ToNearestAway, 999, -999, false},
+	// quax352 quantize  -0.78 1e-3 -> -0.780
+	{"quax352", "-0.78", "1e-3", "-0.780", 0, 9, ToNearestAway, 999, -999, false},
+	// quax353 quantize  -0.78 1e-2 -> -0.78
+	{"quax353", "-0.78", "1e-2", "-0.78", 0, 9, ToNearestAway, 999, -999, false},
+	// quax354 quantize  -0.78 1e-1 -> -0.8 Inexact Rounded
+	{"quax354", "-0.78", "1e-1", "-0.8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax355 quantize  -0.78  1e0 -> -1 Inexact Rounded
+	{"quax355", "-0.78", "1e0", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax356 quantize  -0.78 1e+1 -> -0E+1 Inexact Rounded
+	{"quax356", "-0.78", "1e+1", "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax357 quantize  -0.78 1e+2 -> -0E+2 Inexact Rounded
+	{"quax357", "-0.78", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax360 quantize   7.8 1e-5 ->  7.80000
+	{"quax360", "7.8", "1e-5", "7.80000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax361 quantize   7.8 1e-4 ->  7.8000
+	{"quax361", "7.8", "1e-4", "7.8000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax362 quantize   7.8 1e-3 ->  7.800
+	{"quax362", "7.8", "1e-3", "7.800", 0, 9, ToNearestAway, 999, -999, false},
+	// quax363 quantize   7.8 1e-2 ->  7.80
+	{"quax363", "7.8", "1e-2", "7.80", 0, 9, ToNearestAway, 999, -999, false},
+	// quax364 quantize   7.8 1e-1 ->  7.8
+	{"quax364", "7.8", "1e-1", "7.8", 0, 9, ToNearestAway, 999, -999, false},
+	// quax365 quantize   7.8  1e0 ->  8 Inexact Rounded
+	{"quax365", "7.8", "1e0", "8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax366 quantize   7.8 1e+1 ->  1E+1 Inexact Rounded
+	{"quax366", "7.8", "1e+1", "1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax367 quantize   7.8 1e+2 ->  0E+2 Inexact Rounded
+	{"quax367", "7.8", "1e+2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax368 quantize   7.8 1e+3 ->  0E+3 Inexact Rounded
+	{"quax368", "7.8", "1e+3", "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax370 quantize  -7.8 1e-5 -> -7.80000
+	{"quax370", "-7.8", "1e-5", "-7.80000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax371 quantize  -7.8 1e-4 -> -7.8000
+	{"quax371", "-7.8", "1e-4", "-7.8000", 0, 9, ToNearestAway, 999, -999, false},
+	// quax372 quantize  -7.8 1e-3 -> -7.800
+	{"quax372", "-7.8", "1e-3", "-7.800", 0, 9, ToNearestAway, 999, -999, false},
+	// quax373 quantize  -7.8 1e-2 -> -7.80
+	{"quax373", "-7.8", "1e-2", "-7.80", 0, 9, ToNearestAway, 999, -999, false},
+	// quax374 quantize  -7.8 1e-1 -> -7.8
+	{"quax374", "-7.8", "1e-1", "-7.8", 0, 9, ToNearestAway, 999, -999, false},
+	// quax375 quantize  -7.8  1e0 -> -8 Inexact Rounded
+	{"quax375", "-7.8", "1e0", "-8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax376 quantize  -7.8 1e+1 -> -1E+1 Inexact Rounded
+	{"quax376", "-7.8", "1e+1", "-1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax377 quantize  -7.8 1e+2 -> -0E+2 Inexact Rounded
+	{"quax377", "-7.8", "1e+2", "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax378 quantize  -7.8 1e+3 -> -0E+3 Inexact Rounded
+	{"quax378", "-7.8", "1e+3", "-0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// some individuals
+	// precision: 9
+	// quax380 quantize   352364.506 1e-2 -> 352364.51 Inexact Rounded
+	{"quax380", "352364.506", "1e-2", "352364.51", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax381 quantize   3523645.06 1e-2 -> 3523645.06
+	{"quax381", "3523645.06", "1e-2", "3523645.06", 0, 9, ToNearestAway, 999, -999, false},
+	// quax382 quantize   35236450.6 1e-2 -> NaN Invalid_operation
+	{"quax382", "35236450.6", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax383 quantize   352364506  1e-2 -> NaN Invalid_operation
+	{"quax383", "352364506", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax384 quantize  -352364.506 1e-2 -> -352364.51 Inexact Rounded
+	{"quax384", "-352364.506", "1e-2", "-352364.51", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax385 quantize  -3523645.06 1e-2 -> -3523645.06
+	{"quax385", "-3523645.06", "1e-2", "-3523645.06", 0, 9, ToNearestAway, 999, -999, false},
+	// quax386 quantize  -35236450.6 1e-2 -> NaN Invalid_operation
+	{"quax386", "-35236450.6", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// quax387 quantize  -352364506  1e-2 -> NaN Invalid_operation
+	{"quax387", "-352364506", "1e-2", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rounding: down
+	// quax389 quantize   35236450.6 1e-2 -> NaN Invalid_operation
+	{"quax389", "35236450.6", "1e-2", "NaN", InvalidOperation, 9, ToZero, 999, -999, false},
+	// ? should that one instead have been:
+	// quax389 quantize   35236450.6 1e-2 -> NaN Invalid_operation
+	// rounding: half_up
+	// and a few more from e-mail discussions
+	// precision: 7
+	// quax391 quantize  12.34567  1e-3 -> 12.346   Inexact Rounded
+	{"quax391", "12.34567", "1e-3", "12.346", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax392 quantize  123.4567  1e-3 -> 123.457  Inexact Rounded
+	{"quax392", "123.4567", "1e-3", "123.457", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax393 quantize  1234.567  1e-3 -> 1234.567
+	{"quax393", "1234.567", "1e-3", "1234.567", 0, 7, ToNearestAway, 999, -999, false},
+	// quax394 quantize  12345.67  1e-3 -> NaN Invalid_operation
+	{"quax394", "12345.67", "1e-3", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax395 quantize  123456.7  1e-3 -> NaN Invalid_operation
+	{"quax395", "123456.7", "1e-3", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax396 quantize  1234567.  1e-3 -> NaN Invalid_operation
+	{"quax396", "1234567.", "1e-3", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// some 9999 round-up cases
+	// precision: 9
+	// quax400 quantize   9.999        1e-5  ->  9.99900
+	{"quax400", "9.999", "1e-5", "9.99900", 0, 9, ToNearestAway, 999, -999, false},
+	// quax401 quantize   9.999        1e-4  ->  9.9990
+	{"quax401", "9.999", "1e-4", "9.9990", 0, 9, ToNearestAway, 999, -999, false},
+	// quax402 quantize   9.999        1e-3  ->  9.999
+	{"quax402", "9.999", "1e-3", "9.999", 0, 9, ToNearestAway, 999, -999, false},
+	// quax403 quantize   9.999        1e-2  -> 10.00     Inexact Rounded
+	{"quax403", "9.999", "1e-2", "10.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax404 quantize   9.999        1e-1  -> 10.0      Inexact Rounded
+	{"quax404", "9.999", "1e-1", "10.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax405 quantize   9.999         1e0  -> 10        Inexact Rounded
+	{"quax405", "9.999", "1e0", "10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax406 quantize   9.999         1e1  -> 1E+1      Inexact Rounded
+	{"quax406", "9.999", "1e1", "1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax407 quantize   9.999         1e2  -> 0E+2      Inexact Rounded
+	{"quax407", "9.999", "1e2", "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax410 quantize   0.999        1e-5  ->  0.99900
+	{"quax410", "0.999", "1e-5", "0.99900", 0, 9, ToNearestAway, 999, -999, false},
+	// quax411 quantize   0.999        1e-4  ->  0.9990
+	{"quax411", "0.999", "1e-4", "0.9990", 0, 9, ToNearestAway, 999, -999, false},
+	// quax412 quantize   0.999        1e-3  ->  0.999
+	{"quax412", "0.999", "1e-3", "0.999", 0, 9, ToNearestAway, 999, -999, false},
+	// quax413 quantize   0.999        1e-2  ->  1.00     Inexact Rounded
+	{"quax413", "0.999", "1e-2", "1.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax414 quantize   0.999        1e-1  ->  1.0      Inexact Rounded
+	{"quax414", "0.999", "1e-1", "1.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax415 quantize   0.999         1e0  ->  1        Inexact Rounded
+	{"quax415", "0.999", "1e0", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax416 quantize   0.999         1e1  -> 0E+1      Inexact Rounded
+	{"quax416", "0.999", "1e1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax420 quantize   0.0999       1e-5  ->  0.09990
+	{"quax420", "0.0999", "1e-5", "0.09990", 0, 9, ToNearestAway, 999, -999, false},
+	// quax421 quantize   0.0999       1e-4  ->  0.0999
+	{"quax421", "0.0999", "1e-4", "0.0999", 0, 9, ToNearestAway, 999, -999, false},
+	// quax422 quantize   0.0999       1e-3  ->  0.100    Inexact Rounded
+	{"quax422", "0.0999", "1e-3", "0.100", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax423 quantize   0.0999       1e-2  ->  0.10     Inexact Rounded
+	{"quax423", "0.0999", "1e-2", "0.10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax424 quantize   0.0999       1e-1  ->  0.1      Inexact Rounded
+	{"quax424", "0.0999", "1e-1", "0.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax425 quantize   0.0999        1e0  ->  0        Inexact Rounded
+	{"quax425", "0.0999", "1e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax426 quantize   0.0999        1e1  -> 0E+1      Inexact Rounded
+	{"quax426", "0.0999", "1e1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax430 quantize   0.00999      1e-5  ->  0.00999
+	{"quax430", "0.00999", "1e-5", "0.00999", 0, 9, ToNearestAway, 999, -999, false},
+	// quax431 quantize   0.00999      1e-4  ->  0.0100   Inexact Rounded
+	{"quax431", "0.00999", "1e-4", "0.0100", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax432 quantize   0.00999      1e-3  ->  0.010    Inexact Rounded
+	{"quax432", "0.00999", "1e-3", "0.010", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax433 quantize   0.00999      1e-2  ->  0.01     Inexact Rounded
+	{"quax433", "0.00999", "1e-2", "0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax434 quantize   0.00999      1e-1  ->  0.0      Inexact Rounded
+	{"quax434", "0.00999", "1e-1", "0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax435 quantize   0.00999       1e0  ->  0        Inexact Rounded
+	{"quax435", "0.00999", "1e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax436 quantize   0.00999       1e1  -> 0E+1      Inexact Rounded
+	{"quax436", "0.00999", "1e1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax440 quantize   0.000999     1e-5  ->  0.00100  Inexact Rounded
+	{"quax440", "0.000999", "1e-5", "0.00100", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax441 quantize   0.000999     1e-4  ->  0.0010   Inexact Rounded
+	{"quax441", "0.000999", "1e-4", "0.0010", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax442 quantize   0.000999     1e-3  ->  0.001    Inexact Rounded
+	{"quax442", "0.000999", "1e-3", "0.001", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax443 quantize   0.000999     1e-2  ->  0.00     Inexact Rounded
+	{"quax443", "0.000999", "1e-2", "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax444 quantize   0.000999     1e-1  ->  0.0      Inexact Rounded
+	{"quax444", "0.000999", "1e-1", "0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax445 quantize   0.000999      1e0  ->  0        Inexact Rounded
+	{"quax445", "0.000999", "1e0", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax446 quantize   0.000999      1e1  -> 0E+1      Inexact Rounded
+	{"quax446", "0.000999", "1e1", "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 8
+	// quax449 quantize   9.999E-15    1e-23 ->  NaN Invalid_operation
+	{"quax449", "9.999E-15", "1e-23", "NaN", InvalidOperation, 8, ToNearestAway, 999, -999, false},
+	// quax450 quantize   9.999E-15    1e-22 ->  9.9990000E-15
+	{"quax450", "9.999E-15", "1e-22", "9.9990000E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// quax451 quantize   9.999E-15    1e-21 ->  9.999000E-15
+	{"quax451", "9.999E-15", "1e-21", "9.999000E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// quax452 quantize   9.999E-15    1e-20 ->  9.99900E-15
+	{"quax452", "9.999E-15", "1e-20", "9.99900E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// quax453 quantize   9.999E-15    1e-19 ->  9.9990E-15
+	{"quax453", "9.999E-15", "1e-19", "9.9990E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// quax454 quantize   9.999E-15    1e-18 ->  9.999E-15
+	{"quax454", "9.999E-15", "1e-18", "9.999E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// quax455 quantize   9.999E-15    1e-17 ->  1.000E-14 Inexact Rounded
+	{"quax455", "9.999E-15", "1e-17", "1.000E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax456 quantize   9.999E-15    1e-16 ->  1.00E-14  Inexact Rounded
+	{"quax456", "9.999E-15", "1e-16", "1.00E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax457 quantize   9.999E-15    1e-15 ->  1.0E-14   Inexact Rounded
+	{"quax457", "9.999E-15", "1e-15", "1.0E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax458 quantize   9.999E-15    1e-14 ->  1E-14     Inexact Rounded
+	{"quax458", "9.999E-15", "1e-14", "1E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax459 quantize   9.999E-15    1e-13 ->  0E-13     Inexact Rounded
+	{"quax459", "9.999E-15", "1e-13", "0E-13", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax460 quantize   9.999E-15    1e-12 ->  0E-12     Inexact Rounded
+	{"quax460", "9.999E-15", "1e-12", "0E-12", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax461 quantize   9.999E-15    1e-11 ->  0E-11     Inexact Rounded
+	{"quax461", "9.999E-15", "1e-11", "0E-11", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax462 quantize   9.999E-15    1e-10 ->  0E-10     Inexact Rounded
+	{"quax462", "9.999E-15", "1e-10", "0E-10", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax463 quantize   9.999E-15     1e-9 ->  0E-9      Inexact Rounded
+	{"quax463", "9.999E-15", "1e-9", "0E-9", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax464 quantize   9.999E-15     1e-8 ->  0E-8      Inexact Rounded
+	{"quax464", "9.999E-15", "1e-8", "0E-8", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax465 quantize   9.999E-15     1e-7 ->  0E-7      Inexact Rounded
+	{"quax465", "9.999E-15", "1e-7", "0E-7", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax466 quantize   9.999E-15     1e-6 ->  0.000000  Inexact Rounded
+	{"quax466", "9.999E-15", "1e-6", "0.000000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax467 quantize   9.999E-15     1e-5 ->  0.00000   Inexact Rounded
+	{"quax467", "9.999E-15", "1e-5", "0.00000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax468 quantize   9.999E-15     1e-4 ->  0.0000    Inexact Rounded
+	{"quax468", "9.999E-15", "1e-4", "0.0000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax469 quantize   9.999E-15     1e-3 ->  0.000     Inexact Rounded
+	{"quax469", "9.999E-15", "1e-3", "0.000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax470 quantize   9.999E-15     1e-2 ->  0.00      Inexact Rounded
+	{"quax470", "9.999E-15", "1e-2", "0.00", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax471 quantize   9.999E-15     1e-1 ->  0.0       Inexact Rounded
+	{"quax471", "9.999E-15", "1e-1", "0.0", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax472 quantize   9.999E-15      1e0 ->  0         Inexact Rounded
+	{"quax472", "9.999E-15", "1e0", "0", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// quax473 quantize   9.999E-15      1e1 ->  0E+1      Inexact Rounded
+	{"quax473", "9.999E-15", "1e1", "0E+1", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// precision: 7
+	// quax900 quantize   9.999E-15    1e-22 ->  NaN       Invalid_operation
+	{"quax900", "9.999E-15", "1e-22", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax901 quantize   9.999E-15    1e-21 ->  9.999000E-15
+	{"quax901", "9.999E-15", "1e-21", "9.999000E-15", 0, 7, ToNearestAway, 999, -999, false},
+	// quax902 quantize   9.999E-15    1e-20 ->  9.99900E-15
+	{"quax902", "9.999E-15", "1e-20", "9.99900E-15", 0, 7, ToNearestAway, 999, -999, false},
+	// quax903 quantize   9.999E-15    1e-19 ->  9.9990E-15
+	{"quax903", "9.999E-15", "1e-19", "9.9990E-15", 0, 7, ToNearestAway, 999, -999, false},
+	// quax904 quantize   9.999E-15    1e-18 ->  9.999E-15
+	{"quax904", "9.999E-15", "1e-18", "9.999E-15", 0, 7, ToNearestAway, 999, -999, false},
+	// quax905 quantize   9.999E-15    1e-17 ->  1.000E-14 Inexact Rounded
+	{"quax905", "9.999E-15", "1e-17", "1.000E-14", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax906 quantize   9.999E-15    1e-16 ->  1.00E-14  Inexact Rounded
+	{"quax906", "9.999E-15", "1e-16", "1.00E-14", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax907 quantize   9.999E-15    1e-15 ->  1.0E-14   Inexact Rounded
+	{"quax907", "9.999E-15", "1e-15", "1.0E-14", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax908 quantize   9.999E-15    1e-14 ->  1E-14     Inexact Rounded
+	{"quax908", "9.999E-15", "1e-14", "1E-14", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax909 quantize   9.999E-15    1e-13 ->  0E-13     Inexact Rounded
+	{"quax909", "9.999E-15", "1e-13", "0E-13", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax910 quantize   9.999E-15    1e-12 ->  0E-12     Inexact Rounded
+	{"quax910", "9.999E-15", "1e-12", "0E-12", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax911 quantize   9.999E-15    1e-11 ->  0E-11     Inexact Rounded
+	{"quax911", "9.999E-15", "1e-11", "0E-11", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax912 quantize   9.999E-15    1e-10 ->  0E-10     Inexact Rounded
+	{"quax912", "9.999E-15", "1e-10", "0E-10", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax913 quantize   9.999E-15     1e-9 ->  0E-9      Inexact Rounded
+	{"quax913", "9.999E-15", "1e-9", "0E-9", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax914 quantize   9.999E-15     1e-8 ->  0E-8      Inexact Rounded
+	{"quax914", "9.999E-15", "1e-8", "0E-8", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax915 quantize   9.999E-15     1e-7 ->  0E-7      Inexact Rounded
+	{"quax915", "9.999E-15", "1e-7", "0E-7", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax916 quantize   9.999E-15     1e-6 ->  0.000000  Inexact Rounded
+	{"quax916", "9.999E-15", "1e-6", "0.000000", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax917 quantize   9.999E-15     1e-5 ->  0.00000   Inexact Rounded
+	{"quax917", "9.999E-15", "1e-5", "0.00000", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax918 quantize   9.999E-15     1e-4 ->  0.0000    Inexact Rounded
+	{"quax918", "9.999E-15", "1e-4", "0.0000", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax919 quantize   9.999E-15     1e-3 ->  0.000     Inexact Rounded
+	{"quax919", "9.999E-15", "1e-3", "0.000", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax920 quantize   9.999E-15     1e-2 ->  0.00      Inexact Rounded
+	{"quax920", "9.999E-15", "1e-2", "0.00", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax921 quantize   9.999E-15     1e-1 ->  0.0       Inexact Rounded
+	{"quax921", "9.999E-15", "1e-1", "0.0", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax922 quantize   9.999E-15      1e0 ->  0         Inexact Rounded
+	{"quax922", "9.999E-15", "1e0", "0", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax923 quantize   9.999E-15      1e1 ->  0E+1      Inexact Rounded
+	{"quax923", "9.999E-15", "1e1", "0E+1", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// precision: 6
+	// quax930 quantize   9.999E-15    1e-22 ->  NaN       Invalid_operation
+	{"quax930", "9.999E-15", "1e-22", "NaN", InvalidOperation, 6, ToNearestAway, 999, -999, false},
+	// quax931 quantize   9.999E-15    1e-21 ->  NaN       Invalid_operation
+	{"quax931", "9.999E-15", "1e-21", "NaN", InvalidOperation, 6, ToNearestAway, 999, -999, false},
+	// quax932 quantize   9.999E-15    1e-20 ->  9.99900E-15
+	{"quax932", "9.999E-15", "1e-20", "9.99900E-15", 0, 6, ToNearestAway, 999, -999, false},
+	// quax933 quantize   9.999E-15    1e-19 ->  9.9990E-15
+	{"quax933", "9.999E-15", "1e-19", "9.9990E-15", 0, 6, ToNearestAway, 999, -999, false},
+	// quax934 quantize   9.999E-15    1e-18 ->  9.999E-15
+	{"quax934", "9.999E-15", "1e-18", "9.999E-15", 0, 6, ToNearestAway, 999, -999, false},
+	// quax935 quantize   9.999E-15    1e-17 ->  1.000E-14 Inexact Rounded
+	{"quax935", "9.999E-15", "1e-17", "1.000E-14", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax936 quantize   9.999E-15    1e-16 ->  1.00E-14  Inexact Rounded
+	{"quax936", "9.999E-15", "1e-16", "1.00E-14", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax937 quantize   9.999E-15    1e-15 ->  1.0E-14   Inexact Rounded
+	{"quax937", "9.999E-15", "1e-15", "1.0E-14", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax938 quantize   9.999E-15    1e-14 ->  1E-14     Inexact Rounded
+	{"quax938", "9.999E-15", "1e-14", "1E-14", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax939 quantize   9.999E-15    1e-13 ->  0E-13     Inexact Rounded
+	{"quax939", "9.999E-15", "1e-13", "0E-13", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax940 quantize   9.999E-15    1e-12 ->  0E-12     Inexact Rounded
+	{"quax940", "9.999E-15", "1e-12", "0E-12", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax941 quantize   9.999E-15    1e-11 ->  0E-11     Inexact Rounded
+	{"quax941", "9.999E-15", "1e-11", "0E-11", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax942 quantize   9.999E-15    1e-10 ->  0E-10     Inexact Rounded
+	{"quax942", "9.999E-15", "1e-10", "0E-10", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax943 quantize   9.999E-15     1e-9 ->  0E-9      Inexact Rounded
+	{"quax943", "9.999E-15", "1e-9", "0E-9", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax944 quantize   9.999E-15     1e-8 ->  0E-8      Inexact Rounded
+	{"quax944", "9.999E-15", "1e-8", "0E-8", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax945 quantize   9.999E-15     1e-7 ->  0E-7      Inexact Rounded
+	{"quax945", "9.999E-15", "1e-7", "0E-7", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax946 quantize   9.999E-15     1e-6 ->  0.000000  Inexact Rounded
+	{"quax946", "9.999E-15", "1e-6", "0.000000", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax947 quantize   9.999E-15     1e-5 ->  0.00000   Inexact Rounded
+	{"quax947", "9.999E-15", "1e-5", "0.00000", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax948 quantize   9.999E-15     1e-4 ->  0.0000    Inexact Rounded
+	{"quax948", "9.999E-15", "1e-4", "0.0000", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax949 quantize   9.999E-15     1e-3 ->  0.000     Inexact Rounded
+	{"quax949", "9.999E-15", "1e-3", "0.000", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax950 quantize   9.999E-15     1e-2 ->  0.00      Inexact Rounded
+	{"quax950", "9.999E-15", "1e-2", "0.00", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax951 quantize   9.999E-15     1e-1 ->  0.0       Inexact Rounded
+	{"quax951", "9.999E-15", "1e-1", "0.0", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax952 quantize   9.999E-15      1e0 ->  0         Inexact Rounded
+	{"quax952", "9.999E-15", "1e0", "0", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// quax953 quantize   9.999E-15      1e1 ->  0E+1      Inexact Rounded
+	{"quax953", "9.999E-15", "1e1", "0E+1", Inexact | Rounded, 6, ToNearestAway, 999, -999, false},
+	// precision: 3
+	// quax960 quantize   9.999E-15    1e-22 ->  NaN       Invalid_operation
+	{"quax960", "9.999E-15", "1e-22", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax961 quantize   9.999E-15    1e-21 ->  NaN       Invalid_operation
+	{"quax961", "9.999E-15", "1e-21", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax962 quantize   9.999E-15    1e-20 ->  NaN       Invalid_operation
+	{"quax962", "9.999E-15", "1e-20", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax963 quantize   9.999E-15    1e-19 ->  NaN       Invalid_operation
+	{"quax963", "9.999E-15", "1e-19", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax964 quantize   9.999E-15    1e-18 ->  NaN       Invalid_operation
+	{"quax964", "9.999E-15", "1e-18", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax965 quantize   9.999E-15    1e-17 ->  NaN       Invalid_operation
+	{"quax965", "9.999E-15", "1e-17", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax966 quantize   9.999E-15    1e-16 ->  1.00E-14  Inexact Rounded
+	{"quax966", "9.999E-15", "1e-16", "1.00E-14", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax967 quantize   9.999E-15    1e-15 ->  1.0E-14   Inexact Rounded
+	{"quax967", "9.999E-15", "1e-15", "1.0E-14", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax968 quantize   9.999E-15    1e-14 ->  1E-14     Inexact Rounded
+	{"quax968", "9.999E-15", "1e-14", "1E-14", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax969 quantize   9.999E-15    1e-13 ->  0E-13     Inexact Rounded
+	{"quax969", "9.999E-15", "1e-13", "0E-13", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax970 quantize   9.999E-15    1e-12 ->  0E-12     Inexact Rounded
+	{"quax970", "9.999E-15", "1e-12", "0E-12", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax971 quantize   9.999E-15    1e-11 ->  0E-11     Inexact Rounded
+	{"quax971", "9.999E-15", "1e-11", "0E-11", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax972 quantize   9.999E-15    1e-10 ->  0E-10     Inexact Rounded
+	{"quax972", "9.999E-15", "1e-10", "0E-10", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax973 quantize   9.999E-15     1e-9 ->  0E-9      Inexact Rounded
+	{"quax973", "9.999E-15", "1e-9", "0E-9", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax974 quantize   9.999E-15     1e-8 ->  0E-8      Inexact Rounded
+	{"quax974", "9.999E-15", "1e-8", "0E-8", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax975 quantize   9.999E-15     1e-7 ->  0E-7      Inexact Rounded
+	{"quax975", "9.999E-15", "1e-7", "0E-7", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax976 quantize   9.999E-15     1e-6 ->  0.000000  Inexact Rounded
+	{"quax976", "9.999E-15", "1e-6", "0.000000", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax977 quantize   9.999E-15     1e-5 ->  0.00000   Inexact Rounded
+	{"quax977", "9.999E-15", "1e-5", "0.00000", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax978 quantize   9.999E-15     1e-4 ->  0.0000    Inexact Rounded
+	{"quax978", "9.999E-15", "1e-4", "0.0000", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax979 quantize   9.999E-15     1e-3 ->  0.000     Inexact Rounded
+	{"quax979", "9.999E-15", "1e-3", "0.000", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax980 quantize   9.999E-15     1e-2 ->  0.00      Inexact Rounded
+	{"quax980", "9.999E-15", "1e-2", "0.00", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax981 quantize   9.999E-15     1e-1 ->  0.0       Inexact Rounded
+	{"quax981", "9.999E-15", "1e-1", "0.0", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax982 quantize   9.999E-15      1e0 ->  0         Inexact Rounded
+	{"quax982", "9.999E-15", "1e0", "0", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax983 quantize   9.999E-15      1e1 ->  0E+1      Inexact Rounded
+	{"quax983", "9.999E-15", "1e1", "0E+1", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// Fung Lee's case & similar
+	// precision: 3
+	// quax1001 quantize  0.000        0.001 ->  0.000
+	{"quax1001", "0.000", "0.001", "0.000", 0, 3, ToNearestAway, 999, -999, false},
+	// quax1002 quantize  0.001        0.001 ->  0.001
+	{"quax1002", "0.001", "0.001", "0.001", 0, 3, ToNearestAway, 999, -999, false},
+	// quax1003 quantize  0.0012       0.001 ->  0.001     Inexact Rounded
+	{"quax1003", "0.0012", "0.001", "0.001", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax1004 quantize  0.0018       0.001 ->  0.002     Inexact Rounded
+	{"quax1004", "0.0018", "0.001", "0.002", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax1005 quantize  0.501        0.001 ->  0.501
+	{"quax1005", "0.501", "0.001", "0.501", 0, 3, ToNearestAway, 999, -999, false},
+	// quax1006 quantize  0.5012       0.001 ->  0.501     Inexact Rounded
+	{"quax1006", "0.5012", "0.001", "0.501", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax1007 quantize  0.5018       0.001 ->  0.502     Inexact Rounded
+	{"quax1007", "0.5018", "0.001", "0.502", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax1008 quantize  0.999        0.001 ->  0.999
+	{"quax1008", "0.999", "0.001", "0.999", 0, 3, ToNearestAway, 999, -999, false},
+	// quax1009 quantize  0.9992       0.001 ->  0.999     Inexact Rounded
+	{"quax1009", "0.9992", "0.001", "0.999", Inexact | Rounded, 3, ToNearestAway, 999, -999, false},
+	// quax1010 quantize  0.9998       0.001 ->  NaN       Invalid_operation
+	{"quax1010", "0.9998", "0.001", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax1011 quantize  1.0001       0.001 ->  NaN       Invalid_operation
+	{"quax1011", "1.0001", "0.001", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax1012 quantize  1.0051       0.001 ->  NaN       Invalid_operation
+	{"quax1012", "1.0051", "0.001", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax1013 quantize  1.0551       0.001 ->  NaN       Invalid_operation
+	{"quax1013", "1.0551", "0.001", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax1014 quantize  1.5551       0.001 ->  NaN       Invalid_operation
+	{"quax1014", "1.5551", "0.001", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// quax1015 quantize  1.9999       0.001 ->  NaN       Invalid_operation
+	{"quax1015", "1.9999", "0.001", "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// long operand checks [rhs checks removed]
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// quax481 quantize 12345678000 1e+3 -> 1.2345678E+10 Rounded
+	{"quax481", "12345678000", "1e+3", "1.2345678E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax482 quantize 1234567800  1e+1 -> 1.23456780E+9 Rounded
+	{"quax482", "1234567800", "1e+1", "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax483 quantize 1234567890  1e+1 -> 1.23456789E+9 Rounded
+	{"quax483", "1234567890", "1e+1", "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax484 quantize 1234567891  1e+1 -> 1.23456789E+9 Inexact Rounded
+	{"quax484", "1234567891", "1e+1", "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax485 quantize 12345678901 1e+2 -> 1.23456789E+10 Inexact Rounded
+	{"quax485", "12345678901", "1e+2", "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax486 quantize 1234567896  1e+1 -> 1.23456790E+9 Inexact Rounded
+	{"quax486", "1234567896", "1e+1", "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// a potential double-round
+	// quax487 quantize 1234.987643 1e-4 -> 1234.9876 Inexact Rounded
+	{"quax487", "1234.987643", "1e-4", "1234.9876", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// quax488 quantize 1234.987647 1e-4 -> 1234.9876 Inexact Rounded
+	{"quax488", "1234.987647", "1e-4", "1234.9876", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// quax491 quantize 12345678000 1e+3 -> 1.2345678E+10 Rounded
+	{"quax491", "12345678000", "1e+3", "1.2345678E+10", Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax492 quantize 1234567800  1e+1 -> 1.23456780E+9 Rounded
+	{"quax492", "1234567800", "1e+1", "1.23456780E+9", Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax493 quantize 1234567890  1e+1 -> 1.23456789E+9 Rounded
+	{"quax493", "1234567890", "1e+1", "1.23456789E+9", Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax494 quantize 1234567891  1e+1 -> 1.23456789E+9 Inexact Rounded
+	{"quax494", "1234567891", "1e+1", "1.23456789E+9", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax495 quantize 12345678901 1e+2 -> 1.23456789E+10 Inexact Rounded
+	{"quax495", "12345678901", "1e+2", "1.23456789E+10", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax496 quantize 1234567896  1e+1 -> 1.23456790E+9 Inexact Rounded
+	{"quax496", "1234567896", "1e+1", "1.23456790E+9", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax497 quantize 1234.987643 1e-4 -> 1234.9876 Inexact Rounded
+	{"quax497", "1234.987643", "1e-4", "1234.9876", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax498 quantize 1234.987647 1e-4 -> 1234.9876 Inexact Rounded
+	{"quax498", "1234.987647", "1e-4", "1234.9876", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// Zeros
+	// quax500 quantize   0     1e1 ->  0E+1
+	{"quax500", "0", "1e1", "0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// quax501 quantize   0     1e0 ->  0
+	{"quax501", "0", "1e0", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax502 quantize   0    1e-1 ->  0.0
+	{"quax502", "0", "1e-1", "0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax503 quantize   0.0  1e-1 ->  0.0
+	{"quax503", "0.0", "1e-1", "0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax504 quantize   0.0   1e0 ->  0
+	{"quax504", "0.0", "1e0", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax505 quantize   0.0  1e+1 ->  0E+1
+	{"quax505", "0.0", "1e+1", "0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// quax506 quantize   0E+1 1e-1 ->  0.0
+	{"quax506", "0E+1", "1e-1", "0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax507 quantize   0E+1  1e0 ->  0
+	{"quax507", "0E+1", "1e0", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax508 quantize   0E+1 1e+1 ->  0E+1
+	{"quax508", "0E+1", "1e+1", "0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// quax509 quantize  -0     1e1 -> -0E+1
+	{"quax509", "-0", "1e1", "-0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// quax510 quantize  -0     1e0 -> -0
+	{"quax510", "-0", "1e0", "-0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax511 quantize  -0    1e-1 -> -0.0
+	{"quax511", "-0", "1e-1", "-0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax512 quantize  -0.0  1e-1 -> -0.0
+	{"quax512", "-0.0", "1e-1", "-0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax513 quantize  -0.0   1e0 -> -0
+	{"quax513", "-0.0", "1e0", "-0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax514 quantize  -0.0  1e+1 -> -0E+1
+	{"quax514", "-0.0", "1e+1", "-0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// quax515 quantize  -0E+1 1e-1 -> -0.0
+	{"quax515", "-0E+1", "1e-1", "-0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax516 quantize  -0E+1  1e0 -> -0
+	{"quax516", "-0E+1", "1e0", "-0", 0, 15, ToNearestAway, 999, -999, false},
+	// quax517 quantize  -0E+1 1e+1 -> -0E+1
+	{"quax517", "-0E+1", "1e+1", "-0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// Suspicious RHS values
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 15
+	// quax520 quantize   1.234    1e999999000 -> 0E+999999000 Inexact Rounded
+	{"quax520", "1.234", "1e999999000", "0E+999999000", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax521 quantize 123.456    1e999999000 -> 0E+999999000 Inexact Rounded
+	{"quax521", "123.456", "1e999999000", "0E+999999000", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax522 quantize   1.234    1e999999999 -> 0E+999999999 Inexact Rounded
+	{"quax522", "1.234", "1e999999999", "0E+999999999", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax523 quantize 123.456    1e999999999 -> 0E+999999999 Inexact Rounded
+	{"quax523", "123.456", "1e999999999", "0E+999999999", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax524 quantize 123.456   1e1000000000 -> NaN Invalid_operation
+	{"quax524", "123.456", "1e1000000000", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax525 quantize 123.456  1e12345678903 -> NaN Invalid_operation
+	{"quax525", "123.456", "1e12345678903", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// next four are "won't fit" overflows
+	// quax526 quantize   1.234   1e-999999000 -> NaN Invalid_operation
+	{"quax526", "1.234", "1e-999999000", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax527 quantize 123.456   1e-999999000 -> NaN Invalid_operation
+	{"quax527", "123.456", "1e-999999000", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax528 quantize   1.234   1e-999999999 -> NaN Invalid_operation
+	{"quax528", "1.234", "1e-999999999", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax529 quantize 123.456   1e-999999999 -> NaN Invalid_operation
+	{"quax529", "123.456", "1e-999999999", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax530 quantize 123.456  1e-1000000014 -> NaN Invalid_operation
+	{"quax530", "123.456", "1e-1000000014", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// quax531 quantize 123.456 1e-12345678903 -> NaN Invalid_operation
+	{"quax531", "123.456", "1e-12345678903", "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 15
+	// quax532 quantize   1.234E+999    1e999 -> 1E+999    Inexact Rounded
+	{"quax532", "1.234E+999", "1e999", "1E+999", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax533 quantize   1.234E+998    1e999 -> 0E+999    Inexact Rounded
+	{"quax533", "1.234E+998", "1e999", "0E+999", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax534 quantize   1.234         1e999 -> 0E+999    Inexact Rounded
+	{"quax534", "1.234", "1e999", "0E+999", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// quax535 quantize   1.234        1e1000 -> NaN Invalid_operation
+	{"quax535", "1.234", "1e1000", "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// quax536 quantize   1.234        1e5000 -> NaN Invalid_operation
+	{"quax536", "1.234", "1e5000", "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// quax537 quantize   0            1e-999 -> 0E-999
+	{"quax537", "0", "1e-999", "0E-999", 0, 15, ToNearestAway, 999, -999, false},
+	// next two are "won't fit" overflows
+	// quax538 quantize   1.234        1e-999 -> NaN Invalid_operation
+	{"quax538", "1.234", "1e-999", "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// quax539 quantize   1.234       1e-1000 -> NaN Invalid_operation
+	{"quax539", "1.234", "1e-1000", "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// quax540 quantize   1.234       1e-5000 -> NaN Invalid_operation
+	{"quax540", "1.234", "1e-5000", "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// [more below]
+	// check bounds (lhs maybe out of range for destination, etc.)
+	// precision: 7
+	// quax541 quantize   1E+999   1e+999 -> 1E+999
+	{"quax541", "1E+999", "1e+999", "1E+999", 0, 7, ToNearestAway, 999, -999, false},
+	// quax542 quantize   1E+1000  1e+999 -> NaN Invalid_operation
+	{"quax542", "1E+1000", "1e+999", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax543 quantize   1E+999  1e+1000 -> NaN Invalid_operation
+	{"quax543", "1E+999", "1e+1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax544 quantize   1E-999   1e-999 -> 1E-999
+	{"quax544", "1E-999", "1e-999", "1E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// quax545 quantize   1E-1000  1e-999 -> 0E-999    Inexact Rounded
+	{"quax545", "1E-1000", "1e-999", "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax546 quantize   1E-999  1e-1000 -> 1.0E-999
+	{"quax546", "1E-999", "1e-1000", "1.0E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// quax547 quantize   1E-1005  1e-999 -> 0E-999    Inexact Rounded
+	{"quax547", "1E-1005", "1e-999", "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax548 quantize   1E-1006  1e-999 -> 0E-999    Inexact Rounded
+	{"quax548", "1E-1006", "1e-999", "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax549 quantize   1E-1007  1e-999 -> 0E-999    Inexact Rounded
+	{"quax549", "1E-1007", "1e-999", "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax550 quantize   1E-998  1e-1005 -> NaN Invalid_operation  -- won't fit
+	{"quax550", "1E-998", "1e-1005", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax551 quantize   1E-999  1e-1005 -> 1.000000E-999
+	{"quax551", "1E-999", "1e-1005", "1.000000E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// quax552 quantize   1E-1000 1e-1005 -> 1.00000E-1000 Subnormal
+	{"quax552", "1E-1000", "1e-1005", "1.00000E-1000", Subnormal, 7, ToNearestAway, 999, -999, false},
+	// quax553 quantize   1E-999  1e-1006 -> NaN Invalid_operation
+	{"quax553", "1E-999", "1e-1006", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax554 quantize   1E-999  1e-1007 -> NaN Invalid_operation
+	{"quax554", "1E-999", "1e-1007", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// related subnormal rounding
+	// quax555 quantize   1.666666E-999  1e-1005 -> 1.666666E-999
+	{"quax555", "1.666666E-999", "1e-1005", "1.666666E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// quax556 quantize   1.666666E-1000 1e-1005 -> 1.66667E-1000  Subnormal Inexact Rounded
+	{"quax556", "1.666666E-1000", "1e-1005", "1.66667E-1000", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax557 quantize   1.666666E-1001 1e-1005 -> 1.6667E-1001  Subnormal Inexact Rounded
+	{"quax557", "1.666666E-1001", "1e-1005", "1.6667E-1001", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax558 quantize   1.666666E-1002 1e-1005 -> 1.667E-1002  Subnormal Inexact Rounded
+	{"quax558", "1.666666E-1002", "1e-1005", "1.667E-1002", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax559 quantize   1.666666E-1003 1e-1005 -> 1.67E-1003  Subnormal Inexact Rounded
+	{"quax559", "1.666666E-1003", "1e-1005", "1.67E-1003", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax560 quantize   1.666666E-1004 1e-1005 -> 1.7E-1004  Subnormal Inexact Rounded
+	{"quax560", "1.666666E-1004", "1e-1005", "1.7E-1004", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax561 quantize   1.666666E-1005 1e-1005 -> 2E-1005  Subnormal Inexact Rounded
+	{"quax561", "1.666666E-1005", "1e-1005", "2E-1005", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax562 quantize   1.666666E-1006 1e-1005 -> 0E-1005   Inexact Rounded
+	{"quax562", "1.666666E-1006", "1e-1005", "0E-1005", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// quax563 quantize   1.666666E-1007 1e-1005 -> 0E-1005   Inexact Rounded
+	{"quax563", "1.666666E-1007", "1e-1005", "0E-1005", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// Specials
+	// quax580 quantize  Inf    -Inf   ->  Infinity
+	{"quax580", "Inf", "-Inf", "Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax581 quantize  Inf  1e-1000  ->  NaN  Invalid_operation
+	{"quax581", "Inf", "1e-1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax582 quantize  Inf  1e-1     ->  NaN  Invalid_operation
+	{"quax582", "Inf", "1e-1", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax583 quantize  Inf   1e0     ->  NaN  Invalid_operation
+	{"quax583", "Inf", "1e0", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax584 quantize  Inf   1e1     ->  NaN  Invalid_operation
+	{"quax584", "Inf", "1e1", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax585 quantize  Inf   1e1000  ->  NaN  Invalid_operation
+	{"quax585", "Inf", "1e1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax586 quantize  Inf     Inf   ->  Infinity
+	{"quax586", "Inf", "Inf", "Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax587 quantize -1000    Inf   ->  NaN  Invalid_operation
+	{"quax587", "-1000", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax588 quantize -Inf     Inf   ->  -Infinity
+	{"quax588", "-Inf", "Inf", "-Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax589 quantize -1       Inf   ->  NaN  Invalid_operation
+	{"quax589", "-1", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax590 quantize  0       Inf   ->  NaN  Invalid_operation
+	{"quax590", "0", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax591 quantize  1       Inf   ->  NaN  Invalid_operation
+	{"quax591", "1", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax592 quantize  1000    Inf   ->  NaN  Invalid_operation
+	{"quax592", "1000", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax593 quantize  Inf     Inf   ->  Infinity
+	{"quax593", "Inf", "Inf", "Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax594 quantize  Inf  1e-0     ->  NaN  Invalid_operation
+	{"quax594", "Inf", "1e-0", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax595 quantize -0       Inf   ->  NaN  Invalid_operation
+	{"quax595", "-0", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax600 quantize -Inf    -Inf   ->  -Infinity
+	{"quax600", "-Inf", "-Inf", "-Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax601 quantize -Inf  1e-1000  ->  NaN  Invalid_operation
+	{"quax601", "-Inf", "1e-1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax602 quantize -Inf  1e-1     ->  NaN  Invalid_operation
+	{"quax602", "-Inf", "1e-1", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax603 quantize -Inf   1e0     ->  NaN  Invalid_operation
+	{"quax603", "-Inf", "1e0", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax604 quantize -Inf   1e1     ->  NaN  Invalid_operation
+	{"quax604", "-Inf", "1e1", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax605 quantize -Inf   1e1000  ->  NaN  Invalid_operation
+	{"quax605", "-Inf", "1e1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax606 quantize -Inf     Inf   ->  -Infinity
+	{"quax606", "-Inf", "Inf", "-Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax607 quantize -1000    Inf   ->  NaN  Invalid_operation
+	{"quax607", "-1000", "Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax608 quantize -Inf    -Inf   ->  -Infinity
+	{"quax608", "-Inf", "-Inf", "-Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax609 quantize -1      -Inf   ->  NaN  Invalid_operation
+	{"quax609", "-1", "-Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax610 quantize  0      -Inf   ->  NaN  Invalid_operation
+	{"quax610", "0", "-Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax611 quantize  1      -Inf   ->  NaN  Invalid_operation
+	{"quax611", "1", "-Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax612 quantize  1000   -Inf   ->  NaN  Invalid_operation
+	{"quax612", "1000", "-Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax613 quantize  Inf    -Inf   ->  Infinity
+	{"quax613", "Inf", "-Inf", "Inf", 0, 7, ToNearestAway, 999, -999, false},
+	// quax614 quantize -Inf  1e-0     ->  NaN  Invalid_operation
+	{"quax614", "-Inf", "1e-0", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax615 quantize -0      -Inf   ->  NaN  Invalid_operation
+	{"quax615", "-0", "-Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax621 quantize  NaN   -Inf    ->  NaN
+	{"quax621", "NaN", "-Inf", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax622 quantize  NaN 1e-1000   ->  NaN
+	{"quax622", "NaN", "1e-1000", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax623 quantize  NaN 1e-1      ->  NaN
+	{"quax623", "NaN", "1e-1", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax624 quantize  NaN  1e0      ->  NaN
+	{"quax624", "NaN", "1e0", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax625 quantize  NaN  1e1      ->  NaN
+	{"quax625", "NaN", "1e1", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax626 quantize  NaN  1e1000   ->  NaN
+	{"quax626", "NaN", "1e1000", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax627 quantize  NaN    Inf    ->  NaN
+	{"quax627", "NaN", "Inf", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax628 quantize  NaN    NaN    ->  NaN
+	{"quax628", "NaN", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax629 quantize -Inf    NaN    ->  NaN
+	{"quax629", "-Inf", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax630 quantize -1000   NaN    ->  NaN
+	{"quax630", "-1000", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax631 quantize -1      NaN    ->  NaN
+	{"quax631", "-1", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax632 quantize  0      NaN    ->  NaN
+	{"quax632", "0", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax633 quantize  1      NaN    ->  NaN
+	{"quax633", "1", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax634 quantize  1000   NaN    ->  NaN
+	{"quax634", "1000", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax635 quantize  Inf    NaN    ->  NaN
+	{"quax635", "Inf", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax636 quantize  NaN 1e-0      ->  NaN
+	{"quax636", "NaN", "1e-0", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax637 quantize -0      NaN    ->  NaN
+	{"quax637", "-0", "NaN", "NaN", 0, 7, ToNearestAway, 999, -999, false},
+	// quax641 quantize  sNaN   -Inf   ->  NaN  Invalid_operation
+	{"quax641", "sNaN", "-Inf", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax642 quantize  sNaN 1e-1000  ->  NaN  Invalid_operation
+	{"quax642", "sNaN", "1e-1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax643 quantize  sNaN 1e-1     ->  NaN  Invalid_operation
+	{"quax643", "sNaN", "1e-1", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax644 quantize  sNaN  1e0     ->  NaN  Invalid_operation
+	{"quax644", "sNaN", "1e0", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax645 quantize  sNaN  1e1     ->  NaN  Invalid_operation
+	{"quax645", "sNaN", "1e1", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax646 quantize  sNaN  1e1000  ->  NaN  Invalid_operation
+	{"quax646", "sNaN", "1e1000", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax647 quantize  sNaN    NaN   ->  NaN  Invalid_operation
+	{"quax647", "sNaN", "NaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax648 quantize  sNaN   sNaN   ->  NaN  Invalid_operation
+	{"quax648", "sNaN", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax649 quantize  NaN    sNaN   ->  NaN  Invalid_operation
+	{"quax649", "NaN", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax650 quantize -Inf    sNaN   ->  NaN  Invalid_operation
+	{"quax650", "-Inf", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax651 quantize -1000   sNaN   ->  NaN  Invalid_operation
+	{"quax651", "-1000", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax652 quantize -1      sNaN   ->  NaN  Invalid_operation
+	{"quax652", "-1", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax653 quantize  0      sNaN   ->  NaN  Invalid_operation
+	{"quax653", "0", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax654 quantize  1      sNaN   ->  NaN  Invalid_operation
+	{"quax654", "1", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax655 quantize  1000   sNaN   ->  NaN  Invalid_operation
+	{"quax655", "1000", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax656 quantize  Inf    sNaN   ->  NaN  Invalid_operation
+	{"quax656", "Inf", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax657 quantize  NaN    sNaN   ->  NaN  Invalid_operation
+	{"quax657", "NaN", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax658 quantize  sNaN 1e-0     ->  NaN  Invalid_operation
+	{"quax658", "sNaN", "1e-0", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax659 quantize -0      sNaN   ->  NaN  Invalid_operation
+	{"quax659", "-0", "sNaN", "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// quax661 quantize  NaN9 -Inf   ->  NaN9
+	{"quax661", "NaN9", "-Inf", "NaN9", 0, 7, ToNearestAway, 999, -999, false},
+	// quax662 quantize  NaN8  919   ->  NaN8
+	{"quax662", "NaN8", "919", "NaN8", 0, 7, ToNearestAway, 999, -999, false},
+	// quax663 quantize  NaN71 Inf   ->  NaN71
+	{"quax663", "NaN71", "Inf", "NaN71", 0, 7, ToNearestAway, 999, -999, false},
+	// quax664 quantize  NaN6  NaN5  ->  NaN6
+	{"quax664", "NaN6", "NaN5", "NaN6", 0, 7, ToNearestAway, 999, -999, false},
+	// quax665 quantize -Inf   NaN4  ->  NaN4
+	{"quax665", "-Inf", "NaN4", "NaN4", 0, 7, ToNearestAway, 999, -999, false},
+	// quax666 quantize -919   NaN31 ->  NaN31
+	{"quax666", "-919", "NaN31", "NaN31", 0, 7, ToNearestAway, 999, -999, false},
+	// quax667 quantize  Inf   NaN2  ->  NaN2
+	{"quax667", "Inf", "NaN2", "NaN2", 0, 7, ToNearestAway, 999, -999, false},
+	// quax671 quantize  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	{"quax671", "sNaN99", "-Inf", "NaN99", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax672 quantize  sNaN98 -11     ->  NaN98 Invalid_operation
+	{"quax672", "sNaN98", "-11", "NaN98", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax673 quantize  sNaN97  NaN    ->  NaN97 Invalid_operation
+	{"quax673", "sNaN97", "NaN", "NaN97", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax674 quantize  sNaN16 sNaN94  ->  NaN16 Invalid_operation
+	{"quax674", "sNaN16", "sNaN94", "NaN16", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax675 quantize  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	{"quax675", "NaN95", "sNaN93", "NaN93", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax676 quantize -Inf    sNaN92  ->  NaN92 Invalid_operation
+	{"quax676", "-Inf", "sNaN92", "NaN92", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax677 quantize  088    sNaN91  ->  NaN91 Invalid_operation
+	{"quax677", "088", "sNaN91", "NaN91", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax678 quantize  Inf    sNaN90  ->  NaN90 Invalid_operation
+	{"quax678", "Inf", "sNaN90", "NaN90", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax679 quantize  NaN    sNaN88  ->  NaN88 Invalid_operation
+	{"quax679", "NaN", "sNaN88", "NaN88", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax681 quantize -NaN9 -Inf   -> -NaN9
+	{"quax681", "-NaN9", "-Inf", "-NaN9", 0, 7, ToNearestAway, 999, -999, false},
+	// quax682 quantize -NaN8  919   -> -NaN8
+	{"quax682", "-NaN8", "919", "-NaN8", 0, 7, ToNearestAway, 999, -999, false},
+	// quax683 quantize -NaN71 Inf   -> -NaN71
+	{"quax683", "-NaN71", "Inf", "-NaN71", 0, 7, ToNearestAway, 999, -999, false},
+	// quax684 quantize -NaN6 -NaN5  -> -NaN6
+	{"quax684", "-NaN6", "-NaN5", "-NaN6", 0, 7, ToNearestAway, 999, -999, false},
+	// quax685 quantize -Inf  -NaN4  -> -NaN4
+	{"quax685", "-Inf", "-NaN4", "-NaN4", 0, 7, ToNearestAway, 999, -999, false},
+	// quax686 quantize -919  -NaN31 -> -NaN31
+	{"quax686", "-919", "-NaN31", "-NaN31", 0, 7, ToNearestAway, 999, -999, false},
+	// quax687 quantize  Inf  -NaN2  -> -NaN2
+	{"quax687", "Inf", "-NaN2", "-NaN2", 0, 7, ToNearestAway, 999, -999, false},
+	// quax691 quantize -sNaN99 -Inf    -> -NaN99 Invalid_operation
+	{"quax691", "-sNaN99", "-Inf", "-NaN99", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax692 quantize -sNaN98 -11     -> -NaN98 Invalid_operation
+	{"quax692", "-sNaN98", "-11", "-NaN98", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax693 quantize -sNaN97  NaN    -> -NaN97 Invalid_operation
+	{"quax693", "-sNaN97", "NaN", "-NaN97", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax694 quantize -sNaN16 sNaN94  -> -NaN16 Invalid_operation
+	{"quax694", "-sNaN16", "sNaN94", "-NaN16", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax695 quantize -NaN95 -sNaN93  -> -NaN93 Invalid_operation
+	{"quax695", "-NaN95", "-sNaN93", "-NaN93", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax696 quantize -Inf   -sNaN92  -> -NaN92 Invalid_operation
+	{"quax696", "-Inf", "-sNaN92", "-NaN92", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax697 quantize  088   -sNaN91  -> -NaN91 Invalid_operation
+	{"quax697", "088", "-sNaN91", "-NaN91", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax698 quantize  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	{"quax698", "Inf", "-sNaN90", "-NaN90", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// quax699 quantize  NaN   -sNaN88  -> -NaN88 Invalid_operation
+	{"quax699", "NaN", "-sNaN88", "-NaN88", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// subnormals and underflow
+	// precision: 4
+	// maxexponent: 999
+	// minexponent: -999
+	// quax710 quantize  1.00E-999    1e-999  ->   1E-999    Rounded
+	{"quax710", "1.00E-999", "1e-999", "1E-999", Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax711 quantize  0.1E-999    2e-1000  ->   1E-1000   Subnormal
+	{"quax711", "0.1E-999", "2e-1000", "1E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax712 quantize  0.10E-999   3e-1000  ->   1E-1000   Subnormal Rounded
+	{"quax712", "0.10E-999", "3e-1000", "1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax713 quantize  0.100E-999  4e-1000  ->   1E-1000   Subnormal Rounded
+	{"quax713", "0.100E-999", "4e-1000", "1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax714 quantize  0.01E-999   5e-1001  ->   1E-1001   Subnormal
+	{"quax714", "0.01E-999", "5e-1001", "1E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// quax715 quantize  0.999E-999   1e-999  ->   1E-999    Inexact Rounded
+	{"quax715", "0.999E-999", "1e-999", "1E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax716 quantize  0.099E-999 10e-1000  ->   1E-1000   Inexact Rounded Subnormal
+	{"quax716", "0.099E-999", "10e-1000", "1E-1000", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax717 quantize  0.009E-999  1e-1001  ->   1E-1001   Inexact Rounded Subnormal
+	{"quax717", "0.009E-999", "1e-1001", "1E-1001", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax718 quantize  0.001E-999  1e-1001  ->   0E-1001   Inexact Rounded
+	{"quax718", "0.001E-999", "1e-1001", "0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax719 quantize  0.0009E-999 1e-1001  ->   0E-1001   Inexact Rounded
+	{"quax719", "0.0009E-999", "1e-1001", "0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax720 quantize  0.0001E-999 1e-1001  ->   0E-1001   Inexact Rounded
+	{"quax720", "0.0001E-999", "1e-1001", "0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax730 quantize -1.00E-999   1e-999  ->  -1E-999     Rounded
+	{"quax730", "-1.00E-999", "1e-999", "-1E-999", Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax731 quantize -0.1E-999    1e-999  ->  -0E-999     Rounded Inexact
+	{"quax731", "-0.1E-999", "1e-999", "-0E-999", Rounded | Inexact, 4, ToNearestAway, 999, -999, false},
+	// quax732 quantize -0.10E-999   1e-999  ->  -0E-999     Rounded Inexact
+	{"quax732", "-0.10E-999", "1e-999", "-0E-999", Rounded | Inexact, 4, ToNearestAway, 999, -999, false},
+	// quax733 quantize -0.100E-999  1e-999  ->  -0E-999     Rounded Inexact
+	{"quax733", "-0.100E-999", "1e-999", "-0E-999", Rounded | Inexact, 4, ToNearestAway, 999, -999, false},
+	// quax734 quantize -0.01E-999   1e-999  ->  -0E-999     Inexact Rounded
+	{"quax734", "-0.01E-999", "1e-999", "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// quax735 quantize -0.999E-999 90e-999  ->  -1E-999     Inexact Rounded
+	{"quax735", "-0.999E-999", "90e-999", "-1E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax736 quantize -0.099E-999 -1e-999  ->  -0E-999     Inexact Rounded
+	{"quax736", "-0.099E-999", "-1e-999", "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax737 quantize -0.009E-999 -1e-999  ->  -0E-999     Inexact Rounded
+	{"quax737", "-0.009E-999", "-1e-999", "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax738 quantize -0.001E-999 -0e-999  ->  -0E-999     Inexact Rounded
+	{"quax738", "-0.001E-999", "-0e-999", "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax739 quantize -0.0001E-999 0e-999  ->  -0E-999     Inexact Rounded
+	{"quax739", "-0.0001E-999", "0e-999", "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax740 quantize -1.00E-999   1e-1000 ->  -1.0E-999   Rounded
+	{"quax740", "-1.00E-999", "1e-1000", "-1.0E-999", Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax741 quantize -0.1E-999    1e-1000 ->  -1E-1000    Subnormal
+	{"quax741", "-0.1E-999", "1e-1000", "-1E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax742 quantize -0.10E-999   1e-1000 ->  -1E-1000    Subnormal Rounded
+	{"quax742", "-0.10E-999", "1e-1000", "-1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax743 quantize -0.100E-999  1e-1000 ->  -1E-1000    Subnormal Rounded
+	{"quax743", "-0.100E-999", "1e-1000", "-1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax744 quantize -0.01E-999   1e-1000 ->  -0E-1000    Inexact Rounded
+	{"quax744", "-0.01E-999", "1e-1000", "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// quax745 quantize -0.999E-999  1e-1000 ->  -1.0E-999   Inexact Rounded
+	{"quax745", "-0.999E-999", "1e-1000", "-1.0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax746 quantize -0.099E-999  1e-1000 ->  -1E-1000    Inexact Rounded Subnormal
+	{"quax746", "-0.099E-999", "1e-1000", "-1E-1000", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax747 quantize -0.009E-999  1e-1000 ->  -0E-1000    Inexact Rounded
+	{"quax747", "-0.009E-999", "1e-1000", "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax748 quantize -0.001E-999  1e-1000 ->  -0E-1000    Inexact Rounded
+	{"quax748", "-0.001E-999", "1e-1000", "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax749 quantize -0.0001E-999 1e-1000 ->  -0E-1000    Inexact Rounded
+	{"quax749", "-0.0001E-999", "1e-1000", "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax750 quantize -1.00E-999   1e-1001 ->  -1.00E-999
+	{"quax750", "-1.00E-999", "1e-1001", "-1.00E-999", 0, 4, ToNearestAway, 999, -999, false},
+	// quax751 quantize -0.1E-999    1e-1001 ->  -1.0E-1000  Subnormal
+	{"quax751", "-0.1E-999", "1e-1001", "-1.0E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax752 quantize -0.10E-999   1e-1001 ->  -1.0E-1000  Subnormal
+	{"quax752", "-0.10E-999", "1e-1001", "-1.0E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax753 quantize -0.100E-999  1e-1001 ->  -1.0E-1000  Subnormal Rounded
+	{"quax753", "-0.100E-999", "1e-1001", "-1.0E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax754 quantize -0.01E-999   1e-1001 ->  -1E-1001    Subnormal
+	{"quax754", "-0.01E-999", "1e-1001", "-1E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// quax755 quantize -0.999E-999  1e-1001 ->  -1.00E-999  Inexact Rounded
+	{"quax755", "-0.999E-999", "1e-1001", "-1.00E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax756 quantize -0.099E-999  1e-1001 ->  -1.0E-1000  Inexact Rounded Subnormal
+	{"quax756", "-0.099E-999", "1e-1001", "-1.0E-1000", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax757 quantize -0.009E-999  1e-1001 ->  -1E-1001    Inexact Rounded Subnormal
+	{"quax757", "-0.009E-999", "1e-1001", "-1E-1001", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax758 quantize -0.001E-999  1e-1001 ->  -0E-1001    Inexact Rounded
+	{"quax758", "-0.001E-999", "1e-1001", "-0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax759 quantize -0.0001E-999 1e-1001 ->  -0E-1001    Inexact Rounded
+	{"quax759", "-0.0001E-999", "1e-1001", "-0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// quax760 quantize -1.00E-999   1e-1002 ->  -1.000E-999
+	{"quax760", "-1.00E-999", "1e-1002", "-1.000E-999", 0, 4, ToNearestAway, 999, -999, false},
+	// quax761 quantize -0.1E-999    1e-1002 ->  -1.00E-1000  Subnormal
+	{"quax761", "-0.1E-999", "1e-1002", "-1.00E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax762 quantize -0.10E-999   1e-1002 ->  -1.00E-1000  Subnormal
+	{"quax762", "-0.10E-999", "1e-1002", "-1.00E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax763 quantize -0.100E-999  1e-1002 ->  -1.00E-1000  Subnormal
+	{"quax763", "-0.100E-999", "1e-1002", "-1.00E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax764 quantize -0.01E-999   1e-1002 ->  -1.0E-1001   Subnormal
+	{"quax764", "-0.01E-999", "1e-1002", "-1.0E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax765 quantize -0.999E-999  1e-1002 ->  -9.99E-1000  Subnormal
+	{"quax765", "-0.999E-999", "1e-1002", "-9.99E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax766 quantize -0.099E-999  1e-1002 ->  -9.9E-1001   Subnormal
+	{"quax766", "-0.099E-999", "1e-1002", "-9.9E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax767 quantize -0.009E-999  1e-1002 ->  -9E-1002     Subnormal
+	{"quax767", "-0.009E-999", "1e-1002", "-9E-1002", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax768 quantize -0.001E-999  1e-1002 ->  -1E-1002     Subnormal
+	{"quax768", "-0.001E-999", "1e-1002", "-1E-1002", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// quax769 quantize -0.0001E-999 1e-1002 ->  -0E-1002     Inexact Rounded
+	{"quax769", "-0.0001E-999", "1e-1002", "-0E-1002", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// rhs must be no less than Etiny
+	// quax770 quantize -1.00E-999   1e-1003 ->  NaN Invalid_operation
+	{"quax770", "-1.00E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax771 quantize -0.1E-999    1e-1003 ->  NaN Invalid_operation
+	{"quax771", "-0.1E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax772 quantize -0.10E-999   1e-1003 ->  NaN Invalid_operation
+	{"quax772", "-0.10E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax773 quantize -0.100E-999  1e-1003 ->  NaN Invalid_operation
+	{"quax773", "-0.100E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax774 quantize -0.01E-999   1e-1003 ->  NaN Invalid_operation
+	{"quax774", "-0.01E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax775 quantize -0.999E-999  1e-1003 ->  NaN Invalid_operation
+	{"quax775", "-0.999E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax776 quantize -0.099E-999  1e-1003 ->  NaN Invalid_operation
+	{"quax776", "-0.099E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax777 quantize -0.009E-999  1e-1003 ->  NaN Invalid_operation
+	{"quax777", "-0.009E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax778 quantize -0.001E-999  1e-1003 ->  NaN Invalid_operation
+	{"quax778", "-0.001E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax779 quantize -0.0001E-999 1e-1003 ->  NaN Invalid_operation
+	{"quax779", "-0.0001E-999", "1e-1003", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// quax780 quantize -0.0001E-999 1e-1004 ->  NaN Invalid_operation
+	{"quax780", "-0.0001E-999", "1e-1004", "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// some extremes derived from Rescale testcases
+	// quax801 quantize   0   1e1000000000 -> NaN Invalid_operation
+	{"quax801", "0", "1e1000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax802 quantize   0  1e-1000000000 -> 0E-1000000000
+	{"quax802", "0", "1e-1000000000", "0E-1000000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax803 quantize   0   1e2000000000 -> NaN Invalid_operation
+	{"quax803", "0", "1e2000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax804 quantize   0  1e-2000000000 -> NaN Invalid_operation
+	{"quax804", "0", "1e-2000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax805 quantize   0   1e3000000000 -> NaN Invalid_operation
+	{"quax805", "0", "1e3000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax806 quantize   0  1e-3000000000 -> NaN Invalid_operation
+	{"quax806", "0", "1e-3000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax807 quantize   0   1e4000000000 -> NaN Invalid_operation
+	{"quax807", "0", "1e4000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax808 quantize   0  1e-4000000000 -> NaN Invalid_operation
+	{"quax808", "0", "1e-4000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax809 quantize   0   1e5000000000 -> NaN Invalid_operation
+	{"quax809", "0", "1e5000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax810 quantize   0  1e-5000000000 -> NaN Invalid_operation
+	{"quax810", "0", "1e-5000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax811 quantize   0   1e6000000000 -> NaN Invalid_operation
+	{"quax811", "0", "1e6000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax812 quantize   0  1e-6000000000 -> NaN Invalid_operation
+	{"quax812", "0", "1e-6000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax813 quantize   0   1e7000000000 -> NaN Invalid_operation
+	{"quax813", "0", "1e7000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax814 quantize   0  1e-7000000000 -> NaN Invalid_operation
+	{"quax814", "0", "1e-7000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax815 quantize   0   1e8000000000 -> NaN Invalid_operation
+	{"quax815", "0", "1e8000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax816 quantize   0  1e-8000000000 -> NaN Invalid_operation
+	{"quax816", "0", "1e-8000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax817 quantize   0   1e9000000000 -> NaN Invalid_operation
+	{"quax817", "0", "1e9000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax818 quantize   0  1e-9000000000 -> NaN Invalid_operation
+	{"quax818", "0", "1e-9000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax819 quantize   0   1e9999999999 -> NaN Invalid_operation
+	{"quax819", "0", "1e9999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax820 quantize   0  1e-9999999999 -> NaN Invalid_operation
+	{"quax820", "0", "1e-9999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax821 quantize   0   1e10000000000 -> NaN Invalid_operation
+	{"quax821", "0", "1e10000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax822 quantize   0  1e-10000000000 -> NaN Invalid_operation
+	{"quax822", "0", "1e-10000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax843 quantize   0    1e999999999 -> 0E+999999999
+	{"quax843", "0", "1e999999999", "0E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax844 quantize   0   1e1000000000 -> NaN Invalid_operation
+	{"quax844", "0", "1e1000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax845 quantize   0   1e-999999999 -> 0E-999999999
+	{"quax845", "0", "1e-999999999", "0E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax846 quantize   0  1e-1000000000 -> 0E-1000000000
+	{"quax846", "0", "1e-1000000000", "0E-1000000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax847 quantize   0  1e-1000000001 -> 0E-1000000001
+	{"quax847", "0", "1e-1000000001", "0E-1000000001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax848 quantize   0  1e-1000000002 -> 0E-1000000002
+	{"quax848", "0", "1e-1000000002", "0E-1000000002", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax849 quantize   0  1e-1000000003 -> 0E-1000000003
+	{"quax849", "0", "1e-1000000003", "0E-1000000003", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax850 quantize   0  1e-1000000004 -> 0E-1000000004
+	{"quax850", "0", "1e-1000000004", "0E-1000000004", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax851 quantize   0  1e-1000000005 -> 0E-1000000005
+	{"quax851", "0", "1e-1000000005", "0E-1000000005", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax852 quantize   0  1e-1000000006 -> 0E-1000000006
+	{"quax852", "0", "1e-1000000006", "0E-1000000006", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax853 quantize   0  1e-1000000007 -> 0E-1000000007
+	{"quax853", "0", "1e-1000000007", "0E-1000000007", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax854 quantize   0  1e-1000000008 -> NaN Invalid_operation
+	{"quax854", "0", "1e-1000000008", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax861 quantize   1  1e+2147483649 -> NaN Invalid_operation
+	{"quax861", "1", "1e+2147483649", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax862 quantize   1  1e+2147483648 -> NaN Invalid_operation
+	{"quax862", "1", "1e+2147483648", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax863 quantize   1  1e+2147483647 -> NaN Invalid_operation
+	{"quax863", "1", "1e+2147483647", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax864 quantize   1  1e-2147483647 -> NaN Invalid_operation
+	{"quax864", "1", "1e-2147483647", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax865 quantize   1  1e-2147483648 -> NaN Invalid_operation
+	{"quax865", "1", "1e-2147483648", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// quax866 quantize   1  1e-2147483649 -> NaN Invalid_operation
+	{"quax866", "1", "1e-2147483649", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// More from Fung Lee
+	// precision: 16
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// quax1021 quantize    8.666666666666000E+384     1.000000000000000E+384  -> 8.666666666666000E+384
+	{"quax1021", "8.666666666666000E+384", "1.000000000000000E+384", "8.666666666666000E+384", 0, 16, ToNearestAway, 384, -383, false},
+	// SKIP (encoding not supported): quax1022 quantize 64#8.666666666666000E+384  64#1.000000000000000E+384  -> 8.666666666666000E+384
+	// SKIP (encoding not supported): quax1023 quantize 64#8.666666666666000E+384  128#1.000000000000000E+384 -> 8.666666666666000E+384
+	// SKIP (encoding not supported): quax1024 quantize 64#8.666666666666000E+384  64#1E+384                  -> 8.666666666666000E+384
+	// SKIP (encoding not supported): quax1025 quantize 64#8.666666666666000E+384  64#1E+384   -> 64#8.666666666666000E+384
+	// SKIP (encoding not supported): quax1026 quantize 64#8.666666666666000E+384 128#1E+384   -> 64#9E+384 Inexact Rounded Clamped
+	// SKIP (encoding not supported): quax1027 quantize 64#8.666666666666000E+323  64#1E+31    -> NaN Invalid_operation
+	// SKIP (encoding not supported): quax1028 quantize 64#8.666666666666000E+323 128#1E+31    -> NaN Invalid_operation
+	// SKIP (encoding not supported): quax1029 quantize 64#8.66666666E+3          128#1E+10    -> 64#0E10 Inexact Rounded
+	// quax1030 quantize    8.66666666E+3              1E+3     -> 9E+3 Inexact Rounded
+	{"quax1030", "8.66666666E+3", "1E+3", "9E+3", Inexact | Rounded, 16, ToNearestAway, 384, -383, false},
+	// Int and uInt32 edge values for testing conversions
+	// quax1040 quantize -2147483646     0 -> -2147483646
+	{"quax1040", "-2147483646", "0", "-2147483646", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1041 quantize -2147483647     0 -> -2147483647
+	{"quax1041", "-2147483647", "0", "-2147483647", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1042 quantize -2147483648     0 -> -2147483648
+	{"quax1042", "-2147483648", "0", "-2147483648", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1043 quantize -2147483649     0 -> -2147483649
+	{"quax1043", "-2147483649", "0", "-2147483649", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1044 quantize  2147483646     0 ->  2147483646
+	{"quax1044", "2147483646", "0", "2147483646", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1045 quantize  2147483647     0 ->  2147483647
+	{"quax1045", "2147483647", "0", "2147483647", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1046 quantize  2147483648     0 ->  2147483648
+	{"quax1046", "2147483648", "0", "2147483648", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1047 quantize  2147483649     0 ->  2147483649
+	{"quax1047", "2147483649", "0", "2147483649", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1048 quantize  4294967294     0 ->  4294967294
+	{"quax1048", "4294967294", "0", "4294967294", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1049 quantize  4294967295     0 ->  4294967295
+	{"quax1049", "4294967295", "0", "4294967295", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1050 quantize  4294967296     0 ->  4294967296
+	{"quax1050", "4294967296", "0", "4294967296", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1051 quantize  4294967297     0 ->  4294967297
+	{"quax1051", "4294967297", "0", "4294967297", 0, 16, ToNearestAway, 384, -383, false},
+	// and powers of ten for same
+	// quax1101 quantize  5000000000     0 ->  5000000000
+	{"quax1101", "5000000000", "0", "5000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1102 quantize  4000000000     0 ->  4000000000
+	{"quax1102", "4000000000", "0", "4000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1103 quantize  2000000000     0 ->  2000000000
+	{"quax1103", "2000000000", "0", "2000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1104 quantize  1000000000     0 ->  1000000000
+	{"quax1104", "1000000000", "0", "1000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1105 quantize  0100000000     0 ->  100000000
+	{"quax1105", "0100000000", "0", "100000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1106 quantize  0010000000     0 ->  10000000
+	{"quax1106", "0010000000", "0", "10000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1107 quantize  0001000000     0 ->  1000000
+	{"quax1107", "0001000000", "0", "1000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1108 quantize  0000100000     0 ->  100000
+	{"quax1108", "0000100000", "0", "100000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1109 quantize  0000010000     0 ->  10000
+	{"quax1109", "0000010000", "0", "10000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1110 quantize  0000001000     0 ->  1000
+	{"quax1110", "0000001000", "0", "1000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1111 quantize  0000000100     0 ->  100
+	{"quax1111", "0000000100", "0", "100", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1112 quantize  0000000010     0 ->  10
+	{"quax1112", "0000000010", "0", "10", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1113 quantize  0000000001     0 ->  1
+	{"quax1113", "0000000001", "0", "1", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1114 quantize  0000000000     0 ->  0
+	{"quax1114", "0000000000", "0", "0", 0, 16, ToNearestAway, 384, -383, false},
+	// and powers of ten for same
+	// quax1121 quantize -5000000000     0 -> -5000000000
+	{"quax1121", "-5000000000", "0", "-5000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1122 quantize -4000000000     0 -> -4000000000
+	{"quax1122", "-4000000000", "0", "-4000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1123 quantize -2000000000     0 -> -2000000000
+	{"quax1123", "-2000000000", "0", "-2000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1124 quantize -1000000000     0 -> -1000000000
+	{"quax1124", "-1000000000", "0", "-1000000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1125 quantize -0100000000     0 -> -100000000
+	{"quax1125", "-0100000000", "0", "-100000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1126 quantize -0010000000     0 -> -10000000
+	{"quax1126", "-0010000000", "0", "-10000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1127 quantize -0001000000     0 -> -1000000
+	{"quax1127", "-0001000000", "0", "-1000000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1128 quantize -0000100000     0 -> -100000
+	{"quax1128", "-0000100000", "0", "-100000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1129 quantize -0000010000     0 -> -10000
+	{"quax1129", "-0000010000", "0", "-10000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1130 quantize -0000001000     0 -> -1000
+	{"quax1130", "-0000001000", "0", "-1000", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1131 quantize -0000000100     0 -> -100
+	{"quax1131", "-0000000100", "0", "-100", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1132 quantize -0000000010     0 -> -10
+	{"quax1132", "-0000000010", "0", "-10", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1133 quantize -0000000001     0 -> -1
+	{"quax1133", "-0000000001", "0", "-1", 0, 16, ToNearestAway, 384, -383, false},
+	// quax1134 quantize -0000000000     0 -> -0
+	{"quax1134", "-0000000000", "0", "-0", 0, 16, ToNearestAway, 384, -383, false},
+	// Some miscellany
+	// precision: 34
+	// rounding: half_up
+	// maxexponent: 6144
+	// minexponent: -6143
+	//                             1         2         3
+	//                   1 234567890123456789012345678901234
+	// quax0a1 quantize     8.555555555555555555555555555555555E+6143  1E+6143      -> 9E+6143   Inexact Rounded
+	{"quax0a1", "8.555555555555555555555555555555555E+6143", "1E+6143", "9E+6143", Inexact | Rounded, 34, ToNearestAway, 6144, -6143, false},
+	// SKIP (encoding not supported): quax0a2 quantize 128#8.555555555555555555555555555555555E+6143  128#1E+6143  -> 8.55555555555555555555555555555556E+6143   Rounded Inexact
+	// SKIP (encoding not supported): quax0a3 quantize 128#8.555555555555555555555555555555555E+6144  128#1E+6144  -> 8.555555555555555555555555555555555E+6144
+	// payload decapitate
+	// precision: 5
+	// quax62100 quantize 11 -sNaN1234567890 -> -NaN67890  Invalid_operation
+	{"quax62100", "11", "-sNaN1234567890", "-NaN67890", InvalidOperation, 5, ToNearestAway, 6144, -6143, false},
+	// Null tests
+	// SKIP (encoding not supported): quax998 quantize 10    # -> NaN Invalid_operation
+	// SKIP (encoding not supported): quax999 quantize  # 1e10 -> NaN Invalid_operation
+}
@@ -0,0 +1,379 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var reduceTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// redx001 reduce '1'      -> '1'
+	{"redx001", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx002 reduce '-1'     -> '-1'
+	{"redx002", "-1", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx003 reduce '1.00'   -> '1'
+	{"redx003", "1.00", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx004 reduce '-1.00'  -> '-1'
+	{"redx004", "-1.00", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx005 reduce '0'      -> '0'
+	{"redx005", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx006 reduce '0.00'   -> '0'
+	{"redx006", "0.00", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx007 reduce '00.0'   -> '0'
+	{"redx007", "00.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx008 reduce '00.00'  -> '0'
+	{"redx008", "00.00", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx009 reduce '00'     -> '0'
+	{"redx009", "00", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx010 reduce '0E+1'   -> '0'
+	{"redx010", "0E+1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx011 reduce '0E+5'   -> '0'
+	{"redx011", "0E+5", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx012 reduce '-2'     -> '-2'
+	{"redx012", "-2", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx013 reduce '2'      -> '2'
+	{"redx013", "2", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx014 reduce '-2.00'  -> '-2'
+	{"redx014", "-2.00", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx015 reduce '2.00'   -> '2'
+	{"redx015", "2.00", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx016 reduce '-0'     -> '-0'
+	{"redx016", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx017 reduce '-0.00'  -> '-0'
+	{"redx017", "-0.00", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx018 reduce '-00.0'  -> '-0'
+	{"redx018", "-00.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx019 reduce '-00.00' -> '-0'
+	{"redx019", "-00.00", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx020 reduce '-00'    -> '-0'
+	{"redx020", "-00", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx021 reduce '-0E+5'   -> '-0'
+	{"redx021", "-0E+5", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx022 reduce '-0E+1'  -> '-0'
+	{"redx022", "-0E+1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// redx030 reduce '+0.1'            -> '0.1'
+	{"redx030", "+0.1", "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx031 reduce '-0.1'            -> '-0.1'
+	{"redx031", "-0.1", "-0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx032 reduce '+0.01'           -> '0.01'
+	{"redx032", "+0.01", "0.01", 0, 9, ToNearestAway, 999, -999, false},
+	// redx033 reduce '-0.01'           -> '-0.01'
+	{"redx033", "-0.01", "-0.01", 0, 9, ToNearestAway, 999, -999, false},
+	// redx034 reduce '+0.001'          -> '0.001'
+	{"redx034", "+0.001", "0.001", 0, 9, ToNearestAway, 999, -999, false},
+	// redx035 reduce '-0.001'          -> '-0.001'
+	{"redx035", "-0.001", "-0.001", 0, 9, ToNearestAway, 999, -999, false},
+	// redx036 reduce '+0.000001'       -> '0.000001'
+	{"redx036", "+0.000001", "0.000001", 0, 9, ToNearestAway, 999, -999, false},
+	// redx037 reduce '-0.000001'       -> '-0.000001'
+	{"redx037", "-0.000001", "-0.000001", 0, 9, ToNearestAway, 999, -999, false},
+	// redx038 reduce '+0.000000000001' -> '1E-12'
+	{"redx038", "+0.000000000001", "1E-12", 0, 9, ToNearestAway, 999, -999, false},
+	// redx039 reduce '-0.000000000001' -> '-1E-12'
+	{"redx039", "-0.000000000001", "-1E-12", 0, 9, ToNearestAway, 999, -999, false},
+	// redx041 reduce 1.1        -> 1.1
+	{"redx041", "1.1", "1.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx042 reduce 1.10       -> 1.1
+	{"redx042", "1.10", "1.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx043 reduce 1.100      -> 1.1
+	{"redx043", "1.100", "1.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx044 reduce 1.110      -> 1.11
+	{"redx044", "1.110", "1.11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx045 reduce -1.1       -> -1.1
+	{"redx045", "-1.1", "-1.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx046 reduce -1.10      -> -1.1
+	{"redx046", "-1.10", "-1.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx047 reduce -1.100     -> -1.1
+	{"redx047", "-1.100", "-1.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx048 reduce -1.110     -> -1.11
+	{"redx048", "-1.110", "-1.11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx049 reduce 9.9        -> 9.9
+	{"redx049", "9.9", "9.9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx050 reduce 9.90       -> 9.9
+	{"redx050", "9.90", "9.9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx051 reduce 9.900      -> 9.9
+	{"redx051", "9.900", "9.9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx052 reduce 9.990      -> 9.99
+	{"redx052", "9.990", "9.99", 0, 9, ToNearestAway, 999, -999, false},
+	// redx053 reduce -9.9       -> -9.9
+	{"redx053", "-9.9", "-9.9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx054 reduce -9.90      -> -9.9
+	{"redx054", "-9.90", "-9.9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx055 reduce -9.900     -> -9.9
+	{"redx055", "-9.900", "-9.9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx056 reduce -9.990     -> -9.99
+	{"redx056", "-9.990", "-9.99", 0, 9, ToNearestAway, 999, -999, false},
+	// some trailing fractional zeros with zeros in units
+	// redx060 reduce  10.0        -> 1E+1
+	{"redx060", "10.0", "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx061 reduce  10.00       -> 1E+1
+	{"redx061", "10.00", "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx062 reduce  100.0       -> 1E+2
+	{"redx062", "100.0", "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx063 reduce  100.00      -> 1E+2
+	{"redx063", "100.00", "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx064 reduce  1.1000E+3   -> 1.1E+3
+	{"redx064", "1.1000E+3", "1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx065 reduce  1.10000E+3  -> 1.1E+3
+	{"redx065", "1.10000E+3", "1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx066 reduce -10.0        -> -1E+1
+	{"redx066", "-10.0", "-1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx067 reduce -10.00       -> -1E+1
+	{"redx067", "-10.00", "-1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx068 reduce -100.0       -> -1E+2
+	{"redx068", "-100.0", "-1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx069 reduce -100.00      -> -1E+2
+	{"redx069", "-100.00", "-1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx070 reduce -1.1000E+3   -> -1.1E+3
+	{"redx070", "-1.1000E+3", "-1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx071 reduce -1.10000E+3  -> -1.1E+3
+	{"redx071", "-1.10000E+3", "-1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// some insignificant trailing zeros with positive exponent
+	// redx080 reduce  10E+1       -> 1E+2
+	{"redx080", "10E+1", "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx081 reduce  100E+1      -> 1E+3
+	{"redx081", "100E+1", "1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx082 reduce  1.0E+2      -> 1E+2
+	{"redx082", "1.0E+2", "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx083 reduce  1.0E+3      -> 1E+3
+	{"redx083", "1.0E+3", "1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx084 reduce  1.1E+3      -> 1.1E+3
+	{"redx084", "1.1E+3", "1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx085 reduce  1.00E+3     -> 1E+3
+	{"redx085", "1.00E+3", "1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx086 reduce  1.10E+3     -> 1.1E+3
+	{"redx086", "1.10E+3", "1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx087 reduce -10E+1       -> -1E+2
+	{"redx087", "-10E+1", "-1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx088 reduce -100E+1      -> -1E+3
+	{"redx088", "-100E+1", "-1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx089 reduce -1.0E+2      -> -1E+2
+	{"redx089", "-1.0E+2", "-1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx090 reduce -1.0E+3      -> -1E+3
+	{"redx090", "-1.0E+3", "-1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx091 reduce -1.1E+3      -> -1.1E+3
+	{"redx091", "-1.1E+3", "-1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx092 reduce -1.00E+3     -> -1E+3
+	{"redx092", "-1.00E+3", "-1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx093 reduce -1.10E+3     -> -1.1E+3
+	{"redx093", "-1.10E+3", "-1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// some significant trailing zeros, were we to be trimming
+	// redx100 reduce  11          -> 11
+	{"redx100", "11", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx101 reduce  10          -> 1E+1
+	{"redx101", "10", "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx102 reduce  10.         -> 1E+1
+	{"redx102", "10.", "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx103 reduce  1.1E+1      -> 11
+	{"redx103", "1.1E+1", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx104 reduce  1.0E+1      -> 1E+1
+	{"redx104", "1.0E+1", "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx105 reduce  1.10E+2     -> 1.1E+2
+	{"redx105", "1.10E+2", "1.1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx106 reduce  1.00E+2     -> 1E+2
+	{"redx106", "1.00E+2", "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx107 reduce  1.100E+3    -> 1.1E+3
+	{"redx107", "1.100E+3", "1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx108 reduce  1.000E+3    -> 1E+3
+	{"redx108", "1.000E+3", "1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx109 reduce  1.000000E+6 -> 1E+6
+	{"redx109", "1.000000E+6", "1E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// redx110 reduce -11          -> -11
+	{"redx110", "-11", "-11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx111 reduce -10          -> -1E+1
+	{"redx111", "-10", "-1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx112 reduce -10.         -> -1E+1
+	{"redx112", "-10.", "-1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx113 reduce -1.1E+1      -> -11
+	{"redx113", "-1.1E+1", "-11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx114 reduce -1.0E+1      -> -1E+1
+	{"redx114", "-1.0E+1", "-1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx115 reduce -1.10E+2     -> -1.1E+2
+	{"redx115", "-1.10E+2", "-1.1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx116 reduce -1.00E+2     -> -1E+2
+	{"redx116", "-1.00E+2", "-1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx117 reduce -1.100E+3    -> -1.1E+3
+	{"redx117", "-1.100E+3", "-1.1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx118 reduce -1.000E+3    -> -1E+3
+	{"redx118", "-1.000E+3", "-1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// redx119 reduce -1.00000E+5  -> -1E+5
+	{"redx119", "-1.00000E+5", "-1E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// redx120 reduce -1.000000E+6 -> -1E+6
+	{"redx120", "-1.000000E+6", "-1E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// redx121 reduce -10.00000E+6 -> -1E+7
+	{"redx121", "-10.00000E+6", "-1E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// redx122 reduce -100.0000E+6 -> -1E+8
+	{"redx122", "-100.0000E+6", "-1E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// redx123 reduce -1000.000E+6 -> -1E+9
+	{"redx123", "-1000.000E+6", "-1E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx124 reduce -10000.00E+6 -> -1E+10
+	{"redx124", "-10000.00E+6", "-1E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// redx125 reduce -100000.0E+6 -> -1E+11
+	{"redx125", "-100000.0E+6", "-1E+11", 0, 9, ToNearestAway, 999, -999, false},
+	// redx126 reduce -1000000.E+6 -> -1E+12
+	{"redx126", "-1000000.E+6", "-1E+12", 0, 9, ToNearestAway, 999, -999, false},
+	// examples from decArith
+	// redx140 reduce '2.1'     ->  '2.1'
+	{"redx140", "2.1", "2.1", 0, 9, ToNearestAway, 999, -999, false},
+	// redx141 reduce '-2.0'    ->  '-2'
+	{"redx141", "-2.0", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx142 reduce '1.200'   ->  '1.2'
+	{"redx142", "1.200", "1.2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx143 reduce '-120'    ->  '-1.2E+2'
+	{"redx143", "-120", "-1.2E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx144 reduce '120.00'  ->  '1.2E+2'
+	{"redx144", "120.00", "1.2E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// redx145 reduce '0.00'    ->  '0'
+	{"redx145", "0.00", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// overflow tests
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 3
+	// redx160 reduce 9.999E+999999999  ->  Infinity Inexact Overflow Rounded
+	{"redx160", "9.999E+999999999", "Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// redx161 reduce -9.999E+999999999 -> -Infinity Inexact Overflow Rounded
+	{"redx161", "-9.999E+999999999", "-Inf", Inexact | Overflow | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// subnormals and underflow
+	// precision: 3
+	// maxexponent: 999
+	// minexponent: -999
+	// redx210 reduce  1.00E-999        ->   1E-999
+	{"redx210", "1.00E-999", "1E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// redx211 reduce  0.1E-999         ->   1E-1000   Subnormal
+	{"redx211", "0.1E-999", "1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// redx212 reduce  0.10E-999        ->   1E-1000   Subnormal
+	{"redx212", "0.10E-999", "1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// redx213 reduce  0.100E-999       ->   1E-1000   Subnormal Rounded
+	{"redx213", "0.100E-999", "1E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// redx214 reduce  0.01E-999        ->   1E-1001   Subnormal
+	{"redx214", "0.01E-999", "1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// redx215 reduce  0.999E-999       ->   1E-999    Inexact Rounded Subnormal Underflow
+	{"redx215", "0.999E-999", "1E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// redx216 reduce  0.099E-999       ->   1E-1000   Inexact Rounded Subnormal Underflow
+	{"redx216", "0.099E-999", "1E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// redx217 reduce  0.009E-999       ->   1E-1001   Inexact Rounded Subnormal Underflow
+	{"redx217", "0.009E-999", "1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// redx218 reduce  0.001E-999       ->   0         Inexact Rounded Subnormal Underflow Clamped
+	{"redx218", "0.001E-999", "0", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// redx219 reduce  0.0009E-999      ->   0         Inexact Rounded Subnormal Underflow Clamped
+	{"redx219", "0.0009E-999", "0", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// redx220 reduce  0.0001E-999      ->   0         Inexact Rounded Subnormal Underflow Clamped
+	{"redx220", "0.0001E-999", "0", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// redx230 reduce -1.00E-999        ->  -1E-999
+	{"redx230", "-1.00E-999", "-1E-999", 0, 3, ToNearestAway, 999, -999, false},
+	// redx231 reduce -0.1E-999         ->  -1E-1000   Subnormal
+	{"redx231", "-0.1E-999", "-1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// redx232 reduce -0.10E-999        ->  -1E-1000   Subnormal
+	{"redx232", "-0.10E-999", "-1E-1000", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// redx233 reduce -0.100E-999       ->  -1E-1000   Subnormal Rounded
+	{"redx233", "-0.100E-999", "-1E-1000", Subnormal | Rounded, 3, ToNearestAway, 999, -999, false},
+	// redx234 reduce -0.01E-999        ->  -1E-1001   Subnormal
+	{"redx234", "-0.01E-999", "-1E-1001", Subnormal, 3, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// redx235 reduce -0.999E-999       ->  -1E-999    Inexact Rounded Subnormal Underflow
+	{"redx235", "-0.999E-999", "-1E-999", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// redx236 reduce -0.099E-999       ->  -1E-1000   Inexact Rounded Subnormal Underflow
+	{"redx236", "-0.099E-999", "-1E-1000", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// redx237 reduce -0.009E-999       ->  -1E-1001   Inexact Rounded Subnormal Underflow
+	{"redx237", "-0.009E-999", "-1E-1001", Inexact | Rounded | Subnormal | Underflow, 3, ToNearestAway, 999, -999, false},
+	// redx238 reduce -0.001E-999       ->  -0         Inexact Rounded Subnormal Underflow Clamped
+	{"redx238", "-0.001E-999", "-0", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// redx239 reduce -0.0009E-999      ->  -0         Inexact Rounded Subnormal Underflow Clamped
+	{"redx239", "-0.0009E-999", "-0", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// redx240 reduce -0.0001E-999      ->  -0         Inexact Rounded Subnormal Underflow Clamped
+	{"redx240", "-0.0001E-999", "-0", Inexact | Rounded | Subnormal | Underflow | Clamped, 3, ToNearestAway, 999, -999, false},
+	// more reshaping
+	// precision: 9
+	// redx260 reduce '56260E-10'   -> '0.000005626'
+	{"redx260", "56260E-10", "0.000005626", 0, 9, ToNearestAway, 999, -999, false},
+	// redx261 reduce '56260E-5'    -> '0.5626'
+	{"redx261", "56260E-5", "0.5626", 0, 9, ToNearestAway, 999, -999, false},
+	// redx262 reduce '56260E-2'    -> '562.6'
+	{"redx262", "56260E-2", "562.6", 0, 9, ToNearestAway, 999, -999, false},
+	// redx263 reduce '56260E-1'    -> '5626'
+	{"redx263", "56260E-1", "5626", 0, 9, ToNearestAway, 999, -999, false},
+	// redx265 reduce '56260E-0'    -> '5.626E+4'
+	{"redx265", "56260E-0", "5.626E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// redx266 reduce '56260E+0'    -> '5.626E+4'
+	{"redx266", "56260E+0", "5.626E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// redx267 reduce '56260E+1'    -> '5.626E+5'
+	{"redx267", "56260E+1", "5.626E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// redx268 reduce '56260E+2'    -> '5.626E+6'
+	{"redx268", "56260E+2", "5.626E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// redx269 reduce '56260E+3'    -> '5.626E+7'
+	{"redx269", "56260E+3", "5.626E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// redx270 reduce '56260E+4'    -> '5.626E+8'
+	{"redx270", "56260E+4", "5.626E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// redx271 reduce '56260E+5'    -> '5.626E+9'
+	{"redx271", "56260E+5", "5.626E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx272 reduce '56260E+6'    -> '5.626E+10'
+	{"redx272", "56260E+6", "5.626E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// redx280 reduce '-56260E-10'  -> '-0.000005626'
+	{"redx280", "-56260E-10", "-0.000005626", 0, 9, ToNearestAway, 999, -999, false},
+	// redx281 reduce '-56260E-5'   -> '-0.5626'
+	{"redx281", "-56260E-5", "-0.5626", 0, 9, ToNearestAway, 999, -999, false},
+	// redx282 reduce '-56260E-2'   -> '-562.6'
+	{"redx282", "-56260E-2", "-562.6", 0, 9, ToNearestAway, 999, -999, false},
+	// redx283 reduce '-56260E-1'   -> '-5626'
+	{"redx283", "-56260E-1", "-5626", 0, 9, ToNearestAway, 999, -999, false},
+	// redx285 reduce '-56260E-0'   -> '-5.626E+4'
+	{"redx285", "-56260E-0", "-5.626E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// redx286 reduce '-56260E+0'   -> '-5.626E+4'
+	{"redx286", "-56260E+0", "-5.626E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// redx287 reduce '-56260E+1'   -> '-5.626E+5'
+	{"redx287", "-56260E+1", "-5.626E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// redx288 reduce '-56260E+2'   -> '-5.626E+6'
+	{"redx288", "-56260E+2", "-5.626E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// redx289 reduce '-56260E+3'   -> '-5.626E+7'
+	{"redx289", "-56260E+3", "-5.626E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// redx290 reduce '-56260E+4'   -> '-5.626E+8'
+	{"redx290", "-56260E+4", "-5.626E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// redx291 reduce '-56260E+5'   -> '-5.626E+9'
+	{"redx291", "-56260E+5", "-5.626E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// redx292 reduce '-56260E+6'   -> '-5.626E+10'
+	{"redx292", "-56260E+6", "-5.626E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// FL test
+	// precision: 40
+	// redx295 reduce 9892345673.0123456780000000000 -> 9892345673.012345678
+	{"redx295", "9892345673.0123456780000000000", "9892345673.012345678", 0, 40, ToNearestAway, 999, -999, false},
+	// specials
+	// redx820 reduce 'Inf'    -> 'Infinity'
+	{"redx820", "Inf", "Inf", 0, 40, ToNearestAway, 999, -999, false},
+	// redx821 reduce '-Inf'   -> '-Infinity'
+	{"redx821", "-Inf", "-Inf", 0, 40, ToNearestAway, 999, -999, false},
+	// redx822 reduce   NaN    ->  NaN
+	{"redx822", "NaN", "NaN", 0, 40, ToNearestAway, 999, -999, false},
+	// redx823 reduce  sNaN    ->  NaN    Invalid_operation
+	{"redx823", "sNaN", "NaN", InvalidOperation, 40, ToNearestAway, 999, -999, false},
+	// redx824 reduce   NaN101 ->  NaN101
+	{"redx824", "NaN101", "NaN101", 0, 40, ToNearestAway, 999, -999, false},
+	// redx825 reduce  sNaN010 ->  NaN10  Invalid_operation
+	{"redx825", "sNaN010", "NaN10", InvalidOperation, 40, ToNearestAway, 999, -999, false},
+	// redx827 reduce  -NaN    -> -NaN
+	{"redx827", "-NaN", "-NaN", 0, 40, ToNearestAway, 999, -999, false},
+	// redx828 reduce -sNaN    -> -NaN    Invalid_operation
+	{"redx828", "-sNaN", "-NaN", InvalidOperation, 40, ToNearestAway, 999, -999, false},
+	// redx829 reduce  -NaN101 -> -NaN101
+	{"redx829", "-NaN101", "-NaN101", 0, 40, ToNearestAway, 999, -999, false},
+	// redx830 reduce -sNaN010 -> -NaN10  Invalid_operation
+	{"redx830", "-sNaN010", "-NaN10", InvalidOperation, 40, ToNearestAway, 999, -999, false},
+	// payload decapitate
+	// precision: 5
+	// redx62100 reduce  sNaN1234567890 -> NaN67890  Invalid_operation
+	{"redx62100", "sNaN1234567890", "NaN67890", InvalidOperation, 5, ToNearestAway, 999, -999, false},
+	// Null test
+	// SKIP (encoding not supported): redx900 reduce  # -> NaN Invalid_operation
+}
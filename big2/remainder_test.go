@@ -0,0 +1,1105 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var remainderTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// sanity checks (as base, above)
+	// remx001 remainder  1     1    ->  0
+	{"remx001", "1", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx002 remainder  2     1    ->  0
+	{"remx002", "2", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx003 remainder  1     2    ->  1
+	{"remx003", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx004 remainder  2     2    ->  0
+	{"remx004", "2", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx005 remainder  0     1    ->  0
+	{"remx005", "0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx006 remainder  0     2    ->  0
+	{"remx006", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx007 remainder  1     3    ->  1
+	{"remx007", "1", "3", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx008 remainder  2     3    ->  2
+	{"remx008", "2", "3", "2", 0, 9, ToNearestAway, 384, -383, false},
+	// remx009 remainder  3     3    ->  0
+	{"remx009", "3", "3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx010 remainder  2.4   1    ->  0.4
+	{"remx010", "2.4", "1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// remx011 remainder  2.4   -1   ->  0.4
+	{"remx011", "2.4", "-1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// remx012 remainder  -2.4  1    ->  -0.4
+	{"remx012", "-2.4", "1", "-0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// remx013 remainder  -2.4  -1   ->  -0.4
+	{"remx013", "-2.4", "-1", "-0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// remx014 remainder  2.40  1    ->  0.40
+	{"remx014", "2.40", "1", "0.40", 0, 9, ToNearestAway, 384, -383, false},
+	// remx015 remainder  2.400 1    ->  0.400
+	{"remx015", "2.400", "1", "0.400", 0, 9, ToNearestAway, 384, -383, false},
+	// remx016 remainder  2.4   2    ->  0.4
+	{"remx016", "2.4", "2", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// remx017 remainder  2.400 2    ->  0.400
+	{"remx017", "2.400", "2", "0.400", 0, 9, ToNearestAway, 384, -383, false},
+	// remx018 remainder  2.    2    ->  0
+	{"remx018", "2.", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx019 remainder  20    20   ->  0
+	{"remx019", "20", "20", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx020 remainder  187   187    ->  0
+	{"remx020", "187", "187", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx021 remainder  5     2      ->  1
+	{"remx021", "5", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx022 remainder  5     2.0    ->  1.0
+	{"remx022", "5", "2.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx023 remainder  5     2.000  ->  1.000
+	{"remx023", "5", "2.000", "1.000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx024 remainder  5     0.200  ->  0.000
+	{"remx024", "5", "0.200", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx025 remainder  5     0.200  ->  0.000
+	{"remx025", "5", "0.200", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx030 remainder  1     2      ->  1
+	{"remx030", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx031 remainder  1     4      ->  1
+	{"remx031", "1", "4", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx032 remainder  1     8      ->  1
+	{"remx032", "1", "8", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx033 remainder  1     16     ->  1
+	{"remx033", "1", "16", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx034 remainder  1     32     ->  1
+	{"remx034", "1", "32", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx035 remainder  1     64     ->  1
+	{"remx035", "1", "64", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx040 remainder  1    -2      ->  1
+	{"remx040", "1", "-2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx041 remainder  1    -4      ->  1
+	{"remx041", "1", "-4", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx042 remainder  1    -8      ->  1
+	{"remx042", "1", "-8", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx043 remainder  1    -16     ->  1
+	{"remx043", "1", "-16", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx044 remainder  1    -32     ->  1
+	{"remx044", "1", "-32", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx045 remainder  1    -64     ->  1
+	{"remx045", "1", "-64", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx050 remainder -1     2      ->  -1
+	{"remx050", "-1", "2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx051 remainder -1     4      ->  -1
+	{"remx051", "-1", "4", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx052 remainder -1     8      ->  -1
+	{"remx052", "-1", "8", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx053 remainder -1     16     ->  -1
+	{"remx053", "-1", "16", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx054 remainder -1     32     ->  -1
+	{"remx054", "-1", "32", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx055 remainder -1     64     ->  -1
+	{"remx055", "-1", "64", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx060 remainder -1    -2      ->  -1
+	{"remx060", "-1", "-2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx061 remainder -1    -4      ->  -1
+	{"remx061", "-1", "-4", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx062 remainder -1    -8      ->  -1
+	{"remx062", "-1", "-8", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx063 remainder -1    -16     ->  -1
+	{"remx063", "-1", "-16", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx064 remainder -1    -32     ->  -1
+	{"remx064", "-1", "-32", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx065 remainder -1    -64     ->  -1
+	{"remx065", "-1", "-64", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// remx066 remainder  999999999     1  -> 0
+	{"remx066", "999999999", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx067 remainder  999999999.4   1  -> 0.4
+	{"remx067", "999999999.4", "1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// remx068 remainder  999999999.5   1  -> 0.5
+	{"remx068", "999999999.5", "1", "0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// remx069 remainder  999999999.9   1  -> 0.9
+	{"remx069", "999999999.9", "1", "0.9", 0, 9, ToNearestAway, 384, -383, false},
+	// remx070 remainder  999999999.999 1  -> 0.999
+	{"remx070", "999999999.999", "1", "0.999", 0, 9, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// remx071 remainder  999999999     1  -> NaN Division_impossible
+	{"remx071", "999999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// remx072 remainder  99999999      1  -> NaN Division_impossible
+	{"remx072", "99999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// remx073 remainder  9999999       1  -> NaN Division_impossible
+	{"remx073", "9999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// remx074 remainder  999999        1  -> 0
+	{"remx074", "999999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// remx075 remainder  99999         1  -> 0
+	{"remx075", "99999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// remx076 remainder  9999          1  -> 0
+	{"remx076", "9999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// remx077 remainder  999           1  -> 0
+	{"remx077", "999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// remx078 remainder  99            1  -> 0
+	{"remx078", "99", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// remx079 remainder  9             1  -> 0
+	{"remx079", "9", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// remx080 remainder  0.            1  -> 0
+	{"remx080", "0.", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx081 remainder  .0            1  -> 0.0
+	{"remx081", ".0", "1", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx082 remainder  0.00          1  -> 0.00
+	{"remx082", "0.00", "1", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// remx083 remainder  0.00E+9       1  -> 0
+	{"remx083", "0.00E+9", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx084 remainder  0.00E+3       1  -> 0
+	{"remx084", "0.00E+3", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx085 remainder  0.00E+2       1  -> 0
+	{"remx085", "0.00E+2", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx086 remainder  0.00E+1       1  -> 0.0
+	{"remx086", "0.00E+1", "1", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// remx087 remainder  0.00E+0       1  -> 0.00
+	{"remx087", "0.00E+0", "1", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// remx088 remainder  0.00E-0       1  -> 0.00
+	{"remx088", "0.00E-0", "1", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// remx089 remainder  0.00E-1       1  -> 0.000
+	{"remx089", "0.00E-1", "1", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx090 remainder  0.00E-2       1  -> 0.0000
+	{"remx090", "0.00E-2", "1", "0.0000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx091 remainder  0.00E-3       1  -> 0.00000
+	{"remx091", "0.00E-3", "1", "0.00000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx092 remainder  0.00E-4       1  -> 0.000000
+	{"remx092", "0.00E-4", "1", "0.000000", 0, 9, ToNearestAway, 384, -383, false},
+	// remx093 remainder  0.00E-5       1  -> 0E-7
+	{"remx093", "0.00E-5", "1", "0E-7", 0, 9, ToNearestAway, 384, -383, false},
+	// remx094 remainder  0.00E-6       1  -> 0E-8
+	{"remx094", "0.00E-6", "1", "0E-8", 0, 9, ToNearestAway, 384, -383, false},
+	// remx095 remainder  0.0000E-50    1  -> 0E-54
+	{"remx095", "0.0000E-50", "1", "0E-54", 0, 9, ToNearestAway, 384, -383, false},
+	// Various flavours of remainder by 0
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// remx101 remainder  0       0   -> NaN Division_undefined
+	{"remx101", "0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx102 remainder  0      -0   -> NaN Division_undefined
+	{"remx102", "0", "-0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx103 remainder -0       0   -> NaN Division_undefined
+	{"remx103", "-0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx104 remainder -0      -0   -> NaN Division_undefined
+	{"remx104", "-0", "-0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx105 remainder  0.0E5   0   -> NaN Division_undefined
+	{"remx105", "0.0E5", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx106 remainder  0.000   0   -> NaN Division_undefined
+	{"remx106", "0.000", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// [Some think this next group should be Division_by_zero exception, but
+	// IEEE 854 is explicit that it is Invalid operation .. for
+	// remainder-near, anyway]
+	// remx107 remainder  0.0001  0   -> NaN Invalid_operation
+	{"remx107", "0.0001", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx108 remainder  0.01    0   -> NaN Invalid_operation
+	{"remx108", "0.01", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx109 remainder  0.1     0   -> NaN Invalid_operation
+	{"remx109", "0.1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx110 remainder  1       0   -> NaN Invalid_operation
+	{"remx110", "1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx111 remainder  1       0.0 -> NaN Invalid_operation
+	{"remx111", "1", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx112 remainder 10       0.0 -> NaN Invalid_operation
+	{"remx112", "10", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx113 remainder 1E+100   0.0 -> NaN Invalid_operation
+	{"remx113", "1E+100", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx114 remainder 1E+1000  0   -> NaN Invalid_operation
+	{"remx114", "1E+1000", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx115 remainder  0.0001 -0   -> NaN Invalid_operation
+	{"remx115", "0.0001", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx116 remainder  0.01   -0   -> NaN Invalid_operation
+	{"remx116", "0.01", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx119 remainder  0.1    -0   -> NaN Invalid_operation
+	{"remx119", "0.1", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx120 remainder  1      -0   -> NaN Invalid_operation
+	{"remx120", "1", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx121 remainder  1      -0.0 -> NaN Invalid_operation
+	{"remx121", "1", "-0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx122 remainder 10      -0.0 -> NaN Invalid_operation
+	{"remx122", "10", "-0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx123 remainder 1E+100  -0.0 -> NaN Invalid_operation
+	{"remx123", "1E+100", "-0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx124 remainder 1E+1000 -0   -> NaN Invalid_operation
+	{"remx124", "1E+1000", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// and zeros on left
+	// remx130 remainder  0      1   ->  0
+	{"remx130", "0", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx131 remainder  0     -1   ->  0
+	{"remx131", "0", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx132 remainder  0.0    1   ->  0.0
+	{"remx132", "0.0", "1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx133 remainder  0.0   -1   ->  0.0
+	{"remx133", "0.0", "-1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx134 remainder -0      1   -> -0
+	{"remx134", "-0", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx135 remainder -0     -1   -> -0
+	{"remx135", "-0", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx136 remainder -0.0    1   -> -0.0
+	{"remx136", "-0.0", "1", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx137 remainder -0.0   -1   -> -0.0
+	{"remx137", "-0.0", "-1", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// 0.5ers
+	// remx143 remainder   0.5  2     ->  0.5
+	{"remx143", "0.5", "2", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx144 remainder   0.5  2.1   ->  0.5
+	{"remx144", "0.5", "2.1", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx145 remainder   0.5  2.01  ->  0.50
+	{"remx145", "0.5", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx146 remainder   0.5  2.001 ->  0.500
+	{"remx146", "0.5", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx147 remainder   0.50 2     ->  0.50
+	{"remx147", "0.50", "2", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx148 remainder   0.50 2.01  ->  0.50
+	{"remx148", "0.50", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx149 remainder   0.50 2.001 ->  0.500
+	{"remx149", "0.50", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// steadies
+	// remx150 remainder  1  1   -> 0
+	{"remx150", "1", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx151 remainder  1  2   -> 1
+	{"remx151", "1", "2", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx152 remainder  1  3   -> 1
+	{"remx152", "1", "3", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx153 remainder  1  4   -> 1
+	{"remx153", "1", "4", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx154 remainder  1  5   -> 1
+	{"remx154", "1", "5", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx155 remainder  1  6   -> 1
+	{"remx155", "1", "6", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx156 remainder  1  7   -> 1
+	{"remx156", "1", "7", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx157 remainder  1  8   -> 1
+	{"remx157", "1", "8", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx158 remainder  1  9   -> 1
+	{"remx158", "1", "9", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx159 remainder  1  10  -> 1
+	{"remx159", "1", "10", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx160 remainder  1  1   -> 0
+	{"remx160", "1", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx161 remainder  2  1   -> 0
+	{"remx161", "2", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx162 remainder  3  1   -> 0
+	{"remx162", "3", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx163 remainder  4  1   -> 0
+	{"remx163", "4", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx164 remainder  5  1   -> 0
+	{"remx164", "5", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx165 remainder  6  1   -> 0
+	{"remx165", "6", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx166 remainder  7  1   -> 0
+	{"remx166", "7", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx167 remainder  8  1   -> 0
+	{"remx167", "8", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx168 remainder  9  1   -> 0
+	{"remx168", "9", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx169 remainder  10 1   -> 0
+	{"remx169", "10", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// some differences from remainderNear
+	// remx171 remainder   0.4  1.020 ->  0.400
+	{"remx171", "0.4", "1.020", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx172 remainder   0.50 1.020 ->  0.500
+	{"remx172", "0.50", "1.020", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx173 remainder   0.51 1.020 ->  0.510
+	{"remx173", "0.51", "1.020", "0.510", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx174 remainder   0.52 1.020 ->  0.520
+	{"remx174", "0.52", "1.020", "0.520", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx175 remainder   0.6  1.020 ->  0.600
+	{"remx175", "0.6", "1.020", "0.600", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// More flavours of remainder by 0
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// remx201 remainder  0      0   -> NaN Division_undefined
+	{"remx201", "0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx202 remainder  0.0E5  0   -> NaN Division_undefined
+	{"remx202", "0.0E5", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx203 remainder  0.000  0   -> NaN Division_undefined
+	{"remx203", "0.000", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx204 remainder  0.0001 0   -> NaN Invalid_operation
+	{"remx204", "0.0001", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx205 remainder  0.01   0   -> NaN Invalid_operation
+	{"remx205", "0.01", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx206 remainder  0.1    0   -> NaN Invalid_operation
+	{"remx206", "0.1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx207 remainder  1      0   -> NaN Invalid_operation
+	{"remx207", "1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx208 remainder  1      0.0 -> NaN Invalid_operation
+	{"remx208", "1", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx209 remainder 10      0.0 -> NaN Invalid_operation
+	{"remx209", "10", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx210 remainder 1E+100  0.0 -> NaN Invalid_operation
+	{"remx210", "1E+100", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx211 remainder 1E+1000 0   -> NaN Invalid_operation
+	{"remx211", "1E+1000", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// some differences from remainderNear
+	// remx231 remainder  -0.4  1.020 -> -0.400
+	{"remx231", "-0.4", "1.020", "-0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx232 remainder  -0.50 1.020 -> -0.500
+	{"remx232", "-0.50", "1.020", "-0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx233 remainder  -0.51 1.020 -> -0.510
+	{"remx233", "-0.51", "1.020", "-0.510", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx234 remainder  -0.52 1.020 -> -0.520
+	{"remx234", "-0.52", "1.020", "-0.520", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx235 remainder  -0.6  1.020 -> -0.600
+	{"remx235", "-0.6", "1.020", "-0.600", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// high Xs
+	// remx240 remainder  1E+2  1.00  ->  0.00
+	{"remx240", "1E+2", "1.00", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// test some cases that are close to exponent overflow
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// remx270 remainder 1 1e999999999    -> 1
+	{"remx270", "1", "1e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx271 remainder 1 0.9e999999999  -> 1
+	{"remx271", "1", "0.9e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx272 remainder 1 0.99e999999999 -> 1
+	{"remx272", "1", "0.99e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx273 remainder 1 0.999999999e999999999 -> 1
+	{"remx273", "1", "0.999999999e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx274 remainder 9e999999999          1 -> NaN Division_impossible
+	{"remx274", "9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx275 remainder 9.9e999999999        1 -> NaN Division_impossible
+	{"remx275", "9.9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx276 remainder 9.99e999999999       1 -> NaN Division_impossible
+	{"remx276", "9.99e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx277 remainder 9.99999999e999999999 1 -> NaN Division_impossible
+	{"remx277", "9.99999999e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx280 remainder 0.1 9e-999999999       -> NaN Division_impossible
+	{"remx280", "0.1", "9e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx281 remainder 0.1 99e-999999999      -> NaN Division_impossible
+	{"remx281", "0.1", "99e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx282 remainder 0.1 999e-999999999     -> NaN Division_impossible
+	{"remx282", "0.1", "999e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx283 remainder 0.1 9e-999999998       -> NaN Division_impossible
+	{"remx283", "0.1", "9e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx284 remainder 0.1 99e-999999998      -> NaN Division_impossible
+	{"remx284", "0.1", "99e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx285 remainder 0.1 999e-999999998     -> NaN Division_impossible
+	{"remx285", "0.1", "999e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx286 remainder 0.1 999e-999999997     -> NaN Division_impossible
+	{"remx286", "0.1", "999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx287 remainder 0.1 9999e-999999997    -> NaN Division_impossible
+	{"remx287", "0.1", "9999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx288 remainder 0.1 99999e-999999997   -> NaN Division_impossible
+	{"remx288", "0.1", "99999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx3xx are from DiagBigDecimal
+	// remx301 remainder   1    3     ->  1
+	{"remx301", "1", "3", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx302 remainder   5    5     ->  0
+	{"remx302", "5", "5", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx303 remainder   13   10    ->  3
+	{"remx303", "13", "10", "3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx304 remainder   13   50    ->  13
+	{"remx304", "13", "50", "13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx305 remainder   13   100   ->  13
+	{"remx305", "13", "100", "13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx306 remainder   13   1000  ->  13
+	{"remx306", "13", "1000", "13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx307 remainder   .13    1   ->  0.13
+	{"remx307", ".13", "1", "0.13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx308 remainder   0.133  1   ->  0.133
+	{"remx308", "0.133", "1", "0.133", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx309 remainder   0.1033 1   ->  0.1033
+	{"remx309", "0.1033", "1", "0.1033", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx310 remainder   1.033  1   ->  0.033
+	{"remx310", "1.033", "1", "0.033", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx311 remainder   10.33  1   ->  0.33
+	{"remx311", "10.33", "1", "0.33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx312 remainder   10.33 10   ->  0.33
+	{"remx312", "10.33", "10", "0.33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx313 remainder   103.3  1   ->  0.3
+	{"remx313", "103.3", "1", "0.3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx314 remainder   133   10   ->  3
+	{"remx314", "133", "10", "3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx315 remainder   1033  10   ->  3
+	{"remx315", "1033", "10", "3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx316 remainder   1033  50   ->  33
+	{"remx316", "1033", "50", "33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx317 remainder   101.0  3   ->  2.0
+	{"remx317", "101.0", "3", "2.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx318 remainder   102.0  3   ->  0.0
+	{"remx318", "102.0", "3", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx319 remainder   103.0  3   ->  1.0
+	{"remx319", "103.0", "3", "1.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx320 remainder   2.40   1   ->  0.40
+	{"remx320", "2.40", "1", "0.40", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx321 remainder   2.400  1   ->  0.400
+	{"remx321", "2.400", "1", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx322 remainder   2.4    1   ->  0.4
+	{"remx322", "2.4", "1", "0.4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx323 remainder   2.4    2   ->  0.4
+	{"remx323", "2.4", "2", "0.4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx324 remainder   2.400  2   ->  0.400
+	{"remx324", "2.400", "2", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx325 remainder   1   0.3    ->  0.1
+	{"remx325", "1", "0.3", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx326 remainder   1   0.30   ->  0.10
+	{"remx326", "1", "0.30", "0.10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx327 remainder   1   0.300  ->  0.100
+	{"remx327", "1", "0.300", "0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx328 remainder   1   0.3000 ->  0.1000
+	{"remx328", "1", "0.3000", "0.1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx329 remainder   1.0    0.3 ->  0.1
+	{"remx329", "1.0", "0.3", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx330 remainder   1.00   0.3 ->  0.10
+	{"remx330", "1.00", "0.3", "0.10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx331 remainder   1.000  0.3 ->  0.100
+	{"remx331", "1.000", "0.3", "0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx332 remainder   1.0000 0.3 ->  0.1000
+	{"remx332", "1.0000", "0.3", "0.1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx333 remainder   0.5  2     ->  0.5
+	{"remx333", "0.5", "2", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx334 remainder   0.5  2.1   ->  0.5
+	{"remx334", "0.5", "2.1", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx335 remainder   0.5  2.01  ->  0.50
+	{"remx335", "0.5", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx336 remainder   0.5  2.001 ->  0.500
+	{"remx336", "0.5", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx337 remainder   0.50 2     ->  0.50
+	{"remx337", "0.50", "2", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx338 remainder   0.50 2.01  ->  0.50
+	{"remx338", "0.50", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx339 remainder   0.50 2.001 ->  0.500
+	{"remx339", "0.50", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx340 remainder   0.5   0.5000001    ->  0.5000000
+	{"remx340", "0.5", "0.5000001", "0.5000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx341 remainder   0.5   0.50000001    ->  0.50000000
+	{"remx341", "0.5", "0.50000001", "0.50000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx342 remainder   0.5   0.500000001    ->  0.500000000
+	{"remx342", "0.5", "0.500000001", "0.500000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx343 remainder   0.5   0.5000000001    ->  0.500000000  Rounded
+	{"remx343", "0.5", "0.5000000001", "0.500000000", Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx344 remainder   0.5   0.50000000001    ->  0.500000000  Rounded
+	{"remx344", "0.5", "0.50000000001", "0.500000000", Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx345 remainder   0.5   0.4999999    ->  1E-7
+	{"remx345", "0.5", "0.4999999", "1E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx346 remainder   0.5   0.49999999    ->  1E-8
+	{"remx346", "0.5", "0.49999999", "1E-8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx347 remainder   0.5   0.499999999    ->  1E-9
+	{"remx347", "0.5", "0.499999999", "1E-9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx348 remainder   0.5   0.4999999999    ->  1E-10
+	{"remx348", "0.5", "0.4999999999", "1E-10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx349 remainder   0.5   0.49999999999    ->  1E-11
+	{"remx349", "0.5", "0.49999999999", "1E-11", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx350 remainder   0.5   0.499999999999    ->  1E-12
+	{"remx350", "0.5", "0.499999999999", "1E-12", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx351 remainder   0.03  7  ->  0.03
+	{"remx351", "0.03", "7", "0.03", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx352 remainder   5   2    ->  1
+	{"remx352", "5", "2", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx353 remainder   4.1   2    ->  0.1
+	{"remx353", "4.1", "2", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx354 remainder   4.01   2    ->  0.01
+	{"remx354", "4.01", "2", "0.01", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx355 remainder   4.001   2    ->  0.001
+	{"remx355", "4.001", "2", "0.001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx356 remainder   4.0001   2    ->  0.0001
+	{"remx356", "4.0001", "2", "0.0001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx357 remainder   4.00001   2    ->  0.00001
+	{"remx357", "4.00001", "2", "0.00001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx358 remainder   4.000001   2    ->  0.000001
+	{"remx358", "4.000001", "2", "0.000001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx359 remainder   4.0000001   2    ->  1E-7
+	{"remx359", "4.0000001", "2", "1E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx360 remainder   1.2   0.7345 ->  0.4655
+	{"remx360", "1.2", "0.7345", "0.4655", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx361 remainder   0.8   12     ->  0.8
+	{"remx361", "0.8", "12", "0.8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx362 remainder   0.8   0.2    ->  0.0
+	{"remx362", "0.8", "0.2", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx363 remainder   0.8   0.3    ->  0.2
+	{"remx363", "0.8", "0.3", "0.2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx364 remainder   0.800   12   ->  0.800
+	{"remx364", "0.800", "12", "0.800", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx365 remainder   0.800   1.7  ->  0.800
+	{"remx365", "0.800", "1.7", "0.800", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx366 remainder   2.400   2    ->  0.400
+	{"remx366", "2.400", "2", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// precision: 6
+	// remx371 remainder   2.400  2        ->  0.400
+	{"remx371", "2.400", "2", "0.400", 0, 6, ToNearestAway, 999999999, -999999999, false},
+	// precision: 3
+	// long operand, rounded, case
+	// remx372 remainder   12345678900000 12e+12 -> 3.46E+11 Inexact Rounded
+	{"remx372", "12345678900000", "12e+12", "3.46E+11", Inexact | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	//                  12000000000000
+	// precision: 5
+	// remx381 remainder 12345  1         ->  0
+	{"remx381", "12345", "1", "0", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx382 remainder 12345  1.0001    ->  0.7657
+	{"remx382", "12345", "1.0001", "0.7657", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx383 remainder 12345  1.001     ->  0.668
+	{"remx383", "12345", "1.001", "0.668", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx384 remainder 12345  1.01      ->  0.78
+	{"remx384", "12345", "1.01", "0.78", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx385 remainder 12345  1.1       ->  0.8
+	{"remx385", "12345", "1.1", "0.8", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx386 remainder 12355  4         ->  3
+	{"remx386", "12355", "4", "3", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx387 remainder 12345  4         ->  1
+	{"remx387", "12345", "4", "1", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx388 remainder 12355  4.0001    ->  2.6912
+	{"remx388", "12355", "4.0001", "2.6912", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx389 remainder 12345  4.0001    ->  0.6914
+	{"remx389", "12345", "4.0001", "0.6914", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx390 remainder 12345  4.9       ->  1.9
+	{"remx390", "12345", "4.9", "1.9", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx391 remainder 12345  4.99      ->  4.73
+	{"remx391", "12345", "4.99", "4.73", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx392 remainder 12345  4.999     ->  2.469
+	{"remx392", "12345", "4.999", "2.469", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx393 remainder 12345  4.9999    ->  0.2469
+	{"remx393", "12345", "4.9999", "0.2469", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx394 remainder 12345  5         ->  0
+	{"remx394", "12345", "5", "0", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx395 remainder 12345  5.0001    ->  4.7532
+	{"remx395", "12345", "5.0001", "4.7532", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx396 remainder 12345  5.001     ->  2.532
+	{"remx396", "12345", "5.001", "2.532", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx397 remainder 12345  5.01      ->  0.36
+	{"remx397", "12345", "5.01", "0.36", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// remx398 remainder 12345  5.1       ->  3.0
+	{"remx398", "12345", "5.1", "3.0", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// precision: 9
+	// the nasty division-by-1 cases
+	// remx401 remainder   0.5         1   ->  0.5
+	{"remx401", "0.5", "1", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx402 remainder   0.55        1   ->  0.55
+	{"remx402", "0.55", "1", "0.55", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx403 remainder   0.555       1   ->  0.555
+	{"remx403", "0.555", "1", "0.555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx404 remainder   0.5555      1   ->  0.5555
+	{"remx404", "0.5555", "1", "0.5555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx405 remainder   0.55555     1   ->  0.55555
+	{"remx405", "0.55555", "1", "0.55555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx406 remainder   0.555555    1   ->  0.555555
+	{"remx406", "0.555555", "1", "0.555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx407 remainder   0.5555555   1   ->  0.5555555
+	{"remx407", "0.5555555", "1", "0.5555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx408 remainder   0.55555555  1   ->  0.55555555
+	{"remx408", "0.55555555", "1", "0.55555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx409 remainder   0.555555555 1   ->  0.555555555
+	{"remx409", "0.555555555", "1", "0.555555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// zero signs
+	// remx650 remainder  1  1 ->  0
+	{"remx650", "1", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx651 remainder -1  1 -> -0
+	{"remx651", "-1", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx652 remainder  1 -1 ->  0
+	{"remx652", "1", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx653 remainder -1 -1 -> -0
+	{"remx653", "-1", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx654 remainder  0  1 ->  0
+	{"remx654", "0", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx655 remainder -0  1 -> -0
+	{"remx655", "-0", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx656 remainder  0 -1 ->  0
+	{"remx656", "0", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx657 remainder -0 -1 -> -0
+	{"remx657", "-0", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx658 remainder  0.00  1  ->  0.00
+	{"remx658", "0.00", "1", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx659 remainder -0.00  1  -> -0.00
+	{"remx659", "-0.00", "1", "-0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// Specials
+	// remx680 remainder  Inf  -Inf   ->  NaN Invalid_operation
+	{"remx680", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx681 remainder  Inf  -1000  ->  NaN Invalid_operation
+	{"remx681", "Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx682 remainder  Inf  -1     ->  NaN Invalid_operation
+	{"remx682", "Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx683 remainder  Inf   0     ->  NaN Invalid_operation
+	{"remx683", "Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx684 remainder  Inf  -0     ->  NaN Invalid_operation
+	{"remx684", "Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx685 remainder  Inf   1     ->  NaN Invalid_operation
+	{"remx685", "Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx686 remainder  Inf   1000  ->  NaN Invalid_operation
+	{"remx686", "Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx687 remainder  Inf   Inf   ->  NaN Invalid_operation
+	{"remx687", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx688 remainder -1000  Inf   -> -1000
+	{"remx688", "-1000", "Inf", "-1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx689 remainder -Inf   Inf   ->  NaN Invalid_operation
+	{"remx689", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx691 remainder -1     Inf   -> -1
+	{"remx691", "-1", "Inf", "-1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx692 remainder  0     Inf   ->  0
+	{"remx692", "0", "Inf", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx693 remainder -0     Inf   -> -0
+	{"remx693", "-0", "Inf", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx694 remainder  1     Inf   ->  1
+	{"remx694", "1", "Inf", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx695 remainder  1000  Inf   ->  1000
+	{"remx695", "1000", "Inf", "1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx696 remainder  Inf   Inf   ->  NaN Invalid_operation
+	{"remx696", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx700 remainder -Inf  -Inf   ->  NaN Invalid_operation
+	{"remx700", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx701 remainder -Inf  -1000  ->  NaN Invalid_operation
+	{"remx701", "-Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx702 remainder -Inf  -1     ->  NaN Invalid_operation
+	{"remx702", "-Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx703 remainder -Inf  -0     ->  NaN Invalid_operation
+	{"remx703", "-Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx704 remainder -Inf   0     ->  NaN Invalid_operation
+	{"remx704", "-Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx705 remainder -Inf   1     ->  NaN Invalid_operation
+	{"remx705", "-Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx706 remainder -Inf   1000  ->  NaN Invalid_operation
+	{"remx706", "-Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx707 remainder -Inf   Inf   ->  NaN Invalid_operation
+	{"remx707", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx708 remainder -Inf  -Inf   ->  NaN Invalid_operation
+	{"remx708", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx709 remainder -1000  Inf   -> -1000
+	{"remx709", "-1000", "Inf", "-1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx710 remainder -1    -Inf   -> -1
+	{"remx710", "-1", "-Inf", "-1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx711 remainder -0    -Inf   -> -0
+	{"remx711", "-0", "-Inf", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx712 remainder  0    -Inf   ->  0
+	{"remx712", "0", "-Inf", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx713 remainder  1    -Inf   ->  1
+	{"remx713", "1", "-Inf", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx714 remainder  1000 -Inf   ->  1000
+	{"remx714", "1000", "-Inf", "1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx715 remainder  Inf  -Inf   ->  NaN Invalid_operation
+	{"remx715", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx721 remainder  NaN -Inf    ->  NaN
+	{"remx721", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx722 remainder  NaN -1000   ->  NaN
+	{"remx722", "NaN", "-1000", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx723 remainder  NaN -1      ->  NaN
+	{"remx723", "NaN", "-1", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx724 remainder  NaN -0      ->  NaN
+	{"remx724", "NaN", "-0", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx725 remainder -NaN  0      -> -NaN
+	{"remx725", "-NaN", "0", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx726 remainder  NaN  1      ->  NaN
+	{"remx726", "NaN", "1", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx727 remainder  NaN  1000   ->  NaN
+	{"remx727", "NaN", "1000", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx728 remainder  NaN  Inf    ->  NaN
+	{"remx728", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx729 remainder  NaN -NaN    ->  NaN
+	{"remx729", "NaN", "-NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx730 remainder -Inf  NaN    ->  NaN
+	{"remx730", "-Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx731 remainder -1000 NaN    ->  NaN
+	{"remx731", "-1000", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx732 remainder -1    NaN    ->  NaN
+	{"remx732", "-1", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx733 remainder -0   -NaN    -> -NaN
+	{"remx733", "-0", "-NaN", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx734 remainder  0    NaN    ->  NaN
+	{"remx734", "0", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx735 remainder  1   -NaN    -> -NaN
+	{"remx735", "1", "-NaN", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx736 remainder  1000 NaN    ->  NaN
+	{"remx736", "1000", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx737 remainder  Inf  NaN    ->  NaN
+	{"remx737", "Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx741 remainder  sNaN -Inf   ->  NaN  Invalid_operation
+	{"remx741", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx742 remainder  sNaN -1000  ->  NaN  Invalid_operation
+	{"remx742", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx743 remainder -sNaN -1     -> -NaN  Invalid_operation
+	{"remx743", "-sNaN", "-1", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx744 remainder  sNaN -0     ->  NaN  Invalid_operation
+	{"remx744", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx745 remainder  sNaN  0     ->  NaN  Invalid_operation
+	{"remx745", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx746 remainder  sNaN  1     ->  NaN  Invalid_operation
+	{"remx746", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx747 remainder  sNaN  1000  ->  NaN  Invalid_operation
+	{"remx747", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx749 remainder  sNaN  NaN   ->  NaN  Invalid_operation
+	{"remx749", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx750 remainder  sNaN sNaN   ->  NaN  Invalid_operation
+	{"remx750", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx751 remainder  NaN  sNaN   ->  NaN  Invalid_operation
+	{"remx751", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx752 remainder -Inf  sNaN   ->  NaN  Invalid_operation
+	{"remx752", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx753 remainder -1000 sNaN   ->  NaN  Invalid_operation
+	{"remx753", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx754 remainder -1    sNaN   ->  NaN  Invalid_operation
+	{"remx754", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx755 remainder -0    sNaN   ->  NaN  Invalid_operation
+	{"remx755", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx756 remainder  0    sNaN   ->  NaN  Invalid_operation
+	{"remx756", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx757 remainder  1    sNaN   ->  NaN  Invalid_operation
+	{"remx757", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx758 remainder  1000 sNaN   ->  NaN  Invalid_operation
+	{"remx758", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx759 remainder  Inf -sNaN   -> -NaN  Invalid_operation
+	{"remx759", "Inf", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// propaging NaNs
+	// remx760 remainder  NaN1   NaN7   ->  NaN1
+	{"remx760", "NaN1", "NaN7", "NaN1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx761 remainder sNaN2   NaN8   ->  NaN2 Invalid_operation
+	{"remx761", "sNaN2", "NaN8", "NaN2", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx762 remainder  NaN3  sNaN9   ->  NaN9 Invalid_operation
+	{"remx762", "NaN3", "sNaN9", "NaN9", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx763 remainder sNaN4  sNaN10  ->  NaN4 Invalid_operation
+	{"remx763", "sNaN4", "sNaN10", "NaN4", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx764 remainder    15   NaN11  ->  NaN11
+	{"remx764", "15", "NaN11", "NaN11", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx765 remainder  NaN6   NaN12  ->  NaN6
+	{"remx765", "NaN6", "NaN12", "NaN6", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx766 remainder  Inf    NaN13  ->  NaN13
+	{"remx766", "Inf", "NaN13", "NaN13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx767 remainder  NaN14  -Inf   ->  NaN14
+	{"remx767", "NaN14", "-Inf", "NaN14", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx768 remainder    0    NaN15  ->  NaN15
+	{"remx768", "0", "NaN15", "NaN15", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx769 remainder  NaN16   -0    ->  NaN16
+	{"remx769", "NaN16", "-0", "NaN16", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// test some cases that are close to exponent overflow
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// remx770 remainder 1 1e999999999    -> 1
+	{"remx770", "1", "1e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx771 remainder 1 0.9e999999999  -> 1
+	{"remx771", "1", "0.9e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx772 remainder 1 0.99e999999999 -> 1
+	{"remx772", "1", "0.99e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx773 remainder 1 0.999999999e999999999 -> 1
+	{"remx773", "1", "0.999999999e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx774 remainder 9e999999999          1 -> NaN Division_impossible
+	{"remx774", "9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx775 remainder 9.9e999999999        1 -> NaN Division_impossible
+	{"remx775", "9.9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx776 remainder 9.99e999999999       1 -> NaN Division_impossible
+	{"remx776", "9.99e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx777 remainder 9.99999999e999999999 1 -> NaN Division_impossible
+	{"remx777", "9.99999999e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// long operand checks
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// remx801 remainder 12345678000 100 -> 0
+	{"remx801", "12345678000", "100", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// remx802 remainder 1 12345678000   -> 1
+	{"remx802", "1", "12345678000", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx803 remainder 1234567800  10  -> 0
+	{"remx803", "1234567800", "10", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// remx804 remainder 1 1234567800    -> 1
+	{"remx804", "1", "1234567800", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx805 remainder 1234567890  10  -> 0
+	{"remx805", "1234567890", "10", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// remx806 remainder 1 1234567890    -> 1
+	{"remx806", "1", "1234567890", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx807 remainder 1234567891  10  -> 1
+	{"remx807", "1234567891", "10", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx808 remainder 1 1234567891    -> 1
+	{"remx808", "1", "1234567891", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx809 remainder 12345678901 100 -> 1
+	{"remx809", "12345678901", "100", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx810 remainder 1 12345678901   -> 1
+	{"remx810", "1", "12345678901", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// remx811 remainder 1234567896  10  -> 6
+	{"remx811", "1234567896", "10", "6", 0, 9, ToNearestAway, 999, -999, false},
+	// remx812 remainder 1 1234567896    -> 1
+	{"remx812", "1", "1234567896", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// remx821 remainder 12345678000 100 -> 0
+	{"remx821", "12345678000", "100", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// remx822 remainder 1 12345678000   -> 1
+	{"remx822", "1", "12345678000", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx823 remainder 1234567800  10  -> 0
+	{"remx823", "1234567800", "10", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// remx824 remainder 1 1234567800    -> 1
+	{"remx824", "1", "1234567800", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx825 remainder 1234567890  10  -> 0
+	{"remx825", "1234567890", "10", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// remx826 remainder 1 1234567890    -> 1
+	{"remx826", "1", "1234567890", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx827 remainder 1234567891  10  -> 1
+	{"remx827", "1234567891", "10", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx828 remainder 1 1234567891    -> 1
+	{"remx828", "1", "1234567891", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx829 remainder 12345678901 100 -> 1
+	{"remx829", "12345678901", "100", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx830 remainder 1 12345678901   -> 1
+	{"remx830", "1", "12345678901", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// remx831 remainder 1234567896  10  -> 6
+	{"remx831", "1234567896", "10", "6", 0, 15, ToNearestAway, 999, -999, false},
+	// remx832 remainder 1 1234567896    -> 1
+	{"remx832", "1", "1234567896", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// worries from divideint
+	// precision: 8
+	// remx840 remainder  100000000.0   1  ->  NaN Division_impossible
+	{"remx840", "100000000.0", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// remx841 remainder  100000000.4   1  ->  NaN Division_impossible
+	{"remx841", "100000000.4", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// remx842 remainder  100000000.5   1  ->  NaN Division_impossible
+	{"remx842", "100000000.5", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// remx843 remainder  100000000.9   1  ->  NaN Division_impossible
+	{"remx843", "100000000.9", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// remx844 remainder  100000000.999 1  ->  NaN Division_impossible
+	{"remx844", "100000000.999", "1", "NaN", DivisionImpossible, 8, ToNearestAway, 999, -999, false},
+	// precision: 6
+	// remx850 remainder  100000003     5  ->  NaN Division_impossible
+	{"remx850", "100000003", "5", "NaN", DivisionImpossible, 6, ToNearestAway, 999, -999, false},
+	// remx851 remainder  10000003      5  ->  NaN Division_impossible
+	{"remx851", "10000003", "5", "NaN", DivisionImpossible, 6, ToNearestAway, 999, -999, false},
+	// remx852 remainder  1000003       5  ->  3
+	{"remx852", "1000003", "5", "3", 0, 6, ToNearestAway, 999, -999, false},
+	// remx853 remainder  100003        5  ->  3
+	{"remx853", "100003", "5", "3", 0, 6, ToNearestAway, 999, -999, false},
+	// remx854 remainder  10003         5  ->  3
+	{"remx854", "10003", "5", "3", 0, 6, ToNearestAway, 999, -999, false},
+	// remx855 remainder  1003          5  ->  3
+	{"remx855", "1003", "5", "3", 0, 6, ToNearestAway, 999, -999, false},
+	// remx856 remainder  103           5  ->  3
+	{"remx856", "103", "5", "3", 0, 6, ToNearestAway, 999, -999, false},
+	// remx857 remainder  13            5  ->  3
+	{"remx857", "13", "5", "3", 0, 6, ToNearestAway, 999, -999, false},
+	// remx858 remainder  1             5  ->  1
+	{"remx858", "1", "5", "1", 0, 6, ToNearestAway, 999, -999, false},
+	// Vladimir's cases
+	// remx860 remainder 123.0e1 10000000000000000 -> 1230
+	{"remx860", "123.0e1", "10000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx861 remainder 1230    10000000000000000 -> 1230
+	{"remx861", "1230", "10000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx862 remainder 12.3e2  10000000000000000 -> 1230
+	{"remx862", "12.3e2", "10000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx863 remainder 1.23e3  10000000000000000 -> 1230
+	{"remx863", "1.23e3", "10000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx864 remainder 123e1   10000000000000000 -> 1230
+	{"remx864", "123e1", "10000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx870 remainder 123e1    1000000000000000 -> 1230
+	{"remx870", "123e1", "1000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx871 remainder 123e1     100000000000000 -> 1230
+	{"remx871", "123e1", "100000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx872 remainder 123e1      10000000000000 -> 1230
+	{"remx872", "123e1", "10000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx873 remainder 123e1       1000000000000 -> 1230
+	{"remx873", "123e1", "1000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx874 remainder 123e1        100000000000 -> 1230
+	{"remx874", "123e1", "100000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx875 remainder 123e1         10000000000 -> 1230
+	{"remx875", "123e1", "10000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx876 remainder 123e1          1000000000 -> 1230
+	{"remx876", "123e1", "1000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx877 remainder 123e1           100000000 -> 1230
+	{"remx877", "123e1", "100000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx878 remainder 1230            100000000 -> 1230
+	{"remx878", "1230", "100000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx879 remainder 123e1            10000000 -> 1230
+	{"remx879", "123e1", "10000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx880 remainder 123e1             1000000 -> 1230
+	{"remx880", "123e1", "1000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx881 remainder 123e1              100000 -> 1230
+	{"remx881", "123e1", "100000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx882 remainder 123e1               10000 -> 1230
+	{"remx882", "123e1", "10000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx883 remainder 123e1                1000 ->  230
+	{"remx883", "123e1", "1000", "230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx884 remainder 123e1                 100 ->   30
+	{"remx884", "123e1", "100", "30", 0, 6, ToNearestAway, 999, -999, false},
+	// remx885 remainder 123e1                  10 ->    0
+	{"remx885", "123e1", "10", "0", 0, 6, ToNearestAway, 999, -999, false},
+	// remx886 remainder 123e1                   1 ->    0
+	{"remx886", "123e1", "1", "0", 0, 6, ToNearestAway, 999, -999, false},
+	// remx889 remainder 123e1   20000000000000000 -> 1230
+	{"remx889", "123e1", "20000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx890 remainder 123e1    2000000000000000 -> 1230
+	{"remx890", "123e1", "2000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx891 remainder 123e1     200000000000000 -> 1230
+	{"remx891", "123e1", "200000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx892 remainder 123e1      20000000000000 -> 1230
+	{"remx892", "123e1", "20000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx893 remainder 123e1       2000000000000 -> 1230
+	{"remx893", "123e1", "2000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx894 remainder 123e1        200000000000 -> 1230
+	{"remx894", "123e1", "200000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx895 remainder 123e1         20000000000 -> 1230
+	{"remx895", "123e1", "20000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx896 remainder 123e1          2000000000 -> 1230
+	{"remx896", "123e1", "2000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx897 remainder 123e1           200000000 -> 1230
+	{"remx897", "123e1", "200000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx899 remainder 123e1            20000000 -> 1230
+	{"remx899", "123e1", "20000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx900 remainder 123e1             2000000 -> 1230
+	{"remx900", "123e1", "2000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx901 remainder 123e1              200000 -> 1230
+	{"remx901", "123e1", "200000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx902 remainder 123e1               20000 -> 1230
+	{"remx902", "123e1", "20000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx903 remainder 123e1                2000 -> 1230
+	{"remx903", "123e1", "2000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx904 remainder 123e1                 200 ->   30
+	{"remx904", "123e1", "200", "30", 0, 6, ToNearestAway, 999, -999, false},
+	// remx905 remainder 123e1                  20 ->   10
+	{"remx905", "123e1", "20", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx906 remainder 123e1                   2 ->    0
+	{"remx906", "123e1", "2", "0", 0, 6, ToNearestAway, 999, -999, false},
+	// remx909 remainder 123e1   50000000000000000 -> 1230
+	{"remx909", "123e1", "50000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx910 remainder 123e1    5000000000000000 -> 1230
+	{"remx910", "123e1", "5000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx911 remainder 123e1     500000000000000 -> 1230
+	{"remx911", "123e1", "500000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx912 remainder 123e1      50000000000000 -> 1230
+	{"remx912", "123e1", "50000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx913 remainder 123e1       5000000000000 -> 1230
+	{"remx913", "123e1", "5000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx914 remainder 123e1        500000000000 -> 1230
+	{"remx914", "123e1", "500000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx915 remainder 123e1         50000000000 -> 1230
+	{"remx915", "123e1", "50000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx916 remainder 123e1          5000000000 -> 1230
+	{"remx916", "123e1", "5000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx917 remainder 123e1           500000000 -> 1230
+	{"remx917", "123e1", "500000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx919 remainder 123e1            50000000 -> 1230
+	{"remx919", "123e1", "50000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx920 remainder 123e1             5000000 -> 1230
+	{"remx920", "123e1", "5000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx921 remainder 123e1              500000 -> 1230
+	{"remx921", "123e1", "500000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx922 remainder 123e1               50000 -> 1230
+	{"remx922", "123e1", "50000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx923 remainder 123e1                5000 -> 1230
+	{"remx923", "123e1", "5000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx924 remainder 123e1                 500 ->  230
+	{"remx924", "123e1", "500", "230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx925 remainder 123e1                  50 ->   30
+	{"remx925", "123e1", "50", "30", 0, 6, ToNearestAway, 999, -999, false},
+	// remx926 remainder 123e1                   5 ->    0
+	{"remx926", "123e1", "5", "0", 0, 6, ToNearestAway, 999, -999, false},
+	// remx929 remainder 123e1   90000000000000000 -> 1230
+	{"remx929", "123e1", "90000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx930 remainder 123e1    9000000000000000 -> 1230
+	{"remx930", "123e1", "9000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx931 remainder 123e1     900000000000000 -> 1230
+	{"remx931", "123e1", "900000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx932 remainder 123e1      90000000000000 -> 1230
+	{"remx932", "123e1", "90000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx933 remainder 123e1       9000000000000 -> 1230
+	{"remx933", "123e1", "9000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx934 remainder 123e1        900000000000 -> 1230
+	{"remx934", "123e1", "900000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx935 remainder 123e1         90000000000 -> 1230
+	{"remx935", "123e1", "90000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx936 remainder 123e1          9000000000 -> 1230
+	{"remx936", "123e1", "9000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx937 remainder 123e1           900000000 -> 1230
+	{"remx937", "123e1", "900000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx939 remainder 123e1            90000000 -> 1230
+	{"remx939", "123e1", "90000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx940 remainder 123e1             9000000 -> 1230
+	{"remx940", "123e1", "9000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx941 remainder 123e1              900000 -> 1230
+	{"remx941", "123e1", "900000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx942 remainder 123e1               90000 -> 1230
+	{"remx942", "123e1", "90000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx943 remainder 123e1                9000 -> 1230
+	{"remx943", "123e1", "9000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx944 remainder 123e1                 900 ->  330
+	{"remx944", "123e1", "900", "330", 0, 6, ToNearestAway, 999, -999, false},
+	// remx945 remainder 123e1                  90 ->   60
+	{"remx945", "123e1", "90", "60", 0, 6, ToNearestAway, 999, -999, false},
+	// remx946 remainder 123e1                   9 ->    6
+	{"remx946", "123e1", "9", "6", 0, 6, ToNearestAway, 999, -999, false},
+	// remx950 remainder 123e1   10000000000000000 -> 1230
+	{"remx950", "123e1", "10000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx951 remainder 123e1   100000000000000000 -> 1230
+	{"remx951", "123e1", "100000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx952 remainder 123e1   1000000000000000000 -> 1230
+	{"remx952", "123e1", "1000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx953 remainder 123e1   10000000000000000000 -> 1230
+	{"remx953", "123e1", "10000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx954 remainder 123e1   100000000000000000000 -> 1230
+	{"remx954", "123e1", "100000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx955 remainder 123e1   1000000000000000000000 -> 1230
+	{"remx955", "123e1", "1000000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx956 remainder 123e1   10000000000000000000000 -> 1230
+	{"remx956", "123e1", "10000000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx957 remainder 123e1   100000000000000000000000 -> 1230
+	{"remx957", "123e1", "100000000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx958 remainder 123e1   1000000000000000000000000 -> 1230
+	{"remx958", "123e1", "1000000000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx959 remainder 123e1   10000000000000000000000000 -> 1230
+	{"remx959", "123e1", "10000000000000000000000000", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx960 remainder 123e1   19999999999999999 -> 1230
+	{"remx960", "123e1", "19999999999999999", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx961 remainder 123e1   199999999999999990 -> 1230
+	{"remx961", "123e1", "199999999999999990", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx962 remainder 123e1   1999999999999999999 -> 1230
+	{"remx962", "123e1", "1999999999999999999", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx963 remainder 123e1   19999999999999999990 -> 1230
+	{"remx963", "123e1", "19999999999999999990", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx964 remainder 123e1   199999999999999999999 -> 1230
+	{"remx964", "123e1", "199999999999999999999", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx965 remainder 123e1   1999999999999999999990 -> 1230
+	{"remx965", "123e1", "1999999999999999999990", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx966 remainder 123e1   19999999999999999999999 -> 1230
+	{"remx966", "123e1", "19999999999999999999999", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx967 remainder 123e1   199999999999999999999990 -> 1230
+	{"remx967", "123e1", "199999999999999999999990", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx968 remainder 123e1   1999999999999999999999999 -> 1230
+	{"remx968", "123e1", "1999999999999999999999999", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx969 remainder 123e1   19999999999999999999999990 -> 1230
+	{"remx969", "123e1", "19999999999999999999999990", "1230", 0, 6, ToNearestAway, 999, -999, false},
+	// remx970 remainder 1e1   10000000000000000 -> 10
+	{"remx970", "1e1", "10000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx971 remainder 1e1   100000000000000000 -> 10
+	{"remx971", "1e1", "100000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx972 remainder 1e1   1000000000000000000 -> 10
+	{"remx972", "1e1", "1000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx973 remainder 1e1   10000000000000000000 -> 10
+	{"remx973", "1e1", "10000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx974 remainder 1e1   100000000000000000000 -> 10
+	{"remx974", "1e1", "100000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx975 remainder 1e1   1000000000000000000000 -> 10
+	{"remx975", "1e1", "1000000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx976 remainder 1e1   10000000000000000000000 -> 10
+	{"remx976", "1e1", "10000000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx977 remainder 1e1   100000000000000000000000 -> 10
+	{"remx977", "1e1", "100000000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx978 remainder 1e1   1000000000000000000000000 -> 10
+	{"remx978", "1e1", "1000000000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx979 remainder 1e1   10000000000000000000000000 -> 10
+	{"remx979", "1e1", "10000000000000000000000000", "10", 0, 6, ToNearestAway, 999, -999, false},
+	// remx980 remainder 123e1 1000E999999 -> 1.23E+3  -- 123E+1 internally
+	{"remx980", "123e1", "1000E999999", "1.23E+3", 0, 6, ToNearestAway, 999, -999, false},
+	// overflow and underflow tests [from divide]
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// remx990 remainder +1.23456789012345E-0 9E+999999999 -> 1.23456789 Inexact Rounded
+	{"remx990", "+1.23456789012345E-0", "9E+999999999", "1.23456789", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx991 remainder 9E+999999999 +0.23456789012345E-0 -> NaN Division_impossible
+	{"remx991", "9E+999999999", "+0.23456789012345E-0", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx992 remainder +0.100 9E+999999999               -> 0.100
+	{"remx992", "+0.100", "9E+999999999", "0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx993 remainder 9E-999999999 +9.100               -> 9E-999999999
+	{"remx993", "9E-999999999", "+9.100", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx995 remainder -1.23456789012345E-0 9E+999999999 -> -1.23456789 Inexact Rounded
+	{"remx995", "-1.23456789012345E-0", "9E+999999999", "-1.23456789", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx996 remainder 9E+999999999 -0.83456789012345E-0 -> NaN Division_impossible
+	{"remx996", "9E+999999999", "-0.83456789012345E-0", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx997 remainder -0.100 9E+999999999               -> -0.100
+	{"remx997", "-0.100", "9E+999999999", "-0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// remx998 remainder 9E-999999999 -9.100               -> 9E-999999999
+	{"remx998", "9E-999999999", "-9.100", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// Null tests
+	// SKIP (encoding not supported): remx1000 remainder 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): remx1001 remainder  # 10 -> NaN Invalid_operation
+}
@@ -0,0 +1,970 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var remaindernearTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 384
+	// minexponent: -383
+	// rmnx001 remaindernear  1     1    ->  0
+	{"rmnx001", "1", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx002 remaindernear  2     1    ->  0
+	{"rmnx002", "2", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx003 remaindernear  1     2    ->  1
+	{"rmnx003", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx004 remaindernear  2     2    ->  0
+	{"rmnx004", "2", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx005 remaindernear  0     1    ->  0
+	{"rmnx005", "0", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx006 remaindernear  0     2    ->  0
+	{"rmnx006", "0", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx007 remaindernear  1     3    ->  1
+	{"rmnx007", "1", "3", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx008 remaindernear  2     3    -> -1
+	{"rmnx008", "2", "3", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx009 remaindernear  3     3    ->  0
+	{"rmnx009", "3", "3", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx010 remaindernear  2.4   1    ->  0.4
+	{"rmnx010", "2.4", "1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx011 remaindernear  2.4   -1   ->  0.4
+	{"rmnx011", "2.4", "-1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx012 remaindernear  -2.4  1    ->  -0.4
+	{"rmnx012", "-2.4", "1", "-0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx013 remaindernear  -2.4  -1   ->  -0.4
+	{"rmnx013", "-2.4", "-1", "-0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx014 remaindernear  2.40  1    ->  0.40
+	{"rmnx014", "2.40", "1", "0.40", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx015 remaindernear  2.400 1    ->  0.400
+	{"rmnx015", "2.400", "1", "0.400", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx016 remaindernear  2.4   2    ->  0.4
+	{"rmnx016", "2.4", "2", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx017 remaindernear  2.400 2    ->  0.400
+	{"rmnx017", "2.400", "2", "0.400", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx018 remaindernear  2.    2    ->  0
+	{"rmnx018", "2.", "2", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx019 remaindernear  20    20   ->  0
+	{"rmnx019", "20", "20", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx020 remaindernear  187   187    ->  0
+	{"rmnx020", "187", "187", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx021 remaindernear  5     2      ->  1
+	{"rmnx021", "5", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx022 remaindernear  5     2.0    ->  1.0
+	{"rmnx022", "5", "2.0", "1.0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx023 remaindernear  5     2.000  ->  1.000
+	{"rmnx023", "5", "2.000", "1.000", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx024 remaindernear  5     0.200  ->  0.000
+	{"rmnx024", "5", "0.200", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx025 remaindernear  5     0.200  ->  0.000
+	{"rmnx025", "5", "0.200", "0.000", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx030 remaindernear  1     2      ->  1
+	{"rmnx030", "1", "2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx031 remaindernear  1     4      ->  1
+	{"rmnx031", "1", "4", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx032 remaindernear  1     8      ->  1
+	{"rmnx032", "1", "8", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx033 remaindernear  1     16     ->  1
+	{"rmnx033", "1", "16", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx034 remaindernear  1     32     ->  1
+	{"rmnx034", "1", "32", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx035 remaindernear  1     64     ->  1
+	{"rmnx035", "1", "64", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx040 remaindernear  1    -2      ->  1
+	{"rmnx040", "1", "-2", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx041 remaindernear  1    -4      ->  1
+	{"rmnx041", "1", "-4", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx042 remaindernear  1    -8      ->  1
+	{"rmnx042", "1", "-8", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx043 remaindernear  1    -16     ->  1
+	{"rmnx043", "1", "-16", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx044 remaindernear  1    -32     ->  1
+	{"rmnx044", "1", "-32", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx045 remaindernear  1    -64     ->  1
+	{"rmnx045", "1", "-64", "1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx050 remaindernear -1     2      ->  -1
+	{"rmnx050", "-1", "2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx051 remaindernear -1     4      ->  -1
+	{"rmnx051", "-1", "4", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx052 remaindernear -1     8      ->  -1
+	{"rmnx052", "-1", "8", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx053 remaindernear -1     16     ->  -1
+	{"rmnx053", "-1", "16", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx054 remaindernear -1     32     ->  -1
+	{"rmnx054", "-1", "32", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx055 remaindernear -1     64     ->  -1
+	{"rmnx055", "-1", "64", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx060 remaindernear -1    -2      ->  -1
+	{"rmnx060", "-1", "-2", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx061 remaindernear -1    -4      ->  -1
+	{"rmnx061", "-1", "-4", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx062 remaindernear -1    -8      ->  -1
+	{"rmnx062", "-1", "-8", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx063 remaindernear -1    -16     ->  -1
+	{"rmnx063", "-1", "-16", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx064 remaindernear -1    -32     ->  -1
+	{"rmnx064", "-1", "-32", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx065 remaindernear -1    -64     ->  -1
+	{"rmnx065", "-1", "-64", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx066 remaindernear  999999997     1  -> 0
+	{"rmnx066", "999999997", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx067 remaindernear  999999997.4   1  -> 0.4
+	{"rmnx067", "999999997.4", "1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx068 remaindernear  999999997.5   1  -> -0.5
+	{"rmnx068", "999999997.5", "1", "-0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx069 remaindernear  999999997.9   1  -> -0.1
+	{"rmnx069", "999999997.9", "1", "-0.1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx070 remaindernear  999999997.999 1  -> -0.001
+	{"rmnx070", "999999997.999", "1", "-0.001", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx071 remaindernear  999999998     1  -> 0
+	{"rmnx071", "999999998", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx072 remaindernear  999999998.4   1  -> 0.4
+	{"rmnx072", "999999998.4", "1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx073 remaindernear  999999998.5   1  -> 0.5
+	{"rmnx073", "999999998.5", "1", "0.5", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx074 remaindernear  999999998.9   1  -> -0.1
+	{"rmnx074", "999999998.9", "1", "-0.1", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx075 remaindernear  999999998.999 1  -> -0.001
+	{"rmnx075", "999999998.999", "1", "-0.001", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx076 remaindernear  999999999     1  -> 0
+	{"rmnx076", "999999999", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx077 remaindernear  999999999.4   1  -> 0.4
+	{"rmnx077", "999999999.4", "1", "0.4", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx078 remaindernear  999999999.5   1  -> NaN Division_impossible
+	{"rmnx078", "999999999.5", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 384, -383, false},
+	// rmnx079 remaindernear  999999999.9   1  -> NaN Division_impossible
+	{"rmnx079", "999999999.9", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 384, -383, false},
+	// rmnx080 remaindernear  999999999.999 1  -> NaN Division_impossible
+	{"rmnx080", "999999999.999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 384, -383, false},
+	// precision: 6
+	// rmnx081 remaindernear  999999999     1  -> NaN Division_impossible
+	{"rmnx081", "999999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// rmnx082 remaindernear  99999999      1  -> NaN Division_impossible
+	{"rmnx082", "99999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// rmnx083 remaindernear  9999999       1  -> NaN Division_impossible
+	{"rmnx083", "9999999", "1", "NaN", DivisionImpossible, 6, ToNearestAway, 384, -383, false},
+	// rmnx084 remaindernear  999999        1  -> 0
+	{"rmnx084", "999999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// rmnx085 remaindernear  99999         1  -> 0
+	{"rmnx085", "99999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// rmnx086 remaindernear  9999          1  -> 0
+	{"rmnx086", "9999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// rmnx087 remaindernear  999           1  -> 0
+	{"rmnx087", "999", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// rmnx088 remaindernear  99            1  -> 0
+	{"rmnx088", "99", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// rmnx089 remaindernear  9             1  -> 0
+	{"rmnx089", "9", "1", "0", 0, 6, ToNearestAway, 384, -383, false},
+	// precision: 9
+	// rmnx090 remaindernear  0.            1  -> 0
+	{"rmnx090", "0.", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx091 remaindernear  .0            1  -> 0.0
+	{"rmnx091", ".0", "1", "0.0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx092 remaindernear  0.00          1  -> 0.00
+	{"rmnx092", "0.00", "1", "0.00", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx093 remaindernear  0.00E+9       1  -> 0
+	{"rmnx093", "0.00E+9", "1", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// rmnx094 remaindernear  0.0000E-50    1  -> 0E-54
+	{"rmnx094", "0.0000E-50", "1", "0E-54", 0, 9, ToNearestAway, 384, -383, false},
+	// Various flavours of remaindernear by 0
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// rmnx101 remaindernear  0       0   -> NaN Division_undefined
+	{"rmnx101", "0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx102 remaindernear  0      -0   -> NaN Division_undefined
+	{"rmnx102", "0", "-0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx103 remaindernear -0       0   -> NaN Division_undefined
+	{"rmnx103", "-0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx104 remaindernear -0      -0   -> NaN Division_undefined
+	{"rmnx104", "-0", "-0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx105 remaindernear  0.0E5   0   -> NaN Division_undefined
+	{"rmnx105", "0.0E5", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx106 remaindernear  0.000   0   -> NaN Division_undefined
+	{"rmnx106", "0.000", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// [Some think this next group should be Division_by_zero exception,
+	// but IEEE 854 is explicit that it is Invalid operation .. for
+	// remaindernear-near, anyway]
+	// rmnx107 remaindernear  0.0001  0   -> NaN Invalid_operation
+	{"rmnx107", "0.0001", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx108 remaindernear  0.01    0   -> NaN Invalid_operation
+	{"rmnx108", "0.01", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx109 remaindernear  0.1     0   -> NaN Invalid_operation
+	{"rmnx109", "0.1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx110 remaindernear  1       0   -> NaN Invalid_operation
+	{"rmnx110", "1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx111 remaindernear  1       0.0 -> NaN Invalid_operation
+	{"rmnx111", "1", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx112 remaindernear 10       0.0 -> NaN Invalid_operation
+	{"rmnx112", "10", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx113 remaindernear 1E+100   0.0 -> NaN Invalid_operation
+	{"rmnx113", "1E+100", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx114 remaindernear 1E+1000  0   -> NaN Invalid_operation
+	{"rmnx114", "1E+1000", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx115 remaindernear  0.0001 -0   -> NaN Invalid_operation
+	{"rmnx115", "0.0001", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx116 remaindernear  0.01   -0   -> NaN Invalid_operation
+	{"rmnx116", "0.01", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx119 remaindernear  0.1    -0   -> NaN Invalid_operation
+	{"rmnx119", "0.1", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx120 remaindernear  1      -0   -> NaN Invalid_operation
+	{"rmnx120", "1", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx121 remaindernear  1      -0.0 -> NaN Invalid_operation
+	{"rmnx121", "1", "-0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx122 remaindernear 10      -0.0 -> NaN Invalid_operation
+	{"rmnx122", "10", "-0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx123 remaindernear 1E+100  -0.0 -> NaN Invalid_operation
+	{"rmnx123", "1E+100", "-0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx124 remaindernear 1E+1000 -0   -> NaN Invalid_operation
+	{"rmnx124", "1E+1000", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// and zeros on left
+	// rmnx130 remaindernear  0      1   ->  0
+	{"rmnx130", "0", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx131 remaindernear  0     -1   ->  0
+	{"rmnx131", "0", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx132 remaindernear  0.0    1   ->  0.0
+	{"rmnx132", "0.0", "1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx133 remaindernear  0.0   -1   ->  0.0
+	{"rmnx133", "0.0", "-1", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx134 remaindernear -0      1   -> -0
+	{"rmnx134", "-0", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx135 remaindernear -0     -1   -> -0
+	{"rmnx135", "-0", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx136 remaindernear -0.0    1   -> -0.0
+	{"rmnx136", "-0.0", "1", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx137 remaindernear -0.0   -1   -> -0.0
+	{"rmnx137", "-0.0", "-1", "-0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// 0.5ers
+	// rmmx143 remaindernear   0.5  2     ->  0.5
+	{"rmmx143", "0.5", "2", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmmx144 remaindernear   0.5  2.1   ->  0.5
+	{"rmmx144", "0.5", "2.1", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmmx145 remaindernear   0.5  2.01  ->  0.50
+	{"rmmx145", "0.5", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmmx146 remaindernear   0.5  2.001 ->  0.500
+	{"rmmx146", "0.5", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmmx147 remaindernear   0.50 2     ->  0.50
+	{"rmmx147", "0.50", "2", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmmx148 remaindernear   0.50 2.01  ->  0.50
+	{"rmmx148", "0.50", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmmx149 remaindernear   0.50 2.001 ->  0.500
+	{"rmmx149", "0.50", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// some differences from remainder
+	// rmnx150 remaindernear   0.4  1.020 ->  0.400
+	{"rmnx150", "0.4", "1.020", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx151 remaindernear   0.50 1.020 ->  0.500
+	{"rmnx151", "0.50", "1.020", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx152 remaindernear   0.51 1.020 ->  0.510
+	{"rmnx152", "0.51", "1.020", "0.510", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx153 remaindernear   0.52 1.020 -> -0.500
+	{"rmnx153", "0.52", "1.020", "-0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx154 remaindernear   0.6  1.020 -> -0.420
+	{"rmnx154", "0.6", "1.020", "-0.420", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx155 remaindernear   0.49 1     ->  0.49
+	{"rmnx155", "0.49", "1", "0.49", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx156 remaindernear   0.50 1     ->  0.50
+	{"rmnx156", "0.50", "1", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx157 remaindernear   1.50 1     -> -0.50
+	{"rmnx157", "1.50", "1", "-0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx158 remaindernear   2.50 1     ->  0.50
+	{"rmnx158", "2.50", "1", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx159 remaindernear   9.50 1     -> -0.50
+	{"rmnx159", "9.50", "1", "-0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx160 remaindernear   0.51 1     -> -0.49
+	{"rmnx160", "0.51", "1", "-0.49", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// the nasty division-by-1 cases
+	// rmnx161 remaindernear   0.4         1   ->  0.4
+	{"rmnx161", "0.4", "1", "0.4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx162 remaindernear   0.45        1   ->  0.45
+	{"rmnx162", "0.45", "1", "0.45", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx163 remaindernear   0.455       1   ->  0.455
+	{"rmnx163", "0.455", "1", "0.455", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx164 remaindernear   0.4555      1   ->  0.4555
+	{"rmnx164", "0.4555", "1", "0.4555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx165 remaindernear   0.45555     1   ->  0.45555
+	{"rmnx165", "0.45555", "1", "0.45555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx166 remaindernear   0.455555    1   ->  0.455555
+	{"rmnx166", "0.455555", "1", "0.455555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx167 remaindernear   0.4555555   1   ->  0.4555555
+	{"rmnx167", "0.4555555", "1", "0.4555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx168 remaindernear   0.45555555  1   ->  0.45555555
+	{"rmnx168", "0.45555555", "1", "0.45555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx169 remaindernear   0.455555555 1   ->  0.455555555
+	{"rmnx169", "0.455555555", "1", "0.455555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// with spill...
+	// rmnx171 remaindernear   0.5         1   ->  0.5
+	{"rmnx171", "0.5", "1", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx172 remaindernear   0.55        1   -> -0.45
+	{"rmnx172", "0.55", "1", "-0.45", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx173 remaindernear   0.555       1   -> -0.445
+	{"rmnx173", "0.555", "1", "-0.445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx174 remaindernear   0.5555      1   -> -0.4445
+	{"rmnx174", "0.5555", "1", "-0.4445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx175 remaindernear   0.55555     1   -> -0.44445
+	{"rmnx175", "0.55555", "1", "-0.44445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx176 remaindernear   0.555555    1   -> -0.444445
+	{"rmnx176", "0.555555", "1", "-0.444445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx177 remaindernear   0.5555555   1   -> -0.4444445
+	{"rmnx177", "0.5555555", "1", "-0.4444445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx178 remaindernear   0.55555555  1   -> -0.44444445
+	{"rmnx178", "0.55555555", "1", "-0.44444445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx179 remaindernear   0.555555555 1   -> -0.444444445
+	{"rmnx179", "0.555555555", "1", "-0.444444445", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// progression
+	// rmnx180 remaindernear  1  1   -> 0
+	{"rmnx180", "1", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx181 remaindernear  1  2   -> 1
+	{"rmnx181", "1", "2", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx182 remaindernear  1  3   -> 1
+	{"rmnx182", "1", "3", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx183 remaindernear  1  4   -> 1
+	{"rmnx183", "1", "4", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx184 remaindernear  1  5   -> 1
+	{"rmnx184", "1", "5", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx185 remaindernear  1  6   -> 1
+	{"rmnx185", "1", "6", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx186 remaindernear  1  7   -> 1
+	{"rmnx186", "1", "7", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx187 remaindernear  1  8   -> 1
+	{"rmnx187", "1", "8", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx188 remaindernear  1  9   -> 1
+	{"rmnx188", "1", "9", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx189 remaindernear  1  10  -> 1
+	{"rmnx189", "1", "10", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx190 remaindernear  1  1   -> 0
+	{"rmnx190", "1", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx191 remaindernear  2  1   -> 0
+	{"rmnx191", "2", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx192 remaindernear  3  1   -> 0
+	{"rmnx192", "3", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx193 remaindernear  4  1   -> 0
+	{"rmnx193", "4", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx194 remaindernear  5  1   -> 0
+	{"rmnx194", "5", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx195 remaindernear  6  1   -> 0
+	{"rmnx195", "6", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx196 remaindernear  7  1   -> 0
+	{"rmnx196", "7", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx197 remaindernear  8  1   -> 0
+	{"rmnx197", "8", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx198 remaindernear  9  1   -> 0
+	{"rmnx198", "9", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx199 remaindernear  10 1   -> 0
+	{"rmnx199", "10", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// Various flavours of remaindernear by 0
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// rmnx201 remaindernear  0      0   -> NaN Division_undefined
+	{"rmnx201", "0", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx202 remaindernear  0.0E5  0   -> NaN Division_undefined
+	{"rmnx202", "0.0E5", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx203 remaindernear  0.000  0   -> NaN Division_undefined
+	{"rmnx203", "0.000", "0", "NaN", DivisionUndefined, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx204 remaindernear  0.0001 0   -> NaN Invalid_operation
+	{"rmnx204", "0.0001", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx205 remaindernear  0.01   0   -> NaN Invalid_operation
+	{"rmnx205", "0.01", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx206 remaindernear  0.1    0   -> NaN Invalid_operation
+	{"rmnx206", "0.1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx207 remaindernear  1      0   -> NaN Invalid_operation
+	{"rmnx207", "1", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx208 remaindernear  1      0.0 -> NaN Invalid_operation
+	{"rmnx208", "1", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx209 remaindernear 10      0.0 -> NaN Invalid_operation
+	{"rmnx209", "10", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx210 remaindernear 1E+100  0.0 -> NaN Invalid_operation
+	{"rmnx210", "1E+100", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx211 remaindernear 1E+1000 0   -> NaN Invalid_operation
+	{"rmnx211", "1E+1000", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// tests from the extended specification
+	// rmnx221 remaindernear 2.1     3   -> -0.9
+	{"rmnx221", "2.1", "3", "-0.9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx222 remaindernear  10     6   -> -2
+	{"rmnx222", "10", "6", "-2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx223 remaindernear  10     3   ->  1
+	{"rmnx223", "10", "3", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx224 remaindernear -10     3   -> -1
+	{"rmnx224", "-10", "3", "-1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx225 remaindernear  10.2   1   -> 0.2
+	{"rmnx225", "10.2", "1", "0.2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx226 remaindernear  10     0.3 -> 0.1
+	{"rmnx226", "10", "0.3", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx227 remaindernear   3.6   1.3 -> -0.3
+	{"rmnx227", "3.6", "1.3", "-0.3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// some differences from remainder
+	// rmnx231 remaindernear   0.4  1.020 ->  0.400
+	{"rmnx231", "0.4", "1.020", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx232 remaindernear   0.50 1.020 ->  0.500
+	{"rmnx232", "0.50", "1.020", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx233 remaindernear   0.51 1.020 ->  0.510
+	{"rmnx233", "0.51", "1.020", "0.510", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx234 remaindernear   0.52 1.020 -> -0.500
+	{"rmnx234", "0.52", "1.020", "-0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx235 remaindernear   0.6  1.020 -> -0.420
+	{"rmnx235", "0.6", "1.020", "-0.420", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// test some cases that are close to exponent overflow
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// rmnx270 remaindernear 1 1e999999999    -> 1
+	{"rmnx270", "1", "1e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx271 remaindernear 1 0.9e999999999  -> 1
+	{"rmnx271", "1", "0.9e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx272 remaindernear 1 0.99e999999999 -> 1
+	{"rmnx272", "1", "0.99e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx273 remaindernear 1 0.999999999e999999999 -> 1
+	{"rmnx273", "1", "0.999999999e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx274 remaindernear 9e999999999          1 -> NaN Division_impossible
+	{"rmnx274", "9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx275 remaindernear 9.9e999999999        1 -> NaN Division_impossible
+	{"rmnx275", "9.9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx276 remaindernear 9.99e999999999       1 -> NaN Division_impossible
+	{"rmnx276", "9.99e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx277 remaindernear 9.99999999e999999999 1 -> NaN Division_impossible
+	{"rmnx277", "9.99999999e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx280 remaindernear 0.1 9e-999999999       -> NaN Division_impossible
+	{"rmnx280", "0.1", "9e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx281 remaindernear 0.1 99e-999999999      -> NaN Division_impossible
+	{"rmnx281", "0.1", "99e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx282 remaindernear 0.1 999e-999999999     -> NaN Division_impossible
+	{"rmnx282", "0.1", "999e-999999999", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx283 remaindernear 0.1 9e-999999998       -> NaN Division_impossible
+	{"rmnx283", "0.1", "9e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx284 remaindernear 0.1 99e-999999998      -> NaN Division_impossible
+	{"rmnx284", "0.1", "99e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx285 remaindernear 0.1 999e-999999998     -> NaN Division_impossible
+	{"rmnx285", "0.1", "999e-999999998", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx286 remaindernear 0.1 999e-999999997     -> NaN Division_impossible
+	{"rmnx286", "0.1", "999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx287 remaindernear 0.1 9999e-999999997    -> NaN Division_impossible
+	{"rmnx287", "0.1", "9999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx288 remaindernear 0.1 99999e-999999997   -> NaN Division_impossible
+	{"rmnx288", "0.1", "99999e-999999997", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx3xx are from DiagBigDecimal
+	// rmnx301 remaindernear   1    3     ->  1
+	{"rmnx301", "1", "3", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx302 remaindernear   5    5     ->  0
+	{"rmnx302", "5", "5", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx303 remaindernear   13   10    ->  3
+	{"rmnx303", "13", "10", "3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx304 remaindernear   13   50    ->  13
+	{"rmnx304", "13", "50", "13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx305 remaindernear   13   100   ->  13
+	{"rmnx305", "13", "100", "13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx306 remaindernear   13   1000  ->  13
+	{"rmnx306", "13", "1000", "13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx307 remaindernear   .13    1   ->  0.13
+	{"rmnx307", ".13", "1", "0.13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx308 remaindernear   0.133  1   ->  0.133
+	{"rmnx308", "0.133", "1", "0.133", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx309 remaindernear   0.1033 1   ->  0.1033
+	{"rmnx309", "0.1033", "1", "0.1033", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx310 remaindernear   1.033  1   ->  0.033
+	{"rmnx310", "1.033", "1", "0.033", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx311 remaindernear   10.33  1   ->  0.33
+	{"rmnx311", "10.33", "1", "0.33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx312 remaindernear   10.33 10   ->  0.33
+	{"rmnx312", "10.33", "10", "0.33", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx313 remaindernear   103.3  1   ->  0.3
+	{"rmnx313", "103.3", "1", "0.3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx314 remaindernear   133   10   ->  3
+	{"rmnx314", "133", "10", "3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx315 remaindernear   1033  10   ->  3
+	{"rmnx315", "1033", "10", "3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx316 remaindernear   1033  50   -> -17
+	{"rmnx316", "1033", "50", "-17", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx317 remaindernear   101.0  3   -> -1.0
+	{"rmnx317", "101.0", "3", "-1.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx318 remaindernear   102.0  3   ->  0.0
+	{"rmnx318", "102.0", "3", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx319 remaindernear   103.0  3   ->  1.0
+	{"rmnx319", "103.0", "3", "1.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx320 remaindernear   2.40   1   ->  0.40
+	{"rmnx320", "2.40", "1", "0.40", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx321 remaindernear   2.400  1   ->  0.400
+	{"rmnx321", "2.400", "1", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx322 remaindernear   2.4    1   ->  0.4
+	{"rmnx322", "2.4", "1", "0.4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx323 remaindernear   2.4    2   ->  0.4
+	{"rmnx323", "2.4", "2", "0.4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx324 remaindernear   2.400  2   ->  0.400
+	{"rmnx324", "2.400", "2", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx325 remaindernear   1   0.3    ->  0.1
+	{"rmnx325", "1", "0.3", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx326 remaindernear   1   0.30   ->  0.10
+	{"rmnx326", "1", "0.30", "0.10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx327 remaindernear   1   0.300  ->  0.100
+	{"rmnx327", "1", "0.300", "0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx328 remaindernear   1   0.3000 ->  0.1000
+	{"rmnx328", "1", "0.3000", "0.1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx329 remaindernear   1.0    0.3 ->  0.1
+	{"rmnx329", "1.0", "0.3", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx330 remaindernear   1.00   0.3 ->  0.10
+	{"rmnx330", "1.00", "0.3", "0.10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx331 remaindernear   1.000  0.3 ->  0.100
+	{"rmnx331", "1.000", "0.3", "0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx332 remaindernear   1.0000 0.3 ->  0.1000
+	{"rmnx332", "1.0000", "0.3", "0.1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx333 remaindernear   0.5  2     ->  0.5
+	{"rmnx333", "0.5", "2", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx334 remaindernear   0.5  2.1   ->  0.5
+	{"rmnx334", "0.5", "2.1", "0.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx335 remaindernear   0.5  2.01  ->  0.50
+	{"rmnx335", "0.5", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx336 remaindernear   0.5  2.001 ->  0.500
+	{"rmnx336", "0.5", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx337 remaindernear   0.50 2     ->  0.50
+	{"rmnx337", "0.50", "2", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx338 remaindernear   0.50 2.01  ->  0.50
+	{"rmnx338", "0.50", "2.01", "0.50", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx339 remaindernear   0.50 2.001 ->  0.500
+	{"rmnx339", "0.50", "2.001", "0.500", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx340 remaindernear   0.5   0.5000001    ->  -1E-7
+	{"rmnx340", "0.5", "0.5000001", "-1E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx341 remaindernear   0.5   0.50000001    ->  -1E-8
+	{"rmnx341", "0.5", "0.50000001", "-1E-8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx342 remaindernear   0.5   0.500000001    ->  -1E-9
+	{"rmnx342", "0.5", "0.500000001", "-1E-9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx343 remaindernear   0.5   0.5000000001    ->  -1E-10
+	{"rmnx343", "0.5", "0.5000000001", "-1E-10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx344 remaindernear   0.5   0.50000000001    ->  -1E-11
+	{"rmnx344", "0.5", "0.50000000001", "-1E-11", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx345 remaindernear   0.5   0.4999999    ->  1E-7
+	{"rmnx345", "0.5", "0.4999999", "1E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx346 remaindernear   0.5   0.49999999    ->  1E-8
+	{"rmnx346", "0.5", "0.49999999", "1E-8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx347 remaindernear   0.5   0.499999999    ->  1E-9
+	{"rmnx347", "0.5", "0.499999999", "1E-9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx348 remaindernear   0.5   0.4999999999    ->  1E-10
+	{"rmnx348", "0.5", "0.4999999999", "1E-10", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx349 remaindernear   0.5   0.49999999999    ->  1E-11
+	{"rmnx349", "0.5", "0.49999999999", "1E-11", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx350 remaindernear   0.03  7  ->  0.03
+	{"rmnx350", "0.03", "7", "0.03", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx351 remaindernear   5   2    ->  1
+	{"rmnx351", "5", "2", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx352 remaindernear   4.1   2    ->  0.1
+	{"rmnx352", "4.1", "2", "0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx353 remaindernear   4.01   2    ->  0.01
+	{"rmnx353", "4.01", "2", "0.01", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx354 remaindernear   4.001   2    ->  0.001
+	{"rmnx354", "4.001", "2", "0.001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx355 remaindernear   4.0001   2    ->  0.0001
+	{"rmnx355", "4.0001", "2", "0.0001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx356 remaindernear   4.00001   2    ->  0.00001
+	{"rmnx356", "4.00001", "2", "0.00001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx357 remaindernear   4.000001   2    ->  0.000001
+	{"rmnx357", "4.000001", "2", "0.000001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx358 remaindernear   4.0000001   2    ->  1E-7
+	{"rmnx358", "4.0000001", "2", "1E-7", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx360 remaindernear   1.2   0.7345 -> -0.2690
+	{"rmnx360", "1.2", "0.7345", "-0.2690", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx361 remaindernear   0.8   12     ->  0.8
+	{"rmnx361", "0.8", "12", "0.8", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx362 remaindernear   0.8   0.2    ->  0.0
+	{"rmnx362", "0.8", "0.2", "0.0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx363 remaindernear   0.8   0.3    -> -0.1
+	{"rmnx363", "0.8", "0.3", "-0.1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx364 remaindernear   0.800   12   ->  0.800
+	{"rmnx364", "0.800", "12", "0.800", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx365 remaindernear   0.800   1.7  ->  0.800
+	{"rmnx365", "0.800", "1.7", "0.800", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx366 remaindernear   2.400   2    ->  0.400
+	{"rmnx366", "2.400", "2", "0.400", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// precision: 6
+	// rmnx371 remaindernear   2.400  2        ->  0.400
+	{"rmnx371", "2.400", "2", "0.400", 0, 6, ToNearestAway, 999999999, -999999999, false},
+	// precision: 3
+	// rmnx372 remaindernear   12345678900000 12e+12 -> 3.46E+11 Inexact Rounded
+	{"rmnx372", "12345678900000", "12e+12", "3.46E+11", Inexact | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// precision: 5
+	// rmnx381 remaindernear 12345  1         ->  0
+	{"rmnx381", "12345", "1", "0", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx382 remaindernear 12345  1.0001    -> -0.2344
+	{"rmnx382", "12345", "1.0001", "-0.2344", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx383 remaindernear 12345  1.001     -> -0.333
+	{"rmnx383", "12345", "1.001", "-0.333", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx384 remaindernear 12345  1.01      -> -0.23
+	{"rmnx384", "12345", "1.01", "-0.23", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx385 remaindernear 12345  1.1       -> -0.3
+	{"rmnx385", "12345", "1.1", "-0.3", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx386 remaindernear 12355  4         -> -1
+	{"rmnx386", "12355", "4", "-1", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx387 remaindernear 12345  4         ->  1
+	{"rmnx387", "12345", "4", "1", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx388 remaindernear 12355  4.0001    -> -1.3089
+	{"rmnx388", "12355", "4.0001", "-1.3089", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx389 remaindernear 12345  4.0001    ->  0.6914
+	{"rmnx389", "12345", "4.0001", "0.6914", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx390 remaindernear 12345  4.9       ->  1.9
+	{"rmnx390", "12345", "4.9", "1.9", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx391 remaindernear 12345  4.99      -> -0.26
+	{"rmnx391", "12345", "4.99", "-0.26", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx392 remaindernear 12345  4.999     ->  2.469
+	{"rmnx392", "12345", "4.999", "2.469", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx393 remaindernear 12345  4.9999    ->  0.2469
+	{"rmnx393", "12345", "4.9999", "0.2469", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx394 remaindernear 12345  5         ->  0
+	{"rmnx394", "12345", "5", "0", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx395 remaindernear 12345  5.0001    -> -0.2469
+	{"rmnx395", "12345", "5.0001", "-0.2469", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx396 remaindernear 12345  5.001     -> -2.469
+	{"rmnx396", "12345", "5.001", "-2.469", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx397 remaindernear 12345  5.01      ->  0.36
+	{"rmnx397", "12345", "5.01", "0.36", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// rmnx398 remaindernear 12345  5.1       -> -2.1
+	{"rmnx398", "12345", "5.1", "-2.1", 0, 5, ToNearestAway, 999999999, -999999999, false},
+	// precision: 9
+	// some nasty division-by-1 cases [some similar above]
+	// rmnx401 remaindernear   0.4         1   ->  0.4
+	{"rmnx401", "0.4", "1", "0.4", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx402 remaindernear   0.45        1   ->  0.45
+	{"rmnx402", "0.45", "1", "0.45", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx403 remaindernear   0.455       1   ->  0.455
+	{"rmnx403", "0.455", "1", "0.455", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx404 remaindernear   0.4555      1   ->  0.4555
+	{"rmnx404", "0.4555", "1", "0.4555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx405 remaindernear   0.45555     1   ->  0.45555
+	{"rmnx405", "0.45555", "1", "0.45555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx406 remaindernear   0.455555    1   ->  0.455555
+	{"rmnx406", "0.455555", "1", "0.455555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx407 remaindernear   0.4555555   1   ->  0.4555555
+	{"rmnx407", "0.4555555", "1", "0.4555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx408 remaindernear   0.45555555  1   ->  0.45555555
+	{"rmnx408", "0.45555555", "1", "0.45555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx409 remaindernear   0.455555555 1   ->  0.455555555
+	{"rmnx409", "0.455555555", "1", "0.455555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// some tricky LHSs
+	// rmnx420 remaindernear   99999999.999999999   1E+8   -> -1E-9
+	{"rmnx420", "99999999.999999999", "1E+8", "-1E-9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx421 remaindernear  999999999.999999999   1E+9   -> -1E-9
+	{"rmnx421", "999999999.999999999", "1E+9", "-1E-9", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// precision: 9
+	// rmnx430 remaindernear   0.455555555 1   ->  0.455555555
+	{"rmnx430", "0.455555555", "1", "0.455555555", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// precision: 8
+	// rmnx431 remaindernear   0.455555555 1   ->  0.45555556 Inexact Rounded
+	{"rmnx431", "0.455555555", "1", "0.45555556", Inexact | Rounded, 8, ToNearestAway, 999999999, -999999999, false},
+	// precision: 7
+	// rmnx432 remaindernear   0.455555555 1   ->  0.4555556  Inexact Rounded
+	{"rmnx432", "0.455555555", "1", "0.4555556", Inexact | Rounded, 7, ToNearestAway, 999999999, -999999999, false},
+	// precision: 6
+	// rmnx433 remaindernear   0.455555555 1   ->  0.455556   Inexact Rounded
+	{"rmnx433", "0.455555555", "1", "0.455556", Inexact | Rounded, 6, ToNearestAway, 999999999, -999999999, false},
+	// precision: 5
+	// rmnx434 remaindernear   0.455555555 1   ->  0.45556    Inexact Rounded
+	{"rmnx434", "0.455555555", "1", "0.45556", Inexact | Rounded, 5, ToNearestAway, 999999999, -999999999, false},
+	// precision: 4
+	// rmnx435 remaindernear   0.455555555 1   ->  0.4556     Inexact Rounded
+	{"rmnx435", "0.455555555", "1", "0.4556", Inexact | Rounded, 4, ToNearestAway, 999999999, -999999999, false},
+	// precision: 3
+	// rmnx436 remaindernear   0.455555555 1   ->  0.456      Inexact Rounded
+	{"rmnx436", "0.455555555", "1", "0.456", Inexact | Rounded, 3, ToNearestAway, 999999999, -999999999, false},
+	// precision: 2
+	// rmnx437 remaindernear   0.455555555 1   ->  0.46       Inexact Rounded
+	{"rmnx437", "0.455555555", "1", "0.46", Inexact | Rounded, 2, ToNearestAway, 999999999, -999999999, false},
+	// precision: 1
+	// rmnx438 remaindernear   0.455555555 1   ->  0.5        Inexact Rounded
+	{"rmnx438", "0.455555555", "1", "0.5", Inexact | Rounded, 1, ToNearestAway, 999999999, -999999999, false},
+	// early tests; from text descriptions
+	// precision: 9
+	// rmnx601 remaindernear  10   6  -> -2
+	{"rmnx601", "10", "6", "-2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx602 remaindernear -10   6  -> 2
+	{"rmnx602", "-10", "6", "2", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx603 remaindernear  11   3  -> -1
+	{"rmnx603", "11", "3", "-1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx604 remaindernear  11   5  -> 1
+	{"rmnx604", "11", "5", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx605 remaindernear   7.7 8  -> -0.3
+	{"rmnx605", "7.7", "8", "-0.3", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx606 remaindernear  31.5 3  -> 1.5    -- i=10
+	{"rmnx606", "31.5", "3", "1.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx607 remaindernear  34.5 3  -> -1.5   -- i=11
+	{"rmnx607", "34.5", "3", "-1.5", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// zero signs
+	// rmnx650 remaindernear  1  1 ->  0
+	{"rmnx650", "1", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx651 remaindernear -1  1 -> -0
+	{"rmnx651", "-1", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx652 remaindernear  1 -1 ->  0
+	{"rmnx652", "1", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx653 remaindernear -1 -1 -> -0
+	{"rmnx653", "-1", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx654 remaindernear  0  1 ->  0
+	{"rmnx654", "0", "1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx655 remaindernear -0  1 -> -0
+	{"rmnx655", "-0", "1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx656 remaindernear  0 -1 ->  0
+	{"rmnx656", "0", "-1", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx657 remaindernear -0 -1 -> -0
+	{"rmnx657", "-0", "-1", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx658 remaindernear  0.00  1  ->  0.00
+	{"rmnx658", "0.00", "1", "0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx659 remaindernear -0.00  1  -> -0.00
+	{"rmnx659", "-0.00", "1", "-0.00", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// Specials
+	// rmnx680 remaindernear  Inf  -Inf   ->  NaN Invalid_operation
+	{"rmnx680", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx681 remaindernear  Inf  -1000  ->  NaN Invalid_operation
+	{"rmnx681", "Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx682 remaindernear  Inf  -1     ->  NaN Invalid_operation
+	{"rmnx682", "Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx683 remaindernear  Inf   0     ->  NaN Invalid_operation
+	{"rmnx683", "Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx684 remaindernear  Inf  -0     ->  NaN Invalid_operation
+	{"rmnx684", "Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx685 remaindernear  Inf   1     ->  NaN Invalid_operation
+	{"rmnx685", "Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx686 remaindernear  Inf   1000  ->  NaN Invalid_operation
+	{"rmnx686", "Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx687 remaindernear  Inf   Inf   ->  NaN Invalid_operation
+	{"rmnx687", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx688 remaindernear -1000  Inf   -> -1000
+	{"rmnx688", "-1000", "Inf", "-1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx689 remaindernear -Inf   Inf   ->  NaN Invalid_operation
+	{"rmnx689", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx691 remaindernear -1     Inf   -> -1
+	{"rmnx691", "-1", "Inf", "-1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx692 remaindernear  0     Inf   ->  0
+	{"rmnx692", "0", "Inf", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx693 remaindernear -0     Inf   -> -0
+	{"rmnx693", "-0", "Inf", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx694 remaindernear  1     Inf   ->  1
+	{"rmnx694", "1", "Inf", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx695 remaindernear  1000  Inf   ->  1000
+	{"rmnx695", "1000", "Inf", "1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx696 remaindernear  Inf   Inf   ->  NaN Invalid_operation
+	{"rmnx696", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx700 remaindernear -Inf  -Inf   ->  NaN Invalid_operation
+	{"rmnx700", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx701 remaindernear -Inf  -1000  ->  NaN Invalid_operation
+	{"rmnx701", "-Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx702 remaindernear -Inf  -1     ->  NaN Invalid_operation
+	{"rmnx702", "-Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx703 remaindernear -Inf  -0     ->  NaN Invalid_operation
+	{"rmnx703", "-Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx704 remaindernear -Inf   0     ->  NaN Invalid_operation
+	{"rmnx704", "-Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx705 remaindernear -Inf   1     ->  NaN Invalid_operation
+	{"rmnx705", "-Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx706 remaindernear -Inf   1000  ->  NaN Invalid_operation
+	{"rmnx706", "-Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx707 remaindernear -Inf   Inf   ->  NaN Invalid_operation
+	{"rmnx707", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx708 remaindernear -Inf  -Inf   ->  NaN Invalid_operation
+	{"rmnx708", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx709 remaindernear -1000  Inf   -> -1000
+	{"rmnx709", "-1000", "Inf", "-1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx710 remaindernear -1    -Inf   -> -1
+	{"rmnx710", "-1", "-Inf", "-1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx711 remaindernear -0    -Inf   -> -0
+	{"rmnx711", "-0", "-Inf", "-0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx712 remaindernear  0    -Inf   ->  0
+	{"rmnx712", "0", "-Inf", "0", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx713 remaindernear  1    -Inf   ->  1
+	{"rmnx713", "1", "-Inf", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx714 remaindernear  1000 -Inf   ->  1000
+	{"rmnx714", "1000", "-Inf", "1000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx715 remaindernear  Inf  -Inf   ->  NaN Invalid_operation
+	{"rmnx715", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx721 remaindernear  NaN -Inf    ->  NaN
+	{"rmnx721", "NaN", "-Inf", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx722 remaindernear  NaN -1000   ->  NaN
+	{"rmnx722", "NaN", "-1000", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx723 remaindernear  NaN -1      ->  NaN
+	{"rmnx723", "NaN", "-1", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx724 remaindernear  NaN -0      ->  NaN
+	{"rmnx724", "NaN", "-0", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx725 remaindernear  NaN  0      ->  NaN
+	{"rmnx725", "NaN", "0", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx726 remaindernear  NaN  1      ->  NaN
+	{"rmnx726", "NaN", "1", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx727 remaindernear  NaN  1000   ->  NaN
+	{"rmnx727", "NaN", "1000", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx728 remaindernear  NaN  Inf    ->  NaN
+	{"rmnx728", "NaN", "Inf", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx729 remaindernear  NaN  NaN    ->  NaN
+	{"rmnx729", "NaN", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx730 remaindernear -Inf  NaN    ->  NaN
+	{"rmnx730", "-Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx731 remaindernear -1000 NaN    ->  NaN
+	{"rmnx731", "-1000", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx732 remaindernear -1   -NaN    -> -NaN
+	{"rmnx732", "-1", "-NaN", "-NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx733 remaindernear -0    NaN    ->  NaN
+	{"rmnx733", "-0", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx734 remaindernear  0    NaN    ->  NaN
+	{"rmnx734", "0", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx735 remaindernear  1    NaN    ->  NaN
+	{"rmnx735", "1", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx736 remaindernear  1000 NaN    ->  NaN
+	{"rmnx736", "1000", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx737 remaindernear  Inf  NaN    ->  NaN
+	{"rmnx737", "Inf", "NaN", "NaN", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx741 remaindernear  sNaN -Inf   ->  NaN  Invalid_operation
+	{"rmnx741", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx742 remaindernear  sNaN -1000  ->  NaN  Invalid_operation
+	{"rmnx742", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx743 remaindernear -sNaN -1     -> -NaN  Invalid_operation
+	{"rmnx743", "-sNaN", "-1", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx744 remaindernear  sNaN -0     ->  NaN  Invalid_operation
+	{"rmnx744", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx745 remaindernear  sNaN  0     ->  NaN  Invalid_operation
+	{"rmnx745", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx746 remaindernear  sNaN  1     ->  NaN  Invalid_operation
+	{"rmnx746", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx747 remaindernear  sNaN  1000  ->  NaN  Invalid_operation
+	{"rmnx747", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx749 remaindernear  sNaN  NaN   ->  NaN  Invalid_operation
+	{"rmnx749", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx750 remaindernear  sNaN sNaN   ->  NaN  Invalid_operation
+	{"rmnx750", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx751 remaindernear  NaN  sNaN   ->  NaN  Invalid_operation
+	{"rmnx751", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx752 remaindernear -Inf  sNaN   ->  NaN  Invalid_operation
+	{"rmnx752", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx753 remaindernear -1000 sNaN   ->  NaN  Invalid_operation
+	{"rmnx753", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx754 remaindernear -1    sNaN   ->  NaN  Invalid_operation
+	{"rmnx754", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx755 remaindernear -0   -sNaN   -> -NaN  Invalid_operation
+	{"rmnx755", "-0", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx756 remaindernear  0    sNaN   ->  NaN  Invalid_operation
+	{"rmnx756", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx757 remaindernear  1    sNaN   ->  NaN  Invalid_operation
+	{"rmnx757", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx758 remaindernear  1000 sNaN   ->  NaN  Invalid_operation
+	{"rmnx758", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx759 remaindernear  Inf  sNaN   ->  NaN  Invalid_operation
+	{"rmnx759", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx760 remaindernear  NaN  sNaN   ->  NaN  Invalid_operation
+	{"rmnx760", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// propaging NaNs
+	// rmnx761 remaindernear  NaN1   NaN7   ->  NaN1
+	{"rmnx761", "NaN1", "NaN7", "NaN1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx762 remaindernear sNaN2   NaN8   ->  NaN2 Invalid_operation
+	{"rmnx762", "sNaN2", "NaN8", "NaN2", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx763 remaindernear  NaN3 -sNaN9   -> -NaN9 Invalid_operation
+	{"rmnx763", "NaN3", "-sNaN9", "-NaN9", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx764 remaindernear sNaN4  sNaN10  ->  NaN4 Invalid_operation
+	{"rmnx764", "sNaN4", "sNaN10", "NaN4", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx765 remaindernear    15   NaN11  ->  NaN11
+	{"rmnx765", "15", "NaN11", "NaN11", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx766 remaindernear  NaN6   NaN12  ->  NaN6
+	{"rmnx766", "NaN6", "NaN12", "NaN6", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx767 remaindernear  Inf   -NaN13  -> -NaN13
+	{"rmnx767", "Inf", "-NaN13", "-NaN13", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx768 remaindernear  NaN14  -Inf   ->  NaN14
+	{"rmnx768", "NaN14", "-Inf", "NaN14", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx769 remaindernear    0    NaN15  ->  NaN15
+	{"rmnx769", "0", "NaN15", "NaN15", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx770 remaindernear -NaN16   -0    -> -NaN16
+	{"rmnx770", "-NaN16", "-0", "-NaN16", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// test some cases that are close to exponent overflow
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// rmnx780 remaindernear 1 1e999999999    -> 1
+	{"rmnx780", "1", "1e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx781 remaindernear 1 0.9e999999999  -> 1
+	{"rmnx781", "1", "0.9e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx782 remaindernear 1 0.99e999999999 -> 1
+	{"rmnx782", "1", "0.99e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx783 remaindernear 1 0.999999999e999999999 -> 1
+	{"rmnx783", "1", "0.999999999e999999999", "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx784 remaindernear 9e999999999          1 -> NaN Division_impossible
+	{"rmnx784", "9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx785 remaindernear 9.9e999999999        1 -> NaN Division_impossible
+	{"rmnx785", "9.9e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx786 remaindernear 9.99e999999999       1 -> NaN Division_impossible
+	{"rmnx786", "9.99e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx787 remaindernear 9.99999999e999999999 1 -> NaN Division_impossible
+	{"rmnx787", "9.99999999e999999999", "1", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// overflow and underflow tests [from divide]
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// rmnx790 remaindernear +1.23456789012345E-0 9E+999999999 -> 1.23456789 Inexact Rounded
+	{"rmnx790", "+1.23456789012345E-0", "9E+999999999", "1.23456789", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx791 remaindernear 9E+999999999 +0.23456789012345E-0 -> NaN Division_impossible
+	{"rmnx791", "9E+999999999", "+0.23456789012345E-0", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx792 remaindernear +0.100 9E+999999999               -> 0.100
+	{"rmnx792", "+0.100", "9E+999999999", "0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx793 remaindernear 9E-999999999 +9.100               -> 9E-999999999
+	{"rmnx793", "9E-999999999", "+9.100", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx795 remaindernear -1.23456789012345E-0 9E+999999999 -> -1.23456789 Inexact Rounded
+	{"rmnx795", "-1.23456789012345E-0", "9E+999999999", "-1.23456789", Inexact | Rounded, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx796 remaindernear 9E+999999999 -0.83456789012345E-0 -> NaN Division_impossible
+	{"rmnx796", "9E+999999999", "-0.83456789012345E-0", "NaN", DivisionImpossible, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx797 remaindernear -0.100 9E+999999999               -> -0.100
+	{"rmnx797", "-0.100", "9E+999999999", "-0.100", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// rmnx798 remaindernear 9E-999999999 -9.100               -> 9E-999999999
+	{"rmnx798", "9E-999999999", "-9.100", "9E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// long operands checks
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// rmnx801 remaindernear 12345678000 100 -> 0
+	{"rmnx801", "12345678000", "100", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx802 remaindernear 1 12345678000   -> 1
+	{"rmnx802", "1", "12345678000", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx803 remaindernear 1234567800  10  -> 0
+	{"rmnx803", "1234567800", "10", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx804 remaindernear 1 1234567800    -> 1
+	{"rmnx804", "1", "1234567800", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx805 remaindernear 1234567890  10  -> 0
+	{"rmnx805", "1234567890", "10", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx806 remaindernear 1 1234567890    -> 1
+	{"rmnx806", "1", "1234567890", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx807 remaindernear 1234567891  10  -> 1
+	{"rmnx807", "1234567891", "10", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx808 remaindernear 1 1234567891    -> 1
+	{"rmnx808", "1", "1234567891", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx809 remaindernear 12345678901 100 -> 1
+	{"rmnx809", "12345678901", "100", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx810 remaindernear 1 12345678901   -> 1
+	{"rmnx810", "1", "12345678901", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx811 remaindernear 1234567896  10  -> -4
+	{"rmnx811", "1234567896", "10", "-4", 0, 9, ToNearestAway, 999, -999, false},
+	// rmnx812 remaindernear 1 1234567896    -> 1
+	{"rmnx812", "1", "1234567896", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// rmnx841 remaindernear 12345678000 100 -> 0
+	{"rmnx841", "12345678000", "100", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx842 remaindernear 1 12345678000   -> 1
+	{"rmnx842", "1", "12345678000", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx843 remaindernear 1234567800  10  -> 0
+	{"rmnx843", "1234567800", "10", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx844 remaindernear 1 1234567800    -> 1
+	{"rmnx844", "1", "1234567800", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx845 remaindernear 1234567890  10  -> 0
+	{"rmnx845", "1234567890", "10", "0", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx846 remaindernear 1 1234567890    -> 1
+	{"rmnx846", "1", "1234567890", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx847 remaindernear 1234567891  10  -> 1
+	{"rmnx847", "1234567891", "10", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx848 remaindernear 1 1234567891    -> 1
+	{"rmnx848", "1", "1234567891", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx849 remaindernear 12345678901 100 -> 1
+	{"rmnx849", "12345678901", "100", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx850 remaindernear 1 12345678901   -> 1
+	{"rmnx850", "1", "12345678901", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx851 remaindernear 1234567896  10  -> -4
+	{"rmnx851", "1234567896", "10", "-4", 0, 15, ToNearestAway, 999, -999, false},
+	// rmnx852 remaindernear 1 1234567896    -> 1
+	{"rmnx852", "1", "1234567896", "1", 0, 15, ToNearestAway, 999, -999, false},
+	// Null tests
+	// SKIP (encoding not supported): rmnx900 remaindernear 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): rmnx901 remaindernear  # 10 -> NaN Invalid_operation
+}
@@ -0,0 +1,1221 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var rescaleTests = []struct {
+	id    string
+	in    string
+	scale int32
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// [obsolete]   Quantize.decTest has the improved version
+	// 2004.03.15 Underflow for quantize is suppressed
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// sanity checks
+	// resx001 rescale 0       0   -> 0
+	{"resx001", "0", 0, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx002 rescale 1       0   -> 1
+	{"resx002", "1", 0, "1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx003 rescale 0.1    +2   -> 0E+2 Inexact Rounded
+	{"resx003", "0.1", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx005 rescale 0.1    +1   -> 0E+1 Inexact Rounded
+	{"resx005", "0.1", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx006 rescale 0.1     0   -> 0 Inexact Rounded
+	{"resx006", "0.1", 0, "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx007 rescale 0.1    -1   -> 0.1
+	{"resx007", "0.1", 1, "0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx008 rescale 0.1    -2   -> 0.10
+	{"resx008", "0.1", 2, "0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// resx009 rescale 0.1    -3   -> 0.100
+	{"resx009", "0.1", 3, "0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// resx010 rescale 0.9    +2   -> 0E+2 Inexact Rounded
+	{"resx010", "0.9", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx011 rescale 0.9    +1   -> 0E+1 Inexact Rounded
+	{"resx011", "0.9", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx012 rescale 0.9    +0   -> 1 Inexact Rounded
+	{"resx012", "0.9", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx013 rescale 0.9    -1   -> 0.9
+	{"resx013", "0.9", 1, "0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// resx014 rescale 0.9    -2   -> 0.90
+	{"resx014", "0.9", 2, "0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// resx015 rescale 0.9    -3   -> 0.900
+	{"resx015", "0.9", 3, "0.900", 0, 9, ToNearestAway, 999, -999, false},
+	// negatives
+	// resx021 rescale -0      0   -> -0
+	{"resx021", "-0", 0, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx022 rescale -1      0   -> -1
+	{"resx022", "-1", 0, "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx023 rescale -0.1   +2   -> -0E+2 Inexact Rounded
+	{"resx023", "-0.1", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx025 rescale -0.1   +1   -> -0E+1 Inexact Rounded
+	{"resx025", "-0.1", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx026 rescale -0.1    0   -> -0 Inexact Rounded
+	{"resx026", "-0.1", 0, "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx027 rescale -0.1   -1   -> -0.1
+	{"resx027", "-0.1", 1, "-0.1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx028 rescale -0.1   -2   -> -0.10
+	{"resx028", "-0.1", 2, "-0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// resx029 rescale -0.1   -3   -> -0.100
+	{"resx029", "-0.1", 3, "-0.100", 0, 9, ToNearestAway, 999, -999, false},
+	// resx030 rescale -0.9   +2   -> -0E+2 Inexact Rounded
+	{"resx030", "-0.9", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx031 rescale -0.9   +1   -> -0E+1 Inexact Rounded
+	{"resx031", "-0.9", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx032 rescale -0.9   +0   -> -1 Inexact Rounded
+	{"resx032", "-0.9", 0, "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx033 rescale -0.9   -1   -> -0.9
+	{"resx033", "-0.9", 1, "-0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// resx034 rescale -0.9   -2   -> -0.90
+	{"resx034", "-0.9", 2, "-0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// resx035 rescale -0.9   -3   -> -0.900
+	{"resx035", "-0.9", 3, "-0.900", 0, 9, ToNearestAway, 999, -999, false},
+	// resx036 rescale -0.5   +2   -> -0E+2 Inexact Rounded
+	{"resx036", "-0.5", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx037 rescale -0.5   +1   -> -0E+1 Inexact Rounded
+	{"resx037", "-0.5", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx038 rescale -0.5   +0   -> -1 Inexact Rounded
+	{"resx038", "-0.5", 0, "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx039 rescale -0.5   -1   -> -0.5
+	{"resx039", "-0.5", 1, "-0.5", 0, 9, ToNearestAway, 999, -999, false},
+	// resx040 rescale -0.5   -2   -> -0.50
+	{"resx040", "-0.5", 2, "-0.50", 0, 9, ToNearestAway, 999, -999, false},
+	// resx041 rescale -0.5   -3   -> -0.500
+	{"resx041", "-0.5", 3, "-0.500", 0, 9, ToNearestAway, 999, -999, false},
+	// resx042 rescale -0.9   +2   -> -0E+2 Inexact Rounded
+	{"resx042", "-0.9", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx043 rescale -0.9   +1   -> -0E+1 Inexact Rounded
+	{"resx043", "-0.9", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx044 rescale -0.9   +0   -> -1 Inexact Rounded
+	{"resx044", "-0.9", 0, "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx045 rescale -0.9   -1   -> -0.9
+	{"resx045", "-0.9", 1, "-0.9", 0, 9, ToNearestAway, 999, -999, false},
+	// resx046 rescale -0.9   -2   -> -0.90
+	{"resx046", "-0.9", 2, "-0.90", 0, 9, ToNearestAway, 999, -999, false},
+	// resx047 rescale -0.9   -3   -> -0.900
+	{"resx047", "-0.9", 3, "-0.900", 0, 9, ToNearestAway, 999, -999, false},
+	// examples from Specification
+	// resx060 rescale 2.17   -3   -> 2.170
+	{"resx060", "2.17", 3, "2.170", 0, 9, ToNearestAway, 999, -999, false},
+	// resx061 rescale 2.17   -2   -> 2.17
+	{"resx061", "2.17", 2, "2.17", 0, 9, ToNearestAway, 999, -999, false},
+	// resx062 rescale 2.17   -1   -> 2.2 Inexact Rounded
+	{"resx062", "2.17", 1, "2.2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx063 rescale 2.17    0   -> 2 Inexact Rounded
+	{"resx063", "2.17", 0, "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx064 rescale 2.17   +1   -> 0E+1 Inexact Rounded
+	{"resx064", "2.17", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx065 rescale 2      Inf  -> NaN Invalid_operation
+	// resx066 rescale -0.1    0   -> -0 Inexact Rounded
+	{"resx066", "-0.1", 0, "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx067 rescale -0      5   -> -0E+5
+	{"resx067", "-0", -5, "-0E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// resx068 rescale +35236450.6 -2 -> NaN Invalid_operation
+	{"resx068", "+35236450.6", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx069 rescale -35236450.6 -2 -> NaN Invalid_operation
+	{"resx069", "-35236450.6", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx070 rescale 217    -1   -> 217.0
+	{"resx070", "217", 1, "217.0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx071 rescale 217     0   -> 217
+	{"resx071", "217", 0, "217", 0, 9, ToNearestAway, 999, -999, false},
+	// resx072 rescale 217    +1   -> 2.2E+2 Inexact Rounded
+	{"resx072", "217", -1, "2.2E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx073 rescale 217    +2   -> 2E+2 Inexact Rounded
+	{"resx073", "217", -2, "2E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// general tests ..
+	// resx089 rescale 12     +4   -> 0E+4 Inexact Rounded
+	{"resx089", "12", -4, "0E+4", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx090 rescale 12     +3   -> 0E+3 Inexact Rounded
+	{"resx090", "12", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx091 rescale 12     +2   -> 0E+2 Inexact Rounded
+	{"resx091", "12", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx092 rescale 12     +1   -> 1E+1 Inexact Rounded
+	{"resx092", "12", -1, "1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx093 rescale 1.2345 -2   -> 1.23 Inexact Rounded
+	{"resx093", "1.2345", 2, "1.23", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx094 rescale 1.2355 -2   -> 1.24 Inexact Rounded
+	{"resx094", "1.2355", 2, "1.24", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx095 rescale 1.2345 -6   -> 1.234500
+	{"resx095", "1.2345", 6, "1.234500", 0, 9, ToNearestAway, 999, -999, false},
+	// resx096 rescale 9.9999 -2   -> 10.00 Inexact Rounded
+	{"resx096", "9.9999", 2, "10.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx097 rescale 0.0001 -2   -> 0.00 Inexact Rounded
+	{"resx097", "0.0001", 2, "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx098 rescale 0.001  -2   -> 0.00 Inexact Rounded
+	{"resx098", "0.001", 2, "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx099 rescale 0.009  -2   -> 0.01 Inexact Rounded
+	{"resx099", "0.009", 2, "0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx100 rescale 92     +2   -> 1E+2 Inexact Rounded
+	{"resx100", "92", -2, "1E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx101 rescale -1      0   ->  -1
+	{"resx101", "-1", 0, "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx102 rescale -1     -1   ->  -1.0
+	{"resx102", "-1", 1, "-1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx103 rescale -1     -2   ->  -1.00
+	{"resx103", "-1", 2, "-1.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx104 rescale  0      0   ->  0
+	{"resx104", "0", 0, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx105 rescale  0     -1   ->  0.0
+	{"resx105", "0", 1, "0.0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx106 rescale  0     -2   ->  0.00
+	{"resx106", "0", 2, "0.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx107 rescale  0.00   0   ->  0
+	{"resx107", "0.00", 0, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx108 rescale  0     +1   ->  0E+1
+	{"resx108", "0", -1, "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx109 rescale  0     +2   ->  0E+2
+	{"resx109", "0", -2, "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// resx110 rescale +1      0   ->  1
+	{"resx110", "+1", 0, "1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx111 rescale +1     -1   ->  1.0
+	{"resx111", "+1", 1, "1.0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx112 rescale +1     -2   ->  1.00
+	{"resx112", "+1", 2, "1.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx120 rescale   1.04  -3 ->  1.040
+	{"resx120", "1.04", 3, "1.040", 0, 9, ToNearestAway, 999, -999, false},
+	// resx121 rescale   1.04  -2 ->  1.04
+	{"resx121", "1.04", 2, "1.04", 0, 9, ToNearestAway, 999, -999, false},
+	// resx122 rescale   1.04  -1 ->  1.0 Inexact Rounded
+	{"resx122", "1.04", 1, "1.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx123 rescale   1.04   0 ->  1 Inexact Rounded
+	{"resx123", "1.04", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx124 rescale   1.05  -3 ->  1.050
+	{"resx124", "1.05", 3, "1.050", 0, 9, ToNearestAway, 999, -999, false},
+	// resx125 rescale   1.05  -2 ->  1.05
+	{"resx125", "1.05", 2, "1.05", 0, 9, ToNearestAway, 999, -999, false},
+	// resx126 rescale   1.05  -1 ->  1.1 Inexact Rounded
+	{"resx126", "1.05", 1, "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx127 rescale   1.05   0 ->  1 Inexact Rounded
+	{"resx127", "1.05", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx128 rescale   1.05  -3 ->  1.050
+	{"resx128", "1.05", 3, "1.050", 0, 9, ToNearestAway, 999, -999, false},
+	// resx129 rescale   1.05  -2 ->  1.05
+	{"resx129", "1.05", 2, "1.05", 0, 9, ToNearestAway, 999, -999, false},
+	// resx130 rescale   1.05  -1 ->  1.1 Inexact Rounded
+	{"resx130", "1.05", 1, "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx131 rescale   1.05   0 ->  1 Inexact Rounded
+	{"resx131", "1.05", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx132 rescale   1.06  -3 ->  1.060
+	{"resx132", "1.06", 3, "1.060", 0, 9, ToNearestAway, 999, -999, false},
+	// resx133 rescale   1.06  -2 ->  1.06
+	{"resx133", "1.06", 2, "1.06", 0, 9, ToNearestAway, 999, -999, false},
+	// resx134 rescale   1.06  -1 ->  1.1 Inexact Rounded
+	{"resx134", "1.06", 1, "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx135 rescale   1.06   0 ->  1 Inexact Rounded
+	{"resx135", "1.06", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx140 rescale   -10    -2  ->  -10.00
+	{"resx140", "-10", 2, "-10.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx141 rescale   +1     -2  ->  1.00
+	{"resx141", "+1", 2, "1.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx142 rescale   +10    -2  ->  10.00
+	{"resx142", "+10", 2, "10.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx143 rescale   1E+10  -2  ->  NaN Invalid_operation
+	{"resx143", "1E+10", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx144 rescale   1E-10  -2  ->  0.00 Inexact Rounded
+	{"resx144", "1E-10", 2, "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx145 rescale   1E-3   -2  ->  0.00 Inexact Rounded
+	{"resx145", "1E-3", 2, "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx146 rescale   1E-2   -2  ->  0.01
+	{"resx146", "1E-2", 2, "0.01", 0, 9, ToNearestAway, 999, -999, false},
+	// resx147 rescale   1E-1   -2  ->  0.10
+	{"resx147", "1E-1", 2, "0.10", 0, 9, ToNearestAway, 999, -999, false},
+	// resx148 rescale   0E-10  -2  ->  0.00
+	{"resx148", "0E-10", 2, "0.00", 0, 9, ToNearestAway, 999, -999, false},
+	// resx150 rescale   1.0600 -5 ->  1.06000
+	{"resx150", "1.0600", 5, "1.06000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx151 rescale   1.0600 -4 ->  1.0600
+	{"resx151", "1.0600", 4, "1.0600", 0, 9, ToNearestAway, 999, -999, false},
+	// resx152 rescale   1.0600 -3 ->  1.060 Rounded
+	{"resx152", "1.0600", 3, "1.060", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx153 rescale   1.0600 -2 ->  1.06 Rounded
+	{"resx153", "1.0600", 2, "1.06", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx154 rescale   1.0600 -1 ->  1.1 Inexact Rounded
+	{"resx154", "1.0600", 1, "1.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx155 rescale   1.0600  0 ->  1 Inexact Rounded
+	{"resx155", "1.0600", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// +ve exponents ..
+	// resx201 rescale   -1   +0 ->  -1
+	{"resx201", "-1", 0, "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx202 rescale   -1   +1 ->  -0E+1 Inexact Rounded
+	{"resx202", "-1", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx203 rescale   -1   +2 ->  -0E+2 Inexact Rounded
+	{"resx203", "-1", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx204 rescale    0   +0 ->  0
+	{"resx204", "0", 0, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// resx205 rescale    0   +1 ->  0E+1
+	{"resx205", "0", -1, "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx206 rescale    0   +2 ->  0E+2
+	{"resx206", "0", -2, "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// resx207 rescale   +1   +0 ->  1
+	{"resx207", "+1", 0, "1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx208 rescale   +1   +1 ->  0E+1 Inexact Rounded
+	{"resx208", "+1", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx209 rescale   +1   +2 ->  0E+2 Inexact Rounded
+	{"resx209", "+1", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx220 rescale   1.04 +3 ->  0E+3 Inexact Rounded
+	{"resx220", "1.04", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx221 rescale   1.04 +2 ->  0E+2 Inexact Rounded
+	{"resx221", "1.04", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx222 rescale   1.04 +1 ->  0E+1 Inexact Rounded
+	{"resx222", "1.04", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx223 rescale   1.04 +0 ->  1 Inexact Rounded
+	{"resx223", "1.04", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx224 rescale   1.05 +3 ->  0E+3 Inexact Rounded
+	{"resx224", "1.05", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx225 rescale   1.05 +2 ->  0E+2 Inexact Rounded
+	{"resx225", "1.05", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx226 rescale   1.05 +1 ->  0E+1 Inexact Rounded
+	{"resx226", "1.05", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx227 rescale   1.05 +0 ->  1 Inexact Rounded
+	{"resx227", "1.05", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx228 rescale   1.05 +3 ->  0E+3 Inexact Rounded
+	{"resx228", "1.05", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx229 rescale   1.05 +2 ->  0E+2 Inexact Rounded
+	{"resx229", "1.05", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx230 rescale   1.05 +1 ->  0E+1 Inexact Rounded
+	{"resx230", "1.05", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx231 rescale   1.05 +0 ->  1 Inexact Rounded
+	{"resx231", "1.05", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx232 rescale   1.06 +3 ->  0E+3 Inexact Rounded
+	{"resx232", "1.06", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx233 rescale   1.06 +2 ->  0E+2 Inexact Rounded
+	{"resx233", "1.06", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx234 rescale   1.06 +1 ->  0E+1 Inexact Rounded
+	{"resx234", "1.06", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx235 rescale   1.06 +0 ->  1 Inexact Rounded
+	{"resx235", "1.06", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx240 rescale   -10   +1  ->  -1E+1 Rounded
+	{"resx240", "-10", -1, "-1E+1", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx241 rescale   +1    +1  ->  0E+1 Inexact Rounded
+	{"resx241", "+1", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx242 rescale   +10   +1  ->  1E+1 Rounded
+	{"resx242", "+10", -1, "1E+1", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx243 rescale   1E+1  +1  ->  1E+1          -- underneath this is E+1
+	{"resx243", "1E+1", -1, "1E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx244 rescale   1E+2  +1  ->  1.0E+2        -- underneath this is E+1
+	{"resx244", "1E+2", -1, "1.0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// resx245 rescale   1E+3  +1  ->  1.00E+3       -- underneath this is E+1
+	{"resx245", "1E+3", -1, "1.00E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// resx246 rescale   1E+4  +1  ->  1.000E+4      -- underneath this is E+1
+	{"resx246", "1E+4", -1, "1.000E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// resx247 rescale   1E+5  +1  ->  1.0000E+5     -- underneath this is E+1
+	{"resx247", "1E+5", -1, "1.0000E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// resx248 rescale   1E+6  +1  ->  1.00000E+6    -- underneath this is E+1
+	{"resx248", "1E+6", -1, "1.00000E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// resx249 rescale   1E+7  +1  ->  1.000000E+7   -- underneath this is E+1
+	{"resx249", "1E+7", -1, "1.000000E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// resx250 rescale   1E+8  +1  ->  1.0000000E+8  -- underneath this is E+1
+	{"resx250", "1E+8", -1, "1.0000000E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// resx251 rescale   1E+9  +1  ->  1.00000000E+9 -- underneath this is E+1
+	{"resx251", "1E+9", -1, "1.00000000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// next one tries to add 9 zeros
+	// resx252 rescale   1E+10 +1  ->  NaN Invalid_operation
+	{"resx252", "1E+10", -1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx253 rescale   1E-10 +1  ->  0E+1 Inexact Rounded
+	{"resx253", "1E-10", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx254 rescale   1E-2  +1  ->  0E+1 Inexact Rounded
+	{"resx254", "1E-2", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx255 rescale   0E-10 +1  ->  0E+1
+	{"resx255", "0E-10", -1, "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx256 rescale  -0E-10 +1  -> -0E+1
+	{"resx256", "-0E-10", -1, "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx257 rescale  -0E-1  +1  -> -0E+1
+	{"resx257", "-0E-1", -1, "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx258 rescale  -0     +1  -> -0E+1
+	{"resx258", "-0", -1, "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx259 rescale  -0E+1  +1  -> -0E+1
+	{"resx259", "-0E+1", -1, "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// resx260 rescale   -10   +2  ->  -0E+2 Inexact Rounded
+	{"resx260", "-10", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx261 rescale   +1    +2  ->  0E+2 Inexact Rounded
+	{"resx261", "+1", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx262 rescale   +10   +2  ->  0E+2 Inexact Rounded
+	{"resx262", "+10", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx263 rescale   1E+1  +2  ->  0E+2 Inexact Rounded
+	{"resx263", "1E+1", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx264 rescale   1E+2  +2  ->  1E+2
+	{"resx264", "1E+2", -2, "1E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// resx265 rescale   1E+3  +2  ->  1.0E+3
+	{"resx265", "1E+3", -2, "1.0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// resx266 rescale   1E+4  +2  ->  1.00E+4
+	{"resx266", "1E+4", -2, "1.00E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// resx267 rescale   1E+5  +2  ->  1.000E+5
+	{"resx267", "1E+5", -2, "1.000E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// resx268 rescale   1E+6  +2  ->  1.0000E+6
+	{"resx268", "1E+6", -2, "1.0000E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// resx269 rescale   1E+7  +2  ->  1.00000E+7
+	{"resx269", "1E+7", -2, "1.00000E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// resx270 rescale   1E+8  +2  ->  1.000000E+8
+	{"resx270", "1E+8", -2, "1.000000E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// resx271 rescale   1E+9  +2  ->  1.0000000E+9
+	{"resx271", "1E+9", -2, "1.0000000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// resx272 rescale   1E+10 +2  ->  1.00000000E+10
+	{"resx272", "1E+10", -2, "1.00000000E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// resx273 rescale   1E-10 +2  ->  0E+2 Inexact Rounded
+	{"resx273", "1E-10", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx274 rescale   1E-2  +2  ->  0E+2 Inexact Rounded
+	{"resx274", "1E-2", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx275 rescale   0E-10 +2  ->  0E+2
+	{"resx275", "0E-10", -2, "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// resx280 rescale   -10   +3  ->  -0E+3 Inexact Rounded
+	{"resx280", "-10", -3, "-0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx281 rescale   +1    +3  ->  0E+3 Inexact Rounded
+	{"resx281", "+1", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx282 rescale   +10   +3  ->  0E+3 Inexact Rounded
+	{"resx282", "+10", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx283 rescale   1E+1  +3  ->  0E+3 Inexact Rounded
+	{"resx283", "1E+1", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx284 rescale   1E+2  +3  ->  0E+3 Inexact Rounded
+	{"resx284", "1E+2", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx285 rescale   1E+3  +3  ->  1E+3
+	{"resx285", "1E+3", -3, "1E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// resx286 rescale   1E+4  +3  ->  1.0E+4
+	{"resx286", "1E+4", -3, "1.0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// resx287 rescale   1E+5  +3  ->  1.00E+5
+	{"resx287", "1E+5", -3, "1.00E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// resx288 rescale   1E+6  +3  ->  1.000E+6
+	{"resx288", "1E+6", -3, "1.000E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// resx289 rescale   1E+7  +3  ->  1.0000E+7
+	{"resx289", "1E+7", -3, "1.0000E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// resx290 rescale   1E+8  +3  ->  1.00000E+8
+	{"resx290", "1E+8", -3, "1.00000E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// resx291 rescale   1E+9  +3  ->  1.000000E+9
+	{"resx291", "1E+9", -3, "1.000000E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// resx292 rescale   1E+10 +3  ->  1.0000000E+10
+	{"resx292", "1E+10", -3, "1.0000000E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// resx293 rescale   1E-10 +3  ->  0E+3 Inexact Rounded
+	{"resx293", "1E-10", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx294 rescale   1E-2  +3  ->  0E+3 Inexact Rounded
+	{"resx294", "1E-2", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx295 rescale   0E-10 +3  ->  0E+3
+	{"resx295", "0E-10", -3, "0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// round up from below [sign wrong in JIT compiler once]
+	// resx300 rescale   0.0078 -5 ->  0.00780
+	{"resx300", "0.0078", 5, "0.00780", 0, 9, ToNearestAway, 999, -999, false},
+	// resx301 rescale   0.0078 -4 ->  0.0078
+	{"resx301", "0.0078", 4, "0.0078", 0, 9, ToNearestAway, 999, -999, false},
+	// resx302 rescale   0.0078 -3 ->  0.008 Inexact Rounded
+	{"resx302", "0.0078", 3, "0.008", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx303 rescale   0.0078 -2 ->  0.01 Inexact Rounded
+	{"resx303", "0.0078", 2, "0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx304 rescale   0.0078 -1 ->  0.0 Inexact Rounded
+	{"resx304", "0.0078", 1, "0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx305 rescale   0.0078  0 ->  0 Inexact Rounded
+	{"resx305", "0.0078", 0, "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx306 rescale   0.0078 +1 ->  0E+1 Inexact Rounded
+	{"resx306", "0.0078", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx307 rescale   0.0078 +2 ->  0E+2 Inexact Rounded
+	{"resx307", "0.0078", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx310 rescale  -0.0078 -5 -> -0.00780
+	{"resx310", "-0.0078", 5, "-0.00780", 0, 9, ToNearestAway, 999, -999, false},
+	// resx311 rescale  -0.0078 -4 -> -0.0078
+	{"resx311", "-0.0078", 4, "-0.0078", 0, 9, ToNearestAway, 999, -999, false},
+	// resx312 rescale  -0.0078 -3 -> -0.008 Inexact Rounded
+	{"resx312", "-0.0078", 3, "-0.008", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx313 rescale  -0.0078 -2 -> -0.01 Inexact Rounded
+	{"resx313", "-0.0078", 2, "-0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx314 rescale  -0.0078 -1 -> -0.0 Inexact Rounded
+	{"resx314", "-0.0078", 1, "-0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx315 rescale  -0.0078  0 -> -0 Inexact Rounded
+	{"resx315", "-0.0078", 0, "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx316 rescale  -0.0078 +1 -> -0E+1 Inexact Rounded
+	{"resx316", "-0.0078", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx317 rescale  -0.0078 +2 -> -0E+2 Inexact Rounded
+	{"resx317", "-0.0078", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx320 rescale   0.078 -5 ->  0.07800
+	{"resx320", "0.078", 5, "0.07800", 0, 9, ToNearestAway, 999, -999, false},
+	// resx321 rescale   0.078 -4 ->  0.0780
+	{"resx321", "0.078", 4, "0.0780", 0, 9, ToNearestAway, 999, -999, false},
+	// resx322 rescale   0.078 -3 ->  0.078
+	{"resx322", "0.078", 3, "0.078", 0, 9, ToNearestAway, 999, -999, false},
+	// resx323 rescale   0.078 -2 ->  0.08 Inexact Rounded
+	{"resx323", "0.078", 2, "0.08", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx324 rescale   0.078 -1 ->  0.1 Inexact Rounded
+	{"resx324", "0.078", 1, "0.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx325 rescale   0.078  0 ->  0 Inexact Rounded
+	{"resx325", "0.078", 0, "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx326 rescale   0.078 +1 ->  0E+1 Inexact Rounded
+	{"resx326", "0.078", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx327 rescale   0.078 +2 ->  0E+2 Inexact Rounded
+	{"resx327", "0.078", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx330 rescale  -0.078 -5 -> -0.07800
+	{"resx330", "-0.078", 5, "-0.07800", 0, 9, ToNearestAway, 999, -999, false},
+	// resx331 rescale  -0.078 -4 -> -0.0780
+	{"resx331", "-0.078", 4, "-0.0780", 0, 9, ToNearestAway, 999, -999, false},
+	// resx332 rescale  -0.078 -3 -> -0.078
+	{"resx332", "-0.078", 3, "-0.078", 0, 9, ToNearestAway, 999, -999, false},
+	// resx333 rescale  -0.078 -2 -> -0.08 Inexact Rounded
+	{"resx333", "-0.078", 2, "-0.08", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx334 rescale  -0.078 -1 -> -0.1 Inexact Rounded
+	{"resx334", "-0.078", 1, "-0.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx335 rescale  -0.078  0 -> -0 Inexact Rounded
+	{"resx335", "-0.078", 0, "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx336 rescale  -0.078 +1 -> -0E+1 Inexact Rounded
+	{"resx336", "-0.078", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx337 rescale  -0.078 +2 -> -0E+2 Inexact Rounded
+	{"resx337", "-0.078", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx340 rescale   0.78 -5 ->  0.78000
+	{"resx340", "0.78", 5, "0.78000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx341 rescale   0.78 -4 ->  0.7800
+	{"resx341", "0.78", 4, "0.7800", 0, 9, ToNearestAway, 999, -999, false},
+	// resx342 rescale   0.78 -3 ->  0.780
+	{"resx342", "0.78", 3, "0.780", 0, 9, ToNearestAway, 999, -999, false},
+	// resx343 rescale   0.78 -2 ->  0.78
+	{"resx343", "0.78", 2, "0.78", 0, 9, ToNearestAway, 999, -999, false},
+	// resx344 rescale   0.78 -1 ->  0.8 Inexact Rounded
+	{"resx344", "0.78", 1, "0.8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx345 rescale   0.78  0 ->  1 Inexact Rounded
+	{"resx345", "0.78", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx346 rescale   0.78 +1 ->  0E+1 Inexact Rounded
+	{"resx346", "0.78", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx347 rescale   0.78 +2 ->  0E+2 Inexact Rounded
+	{"resx347", "0.78", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx350 rescale  -0.78 -5 -> -0.78000
+	{"resx350", "-0.78", 5, "-0.78000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx351 rescale  -0.78 -4 -> -0.7800
+	{"resx351", "-0.78", 4, "-0.7800", 0, 9, ToNearestAway, 999, -999, false},
+	// resx352 rescale  -0.78 -3 -> -0.780
+	{"resx352", "-0.78", 3, "-0.780", 0, 9, ToNearestAway, 999, -999, false},
+	// resx353 rescale  -0.78 -2 -> -0.78
+	{"resx353", "-0.78", 2, "-0.78", 0, 9, ToNearestAway, 999, -999, false},
+	// resx354 rescale  -0.78 -1 -> -0.8 Inexact Rounded
+	{"resx354", "-0.78", 1, "-0.8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx355 rescale  -0.78  0 -> -1 Inexact Rounded
+	{"resx355", "-0.78", 0, "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx356 rescale  -0.78 +1 -> -0E+1 Inexact Rounded
+	{"resx356", "-0.78", -1, "-0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx357 rescale  -0.78 +2 -> -0E+2 Inexact Rounded
+	{"resx357", "-0.78", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx360 rescale   7.8 -5 ->  7.80000
+	{"resx360", "7.8", 5, "7.80000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx361 rescale   7.8 -4 ->  7.8000
+	{"resx361", "7.8", 4, "7.8000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx362 rescale   7.8 -3 ->  7.800
+	{"resx362", "7.8", 3, "7.800", 0, 9, ToNearestAway, 999, -999, false},
+	// resx363 rescale   7.8 -2 ->  7.80
+	{"resx363", "7.8", 2, "7.80", 0, 9, ToNearestAway, 999, -999, false},
+	// resx364 rescale   7.8 -1 ->  7.8
+	{"resx364", "7.8", 1, "7.8", 0, 9, ToNearestAway, 999, -999, false},
+	// resx365 rescale   7.8  0 ->  8 Inexact Rounded
+	{"resx365", "7.8", 0, "8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx366 rescale   7.8 +1 ->  1E+1 Inexact Rounded
+	{"resx366", "7.8", -1, "1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx367 rescale   7.8 +2 ->  0E+2 Inexact Rounded
+	{"resx367", "7.8", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx368 rescale   7.8 +3 ->  0E+3 Inexact Rounded
+	{"resx368", "7.8", -3, "0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx370 rescale  -7.8 -5 -> -7.80000
+	{"resx370", "-7.8", 5, "-7.80000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx371 rescale  -7.8 -4 -> -7.8000
+	{"resx371", "-7.8", 4, "-7.8000", 0, 9, ToNearestAway, 999, -999, false},
+	// resx372 rescale  -7.8 -3 -> -7.800
+	{"resx372", "-7.8", 3, "-7.800", 0, 9, ToNearestAway, 999, -999, false},
+	// resx373 rescale  -7.8 -2 -> -7.80
+	{"resx373", "-7.8", 2, "-7.80", 0, 9, ToNearestAway, 999, -999, false},
+	// resx374 rescale  -7.8 -1 -> -7.8
+	{"resx374", "-7.8", 1, "-7.8", 0, 9, ToNearestAway, 999, -999, false},
+	// resx375 rescale  -7.8  0 -> -8 Inexact Rounded
+	{"resx375", "-7.8", 0, "-8", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx376 rescale  -7.8 +1 -> -1E+1 Inexact Rounded
+	{"resx376", "-7.8", -1, "-1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx377 rescale  -7.8 +2 -> -0E+2 Inexact Rounded
+	{"resx377", "-7.8", -2, "-0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx378 rescale  -7.8 +3 -> -0E+3 Inexact Rounded
+	{"resx378", "-7.8", -3, "-0E+3", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// some individuals
+	// precision: 9
+	// resx380 rescale   352364.506 -2 -> 352364.51 Inexact Rounded
+	{"resx380", "352364.506", 2, "352364.51", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx381 rescale   3523645.06 -2 -> 3523645.06
+	{"resx381", "3523645.06", 2, "3523645.06", 0, 9, ToNearestAway, 999, -999, false},
+	// resx382 rescale   35236450.6 -2 -> NaN Invalid_operation
+	{"resx382", "35236450.6", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx383 rescale   352364506  -2 -> NaN Invalid_operation
+	{"resx383", "352364506", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx384 rescale  -352364.506 -2 -> -352364.51 Inexact Rounded
+	{"resx384", "-352364.506", 2, "-352364.51", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx385 rescale  -3523645.06 -2 -> -3523645.06
+	{"resx385", "-3523645.06", 2, "-3523645.06", 0, 9, ToNearestAway, 999, -999, false},
+	// resx386 rescale  -35236450.6 -2 -> NaN Invalid_operation
+	{"resx386", "-35236450.6", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx387 rescale  -352364506  -2 -> NaN Invalid_operation
+	{"resx387", "-352364506", 2, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rounding: down
+	// resx389 rescale   35236450.6 -2 -> NaN Invalid_operation
+	{"resx389", "35236450.6", 2, "NaN", InvalidOperation, 9, ToZero, 999, -999, false},
+	// ? should that one instead have been:
+	// resx389 rescale   35236450.6 -2 -> NaN Invalid_operation
+	// rounding: half_up
+	// and a few more from e-mail discussions
+	// precision: 7
+	// resx391 rescale  12.34567  -3 -> 12.346   Inexact Rounded
+	{"resx391", "12.34567", 3, "12.346", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx392 rescale  123.4567  -3 -> 123.457  Inexact Rounded
+	{"resx392", "123.4567", 3, "123.457", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx393 rescale  1234.567  -3 -> 1234.567
+	{"resx393", "1234.567", 3, "1234.567", 0, 7, ToNearestAway, 999, -999, false},
+	// resx394 rescale  12345.67  -3 -> NaN Invalid_operation
+	{"resx394", "12345.67", 3, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// resx395 rescale  123456.7  -3 -> NaN Invalid_operation
+	{"resx395", "123456.7", 3, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// resx396 rescale  1234567.  -3 -> NaN Invalid_operation
+	{"resx396", "1234567.", 3, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// some 9999 round-up cases
+	// precision: 9
+	// resx400 rescale   9.999        -5  ->  9.99900
+	{"resx400", "9.999", 5, "9.99900", 0, 9, ToNearestAway, 999, -999, false},
+	// resx401 rescale   9.999        -4  ->  9.9990
+	{"resx401", "9.999", 4, "9.9990", 0, 9, ToNearestAway, 999, -999, false},
+	// resx402 rescale   9.999        -3  ->  9.999
+	{"resx402", "9.999", 3, "9.999", 0, 9, ToNearestAway, 999, -999, false},
+	// resx403 rescale   9.999        -2  -> 10.00     Inexact Rounded
+	{"resx403", "9.999", 2, "10.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx404 rescale   9.999        -1  -> 10.0      Inexact Rounded
+	{"resx404", "9.999", 1, "10.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx405 rescale   9.999         0  -> 10        Inexact Rounded
+	{"resx405", "9.999", 0, "10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx406 rescale   9.999         1  -> 1E+1      Inexact Rounded
+	{"resx406", "9.999", -1, "1E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx407 rescale   9.999         2  -> 0E+2      Inexact Rounded
+	{"resx407", "9.999", -2, "0E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx410 rescale   0.999        -5  ->  0.99900
+	{"resx410", "0.999", 5, "0.99900", 0, 9, ToNearestAway, 999, -999, false},
+	// resx411 rescale   0.999        -4  ->  0.9990
+	{"resx411", "0.999", 4, "0.9990", 0, 9, ToNearestAway, 999, -999, false},
+	// resx412 rescale   0.999        -3  ->  0.999
+	{"resx412", "0.999", 3, "0.999", 0, 9, ToNearestAway, 999, -999, false},
+	// resx413 rescale   0.999        -2  ->  1.00     Inexact Rounded
+	{"resx413", "0.999", 2, "1.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx414 rescale   0.999        -1  ->  1.0      Inexact Rounded
+	{"resx414", "0.999", 1, "1.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx415 rescale   0.999         0  ->  1        Inexact Rounded
+	{"resx415", "0.999", 0, "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx416 rescale   0.999         1  -> 0E+1      Inexact Rounded
+	{"resx416", "0.999", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx420 rescale   0.0999       -5  ->  0.09990
+	{"resx420", "0.0999", 5, "0.09990", 0, 9, ToNearestAway, 999, -999, false},
+	// resx421 rescale   0.0999       -4  ->  0.0999
+	{"resx421", "0.0999", 4, "0.0999", 0, 9, ToNearestAway, 999, -999, false},
+	// resx422 rescale   0.0999       -3  ->  0.100    Inexact Rounded
+	{"resx422", "0.0999", 3, "0.100", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx423 rescale   0.0999       -2  ->  0.10     Inexact Rounded
+	{"resx423", "0.0999", 2, "0.10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx424 rescale   0.0999       -1  ->  0.1      Inexact Rounded
+	{"resx424", "0.0999", 1, "0.1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx425 rescale   0.0999        0  ->  0        Inexact Rounded
+	{"resx425", "0.0999", 0, "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx426 rescale   0.0999        1  -> 0E+1      Inexact Rounded
+	{"resx426", "0.0999", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx430 rescale   0.00999      -5  ->  0.00999
+	{"resx430", "0.00999", 5, "0.00999", 0, 9, ToNearestAway, 999, -999, false},
+	// resx431 rescale   0.00999      -4  ->  0.0100   Inexact Rounded
+	{"resx431", "0.00999", 4, "0.0100", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx432 rescale   0.00999      -3  ->  0.010    Inexact Rounded
+	{"resx432", "0.00999", 3, "0.010", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx433 rescale   0.00999      -2  ->  0.01     Inexact Rounded
+	{"resx433", "0.00999", 2, "0.01", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx434 rescale   0.00999      -1  ->  0.0      Inexact Rounded
+	{"resx434", "0.00999", 1, "0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx435 rescale   0.00999       0  ->  0        Inexact Rounded
+	{"resx435", "0.00999", 0, "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx436 rescale   0.00999       1  -> 0E+1      Inexact Rounded
+	{"resx436", "0.00999", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx440 rescale   0.000999     -5  ->  0.00100  Inexact Rounded
+	{"resx440", "0.000999", 5, "0.00100", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx441 rescale   0.000999     -4  ->  0.0010   Inexact Rounded
+	{"resx441", "0.000999", 4, "0.0010", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx442 rescale   0.000999     -3  ->  0.001    Inexact Rounded
+	{"resx442", "0.000999", 3, "0.001", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx443 rescale   0.000999     -2  ->  0.00     Inexact Rounded
+	{"resx443", "0.000999", 2, "0.00", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx444 rescale   0.000999     -1  ->  0.0      Inexact Rounded
+	{"resx444", "0.000999", 1, "0.0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx445 rescale   0.000999      0  ->  0        Inexact Rounded
+	{"resx445", "0.000999", 0, "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx446 rescale   0.000999      1  -> 0E+1      Inexact Rounded
+	{"resx446", "0.000999", -1, "0E+1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 8
+	// resx449 rescale   9.999E-15    -23 ->  NaN      Invalid_operation
+	{"resx449", "9.999E-15", 23, "NaN", InvalidOperation, 8, ToNearestAway, 999, -999, false},
+	// resx450 rescale   9.999E-15    -22 ->  9.9990000E-15
+	{"resx450", "9.999E-15", 22, "9.9990000E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// resx451 rescale   9.999E-15    -21 ->  9.999000E-15
+	{"resx451", "9.999E-15", 21, "9.999000E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// resx452 rescale   9.999E-15    -20 ->  9.99900E-15
+	{"resx452", "9.999E-15", 20, "9.99900E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// resx453 rescale   9.999E-15    -19 ->  9.9990E-15
+	{"resx453", "9.999E-15", 19, "9.9990E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// resx454 rescale   9.999E-15    -18 ->  9.999E-15
+	{"resx454", "9.999E-15", 18, "9.999E-15", 0, 8, ToNearestAway, 999, -999, false},
+	// resx455 rescale   9.999E-15    -17 ->  1.000E-14 Inexact Rounded
+	{"resx455", "9.999E-15", 17, "1.000E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx456 rescale   9.999E-15    -16 ->  1.00E-14  Inexact Rounded
+	{"resx456", "9.999E-15", 16, "1.00E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx457 rescale   9.999E-15    -15 ->  1.0E-14   Inexact Rounded
+	{"resx457", "9.999E-15", 15, "1.0E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx458 rescale   9.999E-15    -14 ->  1E-14     Inexact Rounded
+	{"resx458", "9.999E-15", 14, "1E-14", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx459 rescale   9.999E-15    -13 ->  0E-13     Inexact Rounded
+	{"resx459", "9.999E-15", 13, "0E-13", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx460 rescale   9.999E-15    -12 ->  0E-12     Inexact Rounded
+	{"resx460", "9.999E-15", 12, "0E-12", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx461 rescale   9.999E-15    -11 ->  0E-11     Inexact Rounded
+	{"resx461", "9.999E-15", 11, "0E-11", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx462 rescale   9.999E-15    -10 ->  0E-10     Inexact Rounded
+	{"resx462", "9.999E-15", 10, "0E-10", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx463 rescale   9.999E-15     -9 ->  0E-9      Inexact Rounded
+	{"resx463", "9.999E-15", 9, "0E-9", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx464 rescale   9.999E-15     -8 ->  0E-8      Inexact Rounded
+	{"resx464", "9.999E-15", 8, "0E-8", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx465 rescale   9.999E-15     -7 ->  0E-7      Inexact Rounded
+	{"resx465", "9.999E-15", 7, "0E-7", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx466 rescale   9.999E-15     -6 ->  0.000000  Inexact Rounded
+	{"resx466", "9.999E-15", 6, "0.000000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx467 rescale   9.999E-15     -5 ->  0.00000   Inexact Rounded
+	{"resx467", "9.999E-15", 5, "0.00000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx468 rescale   9.999E-15     -4 ->  0.0000    Inexact Rounded
+	{"resx468", "9.999E-15", 4, "0.0000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx469 rescale   9.999E-15     -3 ->  0.000     Inexact Rounded
+	{"resx469", "9.999E-15", 3, "0.000", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx470 rescale   9.999E-15     -2 ->  0.00      Inexact Rounded
+	{"resx470", "9.999E-15", 2, "0.00", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx471 rescale   9.999E-15     -1 ->  0.0       Inexact Rounded
+	{"resx471", "9.999E-15", 1, "0.0", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx472 rescale   9.999E-15      0 ->  0         Inexact Rounded
+	{"resx472", "9.999E-15", 0, "0", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// resx473 rescale   9.999E-15      1 ->  0E+1      Inexact Rounded
+	{"resx473", "9.999E-15", -1, "0E+1", Inexact | Rounded, 8, ToNearestAway, 999, -999, false},
+	// [additional tests for "don't fit" edge cases are in
+	// quantize.decTest.  Here's a critical one.]
+	// precision: 3
+	// resx480 rescale   0.9999        -3 ->  NaN       Invalid_operation
+	{"resx480", "0.9999", 3, "NaN", InvalidOperation, 3, ToNearestAway, 999, -999, false},
+	// long operand checks [rhs checks removed]
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 9
+	// resx481 rescale 12345678000 +3 -> 1.2345678E+10 Rounded
+	{"resx481", "12345678000", -3, "1.2345678E+10", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx482 rescale 1234567800  +1 -> 1.23456780E+9 Rounded
+	{"resx482", "1234567800", -1, "1.23456780E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx483 rescale 1234567890  +1 -> 1.23456789E+9 Rounded
+	{"resx483", "1234567890", -1, "1.23456789E+9", Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx484 rescale 1234567891  +1 -> 1.23456789E+9 Inexact Rounded
+	{"resx484", "1234567891", -1, "1.23456789E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx485 rescale 12345678901 +2 -> 1.23456789E+10 Inexact Rounded
+	{"resx485", "12345678901", -2, "1.23456789E+10", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx486 rescale 1234567896  +1 -> 1.23456790E+9 Inexact Rounded
+	{"resx486", "1234567896", -1, "1.23456790E+9", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// a potential double-round
+	// resx487 rescale 1234.987643 -4 -> 1234.9876 Inexact Rounded
+	{"resx487", "1234.987643", 4, "1234.9876", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx488 rescale 1234.987647 -4 -> 1234.9876 Inexact Rounded
+	{"resx488", "1234.987647", 4, "1234.9876", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// precision: 15
+	// resx491 rescale 12345678000 +3 -> 1.2345678E+10 Rounded
+	{"resx491", "12345678000", -3, "1.2345678E+10", Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx492 rescale 1234567800  +1 -> 1.23456780E+9 Rounded
+	{"resx492", "1234567800", -1, "1.23456780E+9", Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx493 rescale 1234567890  +1 -> 1.23456789E+9 Rounded
+	{"resx493", "1234567890", -1, "1.23456789E+9", Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx494 rescale 1234567891  +1 -> 1.23456789E+9 Inexact Rounded
+	{"resx494", "1234567891", -1, "1.23456789E+9", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx495 rescale 12345678901 +2 -> 1.23456789E+10 Inexact Rounded
+	{"resx495", "12345678901", -2, "1.23456789E+10", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx496 rescale 1234567896  +1 -> 1.23456790E+9 Inexact Rounded
+	{"resx496", "1234567896", -1, "1.23456790E+9", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx497 rescale 1234.987643 -4 -> 1234.9876 Inexact Rounded
+	{"resx497", "1234.987643", 4, "1234.9876", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx498 rescale 1234.987647 -4 -> 1234.9876 Inexact Rounded
+	{"resx498", "1234.987647", 4, "1234.9876", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// Zeros
+	// resx500 rescale   0     1 ->  0E+1
+	{"resx500", "0", -1, "0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// resx501 rescale   0     0 ->  0
+	{"resx501", "0", 0, "0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx502 rescale   0    -1 ->  0.0
+	{"resx502", "0", 1, "0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx503 rescale   0.0  -1 ->  0.0
+	{"resx503", "0.0", 1, "0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx504 rescale   0.0   0 ->  0
+	{"resx504", "0.0", 0, "0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx505 rescale   0.0  +1 ->  0E+1
+	{"resx505", "0.0", -1, "0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// resx506 rescale   0E+1 -1 ->  0.0
+	{"resx506", "0E+1", 1, "0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx507 rescale   0E+1  0 ->  0
+	{"resx507", "0E+1", 0, "0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx508 rescale   0E+1 +1 ->  0E+1
+	{"resx508", "0E+1", -1, "0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// resx509 rescale  -0     1 -> -0E+1
+	{"resx509", "-0", -1, "-0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// resx510 rescale  -0     0 -> -0
+	{"resx510", "-0", 0, "-0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx511 rescale  -0    -1 -> -0.0
+	{"resx511", "-0", 1, "-0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx512 rescale  -0.0  -1 -> -0.0
+	{"resx512", "-0.0", 1, "-0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx513 rescale  -0.0   0 -> -0
+	{"resx513", "-0.0", 0, "-0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx514 rescale  -0.0  +1 -> -0E+1
+	{"resx514", "-0.0", -1, "-0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// resx515 rescale  -0E+1 -1 -> -0.0
+	{"resx515", "-0E+1", 1, "-0.0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx516 rescale  -0E+1  0 -> -0
+	{"resx516", "-0E+1", 0, "-0", 0, 15, ToNearestAway, 999, -999, false},
+	// resx517 rescale  -0E+1 +1 -> -0E+1
+	{"resx517", "-0E+1", -1, "-0E+1", 0, 15, ToNearestAway, 999, -999, false},
+	// Suspicious RHS values
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// precision: 15
+	// resx520 rescale   1.234    999999E+3 -> 0E+999999000 Inexact Rounded
+	{"resx520", "1.234", -999999000, "0E+999999000", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx521 rescale 123.456    999999E+3 -> 0E+999999000 Inexact Rounded
+	{"resx521", "123.456", -999999000, "0E+999999000", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx522 rescale   1.234    999999999 -> 0E+999999999 Inexact Rounded
+	{"resx522", "1.234", -999999999, "0E+999999999", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx523 rescale 123.456    999999999 -> 0E+999999999 Inexact Rounded
+	{"resx523", "123.456", -999999999, "0E+999999999", Inexact | Rounded, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx524 rescale 123.456   1000000000 -> NaN Invalid_operation
+	{"resx524", "123.456", -1000000000, "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// SKIP (exponent is not an int32): resx525 rescale 123.456  12345678903 -> NaN Invalid_operation
+	// next four are "won't fit" overflows
+	// resx526 rescale   1.234   -999999E+3 -> NaN Invalid_operation
+	{"resx526", "1.234", 999999000, "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx527 rescale 123.456   -999999E+3 -> NaN Invalid_operation
+	{"resx527", "123.456", 999999000, "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx528 rescale   1.234   -999999999 -> NaN Invalid_operation
+	{"resx528", "1.234", 999999999, "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx529 rescale 123.456   -999999999 -> NaN Invalid_operation
+	{"resx529", "123.456", 999999999, "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// resx530 rescale 123.456  -1000000014 -> NaN Invalid_operation
+	{"resx530", "123.456", 1000000014, "NaN", InvalidOperation, 15, ToNearestAway, 999999999, -999999999, false},
+	// SKIP (exponent is not an int32): resx531 rescale 123.456 -12345678903 -> NaN Invalid_operation
+	// maxexponent: 999
+	// minexponent: -999
+	// precision: 15
+	// resx532 rescale   1.234E+999    999 -> 1E+999    Inexact Rounded
+	{"resx532", "1.234E+999", -999, "1E+999", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx533 rescale   1.234E+998    999 -> 0E+999    Inexact Rounded
+	{"resx533", "1.234E+998", -999, "0E+999", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx534 rescale   1.234         999 -> 0E+999    Inexact Rounded
+	{"resx534", "1.234", -999, "0E+999", Inexact | Rounded, 15, ToNearestAway, 999, -999, false},
+	// resx535 rescale   1.234        1000 -> NaN Invalid_operation
+	{"resx535", "1.234", -1000, "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// resx536 rescale   1.234        5000 -> NaN Invalid_operation
+	{"resx536", "1.234", -5000, "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// resx537 rescale   0            -999 -> 0E-999
+	{"resx537", "0", 999, "0E-999", 0, 15, ToNearestAway, 999, -999, false},
+	// next two are "won't fit" overflows
+	// resx538 rescale   1.234        -999 -> NaN Invalid_operation
+	{"resx538", "1.234", 999, "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// resx539 rescale   1.234       -1000 -> NaN Invalid_operation
+	{"resx539", "1.234", 1000, "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// resx540 rescale   1.234       -5000 -> NaN Invalid_operation
+	{"resx540", "1.234", 5000, "NaN", InvalidOperation, 15, ToNearestAway, 999, -999, false},
+	// [more below]
+	// check bounds (lhs maybe out of range for destination, etc.)
+	// precision: 7
+	// resx541 rescale   1E+999   +999 -> 1E+999
+	{"resx541", "1E+999", -999, "1E+999", 0, 7, ToNearestAway, 999, -999, false},
+	// resx542 rescale   1E+1000  +999 -> NaN Invalid_operation
+	{"resx542", "1E+1000", -999, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// resx543 rescale   1E+999  +1000 -> NaN Invalid_operation
+	{"resx543", "1E+999", -1000, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// resx544 rescale   1E-999   -999 -> 1E-999
+	{"resx544", "1E-999", 999, "1E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// resx545 rescale   1E-1000  -999 -> 0E-999    Inexact Rounded
+	{"resx545", "1E-1000", 999, "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx546 rescale   1E-999  -1000 -> 1.0E-999
+	{"resx546", "1E-999", 1000, "1.0E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// resx547 rescale   1E-1005  -999 -> 0E-999    Inexact Rounded
+	{"resx547", "1E-1005", 999, "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx548 rescale   1E-1006  -999 -> 0E-999    Inexact Rounded
+	{"resx548", "1E-1006", 999, "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx549 rescale   1E-1007  -999 -> 0E-999    Inexact Rounded
+	{"resx549", "1E-1007", 999, "0E-999", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx550 rescale   1E-998  -1005 -> NaN Invalid_operation  -- won't fit
+	{"resx550", "1E-998", 1005, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// resx551 rescale   1E-999  -1005 -> 1.000000E-999
+	{"resx551", "1E-999", 1005, "1.000000E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// resx552 rescale   1E-1000 -1005 -> 1.00000E-1000 Subnormal
+	{"resx552", "1E-1000", 1005, "1.00000E-1000", Subnormal, 7, ToNearestAway, 999, -999, false},
+	// resx553 rescale   1E-999  -1006 -> NaN Invalid_operation
+	{"resx553", "1E-999", 1006, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// resx554 rescale   1E-999  -1007 -> NaN Invalid_operation
+	{"resx554", "1E-999", 1007, "NaN", InvalidOperation, 7, ToNearestAway, 999, -999, false},
+	// related subnormal rounding
+	// resx555 rescale   1.666666E-999  -1005 -> 1.666666E-999
+	{"resx555", "1.666666E-999", 1005, "1.666666E-999", 0, 7, ToNearestAway, 999, -999, false},
+	// resx556 rescale   1.666666E-1000 -1005 -> 1.66667E-1000  Subnormal Inexact Rounded
+	{"resx556", "1.666666E-1000", 1005, "1.66667E-1000", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx557 rescale   1.666666E-1001 -1005 -> 1.6667E-1001  Subnormal Inexact Rounded
+	{"resx557", "1.666666E-1001", 1005, "1.6667E-1001", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx558 rescale   1.666666E-1002 -1005 -> 1.667E-1002  Subnormal Inexact Rounded
+	{"resx558", "1.666666E-1002", 1005, "1.667E-1002", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx559 rescale   1.666666E-1003 -1005 -> 1.67E-1003  Subnormal Inexact Rounded
+	{"resx559", "1.666666E-1003", 1005, "1.67E-1003", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx560 rescale   1.666666E-1004 -1005 -> 1.7E-1004  Subnormal Inexact Rounded
+	{"resx560", "1.666666E-1004", 1005, "1.7E-1004", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx561 rescale   1.666666E-1005 -1005 -> 2E-1005  Subnormal Inexact Rounded
+	{"resx561", "1.666666E-1005", 1005, "2E-1005", Subnormal | Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx562 rescale   1.666666E-1006 -1005 -> 0E-1005   Inexact Rounded
+	{"resx562", "1.666666E-1006", 1005, "0E-1005", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// resx563 rescale   1.666666E-1007 -1005 -> 0E-1005   Inexact Rounded
+	{"resx563", "1.666666E-1007", 1005, "0E-1005", Inexact | Rounded, 7, ToNearestAway, 999, -999, false},
+	// fractional RHS, some good and some bad
+	// precision: 9
+	// resx564 rescale   222 +2.0           -> 2E+2 Inexact Rounded
+	{"resx564", "222", -2, "2E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// resx565 rescale   222 +2.00000000    -> 2E+2 Inexact Rounded
+	{"resx565", "222", -2, "2E+2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx566 rescale   222 +2.00100000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx567 rescale   222 +2.000001      -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx568 rescale   222 +2.000000001   -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx569 rescale   222 +2.0000000001  -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx570 rescale   222 +2.00000000001 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx571 rescale   222 +2.99999999999 -> NaN Invalid_operation
+	// resx572 rescale   222 -2.00000000    -> 222.00
+	{"resx572", "222", 2, "222.00", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx573 rescale   222 -2.00100000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx574 rescale   222 -2.0000001000  -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx575 rescale   222 -2.00000000001 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx576 rescale   222 -2.99999999999 -> NaN Invalid_operation
+	// Specials
+	// SKIP (exponent is not an int32): resx580 rescale  Inf  -Inf   ->  Infinity
+	// resx581 rescale  Inf  -1000  ->  NaN  Invalid_operation
+	{"resx581", "Inf", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx582 rescale  Inf  -1     ->  NaN  Invalid_operation
+	{"resx582", "Inf", 1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx583 rescale  Inf   0     ->  NaN  Invalid_operation
+	{"resx583", "Inf", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx584 rescale  Inf   1     ->  NaN  Invalid_operation
+	{"resx584", "Inf", -1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx585 rescale  Inf   1000  ->  NaN  Invalid_operation
+	{"resx585", "Inf", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx586 rescale  Inf   Inf   ->  Infinity
+	// SKIP (exponent is not an int32): resx587 rescale -1000  Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx588 rescale -Inf   Inf   ->  -Infinity
+	// SKIP (exponent is not an int32): resx589 rescale -1     Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx590 rescale  0     Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx591 rescale  1     Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx592 rescale  1000  Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx593 rescale  Inf   Inf   ->  Infinity
+	// resx594 rescale  Inf  -0     ->  NaN  Invalid_operation
+	{"resx594", "Inf", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx595 rescale -0     Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx600 rescale -Inf  -Inf   ->  -Infinity
+	// resx601 rescale -Inf  -1000  ->  NaN  Invalid_operation
+	{"resx601", "-Inf", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx602 rescale -Inf  -1     ->  NaN  Invalid_operation
+	{"resx602", "-Inf", 1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx603 rescale -Inf   0     ->  NaN  Invalid_operation
+	{"resx603", "-Inf", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx604 rescale -Inf   1     ->  NaN  Invalid_operation
+	{"resx604", "-Inf", -1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx605 rescale -Inf   1000  ->  NaN  Invalid_operation
+	{"resx605", "-Inf", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx606 rescale -Inf   Inf   ->  -Infinity
+	// SKIP (exponent is not an int32): resx607 rescale -1000  Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx608 rescale -Inf  -Inf   ->  -Infinity
+	// SKIP (exponent is not an int32): resx609 rescale -1    -Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx610 rescale  0    -Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx611 rescale  1    -Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx612 rescale  1000 -Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx613 rescale  Inf  -Inf   ->  Infinity
+	// resx614 rescale -Inf  -0     ->  NaN  Invalid_operation
+	{"resx614", "-Inf", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx615 rescale -0    -Inf   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx621 rescale  NaN -Inf    ->  NaN
+	// resx622 rescale  NaN -1000   ->  NaN
+	{"resx622", "NaN", 1000, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// resx623 rescale  NaN -1      ->  NaN
+	{"resx623", "NaN", 1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// resx624 rescale  NaN  0      ->  NaN
+	{"resx624", "NaN", 0, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// resx625 rescale  NaN  1      ->  NaN
+	{"resx625", "NaN", -1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// resx626 rescale  NaN  1000   ->  NaN
+	{"resx626", "NaN", -1000, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx627 rescale  NaN  Inf    ->  NaN
+	// SKIP (exponent is not an int32): resx628 rescale  NaN  NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx629 rescale -Inf  NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx630 rescale -1000 NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx631 rescale -1    NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx632 rescale  0    NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx633 rescale  1   -NaN    -> -NaN
+	// SKIP (exponent is not an int32): resx634 rescale  1000 NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx635 rescale  Inf  NaN    ->  NaN
+	// resx636 rescale  NaN -0      ->  NaN
+	{"resx636", "NaN", 0, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx637 rescale -0    NaN    ->  NaN
+	// SKIP (exponent is not an int32): resx641 rescale  sNaN -Inf   ->  NaN  Invalid_operation
+	// resx642 rescale  sNaN -1000  ->  NaN  Invalid_operation
+	{"resx642", "sNaN", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx643 rescale  sNaN -1     ->  NaN  Invalid_operation
+	{"resx643", "sNaN", 1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx644 rescale  sNaN  0     ->  NaN  Invalid_operation
+	{"resx644", "sNaN", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx645 rescale  sNaN  1     ->  NaN  Invalid_operation
+	{"resx645", "sNaN", -1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// resx646 rescale  sNaN  1000  ->  NaN  Invalid_operation
+	{"resx646", "sNaN", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx647 rescale -sNaN  NaN   -> -NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx648 rescale  sNaN -sNaN  ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx649 rescale  NaN  sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx650 rescale -Inf  sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx651 rescale -1000 sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx652 rescale -1    sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx653 rescale  0    sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx654 rescale  1   -sNaN   -> -NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx655 rescale  1000 sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx656 rescale  Inf  sNaN   ->  NaN  Invalid_operation
+	// SKIP (exponent is not an int32): resx657 rescale  NaN  sNaN   ->  NaN  Invalid_operation
+	// resx658 rescale  sNaN -0     ->  NaN  Invalid_operation
+	{"resx658", "sNaN", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx659 rescale -0    sNaN   ->  NaN  Invalid_operation
+	// propagating NaNs
+	// SKIP (exponent is not an int32): resx661 rescale  NaN9 -Inf   ->  NaN9
+	// resx662 rescale  NaN81 919   ->  NaN81
+	{"resx662", "NaN81", -919, "NaN81", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx663 rescale  NaN72 Inf   ->  NaN72
+	// SKIP (exponent is not an int32): resx664 rescale -NaN66 NaN5  -> -NaN66
+	// SKIP (exponent is not an int32): resx665 rescale -Inf   NaN4  ->  NaN4
+	// SKIP (exponent is not an int32): resx666 rescale -919   NaN32 ->  NaN32
+	// SKIP (exponent is not an int32): resx667 rescale  Inf   NaN2  ->  NaN2
+	// SKIP (exponent is not an int32): resx671 rescale  sNaN99 -Inf    ->  NaN99 Invalid_operation
+	// resx672 rescale -sNaN98 -11     -> -NaN98 Invalid_operation
+	{"resx672", "-sNaN98", 11, "-NaN98", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (exponent is not an int32): resx673 rescale  sNaN97  NaN    ->  NaN97 Invalid_operation
+	// SKIP (exponent is not an int32): resx674 rescale  sNaN16 sNaN94  ->  NaN16 Invalid_operation
+	// SKIP (exponent is not an int32): resx675 rescale  NaN95  sNaN93  ->  NaN93 Invalid_operation
+	// SKIP (exponent is not an int32): resx676 rescale -Inf    sNaN92  ->  NaN92 Invalid_operation
+	// SKIP (exponent is not an int32): resx677 rescale  088   -sNaN91  -> -NaN91 Invalid_operation
+	// SKIP (exponent is not an int32): resx678 rescale  Inf   -sNaN90  -> -NaN90 Invalid_operation
+	// SKIP (exponent is not an int32): resx679 rescale  NaN    sNaN87  ->  NaN87 Invalid_operation
+	// subnormals and underflow
+	// precision: 4
+	// maxexponent: 999
+	// minexponent: -999
+	// resx710 rescale  1.00E-999    -999  ->   1E-999    Rounded
+	{"resx710", "1.00E-999", 999, "1E-999", Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx711 rescale  0.1E-999    -1000  ->   1E-1000   Subnormal
+	{"resx711", "0.1E-999", 1000, "1E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx712 rescale  0.10E-999   -1000  ->   1E-1000   Subnormal Rounded
+	{"resx712", "0.10E-999", 1000, "1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx713 rescale  0.100E-999  -1000  ->   1E-1000   Subnormal Rounded
+	{"resx713", "0.100E-999", 1000, "1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx714 rescale  0.01E-999   -1001  ->   1E-1001   Subnormal
+	{"resx714", "0.01E-999", 1001, "1E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// resx715 rescale  0.999E-999   -999  ->   1E-999    Inexact Rounded
+	{"resx715", "0.999E-999", 999, "1E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx716 rescale  0.099E-999  -1000  ->   1E-1000   Inexact Rounded Subnormal
+	{"resx716", "0.099E-999", 1000, "1E-1000", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx717 rescale  0.009E-999  -1001  ->   1E-1001   Inexact Rounded Subnormal
+	{"resx717", "0.009E-999", 1001, "1E-1001", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx718 rescale  0.001E-999  -1001  ->   0E-1001   Inexact Rounded
+	{"resx718", "0.001E-999", 1001, "0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx719 rescale  0.0009E-999 -1001  ->   0E-1001   Inexact Rounded
+	{"resx719", "0.0009E-999", 1001, "0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx720 rescale  0.0001E-999 -1001  ->   0E-1001   Inexact Rounded
+	{"resx720", "0.0001E-999", 1001, "0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx730 rescale -1.00E-999   -999  ->  -1E-999     Rounded
+	{"resx730", "-1.00E-999", 999, "-1E-999", Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx731 rescale -0.1E-999    -999  ->  -0E-999     Rounded Inexact
+	{"resx731", "-0.1E-999", 999, "-0E-999", Rounded | Inexact, 4, ToNearestAway, 999, -999, false},
+	// resx732 rescale -0.10E-999   -999  ->  -0E-999     Rounded Inexact
+	{"resx732", "-0.10E-999", 999, "-0E-999", Rounded | Inexact, 4, ToNearestAway, 999, -999, false},
+	// resx733 rescale -0.100E-999  -999  ->  -0E-999     Rounded Inexact
+	{"resx733", "-0.100E-999", 999, "-0E-999", Rounded | Inexact, 4, ToNearestAway, 999, -999, false},
+	// resx734 rescale -0.01E-999   -999  ->  -0E-999     Inexact Rounded
+	{"resx734", "-0.01E-999", 999, "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// resx735 rescale -0.999E-999  -999  ->  -1E-999     Inexact Rounded
+	{"resx735", "-0.999E-999", 999, "-1E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx736 rescale -0.099E-999  -999  ->  -0E-999     Inexact Rounded
+	{"resx736", "-0.099E-999", 999, "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx737 rescale -0.009E-999  -999  ->  -0E-999     Inexact Rounded
+	{"resx737", "-0.009E-999", 999, "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx738 rescale -0.001E-999  -999  ->  -0E-999     Inexact Rounded
+	{"resx738", "-0.001E-999", 999, "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx739 rescale -0.0001E-999 -999  ->  -0E-999     Inexact Rounded
+	{"resx739", "-0.0001E-999", 999, "-0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx740 rescale -1.00E-999   -1000 ->  -1.0E-999   Rounded
+	{"resx740", "-1.00E-999", 1000, "-1.0E-999", Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx741 rescale -0.1E-999    -1000 ->  -1E-1000    Subnormal
+	{"resx741", "-0.1E-999", 1000, "-1E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx742 rescale -0.10E-999   -1000 ->  -1E-1000    Subnormal Rounded
+	{"resx742", "-0.10E-999", 1000, "-1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx743 rescale -0.100E-999  -1000 ->  -1E-1000    Subnormal Rounded
+	{"resx743", "-0.100E-999", 1000, "-1E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx744 rescale -0.01E-999   -1000 ->  -0E-1000    Inexact Rounded
+	{"resx744", "-0.01E-999", 1000, "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// resx745 rescale -0.999E-999  -1000 ->  -1.0E-999   Inexact Rounded
+	{"resx745", "-0.999E-999", 1000, "-1.0E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx746 rescale -0.099E-999  -1000 ->  -1E-1000    Inexact Rounded Subnormal
+	{"resx746", "-0.099E-999", 1000, "-1E-1000", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx747 rescale -0.009E-999  -1000 ->  -0E-1000    Inexact Rounded
+	{"resx747", "-0.009E-999", 1000, "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx748 rescale -0.001E-999  -1000 ->  -0E-1000    Inexact Rounded
+	{"resx748", "-0.001E-999", 1000, "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx749 rescale -0.0001E-999 -1000 ->  -0E-1000    Inexact Rounded
+	{"resx749", "-0.0001E-999", 1000, "-0E-1000", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx750 rescale -1.00E-999   -1001 ->  -1.00E-999
+	{"resx750", "-1.00E-999", 1001, "-1.00E-999", 0, 4, ToNearestAway, 999, -999, false},
+	// resx751 rescale -0.1E-999    -1001 ->  -1.0E-1000  Subnormal
+	{"resx751", "-0.1E-999", 1001, "-1.0E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx752 rescale -0.10E-999   -1001 ->  -1.0E-1000  Subnormal
+	{"resx752", "-0.10E-999", 1001, "-1.0E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx753 rescale -0.100E-999  -1001 ->  -1.0E-1000  Subnormal Rounded
+	{"resx753", "-0.100E-999", 1001, "-1.0E-1000", Subnormal | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx754 rescale -0.01E-999   -1001 ->  -1E-1001    Subnormal
+	{"resx754", "-0.01E-999", 1001, "-1E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// next is rounded to Emin
+	// resx755 rescale -0.999E-999  -1001 ->  -1.00E-999  Inexact Rounded
+	{"resx755", "-0.999E-999", 1001, "-1.00E-999", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx756 rescale -0.099E-999  -1001 ->  -1.0E-1000  Inexact Rounded Subnormal
+	{"resx756", "-0.099E-999", 1001, "-1.0E-1000", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx757 rescale -0.009E-999  -1001 ->  -1E-1001    Inexact Rounded Subnormal
+	{"resx757", "-0.009E-999", 1001, "-1E-1001", Inexact | Rounded | Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx758 rescale -0.001E-999  -1001 ->  -0E-1001    Inexact Rounded
+	{"resx758", "-0.001E-999", 1001, "-0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx759 rescale -0.0001E-999 -1001 ->  -0E-1001    Inexact Rounded
+	{"resx759", "-0.0001E-999", 1001, "-0E-1001", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// resx760 rescale -1.00E-999   -1002 ->  -1.000E-999
+	{"resx760", "-1.00E-999", 1002, "-1.000E-999", 0, 4, ToNearestAway, 999, -999, false},
+	// resx761 rescale -0.1E-999    -1002 ->  -1.00E-1000  Subnormal
+	{"resx761", "-0.1E-999", 1002, "-1.00E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx762 rescale -0.10E-999   -1002 ->  -1.00E-1000  Subnormal
+	{"resx762", "-0.10E-999", 1002, "-1.00E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx763 rescale -0.100E-999  -1002 ->  -1.00E-1000  Subnormal
+	{"resx763", "-0.100E-999", 1002, "-1.00E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx764 rescale -0.01E-999   -1002 ->  -1.0E-1001   Subnormal
+	{"resx764", "-0.01E-999", 1002, "-1.0E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx765 rescale -0.999E-999  -1002 ->  -9.99E-1000  Subnormal
+	{"resx765", "-0.999E-999", 1002, "-9.99E-1000", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx766 rescale -0.099E-999  -1002 ->  -9.9E-1001   Subnormal
+	{"resx766", "-0.099E-999", 1002, "-9.9E-1001", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx767 rescale -0.009E-999  -1002 ->  -9E-1002     Subnormal
+	{"resx767", "-0.009E-999", 1002, "-9E-1002", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx768 rescale -0.001E-999  -1002 ->  -1E-1002     Subnormal
+	{"resx768", "-0.001E-999", 1002, "-1E-1002", Subnormal, 4, ToNearestAway, 999, -999, false},
+	// resx769 rescale -0.0001E-999 -1002 ->  -0E-1002     Inexact Rounded
+	{"resx769", "-0.0001E-999", 1002, "-0E-1002", Inexact | Rounded, 4, ToNearestAway, 999, -999, false},
+	// rhs must be no less than Etiny
+	// resx770 rescale -1.00E-999   -1003 ->  NaN Invalid_operation
+	{"resx770", "-1.00E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx771 rescale -0.1E-999    -1003 ->  NaN Invalid_operation
+	{"resx771", "-0.1E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx772 rescale -0.10E-999   -1003 ->  NaN Invalid_operation
+	{"resx772", "-0.10E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx773 rescale -0.100E-999  -1003 ->  NaN Invalid_operation
+	{"resx773", "-0.100E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx774 rescale -0.01E-999   -1003 ->  NaN Invalid_operation
+	{"resx774", "-0.01E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx775 rescale -0.999E-999  -1003 ->  NaN Invalid_operation
+	{"resx775", "-0.999E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx776 rescale -0.099E-999  -1003 ->  NaN Invalid_operation
+	{"resx776", "-0.099E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx777 rescale -0.009E-999  -1003 ->  NaN Invalid_operation
+	{"resx777", "-0.009E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx778 rescale -0.001E-999  -1003 ->  NaN Invalid_operation
+	{"resx778", "-0.001E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// resx779 rescale -0.0001E-999 -1003 ->  NaN Invalid_operation
+	{"resx779", "-0.0001E-999", 1003, "NaN", InvalidOperation, 4, ToNearestAway, 999, -999, false},
+	// precision: 9
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// getInt worries
+	// resx801 rescale   0   1000000000 -> NaN Invalid_operation
+	{"resx801", "0", -1000000000, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx802 rescale   0  -1000000000 -> 0E-1000000000
+	{"resx802", "0", 1000000000, "0E-1000000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx803 rescale   0   2000000000 -> NaN Invalid_operation
+	{"resx803", "0", -2000000000, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx804 rescale   0  -2000000000 -> NaN Invalid_operation
+	{"resx804", "0", 2000000000, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// SKIP (exponent is not an int32): resx805 rescale   0   3000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx806 rescale   0  -3000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx807 rescale   0   4000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx808 rescale   0  -4000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx809 rescale   0   5000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx810 rescale   0  -5000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx811 rescale   0   6000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx812 rescale   0  -6000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx813 rescale   0   7000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx814 rescale   0  -7000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx815 rescale   0   8000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx816 rescale   0  -8000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx817 rescale   0   9000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx818 rescale   0  -9000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx819 rescale   0   9999999999 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx820 rescale   0  -9999999999 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx821 rescale   0   10000000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx822 rescale   0  -10000000000 -> NaN Invalid_operation
+	// resx831 rescale   1   0E-1       -> 1
+	{"resx831", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx832 rescale   1   0E-2       -> 1
+	{"resx832", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx833 rescale   1   0E-3       -> 1
+	{"resx833", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx834 rescale   1   0E-4       -> 1
+	{"resx834", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx835 rescale   1   0E-100     -> 1
+	{"resx835", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx836 rescale   1   0E-100000  -> 1
+	{"resx836", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx837 rescale   1   0E+100     -> 1
+	{"resx837", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx838 rescale   1   0E+100000  -> 1
+	{"resx838", "1", 0, "1", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// SKIP (exponent is not an int32): resx841 rescale   0   5E-1000000 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx842 rescale   0   5E-1000000 -> NaN Invalid_operation
+	// resx843 rescale   0    999999999 -> 0E+999999999
+	{"resx843", "0", -999999999, "0E+999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx844 rescale   0   1000000000 -> NaN Invalid_operation
+	{"resx844", "0", -1000000000, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx845 rescale   0   -999999999 -> 0E-999999999
+	{"resx845", "0", 999999999, "0E-999999999", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx846 rescale   0  -1000000000 -> 0E-1000000000
+	{"resx846", "0", 1000000000, "0E-1000000000", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx847 rescale   0  -1000000001 -> 0E-1000000001
+	{"resx847", "0", 1000000001, "0E-1000000001", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx848 rescale   0  -1000000002 -> 0E-1000000002
+	{"resx848", "0", 1000000002, "0E-1000000002", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx849 rescale   0  -1000000003 -> 0E-1000000003
+	{"resx849", "0", 1000000003, "0E-1000000003", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx850 rescale   0  -1000000004 -> 0E-1000000004
+	{"resx850", "0", 1000000004, "0E-1000000004", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx851 rescale   0  -1000000005 -> 0E-1000000005
+	{"resx851", "0", 1000000005, "0E-1000000005", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx852 rescale   0  -1000000006 -> 0E-1000000006
+	{"resx852", "0", 1000000006, "0E-1000000006", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx853 rescale   0  -1000000007 -> 0E-1000000007
+	{"resx853", "0", 1000000007, "0E-1000000007", 0, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx854 rescale   0  -1000000008 -> NaN Invalid_operation
+	{"resx854", "0", 1000000008, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// SKIP (exponent is not an int32): resx861 rescale   1  +2147483649 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx862 rescale   1  +2147483648 -> NaN Invalid_operation
+	// resx863 rescale   1  +2147483647 -> NaN Invalid_operation
+	{"resx863", "1", -2147483647, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// resx864 rescale   1  -2147483647 -> NaN Invalid_operation
+	{"resx864", "1", 2147483647, "NaN", InvalidOperation, 9, ToNearestAway, 999999999, -999999999, false},
+	// SKIP (exponent is not an int32): resx865 rescale   1  -2147483648 -> NaN Invalid_operation
+	// SKIP (exponent is not an int32): resx866 rescale   1  -2147483649 -> NaN Invalid_operation
+	// Null tests
+	// SKIP (encoding not supported): res900 rescale 10  # -> NaN Invalid_operation
+	// SKIP (encoding not supported): res901 rescale  # 10 -> NaN Invalid_operation
+}
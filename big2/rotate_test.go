@@ -0,0 +1,354 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var rotateTests = []struct {
+	id    string
+	in    string
+	n     int
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check
+	// rotx001 rotate          0    0  ->  0
+	{"rotx001", "0", 0, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx002 rotate          0    2  ->  0
+	{"rotx002", "0", 2, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx003 rotate          1    2  ->  100
+	{"rotx003", "1", 2, "100", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx004 rotate         34    8  ->  400000003
+	{"rotx004", "34", 8, "400000003", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx005 rotate          1    9  ->  1
+	{"rotx005", "1", 9, "1", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx006 rotate          1   -1  ->  100000000
+	{"rotx006", "1", -1, "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx007 rotate  123456789   -1  ->  912345678
+	{"rotx007", "123456789", -1, "912345678", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx008 rotate  123456789   -8  ->  234567891
+	{"rotx008", "123456789", -8, "234567891", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx009 rotate  123456789   -9  ->  123456789
+	{"rotx009", "123456789", -9, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx010 rotate          0   -2  ->  0
+	{"rotx010", "0", -2, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rhs must be an integer
+	// SKIP (second operand is not an int): rotx011 rotate        1    1.5    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx012 rotate        1    1.0    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx013 rotate        1    0.1    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx014 rotate        1    0.0    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx015 rotate        1    1E+1   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx016 rotate        1    1E+99  -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx017 rotate        1    Inf    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx018 rotate        1    -Inf   -> NaN Invalid_operation
+	// and |rhs| <= precision
+	// rotx020 rotate        1    -1000  -> NaN Invalid_operation
+	{"rotx020", "1", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx021 rotate        1    -10    -> NaN Invalid_operation
+	{"rotx021", "1", -10, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx022 rotate        1     10    -> NaN Invalid_operation
+	{"rotx022", "1", 10, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx023 rotate        1     1000  -> NaN Invalid_operation
+	{"rotx023", "1", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// full pattern
+	// rotx030 rotate  123456789          -9   -> 123456789
+	{"rotx030", "123456789", -9, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx031 rotate  123456789          -8   -> 234567891
+	{"rotx031", "123456789", -8, "234567891", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx032 rotate  123456789          -7   -> 345678912
+	{"rotx032", "123456789", -7, "345678912", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx033 rotate  123456789          -6   -> 456789123
+	{"rotx033", "123456789", -6, "456789123", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx034 rotate  123456789          -5   -> 567891234
+	{"rotx034", "123456789", -5, "567891234", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx035 rotate  123456789          -4   -> 678912345
+	{"rotx035", "123456789", -4, "678912345", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx036 rotate  123456789          -3   -> 789123456
+	{"rotx036", "123456789", -3, "789123456", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx037 rotate  123456789          -2   -> 891234567
+	{"rotx037", "123456789", -2, "891234567", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx038 rotate  123456789          -1   -> 912345678
+	{"rotx038", "123456789", -1, "912345678", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx039 rotate  123456789          -0   -> 123456789
+	{"rotx039", "123456789", 0, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx040 rotate  123456789          +0   -> 123456789
+	{"rotx040", "123456789", 0, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx041 rotate  123456789          +1   -> 234567891
+	{"rotx041", "123456789", 1, "234567891", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx042 rotate  123456789          +2   -> 345678912
+	{"rotx042", "123456789", 2, "345678912", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx043 rotate  123456789          +3   -> 456789123
+	{"rotx043", "123456789", 3, "456789123", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx044 rotate  123456789          +4   -> 567891234
+	{"rotx044", "123456789", 4, "567891234", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx045 rotate  123456789          +5   -> 678912345
+	{"rotx045", "123456789", 5, "678912345", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx046 rotate  123456789          +6   -> 789123456
+	{"rotx046", "123456789", 6, "789123456", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx047 rotate  123456789          +7   -> 891234567
+	{"rotx047", "123456789", 7, "891234567", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx048 rotate  123456789          +8   -> 912345678
+	{"rotx048", "123456789", 8, "912345678", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx049 rotate  123456789          +9   -> 123456789
+	{"rotx049", "123456789", 9, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// rotx060 rotate  0E-10              +9   ->   0E-10
+	{"rotx060", "0E-10", 9, "0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx061 rotate  0E-10              -9   ->   0E-10
+	{"rotx061", "0E-10", -9, "0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx062 rotate  0.000              +9   ->   0.000
+	{"rotx062", "0.000", 9, "0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx063 rotate  0.000              -9   ->   0.000
+	{"rotx063", "0.000", -9, "0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx064 rotate  0E+10              +9   ->   0E+10
+	{"rotx064", "0E+10", 9, "0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx065 rotate  0E+10              -9   ->   0E+10
+	{"rotx065", "0E+10", -9, "0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx066 rotate -0E-10              +9   ->  -0E-10
+	{"rotx066", "-0E-10", 9, "-0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx067 rotate -0E-10              -9   ->  -0E-10
+	{"rotx067", "-0E-10", -9, "-0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx068 rotate -0.000              +9   ->  -0.000
+	{"rotx068", "-0.000", 9, "-0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx069 rotate -0.000              -9   ->  -0.000
+	{"rotx069", "-0.000", -9, "-0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx070 rotate -0E+10              +9   ->  -0E+10
+	{"rotx070", "-0E+10", 9, "-0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx071 rotate -0E+10              -9   ->  -0E+10
+	{"rotx071", "-0E+10", -9, "-0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// rotx141 rotate  9.99999999E+999     -1  -> 9.99999999E+999
+	{"rotx141", "9.99999999E+999", -1, "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx142 rotate  9.99999999E+999     -8  -> 9.99999999E+999
+	{"rotx142", "9.99999999E+999", -8, "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx143 rotate  9.99999999E+999      1  -> 9.99999999E+999
+	{"rotx143", "9.99999999E+999", 1, "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx144 rotate  9.99999999E+999      8  -> 9.99999999E+999
+	{"rotx144", "9.99999999E+999", 8, "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx145 rotate  1E-999              -1  -> 1.00000000E-991
+	{"rotx145", "1E-999", -1, "1.00000000E-991", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx146 rotate  1E-999              -8  -> 1.0E-998
+	{"rotx146", "1E-999", -8, "1.0E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx147 rotate  1E-999               1  -> 1.0E-998
+	{"rotx147", "1E-999", 1, "1.0E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx148 rotate  1E-999               8  -> 1.00000000E-991
+	{"rotx148", "1E-999", 8, "1.00000000E-991", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx151 rotate  1.00000000E-999     -1  -> 1.0000000E-1000
+	{"rotx151", "1.00000000E-999", -1, "1.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx152 rotate  1.00000000E-999     -8  -> 1E-1007
+	{"rotx152", "1.00000000E-999", -8, "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx153 rotate  1.00000000E-999      1  -> 1E-1007
+	{"rotx153", "1.00000000E-999", 1, "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx154 rotate  1.00000000E-999      8  -> 1.0000000E-1000
+	{"rotx154", "1.00000000E-999", 8, "1.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx155 rotate  9.00000000E-999     -1  -> 9.0000000E-1000
+	{"rotx155", "9.00000000E-999", -1, "9.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx156 rotate  9.00000000E-999     -8  -> 9E-1007
+	{"rotx156", "9.00000000E-999", -8, "9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx157 rotate  9.00000000E-999      1  -> 9E-1007
+	{"rotx157", "9.00000000E-999", 1, "9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx158 rotate  9.00000000E-999      8  -> 9.0000000E-1000
+	{"rotx158", "9.00000000E-999", 8, "9.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx160 rotate  1E-1007             -1  -> 1.00000000E-999
+	{"rotx160", "1E-1007", -1, "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx161 rotate  1E-1007             -8  -> 1.0E-1006
+	{"rotx161", "1E-1007", -8, "1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx162 rotate  1E-1007              1  -> 1.0E-1006
+	{"rotx162", "1E-1007", 1, "1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx163 rotate  1E-1007              8  -> 1.00000000E-999
+	{"rotx163", "1E-1007", 8, "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	//  negatives
+	// rotx171 rotate -9.99999999E+999     -1  -> -9.99999999E+999
+	{"rotx171", "-9.99999999E+999", -1, "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx172 rotate -9.99999999E+999     -8  -> -9.99999999E+999
+	{"rotx172", "-9.99999999E+999", -8, "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx173 rotate -9.99999999E+999      1  -> -9.99999999E+999
+	{"rotx173", "-9.99999999E+999", 1, "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx174 rotate -9.99999999E+999      8  -> -9.99999999E+999
+	{"rotx174", "-9.99999999E+999", 8, "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx175 rotate -1E-999              -1  -> -1.00000000E-991
+	{"rotx175", "-1E-999", -1, "-1.00000000E-991", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx176 rotate -1E-999              -8  -> -1.0E-998
+	{"rotx176", "-1E-999", -8, "-1.0E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx177 rotate -1E-999               1  -> -1.0E-998
+	{"rotx177", "-1E-999", 1, "-1.0E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx178 rotate -1E-999               8  -> -1.00000000E-991
+	{"rotx178", "-1E-999", 8, "-1.00000000E-991", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx181 rotate -1.00000000E-999     -1  -> -1.0000000E-1000
+	{"rotx181", "-1.00000000E-999", -1, "-1.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx182 rotate -1.00000000E-999     -8  -> -1E-1007
+	{"rotx182", "-1.00000000E-999", -8, "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx183 rotate -1.00000000E-999      1  -> -1E-1007
+	{"rotx183", "-1.00000000E-999", 1, "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx184 rotate -1.00000000E-999      8  -> -1.0000000E-1000
+	{"rotx184", "-1.00000000E-999", 8, "-1.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx185 rotate -9.00000000E-999     -1  -> -9.0000000E-1000
+	{"rotx185", "-9.00000000E-999", -1, "-9.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx186 rotate -9.00000000E-999     -8  -> -9E-1007
+	{"rotx186", "-9.00000000E-999", -8, "-9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx187 rotate -9.00000000E-999      1  -> -9E-1007
+	{"rotx187", "-9.00000000E-999", 1, "-9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx188 rotate -9.00000000E-999      8  -> -9.0000000E-1000
+	{"rotx188", "-9.00000000E-999", 8, "-9.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx190 rotate -1E-1007             -1  -> -1.00000000E-999
+	{"rotx190", "-1E-1007", -1, "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx191 rotate -1E-1007             -8  -> -1.0E-1006
+	{"rotx191", "-1E-1007", -8, "-1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx192 rotate -1E-1007              1  -> -1.0E-1006
+	{"rotx192", "-1E-1007", 1, "-1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx193 rotate -1E-1007              8  -> -1.00000000E-999
+	{"rotx193", "-1E-1007", 8, "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// more negatives (of sanities)
+	// rotx201 rotate         -0    0  ->  -0
+	{"rotx201", "-0", 0, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx202 rotate         -0    2  ->  -0
+	{"rotx202", "-0", 2, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx203 rotate         -1    2  ->  -100
+	{"rotx203", "-1", 2, "-100", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx204 rotate         -1    8  ->  -100000000
+	{"rotx204", "-1", 8, "-100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx205 rotate         -1    9  ->  -1
+	{"rotx205", "-1", 9, "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx206 rotate         -1   -1  ->  -100000000
+	{"rotx206", "-1", -1, "-100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx207 rotate -123456789   -1  ->  -912345678
+	{"rotx207", "-123456789", -1, "-912345678", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx208 rotate -123456789   -8  ->  -234567891
+	{"rotx208", "-123456789", -8, "-234567891", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx209 rotate -123456789   -9  ->  -123456789
+	{"rotx209", "-123456789", -9, "-123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx210 rotate         -0   -2  ->  -0
+	{"rotx210", "-0", -2, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// Specials; NaNs are handled as usual
+	// rotx781 rotate -Inf  -8     -> -Infinity
+	{"rotx781", "-Inf", -8, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx782 rotate -Inf  -1     -> -Infinity
+	{"rotx782", "-Inf", -1, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx783 rotate -Inf  -0     -> -Infinity
+	{"rotx783", "-Inf", 0, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx784 rotate -Inf   0     -> -Infinity
+	{"rotx784", "-Inf", 0, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx785 rotate -Inf   1     -> -Infinity
+	{"rotx785", "-Inf", 1, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx786 rotate -Inf   8     -> -Infinity
+	{"rotx786", "-Inf", 8, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): rotx787 rotate -1000 -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx788 rotate -Inf  -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx789 rotate -1    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx790 rotate -0    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx791 rotate  0    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx792 rotate  1    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx793 rotate  1000 -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx794 rotate  Inf  -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx800 rotate  Inf  -Inf   -> NaN Invalid_operation
+	// rotx801 rotate  Inf  -8     -> Infinity
+	{"rotx801", "Inf", -8, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx802 rotate  Inf  -1     -> Infinity
+	{"rotx802", "Inf", -1, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx803 rotate  Inf  -0     -> Infinity
+	{"rotx803", "Inf", 0, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx804 rotate  Inf   0     -> Infinity
+	{"rotx804", "Inf", 0, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx805 rotate  Inf   1     -> Infinity
+	{"rotx805", "Inf", 1, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx806 rotate  Inf   8     -> Infinity
+	{"rotx806", "Inf", 8, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): rotx807 rotate  Inf   Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx808 rotate -1000  Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx809 rotate -Inf   Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx810 rotate -1     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx811 rotate -0     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx812 rotate  0     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx813 rotate  1     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx814 rotate  1000  Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx815 rotate  Inf   Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): rotx821 rotate  NaN -Inf    ->  NaN
+	// rotx822 rotate  NaN -1000   ->  NaN
+	{"rotx822", "NaN", -1000, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx823 rotate  NaN -1      ->  NaN
+	{"rotx823", "NaN", -1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx824 rotate  NaN -0      ->  NaN
+	{"rotx824", "NaN", 0, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx825 rotate  NaN  0      ->  NaN
+	{"rotx825", "NaN", 0, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx826 rotate  NaN  1      ->  NaN
+	{"rotx826", "NaN", 1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx827 rotate  NaN  1000   ->  NaN
+	{"rotx827", "NaN", 1000, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): rotx828 rotate  NaN  Inf    ->  NaN
+	// SKIP (second operand is not an int): rotx829 rotate  NaN  NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx830 rotate -Inf  NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx831 rotate -1000 NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx832 rotate -1    NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx833 rotate -0    NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx834 rotate  0    NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx835 rotate  1    NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx836 rotate  1000 NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx837 rotate  Inf  NaN    ->  NaN
+	// SKIP (second operand is not an int): rotx841 rotate  sNaN -Inf   ->  NaN  Invalid_operation
+	// rotx842 rotate  sNaN -1000  ->  NaN  Invalid_operation
+	{"rotx842", "sNaN", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx843 rotate  sNaN -1     ->  NaN  Invalid_operation
+	{"rotx843", "sNaN", -1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx844 rotate  sNaN -0     ->  NaN  Invalid_operation
+	{"rotx844", "sNaN", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx845 rotate  sNaN  0     ->  NaN  Invalid_operation
+	{"rotx845", "sNaN", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx846 rotate  sNaN  1     ->  NaN  Invalid_operation
+	{"rotx846", "sNaN", 1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx847 rotate  sNaN  1000  ->  NaN  Invalid_operation
+	{"rotx847", "sNaN", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): rotx848 rotate  sNaN  NaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx849 rotate  sNaN sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx850 rotate  NaN  sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx851 rotate -Inf  sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx852 rotate -1000 sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx853 rotate -1    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx854 rotate -0    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx855 rotate  0    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx856 rotate  1    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx857 rotate  1000 sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx858 rotate  Inf  sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): rotx859 rotate  NaN  sNaN   ->  NaN  Invalid_operation
+	// propagating NaNs
+	// SKIP (second operand is not an int): rotx861 rotate  NaN1   -Inf    ->  NaN1
+	// rotx862 rotate +NaN2   -1000   ->  NaN2
+	{"rotx862", "+NaN2", -1000, "NaN2", 0, 9, ToNearestAway, 999, -999, false},
+	// rotx863 rotate  NaN3    1000   ->  NaN3
+	{"rotx863", "NaN3", 1000, "NaN3", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): rotx864 rotate  NaN4    Inf    ->  NaN4
+	// SKIP (second operand is not an int): rotx865 rotate  NaN5   +NaN6   ->  NaN5
+	// SKIP (second operand is not an int): rotx866 rotate -Inf     NaN7   ->  NaN7
+	// SKIP (second operand is not an int): rotx867 rotate -1000    NaN8   ->  NaN8
+	// SKIP (second operand is not an int): rotx868 rotate  1000    NaN9   ->  NaN9
+	// SKIP (second operand is not an int): rotx869 rotate  Inf    +NaN10  ->  NaN10
+	// SKIP (second operand is not an int): rotx871 rotate  sNaN11  -Inf   ->  NaN11  Invalid_operation
+	// rotx872 rotate  sNaN12  -1000  ->  NaN12  Invalid_operation
+	{"rotx872", "sNaN12", -1000, "NaN12", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// rotx873 rotate  sNaN13   1000  ->  NaN13  Invalid_operation
+	{"rotx873", "sNaN13", 1000, "NaN13", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): rotx874 rotate  sNaN14   NaN17 ->  NaN14  Invalid_operation
+	// SKIP (second operand is not an int): rotx875 rotate  sNaN15  sNaN18 ->  NaN15  Invalid_operation
+	// SKIP (second operand is not an int): rotx876 rotate  NaN16   sNaN19 ->  NaN19  Invalid_operation
+	// SKIP (second operand is not an int): rotx877 rotate -Inf    +sNaN20 ->  NaN20  Invalid_operation
+	// SKIP (second operand is not an int): rotx878 rotate -1000    sNaN21 ->  NaN21  Invalid_operation
+	// SKIP (second operand is not an int): rotx879 rotate  1000    sNaN22 ->  NaN22  Invalid_operation
+	// SKIP (second operand is not an int): rotx880 rotate  Inf     sNaN23 ->  NaN23  Invalid_operation
+	// SKIP (second operand is not an int): rotx881 rotate +NaN25  +sNaN24 ->  NaN24  Invalid_operation
+	// SKIP (second operand is not an int): rotx882 rotate -NaN26    NaN28 -> -NaN26
+	// SKIP (second operand is not an int): rotx883 rotate -sNaN27  sNaN29 -> -NaN27  Invalid_operation
+	// SKIP (second operand is not an int): rotx884 rotate  1000    -NaN30 -> -NaN30
+	// SKIP (second operand is not an int): rotx885 rotate  1000   -sNaN31 -> -NaN31  Invalid_operation
+	// payload decapitate
+	// precision: 5
+	// SKIP (second operand is not an int): rotx886 rotate  11 -sNaN1234567890 -> -NaN67890  Invalid_operation
+}
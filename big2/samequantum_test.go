@@ -0,0 +1,687 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var samequantumTests = []struct {
+	id  string
+	in1 string
+	in2 string
+	out bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// samq001 samequantum  0      0      ->  1
+	{"samq001", "0", "0", true},
+	// samq002 samequantum  0      1      ->  1
+	{"samq002", "0", "1", true},
+	// samq003 samequantum  1      0      ->  1
+	{"samq003", "1", "0", true},
+	// samq004 samequantum  1      1      ->  1
+	{"samq004", "1", "1", true},
+	// samq011 samequantum  10     1E+1   -> 0
+	{"samq011", "10", "1E+1", false},
+	// samq012 samequantum  10E+1  10E+1  -> 1
+	{"samq012", "10E+1", "10E+1", true},
+	// samq013 samequantum  100    10E+1  -> 0
+	{"samq013", "100", "10E+1", false},
+	// samq014 samequantum  100    1E+2   -> 0
+	{"samq014", "100", "1E+2", false},
+	// samq015 samequantum  0.1    1E-2   -> 0
+	{"samq015", "0.1", "1E-2", false},
+	// samq016 samequantum  0.1    1E-1   -> 1
+	{"samq016", "0.1", "1E-1", true},
+	// samq017 samequantum  0.1    1E-0   -> 0
+	{"samq017", "0.1", "1E-0", false},
+	// samq018 samequantum  999    999    -> 1
+	{"samq018", "999", "999", true},
+	// samq019 samequantum  999E-1 99.9   -> 1
+	{"samq019", "999E-1", "99.9", true},
+	// samq020 samequantum  111E-1 22.2   -> 1
+	{"samq020", "111E-1", "22.2", true},
+	// samq021 samequantum  111E-1 1234.2 -> 1
+	{"samq021", "111E-1", "1234.2", true},
+	// zeros
+	// samq030 samequantum  0.0    1.1    -> 1
+	{"samq030", "0.0", "1.1", true},
+	// samq031 samequantum  0.0    1.11   -> 0
+	{"samq031", "0.0", "1.11", false},
+	// samq032 samequantum  0.0    0      -> 0
+	{"samq032", "0.0", "0", false},
+	// samq033 samequantum  0.0    0.0    -> 1
+	{"samq033", "0.0", "0.0", true},
+	// samq034 samequantum  0.0    0.00   -> 0
+	{"samq034", "0.0", "0.00", false},
+	// samq035 samequantum  0E+1   0E+0   -> 0
+	{"samq035", "0E+1", "0E+0", false},
+	// samq036 samequantum  0E+1   0E+1   -> 1
+	{"samq036", "0E+1", "0E+1", true},
+	// samq037 samequantum  0E+1   0E+2   -> 0
+	{"samq037", "0E+1", "0E+2", false},
+	// samq038 samequantum  0E-17  0E-16  -> 0
+	{"samq038", "0E-17", "0E-16", false},
+	// samq039 samequantum  0E-17  0E-17  -> 1
+	{"samq039", "0E-17", "0E-17", true},
+	// samq040 samequantum  0E-17  0E-18  -> 0
+	{"samq040", "0E-17", "0E-18", false},
+	// samq041 samequantum  0E-17  0.0E-15 -> 0
+	{"samq041", "0E-17", "0.0E-15", false},
+	// samq042 samequantum  0E-17  0.0E-16 -> 1
+	{"samq042", "0E-17", "0.0E-16", true},
+	// samq043 samequantum  0E-17  0.0E-17 -> 0
+	{"samq043", "0E-17", "0.0E-17", false},
+	// samq044 samequantum -0E-17  0.0E-16 -> 1
+	{"samq044", "-0E-17", "0.0E-16", true},
+	// samq045 samequantum  0E-17 -0.0E-17 -> 0
+	{"samq045", "0E-17", "-0.0E-17", false},
+	// samq046 samequantum  0E-17 -0.0E-16 -> 1
+	{"samq046", "0E-17", "-0.0E-16", true},
+	// samq047 samequantum -0E-17  0.0E-17 -> 0
+	{"samq047", "-0E-17", "0.0E-17", false},
+	// samq048 samequantum -0E-17 -0.0E-16 -> 1
+	{"samq048", "-0E-17", "-0.0E-16", true},
+	// samq049 samequantum -0E-17 -0.0E-17 -> 0
+	{"samq049", "-0E-17", "-0.0E-17", false},
+	// Nmax, Nmin, Ntiny
+	// samq051 samequantum  9.99999999E+999    9.99999999E+999  -> 1
+	{"samq051", "9.99999999E+999", "9.99999999E+999", true},
+	// samq052 samequantum  1E-999             1E-999           -> 1
+	{"samq052", "1E-999", "1E-999", true},
+	// samq053 samequantum  1.00000000E-999    1.00000000E-999  -> 1
+	{"samq053", "1.00000000E-999", "1.00000000E-999", true},
+	// samq054 samequantum  1E-1007            1E-1007          -> 1
+	{"samq054", "1E-1007", "1E-1007", true},
+	// samq055 samequantum  9.99999999E+999    9.99999999E+999  -> 1
+	{"samq055", "9.99999999E+999", "9.99999999E+999", true},
+	// samq056 samequantum  1E-999             1E-999           -> 1
+	{"samq056", "1E-999", "1E-999", true},
+	// samq057 samequantum  1.00000000E-999    1.00000000E-999  -> 1
+	{"samq057", "1.00000000E-999", "1.00000000E-999", true},
+	// samq058 samequantum  1E-1007            1E-1007          -> 1
+	{"samq058", "1E-1007", "1E-1007", true},
+	// samq061 samequantum  -1E-1007           -1E-1007         -> 1
+	{"samq061", "-1E-1007", "-1E-1007", true},
+	// samq062 samequantum  -1.00000000E-999   -1.00000000E-999 -> 1
+	{"samq062", "-1.00000000E-999", "-1.00000000E-999", true},
+	// samq063 samequantum  -1E-999            -1E-999          -> 1
+	{"samq063", "-1E-999", "-1E-999", true},
+	// samq064 samequantum  -9.99999999E+999   -9.99999999E+999 -> 1
+	{"samq064", "-9.99999999E+999", "-9.99999999E+999", true},
+	// samq065 samequantum  -1E-1007           -1E-1007         -> 1
+	{"samq065", "-1E-1007", "-1E-1007", true},
+	// samq066 samequantum  -1.00000000E-999   -1.00000000E-999 -> 1
+	{"samq066", "-1.00000000E-999", "-1.00000000E-999", true},
+	// samq067 samequantum  -1E-999            -1E-999          -> 1
+	{"samq067", "-1E-999", "-1E-999", true},
+	// samq068 samequantum  -9.99999999E+999   -9.99999999E+999 -> 1
+	{"samq068", "-9.99999999E+999", "-9.99999999E+999", true},
+	// samq071 samequantum  -4E-1007           -1E-1007         -> 1
+	{"samq071", "-4E-1007", "-1E-1007", true},
+	// samq072 samequantum  -4.00000000E-999   -1.00004000E-999 -> 1
+	{"samq072", "-4.00000000E-999", "-1.00004000E-999", true},
+	// samq073 samequantum  -4E-999            -1E-999          -> 1
+	{"samq073", "-4E-999", "-1E-999", true},
+	// samq074 samequantum  -4.99999999E+999   -9.99949999E+999 -> 1
+	{"samq074", "-4.99999999E+999", "-9.99949999E+999", true},
+	// samq075 samequantum  -4E-1007           -1E-1007         -> 1
+	{"samq075", "-4E-1007", "-1E-1007", true},
+	// samq076 samequantum  -4.00000000E-999   -1.00400000E-999 -> 1
+	{"samq076", "-4.00000000E-999", "-1.00400000E-999", true},
+	// samq077 samequantum  -4E-999            -1E-999          -> 1
+	{"samq077", "-4E-999", "-1E-999", true},
+	// samq078 samequantum  -4.99999999E+999   -9.94999999E+999 -> 1
+	{"samq078", "-4.99999999E+999", "-9.94999999E+999", true},
+	// samq081 samequantum  -4E-1006           -1E-1007         -> 0
+	{"samq081", "-4E-1006", "-1E-1007", false},
+	// samq082 samequantum  -4.00000000E-999   -1.00004000E-996 -> 0
+	{"samq082", "-4.00000000E-999", "-1.00004000E-996", false},
+	// samq083 samequantum  -4E-996            -1E-999          -> 0
+	{"samq083", "-4E-996", "-1E-999", false},
+	// samq084 samequantum  -4.99999999E+999   -9.99949999E+996 -> 0
+	{"samq084", "-4.99999999E+999", "-9.99949999E+996", false},
+	// samq085 samequantum  -4E-1006           -1E-1007         -> 0
+	{"samq085", "-4E-1006", "-1E-1007", false},
+	// samq086 samequantum  -4.00000000E-999   -1.00400000E-996 -> 0
+	{"samq086", "-4.00000000E-999", "-1.00400000E-996", false},
+	// samq087 samequantum  -4E-996            -1E-999          -> 0
+	{"samq087", "-4E-996", "-1E-999", false},
+	// samq088 samequantum  -4.99999999E+999   -9.94999999E+996 -> 0
+	{"samq088", "-4.99999999E+999", "-9.94999999E+996", false},
+	// specials & combinations
+	// samq0110 samequantum  -Inf    -Inf   -> 1
+	{"samq0110", "-Inf", "-Inf", true},
+	// samq0111 samequantum  -Inf     Inf   -> 1
+	{"samq0111", "-Inf", "Inf", true},
+	// samq0112 samequantum  -Inf     NaN   -> 0
+	{"samq0112", "-Inf", "NaN", false},
+	// samq0113 samequantum  -Inf    -7E+3  -> 0
+	{"samq0113", "-Inf", "-7E+3", false},
+	// samq0114 samequantum  -Inf    -7     -> 0
+	{"samq0114", "-Inf", "-7", false},
+	// samq0115 samequantum  -Inf    -7E-3  -> 0
+	{"samq0115", "-Inf", "-7E-3", false},
+	// samq0116 samequantum  -Inf    -0E-3  -> 0
+	{"samq0116", "-Inf", "-0E-3", false},
+	// samq0117 samequantum  -Inf    -0     -> 0
+	{"samq0117", "-Inf", "-0", false},
+	// samq0118 samequantum  -Inf    -0E+3  -> 0
+	{"samq0118", "-Inf", "-0E+3", false},
+	// samq0119 samequantum  -Inf     0E-3  -> 0
+	{"samq0119", "-Inf", "0E-3", false},
+	// samq0120 samequantum  -Inf     0     -> 0
+	{"samq0120", "-Inf", "0", false},
+	// samq0121 samequantum  -Inf     0E+3  -> 0
+	{"samq0121", "-Inf", "0E+3", false},
+	// samq0122 samequantum  -Inf     7E-3  -> 0
+	{"samq0122", "-Inf", "7E-3", false},
+	// samq0123 samequantum  -Inf     7     -> 0
+	{"samq0123", "-Inf", "7", false},
+	// samq0124 samequantum  -Inf     7E+3  -> 0
+	{"samq0124", "-Inf", "7E+3", false},
+	// samq0125 samequantum  -Inf     sNaN  -> 0
+	{"samq0125", "-Inf", "sNaN", false},
+	// samq0210 samequantum   Inf    -Inf   -> 1
+	{"samq0210", "Inf", "-Inf", true},
+	// samq0211 samequantum   Inf     Inf   -> 1
+	{"samq0211", "Inf", "Inf", true},
+	// samq0212 samequantum   Inf     NaN   -> 0
+	{"samq0212", "Inf", "NaN", false},
+	// samq0213 samequantum   Inf    -7E+3  -> 0
+	{"samq0213", "Inf", "-7E+3", false},
+	// samq0214 samequantum   Inf    -7     -> 0
+	{"samq0214", "Inf", "-7", false},
+	// samq0215 samequantum   Inf    -7E-3  -> 0
+	{"samq0215", "Inf", "-7E-3", false},
+	// samq0216 samequantum   Inf    -0E-3  -> 0
+	{"samq0216", "Inf", "-0E-3", false},
+	// samq0217 samequantum   Inf    -0     -> 0
+	{"samq0217", "Inf", "-0", false},
+	// samq0218 samequantum   Inf    -0E+3  -> 0
+	{"samq0218", "Inf", "-0E+3", false},
+	// samq0219 samequantum   Inf     0E-3  -> 0
+	{"samq0219", "Inf", "0E-3", false},
+	// samq0220 samequantum   Inf     0     -> 0
+	{"samq0220", "Inf", "0", false},
+	// samq0221 samequantum   Inf     0E+3  -> 0
+	{"samq0221", "Inf", "0E+3", false},
+	// samq0222 samequantum   Inf     7E-3  -> 0
+	{"samq0222", "Inf", "7E-3", false},
+	// samq0223 samequantum   Inf     7     -> 0
+	{"samq0223", "Inf", "7", false},
+	// samq0224 samequantum   Inf     7E+3  -> 0
+	{"samq0224", "Inf", "7E+3", false},
+	// samq0225 samequantum   Inf     sNaN  -> 0
+	{"samq0225", "Inf", "sNaN", false},
+	// samq0310 samequantum   NaN    -Inf   -> 0
+	{"samq0310", "NaN", "-Inf", false},
+	// samq0311 samequantum   NaN     Inf   -> 0
+	{"samq0311", "NaN", "Inf", false},
+	// samq0312 samequantum   NaN     NaN   -> 1
+	{"samq0312", "NaN", "NaN", true},
+	// samq0313 samequantum   NaN    -7E+3  -> 0
+	{"samq0313", "NaN", "-7E+3", false},
+	// samq0314 samequantum   NaN    -7     -> 0
+	{"samq0314", "NaN", "-7", false},
+	// samq0315 samequantum   NaN    -7E-3  -> 0
+	{"samq0315", "NaN", "-7E-3", false},
+	// samq0316 samequantum   NaN    -0E-3  -> 0
+	{"samq0316", "NaN", "-0E-3", false},
+	// samq0317 samequantum   NaN    -0     -> 0
+	{"samq0317", "NaN", "-0", false},
+	// samq0318 samequantum   NaN    -0E+3  -> 0
+	{"samq0318", "NaN", "-0E+3", false},
+	// samq0319 samequantum   NaN     0E-3  -> 0
+	{"samq0319", "NaN", "0E-3", false},
+	// samq0320 samequantum   NaN     0     -> 0
+	{"samq0320", "NaN", "0", false},
+	// samq0321 samequantum   NaN     0E+3  -> 0
+	{"samq0321", "NaN", "0E+3", false},
+	// samq0322 samequantum   NaN     7E-3  -> 0
+	{"samq0322", "NaN", "7E-3", false},
+	// samq0323 samequantum   NaN     7     -> 0
+	{"samq0323", "NaN", "7", false},
+	// samq0324 samequantum   NaN     7E+3  -> 0
+	{"samq0324", "NaN", "7E+3", false},
+	// samq0325 samequantum   NaN     sNaN  -> 1
+	{"samq0325", "NaN", "sNaN", true},
+	// samq0410 samequantum  -7E+3    -Inf   -> 0
+	{"samq0410", "-7E+3", "-Inf", false},
+	// samq0411 samequantum  -7E+3     Inf   -> 0
+	{"samq0411", "-7E+3", "Inf", false},
+	// samq0412 samequantum  -7E+3     NaN   -> 0
+	{"samq0412", "-7E+3", "NaN", false},
+	// samq0413 samequantum  -7E+3    -7E+3  -> 1
+	{"samq0413", "-7E+3", "-7E+3", true},
+	// samq0414 samequantum  -7E+3    -7     -> 0
+	{"samq0414", "-7E+3", "-7", false},
+	// samq0415 samequantum  -7E+3    -7E-3  -> 0
+	{"samq0415", "-7E+3", "-7E-3", false},
+	// samq0416 samequantum  -7E+3    -0E-3  -> 0
+	{"samq0416", "-7E+3", "-0E-3", false},
+	// samq0417 samequantum  -7E+3    -0     -> 0
+	{"samq0417", "-7E+3", "-0", false},
+	// samq0418 samequantum  -7E+3    -0E+3  -> 1
+	{"samq0418", "-7E+3", "-0E+3", true},
+	// samq0419 samequantum  -7E+3     0E-3  -> 0
+	{"samq0419", "-7E+3", "0E-3", false},
+	// samq0420 samequantum  -7E+3     0     -> 0
+	{"samq0420", "-7E+3", "0", false},
+	// samq0421 samequantum  -7E+3     0E+3  -> 1
+	{"samq0421", "-7E+3", "0E+3", true},
+	// samq0422 samequantum  -7E+3     7E-3  -> 0
+	{"samq0422", "-7E+3", "7E-3", false},
+	// samq0423 samequantum  -7E+3     7     -> 0
+	{"samq0423", "-7E+3", "7", false},
+	// samq0424 samequantum  -7E+3     7E+3  -> 1
+	{"samq0424", "-7E+3", "7E+3", true},
+	// samq0425 samequantum  -7E+3     sNaN  -> 0
+	{"samq0425", "-7E+3", "sNaN", false},
+	// samq0510 samequantum  -7      -Inf   -> 0
+	{"samq0510", "-7", "-Inf", false},
+	// samq0511 samequantum  -7       Inf   -> 0
+	{"samq0511", "-7", "Inf", false},
+	// samq0512 samequantum  -7       NaN   -> 0
+	{"samq0512", "-7", "NaN", false},
+	// samq0513 samequantum  -7      -7E+3  -> 0
+	{"samq0513", "-7", "-7E+3", false},
+	// samq0514 samequantum  -7      -7     -> 1
+	{"samq0514", "-7", "-7", true},
+	// samq0515 samequantum  -7      -7E-3  -> 0
+	{"samq0515", "-7", "-7E-3", false},
+	// samq0516 samequantum  -7      -0E-3  -> 0
+	{"samq0516", "-7", "-0E-3", false},
+	// samq0517 samequantum  -7      -0     -> 1
+	{"samq0517", "-7", "-0", true},
+	// samq0518 samequantum  -7      -0E+3  -> 0
+	{"samq0518", "-7", "-0E+3", false},
+	// samq0519 samequantum  -7       0E-3  -> 0
+	{"samq0519", "-7", "0E-3", false},
+	// samq0520 samequantum  -7       0     -> 1
+	{"samq0520", "-7", "0", true},
+	// samq0521 samequantum  -7       0E+3  -> 0
+	{"samq0521", "-7", "0E+3", false},
+	// samq0522 samequantum  -7       7E-3  -> 0
+	{"samq0522", "-7", "7E-3", false},
+	// samq0523 samequantum  -7       7     -> 1
+	{"samq0523", "-7", "7", true},
+	// samq0524 samequantum  -7       7E+3  -> 0
+	{"samq0524", "-7", "7E+3", false},
+	// samq0525 samequantum  -7       sNaN  -> 0
+	{"samq0525", "-7", "sNaN", false},
+	// samq0610 samequantum  -7E-3    -Inf   -> 0
+	{"samq0610", "-7E-3", "-Inf", false},
+	// samq0611 samequantum  -7E-3     Inf   -> 0
+	{"samq0611", "-7E-3", "Inf", false},
+	// samq0612 samequantum  -7E-3     NaN   -> 0
+	{"samq0612", "-7E-3", "NaN", false},
+	// samq0613 samequantum  -7E-3    -7E+3  -> 0
+	{"samq0613", "-7E-3", "-7E+3", false},
+	// samq0614 samequantum  -7E-3    -7     -> 0
+	{"samq0614", "-7E-3", "-7", false},
+	// samq0615 samequantum  -7E-3    -7E-3  -> 1
+	{"samq0615", "-7E-3", "-7E-3", true},
+	// samq0616 samequantum  -7E-3    -0E-3  -> 1
+	{"samq0616", "-7E-3", "-0E-3", true},
+	// samq0617 samequantum  -7E-3    -0     -> 0
+	{"samq0617", "-7E-3", "-0", false},
+	// samq0618 samequantum  -7E-3    -0E+3  -> 0
+	{"samq0618", "-7E-3", "-0E+3", false},
+	// samq0619 samequantum  -7E-3     0E-3  -> 1
+	{"samq0619", "-7E-3", "0E-3", true},
+	// samq0620 samequantum  -7E-3     0     -> 0
+	{"samq0620", "-7E-3", "0", false},
+	// samq0621 samequantum  -7E-3     0E+3  -> 0
+	{"samq0621", "-7E-3", "0E+3", false},
+	// samq0622 samequantum  -7E-3     7E-3  -> 1
+	{"samq0622", "-7E-3", "7E-3", true},
+	// samq0623 samequantum  -7E-3     7     -> 0
+	{"samq0623", "-7E-3", "7", false},
+	// samq0624 samequantum  -7E-3     7E+3  -> 0
+	{"samq0624", "-7E-3", "7E+3", false},
+	// samq0625 samequantum  -7E-3     sNaN  -> 0
+	{"samq0625", "-7E-3", "sNaN", false},
+	// samq0710 samequantum  -0E-3    -Inf   -> 0
+	{"samq0710", "-0E-3", "-Inf", false},
+	// samq0711 samequantum  -0E-3     Inf   -> 0
+	{"samq0711", "-0E-3", "Inf", false},
+	// samq0712 samequantum  -0E-3     NaN   -> 0
+	{"samq0712", "-0E-3", "NaN", false},
+	// samq0713 samequantum  -0E-3    -7E+3  -> 0
+	{"samq0713", "-0E-3", "-7E+3", false},
+	// samq0714 samequantum  -0E-3    -7     -> 0
+	{"samq0714", "-0E-3", "-7", false},
+	// samq0715 samequantum  -0E-3    -7E-3  -> 1
+	{"samq0715", "-0E-3", "-7E-3", true},
+	// samq0716 samequantum  -0E-3    -0E-3  -> 1
+	{"samq0716", "-0E-3", "-0E-3", true},
+	// samq0717 samequantum  -0E-3    -0     -> 0
+	{"samq0717", "-0E-3", "-0", false},
+	// samq0718 samequantum  -0E-3    -0E+3  -> 0
+	{"samq0718", "-0E-3", "-0E+3", false},
+	// samq0719 samequantum  -0E-3     0E-3  -> 1
+	{"samq0719", "-0E-3", "0E-3", true},
+	// samq0720 samequantum  -0E-3     0     -> 0
+	{"samq0720", "-0E-3", "0", false},
+	// samq0721 samequantum  -0E-3     0E+3  -> 0
+	{"samq0721", "-0E-3", "0E+3", false},
+	// samq0722 samequantum  -0E-3     7E-3  -> 1
+	{"samq0722", "-0E-3", "7E-3", true},
+	// samq0723 samequantum  -0E-3     7     -> 0
+	{"samq0723", "-0E-3", "7", false},
+	// samq0724 samequantum  -0E-3     7E+3  -> 0
+	{"samq0724", "-0E-3", "7E+3", false},
+	// samq0725 samequantum  -0E-3     sNaN  -> 0
+	{"samq0725", "-0E-3", "sNaN", false},
+	// samq0810 samequantum  -0      -Inf   -> 0
+	{"samq0810", "-0", "-Inf", false},
+	// samq0811 samequantum  -0       Inf   -> 0
+	{"samq0811", "-0", "Inf", false},
+	// samq0812 samequantum  -0       NaN   -> 0
+	{"samq0812", "-0", "NaN", false},
+	// samq0813 samequantum  -0      -7E+3  -> 0
+	{"samq0813", "-0", "-7E+3", false},
+	// samq0814 samequantum  -0      -7     -> 1
+	{"samq0814", "-0", "-7", true},
+	// samq0815 samequantum  -0      -7E-3  -> 0
+	{"samq0815", "-0", "-7E-3", false},
+	// samq0816 samequantum  -0      -0E-3  -> 0
+	{"samq0816", "-0", "-0E-3", false},
+	// samq0817 samequantum  -0      -0     -> 1
+	{"samq0817", "-0", "-0", true},
+	// samq0818 samequantum  -0      -0E+3  -> 0
+	{"samq0818", "-0", "-0E+3", false},
+	// samq0819 samequantum  -0       0E-3  -> 0
+	{"samq0819", "-0", "0E-3", false},
+	// samq0820 samequantum  -0       0     -> 1
+	{"samq0820", "-0", "0", true},
+	// samq0821 samequantum  -0       0E+3  -> 0
+	{"samq0821", "-0", "0E+3", false},
+	// samq0822 samequantum  -0       7E-3  -> 0
+	{"samq0822", "-0", "7E-3", false},
+	// samq0823 samequantum  -0       7     -> 1
+	{"samq0823", "-0", "7", true},
+	// samq0824 samequantum  -0       7E+3  -> 0
+	{"samq0824", "-0", "7E+3", false},
+	// samq0825 samequantum  -0       sNaN  -> 0
+	{"samq0825", "-0", "sNaN", false},
+	// samq0910 samequantum  -0E+3    -Inf   -> 0
+	{"samq0910", "-0E+3", "-Inf", false},
+	// samq0911 samequantum  -0E+3     Inf   -> 0
+	{"samq0911", "-0E+3", "Inf", false},
+	// samq0912 samequantum  -0E+3     NaN   -> 0
+	{"samq0912", "-0E+3", "NaN", false},
+	// samq0913 samequantum  -0E+3    -7E+3  -> 1
+	{"samq0913", "-0E+3", "-7E+3", true},
+	// samq0914 samequantum  -0E+3    -7     -> 0
+	{"samq0914", "-0E+3", "-7", false},
+	// samq0915 samequantum  -0E+3    -7E-3  -> 0
+	{"samq0915", "-0E+3", "-7E-3", false},
+	// samq0916 samequantum  -0E+3    -0E-3  -> 0
+	{"samq0916", "-0E+3", "-0E-3", false},
+	// samq0917 samequantum  -0E+3    -0     -> 0
+	{"samq0917", "-0E+3", "-0", false},
+	// samq0918 samequantum  -0E+3    -0E+3  -> 1
+	{"samq0918", "-0E+3", "-0E+3", true},
+	// samq0919 samequantum  -0E+3     0E-3  -> 0
+	{"samq0919", "-0E+3", "0E-3", false},
+	// samq0920 samequantum  -0E+3     0     -> 0
+	{"samq0920", "-0E+3", "0", false},
+	// samq0921 samequantum  -0E+3     0E+3  -> 1
+	{"samq0921", "-0E+3", "0E+3", true},
+	// samq0922 samequantum  -0E+3     7E-3  -> 0
+	{"samq0922", "-0E+3", "7E-3", false},
+	// samq0923 samequantum  -0E+3     7     -> 0
+	{"samq0923", "-0E+3", "7", false},
+	// samq0924 samequantum  -0E+3     7E+3  -> 1
+	{"samq0924", "-0E+3", "7E+3", true},
+	// samq0925 samequantum  -0E+3     sNaN  -> 0
+	{"samq0925", "-0E+3", "sNaN", false},
+	// samq1110 samequantum  0E-3    -Inf   -> 0
+	{"samq1110", "0E-3", "-Inf", false},
+	// samq1111 samequantum  0E-3     Inf   -> 0
+	{"samq1111", "0E-3", "Inf", false},
+	// samq1112 samequantum  0E-3     NaN   -> 0
+	{"samq1112", "0E-3", "NaN", false},
+	// samq1113 samequantum  0E-3    -7E+3  -> 0
+	{"samq1113", "0E-3", "-7E+3", false},
+	// samq1114 samequantum  0E-3    -7     -> 0
+	{"samq1114", "0E-3", "-7", false},
+	// samq1115 samequantum  0E-3    -7E-3  -> 1
+	{"samq1115", "0E-3", "-7E-3", true},
+	// samq1116 samequantum  0E-3    -0E-3  -> 1
+	{"samq1116", "0E-3", "-0E-3", true},
+	// samq1117 samequantum  0E-3    -0     -> 0
+	{"samq1117", "0E-3", "-0", false},
+	// samq1118 samequantum  0E-3    -0E+3  -> 0
+	{"samq1118", "0E-3", "-0E+3", false},
+	// samq1119 samequantum  0E-3     0E-3  -> 1
+	{"samq1119", "0E-3", "0E-3", true},
+	// samq1120 samequantum  0E-3     0     -> 0
+	{"samq1120", "0E-3", "0", false},
+	// samq1121 samequantum  0E-3     0E+3  -> 0
+	{"samq1121", "0E-3", "0E+3", false},
+	// samq1122 samequantum  0E-3     7E-3  -> 1
+	{"samq1122", "0E-3", "7E-3", true},
+	// samq1123 samequantum  0E-3     7     -> 0
+	{"samq1123", "0E-3", "7", false},
+	// samq1124 samequantum  0E-3     7E+3  -> 0
+	{"samq1124", "0E-3", "7E+3", false},
+	// samq1125 samequantum  0E-3     sNaN  -> 0
+	{"samq1125", "0E-3", "sNaN", false},
+	// samq1210 samequantum  0       -Inf   -> 0
+	{"samq1210", "0", "-Inf", false},
+	// samq1211 samequantum  0        Inf   -> 0
+	{"samq1211", "0", "Inf", false},
+	// samq1212 samequantum  0        NaN   -> 0
+	{"samq1212", "0", "NaN", false},
+	// samq1213 samequantum  0       -7E+3  -> 0
+	{"samq1213", "0", "-7E+3", false},
+	// samq1214 samequantum  0       -7     -> 1
+	{"samq1214", "0", "-7", true},
+	// samq1215 samequantum  0       -7E-3  -> 0
+	{"samq1215", "0", "-7E-3", false},
+	// samq1216 samequantum  0       -0E-3  -> 0
+	{"samq1216", "0", "-0E-3", false},
+	// samq1217 samequantum  0       -0     -> 1
+	{"samq1217", "0", "-0", true},
+	// samq1218 samequantum  0       -0E+3  -> 0
+	{"samq1218", "0", "-0E+3", false},
+	// samq1219 samequantum  0        0E-3  -> 0
+	{"samq1219", "0", "0E-3", false},
+	// samq1220 samequantum  0        0     -> 1
+	{"samq1220", "0", "0", true},
+	// samq1221 samequantum  0        0E+3  -> 0
+	{"samq1221", "0", "0E+3", false},
+	// samq1222 samequantum  0        7E-3  -> 0
+	{"samq1222", "0", "7E-3", false},
+	// samq1223 samequantum  0        7     -> 1
+	{"samq1223", "0", "7", true},
+	// samq1224 samequantum  0        7E+3  -> 0
+	{"samq1224", "0", "7E+3", false},
+	// samq1225 samequantum  0        sNaN  -> 0
+	{"samq1225", "0", "sNaN", false},
+	// samq1310 samequantum  0E+3    -Inf   -> 0
+	{"samq1310", "0E+3", "-Inf", false},
+	// samq1311 samequantum  0E+3     Inf   -> 0
+	{"samq1311", "0E+3", "Inf", false},
+	// samq1312 samequantum  0E+3     NaN   -> 0
+	{"samq1312", "0E+3", "NaN", false},
+	// samq1313 samequantum  0E+3    -7E+3  -> 1
+	{"samq1313", "0E+3", "-7E+3", true},
+	// samq1314 samequantum  0E+3    -7     -> 0
+	{"samq1314", "0E+3", "-7", false},
+	// samq1315 samequantum  0E+3    -7E-3  -> 0
+	{"samq1315", "0E+3", "-7E-3", false},
+	// samq1316 samequantum  0E+3    -0E-3  -> 0
+	{"samq1316", "0E+3", "-0E-3", false},
+	// samq1317 samequantum  0E+3    -0     -> 0
+	{"samq1317", "0E+3", "-0", false},
+	// samq1318 samequantum  0E+3    -0E+3  -> 1
+	{"samq1318", "0E+3", "-0E+3", true},
+	// samq1319 samequantum  0E+3     0E-3  -> 0
+	{"samq1319", "0E+3", "0E-3", false},
+	// samq1320 samequantum  0E+3     0     -> 0
+	{"samq1320", "0E+3", "0", false},
+	// samq1321 samequantum  0E+3     0E+3  -> 1
+	{"samq1321", "0E+3", "0E+3", true},
+	// samq1322 samequantum  0E+3     7E-3  -> 0
+	{"samq1322", "0E+3", "7E-3", false},
+	// samq1323 samequantum  0E+3     7     -> 0
+	{"samq1323", "0E+3", "7", false},
+	// samq1324 samequantum  0E+3     7E+3  -> 1
+	{"samq1324", "0E+3", "7E+3", true},
+	// samq1325 samequantum  0E+3     sNaN  -> 0
+	{"samq1325", "0E+3", "sNaN", false},
+	// samq1410 samequantum  7E-3    -Inf   -> 0
+	{"samq1410", "7E-3", "-Inf", false},
+	// samq1411 samequantum  7E-3     Inf   -> 0
+	{"samq1411", "7E-3", "Inf", false},
+	// samq1412 samequantum  7E-3     NaN   -> 0
+	{"samq1412", "7E-3", "NaN", false},
+	// samq1413 samequantum  7E-3    -7E+3  -> 0
+	{"samq1413", "7E-3", "-7E+3", false},
+	// samq1414 samequantum  7E-3    -7     -> 0
+	{"samq1414", "7E-3", "-7", false},
+	// samq1415 samequantum  7E-3    -7E-3  -> 1
+	{"samq1415", "7E-3", "-7E-3", true},
+	// samq1416 samequantum  7E-3    -0E-3  -> 1
+	{"samq1416", "7E-3", "-0E-3", true},
+	// samq1417 samequantum  7E-3    -0     -> 0
+	{"samq1417", "7E-3", "-0", false},
+	// samq1418 samequantum  7E-3    -0E+3  -> 0
+	{"samq1418", "7E-3", "-0E+3", false},
+	// samq1419 samequantum  7E-3     0E-3  -> 1
+	{"samq1419", "7E-3", "0E-3", true},
+	// samq1420 samequantum  7E-3     0     -> 0
+	{"samq1420", "7E-3", "0", false},
+	// samq1421 samequantum  7E-3     0E+3  -> 0
+	{"samq1421", "7E-3", "0E+3", false},
+	// samq1422 samequantum  7E-3     7E-3  -> 1
+	{"samq1422", "7E-3", "7E-3", true},
+	// samq1423 samequantum  7E-3     7     -> 0
+	{"samq1423", "7E-3", "7", false},
+	// samq1424 samequantum  7E-3     7E+3  -> 0
+	{"samq1424", "7E-3", "7E+3", false},
+	// samq1425 samequantum  7E-3     sNaN  -> 0
+	{"samq1425", "7E-3", "sNaN", false},
+	// samq1510 samequantum  7      -Inf   -> 0
+	{"samq1510", "7", "-Inf", false},
+	// samq1511 samequantum  7       Inf   -> 0
+	{"samq1511", "7", "Inf", false},
+	// samq1512 samequantum  7       NaN   -> 0
+	{"samq1512", "7", "NaN", false},
+	// samq1513 samequantum  7      -7E+3  -> 0
+	{"samq1513", "7", "-7E+3", false},
+	// samq1514 samequantum  7      -7     -> 1
+	{"samq1514", "7", "-7", true},
+	// samq1515 samequantum  7      -7E-3  -> 0
+	{"samq1515", "7", "-7E-3", false},
+	// samq1516 samequantum  7      -0E-3  -> 0
+	{"samq1516", "7", "-0E-3", false},
+	// samq1517 samequantum  7      -0     -> 1
+	{"samq1517", "7", "-0", true},
+	// samq1518 samequantum  7      -0E+3  -> 0
+	{"samq1518", "7", "-0E+3", false},
+	// samq1519 samequantum  7       0E-3  -> 0
+	{"samq1519", "7", "0E-3", false},
+	// samq1520 samequantum  7       0     -> 1
+	{"samq1520", "7", "0", true},
+	// samq1521 samequantum  7       0E+3  -> 0
+	{"samq1521", "7", "0E+3", false},
+	// samq1522 samequantum  7       7E-3  -> 0
+	{"samq1522", "7", "7E-3", false},
+	// samq1523 samequantum  7       7     -> 1
+	{"samq1523", "7", "7", true},
+	// samq1524 samequantum  7       7E+3  -> 0
+	{"samq1524", "7", "7E+3", false},
+	// samq1525 samequantum  7       sNaN  -> 0
+	{"samq1525", "7", "sNaN", false},
+	// samq1610 samequantum  7E+3    -Inf   -> 0
+	{"samq1610", "7E+3", "-Inf", false},
+	// samq1611 samequantum  7E+3     Inf   -> 0
+	{"samq1611", "7E+3", "Inf", false},
+	// samq1612 samequantum  7E+3     NaN   -> 0
+	{"samq1612", "7E+3", "NaN", false},
+	// samq1613 samequantum  7E+3    -7E+3  -> 1
+	{"samq1613", "7E+3", "-7E+3", true},
+	// samq1614 samequantum  7E+3    -7     -> 0
+	{"samq1614", "7E+3", "-7", false},
+	// samq1615 samequantum  7E+3    -7E-3  -> 0
+	{"samq1615", "7E+3", "-7E-3", false},
+	// samq1616 samequantum  7E+3    -0E-3  -> 0
+	{"samq1616", "7E+3", "-0E-3", false},
+	// samq1617 samequantum  7E+3    -0     -> 0
+	{"samq1617", "7E+3", "-0", false},
+	// samq1618 samequantum  7E+3    -0E+3  -> 1
+	{"samq1618", "7E+3", "-0E+3", true},
+	// samq1619 samequantum  7E+3     0E-3  -> 0
+	{"samq1619", "7E+3", "0E-3", false},
+	// samq1620 samequantum  7E+3     0     -> 0
+	{"samq1620", "7E+3", "0", false},
+	// samq1621 samequantum  7E+3     0E+3  -> 1
+	{"samq1621", "7E+3", "0E+3", true},
+	// samq1622 samequantum  7E+3     7E-3  -> 0
+	{"samq1622", "7E+3", "7E-3", false},
+	// samq1623 samequantum  7E+3     7     -> 0
+	{"samq1623", "7E+3", "7", false},
+	// samq1624 samequantum  7E+3     7E+3  -> 1
+	{"samq1624", "7E+3", "7E+3", true},
+	// samq1625 samequantum  7E+3     sNaN  -> 0
+	{"samq1625", "7E+3", "sNaN", false},
+	// samq1710 samequantum  sNaN    -Inf   -> 0
+	{"samq1710", "sNaN", "-Inf", false},
+	// samq1711 samequantum  sNaN     Inf   -> 0
+	{"samq1711", "sNaN", "Inf", false},
+	// samq1712 samequantum  sNaN     NaN   -> 1
+	{"samq1712", "sNaN", "NaN", true},
+	// samq1713 samequantum  sNaN    -7E+3  -> 0
+	{"samq1713", "sNaN", "-7E+3", false},
+	// samq1714 samequantum  sNaN    -7     -> 0
+	{"samq1714", "sNaN", "-7", false},
+	// samq1715 samequantum  sNaN    -7E-3  -> 0
+	{"samq1715", "sNaN", "-7E-3", false},
+	// samq1716 samequantum  sNaN    -0E-3  -> 0
+	{"samq1716", "sNaN", "-0E-3", false},
+	// samq1717 samequantum  sNaN    -0     -> 0
+	{"samq1717", "sNaN", "-0", false},
+	// samq1718 samequantum  sNaN    -0E+3  -> 0
+	{"samq1718", "sNaN", "-0E+3", false},
+	// samq1719 samequantum  sNaN     0E-3  -> 0
+	{"samq1719", "sNaN", "0E-3", false},
+	// samq1720 samequantum  sNaN     0     -> 0
+	{"samq1720", "sNaN", "0", false},
+	// samq1721 samequantum  sNaN     0E+3  -> 0
+	{"samq1721", "sNaN", "0E+3", false},
+	// samq1722 samequantum  sNaN     7E-3  -> 0
+	{"samq1722", "sNaN", "7E-3", false},
+	// samq1723 samequantum  sNaN     7     -> 0
+	{"samq1723", "sNaN", "7", false},
+	// samq1724 samequantum  sNaN     7E+3  -> 0
+	{"samq1724", "sNaN", "7E+3", false},
+	// samq1725 samequantum  sNaN     sNaN  -> 1
+	{"samq1725", "sNaN", "sNaN", true},
+	// noisy NaNs
+	// samq1730 samequantum  sNaN3    sNaN3 -> 1
+	{"samq1730", "sNaN3", "sNaN3", true},
+	// samq1731 samequantum  sNaN3    sNaN4 -> 1
+	{"samq1731", "sNaN3", "sNaN4", true},
+	// samq1732 samequantum   NaN3     NaN3 -> 1
+	{"samq1732", "NaN3", "NaN3", true},
+	// samq1733 samequantum   NaN3     NaN4 -> 1
+	{"samq1733", "NaN3", "NaN4", true},
+	// samq1734 samequantum  sNaN3     3    -> 0
+	{"samq1734", "sNaN3", "3", false},
+	// samq1735 samequantum   NaN3     3    -> 0
+	{"samq1735", "NaN3", "3", false},
+	// samq1736 samequantum      4    sNaN4 -> 0
+	{"samq1736", "4", "sNaN4", false},
+	// samq1737 samequantum      3     NaN3 -> 0
+	{"samq1737", "3", "NaN3", false},
+	// samq1738 samequantum    Inf    sNaN4 -> 0
+	{"samq1738", "Inf", "sNaN4", false},
+	// samq1739 samequantum   -Inf     NaN3 -> 0
+	{"samq1739", "-Inf", "NaN3", false},
+}
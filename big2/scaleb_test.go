@@ -0,0 +1,303 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var scalebTests = []struct {
+	id    string
+	in    string
+	n     int
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Max |rhs| is 2*(999+9) = 2016
+	// Sanity checks
+	// scbx001 scaleb       7.50   10 -> 7.50E+10
+	{"scbx001", "7.50", 10, "7.50E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx002 scaleb       7.50    3 -> 7.50E+3
+	{"scbx002", "7.50", 3, "7.50E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx003 scaleb       7.50    2 -> 750
+	{"scbx003", "7.50", 2, "750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx004 scaleb       7.50    1 -> 75.0
+	{"scbx004", "7.50", 1, "75.0", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx005 scaleb       7.50    0 -> 7.50
+	{"scbx005", "7.50", 0, "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx006 scaleb       7.50   -1 -> 0.750
+	{"scbx006", "7.50", -1, "0.750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx007 scaleb       7.50   -2 -> 0.0750
+	{"scbx007", "7.50", -2, "0.0750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx008 scaleb       7.50  -10 -> 7.50E-10
+	{"scbx008", "7.50", -10, "7.50E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx009 scaleb      -7.50    3 -> -7.50E+3
+	{"scbx009", "-7.50", 3, "-7.50E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx010 scaleb      -7.50    2 -> -750
+	{"scbx010", "-7.50", 2, "-750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx011 scaleb      -7.50    1 -> -75.0
+	{"scbx011", "-7.50", 1, "-75.0", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx012 scaleb      -7.50    0 -> -7.50
+	{"scbx012", "-7.50", 0, "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx013 scaleb      -7.50   -1 -> -0.750
+	{"scbx013", "-7.50", -1, "-0.750", 0, 9, ToNearestAway, 999, -999, false},
+	// Infinities
+	// scbx014 scaleb  Infinity   1 -> Infinity
+	{"scbx014", "Inf", 1, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx015 scaleb  -Infinity  2 -> -Infinity
+	{"scbx015", "-Inf", 2, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx016 scaleb  Infinity  -1 -> Infinity
+	{"scbx016", "Inf", -1, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx017 scaleb  -Infinity -2 -> -Infinity
+	{"scbx017", "-Inf", -2, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// Next two are somewhat undefined in 754r; treat as non-integer
+	// SKIP (second operand is not an int): scbx018 scaleb  10  Infinity -> NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx019 scaleb  10 -Infinity -> NaN Invalid_operation
+	// NaNs are undefined in 754r; assume usual processing
+	// NaNs, 0 payload
+	// scbx021 scaleb         NaN  1 -> NaN
+	{"scbx021", "NaN", 1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx022 scaleb        -NaN -1 -> -NaN
+	{"scbx022", "-NaN", -1, "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx023 scaleb        sNaN  1 -> NaN Invalid_operation
+	{"scbx023", "sNaN", 1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// scbx024 scaleb       -sNaN  1 -> -NaN Invalid_operation
+	{"scbx024", "-sNaN", 1, "-NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): scbx025 scaleb    4    NaN    -> NaN
+	// SKIP (second operand is not an int): scbx026 scaleb -Inf   -NaN    -> -NaN
+	// SKIP (second operand is not an int): scbx027 scaleb    4   sNaN    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx028 scaleb  Inf  -sNaN    -> -NaN Invalid_operation
+	// non-integer RHS
+	// scbx030 scaleb  1.23    1    ->  12.3
+	{"scbx030", "1.23", 1, "12.3", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): scbx031 scaleb  1.23    1.00 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx032 scaleb  1.23    1.1  ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx033 scaleb  1.23    1.01 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx034 scaleb  1.23    0.01 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx035 scaleb  1.23    0.11 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx036 scaleb  1.23    0.999999999 ->  NaN Invalid_operation
+	// scbx037 scaleb  1.23   -1    ->  0.123
+	{"scbx037", "1.23", -1, "0.123", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): scbx038 scaleb  1.23   -1.00 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx039 scaleb  1.23   -1.1  ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx040 scaleb  1.23   -1.01 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx041 scaleb  1.23   -0.01 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx042 scaleb  1.23   -0.11 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx043 scaleb  1.23   -0.999999999 ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx044 scaleb  1.23    0.1         ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx045 scaleb  1.23    1E+1        ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx046 scaleb  1.23    1.1234E+6   ->  NaN Invalid_operation
+	// SKIP (second operand is not an int): scbx047 scaleb  1.23    1.123E+4    ->  NaN Invalid_operation
+	// scbx120 scaleb  1.23    2015        ->  Infinity Overflow Inexact Rounded
+	{"scbx120", "1.23", 2015, "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx121 scaleb  1.23    2016        ->  Infinity Overflow Inexact Rounded
+	{"scbx121", "1.23", 2016, "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx122 scaleb  1.23    2017        ->  NaN Invalid_operation
+	{"scbx122", "1.23", 2017, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// scbx123 scaleb  1.23    2018        ->  NaN Invalid_operation
+	{"scbx123", "1.23", 2018, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// scbx124 scaleb  1.23   -2015        ->  0E-1007 Underflow Subnormal Inexact Rounded Clamped
+	{"scbx124", "1.23", -2015, "0E-1007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999, -999, false},
+	// scbx125 scaleb  1.23   -2016        ->  0E-1007 Underflow Subnormal Inexact Rounded Clamped
+	{"scbx125", "1.23", -2016, "0E-1007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999, -999, false},
+	// scbx126 scaleb  1.23   -2017        ->  NaN Invalid_operation
+	{"scbx126", "1.23", -2017, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// scbx127 scaleb  1.23   -2018        ->  NaN Invalid_operation
+	{"scbx127", "1.23", -2018, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// NaNs, non-0 payload
+	// propagating NaNs
+	// SKIP (second operand is not an int): scbx861 scaleb  NaN01   -Inf     ->  NaN1
+	// scbx862 scaleb -NaN02   -1000    -> -NaN2
+	{"scbx862", "-NaN02", -1000, "-NaN2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx863 scaleb  NaN03    1000    ->  NaN3
+	{"scbx863", "NaN03", 1000, "NaN3", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): scbx864 scaleb  NaN04    Inf     ->  NaN4
+	// SKIP (second operand is not an int): scbx865 scaleb  NaN05    NaN61   ->  NaN5
+	// SKIP (second operand is not an int): scbx866 scaleb -Inf     -NaN71   -> -NaN71
+	// SKIP (second operand is not an int): scbx867 scaleb -1000     NaN81   ->  NaN81
+	// SKIP (second operand is not an int): scbx868 scaleb  1000     NaN91   ->  NaN91
+	// SKIP (second operand is not an int): scbx869 scaleb  Inf      NaN101  ->  NaN101
+	// SKIP (second operand is not an int): scbx871 scaleb  sNaN011  -Inf    ->  NaN11  Invalid_operation
+	// scbx872 scaleb  sNaN012  -1000   ->  NaN12  Invalid_operation
+	{"scbx872", "sNaN012", -1000, "NaN12", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// scbx873 scaleb -sNaN013   1000   -> -NaN13  Invalid_operation
+	{"scbx873", "-sNaN013", 1000, "-NaN13", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): scbx874 scaleb  sNaN014   NaN171 ->  NaN14  Invalid_operation
+	// SKIP (second operand is not an int): scbx875 scaleb  sNaN015  sNaN181 ->  NaN15  Invalid_operation
+	// SKIP (second operand is not an int): scbx876 scaleb  NaN016   sNaN191 ->  NaN191 Invalid_operation
+	// SKIP (second operand is not an int): scbx877 scaleb -Inf      sNaN201 ->  NaN201 Invalid_operation
+	// SKIP (second operand is not an int): scbx878 scaleb -1000     sNaN211 ->  NaN211 Invalid_operation
+	// SKIP (second operand is not an int): scbx879 scaleb  1000    -sNaN221 -> -NaN221 Invalid_operation
+	// SKIP (second operand is not an int): scbx880 scaleb  Inf      sNaN231 ->  NaN231 Invalid_operation
+	// SKIP (second operand is not an int): scbx881 scaleb  NaN025   sNaN241 ->  NaN241 Invalid_operation
+	// finites
+	// scbx051 scaleb          7   -2  -> 0.07
+	{"scbx051", "7", -2, "0.07", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx052 scaleb         -7   -2  -> -0.07
+	{"scbx052", "-7", -2, "-0.07", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx053 scaleb         75   -2  -> 0.75
+	{"scbx053", "75", -2, "0.75", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx054 scaleb        -75   -2  -> -0.75
+	{"scbx054", "-75", -2, "-0.75", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx055 scaleb       7.50   -2  -> 0.0750
+	{"scbx055", "7.50", -2, "0.0750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx056 scaleb      -7.50   -2  -> -0.0750
+	{"scbx056", "-7.50", -2, "-0.0750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx057 scaleb       7.500  -2  -> 0.07500
+	{"scbx057", "7.500", -2, "0.07500", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx058 scaleb      -7.500  -2  -> -0.07500
+	{"scbx058", "-7.500", -2, "-0.07500", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx061 scaleb          7   -1  -> 0.7
+	{"scbx061", "7", -1, "0.7", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx062 scaleb         -7   -1  -> -0.7
+	{"scbx062", "-7", -1, "-0.7", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx063 scaleb         75   -1  -> 7.5
+	{"scbx063", "75", -1, "7.5", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx064 scaleb        -75   -1  -> -7.5
+	{"scbx064", "-75", -1, "-7.5", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx065 scaleb       7.50   -1  -> 0.750
+	{"scbx065", "7.50", -1, "0.750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx066 scaleb      -7.50   -1  -> -0.750
+	{"scbx066", "-7.50", -1, "-0.750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx067 scaleb       7.500  -1  -> 0.7500
+	{"scbx067", "7.500", -1, "0.7500", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx068 scaleb      -7.500  -1  -> -0.7500
+	{"scbx068", "-7.500", -1, "-0.7500", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx071 scaleb          7    0  -> 7
+	{"scbx071", "7", 0, "7", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx072 scaleb         -7    0  -> -7
+	{"scbx072", "-7", 0, "-7", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx073 scaleb         75    0  -> 75
+	{"scbx073", "75", 0, "75", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx074 scaleb        -75    0  -> -75
+	{"scbx074", "-75", 0, "-75", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx075 scaleb       7.50    0  -> 7.50
+	{"scbx075", "7.50", 0, "7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx076 scaleb      -7.50    0  -> -7.50
+	{"scbx076", "-7.50", 0, "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx077 scaleb       7.500   0  -> 7.500
+	{"scbx077", "7.500", 0, "7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx078 scaleb      -7.500   0  -> -7.500
+	{"scbx078", "-7.500", 0, "-7.500", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx081 scaleb          7    1  -> 7E+1
+	{"scbx081", "7", 1, "7E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx082 scaleb         -7    1  -> -7E+1
+	{"scbx082", "-7", 1, "-7E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx083 scaleb         75    1  -> 7.5E+2
+	{"scbx083", "75", 1, "7.5E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx084 scaleb        -75    1  -> -7.5E+2
+	{"scbx084", "-75", 1, "-7.5E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx085 scaleb       7.50    1  -> 75.0
+	{"scbx085", "7.50", 1, "75.0", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx086 scaleb      -7.50    1  -> -75.0
+	{"scbx086", "-7.50", 1, "-75.0", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx087 scaleb       7.500   1  -> 75.00
+	{"scbx087", "7.500", 1, "75.00", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx088 scaleb      -7.500   1  -> -75.00
+	{"scbx088", "-7.500", 1, "-75.00", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx091 scaleb          7    2  -> 7E+2
+	{"scbx091", "7", 2, "7E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx092 scaleb         -7    2  -> -7E+2
+	{"scbx092", "-7", 2, "-7E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx093 scaleb         75    2  -> 7.5E+3
+	{"scbx093", "75", 2, "7.5E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx094 scaleb        -75    2  -> -7.5E+3
+	{"scbx094", "-75", 2, "-7.5E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx095 scaleb       7.50    2  -> 750
+	{"scbx095", "7.50", 2, "750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx096 scaleb      -7.50    2  -> -750
+	{"scbx096", "-7.50", 2, "-750", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx097 scaleb       7.500   2  -> 750.0
+	{"scbx097", "7.500", 2, "750.0", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx098 scaleb      -7.500   2  -> -750.0
+	{"scbx098", "-7.500", 2, "-750.0", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// scbx111 scaleb          0  1 -> 0E+1
+	{"scbx111", "0", 1, "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx112 scaleb         -0  2 -> -0E+2
+	{"scbx112", "-0", 2, "-0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx113 scaleb       0E+4  3 -> 0E+7
+	{"scbx113", "0E+4", 3, "0E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx114 scaleb      -0E+4  4 -> -0E+8
+	{"scbx114", "-0E+4", 4, "-0E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx115 scaleb     0.0000  5 -> 0E+1
+	{"scbx115", "0.0000", 5, "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx116 scaleb    -0.0000  6 -> -0E+2
+	{"scbx116", "-0.0000", 6, "-0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx117 scaleb      0E-141 7 -> 0E-134
+	{"scbx117", "0E-141", 7, "0E-134", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx118 scaleb     -0E-141 8 -> -0E-133
+	{"scbx118", "-0E-141", 8, "-0E-133", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// scbx132 scaleb  9.99999999E+999 +999 -> Infinity    Overflow Inexact Rounded
+	{"scbx132", "9.99999999E+999", 999, "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx133 scaleb  9.99999999E+999  +10 -> Infinity     Overflow Inexact Rounded
+	{"scbx133", "9.99999999E+999", 10, "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx134 scaleb  9.99999999E+999  +1  -> Infinity     Overflow Inexact Rounded
+	{"scbx134", "9.99999999E+999", 1, "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx135 scaleb  9.99999999E+999   0  -> 9.99999999E+999
+	{"scbx135", "9.99999999E+999", 0, "9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx136 scaleb  9.99999999E+999  -1  -> 9.99999999E+998
+	{"scbx136", "9.99999999E+999", -1, "9.99999999E+998", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx137 scaleb  1E-999           +1  -> 1E-998
+	{"scbx137", "1E-999", 1, "1E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx138 scaleb  1E-999           -0  -> 1E-999
+	{"scbx138", "1E-999", 0, "1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx139 scaleb  1E-999           -1  -> 1E-1000         Subnormal
+	{"scbx139", "1E-999", -1, "1E-1000", Subnormal, 9, ToNearestAway, 999, -999, false},
+	// scbx140 scaleb  1.00000000E-999  +1  -> 1.00000000E-998
+	{"scbx140", "1.00000000E-999", 1, "1.00000000E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx141 scaleb  1.00000000E-999   0  -> 1.00000000E-999
+	{"scbx141", "1.00000000E-999", 0, "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx142 scaleb  1.00000000E-999  -1  -> 1.0000000E-1000 Subnormal Rounded
+	{"scbx142", "1.00000000E-999", -1, "1.0000000E-1000", Subnormal | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx143 scaleb  1E-1007          +1  -> 1E-1006         Subnormal
+	{"scbx143", "1E-1007", 1, "1E-1006", Subnormal, 9, ToNearestAway, 999, -999, false},
+	// scbx144 scaleb  1E-1007          -0  -> 1E-1007         Subnormal
+	{"scbx144", "1E-1007", 0, "1E-1007", Subnormal, 9, ToNearestAway, 999, -999, false},
+	// scbx145 scaleb  1E-1007          -1  -> 0E-1007         Underflow Subnormal Inexact Rounded Clamped
+	{"scbx145", "1E-1007", -1, "0E-1007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999, -999, false},
+	// scbx150 scaleb  -1E-1007         +1  -> -1E-1006        Subnormal
+	{"scbx150", "-1E-1007", 1, "-1E-1006", Subnormal, 9, ToNearestAway, 999, -999, false},
+	// scbx151 scaleb  -1E-1007         -0  -> -1E-1007        Subnormal
+	{"scbx151", "-1E-1007", 0, "-1E-1007", Subnormal, 9, ToNearestAway, 999, -999, false},
+	// scbx152 scaleb  -1E-1007         -1  -> -0E-1007        Underflow Subnormal Inexact Rounded Clamped
+	{"scbx152", "-1E-1007", -1, "-0E-1007", Underflow | Subnormal | Inexact | Rounded | Clamped, 9, ToNearestAway, 999, -999, false},
+	// scbx153 scaleb  -1.00000000E-999 +1  -> -1.00000000E-998
+	{"scbx153", "-1.00000000E-999", 1, "-1.00000000E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx154 scaleb  -1.00000000E-999 +0  -> -1.00000000E-999
+	{"scbx154", "-1.00000000E-999", 0, "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx155 scaleb  -1.00000000E-999 -1  -> -1.0000000E-1000 Subnormal Rounded
+	{"scbx155", "-1.00000000E-999", -1, "-1.0000000E-1000", Subnormal | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx156 scaleb  -1E-999          +1  -> -1E-998
+	{"scbx156", "-1E-999", 1, "-1E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx157 scaleb  -1E-999          -0  -> -1E-999
+	{"scbx157", "-1E-999", 0, "-1E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx158 scaleb  -1E-999          -1  -> -1E-1000         Subnormal
+	{"scbx158", "-1E-999", -1, "-1E-1000", Subnormal, 9, ToNearestAway, 999, -999, false},
+	// scbx159 scaleb  -9.99999999E+999 +1  -> -Infinity        Overflow Inexact Rounded
+	{"scbx159", "-9.99999999E+999", 1, "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx160 scaleb  -9.99999999E+999 +0  -> -9.99999999E+999
+	{"scbx160", "-9.99999999E+999", 0, "-9.99999999E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx161 scaleb  -9.99999999E+999 -1  -> -9.99999999E+998
+	{"scbx161", "-9.99999999E+999", -1, "-9.99999999E+998", 0, 9, ToNearestAway, 999, -999, false},
+	// scbx162 scaleb  -9E+999          +1  -> -Infinity        Overflow Inexact Rounded
+	{"scbx162", "-9E+999", 1, "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// scbx163 scaleb  -1E+999          +1  -> -Infinity        Overflow Inexact Rounded
+	{"scbx163", "-1E+999", 1, "-Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// Krah examples
+	// precision: 34
+	// maxexponent: 999999999
+	// minexponent: -999999999
+	// integer overflow in 3.61 or earlier
+	// SKIP (operand range not limited): scbx164 scaleb  1E-999999999  -1200000000  -> NaN Invalid_operation
+	// out of range
+	// SKIP (operand range not limited): scbx165 scaleb  -1E-999999999  +1200000000  -> NaN Invalid_operation
+}
@@ -0,0 +1,364 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var shiftTests = []struct {
+	id    string
+	in    string
+	n     int
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check
+	// shix001 shift          0    0  ->  0
+	{"shix001", "0", 0, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix002 shift          0    2  ->  0
+	{"shix002", "0", 2, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix003 shift          1    2  ->  100
+	{"shix003", "1", 2, "100", 0, 9, ToNearestAway, 999, -999, false},
+	// shix004 shift          1    8  ->  100000000
+	{"shix004", "1", 8, "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix005 shift          1    9  ->  0
+	{"shix005", "1", 9, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix006 shift          1   -1  ->  0
+	{"shix006", "1", -1, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix007 shift  123456789   -1  ->  12345678
+	{"shix007", "123456789", -1, "12345678", 0, 9, ToNearestAway, 999, -999, false},
+	// shix008 shift  123456789   -8  ->  1
+	{"shix008", "123456789", -8, "1", 0, 9, ToNearestAway, 999, -999, false},
+	// shix009 shift  123456789   -9  ->  0
+	{"shix009", "123456789", -9, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix010 shift          0   -2  ->  0
+	{"shix010", "0", -2, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// rhs must be an integer
+	// SKIP (second operand is not an int): shix011 shift        1    1.5    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix012 shift        1    1.0    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix013 shift        1    0.1    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix014 shift        1    0.0    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix015 shift        1    1E+1   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix016 shift        1    1E+99  -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix017 shift        1    Inf    -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix018 shift        1    -Inf   -> NaN Invalid_operation
+	// and |rhs| <= precision
+	// shix020 shift        1    -1000  -> NaN Invalid_operation
+	{"shix020", "1", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix021 shift        1    -10    -> NaN Invalid_operation
+	{"shix021", "1", -10, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix022 shift        1     10    -> NaN Invalid_operation
+	{"shix022", "1", 10, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix023 shift        1     1000  -> NaN Invalid_operation
+	{"shix023", "1", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// full shifting pattern
+	// shix030 shift  123456789          -9   -> 0
+	{"shix030", "123456789", -9, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix031 shift  123456789          -8   -> 1
+	{"shix031", "123456789", -8, "1", 0, 9, ToNearestAway, 999, -999, false},
+	// shix032 shift  123456789          -7   -> 12
+	{"shix032", "123456789", -7, "12", 0, 9, ToNearestAway, 999, -999, false},
+	// shix033 shift  123456789          -6   -> 123
+	{"shix033", "123456789", -6, "123", 0, 9, ToNearestAway, 999, -999, false},
+	// shix034 shift  123456789          -5   -> 1234
+	{"shix034", "123456789", -5, "1234", 0, 9, ToNearestAway, 999, -999, false},
+	// shix035 shift  123456789          -4   -> 12345
+	{"shix035", "123456789", -4, "12345", 0, 9, ToNearestAway, 999, -999, false},
+	// shix036 shift  123456789          -3   -> 123456
+	{"shix036", "123456789", -3, "123456", 0, 9, ToNearestAway, 999, -999, false},
+	// shix037 shift  123456789          -2   -> 1234567
+	{"shix037", "123456789", -2, "1234567", 0, 9, ToNearestAway, 999, -999, false},
+	// shix038 shift  123456789          -1   -> 12345678
+	{"shix038", "123456789", -1, "12345678", 0, 9, ToNearestAway, 999, -999, false},
+	// shix039 shift  123456789          -0   -> 123456789
+	{"shix039", "123456789", 0, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// shix040 shift  123456789          +0   -> 123456789
+	{"shix040", "123456789", 0, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// shix041 shift  123456789          +1   -> 234567890
+	{"shix041", "123456789", 1, "234567890", 0, 9, ToNearestAway, 999, -999, false},
+	// shix042 shift  123456789          +2   -> 345678900
+	{"shix042", "123456789", 2, "345678900", 0, 9, ToNearestAway, 999, -999, false},
+	// shix043 shift  123456789          +3   -> 456789000
+	{"shix043", "123456789", 3, "456789000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix044 shift  123456789          +4   -> 567890000
+	{"shix044", "123456789", 4, "567890000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix045 shift  123456789          +5   -> 678900000
+	{"shix045", "123456789", 5, "678900000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix046 shift  123456789          +6   -> 789000000
+	{"shix046", "123456789", 6, "789000000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix047 shift  123456789          +7   -> 890000000
+	{"shix047", "123456789", 7, "890000000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix048 shift  123456789          +8   -> 900000000
+	{"shix048", "123456789", 8, "900000000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix049 shift  123456789          +9   -> 0
+	{"shix049", "123456789", 9, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// from examples
+	// shix051 shift 34        8   ->  '400000000'
+	{"shix051", "34", 8, "400000000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix052 shift 12        9   ->  '0'
+	{"shix052", "12", 9, "0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix053 shift 123456789 -2  ->  '1234567'
+	{"shix053", "123456789", -2, "1234567", 0, 9, ToNearestAway, 999, -999, false},
+	// shix054 shift 123456789 0   ->  '123456789'
+	{"shix054", "123456789", 0, "123456789", 0, 9, ToNearestAway, 999, -999, false},
+	// shix055 shift 123456789 +2  ->  '345678900'
+	{"shix055", "123456789", 2, "345678900", 0, 9, ToNearestAway, 999, -999, false},
+	// zeros
+	// shix060 shift  0E-10              +9   ->   0E-10
+	{"shix060", "0E-10", 9, "0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix061 shift  0E-10              -9   ->   0E-10
+	{"shix061", "0E-10", -9, "0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix062 shift  0.000              +9   ->   0.000
+	{"shix062", "0.000", 9, "0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix063 shift  0.000              -9   ->   0.000
+	{"shix063", "0.000", -9, "0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix064 shift  0E+10              +9   ->   0E+10
+	{"shix064", "0E+10", 9, "0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix065 shift  0E+10              -9   ->   0E+10
+	{"shix065", "0E+10", -9, "0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix066 shift -0E-10              +9   ->  -0E-10
+	{"shix066", "-0E-10", 9, "-0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix067 shift -0E-10              -9   ->  -0E-10
+	{"shix067", "-0E-10", -9, "-0E-10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix068 shift -0.000              +9   ->  -0.000
+	{"shix068", "-0.000", 9, "-0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix069 shift -0.000              -9   ->  -0.000
+	{"shix069", "-0.000", -9, "-0.000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix070 shift -0E+10              +9   ->  -0E+10
+	{"shix070", "-0E+10", 9, "-0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// shix071 shift -0E+10              -9   ->  -0E+10
+	{"shix071", "-0E+10", -9, "-0E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// shix141 shift  9.99999999E+999     -1  -> 9.9999999E+998
+	{"shix141", "9.99999999E+999", -1, "9.9999999E+998", 0, 9, ToNearestAway, 999, -999, false},
+	// shix142 shift  9.99999999E+999     -8  -> 9E+991
+	{"shix142", "9.99999999E+999", -8, "9E+991", 0, 9, ToNearestAway, 999, -999, false},
+	// shix143 shift  9.99999999E+999      1  -> 9.99999990E+999
+	{"shix143", "9.99999999E+999", 1, "9.99999990E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix144 shift  9.99999999E+999      8  -> 9.00000000E+999
+	{"shix144", "9.99999999E+999", 8, "9.00000000E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix145 shift  1E-999              -1  -> 0E-999
+	{"shix145", "1E-999", -1, "0E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix146 shift  1E-999              -8  -> 0E-999
+	{"shix146", "1E-999", -8, "0E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix147 shift  1E-999               1  -> 1.0E-998
+	{"shix147", "1E-999", 1, "1.0E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// shix148 shift  1E-999               8  -> 1.00000000E-991
+	{"shix148", "1E-999", 8, "1.00000000E-991", 0, 9, ToNearestAway, 999, -999, false},
+	// shix151 shift  1.00000000E-999     -1  -> 1.0000000E-1000
+	{"shix151", "1.00000000E-999", -1, "1.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix152 shift  1.00000000E-999     -8  -> 1E-1007
+	{"shix152", "1.00000000E-999", -8, "1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix153 shift  1.00000000E-999      1  -> 0E-1007
+	{"shix153", "1.00000000E-999", 1, "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix154 shift  1.00000000E-999      8  -> 0E-1007
+	{"shix154", "1.00000000E-999", 8, "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix155 shift  9.00000000E-999     -1  -> 9.0000000E-1000
+	{"shix155", "9.00000000E-999", -1, "9.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix156 shift  9.00000000E-999     -8  -> 9E-1007
+	{"shix156", "9.00000000E-999", -8, "9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix157 shift  9.00000000E-999      1  -> 0E-1007
+	{"shix157", "9.00000000E-999", 1, "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix158 shift  9.00000000E-999      8  -> 0E-1007
+	{"shix158", "9.00000000E-999", 8, "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix160 shift  1E-1007             -1  -> 0E-1007
+	{"shix160", "1E-1007", -1, "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix161 shift  1E-1007             -8  -> 0E-1007
+	{"shix161", "1E-1007", -8, "0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix162 shift  1E-1007              1  -> 1.0E-1006
+	{"shix162", "1E-1007", 1, "1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// shix163 shift  1E-1007              8  -> 1.00000000E-999
+	{"shix163", "1E-1007", 8, "1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	//  negatives
+	// shix171 shift -9.99999999E+999     -1  -> -9.9999999E+998
+	{"shix171", "-9.99999999E+999", -1, "-9.9999999E+998", 0, 9, ToNearestAway, 999, -999, false},
+	// shix172 shift -9.99999999E+999     -8  -> -9E+991
+	{"shix172", "-9.99999999E+999", -8, "-9E+991", 0, 9, ToNearestAway, 999, -999, false},
+	// shix173 shift -9.99999999E+999      1  -> -9.99999990E+999
+	{"shix173", "-9.99999999E+999", 1, "-9.99999990E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix174 shift -9.99999999E+999      8  -> -9.00000000E+999
+	{"shix174", "-9.99999999E+999", 8, "-9.00000000E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix175 shift -1E-999              -1  -> -0E-999
+	{"shix175", "-1E-999", -1, "-0E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix176 shift -1E-999              -8  -> -0E-999
+	{"shix176", "-1E-999", -8, "-0E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// shix177 shift -1E-999               1  -> -1.0E-998
+	{"shix177", "-1E-999", 1, "-1.0E-998", 0, 9, ToNearestAway, 999, -999, false},
+	// shix178 shift -1E-999               8  -> -1.00000000E-991
+	{"shix178", "-1E-999", 8, "-1.00000000E-991", 0, 9, ToNearestAway, 999, -999, false},
+	// shix181 shift -1.00000000E-999     -1  -> -1.0000000E-1000
+	{"shix181", "-1.00000000E-999", -1, "-1.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix182 shift -1.00000000E-999     -8  -> -1E-1007
+	{"shix182", "-1.00000000E-999", -8, "-1E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix183 shift -1.00000000E-999      1  -> -0E-1007
+	{"shix183", "-1.00000000E-999", 1, "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix184 shift -1.00000000E-999      8  -> -0E-1007
+	{"shix184", "-1.00000000E-999", 8, "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix185 shift -9.00000000E-999     -1  -> -9.0000000E-1000
+	{"shix185", "-9.00000000E-999", -1, "-9.0000000E-1000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix186 shift -9.00000000E-999     -8  -> -9E-1007
+	{"shix186", "-9.00000000E-999", -8, "-9E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix187 shift -9.00000000E-999      1  -> -0E-1007
+	{"shix187", "-9.00000000E-999", 1, "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix188 shift -9.00000000E-999      8  -> -0E-1007
+	{"shix188", "-9.00000000E-999", 8, "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix190 shift -1E-1007             -1  -> -0E-1007
+	{"shix190", "-1E-1007", -1, "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix191 shift -1E-1007             -8  -> -0E-1007
+	{"shix191", "-1E-1007", -8, "-0E-1007", 0, 9, ToNearestAway, 999, -999, false},
+	// shix192 shift -1E-1007              1  -> -1.0E-1006
+	{"shix192", "-1E-1007", 1, "-1.0E-1006", 0, 9, ToNearestAway, 999, -999, false},
+	// shix193 shift -1E-1007              8  -> -1.00000000E-999
+	{"shix193", "-1E-1007", 8, "-1.00000000E-999", 0, 9, ToNearestAway, 999, -999, false},
+	// more negatives (of sanities)
+	// shix201 shift         -0    0  ->  -0
+	{"shix201", "-0", 0, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix202 shift         -0    2  ->  -0
+	{"shix202", "-0", 2, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix203 shift         -1    2  ->  -100
+	{"shix203", "-1", 2, "-100", 0, 9, ToNearestAway, 999, -999, false},
+	// shix204 shift         -1    8  ->  -100000000
+	{"shix204", "-1", 8, "-100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// shix205 shift         -1    9  ->  -0
+	{"shix205", "-1", 9, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix206 shift         -1   -1  ->  -0
+	{"shix206", "-1", -1, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix207 shift -123456789   -1  ->  -12345678
+	{"shix207", "-123456789", -1, "-12345678", 0, 9, ToNearestAway, 999, -999, false},
+	// shix208 shift -123456789   -8  ->  -1
+	{"shix208", "-123456789", -8, "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// shix209 shift -123456789   -9  ->  -0
+	{"shix209", "-123456789", -9, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix210 shift         -0   -2  ->  -0
+	{"shix210", "-0", -2, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// shix211 shift         -0   -0  ->  -0
+	{"shix211", "-0", 0, "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// Specials; NaNs are handled as usual
+	// shix781 shift -Inf  -8     -> -Infinity
+	{"shix781", "-Inf", -8, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix782 shift -Inf  -1     -> -Infinity
+	{"shix782", "-Inf", -1, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix783 shift -Inf  -0     -> -Infinity
+	{"shix783", "-Inf", 0, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix784 shift -Inf   0     -> -Infinity
+	{"shix784", "-Inf", 0, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix785 shift -Inf   1     -> -Infinity
+	{"shix785", "-Inf", 1, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix786 shift -Inf   8     -> -Infinity
+	{"shix786", "-Inf", 8, "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): shix787 shift -1000 -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix788 shift -Inf  -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix789 shift -1    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix790 shift -0    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix791 shift  0    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix792 shift  1    -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix793 shift  1000 -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix794 shift  Inf  -Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix800 shift  Inf  -Inf   -> NaN Invalid_operation
+	// shix801 shift  Inf  -8     -> Infinity
+	{"shix801", "Inf", -8, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix802 shift  Inf  -1     -> Infinity
+	{"shix802", "Inf", -1, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix803 shift  Inf  -0     -> Infinity
+	{"shix803", "Inf", 0, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix804 shift  Inf   0     -> Infinity
+	{"shix804", "Inf", 0, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix805 shift  Inf   1     -> Infinity
+	{"shix805", "Inf", 1, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// shix806 shift  Inf   8     -> Infinity
+	{"shix806", "Inf", 8, "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): shix807 shift  Inf   Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix808 shift -1000  Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix809 shift -Inf   Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix810 shift -1     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix811 shift -0     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix812 shift  0     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix813 shift  1     Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix814 shift  1000  Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix815 shift  Inf   Inf   -> NaN Invalid_operation
+	// SKIP (second operand is not an int): shix821 shift  NaN -Inf    ->  NaN
+	// shix822 shift  NaN -1000   ->  NaN
+	{"shix822", "NaN", -1000, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// shix823 shift  NaN -1      ->  NaN
+	{"shix823", "NaN", -1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// shix824 shift  NaN -0      ->  NaN
+	{"shix824", "NaN", 0, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// shix825 shift  NaN  0      ->  NaN
+	{"shix825", "NaN", 0, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// shix826 shift  NaN  1      ->  NaN
+	{"shix826", "NaN", 1, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// shix827 shift  NaN  1000   ->  NaN
+	{"shix827", "NaN", 1000, "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): shix828 shift  NaN  Inf    ->  NaN
+	// SKIP (second operand is not an int): shix829 shift  NaN  NaN    ->  NaN
+	// SKIP (second operand is not an int): shix830 shift -Inf  NaN    ->  NaN
+	// SKIP (second operand is not an int): shix831 shift -1000 NaN    ->  NaN
+	// SKIP (second operand is not an int): shix832 shift -1    NaN    ->  NaN
+	// SKIP (second operand is not an int): shix833 shift -0    NaN    ->  NaN
+	// SKIP (second operand is not an int): shix834 shift  0    NaN    ->  NaN
+	// SKIP (second operand is not an int): shix835 shift  1    NaN    ->  NaN
+	// SKIP (second operand is not an int): shix836 shift  1000 NaN    ->  NaN
+	// SKIP (second operand is not an int): shix837 shift  Inf  NaN    ->  NaN
+	// SKIP (second operand is not an int): shix841 shift  sNaN -Inf   ->  NaN  Invalid_operation
+	// shix842 shift  sNaN -1000  ->  NaN  Invalid_operation
+	{"shix842", "sNaN", -1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix843 shift  sNaN -1     ->  NaN  Invalid_operation
+	{"shix843", "sNaN", -1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix844 shift  sNaN -0     ->  NaN  Invalid_operation
+	{"shix844", "sNaN", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix845 shift  sNaN  0     ->  NaN  Invalid_operation
+	{"shix845", "sNaN", 0, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix846 shift  sNaN  1     ->  NaN  Invalid_operation
+	{"shix846", "sNaN", 1, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix847 shift  sNaN  1000  ->  NaN  Invalid_operation
+	{"shix847", "sNaN", 1000, "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): shix848 shift  sNaN  NaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix849 shift  sNaN sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix850 shift  NaN  sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix851 shift -Inf  sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix852 shift -1000 sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix853 shift -1    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix854 shift -0    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix855 shift  0    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix856 shift  1    sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix857 shift  1000 sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix858 shift  Inf  sNaN   ->  NaN  Invalid_operation
+	// SKIP (second operand is not an int): shix859 shift  NaN  sNaN   ->  NaN  Invalid_operation
+	// propagating NaNs
+	// SKIP (second operand is not an int): shix861 shift  NaN1   -Inf    ->  NaN1
+	// shix862 shift +NaN2   -1000   ->  NaN2
+	{"shix862", "+NaN2", -1000, "NaN2", 0, 9, ToNearestAway, 999, -999, false},
+	// shix863 shift  NaN3    1000   ->  NaN3
+	{"shix863", "NaN3", 1000, "NaN3", 0, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): shix864 shift  NaN4    Inf    ->  NaN4
+	// SKIP (second operand is not an int): shix865 shift  NaN5   +NaN6   ->  NaN5
+	// SKIP (second operand is not an int): shix866 shift -Inf     NaN7   ->  NaN7
+	// SKIP (second operand is not an int): shix867 shift -1000    NaN8   ->  NaN8
+	// SKIP (second operand is not an int): shix868 shift  1000    NaN9   ->  NaN9
+	// SKIP (second operand is not an int): shix869 shift  Inf    +NaN10  ->  NaN10
+	// SKIP (second operand is not an int): shix871 shift  sNaN11  -Inf   ->  NaN11  Invalid_operation
+	// shix872 shift  sNaN12  -1000  ->  NaN12  Invalid_operation
+	{"shix872", "sNaN12", -1000, "NaN12", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// shix873 shift  sNaN13   1000  ->  NaN13  Invalid_operation
+	{"shix873", "sNaN13", 1000, "NaN13", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// SKIP (second operand is not an int): shix874 shift  sNaN14   NaN17 ->  NaN14  Invalid_operation
+	// SKIP (second operand is not an int): shix875 shift  sNaN15  sNaN18 ->  NaN15  Invalid_operation
+	// SKIP (second operand is not an int): shix876 shift  NaN16   sNaN19 ->  NaN19  Invalid_operation
+	// SKIP (second operand is not an int): shix877 shift -Inf    +sNaN20 ->  NaN20  Invalid_operation
+	// SKIP (second operand is not an int): shix878 shift -1000    sNaN21 ->  NaN21  Invalid_operation
+	// SKIP (second operand is not an int): shix879 shift  1000    sNaN22 ->  NaN22  Invalid_operation
+	// SKIP (second operand is not an int): shix880 shift  Inf     sNaN23 ->  NaN23  Invalid_operation
+	// SKIP (second operand is not an int): shix881 shift +NaN25  +sNaN24 ->  NaN24  Invalid_operation
+	// SKIP (second operand is not an int): shix882 shift -NaN26    NaN28 -> -NaN26
+	// SKIP (second operand is not an int): shix883 shift -sNaN27  sNaN29 -> -NaN27  Invalid_operation
+	// SKIP (second operand is not an int): shix884 shift  1000    -NaN30 -> -NaN30
+	// SKIP (second operand is not an int): shix885 shift  1000   -sNaN31 -> -NaN31  Invalid_operation
+}
@@ -0,0 +1,382 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var tointegralTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// This set of tests tests the extended specification 'round-to-integral
+	// value' operation (from IEEE 854, later modified in 754r).
+	// All non-zero results are defined as being those from either copy or
+	// quantize, so those are assumed to have been tested.
+	// Note that 754r requires that Inexact not be set, and we similarly
+	// assume Rounded is not set.
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// intx001 tointegral      0     ->  0
+	{"intx001", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx002 tointegral      0.0   ->  0
+	{"intx002", "0.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx003 tointegral      0.1   ->  0
+	{"intx003", "0.1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx004 tointegral      0.2   ->  0
+	{"intx004", "0.2", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx005 tointegral      0.3   ->  0
+	{"intx005", "0.3", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx006 tointegral      0.4   ->  0
+	{"intx006", "0.4", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx007 tointegral      0.5   ->  1
+	{"intx007", "0.5", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx008 tointegral      0.6   ->  1
+	{"intx008", "0.6", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx009 tointegral      0.7   ->  1
+	{"intx009", "0.7", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx010 tointegral      0.8   ->  1
+	{"intx010", "0.8", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx011 tointegral      0.9   ->  1
+	{"intx011", "0.9", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx012 tointegral      1     ->  1
+	{"intx012", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx013 tointegral      1.0   ->  1
+	{"intx013", "1.0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx014 tointegral      1.1   ->  1
+	{"intx014", "1.1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx015 tointegral      1.2   ->  1
+	{"intx015", "1.2", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx016 tointegral      1.3   ->  1
+	{"intx016", "1.3", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx017 tointegral      1.4   ->  1
+	{"intx017", "1.4", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx018 tointegral      1.5   ->  2
+	{"intx018", "1.5", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx019 tointegral      1.6   ->  2
+	{"intx019", "1.6", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx020 tointegral      1.7   ->  2
+	{"intx020", "1.7", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx021 tointegral      1.8   ->  2
+	{"intx021", "1.8", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx022 tointegral      1.9   ->  2
+	{"intx022", "1.9", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// negatives
+	// intx031 tointegral     -0     -> -0
+	{"intx031", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx032 tointegral     -0.0   -> -0
+	{"intx032", "-0.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx033 tointegral     -0.1   -> -0
+	{"intx033", "-0.1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx034 tointegral     -0.2   -> -0
+	{"intx034", "-0.2", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx035 tointegral     -0.3   -> -0
+	{"intx035", "-0.3", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx036 tointegral     -0.4   -> -0
+	{"intx036", "-0.4", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx037 tointegral     -0.5   -> -1
+	{"intx037", "-0.5", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx038 tointegral     -0.6   -> -1
+	{"intx038", "-0.6", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx039 tointegral     -0.7   -> -1
+	{"intx039", "-0.7", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx040 tointegral     -0.8   -> -1
+	{"intx040", "-0.8", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx041 tointegral     -0.9   -> -1
+	{"intx041", "-0.9", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx042 tointegral     -1     -> -1
+	{"intx042", "-1", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx043 tointegral     -1.0   -> -1
+	{"intx043", "-1.0", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx044 tointegral     -1.1   -> -1
+	{"intx044", "-1.1", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx045 tointegral     -1.2   -> -1
+	{"intx045", "-1.2", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx046 tointegral     -1.3   -> -1
+	{"intx046", "-1.3", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx047 tointegral     -1.4   -> -1
+	{"intx047", "-1.4", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx048 tointegral     -1.5   -> -2
+	{"intx048", "-1.5", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx049 tointegral     -1.6   -> -2
+	{"intx049", "-1.6", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx050 tointegral     -1.7   -> -2
+	{"intx050", "-1.7", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx051 tointegral     -1.8   -> -2
+	{"intx051", "-1.8", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx052 tointegral     -1.9   -> -2
+	{"intx052", "-1.9", "-2", 0, 9, ToNearestAway, 999, -999, false},
+	// next two would be NaN using quantize(x, 0)
+	// intx053 tointegral    10E+30  -> 1.0E+31
+	{"intx053", "10E+30", "1.0E+31", 0, 9, ToNearestAway, 999, -999, false},
+	// intx054 tointegral   -10E+30  -> -1.0E+31
+	{"intx054", "-10E+30", "-1.0E+31", 0, 9, ToNearestAway, 999, -999, false},
+	// numbers around precision
+	// precision: 9
+	// intx060 tointegral '56267E-10'   -> '0'
+	{"intx060", "56267E-10", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx061 tointegral '56267E-5'    -> '1'
+	{"intx061", "56267E-5", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx062 tointegral '56267E-2'    -> '563'
+	{"intx062", "56267E-2", "563", 0, 9, ToNearestAway, 999, -999, false},
+	// intx063 tointegral '56267E-1'    -> '5627'
+	{"intx063", "56267E-1", "5627", 0, 9, ToNearestAway, 999, -999, false},
+	// intx065 tointegral '56267E-0'    -> '56267'
+	{"intx065", "56267E-0", "56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intx066 tointegral '56267E+0'    -> '56267'
+	{"intx066", "56267E+0", "56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intx067 tointegral '56267E+1'    -> '5.6267E+5'
+	{"intx067", "56267E+1", "5.6267E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// intx068 tointegral '56267E+2'    -> '5.6267E+6'
+	{"intx068", "56267E+2", "5.6267E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// intx069 tointegral '56267E+3'    -> '5.6267E+7'
+	{"intx069", "56267E+3", "5.6267E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// intx070 tointegral '56267E+4'    -> '5.6267E+8'
+	{"intx070", "56267E+4", "5.6267E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// intx071 tointegral '56267E+5'    -> '5.6267E+9'
+	{"intx071", "56267E+5", "5.6267E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// intx072 tointegral '56267E+6'    -> '5.6267E+10'
+	{"intx072", "56267E+6", "5.6267E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// intx073 tointegral '1.23E+96'    -> '1.23E+96'
+	{"intx073", "1.23E+96", "1.23E+96", 0, 9, ToNearestAway, 999, -999, false},
+	// intx074 tointegral '1.23E+384'   -> '1.23E+384'
+	{"intx074", "1.23E+384", "1.23E+384", 0, 9, ToNearestAway, 999, -999, false},
+	// intx075 tointegral '1.23E+999'   -> '1.23E+999'
+	{"intx075", "1.23E+999", "1.23E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// intx080 tointegral '-56267E-10'  -> '-0'
+	{"intx080", "-56267E-10", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx081 tointegral '-56267E-5'   -> '-1'
+	{"intx081", "-56267E-5", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx082 tointegral '-56267E-2'   -> '-563'
+	{"intx082", "-56267E-2", "-563", 0, 9, ToNearestAway, 999, -999, false},
+	// intx083 tointegral '-56267E-1'   -> '-5627'
+	{"intx083", "-56267E-1", "-5627", 0, 9, ToNearestAway, 999, -999, false},
+	// intx085 tointegral '-56267E-0'   -> '-56267'
+	{"intx085", "-56267E-0", "-56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intx086 tointegral '-56267E+0'   -> '-56267'
+	{"intx086", "-56267E+0", "-56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intx087 tointegral '-56267E+1'   -> '-5.6267E+5'
+	{"intx087", "-56267E+1", "-5.6267E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// intx088 tointegral '-56267E+2'   -> '-5.6267E+6'
+	{"intx088", "-56267E+2", "-5.6267E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// intx089 tointegral '-56267E+3'   -> '-5.6267E+7'
+	{"intx089", "-56267E+3", "-5.6267E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// intx090 tointegral '-56267E+4'   -> '-5.6267E+8'
+	{"intx090", "-56267E+4", "-5.6267E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// intx091 tointegral '-56267E+5'   -> '-5.6267E+9'
+	{"intx091", "-56267E+5", "-5.6267E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// intx092 tointegral '-56267E+6'   -> '-5.6267E+10'
+	{"intx092", "-56267E+6", "-5.6267E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// intx093 tointegral '-1.23E+96'   -> '-1.23E+96'
+	{"intx093", "-1.23E+96", "-1.23E+96", 0, 9, ToNearestAway, 999, -999, false},
+	// intx094 tointegral '-1.23E+384'  -> '-1.23E+384'
+	{"intx094", "-1.23E+384", "-1.23E+384", 0, 9, ToNearestAway, 999, -999, false},
+	// intx095 tointegral '-1.23E+999'  -> '-1.23E+999'
+	{"intx095", "-1.23E+999", "-1.23E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// subnormal inputs
+	// intx100 tointegral        1E-999 -> 0
+	{"intx100", "1E-999", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx101 tointegral      0.1E-999 -> 0
+	{"intx101", "0.1E-999", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx102 tointegral     0.01E-999 -> 0
+	{"intx102", "0.01E-999", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx103 tointegral        0E-999 -> 0
+	{"intx103", "0E-999", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// specials and zeros
+	// intx120 tointegral 'Inf'       ->  Infinity
+	{"intx120", "Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// intx121 tointegral '-Inf'      -> -Infinity
+	{"intx121", "-Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// intx122 tointegral   NaN       ->  NaN
+	{"intx122", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// intx123 tointegral  sNaN       ->  NaN  Invalid_operation
+	{"intx123", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// intx124 tointegral     0       ->  0
+	{"intx124", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx125 tointegral    -0       -> -0
+	{"intx125", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx126 tointegral     0.000   ->  0
+	{"intx126", "0.000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx127 tointegral     0.00    ->  0
+	{"intx127", "0.00", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx128 tointegral     0.0     ->  0
+	{"intx128", "0.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx129 tointegral     0       ->  0
+	{"intx129", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx130 tointegral     0E-3    ->  0
+	{"intx130", "0E-3", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx131 tointegral     0E-2    ->  0
+	{"intx131", "0E-2", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx132 tointegral     0E-1    ->  0
+	{"intx132", "0E-1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx133 tointegral     0E-0    ->  0
+	{"intx133", "0E-0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx134 tointegral     0E+1    ->  0E+1
+	{"intx134", "0E+1", "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx135 tointegral     0E+2    ->  0E+2
+	{"intx135", "0E+2", "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx136 tointegral     0E+3    ->  0E+3
+	{"intx136", "0E+3", "0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// intx137 tointegral     0E+4    ->  0E+4
+	{"intx137", "0E+4", "0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// intx138 tointegral     0E+5    ->  0E+5
+	{"intx138", "0E+5", "0E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// intx139 tointegral    -0.000   -> -0
+	{"intx139", "-0.000", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx140 tointegral    -0.00    -> -0
+	{"intx140", "-0.00", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx141 tointegral    -0.0     -> -0
+	{"intx141", "-0.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx142 tointegral    -0       -> -0
+	{"intx142", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx143 tointegral    -0E-3    -> -0
+	{"intx143", "-0E-3", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx144 tointegral    -0E-2    -> -0
+	{"intx144", "-0E-2", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx145 tointegral    -0E-1    -> -0
+	{"intx145", "-0E-1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx146 tointegral    -0E-0    -> -0
+	{"intx146", "-0E-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intx147 tointegral    -0E+1    -> -0E+1
+	{"intx147", "-0E+1", "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// intx148 tointegral    -0E+2    -> -0E+2
+	{"intx148", "-0E+2", "-0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx149 tointegral    -0E+3    -> -0E+3
+	{"intx149", "-0E+3", "-0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// intx150 tointegral    -0E+4    -> -0E+4
+	{"intx150", "-0E+4", "-0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// intx151 tointegral    -0E+5    -> -0E+5
+	{"intx151", "-0E+5", "-0E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// intx152 tointegral   NaN808    ->  NaN808
+	{"intx152", "NaN808", "NaN808", 0, 9, ToNearestAway, 999, -999, false},
+	// intx153 tointegral  sNaN080    ->  NaN80  Invalid_operation
+	{"intx153", "sNaN080", "NaN80", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// intx154 tointegral  -NaN808    -> -NaN808
+	{"intx154", "-NaN808", "-NaN808", 0, 9, ToNearestAway, 999, -999, false},
+	// intx155 tointegral -sNaN080    -> -NaN80  Invalid_operation
+	{"intx155", "-sNaN080", "-NaN80", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// intx156 tointegral  -NaN       -> -NaN
+	{"intx156", "-NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// intx157 tointegral -sNaN       -> -NaN    Invalid_operation
+	{"intx157", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// examples
+	// rounding: half_up
+	// precision: 9
+	// intx200 tointegral     2.1    -> 2
+	{"intx200", "2.1", "2", 0, 9, ToNearestAway, 999, -999, false},
+	// intx201 tointegral   100      -> 100
+	{"intx201", "100", "100", 0, 9, ToNearestAway, 999, -999, false},
+	// intx202 tointegral   100.0    -> 100
+	{"intx202", "100.0", "100", 0, 9, ToNearestAway, 999, -999, false},
+	// intx203 tointegral   101.5    -> 102
+	{"intx203", "101.5", "102", 0, 9, ToNearestAway, 999, -999, false},
+	// intx204 tointegral  -101.5    -> -102
+	{"intx204", "-101.5", "-102", 0, 9, ToNearestAway, 999, -999, false},
+	// intx205 tointegral   10E+5    -> 1.0E+6
+	{"intx205", "10E+5", "1.0E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// intx206 tointegral  7.89E+77  -> 7.89E+77
+	{"intx206", "7.89E+77", "7.89E+77", 0, 9, ToNearestAway, 999, -999, false},
+	// intx207 tointegral   -Inf     -> -Infinity
+	{"intx207", "-Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// all rounding modes
+	// rounding: half_even
+	// intx210 tointegral     55.5   ->  56
+	{"intx210", "55.5", "56", 0, 9, ToNearestEven, 999, -999, false},
+	// intx211 tointegral     56.5   ->  56
+	{"intx211", "56.5", "56", 0, 9, ToNearestEven, 999, -999, false},
+	// intx212 tointegral     57.5   ->  58
+	{"intx212", "57.5", "58", 0, 9, ToNearestEven, 999, -999, false},
+	// intx213 tointegral    -55.5   -> -56
+	{"intx213", "-55.5", "-56", 0, 9, ToNearestEven, 999, -999, false},
+	// intx214 tointegral    -56.5   -> -56
+	{"intx214", "-56.5", "-56", 0, 9, ToNearestEven, 999, -999, false},
+	// intx215 tointegral    -57.5   -> -58
+	{"intx215", "-57.5", "-58", 0, 9, ToNearestEven, 999, -999, false},
+	// rounding: half_up
+	// intx220 tointegral     55.5   ->  56
+	{"intx220", "55.5", "56", 0, 9, ToNearestAway, 999, -999, false},
+	// intx221 tointegral     56.5   ->  57
+	{"intx221", "56.5", "57", 0, 9, ToNearestAway, 999, -999, false},
+	// intx222 tointegral     57.5   ->  58
+	{"intx222", "57.5", "58", 0, 9, ToNearestAway, 999, -999, false},
+	// intx223 tointegral    -55.5   -> -56
+	{"intx223", "-55.5", "-56", 0, 9, ToNearestAway, 999, -999, false},
+	// intx224 tointegral    -56.5   -> -57
+	{"intx224", "-56.5", "-57", 0, 9, ToNearestAway, 999, -999, false},
+	// intx225 tointegral    -57.5   -> -58
+	{"intx225", "-57.5", "-58", 0, 9, ToNearestAway, 999, -999, false},
+	// rounding: half_down
+	// intx230 tointegral     55.5   ->  55
+	{"intx230", "55.5", "55", 0, 9, ToNearestZero, 999, -999, false},
+	// intx231 tointegral     56.5   ->  56
+	{"intx231", "56.5", "56", 0, 9, ToNearestZero, 999, -999, false},
+	// intx232 tointegral     57.5   ->  57
+	{"intx232", "57.5", "57", 0, 9, ToNearestZero, 999, -999, false},
+	// intx233 tointegral    -55.5   -> -55
+	{"intx233", "-55.5", "-55", 0, 9, ToNearestZero, 999, -999, false},
+	// intx234 tointegral    -56.5   -> -56
+	{"intx234", "-56.5", "-56", 0, 9, ToNearestZero, 999, -999, false},
+	// intx235 tointegral    -57.5   -> -57
+	{"intx235", "-57.5", "-57", 0, 9, ToNearestZero, 999, -999, false},
+	// rounding: up
+	// intx240 tointegral     55.3   ->  56
+	{"intx240", "55.3", "56", 0, 9, AwayFromZero, 999, -999, false},
+	// intx241 tointegral     56.3   ->  57
+	{"intx241", "56.3", "57", 0, 9, AwayFromZero, 999, -999, false},
+	// intx242 tointegral     57.3   ->  58
+	{"intx242", "57.3", "58", 0, 9, AwayFromZero, 999, -999, false},
+	// intx243 tointegral    -55.3   -> -56
+	{"intx243", "-55.3", "-56", 0, 9, AwayFromZero, 999, -999, false},
+	// intx244 tointegral    -56.3   -> -57
+	{"intx244", "-56.3", "-57", 0, 9, AwayFromZero, 999, -999, false},
+	// intx245 tointegral    -57.3   -> -58
+	{"intx245", "-57.3", "-58", 0, 9, AwayFromZero, 999, -999, false},
+	// rounding: down
+	// intx250 tointegral     55.7   ->  55
+	{"intx250", "55.7", "55", 0, 9, ToZero, 999, -999, false},
+	// intx251 tointegral     56.7   ->  56
+	{"intx251", "56.7", "56", 0, 9, ToZero, 999, -999, false},
+	// intx252 tointegral     57.7   ->  57
+	{"intx252", "57.7", "57", 0, 9, ToZero, 999, -999, false},
+	// intx253 tointegral    -55.7   -> -55
+	{"intx253", "-55.7", "-55", 0, 9, ToZero, 999, -999, false},
+	// intx254 tointegral    -56.7   -> -56
+	{"intx254", "-56.7", "-56", 0, 9, ToZero, 999, -999, false},
+	// intx255 tointegral    -57.7   -> -57
+	{"intx255", "-57.7", "-57", 0, 9, ToZero, 999, -999, false},
+	// rounding: ceiling
+	// intx260 tointegral     55.3   ->  56
+	{"intx260", "55.3", "56", 0, 9, ToPositiveInf, 999, -999, false},
+	// intx261 tointegral     56.3   ->  57
+	{"intx261", "56.3", "57", 0, 9, ToPositiveInf, 999, -999, false},
+	// intx262 tointegral     57.3   ->  58
+	{"intx262", "57.3", "58", 0, 9, ToPositiveInf, 999, -999, false},
+	// intx263 tointegral    -55.3   -> -55
+	{"intx263", "-55.3", "-55", 0, 9, ToPositiveInf, 999, -999, false},
+	// intx264 tointegral    -56.3   -> -56
+	{"intx264", "-56.3", "-56", 0, 9, ToPositiveInf, 999, -999, false},
+	// intx265 tointegral    -57.3   -> -57
+	{"intx265", "-57.3", "-57", 0, 9, ToPositiveInf, 999, -999, false},
+	// rounding: floor
+	// intx270 tointegral     55.7   ->  55
+	{"intx270", "55.7", "55", 0, 9, ToNegativeInf, 999, -999, false},
+	// intx271 tointegral     56.7   ->  56
+	{"intx271", "56.7", "56", 0, 9, ToNegativeInf, 999, -999, false},
+	// intx272 tointegral     57.7   ->  57
+	{"intx272", "57.7", "57", 0, 9, ToNegativeInf, 999, -999, false},
+	// intx273 tointegral    -55.7   -> -56
+	{"intx273", "-55.7", "-56", 0, 9, ToNegativeInf, 999, -999, false},
+	// intx274 tointegral    -56.7   -> -57
+	{"intx274", "-56.7", "-57", 0, 9, ToNegativeInf, 999, -999, false},
+	// intx275 tointegral    -57.7   -> -58
+	{"intx275", "-57.7", "-58", 0, 9, ToNegativeInf, 999, -999, false},
+}
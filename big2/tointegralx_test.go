@@ -0,0 +1,407 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var tointegralxTests = []struct {
+	id    string
+	in    string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// This set of tests tests the extended specification 'round-to-integral
+	// value' operation (from IEEE 854, later modified in 754r).
+	// All non-zero results are defined as being those from either copy or
+	// quantize, so those are assumed to have been tested.
+	// This tests toIntegraExact, which may set Inexact
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// intxx001 tointegralx      0     ->  0
+	{"intxx001", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx002 tointegralx      0.0   ->  0
+	{"intxx002", "0.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx003 tointegralx      0.1   ->  0 Inexact Rounded
+	{"intxx003", "0.1", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx004 tointegralx      0.2   ->  0 Inexact Rounded
+	{"intxx004", "0.2", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx005 tointegralx      0.3   ->  0 Inexact Rounded
+	{"intxx005", "0.3", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx006 tointegralx      0.4   ->  0 Inexact Rounded
+	{"intxx006", "0.4", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx007 tointegralx      0.5   ->  1 Inexact Rounded
+	{"intxx007", "0.5", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx008 tointegralx      0.6   ->  1 Inexact Rounded
+	{"intxx008", "0.6", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx009 tointegralx      0.7   ->  1 Inexact Rounded
+	{"intxx009", "0.7", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx010 tointegralx      0.8   ->  1 Inexact Rounded
+	{"intxx010", "0.8", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx011 tointegralx      0.9   ->  1 Inexact Rounded
+	{"intxx011", "0.9", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx012 tointegralx      1     ->  1
+	{"intxx012", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx013 tointegralx      1.0   ->  1 Rounded
+	{"intxx013", "1.0", "1", Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx014 tointegralx      1.1   ->  1 Inexact Rounded
+	{"intxx014", "1.1", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx015 tointegralx      1.2   ->  1 Inexact Rounded
+	{"intxx015", "1.2", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx016 tointegralx      1.3   ->  1 Inexact Rounded
+	{"intxx016", "1.3", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx017 tointegralx      1.4   ->  1 Inexact Rounded
+	{"intxx017", "1.4", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx018 tointegralx      1.5   ->  2 Inexact Rounded
+	{"intxx018", "1.5", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx019 tointegralx      1.6   ->  2 Inexact Rounded
+	{"intxx019", "1.6", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx020 tointegralx      1.7   ->  2 Inexact Rounded
+	{"intxx020", "1.7", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx021 tointegralx      1.8   ->  2 Inexact Rounded
+	{"intxx021", "1.8", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx022 tointegralx      1.9   ->  2 Inexact Rounded
+	{"intxx022", "1.9", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// negatives
+	// intxx031 tointegralx     -0     -> -0
+	{"intxx031", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx032 tointegralx     -0.0   -> -0
+	{"intxx032", "-0.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx033 tointegralx     -0.1   -> -0 Inexact Rounded
+	{"intxx033", "-0.1", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx034 tointegralx     -0.2   -> -0 Inexact Rounded
+	{"intxx034", "-0.2", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx035 tointegralx     -0.3   -> -0 Inexact Rounded
+	{"intxx035", "-0.3", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx036 tointegralx     -0.4   -> -0 Inexact Rounded
+	{"intxx036", "-0.4", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx037 tointegralx     -0.5   -> -1 Inexact Rounded
+	{"intxx037", "-0.5", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx038 tointegralx     -0.6   -> -1 Inexact Rounded
+	{"intxx038", "-0.6", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx039 tointegralx     -0.7   -> -1 Inexact Rounded
+	{"intxx039", "-0.7", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx040 tointegralx     -0.8   -> -1 Inexact Rounded
+	{"intxx040", "-0.8", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx041 tointegralx     -0.9   -> -1 Inexact Rounded
+	{"intxx041", "-0.9", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx042 tointegralx     -1     -> -1
+	{"intxx042", "-1", "-1", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx043 tointegralx     -1.0   -> -1 Rounded
+	{"intxx043", "-1.0", "-1", Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx044 tointegralx     -1.1   -> -1 Inexact Rounded
+	{"intxx044", "-1.1", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx045 tointegralx     -1.2   -> -1 Inexact Rounded
+	{"intxx045", "-1.2", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx046 tointegralx     -1.3   -> -1 Inexact Rounded
+	{"intxx046", "-1.3", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx047 tointegralx     -1.4   -> -1 Inexact Rounded
+	{"intxx047", "-1.4", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx048 tointegralx     -1.5   -> -2 Inexact Rounded
+	{"intxx048", "-1.5", "-2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx049 tointegralx     -1.6   -> -2 Inexact Rounded
+	{"intxx049", "-1.6", "-2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx050 tointegralx     -1.7   -> -2 Inexact Rounded
+	{"intxx050", "-1.7", "-2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx051 tointegralx     -1.8   -> -2 Inexact Rounded
+	{"intxx051", "-1.8", "-2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx052 tointegralx     -1.9   -> -2 Inexact Rounded
+	{"intxx052", "-1.9", "-2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// next two would be NaN using quantize(x, 0)
+	// intxx053 tointegralx    10E+30  -> 1.0E+31
+	{"intxx053", "10E+30", "1.0E+31", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx054 tointegralx   -10E+30  -> -1.0E+31
+	{"intxx054", "-10E+30", "-1.0E+31", 0, 9, ToNearestAway, 999, -999, false},
+	// numbers around precision
+	// precision: 9
+	// intxx060 tointegralx '56267E-10'   -> '0'               Inexact Rounded
+	{"intxx060", "56267E-10", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx061 tointegralx '56267E-5'    -> '1'               Inexact Rounded
+	{"intxx061", "56267E-5", "1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx062 tointegralx '56267E-2'    -> '563'             Inexact Rounded
+	{"intxx062", "56267E-2", "563", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx063 tointegralx '56267E-1'    -> '5627'            Inexact Rounded
+	{"intxx063", "56267E-1", "5627", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx065 tointegralx '56267E-0'    -> '56267'
+	{"intxx065", "56267E-0", "56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx066 tointegralx '56267E+0'    -> '56267'
+	{"intxx066", "56267E+0", "56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx067 tointegralx '56267E+1'    -> '5.6267E+5'
+	{"intxx067", "56267E+1", "5.6267E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx068 tointegralx '56267E+2'    -> '5.6267E+6'
+	{"intxx068", "56267E+2", "5.6267E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx069 tointegralx '56267E+3'    -> '5.6267E+7'
+	{"intxx069", "56267E+3", "5.6267E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx070 tointegralx '56267E+4'    -> '5.6267E+8'
+	{"intxx070", "56267E+4", "5.6267E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx071 tointegralx '56267E+5'    -> '5.6267E+9'
+	{"intxx071", "56267E+5", "5.6267E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx072 tointegralx '56267E+6'    -> '5.6267E+10'
+	{"intxx072", "56267E+6", "5.6267E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx073 tointegralx '1.23E+96'    -> '1.23E+96'
+	{"intxx073", "1.23E+96", "1.23E+96", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx074 tointegralx '1.23E+384'   -> '1.23E+384'
+	{"intxx074", "1.23E+384", "1.23E+384", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx075 tointegralx '1.23E+999'   -> '1.23E+999'
+	{"intxx075", "1.23E+999", "1.23E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx080 tointegralx '-56267E-10'  -> '-0'              Inexact Rounded
+	{"intxx080", "-56267E-10", "-0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx081 tointegralx '-56267E-5'   -> '-1'              Inexact Rounded
+	{"intxx081", "-56267E-5", "-1", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx082 tointegralx '-56267E-2'   -> '-563'            Inexact Rounded
+	{"intxx082", "-56267E-2", "-563", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx083 tointegralx '-56267E-1'   -> '-5627'           Inexact Rounded
+	{"intxx083", "-56267E-1", "-5627", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx085 tointegralx '-56267E-0'   -> '-56267'
+	{"intxx085", "-56267E-0", "-56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx086 tointegralx '-56267E+0'   -> '-56267'
+	{"intxx086", "-56267E+0", "-56267", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx087 tointegralx '-56267E+1'   -> '-5.6267E+5'
+	{"intxx087", "-56267E+1", "-5.6267E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx088 tointegralx '-56267E+2'   -> '-5.6267E+6'
+	{"intxx088", "-56267E+2", "-5.6267E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx089 tointegralx '-56267E+3'   -> '-5.6267E+7'
+	{"intxx089", "-56267E+3", "-5.6267E+7", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx090 tointegralx '-56267E+4'   -> '-5.6267E+8'
+	{"intxx090", "-56267E+4", "-5.6267E+8", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx091 tointegralx '-56267E+5'   -> '-5.6267E+9'
+	{"intxx091", "-56267E+5", "-5.6267E+9", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx092 tointegralx '-56267E+6'   -> '-5.6267E+10'
+	{"intxx092", "-56267E+6", "-5.6267E+10", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx093 tointegralx '-1.23E+96'   -> '-1.23E+96'
+	{"intxx093", "-1.23E+96", "-1.23E+96", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx094 tointegralx '-1.23E+384'  -> '-1.23E+384'
+	{"intxx094", "-1.23E+384", "-1.23E+384", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx095 tointegralx '-1.23E+999'  -> '-1.23E+999'
+	{"intxx095", "-1.23E+999", "-1.23E+999", 0, 9, ToNearestAway, 999, -999, false},
+	// subnormal inputs
+	// intxx100 tointegralx        1E-999 -> 0                 Inexact Rounded
+	{"intxx100", "1E-999", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx101 tointegralx      0.1E-999 -> 0                 Inexact Rounded
+	{"intxx101", "0.1E-999", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx102 tointegralx     0.01E-999 -> 0                 Inexact Rounded
+	{"intxx102", "0.01E-999", "0", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx103 tointegralx        0E-999 -> 0
+	{"intxx103", "0E-999", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// specials and zeros
+	// intxx120 tointegralx 'Inf'       ->  Infinity
+	{"intxx120", "Inf", "Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx121 tointegralx '-Inf'      -> -Infinity
+	{"intxx121", "-Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx122 tointegralx   NaN       ->  NaN
+	{"intxx122", "NaN", "NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx123 tointegralx  sNaN       ->  NaN  Invalid_operation
+	{"intxx123", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// intxx124 tointegralx     0       ->  0
+	{"intxx124", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx125 tointegralx    -0       -> -0
+	{"intxx125", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx126 tointegralx     0.000   ->  0
+	{"intxx126", "0.000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx127 tointegralx     0.00    ->  0
+	{"intxx127", "0.00", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx128 tointegralx     0.0     ->  0
+	{"intxx128", "0.0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx129 tointegralx     0       ->  0
+	{"intxx129", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx130 tointegralx     0E-3    ->  0
+	{"intxx130", "0E-3", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx131 tointegralx     0E-2    ->  0
+	{"intxx131", "0E-2", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx132 tointegralx     0E-1    ->  0
+	{"intxx132", "0E-1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx133 tointegralx     0E-0    ->  0
+	{"intxx133", "0E-0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx134 tointegralx     0E+1    ->  0E+1
+	{"intxx134", "0E+1", "0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx135 tointegralx     0E+2    ->  0E+2
+	{"intxx135", "0E+2", "0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx136 tointegralx     0E+3    ->  0E+3
+	{"intxx136", "0E+3", "0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx137 tointegralx     0E+4    ->  0E+4
+	{"intxx137", "0E+4", "0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx138 tointegralx     0E+5    ->  0E+5
+	{"intxx138", "0E+5", "0E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx139 tointegralx    -0.000   -> -0
+	{"intxx139", "-0.000", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx140 tointegralx    -0.00    -> -0
+	{"intxx140", "-0.00", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx141 tointegralx    -0.0     -> -0
+	{"intxx141", "-0.0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx142 tointegralx    -0       -> -0
+	{"intxx142", "-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx143 tointegralx    -0E-3    -> -0
+	{"intxx143", "-0E-3", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx144 tointegralx    -0E-2    -> -0
+	{"intxx144", "-0E-2", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx145 tointegralx    -0E-1    -> -0
+	{"intxx145", "-0E-1", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx146 tointegralx    -0E-0    -> -0
+	{"intxx146", "-0E-0", "-0", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx147 tointegralx    -0E+1    -> -0E+1
+	{"intxx147", "-0E+1", "-0E+1", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx148 tointegralx    -0E+2    -> -0E+2
+	{"intxx148", "-0E+2", "-0E+2", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx149 tointegralx    -0E+3    -> -0E+3
+	{"intxx149", "-0E+3", "-0E+3", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx150 tointegralx    -0E+4    -> -0E+4
+	{"intxx150", "-0E+4", "-0E+4", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx151 tointegralx    -0E+5    -> -0E+5
+	{"intxx151", "-0E+5", "-0E+5", 0, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// intxx152 tointegralx   NaN808    ->  NaN808
+	{"intxx152", "NaN808", "NaN808", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx153 tointegralx  sNaN080    ->  NaN80  Invalid_operation
+	{"intxx153", "sNaN080", "NaN80", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// intxx154 tointegralx  -NaN808    -> -NaN808
+	{"intxx154", "-NaN808", "-NaN808", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx155 tointegralx -sNaN080    -> -NaN80  Invalid_operation
+	{"intxx155", "-sNaN080", "-NaN80", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// intxx156 tointegralx  -NaN       -> -NaN
+	{"intxx156", "-NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx157 tointegralx -sNaN       -> -NaN    Invalid_operation
+	{"intxx157", "-sNaN", "-NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// examples
+	// rounding: half_up
+	// precision: 9
+	// intxx200 tointegralx     2.1    -> 2                    Inexact Rounded
+	{"intxx200", "2.1", "2", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx201 tointegralx   100      -> 100
+	{"intxx201", "100", "100", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx202 tointegralx   100.0    -> 100                  Rounded
+	{"intxx202", "100.0", "100", Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx203 tointegralx   101.5    -> 102                  Inexact Rounded
+	{"intxx203", "101.5", "102", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx204 tointegralx  -101.5    -> -102                 Inexact Rounded
+	{"intxx204", "-101.5", "-102", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx205 tointegralx   10E+5    -> 1.0E+6
+	{"intxx205", "10E+5", "1.0E+6", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx206 tointegralx  7.89E+77  -> 7.89E+77
+	{"intxx206", "7.89E+77", "7.89E+77", 0, 9, ToNearestAway, 999, -999, false},
+	// intxx207 tointegralx   -Inf     -> -Infinity
+	{"intxx207", "-Inf", "-Inf", 0, 9, ToNearestAway, 999, -999, false},
+	// all rounding modes
+	// rounding: half_even
+	// intxx210 tointegralx     55.5   ->  56   Inexact Rounded
+	{"intxx210", "55.5", "56", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// intxx211 tointegralx     56.5   ->  56   Inexact Rounded
+	{"intxx211", "56.5", "56", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// intxx212 tointegralx     57.5   ->  58   Inexact Rounded
+	{"intxx212", "57.5", "58", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// intxx213 tointegralx    -55.5   -> -56   Inexact Rounded
+	{"intxx213", "-55.5", "-56", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// intxx214 tointegralx    -56.5   -> -56   Inexact Rounded
+	{"intxx214", "-56.5", "-56", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// intxx215 tointegralx    -57.5   -> -58   Inexact Rounded
+	{"intxx215", "-57.5", "-58", Inexact | Rounded, 9, ToNearestEven, 999, -999, false},
+	// rounding: half_up
+	// intxx220 tointegralx     55.5   ->  56   Inexact Rounded
+	{"intxx220", "55.5", "56", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx221 tointegralx     56.5   ->  57   Inexact Rounded
+	{"intxx221", "56.5", "57", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx222 tointegralx     57.5   ->  58   Inexact Rounded
+	{"intxx222", "57.5", "58", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx223 tointegralx    -55.5   -> -56   Inexact Rounded
+	{"intxx223", "-55.5", "-56", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx224 tointegralx    -56.5   -> -57   Inexact Rounded
+	{"intxx224", "-56.5", "-57", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// intxx225 tointegralx    -57.5   -> -58   Inexact Rounded
+	{"intxx225", "-57.5", "-58", Inexact | Rounded, 9, ToNearestAway, 999, -999, false},
+	// rounding: half_down
+	// intxx230 tointegralx     55.5   ->  55   Inexact Rounded
+	{"intxx230", "55.5", "55", Inexact | Rounded, 9, ToNearestZero, 999, -999, false},
+	// intxx231 tointegralx     56.5   ->  56   Inexact Rounded
+	{"intxx231", "56.5", "56", Inexact | Rounded, 9, ToNearestZero, 999, -999, false},
+	// intxx232 tointegralx     57.5   ->  57   Inexact Rounded
+	{"intxx232", "57.5", "57", Inexact | Rounded, 9, ToNearestZero, 999, -999, false},
+	// intxx233 tointegralx    -55.5   -> -55   Inexact Rounded
+	{"intxx233", "-55.5", "-55", Inexact | Rounded, 9, ToNearestZero, 999, -999, false},
+	// intxx234 tointegralx    -56.5   -> -56   Inexact Rounded
+	{"intxx234", "-56.5", "-56", Inexact | Rounded, 9, ToNearestZero, 999, -999, false},
+	// intxx235 tointegralx    -57.5   -> -57   Inexact Rounded
+	{"intxx235", "-57.5", "-57", Inexact | Rounded, 9, ToNearestZero, 999, -999, false},
+	// rounding: up
+	// intxx240 tointegralx     55.3   ->  56   Inexact Rounded
+	{"intxx240", "55.3", "56", Inexact | Rounded, 9, AwayFromZero, 999, -999, false},
+	// intxx241 tointegralx     56.3   ->  57   Inexact Rounded
+	{"intxx241", "56.3", "57", Inexact | Rounded, 9, AwayFromZero, 999, -999, false},
+	// intxx242 tointegralx     57.3   ->  58   Inexact Rounded
+	{"intxx242", "57.3", "58", Inexact | Rounded, 9, AwayFromZero, 999, -999, false},
+	// intxx243 tointegralx    -55.3   -> -56   Inexact Rounded
+	{"intxx243", "-55.3", "-56", Inexact | Rounded, 9, AwayFromZero, 999, -999, false},
+	// intxx244 tointegralx    -56.3   -> -57   Inexact Rounded
+	{"intxx244", "-56.3", "-57", Inexact | Rounded, 9, AwayFromZero, 999, -999, false},
+	// intxx245 tointegralx    -57.3   -> -58   Inexact Rounded
+	{"intxx245", "-57.3", "-58", Inexact | Rounded, 9, AwayFromZero, 999, -999, false},
+	// rounding: down
+	// intxx250 tointegralx     55.7   ->  55   Inexact Rounded
+	{"intxx250", "55.7", "55", Inexact | Rounded, 9, ToZero, 999, -999, false},
+	// intxx251 tointegralx     56.7   ->  56   Inexact Rounded
+	{"intxx251", "56.7", "56", Inexact | Rounded, 9, ToZero, 999, -999, false},
+	// intxx252 tointegralx     57.7   ->  57   Inexact Rounded
+	{"intxx252", "57.7", "57", Inexact | Rounded, 9, ToZero, 999, -999, false},
+	// intxx253 tointegralx    -55.7   -> -55   Inexact Rounded
+	{"intxx253", "-55.7", "-55", Inexact | Rounded, 9, ToZero, 999, -999, false},
+	// intxx254 tointegralx    -56.7   -> -56   Inexact Rounded
+	{"intxx254", "-56.7", "-56", Inexact | Rounded, 9, ToZero, 999, -999, false},
+	// intxx255 tointegralx    -57.7   -> -57   Inexact Rounded
+	{"intxx255", "-57.7", "-57", Inexact | Rounded, 9, ToZero, 999, -999, false},
+	// rounding: ceiling
+	// intxx260 tointegralx     55.3   ->  56   Inexact Rounded
+	{"intxx260", "55.3", "56", Inexact | Rounded, 9, ToPositiveInf, 999, -999, false},
+	// intxx261 tointegralx     56.3   ->  57   Inexact Rounded
+	{"intxx261", "56.3", "57", Inexact | Rounded, 9, ToPositiveInf, 999, -999, false},
+	// intxx262 tointegralx     57.3   ->  58   Inexact Rounded
+	{"intxx262", "57.3", "58", Inexact | Rounded, 9, ToPositiveInf, 999, -999, false},
+	// intxx263 tointegralx    -55.3   -> -55   Inexact Rounded
+	{"intxx263", "-55.3", "-55", Inexact | Rounded, 9, ToPositiveInf, 999, -999, false},
+	// intxx264 tointegralx    -56.3   -> -56   Inexact Rounded
+	{"intxx264", "-56.3", "-56", Inexact | Rounded, 9, ToPositiveInf, 999, -999, false},
+	// intxx265 tointegralx    -57.3   -> -57   Inexact Rounded
+	{"intxx265", "-57.3", "-57", Inexact | Rounded, 9, ToPositiveInf, 999, -999, false},
+	// rounding: floor
+	// intxx270 tointegralx     55.7   ->  55   Inexact Rounded
+	{"intxx270", "55.7", "55", Inexact | Rounded, 9, ToNegativeInf, 999, -999, false},
+	// intxx271 tointegralx     56.7   ->  56   Inexact Rounded
+	{"intxx271", "56.7", "56", Inexact | Rounded, 9, ToNegativeInf, 999, -999, false},
+	// intxx272 tointegralx     57.7   ->  57   Inexact Rounded
+	{"intxx272", "57.7", "57", Inexact | Rounded, 9, ToNegativeInf, 999, -999, false},
+	// intxx273 tointegralx    -55.7   -> -56   Inexact Rounded
+	{"intxx273", "-55.7", "-56", Inexact | Rounded, 9, ToNegativeInf, 999, -999, false},
+	// intxx274 tointegralx    -56.7   -> -57   Inexact Rounded
+	{"intxx274", "-56.7", "-57", Inexact | Rounded, 9, ToNegativeInf, 999, -999, false},
+	// intxx275 tointegralx    -57.7   -> -58   Inexact Rounded
+	{"intxx275", "-57.7", "-58", Inexact | Rounded, 9, ToNegativeInf, 999, -999, false},
+	// Int and uInt32 edge values for testing conversions
+	// precision: 16
+	// intxx300 tointegralx -2147483646  -> -2147483646
+	{"intxx300", "-2147483646", "-2147483646", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx301 tointegralx -2147483647  -> -2147483647
+	{"intxx301", "-2147483647", "-2147483647", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx302 tointegralx -2147483648  -> -2147483648
+	{"intxx302", "-2147483648", "-2147483648", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx303 tointegralx -2147483649  -> -2147483649
+	{"intxx303", "-2147483649", "-2147483649", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx304 tointegralx  2147483646  ->  2147483646
+	{"intxx304", "2147483646", "2147483646", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx305 tointegralx  2147483647  ->  2147483647
+	{"intxx305", "2147483647", "2147483647", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx306 tointegralx  2147483648  ->  2147483648
+	{"intxx306", "2147483648", "2147483648", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx307 tointegralx  2147483649  ->  2147483649
+	{"intxx307", "2147483649", "2147483649", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx308 tointegralx  4294967294  ->  4294967294
+	{"intxx308", "4294967294", "4294967294", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx309 tointegralx  4294967295  ->  4294967295
+	{"intxx309", "4294967295", "4294967295", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx310 tointegralx  4294967296  ->  4294967296
+	{"intxx310", "4294967296", "4294967296", 0, 16, ToNegativeInf, 999, -999, false},
+	// intxx311 tointegralx  4294967297  ->  4294967297
+	{"intxx311", "4294967297", "4294967297", 0, 16, ToNegativeInf, 999, -999, false},
+}
@@ -0,0 +1,592 @@
+package big2
+
+// Generated by dectest. DO NOT EDIT
+
+var xorTests = []struct {
+	id    string
+	in1   string
+	in2   string
+	out   string
+	cond  Condition
+	prec  uint
+	mode  RoundingMode
+	emax  int
+	emin  int
+	clamp bool
+}{
+	// version: 2.59
+	// extended: 1
+	// precision: 9
+	// rounding: half_up
+	// maxexponent: 999
+	// minexponent: -999
+	// Sanity check (truth table)
+	// xorx001 xor             0    0 ->    0
+	{"xorx001", "0", "0", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx002 xor             0    1 ->    1
+	{"xorx002", "0", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx003 xor             1    0 ->    1
+	{"xorx003", "1", "0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx004 xor             1    1 ->    0
+	{"xorx004", "1", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx005 xor          1100 1010 ->  110
+	{"xorx005", "1100", "1010", "110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx006 xor          1111   10 -> 1101
+	{"xorx006", "1111", "10", "1101", 0, 9, ToNearestAway, 999, -999, false},
+	// and at msd and msd-1
+	// xorx010 xor 000000000 000000000 ->           0
+	{"xorx010", "000000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx011 xor 000000000 100000000 ->   100000000
+	{"xorx011", "000000000", "100000000", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx012 xor 100000000 000000000 ->   100000000
+	{"xorx012", "100000000", "000000000", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx013 xor 100000000 100000000 ->           0
+	{"xorx013", "100000000", "100000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx014 xor 000000000 000000000 ->           0
+	{"xorx014", "000000000", "000000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx015 xor 000000000 010000000 ->    10000000
+	{"xorx015", "000000000", "010000000", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx016 xor 010000000 000000000 ->    10000000
+	{"xorx016", "010000000", "000000000", "10000000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx017 xor 010000000 010000000 ->           0
+	{"xorx017", "010000000", "010000000", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// Various lengths
+	//        123456789     123456789      123456789
+	// xorx021 xor 111111111     111111111  ->  0
+	{"xorx021", "111111111", "111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx022 xor 111111111111  111111111  ->  0
+	{"xorx022", "111111111111", "111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx023 xor  11111111      11111111  ->  0
+	{"xorx023", "11111111", "11111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx025 xor   1111111       1111111  ->  0
+	{"xorx025", "1111111", "1111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx026 xor    111111        111111  ->  0
+	{"xorx026", "111111", "111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx027 xor     11111         11111  ->  0
+	{"xorx027", "11111", "11111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx028 xor      1111          1111  ->  0
+	{"xorx028", "1111", "1111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx029 xor       111           111  ->  0
+	{"xorx029", "111", "111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx031 xor        11            11  ->  0
+	{"xorx031", "11", "11", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx032 xor         1             1  ->  0
+	{"xorx032", "1", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx033 xor 111111111111 1111111111  ->  0
+	{"xorx033", "111111111111", "1111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx034 xor 11111111111 11111111111  ->  0
+	{"xorx034", "11111111111", "11111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx035 xor 1111111111 111111111111  ->  0
+	{"xorx035", "1111111111", "111111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx036 xor 111111111 1111111111111  ->  0
+	{"xorx036", "111111111", "1111111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx040 xor 111111111  111111111111  ->  0
+	{"xorx040", "111111111", "111111111111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx041 xor  11111111  111111111111  ->  100000000
+	{"xorx041", "11111111", "111111111111", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx042 xor  11111111     111111111  ->  100000000
+	{"xorx042", "11111111", "111111111", "100000000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx043 xor   1111111     100000010  ->  101111101
+	{"xorx043", "1111111", "100000010", "101111101", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx044 xor    111111     100000100  ->  100111011
+	{"xorx044", "111111", "100000100", "100111011", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx045 xor     11111     100001000  ->  100010111
+	{"xorx045", "11111", "100001000", "100010111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx046 xor      1111     100010000  ->  100011111
+	{"xorx046", "1111", "100010000", "100011111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx047 xor       111     100100000  ->  100100111
+	{"xorx047", "111", "100100000", "100100111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx048 xor        11     101000000  ->  101000011
+	{"xorx048", "11", "101000000", "101000011", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx049 xor         1     110000000  ->  110000001
+	{"xorx049", "1", "110000000", "110000001", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx050 xor 1111111111  1  ->  111111110
+	{"xorx050", "1111111111", "1", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx051 xor  111111111  1  ->  111111110
+	{"xorx051", "111111111", "1", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx052 xor   11111111  1  ->  11111110
+	{"xorx052", "11111111", "1", "11111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx053 xor    1111111  1  ->  1111110
+	{"xorx053", "1111111", "1", "1111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx054 xor     111111  1  ->  111110
+	{"xorx054", "111111", "1", "111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx055 xor      11111  1  ->  11110
+	{"xorx055", "11111", "1", "11110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx056 xor       1111  1  ->  1110
+	{"xorx056", "1111", "1", "1110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx057 xor        111  1  ->  110
+	{"xorx057", "111", "1", "110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx058 xor         11  1  ->  10
+	{"xorx058", "11", "1", "10", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx059 xor          1  1  ->  0
+	{"xorx059", "1", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx060 xor 1111111111  0  ->  111111111
+	{"xorx060", "1111111111", "0", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx061 xor  111111111  0  ->  111111111
+	{"xorx061", "111111111", "0", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx062 xor   11111111  0  ->  11111111
+	{"xorx062", "11111111", "0", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx063 xor    1111111  0  ->  1111111
+	{"xorx063", "1111111", "0", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx064 xor     111111  0  ->  111111
+	{"xorx064", "111111", "0", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx065 xor      11111  0  ->  11111
+	{"xorx065", "11111", "0", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx066 xor       1111  0  ->  1111
+	{"xorx066", "1111", "0", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx067 xor        111  0  ->  111
+	{"xorx067", "111", "0", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx068 xor         11  0  ->  11
+	{"xorx068", "11", "0", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx069 xor          1  0  ->  1
+	{"xorx069", "1", "0", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx070 xor 1  1111111111  ->  111111110
+	{"xorx070", "1", "1111111111", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx071 xor 1   111111111  ->  111111110
+	{"xorx071", "1", "111111111", "111111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx072 xor 1    11111111  ->  11111110
+	{"xorx072", "1", "11111111", "11111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx073 xor 1     1111111  ->  1111110
+	{"xorx073", "1", "1111111", "1111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx074 xor 1      111111  ->  111110
+	{"xorx074", "1", "111111", "111110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx075 xor 1       11111  ->  11110
+	{"xorx075", "1", "11111", "11110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx076 xor 1        1111  ->  1110
+	{"xorx076", "1", "1111", "1110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx077 xor 1         111  ->  110
+	{"xorx077", "1", "111", "110", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx078 xor 1          11  ->  10
+	{"xorx078", "1", "11", "10", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx079 xor 1           1  ->  0
+	{"xorx079", "1", "1", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx080 xor 0  1111111111  ->  111111111
+	{"xorx080", "0", "1111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx081 xor 0   111111111  ->  111111111
+	{"xorx081", "0", "111111111", "111111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx082 xor 0    11111111  ->  11111111
+	{"xorx082", "0", "11111111", "11111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx083 xor 0     1111111  ->  1111111
+	{"xorx083", "0", "1111111", "1111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx084 xor 0      111111  ->  111111
+	{"xorx084", "0", "111111", "111111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx085 xor 0       11111  ->  11111
+	{"xorx085", "0", "11111", "11111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx086 xor 0        1111  ->  1111
+	{"xorx086", "0", "1111", "1111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx087 xor 0         111  ->  111
+	{"xorx087", "0", "111", "111", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx088 xor 0          11  ->  11
+	{"xorx088", "0", "11", "11", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx089 xor 0           1  ->  1
+	{"xorx089", "0", "1", "1", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx090 xor 011111111  111101111  ->  100010000
+	{"xorx090", "011111111", "111101111", "100010000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx091 xor 101111111  111101111  ->   10010000
+	{"xorx091", "101111111", "111101111", "10010000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx092 xor 110111111  111101111  ->    1010000
+	{"xorx092", "110111111", "111101111", "1010000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx093 xor 111011111  111101111  ->     110000
+	{"xorx093", "111011111", "111101111", "110000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx094 xor 111101111  111101111  ->          0
+	{"xorx094", "111101111", "111101111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx095 xor 111110111  111101111  ->      11000
+	{"xorx095", "111110111", "111101111", "11000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx096 xor 111111011  111101111  ->      10100
+	{"xorx096", "111111011", "111101111", "10100", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx097 xor 111111101  111101111  ->      10010
+	{"xorx097", "111111101", "111101111", "10010", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx098 xor 111111110  111101111  ->      10001
+	{"xorx098", "111111110", "111101111", "10001", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx100 xor 111101111  011111111  ->  100010000
+	{"xorx100", "111101111", "011111111", "100010000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx101 xor 111101111  101111111  ->   10010000
+	{"xorx101", "111101111", "101111111", "10010000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx102 xor 111101111  110111111  ->    1010000
+	{"xorx102", "111101111", "110111111", "1010000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx103 xor 111101111  111011111  ->     110000
+	{"xorx103", "111101111", "111011111", "110000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx104 xor 111101111  111101111  ->          0
+	{"xorx104", "111101111", "111101111", "0", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx105 xor 111101111  111110111  ->      11000
+	{"xorx105", "111101111", "111110111", "11000", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx106 xor 111101111  111111011  ->      10100
+	{"xorx106", "111101111", "111111011", "10100", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx107 xor 111101111  111111101  ->      10010
+	{"xorx107", "111101111", "111111101", "10010", 0, 9, ToNearestAway, 999, -999, false},
+	// xorx108 xor 111101111  111111110  ->      10001
+	{"xorx108", "111101111", "111111110", "10001", 0, 9, ToNearestAway, 999, -999, false},
+	// non-0/1 should not be accepted, nor should signs
+	// xorx220 xor 111111112  111111111  ->  NaN Invalid_operation
+	{"xorx220", "111111112", "111111111", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx221 xor 333333333  333333333  ->  NaN Invalid_operation
+	{"xorx221", "333333333", "333333333", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx222 xor 555555555  555555555  ->  NaN Invalid_operation
+	{"xorx222", "555555555", "555555555", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx223 xor 777777777  777777777  ->  NaN Invalid_operation
+	{"xorx223", "777777777", "777777777", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx224 xor 999999999  999999999  ->  NaN Invalid_operation
+	{"xorx224", "999999999", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx225 xor 222222222  999999999  ->  NaN Invalid_operation
+	{"xorx225", "222222222", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx226 xor 444444444  999999999  ->  NaN Invalid_operation
+	{"xorx226", "444444444", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx227 xor 666666666  999999999  ->  NaN Invalid_operation
+	{"xorx227", "666666666", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx228 xor 888888888  999999999  ->  NaN Invalid_operation
+	{"xorx228", "888888888", "999999999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx229 xor 999999999  222222222  ->  NaN Invalid_operation
+	{"xorx229", "999999999", "222222222", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx230 xor 999999999  444444444  ->  NaN Invalid_operation
+	{"xorx230", "999999999", "444444444", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx231 xor 999999999  666666666  ->  NaN Invalid_operation
+	{"xorx231", "999999999", "666666666", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx232 xor 999999999  888888888  ->  NaN Invalid_operation
+	{"xorx232", "999999999", "888888888", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// a few randoms
+	// xorx240 xor  567468689 -934981942 ->  NaN Invalid_operation
+	{"xorx240", "567468689", "-934981942", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx241 xor  567367689  934981942 ->  NaN Invalid_operation
+	{"xorx241", "567367689", "934981942", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx242 xor -631917772 -706014634 ->  NaN Invalid_operation
+	{"xorx242", "-631917772", "-706014634", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx243 xor -756253257  138579234 ->  NaN Invalid_operation
+	{"xorx243", "-756253257", "138579234", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx244 xor  835590149  567435400 ->  NaN Invalid_operation
+	{"xorx244", "835590149", "567435400", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD
+	// xorx250 xor  200000000 100000000 ->  NaN Invalid_operation
+	{"xorx250", "200000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx251 xor  700000000 100000000 ->  NaN Invalid_operation
+	{"xorx251", "700000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx252 xor  800000000 100000000 ->  NaN Invalid_operation
+	{"xorx252", "800000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx253 xor  900000000 100000000 ->  NaN Invalid_operation
+	{"xorx253", "900000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx254 xor  200000000 000000000 ->  NaN Invalid_operation
+	{"xorx254", "200000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx255 xor  700000000 000000000 ->  NaN Invalid_operation
+	{"xorx255", "700000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx256 xor  800000000 000000000 ->  NaN Invalid_operation
+	{"xorx256", "800000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx257 xor  900000000 000000000 ->  NaN Invalid_operation
+	{"xorx257", "900000000", "000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx258 xor  100000000 200000000 ->  NaN Invalid_operation
+	{"xorx258", "100000000", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx259 xor  100000000 700000000 ->  NaN Invalid_operation
+	{"xorx259", "100000000", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx260 xor  100000000 800000000 ->  NaN Invalid_operation
+	{"xorx260", "100000000", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx261 xor  100000000 900000000 ->  NaN Invalid_operation
+	{"xorx261", "100000000", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx262 xor  000000000 200000000 ->  NaN Invalid_operation
+	{"xorx262", "000000000", "200000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx263 xor  000000000 700000000 ->  NaN Invalid_operation
+	{"xorx263", "000000000", "700000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx264 xor  000000000 800000000 ->  NaN Invalid_operation
+	{"xorx264", "000000000", "800000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx265 xor  000000000 900000000 ->  NaN Invalid_operation
+	{"xorx265", "000000000", "900000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test MSD-1
+	// xorx270 xor  020000000 100000000 ->  NaN Invalid_operation
+	{"xorx270", "020000000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx271 xor  070100000 100000000 ->  NaN Invalid_operation
+	{"xorx271", "070100000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx272 xor  080010000 100000001 ->  NaN Invalid_operation
+	{"xorx272", "080010000", "100000001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx273 xor  090001000 100000010 ->  NaN Invalid_operation
+	{"xorx273", "090001000", "100000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx274 xor  100000100 020010100 ->  NaN Invalid_operation
+	{"xorx274", "100000100", "020010100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx275 xor  100000000 070001000 ->  NaN Invalid_operation
+	{"xorx275", "100000000", "070001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx276 xor  100000010 080010100 ->  NaN Invalid_operation
+	{"xorx276", "100000010", "080010100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx277 xor  100000000 090000010 ->  NaN Invalid_operation
+	{"xorx277", "100000000", "090000010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test LSD
+	// xorx280 xor  001000002 100000000 ->  NaN Invalid_operation
+	{"xorx280", "001000002", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx281 xor  000000007 100000000 ->  NaN Invalid_operation
+	{"xorx281", "000000007", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx282 xor  000000008 100000000 ->  NaN Invalid_operation
+	{"xorx282", "000000008", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx283 xor  000000009 100000000 ->  NaN Invalid_operation
+	{"xorx283", "000000009", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx284 xor  100000000 000100002 ->  NaN Invalid_operation
+	{"xorx284", "100000000", "000100002", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx285 xor  100100000 001000007 ->  NaN Invalid_operation
+	{"xorx285", "100100000", "001000007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx286 xor  100010000 010000008 ->  NaN Invalid_operation
+	{"xorx286", "100010000", "010000008", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx287 xor  100001000 100000009 ->  NaN Invalid_operation
+	{"xorx287", "100001000", "100000009", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// test Middie
+	// xorx288 xor  001020000 100000000 ->  NaN Invalid_operation
+	{"xorx288", "001020000", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx289 xor  000070001 100000000 ->  NaN Invalid_operation
+	{"xorx289", "000070001", "100000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx290 xor  000080000 100010000 ->  NaN Invalid_operation
+	{"xorx290", "000080000", "100010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx291 xor  000090000 100001000 ->  NaN Invalid_operation
+	{"xorx291", "000090000", "100001000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx292 xor  100000010 000020100 ->  NaN Invalid_operation
+	{"xorx292", "100000010", "000020100", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx293 xor  100100000 000070010 ->  NaN Invalid_operation
+	{"xorx293", "100100000", "000070010", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx294 xor  100010100 000080001 ->  NaN Invalid_operation
+	{"xorx294", "100010100", "000080001", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx295 xor  100001000 000090000 ->  NaN Invalid_operation
+	{"xorx295", "100001000", "000090000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// signs
+	// xorx296 xor -100001000 -000000000 ->  NaN Invalid_operation
+	{"xorx296", "-100001000", "-000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx297 xor -100001000  000010000 ->  NaN Invalid_operation
+	{"xorx297", "-100001000", "000010000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx298 xor  100001000 -000000000 ->  NaN Invalid_operation
+	{"xorx298", "100001000", "-000000000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx299 xor  100001000  000011000 ->  100010000
+	{"xorx299", "100001000", "000011000", "100010000", 0, 9, ToNearestAway, 999, -999, false},
+	// Nmax, Nmin, Ntiny
+	// xorx331 xor  2   9.99999999E+999     -> NaN Invalid_operation
+	{"xorx331", "2", "9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx332 xor  3   1E-999              -> NaN Invalid_operation
+	{"xorx332", "3", "1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx333 xor  4   1.00000000E-999     -> NaN Invalid_operation
+	{"xorx333", "4", "1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx334 xor  5   1E-1007             -> NaN Invalid_operation
+	{"xorx334", "5", "1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx335 xor  6   -1E-1007            -> NaN Invalid_operation
+	{"xorx335", "6", "-1E-1007", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx336 xor  7   -1.00000000E-999    -> NaN Invalid_operation
+	{"xorx336", "7", "-1.00000000E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx337 xor  8   -1E-999             -> NaN Invalid_operation
+	{"xorx337", "8", "-1E-999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx338 xor  9   -9.99999999E+999    -> NaN Invalid_operation
+	{"xorx338", "9", "-9.99999999E+999", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx341 xor  9.99999999E+999     -18 -> NaN Invalid_operation
+	{"xorx341", "9.99999999E+999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx342 xor  1E-999               01 -> NaN Invalid_operation
+	{"xorx342", "1E-999", "01", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx343 xor  1.00000000E-999     -18 -> NaN Invalid_operation
+	{"xorx343", "1.00000000E-999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx344 xor  1E-1007              18 -> NaN Invalid_operation
+	{"xorx344", "1E-1007", "18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx345 xor  -1E-1007            -10 -> NaN Invalid_operation
+	{"xorx345", "-1E-1007", "-10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx346 xor  -1.00000000E-999     18 -> NaN Invalid_operation
+	{"xorx346", "-1.00000000E-999", "18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx347 xor  -1E-999              10 -> NaN Invalid_operation
+	{"xorx347", "-1E-999", "10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx348 xor  -9.99999999E+999    -18 -> NaN Invalid_operation
+	{"xorx348", "-9.99999999E+999", "-18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// A few other non-integers
+	// xorx361 xor  1.0                  1  -> NaN Invalid_operation
+	{"xorx361", "1.0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx362 xor  1E+1                 1  -> NaN Invalid_operation
+	{"xorx362", "1E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx363 xor  0.0                  1  -> NaN Invalid_operation
+	{"xorx363", "0.0", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx364 xor  0E+1                 1  -> NaN Invalid_operation
+	{"xorx364", "0E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx365 xor  9.9                  1  -> NaN Invalid_operation
+	{"xorx365", "9.9", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx366 xor  9E+1                 1  -> NaN Invalid_operation
+	{"xorx366", "9E+1", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx371 xor  0 1.0                   -> NaN Invalid_operation
+	{"xorx371", "0", "1.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx372 xor  0 1E+1                  -> NaN Invalid_operation
+	{"xorx372", "0", "1E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx373 xor  0 0.0                   -> NaN Invalid_operation
+	{"xorx373", "0", "0.0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx374 xor  0 0E+1                  -> NaN Invalid_operation
+	{"xorx374", "0", "0E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx375 xor  0 9.9                   -> NaN Invalid_operation
+	{"xorx375", "0", "9.9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx376 xor  0 9E+1                  -> NaN Invalid_operation
+	{"xorx376", "0", "9E+1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// All Specials are in error
+	// xorx780 xor -Inf  -Inf   -> NaN Invalid_operation
+	{"xorx780", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx781 xor -Inf  -1000  -> NaN Invalid_operation
+	{"xorx781", "-Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx782 xor -Inf  -1     -> NaN Invalid_operation
+	{"xorx782", "-Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx783 xor -Inf  -0     -> NaN Invalid_operation
+	{"xorx783", "-Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx784 xor -Inf   0     -> NaN Invalid_operation
+	{"xorx784", "-Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx785 xor -Inf   1     -> NaN Invalid_operation
+	{"xorx785", "-Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx786 xor -Inf   1000  -> NaN Invalid_operation
+	{"xorx786", "-Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx787 xor -1000 -Inf   -> NaN Invalid_operation
+	{"xorx787", "-1000", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx788 xor -Inf  -Inf   -> NaN Invalid_operation
+	{"xorx788", "-Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx789 xor -1    -Inf   -> NaN Invalid_operation
+	{"xorx789", "-1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx790 xor -0    -Inf   -> NaN Invalid_operation
+	{"xorx790", "-0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx791 xor  0    -Inf   -> NaN Invalid_operation
+	{"xorx791", "0", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx792 xor  1    -Inf   -> NaN Invalid_operation
+	{"xorx792", "1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx793 xor  1000 -Inf   -> NaN Invalid_operation
+	{"xorx793", "1000", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx794 xor  Inf  -Inf   -> NaN Invalid_operation
+	{"xorx794", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx800 xor  Inf  -Inf   -> NaN Invalid_operation
+	{"xorx800", "Inf", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx801 xor  Inf  -1000  -> NaN Invalid_operation
+	{"xorx801", "Inf", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx802 xor  Inf  -1     -> NaN Invalid_operation
+	{"xorx802", "Inf", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx803 xor  Inf  -0     -> NaN Invalid_operation
+	{"xorx803", "Inf", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx804 xor  Inf   0     -> NaN Invalid_operation
+	{"xorx804", "Inf", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx805 xor  Inf   1     -> NaN Invalid_operation
+	{"xorx805", "Inf", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx806 xor  Inf   1000  -> NaN Invalid_operation
+	{"xorx806", "Inf", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx807 xor  Inf   Inf   -> NaN Invalid_operation
+	{"xorx807", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx808 xor -1000  Inf   -> NaN Invalid_operation
+	{"xorx808", "-1000", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx809 xor -Inf   Inf   -> NaN Invalid_operation
+	{"xorx809", "-Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx810 xor -1     Inf   -> NaN Invalid_operation
+	{"xorx810", "-1", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx811 xor -0     Inf   -> NaN Invalid_operation
+	{"xorx811", "-0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx812 xor  0     Inf   -> NaN Invalid_operation
+	{"xorx812", "0", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx813 xor  1     Inf   -> NaN Invalid_operation
+	{"xorx813", "1", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx814 xor  1000  Inf   -> NaN Invalid_operation
+	{"xorx814", "1000", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx815 xor  Inf   Inf   -> NaN Invalid_operation
+	{"xorx815", "Inf", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx821 xor  NaN -Inf    -> NaN Invalid_operation
+	{"xorx821", "NaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx822 xor  NaN -1000   -> NaN Invalid_operation
+	{"xorx822", "NaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx823 xor  NaN -1      -> NaN Invalid_operation
+	{"xorx823", "NaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx824 xor  NaN -0      -> NaN Invalid_operation
+	{"xorx824", "NaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx825 xor  NaN  0      -> NaN Invalid_operation
+	{"xorx825", "NaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx826 xor  NaN  1      -> NaN Invalid_operation
+	{"xorx826", "NaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx827 xor  NaN  1000   -> NaN Invalid_operation
+	{"xorx827", "NaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx828 xor  NaN  Inf    -> NaN Invalid_operation
+	{"xorx828", "NaN", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx829 xor  NaN  NaN    -> NaN Invalid_operation
+	{"xorx829", "NaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx830 xor -Inf  NaN    -> NaN Invalid_operation
+	{"xorx830", "-Inf", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx831 xor -1000 NaN    -> NaN Invalid_operation
+	{"xorx831", "-1000", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx832 xor -1    NaN    -> NaN Invalid_operation
+	{"xorx832", "-1", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx833 xor -0    NaN    -> NaN Invalid_operation
+	{"xorx833", "-0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx834 xor  0    NaN    -> NaN Invalid_operation
+	{"xorx834", "0", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx835 xor  1    NaN    -> NaN Invalid_operation
+	{"xorx835", "1", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx836 xor  1000 NaN    -> NaN Invalid_operation
+	{"xorx836", "1000", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx837 xor  Inf  NaN    -> NaN Invalid_operation
+	{"xorx837", "Inf", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx841 xor  sNaN -Inf   ->  NaN  Invalid_operation
+	{"xorx841", "sNaN", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx842 xor  sNaN -1000  ->  NaN  Invalid_operation
+	{"xorx842", "sNaN", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx843 xor  sNaN -1     ->  NaN  Invalid_operation
+	{"xorx843", "sNaN", "-1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx844 xor  sNaN -0     ->  NaN  Invalid_operation
+	{"xorx844", "sNaN", "-0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx845 xor  sNaN  0     ->  NaN  Invalid_operation
+	{"xorx845", "sNaN", "0", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx846 xor  sNaN  1     ->  NaN  Invalid_operation
+	{"xorx846", "sNaN", "1", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx847 xor  sNaN  1000  ->  NaN  Invalid_operation
+	{"xorx847", "sNaN", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx848 xor  sNaN  NaN   ->  NaN  Invalid_operation
+	{"xorx848", "sNaN", "NaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx849 xor  sNaN sNaN   ->  NaN  Invalid_operation
+	{"xorx849", "sNaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx850 xor  NaN  sNaN   ->  NaN  Invalid_operation
+	{"xorx850", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx851 xor -Inf  sNaN   ->  NaN  Invalid_operation
+	{"xorx851", "-Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx852 xor -1000 sNaN   ->  NaN  Invalid_operation
+	{"xorx852", "-1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx853 xor -1    sNaN   ->  NaN  Invalid_operation
+	{"xorx853", "-1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx854 xor -0    sNaN   ->  NaN  Invalid_operation
+	{"xorx854", "-0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx855 xor  0    sNaN   ->  NaN  Invalid_operation
+	{"xorx855", "0", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx856 xor  1    sNaN   ->  NaN  Invalid_operation
+	{"xorx856", "1", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx857 xor  1000 sNaN   ->  NaN  Invalid_operation
+	{"xorx857", "1000", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx858 xor  Inf  sNaN   ->  NaN  Invalid_operation
+	{"xorx858", "Inf", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx859 xor  NaN  sNaN   ->  NaN  Invalid_operation
+	{"xorx859", "NaN", "sNaN", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// propagating NaNs
+	// xorx861 xor  NaN1   -Inf    -> NaN Invalid_operation
+	{"xorx861", "NaN1", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx862 xor +NaN2   -1000   -> NaN Invalid_operation
+	{"xorx862", "+NaN2", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx863 xor  NaN3    1000   -> NaN Invalid_operation
+	{"xorx863", "NaN3", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx864 xor  NaN4    Inf    -> NaN Invalid_operation
+	{"xorx864", "NaN4", "Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx865 xor  NaN5   +NaN6   -> NaN Invalid_operation
+	{"xorx865", "NaN5", "+NaN6", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx866 xor -Inf     NaN7   -> NaN Invalid_operation
+	{"xorx866", "-Inf", "NaN7", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx867 xor -1000    NaN8   -> NaN Invalid_operation
+	{"xorx867", "-1000", "NaN8", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx868 xor  1000    NaN9   -> NaN Invalid_operation
+	{"xorx868", "1000", "NaN9", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx869 xor  Inf    +NaN10  -> NaN Invalid_operation
+	{"xorx869", "Inf", "+NaN10", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx871 xor  sNaN11  -Inf   -> NaN Invalid_operation
+	{"xorx871", "sNaN11", "-Inf", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx872 xor  sNaN12  -1000  -> NaN Invalid_operation
+	{"xorx872", "sNaN12", "-1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx873 xor  sNaN13   1000  -> NaN Invalid_operation
+	{"xorx873", "sNaN13", "1000", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx874 xor  sNaN14   NaN17 -> NaN Invalid_operation
+	{"xorx874", "sNaN14", "NaN17", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx875 xor  sNaN15  sNaN18 -> NaN Invalid_operation
+	{"xorx875", "sNaN15", "sNaN18", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx876 xor  NaN16   sNaN19 -> NaN Invalid_operation
+	{"xorx876", "NaN16", "sNaN19", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx877 xor -Inf    +sNaN20 -> NaN Invalid_operation
+	{"xorx877", "-Inf", "+sNaN20", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx878 xor -1000    sNaN21 -> NaN Invalid_operation
+	{"xorx878", "-1000", "sNaN21", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx879 xor  1000    sNaN22 -> NaN Invalid_operation
+	{"xorx879", "1000", "sNaN22", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx880 xor  Inf     sNaN23 -> NaN Invalid_operation
+	{"xorx880", "Inf", "sNaN23", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx881 xor +NaN25  +sNaN24 -> NaN Invalid_operation
+	{"xorx881", "+NaN25", "+sNaN24", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx882 xor -NaN26    NaN28 -> NaN Invalid_operation
+	{"xorx882", "-NaN26", "NaN28", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx883 xor -sNaN27  sNaN29 -> NaN Invalid_operation
+	{"xorx883", "-sNaN27", "sNaN29", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx884 xor  1000    -NaN30 -> NaN Invalid_operation
+	{"xorx884", "1000", "-NaN30", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+	// xorx885 xor  1000   -sNaN31 -> NaN Invalid_operation
+	{"xorx885", "1000", "-sNaN31", "NaN", InvalidOperation, 9, ToNearestAway, 999, -999, false},
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // This program generates test data to drive tests for arbitrary precision
@@ -60,11 +61,6 @@ func generate(in io.Reader, out io.Writer) error {
 					return fmt.Errorf("Unsupported operation: %s", t.operation)
 				}
 
-				if op.importMathBig {
-					fmt.Fprintln(w, "import \"math/big\"")
-					fmt.Fprintln(w, "")
-				}
-
 				fmt.Fprintf(w, "var %sTests = []struct {\n", op.name)
 				for _, f := range op.structFields {
 					fmt.Fprintf(w, "\t%s\n", f)
@@ -95,6 +91,24 @@ func generate(in io.Reader, out io.Writer) error {
 				env.precision = uint(p)
 			case "rounding":
 				env.rounding = t.value
+			case "maxexponent":
+				e, err := strconv.ParseUint(strings.TrimPrefix(t.value, "+"), 10, 0)
+				if err != nil {
+					return err
+				}
+				env.maxExponent = uint(e)
+			case "minexponent":
+				e, err := strconv.Atoi(t.value)
+				if err != nil {
+					return err
+				}
+				env.minExponent = e
+			case "clamp":
+				c, err := strconv.Atoi(t.value)
+				if err != nil {
+					return err
+				}
+				env.clamp = c
 			}
 			if op == nil {
 				fmt.Fprintf(initialComments, "\t// %s\n", t)
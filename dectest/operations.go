@@ -2,7 +2,9 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"strconv"
 	"strings"
 )
 
@@ -10,38 +12,93 @@ type operation struct {
 	name         string
 	structFields []string
 	// testDataFunc returns either (dataLine, true) or (skipReason, false)
-	testDataFunc  func(t *test, env *testEnv) (string, bool)
-	importMathBig bool
+	testDataFunc func(t *test, env *testEnv) (string, bool)
 }
 
 func findOperation(name string) *operation {
 	switch name {
-	case "abs", "minus":
+	case "abs", "minus", "copy", "copyabs", "copynegate", "reduce", "tointegral", "tointegralx",
+		"nextplus", "nextminus", "invert", "logb", "squareroot", "exp", "ln", "log10":
 		return &operation{
 			name: name,
 			structFields: []string{
-				"id      string",
-				"in      string",
-				"out     string",
-				"inexact bool",
-				"prec    uint",
-				"mode    big.RoundingMode",
+				"id    string",
+				"in    string",
+				"out   string",
+				"cond  Condition",
+				"prec  uint",
+				"mode  RoundingMode",
+				"emax  int",
+				"emin  int",
+				"clamp bool",
 			},
 			testDataFunc: func(t *test, env *testEnv) (string, bool) {
-				if strings.Index(t.src, "NaN") >= 0 {
-					return "NaN", false
+				if isEncoded(t) {
+					return "encoding not supported", false
 				}
-				mode, ok := rounding2Mode(env.rounding)
+				mode := rounding2Mode(env.rounding)
+				cond, ok := conditions2Go(t)
 				if !ok {
-					return "unsupported rounding", false
+					return cond, false
 				}
-				return fmt.Sprintf(`"%s", "%s", "%s", %t, %d, big.%s`,
-					t.id, t.operands[0], t.result, isInexact(t), env.precision, mode), true
+				return fmt.Sprintf(`"%s", "%s", "%s", %s, %d, %s, %d, %d, %t`,
+					t.id, t.operands[0], t.result, cond, env.precision, mode,
+					env.maxExponent, env.minExponent, env.clamp == 1), true
 			},
-			importMathBig: true,
 		}
 
-	case "compare", "comparetotal":
+	case "compare":
+		// the result of compare can be a NaN
+		return &operation{
+			name: name,
+			structFields: []string{
+				"id   string",
+				"in1  string",
+				"in2  string",
+				"out  string",
+				"cond Condition",
+			},
+			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				cond, ok := conditions2Go(t)
+				if !ok {
+					return cond, false
+				}
+				return fmt.Sprintf(`"%s", "%s", "%s", "%s", %s`,
+					t.id, t.operands[0], t.operands[1], t.result, cond), true
+			},
+		}
+
+	case "class":
+		// the result of class is the name of a class rather than a number
+		return &operation{
+			name: name,
+			structFields: []string{
+				"id   string",
+				"in   string",
+				"out  string",
+				"emin int",
+			},
+			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				// undo the normalization of infinite results by the parser
+				out := t.result
+				switch out {
+				case "Inf":
+					out = "+Infinity"
+				case "-Inf":
+					out = "-Infinity"
+				}
+				return fmt.Sprintf(`"%s", "%s", "%s", %d`,
+					t.id, t.operands[0], out, env.minExponent), true
+			},
+		}
+
+	case "comparetotal":
 		return &operation{
 			name: name,
 			structFields: []string{
@@ -51,32 +108,57 @@ func findOperation(name string) *operation {
 				"out int",
 			},
 			testDataFunc: func(t *test, env *testEnv) (string, bool) {
-				if strings.Index(t.src, "NaN") >= 0 {
-					return "NaN", false
+				if isEncoded(t) {
+					return "encoding not supported", false
 				}
 				return fmt.Sprintf(`"%s", "%s", "%s", %s`,
 					t.id, t.operands[0], t.operands[1], t.result), true
 			},
 		}
 
+	case "samequantum":
+		return &operation{
+			name: name,
+			structFields: []string{
+				"id  string",
+				"in1 string",
+				"in2 string",
+				"out bool",
+			},
+			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				return fmt.Sprintf(`"%s", "%s", "%s", %t`,
+					t.id, t.operands[0], t.operands[1], t.result == "1"), true
+			},
+		}
+
 	case "tosci", "toeng", "apply":
+		opName := "toSci"
+		if name == "apply" {
+			opName = "apply"
+		}
 		return &operation{
-			name: "toSci",
+			name: opName,
 			structFields: []string{
-				"id      string",
-				"in      string",
-				"out     string",
-				"inexact bool",
-				"prec    uint",
-				"mode    big.RoundingMode",
+				"id    string",
+				"in    string",
+				"out   string",
+				"cond  Condition",
+				"prec  uint",
+				"mode  RoundingMode",
+				"emax  int",
+				"emin  int",
+				"clamp bool",
 			},
 			testDataFunc: func(t *test, env *testEnv) (string, bool) {
 				// TODO: support tests for parse errors
-				if t.operation != "tosci" {
+				if t.operation != "tosci" && t.operation != "apply" {
 					return t.operation + " not supported", false
 				}
-				if strings.HasPrefix(t.id, "emax") {
-					return "emax not supported", false
+				if isEncoded(t) {
+					return "encoding not supported", false
 				}
 				if strings.Index(strings.ToLower(t.operands[0]), "inf") >= 0 {
 					return "infinity test", false
@@ -88,77 +170,305 @@ func findOperation(name string) *operation {
 					// basx512 toSci '12 '             -> NaN Conversion_syntax
 					return "TODO (nyi)", false // TODO: handle escaped space correctly
 				}
-				mode, ok := rounding2Mode(env.rounding)
+				mode := rounding2Mode(env.rounding)
+				cond, ok := conditions2Go(t)
 				if !ok {
-					return "unsupported rounding", false
+					return cond, false
 				}
 				out := t.result
-				if strings.Index(t.result, "NaN") >= 0 {
-					out = "" // empty string means "unparseable value"
+				for _, c := range t.conditions {
+					if c == "conversion_syntax" {
+						out = "" // empty string means "unparseable value"
+					}
 				}
 
-				return fmt.Sprintf(`"%s", "%s", "%s", %t, %d, big.%s`,
-					t.id, t.operands[0], out, isInexact(t), env.precision, mode), true
+				return fmt.Sprintf(`"%s", "%s", "%s", %s, %d, %s, %d, %d, %t`,
+					t.id, t.operands[0], out, cond, env.precision, mode,
+					env.maxExponent, env.minExponent, env.clamp == 1), true
 			},
-			importMathBig: true,
 		}
-	case "add", "subtract":
+	case "add", "subtract", "multiply", "divide", "divideint", "remainder", "remaindernear", "power", "quantize",
+		"nexttoward", "max", "min", "maxmag", "minmag", "and", "or", "xor", "copysign":
 		return &operation{
 			name: name,
 			structFields: []string{
-				"id      string",
-				"in1     string",
-				"in2     string",
-				"out     string",
-				"inexact bool",
-				"prec    uint",
-				"mode    big.RoundingMode",
+				"id    string",
+				"in1   string",
+				"in2   string",
+				"out   string",
+				"cond  Condition",
+				"prec  uint",
+				"mode  RoundingMode",
+				"emax  int",
+				"emin  int",
+				"clamp bool",
 			},
 			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if t.operation != name {
+					return t.operation + " not supported", false
+				}
 				if len(t.operands) != 2 {
 					return "ERROR: expected 2 operands", false
 				}
-				if strings.Index(t.src, "NaN") >= 0 {
-					return "NaN", false
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				if t.operation == "power" && !powerOperandsInRange(t) {
+					return "operand range not limited", false
+				}
+				mode := rounding2Mode(env.rounding)
+				cond, ok := conditions2Go(t)
+				if !ok {
+					return cond, false
+				}
+				return fmt.Sprintf(`"%s", "%s", "%s", "%s", %s, %d, %s, %d, %d, %t`,
+					t.id, t.operands[0], t.operands[1], t.result, cond, env.precision, mode,
+					env.maxExponent, env.minExponent, env.clamp == 1), true
+			},
+		}
+	case "rescale":
+		// the exponent operand is passed as a scale
+		return &operation{
+			name: name,
+			structFields: []string{
+				"id    string",
+				"in    string",
+				"scale int32",
+				"out   string",
+				"cond  Condition",
+				"prec  uint",
+				"mode  RoundingMode",
+				"emax  int",
+				"emin  int",
+				"clamp bool",
+			},
+			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if t.operation != name {
+					return t.operation + " not supported", false
+				}
+				if len(t.operands) != 2 {
+					return "ERROR: expected 2 operands", false
+				}
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				r, ok := new(big.Rat).SetString(t.operands[1])
+				if !ok || !r.IsInt() || !r.Num().IsInt64() {
+					return "exponent is not an int32", false
+				}
+				exp := r.Num().Int64()
+				if exp < -math.MaxInt32 || exp > math.MaxInt32 {
+					return "exponent is not an int32", false
 				}
-				mode, ok := rounding2Mode(env.rounding)
+				mode := rounding2Mode(env.rounding)
+				cond, ok := conditions2Go(t)
 				if !ok {
-					return "unsupported rounding", false
+					return cond, false
 				}
-				return fmt.Sprintf(`"%s", "%s", "%s", "%s", %t, %d, big.%s`,
-					t.id, t.operands[0], t.operands[1], t.result, isInexact(t), env.precision, mode), true
+				return fmt.Sprintf(`"%s", "%s", %d, "%s", %s, %d, %s, %d, %d, %t`,
+					t.id, t.operands[0], -exp, t.result, cond, env.precision, mode,
+					env.maxExponent, env.minExponent, env.clamp == 1), true
+			},
+		}
+	case "shift", "rotate", "scaleb":
+		// the second operand is passed as an int
+		return &operation{
+			name: name,
+			structFields: []string{
+				"id    string",
+				"in    string",
+				"n     int",
+				"out   string",
+				"cond  Condition",
+				"prec  uint",
+				"mode  RoundingMode",
+				"emax  int",
+				"emin  int",
+				"clamp bool",
+			},
+			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if t.operation != name {
+					return t.operation + " not supported", false
+				}
+				if len(t.operands) != 2 {
+					return "ERROR: expected 2 operands", false
+				}
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				n, err := strconv.Atoi(t.operands[1])
+				if err != nil {
+					return "second operand is not an int", false
+				}
+				if t.operation == "scaleb" && (n <= -1e9 || n >= 1e9) {
+					// decNumber reads integer operands of at most 9 digits
+					return "operand range not limited", false
+				}
+				mode := rounding2Mode(env.rounding)
+				cond, ok := conditions2Go(t)
+				if !ok {
+					return cond, false
+				}
+				return fmt.Sprintf(`"%s", "%s", %d, "%s", %s, %d, %s, %d, %d, %t`,
+					t.id, t.operands[0], n, t.result, cond, env.precision, mode,
+					env.maxExponent, env.minExponent, env.clamp == 1), true
+			},
+		}
+	case "fma":
+		return &operation{
+			name: name,
+			structFields: []string{
+				"id    string",
+				"in1   string",
+				"in2   string",
+				"in3   string",
+				"out   string",
+				"cond  Condition",
+				"prec  uint",
+				"mode  RoundingMode",
+				"emax  int",
+				"emin  int",
+				"clamp bool",
+			},
+			testDataFunc: func(t *test, env *testEnv) (string, bool) {
+				if t.operation != name {
+					return t.operation + " not supported", false
+				}
+				if len(t.operands) != 3 {
+					return "ERROR: expected 3 operands", false
+				}
+				if isEncoded(t) {
+					return "encoding not supported", false
+				}
+				mode := rounding2Mode(env.rounding)
+				cond, ok := conditions2Go(t)
+				if !ok {
+					return cond, false
+				}
+				return fmt.Sprintf(`"%s", "%s", "%s", "%s", "%s", %s, %d, %s, %d, %d, %t`,
+					t.id, t.operands[0], t.operands[1], t.operands[2], t.result, cond,
+					env.precision, mode, env.maxExponent, env.minExponent, env.clamp == 1), true
 			},
-			importMathBig: true,
 		}
 	}
 	return nil
 }
 
-func isInexact(t *test) bool {
-	for _, c := range t.conditions {
-		if c == "inexact" {
+// isEncoded reports whether an operand or the result of t is given in an
+// encoded (hexadecimal) form or with a format prefix such as "64#".
+func isEncoded(t *test) bool {
+	for _, o := range t.operands {
+		if strings.Contains(o, "#") {
 			return true
 		}
 	}
-	return false
+	return strings.Contains(t.result, "#")
+}
+
+// powerOperandsInRange reports whether the operands of the power test t are
+// within the implementation limits of decNumber. Unless the exponent is an
+// integer of at most 9 digits, decNumber requires the finite non-zero
+// operands to have adjusted exponents in the range [-1999997, 999999]
+// (DEC_MAX_MATH); big2 has no such limit.
+func powerOperandsInRange(t *test) bool {
+	const maxMath = 999999
+	if coef, exp, ok := parseDecimal(t.operands[1]); ok && isSmallInt(coef, exp) {
+		return true
+	}
+	for _, o := range t.operands {
+		coef, exp, ok := parseDecimal(o)
+		if !ok || coef == "" {
+			continue
+		}
+		if e := exp + int64(len(coef)) - 1; e > maxMath || e < 2*(1-maxMath)-1 {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDecimal returns the coefficient without leading zeros and the
+// exponent of the finite number s, or false if s is not a finite number.
+func parseDecimal(s string) (coef string, exp int64, ok bool) {
+	s = strings.TrimLeft(s, "+-")
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 64)
+		if err != nil {
+			return "", 0, false
+		}
+		exp = e
+		s = s[:i]
+	}
+	if i := strings.Index(s, "."); i >= 0 {
+		exp -= int64(len(s) - i - 1)
+		s = s[:i] + s[i+1:]
+	}
+	if s == "" || strings.Trim(s, "0123456789") != "" {
+		return "", 0, false
+	}
+	return strings.TrimLeft(s, "0"), exp, true
+}
+
+// isSmallInt reports whether coef×10**exp is an integer of at most 9 digits.
+func isSmallInt(coef string, exp int64) bool {
+	for exp < 0 && strings.HasSuffix(coef, "0") {
+		coef = coef[:len(coef)-1]
+		exp++
+	}
+	return coef == "" || exp >= 0 && int64(len(coef))+exp <= 9
+}
+
+// conditions2Go returns the conditions of t as a Go expression of type
+// big2.Condition or false if t has a condition that is not supported.
+func conditions2Go(t *test) (string, bool) {
+	names := make([]string, 0, len(t.conditions))
+	for _, c := range t.conditions {
+		name, ok := conditionNames[c]
+		if !ok {
+			return "unsupported condition " + c, false
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "0", true
+	}
+	return strings.Join(names, " | "), true
+}
+
+var conditionNames = map[string]string{
+	"clamped":             "Clamped",
+	"conversion_syntax":   "ConversionSyntax",
+	"division_by_zero":    "DivisionByZero",
+	"division_impossible": "DivisionImpossible",
+	"division_undefined":  "DivisionUndefined",
+	"inexact":             "Inexact",
+	"invalid_operation":   "InvalidOperation",
+	"overflow":            "Overflow",
+	"rounded":             "Rounded",
+	"subnormal":           "Subnormal",
+	"underflow":           "Underflow",
 }
 
-func rounding2Mode(s string) (big.RoundingMode, bool) {
+// rounding2Mode returns the name of the big2.RoundingMode constant for the
+// rounding directive value s.
+func rounding2Mode(s string) string {
 	switch s {
 	case "half_even":
-		return big.ToNearestEven, true
+		return "ToNearestEven"
 	case "half_up":
-		return big.ToNearestAway, true
+		return "ToNearestAway"
 	case "down":
-		return big.ToZero, true
+		return "ToZero"
 	case "up":
-		return big.AwayFromZero, true
+		return "AwayFromZero"
 	case "floor":
-		return big.ToNegativeInf, true
+		return "ToNegativeInf"
 	case "ceiling":
-		return big.ToPositiveInf, true
+		return "ToPositiveInf"
 	case "half_down":
-		return 0, false // not supported
+		return "ToNearestZero"
+	case "05up":
+		return "ZeroFiveUp"
 	}
 	panic("unexpected rounding " + s)
 }
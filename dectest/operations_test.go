@@ -17,6 +17,8 @@ func ExampleAbs() {
 	generateFromString(`
 precision:   9
 rounding:    half_up
+maxExponent: 384
+minExponent: -383
 absx001 abs '1'      -> '1'`)
 
 	// Output:
@@ -24,20 +26,23 @@ absx001 abs '1'      -> '1'`)
 	//
 	// // Generated by dectest. DO NOT EDIT
 	//
-	// import "math/big"
-	//
 	// var absTests = []struct {
-	// 	id      string
-	// 	in      string
-	// 	out     string
-	// 	inexact bool
-	// 	prec    uint
-	// 	mode    big.RoundingMode
+	// 	id    string
+	// 	in    string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
 	// }{
 	// 	// precision: 9
 	// 	// rounding: half_up
+	// 	// maxexponent: 384
+	// 	// minexponent: -383
 	// 	// absx001 abs '1'      -> '1'
-	// 	{"absx001", "1", "1", false, 9, big.ToNearestAway},
+	// 	{"absx001", "1", "1", 0, 9, ToNearestAway, 384, -383, false},
 	// }
 }
 
@@ -63,15 +68,16 @@ minx021 minus         NaN  -> -NaN
 	//
 	// // Generated by dectest. DO NOT EDIT
 	//
-	// import "math/big"
-	//
 	// var minusTests = []struct {
-	// 	id      string
-	// 	in      string
-	// 	out     string
-	// 	inexact bool
-	// 	prec    uint
-	// 	mode    big.RoundingMode
+	// 	id    string
+	// 	in    string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
 	// }{
 	// 	// version: 2.62
 	// 	// extended: 1
@@ -81,8 +87,9 @@ minx021 minus         NaN  -> -NaN
 	// 	// minexponent: -999
 	// 	// Sanity check
 	// 	// minx001 minus       +7.50  -> -7.50
-	// 	{"minx001", "+7.50", "-7.50", false, 9, big.ToNearestAway},
-	// 	// SKIP (NaN): minx021 minus         NaN  -> -NaN
+	// 	{"minx001", "+7.50", "-7.50", 0, 9, ToNearestAway, 999, -999, false},
+	// 	// minx021 minus         NaN  -> -NaN
+	// 	{"minx021", "NaN", "-NaN", 0, 9, ToNearestAway, 999, -999, false},
 	// }
 }
 
@@ -102,16 +109,17 @@ comx001 compare  -2  -2  -> 0
 	// // Generated by dectest. DO NOT EDIT
 	//
 	// var compareTests = []struct {
-	// 	id  string
-	// 	in1 string
-	// 	in2 string
-	// 	out int
+	// 	id   string
+	// 	in1  string
+	// 	in2  string
+	// 	out  string
+	// 	cond Condition
 	// }{
 	// 	// precision: 9
 	// 	// rounding: half_up
 	// 	// sanity checks
 	// 	// comx001 compare  -2  -2  -> 0
-	// 	{"comx001", "-2", "-2", 0},
+	// 	{"comx001", "-2", "-2", "0", 0},
 	// }
 }
 
@@ -165,31 +173,33 @@ bsrx402  toSci  1.234549   ->  1.2346  Rounded Inexact
 	//
 	// // Generated by dectest. DO NOT EDIT
 	//
-	// import "math/big"
-	//
 	// var toSciTests = []struct {
-	// 	id      string
-	// 	in      string
-	// 	out     string
-	// 	inexact bool
-	// 	prec    uint
-	// 	mode    big.RoundingMode
+	// 	id    string
+	// 	in    string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
 	// }{
 	// 	// precision: 16
 	// 	// rounding: half_up
 	// 	// maxexponent: 384
 	// 	// minexponent: -383
 	// 	// basx001 toSci       0 -> 0
-	// 	{"basx001", "0", "0", false, 16, big.ToNearestAway},
+	// 	{"basx001", "0", "0", 0, 16, ToNearestAway, 384, -383, false},
 	// 	// basx500 toSci '1..2' -> NaN Conversion_syntax
-	// 	{"basx500", "1..2", "", false, 16, big.ToNearestAway},
+	// 	{"basx500", "1..2", "", ConversionSyntax, 16, ToNearestAway, 384, -383, false},
 	// 	// SKIP (toeng not supported): basx302 toEng 10e12  -> 10E+12
-	// 	// SKIP (emax not supported): emax006 toSci   -1   -> -1
+	// 	// emax006 toSci   -1   -> -1
+	// 	{"emax006", "-1", "-1", 0, 16, ToNearestAway, 384, -383, false},
 	// 	// SKIP (infinity test): basx748 toSci "+InFinity" -> Infinity
 	// 	// precision: 5
 	// 	// rounding: ceiling
 	// 	// bsrx402  toSci  1.234549   ->  1.2346  Rounded Inexact
-	// 	{"bsrx402", "1.234549", "1.2346", true, 5, big.ToPositiveInf},
+	// 	{"bsrx402", "1.234549", "1.2346", Rounded | Inexact, 5, ToPositiveInf, 384, -383, false},
 	// }
 }
 
@@ -197,6 +207,8 @@ func ExampleAdd() {
 	generateFromString(`
 precision:   9
 rounding:    half_up
+maxExponent: 384
+minExponent: -383
 
 addx001 add 1       1       ->  2`)
 
@@ -205,21 +217,24 @@ addx001 add 1       1       ->  2`)
 	//
 	// // Generated by dectest. DO NOT EDIT
 	//
-	// import "math/big"
-	//
 	// var addTests = []struct {
-	// 	id      string
-	// 	in1     string
-	// 	in2     string
-	// 	out     string
-	// 	inexact bool
-	// 	prec    uint
-	// 	mode    big.RoundingMode
+	// 	id    string
+	// 	in1   string
+	// 	in2   string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
 	// }{
 	// 	// precision: 9
 	// 	// rounding: half_up
+	// 	// maxexponent: 384
+	// 	// minexponent: -383
 	// 	// addx001 add 1       1       ->  2
-	// 	{"addx001", "1", "1", "2", false, 9, big.ToNearestAway},
+	// 	{"addx001", "1", "1", "2", 0, 9, ToNearestAway, 384, -383, false},
 	// }
 }
 
@@ -227,6 +242,8 @@ func ExampleSub() {
 	generateFromString(`
 precision:   9
 rounding:    half_up
+maxExponent: 384
+minExponent: -383
 
 subx001 subtract  0   0  -> '0'
 `)
@@ -236,20 +253,208 @@ subx001 subtract  0   0  -> '0'
 	//
 	// // Generated by dectest. DO NOT EDIT
 	//
-	// import "math/big"
-	//
 	// var subtractTests = []struct {
-	// 	id      string
-	// 	in1     string
-	// 	in2     string
-	// 	out     string
-	// 	inexact bool
-	// 	prec    uint
-	// 	mode    big.RoundingMode
+	// 	id    string
+	// 	in1   string
+	// 	in2   string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
 	// }{
 	// 	// precision: 9
 	// 	// rounding: half_up
+	// 	// maxexponent: 384
+	// 	// minexponent: -383
 	// 	// subx001 subtract  0   0  -> '0'
-	// 	{"subx001", "0", "0", "0", false, 9, big.ToNearestAway},
+	// 	{"subx001", "0", "0", "0", 0, 9, ToNearestAway, 384, -383, false},
+	// }
+}
+
+func ExampleMultiply() {
+	generateFromString(`
+precision:   9
+rounding:    half_up
+maxExponent: 384
+minExponent: -383
+
+mulx000 multiply 2      2 -> 4
+`)
+
+	// Output:
+	// package big2
+	//
+	// // Generated by dectest. DO NOT EDIT
+	//
+	// var multiplyTests = []struct {
+	// 	id    string
+	// 	in1   string
+	// 	in2   string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
+	// }{
+	// 	// precision: 9
+	// 	// rounding: half_up
+	// 	// maxexponent: 384
+	// 	// minexponent: -383
+	// 	// mulx000 multiply 2      2 -> 4
+	// 	{"mulx000", "2", "2", "4", 0, 9, ToNearestAway, 384, -383, false},
+	// }
+}
+
+func ExampleDivide() {
+	generateFromString(`
+precision:   9
+rounding:    half_up
+maxExponent: 384
+minExponent: -383
+
+divx008 divide  2      3     -> 0.666666667 Inexact Rounded
+`)
+
+	// Output:
+	// package big2
+	//
+	// // Generated by dectest. DO NOT EDIT
+	//
+	// var divideTests = []struct {
+	// 	id    string
+	// 	in1   string
+	// 	in2   string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
+	// }{
+	// 	// precision: 9
+	// 	// rounding: half_up
+	// 	// maxexponent: 384
+	// 	// minexponent: -383
+	// 	// divx008 divide  2      3     -> 0.666666667 Inexact Rounded
+	// 	{"divx008", "2", "3", "0.666666667", Inexact | Rounded, 9, ToNearestAway, 384, -383, false},
+	// }
+}
+
+func ExampleRemainderNear() {
+	generateFromString(`
+precision:   9
+rounding:    half_up
+maxExponent: 384
+minExponent: -383
+
+rmnx008 remaindernear  2     3    -> -1
+`)
+
+	// Output:
+	// package big2
+	//
+	// // Generated by dectest. DO NOT EDIT
+	//
+	// var remaindernearTests = []struct {
+	// 	id    string
+	// 	in1   string
+	// 	in2   string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
+	// }{
+	// 	// precision: 9
+	// 	// rounding: half_up
+	// 	// maxexponent: 384
+	// 	// minexponent: -383
+	// 	// rmnx008 remaindernear  2     3    -> -1
+	// 	{"rmnx008", "2", "3", "-1", 0, 9, ToNearestAway, 384, -383, false},
+	// }
+}
+
+func ExamplePower() {
+	generateFromString(`
+precision:   9
+rounding:    half_up
+maxExponent: 999999
+minExponent: -999999
+powx289  power 9.9E999999999 999999       -> Infinity Overflow Inexact Rounded
+powx4007 power 1             1.1E+999999  -> 1
+powx4008 power 1             1.1E+1000000 -> NaN Invalid_operation
+powx4014 power 1.1E-1999998  1.1          -> NaN Invalid_operation`)
+
+	// Output:
+	// package big2
+	//
+	// // Generated by dectest. DO NOT EDIT
+	//
+	// var powerTests = []struct {
+	// 	id    string
+	// 	in1   string
+	// 	in2   string
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
+	// }{
+	// 	// precision: 9
+	// 	// rounding: half_up
+	// 	// maxexponent: 999999
+	// 	// minexponent: -999999
+	// 	// powx289  power 9.9E999999999 999999       -> Infinity Overflow Inexact Rounded
+	// 	{"powx289", "9.9E999999999", "999999", "Inf", Overflow | Inexact | Rounded, 9, ToNearestAway, 999999, -999999, false},
+	// 	// powx4007 power 1             1.1E+999999  -> 1
+	// 	{"powx4007", "1", "1.1E+999999", "1", 0, 9, ToNearestAway, 999999, -999999, false},
+	// 	// SKIP (operand range not limited): powx4008 power 1             1.1E+1000000 -> NaN Invalid_operation
+	// 	// SKIP (operand range not limited): powx4014 power 1.1E-1999998  1.1          -> NaN Invalid_operation
+	// }
+}
+
+func ExampleScaleB() {
+	generateFromString(`
+precision:   34
+rounding:    half_up
+maxExponent: 999999999
+minExponent: -999999999
+scbx001 scaleb 7.50          10          -> 7.50E+10
+scbx164 scaleb 1E-999999999  -1200000000 -> NaN Invalid_operation`)
+
+	// Output:
+	// package big2
+	//
+	// // Generated by dectest. DO NOT EDIT
+	//
+	// var scalebTests = []struct {
+	// 	id    string
+	// 	in    string
+	// 	n     int
+	// 	out   string
+	// 	cond  Condition
+	// 	prec  uint
+	// 	mode  RoundingMode
+	// 	emax  int
+	// 	emin  int
+	// 	clamp bool
+	// }{
+	// 	// precision: 34
+	// 	// rounding: half_up
+	// 	// maxexponent: 999999999
+	// 	// minexponent: -999999999
+	// 	// scbx001 scaleb 7.50          10          -> 7.50E+10
+	// 	{"scbx001", "7.50", 10, "7.50E+10", 0, 34, ToNearestAway, 999999999, -999999999, false},
+	// 	// SKIP (operand range not limited): scbx164 scaleb 1E-999999999  -1200000000 -> NaN Invalid_operation
 	// }
 }
@@ -138,9 +138,13 @@ func parseLine(line string) statement {
 		if len(ss) == 2 {
 			d := directive{
 				keyword: strings.ToLower(strings.TrimSpace(ss[0])),
-				value:   strings.ToLower(strings.TrimSpace(ss[1])),
-				comment: "", // TODO: detect comments in directives
+				value:   ss[1],
 			}
+			if i := strings.Index(d.value, "--"); i >= 0 {
+				d.comment = strings.TrimSpace(strings.TrimPrefix(d.value[i:], "--"))
+				d.value = d.value[:i]
+			}
+			d.value = strings.ToLower(strings.TrimSpace(d.value))
 			return &d
 		}
 		// try to parse this line as a testcase
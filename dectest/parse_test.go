@@ -14,6 +14,7 @@ func ExampleDectestParsing() {
 Version: 2.44
 precision: 100
  Rounding :  half_UP  
+maxExponent: 10  -- boundary
 
 simp001  add       1 1 -> 2   -- can we get this right?
 simp002  multiply  2 2 -> 4
@@ -39,6 +40,7 @@ simp006  multiply  Infinity "-Infinity" -> Infinity
 	// *main.directive (&main.directive{keyword:"version", value:"2.44", comment:""})
 	// *main.directive (&main.directive{keyword:"precision", value:"100", comment:""})
 	// *main.directive (&main.directive{keyword:"rounding", value:"half_up", comment:""})
+	// *main.directive (&main.directive{keyword:"maxexponent", value:"10", comment:"boundary"})
 	// *main.test (&main.test{src:"simp001  add       1 1 -> 2   -- can we get this right?", id:"simp001", operation:"add", operands:[]string{"1", "1"}, result:"2", conditions:[]string{}, comment:"can we get this right?"})
 	// *main.test (&main.test{src:"simp002  multiply  2 2 -> 4", id:"simp002", operation:"multiply", operands:[]string{"2", "2"}, result:"4", conditions:[]string{}, comment:""})
 	// *main.test (&main.test{src:"simp003  divide    1 3 -> 0.333333333  Inexact Rounded", id:"simp003", operation:"divide", operands:[]string{"1", "3"}, result:"0.333333333", conditions:[]string{"inexact", "rounded"}, comment:""})